@@ -340,6 +340,8 @@ type Metadata struct {
 	//
 	// Since: cosmos-sdk 0.43
 	Symbol string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// URI to a document (on or off-chain) that contains additional information. Optional.
+	URI string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -417,6 +419,13 @@ func (m *Metadata) GetSymbol() string {
 	return ""
 }
 
+func (m *Metadata) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -430,45 +439,46 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 602 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0xd4, 0x4e,
-	0x14, 0xdf, 0xe9, 0x76, 0xf3, 0xdd, 0xce, 0x7e, 0xbd, 0x8c, 0x45, 0xd2, 0x82, 0x49, 0x0c, 0x08,
-	0x55, 0xda, 0xa4, 0xd5, 0x9e, 0x16, 0x41, 0x58, 0x7f, 0x94, 0x0a, 0xa2, 0xa4, 0x88, 0xa0, 0x87,
-	0x65, 0x92, 0x99, 0x6e, 0x87, 0x26, 0x33, 0x61, 0x67, 0x52, 0x9a, 0xff, 0xc0, 0xa3, 0xe8, 0xc5,
-	0x63, 0xcf, 0x9e, 0xbd, 0xf9, 0x07, 0xd8, 0x63, 0xd1, 0x8b, 0xa7, 0x2a, 0xed, 0xc5, 0x73, 0xff,
-	0x02, 0xc9, 0x4c, 0x76, 0xbb, 0x85, 0x15, 0x3d, 0x78, 0xf0, 0xf6, 0x3e, 0xef, 0x7d, 0xe6, 0xf3,
-	0x3e, 0xbc, 0xf7, 0x12, 0xe8, 0x24, 0x42, 0x66, 0x42, 0x86, 0x31, 0xe6, 0xbb, 0xe1, 0xde, 0x5a,
-	0x4c, 0x15, 0x5e, 0xd3, 0x20, 0xc8, 0x87, 0x42, 0x09, 0x74, 0xd9, 0xd4, 0x03, 0x9d, 0xaa, 0xeb,
-	0x8b, 0xf3, 0x03, 0x31, 0x10, 0xba, 0x1e, 0x56, 0x91, 0xa1, 0x2e, 0x2e, 0x18, 0x6a, 0xdf, 0x14,
-	0xea, 0x77, 0xa6, 0x74, 0xde, 0x45, 0xd2, 0x71, 0x97, 0x44, 0x30, 0x6e, 0xea, 0xfe, 0x17, 0x00,
-	0xad, 0xa7, 0x78, 0x88, 0x33, 0x89, 0xb6, 0xe1, 0xff, 0x92, 0x72, 0xd2, 0xa7, 0x1c, 0xc7, 0x29,
-	0x25, 0x36, 0xf0, 0x9a, 0x4b, 0x9d, 0x5b, 0x5e, 0x30, 0xc5, 0x47, 0xb0, 0x45, 0x39, 0x79, 0x60,
-	0x78, 0xbd, 0x6b, 0x67, 0xc7, 0xee, 0xd5, 0x12, 0x67, 0x69, 0xd7, 0x9f, 0x7c, 0xbf, 0x2c, 0x32,
-	0xa6, 0x68, 0x96, 0xab, 0xd2, 0x8f, 0x3a, 0xf2, 0x9c, 0x8f, 0x5e, 0xc2, 0x79, 0x42, 0xb7, 0x71,
-	0x91, 0xaa, 0xfe, 0x85, 0x7e, 0x33, 0x1e, 0x58, 0x6a, 0xf7, 0x6e, 0x9c, 0x1d, 0xbb, 0xd7, 0x8d,
-	0xda, 0x34, 0xd6, 0xa4, 0x2a, 0xaa, 0x09, 0x13, 0x66, 0xba, 0xb3, 0xef, 0x0e, 0xdc, 0x86, 0xbf,
-	0x01, 0x3b, 0x13, 0x49, 0x34, 0x0f, 0x5b, 0x84, 0x72, 0x91, 0xd9, 0xc0, 0x03, 0x4b, 0x73, 0x91,
-	0x01, 0xc8, 0x86, 0xff, 0x5d, 0x68, 0x1d, 0x8d, 0x60, 0xb7, 0x5d, 0x89, 0xfc, 0x38, 0x70, 0x81,
-	0xff, 0x06, 0xc0, 0xd6, 0x26, 0xcf, 0x0b, 0x55, 0xb1, 0x31, 0x21, 0x43, 0x2a, 0x65, 0xad, 0x32,
-	0x82, 0x88, 0xc2, 0x56, 0x35, 0x50, 0x69, 0xcf, 0xe8, 0x81, 0x2d, 0x9c, 0x0f, 0x4c, 0xd2, 0xf1,
-	0xc0, 0xee, 0x09, 0xc6, 0x7b, 0xeb, 0x87, 0xc7, 0x6e, 0xe3, 0xfd, 0x37, 0x77, 0x79, 0xc0, 0xd4,
-	0x4e, 0x11, 0x07, 0x89, 0xc8, 0xc2, 0x87, 0x8c, 0xcb, 0x64, 0x87, 0xe1, 0x70, 0xbb, 0x0e, 0x56,
-	0x24, 0xd9, 0x0d, 0x55, 0x99, 0x53, 0xa9, 0x1f, 0xc9, 0xc8, 0xa8, 0x77, 0xdb, 0xaf, 0x8c, 0xa9,
-	0x86, 0xff, 0x16, 0x40, 0xeb, 0x49, 0xa1, 0xfe, 0x31, 0x57, 0x1f, 0x01, 0xb4, 0xb6, 0x8a, 0x3c,
-	0x4f, 0xcb, 0xaa, 0xb7, 0x12, 0x0a, 0xa7, 0xf5, 0x09, 0xfd, 0xfd, 0xde, 0x5a, 0xbd, 0xfb, 0xa8,
-	0xee, 0x0d, 0x3e, 0x7f, 0x58, 0xb9, 0x73, 0xf3, 0xb7, 0x0a, 0xfb, 0xe6, 0x53, 0x4b, 0xe9, 0x00,
-	0x27, 0x65, 0xb8, 0xb7, 0xba, 0xbe, 0x1a, 0x18, 0xbf, 0x9b, 0x36, 0xf0, 0x9f, 0xc3, 0xb9, 0xfb,
-	0xd5, 0x55, 0x3c, 0xe3, 0x4c, 0xfd, 0xe2, 0x5e, 0x16, 0x61, 0x9b, 0xee, 0xe7, 0x82, 0x53, 0xae,
-	0xf4, 0xc1, 0x5c, 0x8a, 0xc6, 0x58, 0xef, 0x21, 0x65, 0x58, 0x52, 0x69, 0x37, 0xbd, 0xa6, 0xde,
-	0x83, 0x81, 0xfe, 0x27, 0x00, 0xdb, 0x8f, 0xa9, 0xc2, 0x04, 0x2b, 0x8c, 0x3c, 0xd8, 0x21, 0x54,
-	0x26, 0x43, 0x96, 0x2b, 0x26, 0x78, 0x2d, 0x3f, 0x99, 0x42, 0x77, 0x2b, 0x06, 0x17, 0x59, 0xbf,
-	0xe0, 0x4c, 0x8d, 0x96, 0xe7, 0x4c, 0xfd, 0x06, 0xc7, 0x7e, 0x23, 0x48, 0x46, 0xa1, 0x44, 0x08,
-	0xce, 0x56, 0x63, 0xb6, 0x9b, 0x5a, 0x5b, 0xc7, 0x95, 0x3b, 0xc2, 0x64, 0x9e, 0xe2, 0xd2, 0x9e,
-	0x35, 0x57, 0x52, 0xc3, 0x8a, 0xcd, 0x71, 0x46, 0xed, 0x96, 0x61, 0x57, 0x31, 0xba, 0x02, 0x2d,
-	0x59, 0x66, 0xb1, 0x48, 0x6d, 0x4b, 0x67, 0x6b, 0xd4, 0xdb, 0x38, 0x3c, 0x71, 0xc0, 0xd1, 0x89,
-	0x03, 0xbe, 0x9f, 0x38, 0xe0, 0xf5, 0xa9, 0xd3, 0x38, 0x3a, 0x75, 0x1a, 0x5f, 0x4f, 0x9d, 0xc6,
-	0x8b, 0x95, 0x3f, 0x9d, 0xbd, 0x5e, 0x62, 0x6c, 0xe9, 0x5f, 0xcf, 0xed, 0x9f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xd9, 0xb7, 0x17, 0x4d, 0x02, 0x05, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x34, 0x4d, 0x9a, 0x4e, 0xf4, 0x32, 0x16, 0x99, 0x16, 0xdc, 0x8d, 0x0b, 0x42, 0x95,
+	0x36, 0xdb, 0x6a, 0x4f, 0x41, 0x10, 0xe2, 0x8f, 0x52, 0x41, 0x94, 0x2d, 0x45, 0xd0, 0x43, 0x98,
+	0x64, 0xa6, 0xe9, 0xd0, 0xdd, 0x99, 0x25, 0x33, 0x5b, 0xba, 0xff, 0x81, 0x47, 0xd1, 0x8b, 0xc7,
+	0x9e, 0x3d, 0x7b, 0xf3, 0x1f, 0xe8, 0xb1, 0xe8, 0xc5, 0x53, 0x94, 0xf4, 0xe2, 0xb9, 0x7f, 0x81,
+	0xcc, 0xcc, 0x26, 0x4d, 0xa1, 0xa2, 0x17, 0xc1, 0xdb, 0xfb, 0xde, 0xfb, 0xe6, 0x7b, 0x1f, 0xef,
+	0xbd, 0x5d, 0xe8, 0xf5, 0xa4, 0x4a, 0xa4, 0x0a, 0xbb, 0x44, 0xec, 0x87, 0x07, 0xeb, 0x5d, 0xa6,
+	0xc9, 0xba, 0x05, 0xcd, 0x74, 0x20, 0xb5, 0x44, 0xd7, 0x5c, 0xbd, 0x69, 0x53, 0x45, 0x7d, 0x69,
+	0xa1, 0x2f, 0xfb, 0xd2, 0xd6, 0x43, 0x13, 0x39, 0xea, 0xd2, 0xa2, 0xa3, 0x76, 0x5c, 0xa1, 0x78,
+	0xe7, 0x4a, 0xe7, 0x5d, 0x14, 0x9b, 0x74, 0xe9, 0x49, 0x2e, 0x5c, 0x3d, 0xf8, 0x0a, 0x60, 0xf5,
+	0x05, 0x19, 0x90, 0x44, 0xa1, 0x5d, 0x78, 0x45, 0x31, 0x41, 0x3b, 0x4c, 0x90, 0x6e, 0xcc, 0x28,
+	0x06, 0x8d, 0xf2, 0x72, 0xfd, 0x6e, 0xa3, 0x79, 0x89, 0x8f, 0xe6, 0x36, 0x13, 0xf4, 0xb1, 0xe3,
+	0xb5, 0x6f, 0x9e, 0x0d, 0xfd, 0x1b, 0x39, 0x49, 0xe2, 0x56, 0x30, 0xfd, 0x7e, 0x45, 0x26, 0x5c,
+	0xb3, 0x24, 0xd5, 0x79, 0x10, 0xd5, 0xd5, 0x39, 0x1f, 0xbd, 0x86, 0x0b, 0x94, 0xed, 0x92, 0x2c,
+	0xd6, 0x9d, 0x0b, 0xfd, 0x66, 0x1a, 0x60, 0xb9, 0xd6, 0xbe, 0x7d, 0x36, 0xf4, 0x6f, 0x39, 0xb5,
+	0xcb, 0x58, 0xd3, 0xaa, 0xa8, 0x20, 0x4c, 0x99, 0x69, 0xcd, 0x7e, 0x38, 0xf2, 0x4b, 0xc1, 0x26,
+	0xac, 0x4f, 0x25, 0xd1, 0x02, 0xac, 0x50, 0x26, 0x64, 0x82, 0x41, 0x03, 0x2c, 0xcf, 0x47, 0x0e,
+	0x20, 0x0c, 0xe7, 0x2e, 0xb4, 0x8e, 0xc6, 0xb0, 0x55, 0x33, 0x22, 0x3f, 0x8f, 0x7c, 0x10, 0xbc,
+	0x03, 0xb0, 0xb2, 0x25, 0xd2, 0x4c, 0x1b, 0x36, 0xa1, 0x74, 0xc0, 0x94, 0x2a, 0x54, 0xc6, 0x10,
+	0x31, 0x58, 0x31, 0x03, 0x55, 0x78, 0xc6, 0x0e, 0x6c, 0xf1, 0x7c, 0x60, 0x8a, 0x4d, 0x06, 0xf6,
+	0x50, 0x72, 0xd1, 0xde, 0x38, 0x1e, 0xfa, 0xa5, 0x8f, 0xdf, 0xfd, 0x95, 0x3e, 0xd7, 0x7b, 0x59,
+	0xb7, 0xd9, 0x93, 0x49, 0xf8, 0x84, 0x0b, 0xd5, 0xdb, 0xe3, 0x24, 0xdc, 0x2d, 0x82, 0x55, 0x45,
+	0xf7, 0x43, 0x9d, 0xa7, 0x4c, 0xd9, 0x47, 0x2a, 0x72, 0xea, 0xad, 0xda, 0x1b, 0x67, 0xaa, 0x14,
+	0xbc, 0x07, 0xb0, 0xfa, 0x3c, 0xd3, 0xff, 0x99, 0xab, 0xcf, 0x00, 0x56, 0xb7, 0xb3, 0x34, 0x8d,
+	0x73, 0xd3, 0x5b, 0x4b, 0x4d, 0x62, 0x0c, 0xfe, 0x51, 0x6f, 0xab, 0xde, 0x7a, 0x5a, 0xf4, 0x06,
+	0x5f, 0x3e, 0xad, 0xde, 0xbf, 0xf3, 0x47, 0x85, 0x43, 0xf7, 0xa9, 0xc5, 0xac, 0x4f, 0x7a, 0x79,
+	0x78, 0xb0, 0xb6, 0xb1, 0xd6, 0x74, 0x7e, 0xb7, 0x30, 0x08, 0x5e, 0xc2, 0xf9, 0x47, 0xe6, 0x2a,
+	0x76, 0x04, 0xd7, 0xbf, 0xb9, 0x97, 0x25, 0x58, 0x63, 0x87, 0xa9, 0x14, 0x4c, 0x68, 0x7b, 0x30,
+	0x57, 0xa3, 0x09, 0xb6, 0x7b, 0x88, 0x39, 0x51, 0x4c, 0xe1, 0x72, 0xa3, 0x6c, 0xf7, 0xe0, 0x60,
+	0x30, 0x02, 0xb0, 0xf6, 0x8c, 0x69, 0x42, 0x89, 0x26, 0xa8, 0x01, 0xeb, 0x94, 0xa9, 0xde, 0x80,
+	0xa7, 0x9a, 0x4b, 0x51, 0xc8, 0x4f, 0xa7, 0xd0, 0x03, 0xc3, 0x10, 0x32, 0xe9, 0x64, 0x82, 0xeb,
+	0xf1, 0xf2, 0xbc, 0x4b, 0xbf, 0xc1, 0x89, 0xdf, 0x08, 0xd2, 0x71, 0xa8, 0x10, 0x82, 0xb3, 0x66,
+	0xcc, 0xb8, 0x6c, 0xb5, 0x6d, 0x6c, 0xdc, 0x51, 0xae, 0xd2, 0x98, 0xe4, 0x78, 0xd6, 0x5d, 0x49,
+	0x01, 0x0d, 0x5b, 0x90, 0x84, 0xe1, 0x8a, 0x63, 0x9b, 0x18, 0x5d, 0x87, 0x55, 0x95, 0x27, 0x5d,
+	0x19, 0xe3, 0xaa, 0xcd, 0x16, 0x08, 0x2d, 0xc2, 0x72, 0x36, 0xe0, 0x78, 0xce, 0x24, 0xdb, 0x73,
+	0xa3, 0xa1, 0x5f, 0xde, 0x89, 0xb6, 0x22, 0x93, 0x6b, 0x6f, 0x1e, 0x8f, 0x3c, 0x70, 0x32, 0xf2,
+	0xc0, 0x8f, 0x91, 0x07, 0xde, 0x9e, 0x7a, 0xa5, 0x93, 0x53, 0xaf, 0xf4, 0xed, 0xd4, 0x2b, 0xbd,
+	0x5a, 0xfd, 0xdb, 0xb5, 0xd8, 0xfd, 0x76, 0xab, 0xf6, 0xaf, 0x74, 0xef, 0xd7, 0x00, 0x78, 0x53,
+	0xf0, 0x2c, 0x1d, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -803,6 +813,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Symbol) > 0 {
 		i -= len(m.Symbol)
 		copy(dAtA[i:], m.Symbol)
@@ -1007,6 +1024,10 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBank(uint64(l))
 	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
 	return n
 }
 
@@ -1894,6 +1915,38 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
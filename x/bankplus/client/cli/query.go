@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	bankcli "github.com/Finschia/finschia-sdk/x/bank/client/cli"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// GetQueryCmd returns the parent command for all x/bank CLI query commands,
+// including the ones of x/bankplus.
+func GetQueryCmd() *cobra.Command {
+	cmd := bankcli.GetQueryCmd()
+
+	cmd.AddCommand(
+		NewQueryCmdInactiveAddresses(),
+		NewQueryCmdDenomRestriction(),
+		NewQueryCmdDenomRestrictions(),
+	)
+
+	return cmd
+}
+
+func NewQueryCmdInactiveAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inactive-addresses",
+		Args:  cobra.NoArgs,
+		Short: "Query all the addresses which are not allowed to receive funds",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InactiveAddresses(cmd.Context(), &types.QueryInactiveAddressesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "inactive-addresses")
+	return cmd
+}
+
+func NewQueryCmdDenomRestriction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-restriction [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the restriction on the transfers of a denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomRestriction(cmd.Context(), &types.QueryDenomRestrictionRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdDenomRestrictions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-restrictions",
+		Args:  cobra.NoArgs,
+		Short: "Query all the restrictions on the transfers of the denoms",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomRestrictions(cmd.Context(), &types.QueryDenomRestrictionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom-restrictions")
+	return cmd
+}
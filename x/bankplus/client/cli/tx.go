@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/client/tx"
+	bankcli "github.com/Finschia/finschia-sdk/x/bank/client/cli"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+const (
+	FlagFrozenAddresses      = "frozen-addresses"
+	FlagAllowlistEnabled     = "allowlist-enabled"
+	FlagAllowlistedAddresses = "allowlisted-addresses"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/bank transaction commands,
+// including the ones of x/bankplus.
+func NewTxCmd() *cobra.Command {
+	txCmd := bankcli.NewTxCmd()
+
+	txCmd.AddCommand(
+		NewTxCmdDeactivate(),
+		NewTxCmdActivate(),
+		NewTxCmdSetDenomRestriction(),
+	)
+
+	return txCmd
+}
+
+func validateGenerateOnly(cmd *cobra.Command) error {
+	generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
+	if err != nil {
+		return err
+	}
+	if !generateOnly {
+		return fmt.Errorf("you must use it with the flag --%s", flags.FlagGenerateOnly)
+	}
+	return nil
+}
+
+func NewTxCmdDeactivate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate [authority] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Forbid an address from receiving funds",
+		Long: `Forbid an address from receiving funds
+
+Parameters:
+    authority: the address of the authority
+    address: the address to deactivate
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeactivate{
+				Authority: args[0],
+				Address:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdActivate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activate [authority] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Allow an inactive address to receive funds again",
+		Long: `Allow an inactive address to receive funds again
+
+Parameters:
+    authority: the address of the authority
+    address: the address to activate
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgActivate{
+				Authority: args[0],
+				Address:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdSetDenomRestriction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-restriction [authority] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the restriction on the transfers of a denom",
+		Long: `Set the restriction on the transfers of a denom
+
+Parameters:
+    authority: the address of the authority
+    denom: the denom to restrict
+
+The restriction replaces the existing one of the denom. The addresses given by
+--frozen-addresses can neither send nor receive the denom. If --allowlist-enabled
+is set, the denom can only move between the addresses given by --allowlisted-addresses.
+A restriction without any of the flags removes the existing one.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			frozenAddrs, err := cmd.Flags().GetStringSlice(FlagFrozenAddresses)
+			if err != nil {
+				return err
+			}
+
+			allowlistEnabled, err := cmd.Flags().GetBool(FlagAllowlistEnabled)
+			if err != nil {
+				return err
+			}
+
+			allowlistedAddrs, err := cmd.Flags().GetStringSlice(FlagAllowlistedAddresses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetDenomRestriction{
+				Authority: args[0],
+				Restriction: types.DenomRestriction{
+					Denom:                args[1],
+					FrozenAddresses:      frozenAddrs,
+					AllowlistEnabled:     allowlistEnabled,
+					AllowlistedAddresses: allowlistedAddrs,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagFrozenAddresses, nil, "addresses which can neither send nor receive the denom")
+	cmd.Flags().Bool(FlagAllowlistEnabled, false, "restrict the transfers to the ones between the allowlisted addresses")
+	cmd.Flags().StringSlice(FlagAllowlistedAddresses, nil, "addresses which can send and receive the denom, if the allowlist is enabled")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
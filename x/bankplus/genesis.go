@@ -0,0 +1,96 @@
+package bankplus
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+var (
+	_ module.AppModuleGenesis = GenesisAppModule{}
+	_ module.AppModuleBasic   = GenesisAppModuleBasic{}
+)
+
+// GenesisAppModuleBasic defines the basic application module which carries the
+// bankplus genesis state. The state is kept apart from the bank genesis state,
+// so the bank genesis remains the one of cosmos-sdk.
+type GenesisAppModuleBasic struct{}
+
+// Name returns the bankplus module's name.
+func (GenesisAppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec does nothing, as AppModuleBasic registers the bankplus types.
+func (GenesisAppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterInterfaces does nothing, as AppModuleBasic registers the bankplus types.
+func (GenesisAppModuleBasic) RegisterInterfaces(_ codectypes.InterfaceRegistry) {}
+
+// DefaultGenesis returns default genesis state as raw bytes for the bankplus
+// module.
+func (GenesisAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the bankplus module.
+func (GenesisAppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
+// RegisterGRPCGatewayRoutes does nothing, as AppModuleBasic registers the bankplus routes.
+func (GenesisAppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
+// GetTxCmd returns no root tx command, as AppModuleBasic provides the bankplus commands.
+func (GenesisAppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+
+// GetQueryCmd returns no root query command, as AppModuleBasic provides the bankplus commands.
+func (GenesisAppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
+
+// GenesisAppModule implements an application module which initializes and
+// exports the bankplus genesis state.
+type GenesisAppModule struct {
+	GenesisAppModuleBasic
+
+	keeper keeper.BaseKeeper
+}
+
+// NewGenesisAppModule creates a new GenesisAppModule object
+func NewGenesisAppModule(keeper keeper.BaseKeeper) module.AppModule {
+	return module.NewGenesisOnlyAppModule(GenesisAppModule{
+		GenesisAppModuleBasic: GenesisAppModuleBasic{},
+		keeper:                keeper,
+	})
+}
+
+// InitGenesis performs genesis initialization for the bankplus module. It returns
+// no validator updates.
+func (am GenesisAppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	am.keeper.InitBankPlusGenesis(ctx, &genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the bankplus
+// module.
+func (am GenesisAppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := am.keeper.ExportBankPlusGenesis(ctx)
+	return cdc.MustMarshalJSON(gs)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// InitBankPlusGenesis initializes the inactive addresses and the denom restrictions
+// from a given bankplus genesis state.
+func (keeper BaseKeeper) InitBankPlusGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, addr := range genState.InactiveAddresses {
+		keeper.AddToInactiveAddr(ctx, sdk.MustAccAddressFromBech32(addr))
+	}
+
+	for _, restriction := range genState.DenomRestrictions {
+		keeper.SetDenomRestriction(ctx, restriction)
+	}
+}
+
+// ExportBankPlusGenesis returns the bankplus genesis state, which consists of
+// the inactive addresses and the denom restrictions.
+func (keeper BaseKeeper) ExportBankPlusGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := types.DefaultGenesisState()
+
+	keeper.iterateInactiveAddrs(ctx, func(address sdk.AccAddress) (stop bool) {
+		genState.InactiveAddresses = append(genState.InactiveAddresses, address.String())
+		return false
+	})
+
+	keeper.iterateDenomRestrictions(ctx, func(restriction types.DenomRestriction) (stop bool) {
+		genState.DenomRestrictions = append(genState.DenomRestrictions, restriction)
+		return false
+	})
+
+	return genState
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+type queryServer struct {
+	keeper BaseKeeper
+}
+
+// NewQueryServer returns an implementation of the bankplus QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper BaseKeeper) types.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.QueryServer = queryServer{}
+
+func (s queryServer) InactiveAddresses(c context.Context, req *types.QueryInactiveAddressesRequest) (*types.QueryInactiveAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	addrStore := prefix.NewStore(store, inactiveAddrsKeyPrefix)
+
+	var addresses []string
+	pageRes, err := query.Paginate(addrStore, req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInactiveAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (s queryServer) DenomRestriction(c context.Context, req *types.QueryDenomRestrictionRequest) (*types.QueryDenomRestrictionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	restriction := s.keeper.GetDenomRestriction(ctx, req.Denom)
+
+	return &types.QueryDenomRestrictionResponse{Restriction: restriction}, nil
+}
+
+func (s queryServer) DenomRestrictions(c context.Context, req *types.QueryDenomRestrictionsRequest) (*types.QueryDenomRestrictionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	restrictionStore := prefix.NewStore(store, denomRestrictionKeyPrefix)
+
+	var restrictions []types.DenomRestriction
+	pageRes, err := query.Paginate(restrictionStore, req.Pagination, func(key []byte, _ []byte) error {
+		restrictions = append(restrictions, s.keeper.GetDenomRestriction(ctx, string(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomRestrictionsResponse{Restrictions: restrictions, Pagination: pageRes}, nil
+}
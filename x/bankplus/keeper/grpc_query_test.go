@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestQueryInactiveAddresses(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+	queryServer := NewQueryServer(bankKeeper)
+
+	_, err := queryServer.InactiveAddresses(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	res, err := queryServer.InactiveAddresses(sdk.WrapSDKContext(ctx), &types.QueryInactiveAddressesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Addresses)
+
+	addrs := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		addr := genAddress()
+		bankKeeper.AddToInactiveAddr(ctx, addr)
+		addrs[addr.String()] = true
+	}
+
+	res, err = queryServer.InactiveAddresses(sdk.WrapSDKContext(ctx), &types.QueryInactiveAddressesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Addresses, 2)
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	next, err := queryServer.InactiveAddresses(sdk.WrapSDKContext(ctx), &types.QueryInactiveAddressesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, next.Addresses, 1)
+
+	for _, addr := range append(res.Addresses, next.Addresses...) {
+		require.True(t, addrs[addr])
+	}
+}
+
+func TestQueryDenomRestrictions(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+	queryServer := NewQueryServer(bankKeeper)
+
+	restrictions := []types.DenomRestriction{
+		{Denom: "bar", AllowlistEnabled: true, AllowlistedAddresses: []string{genAddress().String()}},
+		{Denom: "foo", FrozenAddresses: []string{genAddress().String()}},
+	}
+	for _, restriction := range restrictions {
+		bankKeeper.SetDenomRestriction(ctx, restriction)
+	}
+
+	_, err := queryServer.DenomRestriction(sdk.WrapSDKContext(ctx), &types.QueryDenomRestrictionRequest{})
+	require.Error(t, err)
+
+	res, err := queryServer.DenomRestriction(sdk.WrapSDKContext(ctx), &types.QueryDenomRestrictionRequest{Denom: "foo"})
+	require.NoError(t, err)
+	require.Equal(t, restrictions[1], res.Restriction)
+
+	res, err = queryServer.DenomRestriction(sdk.WrapSDKContext(ctx), &types.QueryDenomRestrictionRequest{Denom: "baz"})
+	require.NoError(t, err)
+	require.True(t, res.Restriction.IsEmpty())
+
+	resAll, err := queryServer.DenomRestrictions(sdk.WrapSDKContext(ctx), &types.QueryDenomRestrictionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, restrictions, resAll.Restrictions)
+
+	resAll, err = queryServer.DenomRestrictions(sdk.WrapSDKContext(ctx), &types.QueryDenomRestrictionsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, restrictions[:1], resAll.Restrictions)
+	require.NotNil(t, resAll.Pagination.NextKey)
+}
@@ -5,8 +5,7 @@ import (
 	"github.com/Finschia/finschia-sdk/x/bankplus/types"
 )
 
-// Keys for bankplus store but this prefix must not be overlap with bank key prefix.
-var inactiveAddrsKeyPrefix = []byte{0xa0}
+var inactiveAddrsKeyPrefix = types.InactiveAddrsKeyPrefix
 
 // inactiveAddrKey key of a specific inactiveAddr from store
 func inactiveAddrKey(addr sdk.AccAddress) []byte {
@@ -34,18 +33,17 @@ func (keeper BaseKeeper) deleteFromInactiveAddr(ctx sdk.Context, address sdk.Acc
 	store.Delete(inactiveAddrKey(address))
 }
 
-// loadAllInactiveAddrs loads all blocked address and set to `inactiveAddr`.
-// This function is executed when the app is initiated and save all inactive address in caches
-// in order to prevent to query to store in every time to send
-func (keeper BaseKeeper) loadAllInactiveAddrs(ctx sdk.Context) {
+// iterateInactiveAddrs iterates over all the inactive addresses in the store.
+func (keeper BaseKeeper) iterateInactiveAddrs(ctx sdk.Context, fn func(address sdk.AccAddress) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, inactiveAddrsKeyPrefix)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var bAddr types.InactiveAddr
-		keeper.cdc.MustUnmarshal(iterator.Value(), &bAddr)
+		address := sdk.AccAddress(iterator.Key()[len(inactiveAddrsKeyPrefix):])
 
-		keeper.inactiveAddrs[bAddr.Address] = true
+		if fn(address) {
+			break
+		}
 	}
 }
@@ -16,6 +16,7 @@ import (
 	accountkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	accounttypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
 	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 )
 
@@ -28,18 +29,19 @@ func genAddress() sdk.AccAddress {
 	return b
 }
 
+var accountStoreKey = sdk.NewKVStoreKey(accounttypes.StoreKey)
+
 func setupKeeper(storeKey *sdk.KVStoreKey) BaseKeeper {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	amino := codec.NewLegacyAmino()
 	testTransientStoreKey := sdk.NewTransientStoreKey("test")
 
-	accountStoreKey := sdk.NewKVStoreKey(accounttypes.StoreKey)
 	accountSubspace := paramtypes.NewSubspace(cdc, amino, accountStoreKey, testTransientStoreKey, accounttypes.ModuleName)
 	accountKeeper := accountkeeper.NewAccountKeeper(cdc, accountStoreKey, accountSubspace, accounttypes.ProtoBaseAccount, nil)
 
 	bankSubspace := paramtypes.NewSubspace(cdc, amino, storeKey, testTransientStoreKey, banktypes.StoreKey)
-	return NewBaseKeeper(cdc, storeKey, accountKeeper, bankSubspace, nil, false)
+	return NewBaseKeeper(cdc, storeKey, accountKeeper, bankSubspace, nil, false, types.DefaultAuthority().String())
 }
 
 func setupContext(t *testing.T, storeKey *sdk.KVStoreKey) sdk.Context {
@@ -47,6 +49,7 @@ func setupContext(t *testing.T, storeKey *sdk.KVStoreKey) sdk.Context {
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(accountStoreKey, sdk.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	return sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -59,8 +62,6 @@ func TestInactiveAddr(t *testing.T) {
 
 	addr := genAddress()
 
-	require.Equal(t, 0, len(bankKeeper.inactiveAddrs))
-
 	bankKeeper.addToInactiveAddr(ctx, addr)
 	require.True(t, bankKeeper.isStoredInactiveAddr(ctx, addr))
 
@@ -76,11 +77,4 @@ func TestInactiveAddr(t *testing.T) {
 
 	// expect no error
 	bankKeeper.deleteFromInactiveAddr(ctx, addr2)
-
-	// test loadAllInactiveAddrs
-	bankKeeper.addToInactiveAddr(ctx, addr)
-	bankKeeper.addToInactiveAddr(ctx, addr2)
-	require.Equal(t, 0, len(bankKeeper.inactiveAddrs))
-	bankKeeper.loadAllInactiveAddrs(ctx)
-	require.Equal(t, 2, len(bankKeeper.inactiveAddrs))
 }
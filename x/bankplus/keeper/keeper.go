@@ -16,9 +16,13 @@ type Keeper interface {
 
 	AddToInactiveAddr(ctx sdk.Context, address sdk.AccAddress)
 	DeleteFromInactiveAddr(ctx sdk.Context, address sdk.AccAddress)
-	IsInactiveAddr(address sdk.AccAddress) bool
+	IsInactiveAddr(ctx sdk.Context, address sdk.AccAddress) bool
 
+	// Deprecated: the inactive addresses are read from the store directly,
+	// so there is nothing to initialize.
 	InitializeBankPlus(ctx sdk.Context)
+
+	GetAuthority() string
 }
 
 type BaseKeeper struct {
@@ -27,28 +31,39 @@ type BaseKeeper struct {
 	ak             types.AccountKeeper
 	cdc            codec.Codec
 	storeKey       sdk.StoreKey
-	inactiveAddrs  map[string]bool
 	deactMultiSend bool
+	authority      string
 }
 
 func NewBaseKeeper(
 	cdc codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace,
-	blockedAddr map[string]bool, deactMultiSend bool,
+	blockedAddr map[string]bool, deactMultiSend bool, authority string,
 ) BaseKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
 	return BaseKeeper{
 		BaseKeeper:     bankkeeper.NewBaseKeeper(cdc, storeKey, ak, paramSpace, blockedAddr),
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
-		inactiveAddrs:  map[string]bool{},
 		deactMultiSend: deactMultiSend,
+		authority:      authority,
 	}
 }
 
-func (keeper BaseKeeper) InitializeBankPlus(ctx sdk.Context) {
-	keeper.loadAllInactiveAddrs(ctx)
+// GetAuthority returns the address which is allowed to manage the inactive addresses.
+func (keeper BaseKeeper) GetAuthority() string {
+	return keeper.authority
 }
 
+// InitializeBankPlus does nothing. It remains for the compatibility of the apps.
+//
+// Deprecated: the inactive addresses are read from the store directly,
+// so there is nothing to initialize.
+func (keeper BaseKeeper) InitializeBankPlus(_ sdk.Context) {}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // It will panic if the module account does not exist.
 func (keeper BaseKeeper) SendCoinsFromModuleToAccount(
@@ -97,43 +112,42 @@ func (keeper BaseKeeper) SendCoinsFromAccountToModule(
 	return keeper.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
-func (keeper BaseKeeper) isInactiveAddr(addr sdk.AccAddress) bool {
-	return keeper.inactiveAddrs[addr.String()]
-}
-
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // This is wrapped bank the `SendKeeper` interface of `bank` module,
 // and checks if `toAddr` is a inactiveAddr managed by the module.
+// It also checks the restrictions on the denoms of `amt`, for both of the addresses.
 func (keeper BaseKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	// if toAddr is smart contract, check the status of contract.
-	if keeper.isInactiveAddr(toAddr) {
+	if keeper.isStoredInactiveAddr(ctx, toAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
 	}
 
+	for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+		if err := keeper.validateDenomRestrictions(ctx, addr, amt); err != nil {
+			return err
+		}
+	}
+
 	return keeper.BaseSendKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
 // AddToInactiveAddr adds the address to `inactiveAddr`.
 func (keeper BaseKeeper) AddToInactiveAddr(ctx sdk.Context, address sdk.AccAddress) {
-	if !keeper.inactiveAddrs[address.String()] {
-		keeper.inactiveAddrs[address.String()] = true
-
+	if !keeper.isStoredInactiveAddr(ctx, address) {
 		keeper.addToInactiveAddr(ctx, address)
 	}
 }
 
 // DeleteFromInactiveAddr removes the address from `inactiveAddr`.
 func (keeper BaseKeeper) DeleteFromInactiveAddr(ctx sdk.Context, address sdk.AccAddress) {
-	if keeper.inactiveAddrs[address.String()] {
-		delete(keeper.inactiveAddrs, address.String())
-
+	if keeper.isStoredInactiveAddr(ctx, address) {
 		keeper.deleteFromInactiveAddr(ctx, address)
 	}
 }
 
 // IsInactiveAddr returns if the address is added in inactiveAddr.
-func (keeper BaseKeeper) IsInactiveAddr(address sdk.AccAddress) bool {
-	return keeper.inactiveAddrs[address.String()]
+func (keeper BaseKeeper) IsInactiveAddr(ctx sdk.Context, address sdk.AccAddress) bool {
+	return keeper.isStoredInactiveAddr(ctx, address)
 }
 
 func (keeper BaseKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
@@ -142,10 +156,38 @@ func (keeper BaseKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	}
 
 	for _, out := range outputs {
-		if keeper.inactiveAddrs[out.Address] {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		if keeper.isStoredInactiveAddr(ctx, addr) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 		}
+
+		if err := keeper.validateDenomRestrictions(ctx, addr, out.Coins); err != nil {
+			return err
+		}
+	}
+
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.validateDenomRestrictions(ctx, addr, in.Coins); err != nil {
+			return err
+		}
 	}
 
 	return keeper.BaseSendKeeper.InputOutputCoins(ctx, inputs, outputs)
 }
+
+func (keeper BaseKeeper) validateAuthority(authority string) error {
+	if authority != keeper.authority {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", keeper.authority, authority)
+	}
+
+	return nil
+}
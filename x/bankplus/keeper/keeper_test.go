@@ -13,6 +13,8 @@ import (
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/bank/types"
 	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+	bankplustypes "github.com/Finschia/finschia-sdk/x/bankplus/types"
+	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 )
 
@@ -63,7 +65,7 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	)
 	keeper := bankpluskeeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false, bankplustypes.DefaultAuthority().String(),
 	)
 
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
@@ -134,7 +136,7 @@ func (suite *IntegrationTestSuite) TestInactiveAddrOfSendCoins() {
 
 	keeper := bankpluskeeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false, bankplustypes.DefaultAuthority().String(),
 	)
 
 	// set initial balances
@@ -149,11 +151,11 @@ func (suite *IntegrationTestSuite) TestInactiveAddrOfSendCoins() {
 	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
 	suite.Require().Equal(initCoins, keeper.GetAllBalances(ctx, holderAcc.GetAddress()))
 
-	suite.Require().False(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+	suite.Require().False(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
 
 	// add blocked address
 	keeper.AddToInactiveAddr(ctx, blockedAcc.GetAddress())
-	suite.Require().True(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+	suite.Require().True(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
 
 	err := keeper.SendCoins(ctx, holderAcc.GetAddress(), blockedAcc.GetAddress(), initCoins)
 	suite.Require().Contains(err.Error(), "is not allowed to receive funds")
@@ -161,13 +163,13 @@ func (suite *IntegrationTestSuite) TestInactiveAddrOfSendCoins() {
 
 	// delete blocked address
 	keeper.DeleteFromInactiveAddr(ctx, blockedAcc.GetAddress())
-	suite.Require().False(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+	suite.Require().False(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
 
 	suite.Require().NoError(keeper.SendCoins(ctx, holderAcc.GetAddress(), blockedAcc.GetAddress(), initCoins))
 	suite.Require().Equal(sdk.NewCoins().String(), keeper.GetAllBalances(ctx, holderAcc.GetAddress()).String())
 }
 
-func (suite *IntegrationTestSuite) TestInitializeBankPlus() {
+func (suite *IntegrationTestSuite) TestInactiveAddrFromStore() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
 	appCodec := app.AppCodec()
@@ -186,21 +188,31 @@ func (suite *IntegrationTestSuite) TestInitializeBankPlus() {
 	{
 		keeper := bankpluskeeper.NewBaseKeeper(
 			appCodec, app.GetKey(types.StoreKey), authKeeper,
-			app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+			app.GetSubspace(types.ModuleName), make(map[string]bool), false, bankplustypes.DefaultAuthority().String(),
 		)
 
+		// the address added in a discarded context must not be inactive
+		cacheCtx, _ := ctx.CacheContext()
+		keeper.AddToInactiveAddr(cacheCtx, blockedAcc.GetAddress())
+		suite.Require().True(keeper.IsInactiveAddr(cacheCtx, blockedAcc.GetAddress()))
+		suite.Require().False(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
+
 		// add blocked address
 		keeper.AddToInactiveAddr(ctx, blockedAcc.GetAddress())
-		suite.Require().True(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+		suite.Require().True(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
 	}
 
 	{
 		keeper := bankpluskeeper.NewBaseKeeper(
 			appCodec, app.GetKey(types.StoreKey), authKeeper,
-			app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+			app.GetSubspace(types.ModuleName), make(map[string]bool), false, bankplustypes.DefaultAuthority().String(),
 		)
-		keeper.InitializeBankPlus(ctx)
-		suite.Require().True(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+		suite.Require().True(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
+
+		// the check consumes gas like any other read of the store
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		keeper.IsInactiveAddr(gasCtx, blockedAcc.GetAddress())
+		suite.Require().Positive(gasCtx.GasMeter().GasConsumed())
 	}
 }
 
@@ -223,7 +235,7 @@ func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToAccount_Blacklist()
 	)
 	keeper := bankpluskeeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), map[string]bool{addr1.String(): true}, false)
+		app.GetSubspace(types.ModuleName), map[string]bool{addr1.String(): true}, false, bankplustypes.DefaultAuthority().String())
 
 	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
 	suite.Require().Error(keeper.SendCoinsFromModuleToAccount(
@@ -248,7 +260,7 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	)
 	keeper := bankpluskeeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false, bankplustypes.DefaultAuthority().String(),
 	)
 
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
@@ -276,7 +288,7 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	targetKeeper := func(isDeact bool) bankpluskeeper.BaseKeeper {
 		return bankpluskeeper.NewBaseKeeper(
 			appCodec, app.GetKey(types.StoreKey), authKeeper,
-			app.GetSubspace(types.ModuleName), make(map[string]bool), isDeact,
+			app.GetSubspace(types.ModuleName), make(map[string]bool), isDeact, bankplustypes.DefaultAuthority().String(),
 		)
 	}
 	tcs := map[string]struct {
@@ -308,3 +320,115 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestInactiveAddrGenesis() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	keeper := app.BankKeeper.(bankpluskeeper.BaseKeeper)
+
+	genState := keeper.ExportBankPlusGenesis(ctx)
+	suite.Require().Empty(genState.InactiveAddresses)
+
+	genState.InactiveAddresses = []string{blockedAcc.GetAddress().String()}
+	keeper.InitBankPlusGenesis(ctx, genState)
+	suite.Require().True(keeper.IsInactiveAddr(ctx, blockedAcc.GetAddress()))
+
+	exported := keeper.ExportBankPlusGenesis(ctx)
+	suite.Require().Equal(genState.InactiveAddresses, exported.InactiveAddresses)
+}
+
+func (suite *IntegrationTestSuite) TestDenomRestrictionOfInputOutputCoins() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	keeper := app.BankKeeper.(bankpluskeeper.BaseKeeper)
+
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, from, initCoins))
+
+	input := []types.Input{types.NewInput(from, initCoins)}
+	output := []types.Output{types.NewOutput(to, initCoins)}
+
+	tcs := map[string]struct {
+		restriction bankplustypes.DenomRestriction
+		err         error
+	}{
+		"frozen sender": {
+			restriction: bankplustypes.DenomRestriction{Denom: sdk.DefaultBondDenom, FrozenAddresses: []string{from.String()}},
+			err:         sdkerrors.ErrUnauthorized,
+		},
+		"frozen recipient": {
+			restriction: bankplustypes.DenomRestriction{Denom: sdk.DefaultBondDenom, FrozenAddresses: []string{to.String()}},
+			err:         sdkerrors.ErrUnauthorized,
+		},
+		"recipient not allowlisted": {
+			restriction: bankplustypes.DenomRestriction{Denom: sdk.DefaultBondDenom, AllowlistEnabled: true, AllowlistedAddresses: []string{from.String()}},
+			err:         sdkerrors.ErrUnauthorized,
+		},
+		"allowlisted": {
+			restriction: bankplustypes.DenomRestriction{Denom: sdk.DefaultBondDenom, AllowlistEnabled: true, AllowlistedAddresses: []string{from.String(), to.String()}},
+		},
+	}
+
+	for name, tc := range tcs {
+		suite.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+			keeper.SetDenomRestriction(ctx, tc.restriction)
+
+			err := keeper.InputOutputCoins(ctx, input, output)
+			suite.Require().ErrorIs(err, tc.err)
+			if tc.err == nil {
+				suite.Require().Equal(initCoins, keeper.GetAllBalances(ctx, to))
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestDenomRestrictionOfModuleAccounts() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	keeper := app.BankKeeper.(bankpluskeeper.BaseKeeper)
+
+	allowlisted := sdk.AccAddress("allowlisted_________")
+	stranger := sdk.AccAddress("stranger____________")
+	keeper.SetDenomRestriction(ctx, bankplustypes.DenomRestriction{
+		Denom:                sdk.DefaultBondDenom,
+		AllowlistEnabled:     true,
+		AllowlistedAddresses: []string{allowlisted.String()},
+	})
+
+	// the module accounts are not listed, but they are not restricted
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, allowlisted, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromAccountToModule(ctx, allowlisted, authtypes.FeeCollectorName, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, govtypes.ModuleName, initCoins))
+	suite.Require().NoError(keeper.BurnCoins(ctx, govtypes.ModuleName, initCoins))
+
+	// the users are still restricted
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	err := keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, stranger, initCoins)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorContains(err, stranger.String())
+}
+
+func (suite *IntegrationTestSuite) TestDenomRestrictionGenesis() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	keeper := app.BankKeeper.(bankpluskeeper.BaseKeeper)
+
+	genState := keeper.ExportBankPlusGenesis(ctx)
+	suite.Require().Empty(genState.DenomRestrictions)
+
+	genState.DenomRestrictions = []bankplustypes.DenomRestriction{
+		{
+			Denom:           sdk.DefaultBondDenom,
+			FrozenAddresses: []string{blockedAcc.GetAddress().String()},
+		},
+	}
+	keeper.InitBankPlusGenesis(ctx, genState)
+	suite.Require().Equal(genState.DenomRestrictions[0], keeper.GetDenomRestriction(ctx, sdk.DefaultBondDenom))
+
+	exported := keeper.ExportBankPlusGenesis(ctx)
+	suite.Require().Equal(genState.DenomRestrictions, exported.DenomRestrictions)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+type msgServer struct {
+	keeper BaseKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the bankplus MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper BaseKeeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (s msgServer) Deactivate(c context.Context, req *types.MsgDeactivate) (*types.MsgDeactivateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(req.Address)
+	if s.keeper.isStoredInactiveAddr(ctx, addr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is already inactive", req.Address)
+	}
+
+	if s.keeper.BlockedAddr(addr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is a blocked address", req.Address)
+	}
+
+	s.keeper.AddToInactiveAddr(ctx, addr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeactivate{
+		Address: req.Address,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgDeactivateResponse{}, nil
+}
+
+func (s msgServer) Activate(c context.Context, req *types.MsgActivate) (*types.MsgActivateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(req.Address)
+	if !s.keeper.isStoredInactiveAddr(ctx, addr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not inactive", req.Address)
+	}
+
+	s.keeper.DeleteFromInactiveAddr(ctx, addr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventActivate{
+		Address: req.Address,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgActivateResponse{}, nil
+}
+
+func (s msgServer) SetDenomRestriction(c context.Context, req *types.MsgSetDenomRestriction) (*types.MsgSetDenomRestrictionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	s.keeper.SetDenomRestriction(ctx, req.Restriction)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetDenomRestriction{
+		Restriction: req.Restriction,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgSetDenomRestrictionResponse{}, nil
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestMsgDeactivate(t *testing.T) {
+	authority := types.DefaultAuthority()
+	inactive := genAddress()
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		address   sdk.AccAddress
+		err       error
+	}{
+		"valid request": {
+			authority: authority,
+			address:   genAddress(),
+		},
+		"not authorized": {
+			authority: genAddress(),
+			address:   genAddress(),
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		"already inactive": {
+			authority: authority,
+			address:   inactive,
+			err:       sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+			bankKeeper := setupKeeper(storeKey)
+			ctx := setupContext(t, storeKey)
+			bankKeeper.AddToInactiveAddr(ctx, inactive)
+
+			req := &types.MsgDeactivate{
+				Authority: tc.authority.String(),
+				Address:   tc.address.String(),
+			}
+			res, err := NewMsgServerImpl(bankKeeper).Deactivate(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.NotNil(t, res)
+
+			require.True(t, bankKeeper.isStoredInactiveAddr(ctx, tc.address))
+			require.True(t, bankKeeper.IsInactiveAddr(ctx, tc.address))
+			require.Error(t, bankKeeper.SendCoins(ctx, genAddress(), tc.address, sdk.NewCoins()))
+		})
+	}
+}
+
+func TestMsgActivate(t *testing.T) {
+	authority := types.DefaultAuthority()
+	inactive := genAddress()
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		address   sdk.AccAddress
+		err       error
+	}{
+		"valid request": {
+			authority: authority,
+			address:   inactive,
+		},
+		"not authorized": {
+			authority: genAddress(),
+			address:   inactive,
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		"not inactive": {
+			authority: authority,
+			address:   genAddress(),
+			err:       sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+			bankKeeper := setupKeeper(storeKey)
+			ctx := setupContext(t, storeKey)
+			bankKeeper.AddToInactiveAddr(ctx, inactive)
+
+			req := &types.MsgActivate{
+				Authority: tc.authority.String(),
+				Address:   tc.address.String(),
+			}
+			res, err := NewMsgServerImpl(bankKeeper).Activate(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.NotNil(t, res)
+
+			require.False(t, bankKeeper.isStoredInactiveAddr(ctx, tc.address))
+			require.False(t, bankKeeper.IsInactiveAddr(ctx, tc.address))
+		})
+	}
+}
+
+func TestMsgSetDenomRestriction(t *testing.T) {
+	authority := types.DefaultAuthority()
+	restriction := types.DenomRestriction{
+		Denom:           "stake",
+		FrozenAddresses: []string{genAddress().String()},
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		err       error
+	}{
+		"valid request": {
+			authority: authority,
+		},
+		"not authorized": {
+			authority: genAddress(),
+			err:       sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+			bankKeeper := setupKeeper(storeKey)
+			ctx := setupContext(t, storeKey)
+
+			req := &types.MsgSetDenomRestriction{
+				Authority:   tc.authority.String(),
+				Restriction: restriction,
+			}
+			res, err := NewMsgServerImpl(bankKeeper).SetDenomRestriction(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				require.True(t, bankKeeper.GetDenomRestriction(ctx, restriction.Denom).IsEmpty())
+				return
+			}
+			require.NotNil(t, res)
+
+			require.Equal(t, restriction, bankKeeper.GetDenomRestriction(ctx, restriction.Denom))
+		})
+	}
+}
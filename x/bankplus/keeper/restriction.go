@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/address"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+var (
+	denomRestrictionKeyPrefix = types.DenomRestrictionKeyPrefix
+	frozenAddrKeyPrefix       = types.FrozenAddrKeyPrefix
+	allowlistedAddrKeyPrefix  = types.AllowlistedAddrKeyPrefix
+)
+
+// denomRestrictionKey key of the restriction on a specific denom
+func denomRestrictionKey(denom string) []byte {
+	return append(denomRestrictionKeyPrefix, denom...)
+}
+
+// restrictionAddrKeyPrefix key prefix of the addresses of a specific denom restriction
+func restrictionAddrKeyPrefix(keyPrefix []byte, denom string) []byte {
+	key := make([]byte, len(keyPrefix)+1+len(denom))
+	copy(key, keyPrefix)
+	key[len(keyPrefix)] = byte(len(denom))
+	copy(key[len(keyPrefix)+1:], denom)
+	return key
+}
+
+// restrictionAddrKey key of an address of a specific denom restriction
+func restrictionAddrKey(keyPrefix []byte, denom string, addr sdk.AccAddress) []byte {
+	return append(restrictionAddrKeyPrefix(keyPrefix, denom), address.MustLengthPrefix(addr)...)
+}
+
+// GetDenomRestriction returns the restriction on the transfers of the denom.
+// The restriction would be empty if the denom is not restricted.
+func (keeper BaseKeeper) GetDenomRestriction(ctx sdk.Context, denom string) types.DenomRestriction {
+	restriction := types.DenomRestriction{Denom: denom}
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(denomRestrictionKey(denom))
+	if bz == nil {
+		return restriction
+	}
+	keeper.cdc.MustUnmarshal(bz, &restriction)
+
+	keeper.iterateRestrictionAddrs(ctx, frozenAddrKeyPrefix, denom, func(addr sdk.AccAddress) (stop bool) {
+		restriction.FrozenAddresses = append(restriction.FrozenAddresses, addr.String())
+		return false
+	})
+	keeper.iterateRestrictionAddrs(ctx, allowlistedAddrKeyPrefix, denom, func(addr sdk.AccAddress) (stop bool) {
+		restriction.AllowlistedAddresses = append(restriction.AllowlistedAddresses, addr.String())
+		return false
+	})
+
+	return restriction
+}
+
+// SetDenomRestriction replaces the restriction on the transfers of the denom.
+// An empty restriction removes the existing one.
+func (keeper BaseKeeper) SetDenomRestriction(ctx sdk.Context, restriction types.DenomRestriction) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	for _, keyPrefix := range [][]byte{frozenAddrKeyPrefix, allowlistedAddrKeyPrefix} {
+		var keys [][]byte
+		keeper.iterateRestrictionAddrs(ctx, keyPrefix, restriction.Denom, func(addr sdk.AccAddress) (stop bool) {
+			keys = append(keys, restrictionAddrKey(keyPrefix, restriction.Denom, addr))
+			return false
+		})
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	if restriction.IsEmpty() {
+		store.Delete(denomRestrictionKey(restriction.Denom))
+		return
+	}
+
+	// the addresses are stored separately, so the header remains small
+	header := types.DenomRestriction{
+		Denom:            restriction.Denom,
+		AllowlistEnabled: restriction.AllowlistEnabled,
+	}
+	store.Set(denomRestrictionKey(restriction.Denom), keeper.cdc.MustMarshal(&header))
+
+	for _, addr := range restriction.FrozenAddresses {
+		store.Set(restrictionAddrKey(frozenAddrKeyPrefix, restriction.Denom, sdk.MustAccAddressFromBech32(addr)), []byte{})
+	}
+	for _, addr := range restriction.AllowlistedAddresses {
+		store.Set(restrictionAddrKey(allowlistedAddrKeyPrefix, restriction.Denom, sdk.MustAccAddressFromBech32(addr)), []byte{})
+	}
+}
+
+// iterateDenomRestrictions iterates over all the denom restrictions.
+func (keeper BaseKeeper) iterateDenomRestrictions(ctx sdk.Context, fn func(restriction types.DenomRestriction) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, denomRestrictionKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(denomRestrictionKeyPrefix):])
+
+		if fn(keeper.GetDenomRestriction(ctx, denom)) {
+			break
+		}
+	}
+}
+
+func (keeper BaseKeeper) iterateRestrictionAddrs(ctx sdk.Context, keyPrefix []byte, denom string, fn func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	addrKeyPrefix := restrictionAddrKeyPrefix(keyPrefix, denom)
+	iterator := sdk.KVStorePrefixIterator(store, addrKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// skip the length prefix of the address
+		addr := sdk.AccAddress(iterator.Key()[len(addrKeyPrefix)+1:])
+
+		if fn(addr) {
+			break
+		}
+	}
+}
+
+// validateDenomRestrictions checks if the address is allowed to send or receive the coins,
+// under the restrictions on their denoms. The module accounts are not subject to the
+// restrictions, so the fees, mints, burns and escrows keep working.
+func (keeper BaseKeeper) validateDenomRestrictions(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	store := ctx.KVStore(keeper.storeKey)
+
+	for _, coin := range amt {
+		bz := store.Get(denomRestrictionKey(coin.Denom))
+		if bz == nil {
+			continue
+		}
+
+		if keeper.isModuleAddr(ctx, addr) {
+			return nil
+		}
+
+		if store.Has(restrictionAddrKey(frozenAddrKeyPrefix, coin.Denom, addr)) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is frozen for %s", addr, coin.Denom)
+		}
+
+		var restriction types.DenomRestriction
+		keeper.cdc.MustUnmarshal(bz, &restriction)
+		if restriction.AllowlistEnabled && !store.Has(restrictionAddrKey(allowlistedAddrKeyPrefix, coin.Denom, addr)) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowlisted for %s", addr, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// isModuleAddr returns whether the address belongs to a module account.
+func (keeper BaseKeeper) isModuleAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if keeper.BlockedAddr(addr) {
+		return true
+	}
+
+	_, ok := keeper.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
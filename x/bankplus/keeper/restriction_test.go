@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestDenomRestriction(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+
+	denom := "stake"
+	frozen := genAddress()
+	allowlisted := genAddress()
+
+	require.True(t, bankKeeper.GetDenomRestriction(ctx, denom).IsEmpty())
+
+	restriction := types.DenomRestriction{
+		Denom:                denom,
+		FrozenAddresses:      []string{frozen.String()},
+		AllowlistEnabled:     true,
+		AllowlistedAddresses: []string{allowlisted.String()},
+	}
+	bankKeeper.SetDenomRestriction(ctx, restriction)
+	require.Equal(t, restriction, bankKeeper.GetDenomRestriction(ctx, denom))
+
+	// replace the restriction
+	restriction = types.DenomRestriction{
+		Denom:           denom,
+		FrozenAddresses: []string{allowlisted.String()},
+	}
+	bankKeeper.SetDenomRestriction(ctx, restriction)
+	require.Equal(t, restriction, bankKeeper.GetDenomRestriction(ctx, denom))
+
+	// remove the restriction
+	bankKeeper.SetDenomRestriction(ctx, types.DenomRestriction{Denom: denom})
+	require.True(t, bankKeeper.GetDenomRestriction(ctx, denom).IsEmpty())
+
+	var restrictions []types.DenomRestriction
+	bankKeeper.iterateDenomRestrictions(ctx, func(restriction types.DenomRestriction) (stop bool) {
+		restrictions = append(restrictions, restriction)
+		return false
+	})
+	require.Empty(t, restrictions)
+}
+
+func TestValidateDenomRestrictions(t *testing.T) {
+	denom := "stake"
+	restricted := sdk.NewCoins(sdk.NewInt64Coin(denom, 1), sdk.NewInt64Coin("free", 1))
+	free := sdk.NewCoins(sdk.NewInt64Coin("free", 1))
+
+	frozen := genAddress()
+	allowlisted := genAddress()
+	stranger := genAddress()
+
+	testCases := map[string]struct {
+		restriction types.DenomRestriction
+		addr        sdk.AccAddress
+		amt         sdk.Coins
+		err         error
+	}{
+		"not restricted": {
+			restriction: types.DenomRestriction{Denom: denom},
+			addr:        frozen,
+			amt:         restricted,
+		},
+		"frozen": {
+			restriction: types.DenomRestriction{Denom: denom, FrozenAddresses: []string{frozen.String()}},
+			addr:        frozen,
+			amt:         restricted,
+			err:         sdkerrors.ErrUnauthorized,
+		},
+		"frozen on another denom": {
+			restriction: types.DenomRestriction{Denom: denom, FrozenAddresses: []string{frozen.String()}},
+			addr:        frozen,
+			amt:         free,
+		},
+		"not frozen": {
+			restriction: types.DenomRestriction{Denom: denom, FrozenAddresses: []string{frozen.String()}},
+			addr:        stranger,
+			amt:         restricted,
+		},
+		"allowlisted": {
+			restriction: types.DenomRestriction{Denom: denom, AllowlistEnabled: true, AllowlistedAddresses: []string{allowlisted.String()}},
+			addr:        allowlisted,
+			amt:         restricted,
+		},
+		"not allowlisted": {
+			restriction: types.DenomRestriction{Denom: denom, AllowlistEnabled: true, AllowlistedAddresses: []string{allowlisted.String()}},
+			addr:        stranger,
+			amt:         restricted,
+			err:         sdkerrors.ErrUnauthorized,
+		},
+		"frozen while allowlisted": {
+			restriction: types.DenomRestriction{
+				Denom:                denom,
+				FrozenAddresses:      []string{allowlisted.String()},
+				AllowlistEnabled:     true,
+				AllowlistedAddresses: []string{allowlisted.String()},
+			},
+			addr: allowlisted,
+			amt:  restricted,
+			err:  sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+			bankKeeper := setupKeeper(storeKey)
+			ctx := setupContext(t, storeKey)
+			bankKeeper.SetDenomRestriction(ctx, tc.restriction)
+
+			err := bankKeeper.validateDenomRestrictions(ctx, tc.addr, tc.amt)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err == nil {
+				return
+			}
+
+			// both of the sender and the recipient are restricted
+			require.ErrorIs(t, bankKeeper.SendCoins(ctx, tc.addr, genAddress(), tc.amt), tc.err)
+			require.ErrorIs(t, bankKeeper.SendCoins(ctx, genAddress(), tc.addr, tc.amt), tc.err)
+		})
+	}
+}
@@ -1,22 +1,66 @@
 package bankplus
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	"github.com/Finschia/finschia-sdk/types/module"
 	accountkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	"github.com/Finschia/finschia-sdk/x/bank"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/client/cli"
 	"github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
 )
 
 var (
+	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
 )
 
+// AppModuleBasic extends the bank AppModuleBasic with the bankplus types and commands.
+type AppModuleBasic struct {
+	bank.AppModuleBasic
+}
+
+// RegisterLegacyAminoCodec registers the bank and bankplus types on the LegacyAmino codec.
+func (b AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	b.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank and bankplus modules.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd returns the root tx command for the bank and bankplus modules.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// GetQueryCmd returns the root query command for the bank and bankplus modules.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// RegisterInterfaces registers interfaces and implementations of the bank and bankplus modules.
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	b.AppModuleBasic.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+}
+
 type AppModule struct {
 	bank.AppModule
 
@@ -34,7 +78,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.bankKeeper))
 	banktypes.RegisterQueryServer(cfg.QueryServer(), am.bankKeeper)
 
-	m := bankkeeper.NewMigrator(am.bankKeeper.(keeper.BaseKeeper).BaseKeeper)
+	bankplusKeeper := am.bankKeeper.(keeper.BaseKeeper)
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(bankplusKeeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(bankplusKeeper))
+
+	m := bankkeeper.NewMigrator(bankplusKeeper.BaseKeeper)
 	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
 	}
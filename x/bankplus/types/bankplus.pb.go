@@ -68,26 +68,109 @@ func (m *InactiveAddr) GetAddress() string {
 	return ""
 }
 
+// DenomRestriction defines the restriction on the transfers of a denom.
+type DenomRestriction struct {
+	// denom is the denom of the coins to restrict.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// frozen_addresses are the addresses which can neither send nor receive the denom.
+	FrozenAddresses []string `protobuf:"bytes,2,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+	// allowlist_enabled restricts the transfers of the denom to the ones between allowlisted_addresses.
+	AllowlistEnabled bool `protobuf:"varint,3,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty" yaml:"allowlist_enabled"`
+	// allowlisted_addresses are the addresses which can send and receive the denom, if allowlist_enabled.
+	AllowlistedAddresses []string `protobuf:"bytes,4,rep,name=allowlisted_addresses,json=allowlistedAddresses,proto3" json:"allowlisted_addresses,omitempty" yaml:"allowlisted_addresses"`
+}
+
+func (m *DenomRestriction) Reset()         { *m = DenomRestriction{} }
+func (m *DenomRestriction) String() string { return proto.CompactTextString(m) }
+func (*DenomRestriction) ProtoMessage()    {}
+func (*DenomRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e8c66834b4419a, []int{1}
+}
+func (m *DenomRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRestriction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRestriction.Merge(m, src)
+}
+func (m *DenomRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRestriction proto.InternalMessageInfo
+
+func (m *DenomRestriction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRestriction) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *DenomRestriction) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+func (m *DenomRestriction) GetAllowlistedAddresses() []string {
+	if m != nil {
+		return m.AllowlistedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InactiveAddr)(nil), "lbm.bankplus.v1.InactiveAddr")
+	proto.RegisterType((*DenomRestriction)(nil), "lbm.bankplus.v1.DenomRestriction")
 }
 
 func init() { proto.RegisterFile("lbm/bankplus/v1/bankplus.proto", fileDescriptor_79e8c66834b4419a) }
 
 var fileDescriptor_79e8c66834b4419a = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x49, 0xca, 0xd5,
-	0x4f, 0x4a, 0xcc, 0xcb, 0x2e, 0xc8, 0x29, 0x2d, 0xd6, 0x2f, 0x33, 0x84, 0xb3, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0x73, 0x92, 0x72, 0xf5, 0xe0, 0x62, 0x65, 0x86, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x39, 0x7d, 0x10, 0x0b, 0xa2, 0x4c, 0x49, 0x8f, 0x8b, 0xc7, 0x33, 0x2f,
-	0x31, 0xb9, 0x24, 0xb3, 0x2c, 0xd5, 0x31, 0x25, 0xa5, 0x48, 0x48, 0x82, 0x8b, 0x3d, 0x31, 0x25,
-	0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0xb5, 0x62, 0x79,
-	0xb1, 0x40, 0x9e, 0xd1, 0xc9, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x0c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xdd, 0x32, 0xf3, 0x8a,
-	0x93, 0x33, 0x32, 0x13, 0xf5, 0xd3, 0xa0, 0x0c, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0x84, 0x7b,
-	0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x6e, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x9e, 0x90, 0xb8, 0x29, 0xcc, 0x00, 0x00, 0x00,
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x4f, 0xc2, 0x30,
+	0x1c, 0xc5, 0x29, 0xe0, 0x0f, 0x1a, 0x13, 0x70, 0xc1, 0xb8, 0x28, 0xe9, 0x96, 0x9d, 0xb8, 0xb8,
+	0x85, 0x78, 0xe3, 0x06, 0x51, 0x12, 0xe2, 0x6d, 0x89, 0x17, 0x2f, 0xa4, 0x5b, 0x0b, 0x34, 0x74,
+	0x2d, 0x59, 0x0b, 0x8a, 0x7f, 0x85, 0xf1, 0x2f, 0xf0, 0xcf, 0xf1, 0xc8, 0xd1, 0x13, 0x31, 0x70,
+	0xf1, 0xcc, 0x5f, 0x60, 0x60, 0x30, 0x17, 0xbc, 0xbd, 0xef, 0xa7, 0xaf, 0x2f, 0x2f, 0x79, 0x10,
+	0xf1, 0x20, 0xf2, 0x02, 0x2c, 0x46, 0x63, 0x3e, 0x51, 0xde, 0xb4, 0x91, 0x6a, 0x77, 0x1c, 0x4b,
+	0x2d, 0x8d, 0x32, 0x0f, 0x22, 0x37, 0x65, 0xd3, 0xc6, 0x55, 0x75, 0x20, 0x07, 0x72, 0xfb, 0xe6,
+	0x6d, 0x54, 0x62, 0x73, 0x5c, 0x78, 0xd6, 0x15, 0x38, 0xd4, 0x6c, 0x4a, 0x5b, 0x84, 0xc4, 0x86,
+	0x09, 0x4f, 0x30, 0x21, 0x31, 0x55, 0xca, 0x04, 0x36, 0xa8, 0x97, 0xfc, 0xfd, 0xd9, 0x2c, 0xfe,
+	0x7c, 0x58, 0xc0, 0x79, 0xcf, 0xc3, 0xca, 0x1d, 0x15, 0x32, 0xf2, 0xa9, 0xd2, 0x31, 0x0b, 0x35,
+	0x93, 0xc2, 0xa8, 0xc2, 0x23, 0xb2, 0x61, 0xbb, 0x2f, 0xc9, 0x61, 0x74, 0x60, 0xa5, 0x1f, 0xcb,
+	0x57, 0x2a, 0x7a, 0xbb, 0x08, 0xaa, 0xcc, 0xbc, 0x5d, 0xa8, 0x97, 0xda, 0xd7, 0xeb, 0x85, 0x75,
+	0x39, 0xc3, 0x11, 0x6f, 0x3a, 0x87, 0x0e, 0xc7, 0x2f, 0x27, 0xa8, 0xb5, 0x27, 0x46, 0x17, 0x9e,
+	0x63, 0xce, 0xe5, 0x33, 0x67, 0x4a, 0xf7, 0xa8, 0xc0, 0x01, 0xa7, 0xc4, 0x2c, 0xd8, 0xa0, 0x7e,
+	0xda, 0xae, 0xad, 0x17, 0x96, 0x99, 0x04, 0xfd, 0xb3, 0x38, 0x7e, 0x25, 0x65, 0xf7, 0x09, 0x32,
+	0x1e, 0xe1, 0x45, 0xca, 0x28, 0xc9, 0xf4, 0x2a, 0x6e, 0x7b, 0xd9, 0xeb, 0x85, 0x55, 0x3b, 0x88,
+	0xcb, 0xda, 0x1c, 0xbf, 0x9a, 0xe1, 0x69, 0xc3, 0xf6, 0xc3, 0xe7, 0x12, 0x81, 0xf9, 0x12, 0x81,
+	0xef, 0x25, 0x02, 0x6f, 0x2b, 0x94, 0x9b, 0xaf, 0x50, 0xee, 0x6b, 0x85, 0x72, 0x4f, 0x8d, 0x01,
+	0xd3, 0xc3, 0x49, 0xe0, 0x86, 0x32, 0xf2, 0x3a, 0x4c, 0xa8, 0x70, 0xc8, 0xb0, 0xd7, 0xdf, 0x89,
+	0x1b, 0x45, 0x46, 0xde, 0xcb, 0xdf, 0x88, 0x7a, 0x36, 0xa6, 0x2a, 0x38, 0xde, 0x0e, 0x73, 0xfb,
+	0x3b, 0x00, 0xf1, 0xed, 0x48, 0xd3, 0xe1, 0x01, 0x00, 0x00,
 }
 
 func (this *InactiveAddr) Equal(that interface{}) bool {
@@ -144,6 +227,64 @@ func (m *InactiveAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowlistedAddresses) > 0 {
+		for iNdEx := len(m.AllowlistedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowlistedAddresses[iNdEx])
+			i = encodeVarintBankplus(dAtA, i, uint64(len(m.AllowlistedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintBankplus(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBankplus(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBankplus(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBankplus(v)
 	base := offset
@@ -168,6 +309,34 @@ func (m *InactiveAddr) Size() (n int) {
 	return n
 }
 
+func (m *DenomRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBankplus(uint64(l))
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovBankplus(uint64(l))
+		}
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	if len(m.AllowlistedAddresses) > 0 {
+		for _, s := range m.AllowlistedAddresses {
+			l = len(s)
+			n += 1 + l + sovBankplus(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBankplus(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -256,6 +425,172 @@ func (m *InactiveAddr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBankplus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBankplus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBankplus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBankplus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBankplus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistedAddresses = append(m.AllowlistedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBankplus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBankplus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBankplus(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
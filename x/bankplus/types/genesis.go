@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// DefaultGenesisState returns a default bankplus module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InactiveAddresses: []string{},
+		DenomRestrictions: []DenomRestriction{},
+	}
+}
+
+// Validate performs basic validation of bankplus genesis data returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	seenInactiveAddrs := make(map[string]bool)
+	for _, addr := range gs.InactiveAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid inactive address %s: %w", addr, err)
+		}
+
+		if seenInactiveAddrs[addr] {
+			return fmt.Errorf("duplicate inactive address %s", addr)
+		}
+
+		seenInactiveAddrs[addr] = true
+	}
+
+	seenRestrictions := make(map[string]bool)
+	for _, restriction := range gs.DenomRestrictions {
+		if seenRestrictions[restriction.Denom] {
+			return fmt.Errorf("duplicate restriction for denom %s", restriction.Denom)
+		}
+
+		if err := restriction.Validate(); err != nil {
+			return err
+		}
+
+		if restriction.IsEmpty() {
+			return fmt.Errorf("empty restriction for denom %s", restriction.Denom)
+		}
+
+		seenRestrictions[restriction.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	addr := "link1yq8lgssgxlx9smjhes6ryjasmqmd3ts2p6925r"
+
+	testCases := map[string]struct {
+		genesis GenesisState
+		valid   bool
+	}{
+		"default genesis": {
+			genesis: *DefaultGenesisState(),
+			valid:   true,
+		},
+		"valid inactive addresses": {
+			genesis: GenesisState{
+				InactiveAddresses: []string{addr},
+			},
+			valid: true,
+		},
+		"invalid inactive address": {
+			genesis: GenesisState{
+				InactiveAddresses: []string{"invalid"},
+			},
+		},
+		"dup inactive addresses": {
+			genesis: GenesisState{
+				InactiveAddresses: []string{addr, addr},
+			},
+		},
+		"valid denom restrictions": {
+			genesis: GenesisState{
+				DenomRestrictions: []DenomRestriction{
+					{Denom: "uatom", FrozenAddresses: []string{addr}},
+				},
+			},
+			valid: true,
+		},
+		"empty denom restriction": {
+			genesis: GenesisState{
+				DenomRestrictions: []DenomRestriction{{Denom: "uatom"}},
+			},
+		},
+		"dup denom restrictions": {
+			genesis: GenesisState{
+				DenomRestrictions: []DenomRestriction{
+					{Denom: "uatom", AllowlistEnabled: true},
+					{Denom: "uatom", AllowlistEnabled: true},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
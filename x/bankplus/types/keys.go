@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+const (
+	// ModuleName is the name of the bankplus module
+	ModuleName = "bankplus"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// KVStore keys
+//
+// The bankplus state is stored in the store of x/bank, so the following prefixes
+// are reserved for bankplus. x/bank must not use them for its own keys.
+var (
+	InactiveAddrsKeyPrefix    = []byte{0xa0}
+	DenomRestrictionKeyPrefix = []byte{0xa1}
+	FrozenAddrKeyPrefix       = []byte{0xa2}
+	AllowlistedAddrKeyPrefix  = []byte{0xa3}
+)
+
+// DefaultAuthority returns the default authority which manages the inactive addresses.
+func DefaultAuthority() sdk.AccAddress {
+	return authtypes.NewModuleAddress(foundation.ModuleName)
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+)
+
+func TestKeyPrefixesNotOverlapBank(t *testing.T) {
+	bankPrefixes := [][]byte{
+		banktypes.SupplyKey,
+		banktypes.DenomMetadataPrefix,
+		banktypes.BalancesPrefix,
+	}
+	bankplusPrefixes := [][]byte{
+		InactiveAddrsKeyPrefix,
+		DenomRestrictionKeyPrefix,
+		FrozenAddrKeyPrefix,
+		AllowlistedAddrKeyPrefix,
+	}
+
+	seen := map[byte]bool{}
+	for _, prefix := range append(bankPrefixes, bankplusPrefixes...) {
+		require.Len(t, prefix, 1)
+		require.False(t, seen[prefix[0]], "duplicate prefix %X", prefix)
+		seen[prefix[0]] = true
+	}
+}
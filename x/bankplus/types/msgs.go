@@ -0,0 +1,114 @@
+package types
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgDeactivate{}
+	_ sdk.Msg = &MsgActivate{}
+	_ sdk.Msg = &MsgSetDenomRestriction{}
+)
+
+// ValidateBasic implements Msg.
+func (m *MsgDeactivate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", m.Address)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m *MsgDeactivate) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m *MsgDeactivate) Type() string {
+	return sdk.MsgTypeURL(m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m *MsgDeactivate) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m *MsgDeactivate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements Msg.
+func (m *MsgActivate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", m.Address)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m *MsgActivate) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m *MsgActivate) Type() string {
+	return sdk.MsgTypeURL(m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m *MsgActivate) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m *MsgActivate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements Msg.
+func (m *MsgSetDenomRestriction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if err := m.Restriction.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m *MsgSetDenomRestriction) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m *MsgSetDenomRestriction) Type() string {
+	return sdk.MsgTypeURL(m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m *MsgSetDenomRestriction) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m *MsgSetDenomRestriction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestMsgDeactivate(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		address   sdk.AccAddress
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			address:   addrs[1],
+			valid:     true,
+		},
+		"empty authority": {
+			address: addrs[1],
+		},
+		"empty address": {
+			authority: addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			deactivate := types.MsgDeactivate{
+				Authority: tc.authority.String(),
+				Address:   tc.address.String(),
+			}
+			activate := types.MsgActivate{
+				Authority: tc.authority.String(),
+				Address:   tc.address.String(),
+			}
+
+			for _, msg := range []sdk.Msg{&deactivate, &activate} {
+				err := msg.ValidateBasic()
+				if !tc.valid {
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+			}
+		})
+	}
+}
+
+func TestMsgSetDenomRestriction(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority   sdk.AccAddress
+		restriction types.DenomRestriction
+		valid       bool
+	}{
+		"valid msg": {
+			authority:   addrs[0],
+			restriction: types.DenomRestriction{Denom: "stake", FrozenAddresses: []string{addrs[1].String()}},
+			valid:       true,
+		},
+		"removal": {
+			authority:   addrs[0],
+			restriction: types.DenomRestriction{Denom: "stake"},
+			valid:       true,
+		},
+		"empty authority": {
+			restriction: types.DenomRestriction{Denom: "stake"},
+		},
+		"invalid restriction": {
+			authority:   addrs[0],
+			restriction: types.DenomRestriction{Denom: "stake", FrozenAddresses: []string{"invalid"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := types.MsgSetDenomRestriction{
+				Authority:   tc.authority.String(),
+				Restriction: tc.restriction,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
@@ -0,0 +1,1522 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/bankplus/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/Finschia/finschia-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryInactiveAddressesRequest is the request type for the Query/InactiveAddresses RPC method.
+type QueryInactiveAddressesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInactiveAddressesRequest) Reset()         { *m = QueryInactiveAddressesRequest{} }
+func (m *QueryInactiveAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveAddressesRequest) ProtoMessage()    {}
+func (*QueryInactiveAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{0}
+}
+func (m *QueryInactiveAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInactiveAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInactiveAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveAddressesRequest.Merge(m, src)
+}
+func (m *QueryInactiveAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInactiveAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInactiveAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryInactiveAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInactiveAddressesResponse is the response type for the Query/InactiveAddresses RPC method.
+type QueryInactiveAddressesResponse struct {
+	// addresses are the inactive addresses.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInactiveAddressesResponse) Reset()         { *m = QueryInactiveAddressesResponse{} }
+func (m *QueryInactiveAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveAddressesResponse) ProtoMessage()    {}
+func (*QueryInactiveAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{1}
+}
+func (m *QueryInactiveAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInactiveAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInactiveAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveAddressesResponse.Merge(m, src)
+}
+func (m *QueryInactiveAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInactiveAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInactiveAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryInactiveAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryInactiveAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomRestrictionRequest is the request type for the Query/DenomRestriction RPC method.
+type QueryDenomRestrictionRequest struct {
+	// denom is the denom of the restriction.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomRestrictionRequest) Reset()         { *m = QueryDenomRestrictionRequest{} }
+func (m *QueryDenomRestrictionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionRequest) ProtoMessage()    {}
+func (*QueryDenomRestrictionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{2}
+}
+func (m *QueryDenomRestrictionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionRequest.Merge(m, src)
+}
+func (m *QueryDenomRestrictionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomRestrictionResponse is the response type for the Query/DenomRestriction RPC method.
+type QueryDenomRestrictionResponse struct {
+	// restriction is the restriction on the transfers of the denom.
+	Restriction DenomRestriction `protobuf:"bytes,1,opt,name=restriction,proto3" json:"restriction"`
+}
+
+func (m *QueryDenomRestrictionResponse) Reset()         { *m = QueryDenomRestrictionResponse{} }
+func (m *QueryDenomRestrictionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionResponse) ProtoMessage()    {}
+func (*QueryDenomRestrictionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{3}
+}
+func (m *QueryDenomRestrictionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionResponse.Merge(m, src)
+}
+func (m *QueryDenomRestrictionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionResponse proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionResponse) GetRestriction() DenomRestriction {
+	if m != nil {
+		return m.Restriction
+	}
+	return DenomRestriction{}
+}
+
+// QueryDenomRestrictionsRequest is the request type for the Query/DenomRestrictions RPC method.
+type QueryDenomRestrictionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomRestrictionsRequest) Reset()         { *m = QueryDenomRestrictionsRequest{} }
+func (m *QueryDenomRestrictionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionsRequest) ProtoMessage()    {}
+func (*QueryDenomRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{4}
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionsRequest.Merge(m, src)
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomRestrictionsResponse is the response type for the Query/DenomRestrictions RPC method.
+type QueryDenomRestrictionsResponse struct {
+	// restrictions are the restrictions on the transfers of the denoms.
+	Restrictions []DenomRestriction `protobuf:"bytes,1,rep,name=restrictions,proto3" json:"restrictions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomRestrictionsResponse) Reset()         { *m = QueryDenomRestrictionsResponse{} }
+func (m *QueryDenomRestrictionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionsResponse) ProtoMessage()    {}
+func (*QueryDenomRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{5}
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionsResponse.Merge(m, src)
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionsResponse) GetRestrictions() []DenomRestriction {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
+func (m *QueryDenomRestrictionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryInactiveAddressesRequest)(nil), "lbm.bankplus.v1.QueryInactiveAddressesRequest")
+	proto.RegisterType((*QueryInactiveAddressesResponse)(nil), "lbm.bankplus.v1.QueryInactiveAddressesResponse")
+	proto.RegisterType((*QueryDenomRestrictionRequest)(nil), "lbm.bankplus.v1.QueryDenomRestrictionRequest")
+	proto.RegisterType((*QueryDenomRestrictionResponse)(nil), "lbm.bankplus.v1.QueryDenomRestrictionResponse")
+	proto.RegisterType((*QueryDenomRestrictionsRequest)(nil), "lbm.bankplus.v1.QueryDenomRestrictionsRequest")
+	proto.RegisterType((*QueryDenomRestrictionsResponse)(nil), "lbm.bankplus.v1.QueryDenomRestrictionsResponse")
+}
+
+func init() { proto.RegisterFile("lbm/bankplus/v1/query.proto", fileDescriptor_9ca08475e4ace696) }
+
+var fileDescriptor_9ca08475e4ace696 = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xeb, 0x8d, 0x21, 0xd5, 0x43, 0x02, 0xac, 0x1d, 0xa6, 0xd2, 0x85, 0x51, 0x04, 0x4c,
+	0x4c, 0xb5, 0x95, 0x8d, 0x17, 0x60, 0x42, 0x43, 0xd3, 0x2e, 0x90, 0x23, 0x97, 0xc9, 0x49, 0x4d,
+	0x66, 0xd6, 0xd8, 0x59, 0xec, 0x56, 0x4c, 0x88, 0x0b, 0x17, 0xae, 0x48, 0x3c, 0x01, 0x17, 0x1e,
+	0x81, 0x67, 0xd8, 0x71, 0x12, 0x17, 0x4e, 0x08, 0xb5, 0x3c, 0x04, 0x47, 0x14, 0xdb, 0x5d, 0xda,
+	0x64, 0xdd, 0x3a, 0xa9, 0x37, 0xf7, 0xf7, 0xf7, 0xf3, 0xfb, 0xfd, 0xbe, 0x0d, 0xbc, 0xd7, 0x0d,
+	0x13, 0x12, 0x52, 0x71, 0x94, 0x76, 0x7b, 0x8a, 0xf4, 0x7d, 0x72, 0xdc, 0x63, 0xd9, 0x09, 0x4e,
+	0x33, 0xa9, 0x25, 0xba, 0xdd, 0x0d, 0x13, 0x3c, 0x72, 0xe2, 0xbe, 0xdf, 0x68, 0xc6, 0x52, 0xc6,
+	0x5d, 0x46, 0x68, 0xca, 0x09, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14, 0xca, 0x86, 0x37, 0x56, 0x62,
+	0x19, 0x4b, 0xf3, 0x24, 0xf9, 0xcb, 0x59, 0x9f, 0x46, 0x52, 0x25, 0x52, 0x91, 0x90, 0x2a, 0x66,
+	0xab, 0x93, 0xbe, 0x1f, 0x32, 0x4d, 0x7d, 0x92, 0xd2, 0x98, 0x0b, 0x53, 0xc2, 0xc5, 0x7a, 0x65,
+	0x9a, 0xf3, 0xe6, 0xc6, 0xdf, 0x8a, 0xe1, 0xda, 0xeb, 0xbc, 0xc2, 0x9e, 0xa0, 0x91, 0xe6, 0x7d,
+	0xf6, 0xbc, 0xd3, 0xc9, 0x98, 0x52, 0x4c, 0x05, 0xec, 0xb8, 0xc7, 0x94, 0x46, 0xbb, 0x10, 0x16,
+	0x45, 0x57, 0xc1, 0x3a, 0xd8, 0x58, 0xde, 0x7a, 0x8c, 0x2d, 0x01, 0xce, 0x09, 0xb0, 0x9d, 0xcf,
+	0x11, 0xe0, 0x57, 0x34, 0x66, 0x2e, 0x37, 0x18, 0xcb, 0x6c, 0x7d, 0x06, 0xd0, 0x9b, 0xd6, 0x49,
+	0xa5, 0x52, 0x28, 0x86, 0x9a, 0xb0, 0x4e, 0x47, 0xc6, 0x55, 0xb0, 0xbe, 0xb8, 0x51, 0x0f, 0x0a,
+	0x03, 0x7a, 0x39, 0x01, 0xb2, 0x60, 0x40, 0x9e, 0x5c, 0x09, 0x62, 0x4b, 0x4f, 0x90, 0x3c, 0x83,
+	0x4d, 0x03, 0xf2, 0x82, 0x09, 0x99, 0x04, 0x4c, 0xe9, 0x8c, 0x47, 0xb9, 0x63, 0x34, 0xf1, 0x0a,
+	0x5c, 0xea, 0xe4, 0x2e, 0x33, 0x6c, 0x3d, 0xb0, 0x3f, 0x5a, 0xef, 0xe0, 0xda, 0x94, 0x2c, 0x47,
+	0xbf, 0x07, 0x97, 0xb3, 0xc2, 0xec, 0x36, 0xf5, 0x00, 0x97, 0x0e, 0x8e, 0xcb, 0xf9, 0x3b, 0x37,
+	0x4e, 0x7f, 0xdf, 0xaf, 0x05, 0xe3, 0xb9, 0xe7, 0x47, 0x29, 0xc7, 0xce, 0xfd, 0x28, 0x3f, 0x46,
+	0x47, 0xb9, 0xa0, 0x93, 0x1b, 0x6b, 0x1f, 0xde, 0x1a, 0x43, 0xb3, 0x77, 0xb9, 0xc6, 0x5c, 0x13,
+	0xc9, 0x73, 0xbb, 0xe1, 0xd6, 0xbf, 0x45, 0xb8, 0x64, 0xc0, 0xd1, 0x37, 0x00, 0xef, 0x56, 0x24,
+	0x85, 0x70, 0x85, 0xef, 0x52, 0x95, 0x37, 0xc8, 0xcc, 0xf1, 0x16, 0xa6, 0xb5, 0xf9, 0xe9, 0xe7,
+	0xdf, 0xaf, 0x0b, 0x8f, 0xd0, 0x43, 0x52, 0xfe, 0x83, 0x71, 0x97, 0x73, 0x50, 0x48, 0xf7, 0x3b,
+	0x80, 0x77, 0xca, 0xfb, 0x41, 0xed, 0x8b, 0x5b, 0x4e, 0x51, 0x65, 0x03, 0xcf, 0x1a, 0xee, 0x00,
+	0xb7, 0x0d, 0x60, 0x1b, 0x6d, 0x56, 0x00, 0x8d, 0x9e, 0x0f, 0xc6, 0xef, 0x42, 0x3e, 0x18, 0xdb,
+	0x47, 0xb3, 0xcc, 0x8a, 0x14, 0xd0, 0x8c, 0xad, 0xaf, 0x5a, 0xe6, 0x54, 0x8d, 0x5d, 0xb2, 0xcc,
+	0x2a, 0xeb, 0xce, 0xfe, 0xe9, 0xc0, 0x03, 0x67, 0x03, 0x0f, 0xfc, 0x19, 0x78, 0xe0, 0xcb, 0xd0,
+	0xab, 0x9d, 0x0d, 0xbd, 0xda, 0xaf, 0xa1, 0x57, 0x7b, 0xe3, 0xc7, 0x5c, 0x1f, 0xf6, 0x42, 0x1c,
+	0xc9, 0x84, 0xec, 0x72, 0xa1, 0xa2, 0x43, 0x4e, 0xc9, 0x5b, 0xf7, 0x68, 0xab, 0xce, 0x11, 0x79,
+	0x5f, 0x14, 0xd7, 0x27, 0x29, 0x53, 0xe1, 0x4d, 0xf3, 0x15, 0xdc, 0xfe, 0x3f, 0x00, 0xb9, 0x9f,
+	0x3a, 0x5b, 0xb5, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// InactiveAddresses queries all the addresses which are not allowed to receive funds.
+	InactiveAddresses(ctx context.Context, in *QueryInactiveAddressesRequest, opts ...grpc.CallOption) (*QueryInactiveAddressesResponse, error)
+	// DenomRestriction queries the restriction on the transfers of a denom.
+	DenomRestriction(ctx context.Context, in *QueryDenomRestrictionRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionResponse, error)
+	// DenomRestrictions queries all the restrictions on the transfers of the denoms.
+	DenomRestrictions(ctx context.Context, in *QueryDenomRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) InactiveAddresses(ctx context.Context, in *QueryInactiveAddressesRequest, opts ...grpc.CallOption) (*QueryInactiveAddressesResponse, error) {
+	out := new(QueryInactiveAddressesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.bankplus.v1.Query/InactiveAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRestriction(ctx context.Context, in *QueryDenomRestrictionRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionResponse, error) {
+	out := new(QueryDenomRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/lbm.bankplus.v1.Query/DenomRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRestrictions(ctx context.Context, in *QueryDenomRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionsResponse, error) {
+	out := new(QueryDenomRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.bankplus.v1.Query/DenomRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// InactiveAddresses queries all the addresses which are not allowed to receive funds.
+	InactiveAddresses(context.Context, *QueryInactiveAddressesRequest) (*QueryInactiveAddressesResponse, error)
+	// DenomRestriction queries the restriction on the transfers of a denom.
+	DenomRestriction(context.Context, *QueryDenomRestrictionRequest) (*QueryDenomRestrictionResponse, error)
+	// DenomRestrictions queries all the restrictions on the transfers of the denoms.
+	DenomRestrictions(context.Context, *QueryDenomRestrictionsRequest) (*QueryDenomRestrictionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) InactiveAddresses(ctx context.Context, req *QueryInactiveAddressesRequest) (*QueryInactiveAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveAddresses not implemented")
+}
+func (*UnimplementedQueryServer) DenomRestriction(ctx context.Context, req *QueryDenomRestrictionRequest) (*QueryDenomRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRestriction not implemented")
+}
+func (*UnimplementedQueryServer) DenomRestrictions(ctx context.Context, req *QueryDenomRestrictionsRequest) (*QueryDenomRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRestrictions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_InactiveAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.bankplus.v1.Query/InactiveAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveAddresses(ctx, req.(*QueryInactiveAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.bankplus.v1.Query/DenomRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRestriction(ctx, req.(*QueryDenomRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.bankplus.v1.Query/DenomRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRestrictions(ctx, req.(*QueryDenomRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.bankplus.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InactiveAddresses",
+			Handler:    _Query_InactiveAddresses_Handler,
+		},
+		{
+			MethodName: "DenomRestriction",
+			Handler:    _Query_DenomRestriction_Handler,
+		},
+		{
+			MethodName: "DenomRestrictions",
+			Handler:    _Query_DenomRestrictions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/bankplus/v1/query.proto",
+}
+
+func (m *QueryInactiveAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomRestrictionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRestrictionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomRestrictionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRestrictionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Restriction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomRestrictionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRestrictionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomRestrictionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRestrictionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Restrictions) > 0 {
+		for iNdEx := len(m.Restrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInactiveAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRestrictionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRestrictionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Restriction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomRestrictionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRestrictionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for _, e := range m.Restrictions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInactiveAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInactiveAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomRestrictionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRestrictionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRestrictionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomRestrictionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRestrictionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRestrictionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restriction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Restriction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomRestrictionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomRestrictionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRestrictionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = append(m.Restrictions, DenomRestriction{})
+			if err := m.Restrictions[len(m.Restrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
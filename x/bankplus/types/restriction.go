@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// Validate performs a basic validation of the denom restriction fields. It checks:
+//   - Denom is a valid coin denomination
+//   - Addresses are valid and not duplicated
+//   - Allowlisted addresses are empty if the allowlist is disabled
+func (r DenomRestriction) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid restriction denom: %w", err)
+	}
+
+	if err := validateRestrictionAddresses(r.FrozenAddresses); err != nil {
+		return fmt.Errorf("invalid frozen addresses: %w", err)
+	}
+
+	if !r.AllowlistEnabled && len(r.AllowlistedAddresses) != 0 {
+		return errors.New("allowlisted addresses on the disabled allowlist")
+	}
+
+	if err := validateRestrictionAddresses(r.AllowlistedAddresses); err != nil {
+		return fmt.Errorf("invalid allowlisted addresses: %w", err)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the restriction does not restrict any transfer.
+func (r DenomRestriction) IsEmpty() bool {
+	return len(r.FrozenAddresses) == 0 && !r.AllowlistEnabled
+}
+
+func validateRestrictionAddresses(addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address %s: %w", addr, err)
+		}
+
+		if seen[addr] {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomRestrictionValidate(t *testing.T) {
+	addr := "link1yq8lgssgxlx9smjhes6ryjasmqmd3ts2p6925r"
+
+	testCases := map[string]struct {
+		restriction DenomRestriction
+		valid       bool
+		empty       bool
+	}{
+		"empty restriction": {
+			restriction: DenomRestriction{Denom: "stake"},
+			valid:       true,
+			empty:       true,
+		},
+		"frozen addresses": {
+			restriction: DenomRestriction{Denom: "stake", FrozenAddresses: []string{addr}},
+			valid:       true,
+		},
+		"allowlist": {
+			restriction: DenomRestriction{Denom: "stake", AllowlistEnabled: true, AllowlistedAddresses: []string{addr}},
+			valid:       true,
+		},
+		"empty allowlist": {
+			restriction: DenomRestriction{Denom: "stake", AllowlistEnabled: true},
+			valid:       true,
+		},
+		"invalid denom": {
+			restriction: DenomRestriction{FrozenAddresses: []string{addr}},
+		},
+		"invalid frozen address": {
+			restriction: DenomRestriction{Denom: "stake", FrozenAddresses: []string{"invalid"}},
+		},
+		"duplicate frozen addresses": {
+			restriction: DenomRestriction{Denom: "stake", FrozenAddresses: []string{addr, addr}},
+		},
+		"allowlisted addresses on the disabled allowlist": {
+			restriction: DenomRestriction{Denom: "stake", AllowlistedAddresses: []string{addr}},
+		},
+		"duplicate allowlisted addresses": {
+			restriction: DenomRestriction{Denom: "stake", AllowlistEnabled: true, AllowlistedAddresses: []string{addr, addr}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.restriction.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.empty, tc.restriction.IsEmpty())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/client/flags"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/version"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
@@ -44,6 +45,13 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
+		NewQueryCmdAllowance(),
+		NewQueryCmdNFTsByOwner(),
+		NewQueryCmdNFTsByClass(),
+		NewQueryCmdNFTsByAttribute(),
+		NewQueryCmdTokenClasses(),
+		NewQueryCmdContracts(),
+		NewQueryCmdRoyalty(),
 	)
 
 	return queryCmd
@@ -715,3 +723,308 @@ func NewQueryCmdHoldersByOperator() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "approvers")
 	return cmd
 }
+
+func NewQueryCmdAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowance [contract-id] [holder] [spender] [token-id]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "query the remaining allowance of spender on a fungible token of the holder",
+		Example: fmt.Sprintf(`$ %s query %s allowance [contract-id] [holder] [spender] [token-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			holder := args[1]
+			if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+				return err
+			}
+
+			spender := args[2]
+			if _, err := sdk.AccAddressFromBech32(spender); err != nil {
+				return err
+			}
+
+			tokenID := args[3]
+			if err := collection.ValidateFTID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryAllowanceRequest{
+				ContractId: contractID,
+				Holder:     holder,
+				Spender:    spender,
+				TokenId:    tokenID,
+			}
+			res, err := queryClient.Allowance(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdNFTsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-owner [contract-id] [owner]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all non-fungible tokens directly owned by a given address",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-owner [contract-id] [owner]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			owner := args[1]
+			if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByOwnerRequest{
+				ContractId: contractID,
+				Owner:      owner,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByOwner(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts-by-owner")
+	return cmd
+}
+
+func NewQueryCmdNFTsByClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-class [contract-id] [class-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all non-fungible tokens of a given token class",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-class [contract-id] [class-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID := args[1]
+			if err := collection.ValidateClassID(classID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByClassRequest{
+				ContractId: contractID,
+				ClassId:    classID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByClass(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts-by-class")
+	return cmd
+}
+
+func NewQueryCmdNFTsByAttribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-attribute [contract-id] [class-id] [key] [value]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "query all non-fungible tokens of a given token class which have a given attribute value",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-attribute [contract-id] [class-id] [key] [value]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID := args[1]
+			if err := collection.ValidateClassID(classID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByAttributeRequest{
+				ContractId: contractID,
+				ClassId:    classID,
+				Key:        args[2],
+				Value:      args[3],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByAttribute(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts-by-attribute")
+	return cmd
+}
+
+func NewQueryCmdTokenClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token-classes [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all token classes of a given contract",
+		Example: fmt.Sprintf(`$ %s query %s token-classes [contract-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryTokenClassesRequest{
+				ContractId: contractID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.TokenClasses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-classes")
+	return cmd
+}
+
+func NewQueryCmdContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contracts",
+		Args:    cobra.NoArgs,
+		Short:   "query all contracts",
+		Example: fmt.Sprintf(`$ %s query %s contracts`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryContractsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.Contracts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
+	return cmd
+}
+
+func NewQueryCmdRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [contract-id] [token-id] [sale-price]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query the royalty of an nft on a given sale price",
+		Example: fmt.Sprintf(`$ %s query %s royalty [contract-id] [token-id] [sale-price]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			tokenID := args[1]
+			if err := collection.ValidateNFTID(tokenID); err != nil {
+				return err
+			}
+
+			salePrice, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set sale price: %s", args[2])
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryRoyaltyRequest{
+				ContractId: contractID,
+				TokenId:    tokenID,
+				SalePrice:  salePrice,
+			}
+			res, err := queryClient.Royalty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
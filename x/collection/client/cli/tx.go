@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +30,17 @@ const (
 	FlagTo       = "to"
 	FlagSupply   = "supply"
 
+	// flag for allowances
+	FlagExpiration = "expiration"
+
+	// flags for royalties
+	FlagRoyaltyRecipient = "royalty-recipient"
+	FlagRoyaltyRate      = "royalty-rate"
+
+	// flags for attributes of non-fungible tokens
+	FlagAttributeSchema = "attribute-schema"
+	FlagAttribute       = "attribute"
+
 	DefaultDecimals = 8
 	DefaultSupply   = "0"
 )
@@ -61,7 +73,9 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdRevokePermission(),
 		NewTxCmdAuthorizeOperator(),
 		NewTxCmdRevokeOperator(),
+		NewTxCmdApprove(),
 		NewTxCmdModify(),
+		NewTxCmdSetRoyalty(),
 	)
 
 	return txCmd
@@ -392,11 +406,27 @@ func NewTxCmdIssueNFT() *cobra.Command {
 				return err
 			}
 
+			royalty, err := royaltyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			schemaStrs, err := cmd.Flags().GetStringArray(FlagAttributeSchema)
+			if err != nil {
+				return err
+			}
+			schema, err := parseAttributeSchema(schemaStrs)
+			if err != nil {
+				return err
+			}
+
 			msg := collection.MsgIssueNFT{
-				ContractId: args[0],
-				Owner:      operator,
-				Name:       name,
-				Meta:       meta,
+				ContractId:      args[0],
+				Owner:           operator,
+				Name:            name,
+				Meta:            meta,
+				Royalty:         royalty,
+				AttributeSchema: schema,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -408,6 +438,8 @@ func NewTxCmdIssueNFT() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	addRoyaltyFlags(cmd)
+	cmd.Flags().StringArray(FlagAttributeSchema, nil, "declare an attribute in the form of key:type[:required][:mutable], where type is one of string, int and bool (repeatable)")
 
 	return cmd
 }
@@ -484,10 +516,26 @@ func NewTxCmdMintNFT() *cobra.Command {
 				return err
 			}
 
+			royalty, err := royaltyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			attributeStrs, err := cmd.Flags().GetStringArray(FlagAttribute)
+			if err != nil {
+				return err
+			}
+			attributes, err := parseAttributes(attributeStrs)
+			if err != nil {
+				return err
+			}
+
 			params := []collection.MintNFTParam{{
-				TokenType: args[3],
-				Name:      name,
-				Meta:      meta,
+				TokenType:  args[3],
+				Name:       name,
+				Meta:       meta,
+				Royalty:    royalty,
+				Attributes: attributes,
 			}}
 
 			msg := collection.MsgMintNFT{
@@ -507,6 +555,8 @@ func NewTxCmdMintNFT() *cobra.Command {
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
 	_ = cmd.MarkFlagRequired(FlagName)
+	addRoyaltyFlags(cmd)
+	cmd.Flags().StringArray(FlagAttribute, nil, "set an attribute in the form of key=value (repeatable)")
 
 	return cmd
 }
@@ -706,6 +756,138 @@ func NewTxCmdModify() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdSetRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-royalty [contract-id] [operator] [token-type] [token-index]",
+		Args:  cobra.RangeArgs(3, 4),
+		Short: "set the royalty of a non-fungible token class or a non-fungible token",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-royalty [contract-id] [operator] [token-type] [token-index] --%s [recipient] --%s [rate]
+omit [token-index] to set the royalty of the token class, and omit the royalty flags to remove the royalty.`,
+			version.AppName, collection.ModuleName, FlagRoyaltyRecipient, FlagRoyaltyRate),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			operator := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, operator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var tokenIndex string
+			if len(args) > 3 {
+				tokenIndex = args[3]
+			}
+
+			royalty, err := royaltyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgSetRoyalty{
+				ContractId: args[0],
+				Operator:   operator,
+				TokenType:  args[2],
+				TokenIndex: tokenIndex,
+				Royalty:    royalty,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRoyaltyFlags(cmd)
+
+	return cmd
+}
+
+func addRoyaltyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagRoyaltyRecipient, "", "set the recipient of the royalty")
+	cmd.Flags().String(FlagRoyaltyRate, "", "set the rate of the royalty (e.g. 0.05)")
+}
+
+// royaltyFromFlags returns nil if neither of the royalty flags is set.
+func royaltyFromFlags(cmd *cobra.Command) (*collection.Royalty, error) {
+	recipient, err := cmd.Flags().GetString(FlagRoyaltyRecipient)
+	if err != nil {
+		return nil, err
+	}
+
+	rateStr, err := cmd.Flags().GetString(FlagRoyaltyRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recipient) == 0 && len(rateStr) == 0 {
+		return nil, nil
+	}
+
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return nil, collection.ErrInvalidRoyalty.Wrapf("failed to set rate: %s", rateStr)
+	}
+
+	return &collection.Royalty{
+		Recipient: recipient,
+		Rate:      rate,
+	}, nil
+}
+
+// parseAttributeSchema parses the attribute definitions in the form of key:type[:required][:mutable].
+func parseAttributeSchema(definitionStrs []string) ([]collection.AttributeDefinition, error) {
+	schema := make([]collection.AttributeDefinition, 0, len(definitionStrs))
+	for _, definitionStr := range definitionStrs {
+		fields := strings.Split(definitionStr, ":")
+		if len(fields) < 2 {
+			return nil, collection.ErrInvalidAttributeSchema.Wrapf("invalid attribute definition: %s", definitionStr)
+		}
+
+		typeName := "ATTRIBUTE_TYPE_" + strings.ToUpper(fields[1])
+		definition := collection.AttributeDefinition{
+			Key:  fields[0],
+			Type: collection.AttributeType(collection.AttributeType_value[typeName]),
+		}
+		for _, option := range fields[2:] {
+			switch option {
+			case "required":
+				definition.Required = true
+			case "mutable":
+				definition.Mutable = true
+			default:
+				return nil, collection.ErrInvalidAttributeSchema.Wrapf("invalid option of %s: %s", definition.Key, option)
+			}
+		}
+
+		schema = append(schema, definition)
+	}
+
+	return schema, nil
+}
+
+// parseAttributes parses the attributes in the form of key=value.
+func parseAttributes(attributeStrs []string) ([]collection.Attribute, error) {
+	attributes := make([]collection.Attribute, 0, len(attributeStrs))
+	for _, attributeStr := range attributeStrs {
+		key, value, found := strings.Cut(attributeStr, "=")
+		if !found {
+			return nil, collection.ErrInvalidAttribute.Wrapf("invalid attribute: %s", attributeStr)
+		}
+
+		attributes = append(attributes, collection.Attribute{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return attributes, nil
+}
+
 func NewTxCmdAttach() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "attach [contract-id] [holder] [subject] [target]",
@@ -956,6 +1138,63 @@ func NewTxCmdAuthorizeOperator() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [contract-id] [holder] [spender] [token-id] [spend-limit]",
+		Args:  cobra.ExactArgs(5),
+		Short: "set the allowance of spender on a fungible token of holder",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve [contract-id] [holder] [spender] [token-id] [spend-limit] [--%s [expiration]]`, version.AppName, collection.ModuleName, FlagExpiration),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			holder := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, holder); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limitStr := args[4]
+			limit, ok := sdk.NewIntFromString(limitStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set spend limit: %s", limitStr)
+			}
+
+			msg := collection.MsgApprove{
+				ContractId: args[0],
+				Holder:     holder,
+				Spender:    args[2],
+				TokenId:    args[3],
+				SpendLimit: limit,
+			}
+
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != "" {
+				expiration, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				msg.Expiration = &expiration
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the allowance expires")
+	return cmd
+}
+
 func NewTxCmdRevokeOperator() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revoke-operator [contract-id] [holder] [operator]",
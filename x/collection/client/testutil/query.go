@@ -743,6 +743,10 @@ func (s *IntegrationTestSuite) TestNewQueryCmdRoot() {
 				Root: collection.NFT{
 					TokenId: collection.NewNFTID(s.nftClassID, 1),
 					Name:    "arctic fox",
+					Attributes: []collection.Attribute{{
+						Key:   "habitat",
+						Value: "tundra",
+					}},
 				},
 			},
 		},
@@ -813,6 +817,10 @@ func (s *IntegrationTestSuite) TestNewQueryCmdParent() {
 				Parent: collection.NFT{
 					TokenId: collection.NewNFTID(s.nftClassID, 1),
 					Name:    "arctic fox",
+					Attributes: []collection.Attribute{{
+						Key:   "habitat",
+						Value: "tundra",
+					}},
 				},
 			},
 		},
@@ -883,6 +891,10 @@ func (s *IntegrationTestSuite) TestNewQueryCmdChildren() {
 				Children: []collection.NFT{{
 					TokenId: collection.NewNFTID(s.nftClassID, 2),
 					Name:    "arctic fox",
+					Attributes: []collection.Attribute{{
+						Key:   "habitat",
+						Value: "tundra",
+					}},
 				}},
 				Pagination: &query.PageResponse{},
 			},
@@ -1127,3 +1139,453 @@ func (s *IntegrationTestSuite) TestNewQueryCmdHoldersByOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryCmdAllowance() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	tokenID := collection.NewFTID(s.ftClassID)
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.vendor.String(),
+				tokenID,
+			},
+			true,
+			&collection.QueryAllowanceResponse{
+				Allowance: collection.Allowance{
+					Holder:     s.customer.String(),
+					Spender:    s.vendor.String(),
+					TokenId:    tokenID,
+					SpendLimit: sdk.ZeroInt(),
+				},
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.vendor.String(),
+				tokenID,
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.vendor.String(),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdAllowance()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryAllowanceResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdNFTsByOwner() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+			},
+			true,
+			3,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				"extra",
+			},
+			false,
+			0,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+			},
+			false,
+			0,
+		},
+		"invalid owner": {
+			[]string{
+				s.contractID,
+				"invalid",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdNFTsByOwner()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryNFTsByOwnerResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, len(actual.Tokens))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdNFTsByClass() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+			},
+			true,
+			4 * 3 * s.lenChain,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"extra",
+			},
+			false,
+			0,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+			},
+			false,
+			0,
+		},
+		"not a class of nft": {
+			[]string{
+				s.contractID,
+				s.ftClassID,
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdNFTsByClass()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryNFTsByClassResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, len(actual.Tokens))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdTokenClasses() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+			},
+			true,
+			2,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				"extra",
+			},
+			false,
+			0,
+		},
+		"not enough args": {
+			[]string{},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdTokenClasses()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryTokenClassesResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, len(actual.Classes))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdContracts() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			1,
+		},
+		"extra args": {
+			[]string{
+				"extra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdContracts()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryContractsResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, len(actual.Contracts))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdRoyalty() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"1000",
+			},
+			true,
+			&collection.QueryRoyaltyResponse{
+				Amount: sdk.ZeroInt(),
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"1000",
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				tokenID,
+			},
+			false,
+			nil,
+		},
+		"invalid sale price": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"price",
+			},
+			false,
+			nil,
+		},
+		"token not found": {
+			[]string{
+				s.contractID,
+				collection.NewNFTID("deadbeef", 1),
+				"1000",
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdRoyalty()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryRoyaltyResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdNFTsByAttribute() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"habitat",
+				"tundra",
+			},
+			true,
+			4 * 3 * s.lenChain,
+		},
+		"no matching token": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"habitat",
+				"desert",
+			},
+			true,
+			0,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"habitat",
+				"tundra",
+				"extra",
+			},
+			false,
+			0,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"habitat",
+			},
+			false,
+			0,
+		},
+		"not a class of nft": {
+			[]string{
+				s.contractID,
+				s.ftClassID,
+				"habitat",
+				"tundra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdNFTsByAttribute()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryNFTsByAttributeResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, len(actual.Tokens))
+		})
+	}
+}
@@ -197,6 +197,7 @@ func (s *IntegrationTestSuite) createNFTClass(contractID string, operator sdk.Ac
 	args := append([]string{
 		contractID,
 		operator.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagAttributeSchema, "habitat:string"),
 	}, commonArgs...)
 
 	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdIssueNFT(), args)
@@ -239,6 +240,7 @@ func (s *IntegrationTestSuite) mintNFT(contractID string, operator, to sdk.AccAd
 		to.String(),
 		classID,
 		fmt.Sprintf("--%s=%s", cli.FlagName, "arctic fox"),
+		fmt.Sprintf("--%s=%s", cli.FlagAttribute, "habitat=tundra"),
 	}, commonArgs...)
 
 	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdMintNFT(), args)
@@ -466,6 +466,32 @@ func (s *IntegrationTestSuite) TestNewTxCmdIssueNFT() {
 			},
 			true,
 		},
+		"valid transaction with royalty": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRecipient, s.vendor),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRate, "0.05"),
+			},
+			true,
+		},
+		"valid transaction with attribute schema": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagAttributeSchema, "level:int:required:mutable"),
+				fmt.Sprintf("--%s=%s", cli.FlagAttributeSchema, "rare:bool"),
+			},
+			true,
+		},
+		"invalid attribute schema": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagAttributeSchema, "level:float"),
+			},
+			false,
+		},
 		"extra args": {
 			[]string{
 				s.contractID,
@@ -599,6 +625,28 @@ func (s *IntegrationTestSuite) TestNewTxCmdMintNFT() {
 			},
 			true,
 		},
+		"valid transaction with attributes": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				s.customer.String(),
+				s.nftClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagName, "arctic fox"),
+				fmt.Sprintf("--%s=%s", cli.FlagAttribute, "habitat=tundra"),
+			},
+			true,
+		},
+		"invalid attribute": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				s.customer.String(),
+				s.nftClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagName, "arctic fox"),
+				fmt.Sprintf("--%s=%s", cli.FlagAttribute, "habitat"),
+			},
+			false,
+		},
 		"extra args": {
 			[]string{
 				s.contractID,
@@ -984,6 +1032,95 @@ func (s *IntegrationTestSuite) TestNewTxCmdModify() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdSetRoyalty() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	royaltyArgs := []string{
+		fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRecipient, s.vendor),
+		fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRate, "0.05"),
+	}
+	tokenIndex := collection.NewNFTID(s.nftClassID, 1)[8:]
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction (class)": {
+			append([]string{
+				s.contractID,
+				s.operator.String(),
+				s.nftClassID,
+			}, royaltyArgs...),
+			true,
+		},
+		"valid transaction (nft)": {
+			append([]string{
+				s.contractID,
+				s.operator.String(),
+				s.nftClassID,
+				tokenIndex,
+			}, royaltyArgs...),
+			true,
+		},
+		"valid transaction (removal)": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				s.nftClassID,
+				tokenIndex,
+			},
+			true,
+		},
+		"extra args": {
+			append([]string{
+				s.contractID,
+				s.operator.String(),
+				s.nftClassID,
+				tokenIndex,
+				"extra",
+			}, royaltyArgs...),
+			false,
+		},
+		"not enough args": {
+			append([]string{
+				s.contractID,
+				s.operator.String(),
+			}, royaltyArgs...),
+			false,
+		},
+		"invalid rate": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				s.nftClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRecipient, s.vendor),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRate, "1.5"),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdSetRoyalty()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdAttach() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -1480,3 +1617,77 @@ func (s *IntegrationTestSuite) TestNewTxCmdRevokeOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewTxCmdApprove() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	tokenID := collection.NewFTID(s.ftClassID)
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				tokenID,
+				"1",
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "2100-01-01T00:00:00Z"),
+			},
+			true,
+		},
+		"invalid expiration": {
+			[]string{
+				s.contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				tokenID,
+				"1",
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "tomorrow"),
+			},
+			false,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				tokenID,
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				tokenID,
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdApprove()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
@@ -36,6 +36,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDetach{}, "lbm-sdk/MsgDetach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorAttach{}, "lbm-sdk/MsgOperatorAttach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorDetach{}, "lbm-sdk/MsgOperatorDetach")
+	legacy.RegisterAminoMsg(cdc, &MsgApprove{}, "lbm-sdk/MsgApprove")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRoyalty{}, "lbm-sdk/MsgSetRoyalty")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -53,11 +55,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorSendNFT{},
 		&MsgAuthorizeOperator{},
 		&MsgRevokeOperator{},
+		&MsgApprove{},
 		&MsgBurnFT{},
 		&MsgOperatorBurnFT{},
 		&MsgBurnNFT{},
 		&MsgOperatorBurnNFT{},
 		&MsgModify{},
+		&MsgSetRoyalty{},
 		&MsgGrantPermission{},
 		&MsgRevokePermission{},
 		&MsgOperatorAttach{},
@@ -9,6 +9,7 @@ import (
 
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
 const (
@@ -140,6 +141,172 @@ func (c NFTClass) ValidateBasic() error {
 	if err := validateMeta(c.Meta); err != nil {
 		return err
 	}
+	if err := ValidateRoyalty(c.Royalty); err != nil {
+		return err
+	}
+	if err := ValidateAttributeSchema(c.AttributeSchema); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateNFTAttributes checks the attributes of a token against the attribute schema of the class.
+func (c NFTClass) ValidateNFTAttributes(attributes []Attribute) error {
+	definitions := make(map[string]AttributeDefinition, len(c.AttributeSchema))
+	for _, definition := range c.AttributeSchema {
+		definitions[definition.Key] = definition
+	}
+
+	seenKeys := map[string]bool{}
+	for _, attribute := range attributes {
+		definition, ok := definitions[attribute.Key]
+		if !ok {
+			return ErrInvalidAttribute.Wrapf("undeclared attribute: %s", attribute.Key)
+		}
+
+		if seenKeys[attribute.Key] {
+			return ErrInvalidAttribute.Wrapf("duplicate key: %s", attribute.Key)
+		}
+		seenKeys[attribute.Key] = true
+
+		if err := definition.ValidateValue(attribute.Value); err != nil {
+			return ErrInvalidAttribute.Wrap(err.Error())
+		}
+	}
+
+	for _, definition := range c.AttributeSchema {
+		if definition.Required && !seenKeys[definition.Key] {
+			return ErrInvalidAttribute.Wrapf("missing required attribute: %s", definition.Key)
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Royalty
+
+// ValidateRoyalty checks the royalty, where nil means no royalty.
+func ValidateRoyalty(royalty *Royalty) error {
+	if royalty == nil {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(royalty.Recipient); err != nil {
+		return ErrInvalidRoyalty.Wrapf("invalid recipient address: %s", royalty.Recipient)
+	}
+
+	if royalty.Rate.IsNil() || !royalty.Rate.IsPositive() || royalty.Rate.GT(sdk.OneDec()) {
+		return ErrInvalidRoyalty.Wrapf("rate must be positive and not greater than 1: %s", royalty.Rate)
+	}
+
+	return nil
+}
+
+// Calculate returns the amount of the royalty on a given sale price.
+func (r Royalty) Calculate(salePrice sdk.Int) sdk.Int {
+	return r.Rate.MulInt(salePrice).TruncateInt()
+}
+
+// ----------------------------------------------------------------------------
+// Attribute
+
+var reAttributeKey = regexp.MustCompile(`^[a-z][a-z0-9_]{0,29}$`)
+
+// validateAttributeKey checks the key of an attribute, which must not collide
+// with the keys of the built-in fields.
+func validateAttributeKey(key string) error {
+	if !reAttributeKey.MatchString(key) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid key: %s", key)
+	}
+
+	if AttributeKeyFromString(key) != AttributeKeyUnspecified {
+		return sdkerrors.ErrInvalidRequest.Wrapf("key of a built-in field: %s", key)
+	}
+
+	return nil
+}
+
+func validateAttributeValue(value string) error {
+	if len(value) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty value")
+	}
+
+	return validateStringSize(value, attributeValueLengthLimit, "value")
+}
+
+// ValidateAttributeSchema checks the attribute schema of a class of non-fungible token.
+func ValidateAttributeSchema(schema []AttributeDefinition) error {
+	if len(schema) > attributesLimit {
+		return ErrInvalidAttributeSchema.Wrapf("the number of attributes exceeds the limit: %d > %d", len(schema), attributesLimit)
+	}
+
+	seenKeys := map[string]bool{}
+	for _, definition := range schema {
+		if err := validateAttributeKey(definition.Key); err != nil {
+			return ErrInvalidAttributeSchema.Wrap(err.Error())
+		}
+
+		if seenKeys[definition.Key] {
+			return ErrInvalidAttributeSchema.Wrapf("duplicate key: %s", definition.Key)
+		}
+		seenKeys[definition.Key] = true
+
+		if _, ok := AttributeType_name[int32(definition.Type)]; !ok || definition.Type == AttributeTypeUnspecified {
+			return ErrInvalidAttributeSchema.Wrapf("invalid type of %s: %s", definition.Key, definition.Type)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAttributes checks the attributes of a non-fungible token,
+// regardless of the attribute schema of its class.
+func ValidateAttributes(attributes []Attribute) error {
+	if len(attributes) > attributesLimit {
+		return ErrInvalidAttribute.Wrapf("the number of attributes exceeds the limit: %d > %d", len(attributes), attributesLimit)
+	}
+
+	seenKeys := map[string]bool{}
+	for _, attribute := range attributes {
+		if err := validateAttributeKey(attribute.Key); err != nil {
+			return ErrInvalidAttribute.Wrap(err.Error())
+		}
+
+		if seenKeys[attribute.Key] {
+			return ErrInvalidAttribute.Wrapf("duplicate key: %s", attribute.Key)
+		}
+		seenKeys[attribute.Key] = true
+
+		if err := validateAttributeValue(attribute.Value); err != nil {
+			return ErrInvalidAttribute.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks the value against the type of the attribute.
+// Note: a value must be in its canonical form, so tokens can be filtered by its string.
+func (d AttributeDefinition) ValidateValue(value string) error {
+	if err := validateAttributeValue(value); err != nil {
+		return err
+	}
+
+	switch d.Type {
+	case AttributeTypeString:
+	case AttributeTypeInt:
+		if i, ok := sdk.NewIntFromString(value); !ok || i.String() != value {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s must be an integer: %s", d.Key, value)
+		}
+	case AttributeTypeBool:
+		if value != "true" && value != "false" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s must be a boolean: %s", d.Key, value)
+		}
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid type of %s: %s", d.Key, d.Type)
+	}
 
 	return nil
 }
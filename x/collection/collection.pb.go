@@ -8,16 +8,20 @@ import (
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -103,6 +107,42 @@ func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bb15fea9f4c37044, []int{1}
 }
 
+// AttributeType enumerates the valid types of attribute values.
+type AttributeType int32
+
+const (
+	// unspecified defines the default type which is invalid.
+	AttributeTypeUnspecified AttributeType = 0
+	// ATTRIBUTE_TYPE_STRING defines a string value.
+	AttributeTypeString AttributeType = 1
+	// ATTRIBUTE_TYPE_INT defines an integer value in its canonical decimal form (e.g. `-12`).
+	AttributeTypeInt AttributeType = 2
+	// ATTRIBUTE_TYPE_BOOL defines a boolean value, which is either `true` or `false`.
+	AttributeTypeBool AttributeType = 3
+)
+
+var AttributeType_name = map[int32]string{
+	0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+	1: "ATTRIBUTE_TYPE_STRING",
+	2: "ATTRIBUTE_TYPE_INT",
+	3: "ATTRIBUTE_TYPE_BOOL",
+}
+
+var AttributeType_value = map[string]int32{
+	"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+	"ATTRIBUTE_TYPE_STRING":      1,
+	"ATTRIBUTE_TYPE_INT":         2,
+	"ATTRIBUTE_TYPE_BOOL":        3,
+}
+
+func (x AttributeType) String() string {
+	return proto.EnumName(AttributeType_name, int32(x))
+}
+
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{2}
+}
+
 // Params defines the parameters for the collection module.
 type Params struct {
 	DepthLimit uint32 `protobuf:"varint,1,opt,name=depth_limit,json=depthLimit,proto3" json:"depth_limit,omitempty"` // Deprecated: Do not use.
@@ -286,6 +326,11 @@ type NFTClass struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token class.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty applied to the tokens of the class.
+	// Note: the royalty of a token overrides it.
+	Royalty *Royalty `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// attribute schema which the attributes of the tokens must conform to.
+	AttributeSchema []AttributeDefinition `protobuf:"bytes,5,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema"`
 }
 
 func (m *NFTClass) Reset()         { *m = NFTClass{} }
@@ -342,6 +387,20 @@ func (m *NFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *NFTClass) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *NFTClass) GetAttributeSchema() []AttributeDefinition {
+	if m != nil {
+		return m.AttributeSchema
+	}
+	return nil
+}
+
 // NFT defines the information of non-fungible token.
 //
 // Since: 0.46.0 (finschia)
@@ -352,6 +411,11 @@ type NFT struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty applied to the token.
+	// Note: it overrides the royalty of its class.
+	Royalty *Royalty `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// attributes of the token, which conform to the attribute schema of its class.
+	Attributes []Attribute `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes"`
 }
 
 func (m *NFT) Reset()         { *m = NFT{} }
@@ -387,6 +451,48 @@ func (m *NFT) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NFT proto.InternalMessageInfo
 
+// Royalty defines the royalty paid to the recipient on a sale of non-fungible tokens.
+type Royalty struct {
+	// address of the recipient.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// rate of the royalty to the sale price.
+	// Note: it must be positive and not greater than 1.
+	Rate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"rate"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{5}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
 // Deprecated: use NFT
 //
 // OwnerNFT defines the information of non-fungible token.
@@ -407,7 +513,7 @@ func (m *OwnerNFT) Reset()         { *m = OwnerNFT{} }
 func (m *OwnerNFT) String() string { return proto.CompactTextString(m) }
 func (*OwnerNFT) ProtoMessage()    {}
 func (*OwnerNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{5}
+	return fileDescriptor_bb15fea9f4c37044, []int{6}
 }
 func (m *OwnerNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +564,7 @@ func (m *FT) Reset()         { *m = FT{} }
 func (m *FT) String() string { return proto.CompactTextString(m) }
 func (*FT) ProtoMessage()    {}
 func (*FT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{6}
+	return fileDescriptor_bb15fea9f4c37044, []int{7}
 }
 func (m *FT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +615,7 @@ func (m *TokenType) Reset()         { *m = TokenType{} }
 func (m *TokenType) String() string { return proto.CompactTextString(m) }
 func (*TokenType) ProtoMessage()    {}
 func (*TokenType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{7}
+	return fileDescriptor_bb15fea9f4c37044, []int{8}
 }
 func (m *TokenType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +655,7 @@ type Coin struct {
 func (m *Coin) Reset()      { *m = Coin{} }
 func (*Coin) ProtoMessage() {}
 func (*Coin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{8}
+	return fileDescriptor_bb15fea9f4c37044, []int{9}
 }
 func (m *Coin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +698,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{9}
+	return fileDescriptor_bb15fea9f4c37044, []int{10}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +741,7 @@ func (m *Authorization) Reset()         { *m = Authorization{} }
 func (m *Authorization) String() string { return proto.CompactTextString(m) }
 func (*Authorization) ProtoMessage()    {}
 func (*Authorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{10}
+	return fileDescriptor_bb15fea9f4c37044, []int{11}
 }
 func (m *Authorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,6 +770,53 @@ func (m *Authorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Authorization proto.InternalMessageInfo
 
+// Allowance defines an amount of fungible tokens which the spender can send on behalf of the holder.
+type Allowance struct {
+	// address of the holder which approves the allowance.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// token id of the fungible token.
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// remaining number of tokens the spender can send.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"spend_limit"`
+	// time when the allowance expires. The allowance never expires if not set.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{12}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
 // Attribute defines a key and value of the attribute.
 //
 // Since: 0.46.0 (finschia)
@@ -676,7 +829,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{11}
+	return fileDescriptor_bb15fea9f4c37044, []int{13}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,21 +858,71 @@ func (m *Attribute) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Attribute proto.InternalMessageInfo
 
+// AttributeDefinition defines an attribute in the attribute schema of a non-fungible token class.
+type AttributeDefinition struct {
+	// key of the attribute.
+	// Note: it must not collide with the keys of the built-in fields (e.g. `name`).
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// type of the attribute value.
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=lbm.collection.v1.AttributeType" json:"type,omitempty"`
+	// whether the attribute must be set on the tokens.
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// whether the attribute can be modified after minting.
+	Mutable bool `protobuf:"varint,4,opt,name=mutable,proto3" json:"mutable,omitempty"`
+}
+
+func (m *AttributeDefinition) Reset()         { *m = AttributeDefinition{} }
+func (m *AttributeDefinition) String() string { return proto.CompactTextString(m) }
+func (*AttributeDefinition) ProtoMessage()    {}
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{14}
+}
+func (m *AttributeDefinition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeDefinition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeDefinition.Merge(m, src)
+}
+func (m *AttributeDefinition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeDefinition proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("lbm.collection.v1.Permission", Permission_name, Permission_value)
 	proto.RegisterEnum("lbm.collection.v1.LegacyPermission", LegacyPermission_name, LegacyPermission_value)
+	proto.RegisterEnum("lbm.collection.v1.AttributeType", AttributeType_name, AttributeType_value)
 	proto.RegisterType((*Params)(nil), "lbm.collection.v1.Params")
 	proto.RegisterType((*Contract)(nil), "lbm.collection.v1.Contract")
 	proto.RegisterType((*FTClass)(nil), "lbm.collection.v1.FTClass")
 	proto.RegisterType((*NFTClass)(nil), "lbm.collection.v1.NFTClass")
 	proto.RegisterType((*NFT)(nil), "lbm.collection.v1.NFT")
+	proto.RegisterType((*Royalty)(nil), "lbm.collection.v1.Royalty")
 	proto.RegisterType((*OwnerNFT)(nil), "lbm.collection.v1.OwnerNFT")
 	proto.RegisterType((*FT)(nil), "lbm.collection.v1.FT")
 	proto.RegisterType((*TokenType)(nil), "lbm.collection.v1.TokenType")
 	proto.RegisterType((*Coin)(nil), "lbm.collection.v1.Coin")
 	proto.RegisterType((*Grant)(nil), "lbm.collection.v1.Grant")
 	proto.RegisterType((*Authorization)(nil), "lbm.collection.v1.Authorization")
+	proto.RegisterType((*Allowance)(nil), "lbm.collection.v1.Allowance")
 	proto.RegisterType((*Attribute)(nil), "lbm.collection.v1.Attribute")
+	proto.RegisterType((*AttributeDefinition)(nil), "lbm.collection.v1.AttributeDefinition")
 }
 
 func init() {
@@ -727,63 +930,85 @@ func init() {
 }
 
 var fileDescriptor_bb15fea9f4c37044 = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xe3, 0xd4,
-	0x17, 0xb5, 0xf3, 0xaf, 0xc9, 0xad, 0xa6, 0x93, 0xf1, 0x2f, 0xbf, 0xe2, 0x06, 0xd5, 0x89, 0xcc,
-	0x82, 0xa1, 0xa8, 0x89, 0x66, 0x06, 0x10, 0xaa, 0xc4, 0xa2, 0xc9, 0x24, 0x83, 0x51, 0x9b, 0x56,
-	0x4e, 0x8a, 0x34, 0x6c, 0x82, 0x63, 0xbf, 0x26, 0x4f, 0xb5, 0xfd, 0x22, 0xfb, 0xa5, 0x25, 0x7c,
-	0x82, 0x51, 0x36, 0xb0, 0x64, 0x13, 0xa9, 0x12, 0x2c, 0x66, 0xc3, 0x6e, 0xd6, 0xac, 0xbb, 0x1c,
-	0xcd, 0x0a, 0xb1, 0x18, 0x41, 0xbb, 0x61, 0xcf, 0x17, 0x40, 0xef, 0xd9, 0x71, 0x4c, 0x12, 0x66,
-	0x46, 0x20, 0xb1, 0xbb, 0xf7, 0xbe, 0x73, 0xce, 0xbd, 0x3e, 0xd7, 0xf6, 0x03, 0xd5, 0xee, 0x39,
-	0x55, 0x93, 0xd8, 0x36, 0x32, 0x29, 0x26, 0x6e, 0xf5, 0xfc, 0x5e, 0x2c, 0xab, 0x0c, 0x3d, 0x42,
-	0x89, 0x74, 0xc7, 0xee, 0x39, 0x95, 0x58, 0xf5, 0xfc, 0x5e, 0xb1, 0xd0, 0x27, 0x7d, 0xc2, 0x4f,
-	0xab, 0x2c, 0x0a, 0x80, 0xc5, 0x2d, 0x93, 0xf8, 0x0e, 0xf1, 0xbb, 0xc1, 0x41, 0x90, 0x04, 0x47,
-	0xaa, 0x0e, 0x99, 0x63, 0xc3, 0x33, 0x1c, 0x5f, 0x7a, 0x07, 0xd6, 0x2d, 0x34, 0xa4, 0x83, 0xae,
-	0x8d, 0x1d, 0x4c, 0x65, 0xb1, 0x2c, 0xde, 0xbd, 0x55, 0x4b, 0xc8, 0xa2, 0x0e, 0xbc, 0x7c, 0xc0,
-	0xaa, 0x0c, 0x74, 0x81, 0xad, 0x08, 0x94, 0x98, 0x83, 0x78, 0x99, 0x83, 0xd4, 0x0e, 0x64, 0xeb,
-	0xc4, 0xa5, 0x9e, 0x61, 0x52, 0x69, 0x03, 0x12, 0xd8, 0xe2, 0x62, 0x39, 0x3d, 0x81, 0x2d, 0x49,
-	0x82, 0x94, 0x6b, 0x38, 0x88, 0x33, 0x73, 0x3a, 0x8f, 0x59, 0xcd, 0x41, 0xd4, 0x90, 0x93, 0x41,
-	0x8d, 0xc5, 0x52, 0x1e, 0x92, 0x23, 0x0f, 0xcb, 0x29, 0x5e, 0x62, 0xa1, 0xfa, 0x8d, 0x08, 0x6b,
-	0xcd, 0x4e, 0xdd, 0x36, 0x7c, 0xff, 0x1f, 0xab, 0x16, 0x21, 0x6b, 0x21, 0x13, 0x3b, 0x86, 0xed,
-	0x73, 0xe9, 0xb4, 0x1e, 0xe5, 0xec, 0xcc, 0xc1, 0x2e, 0x35, 0x7a, 0x36, 0x92, 0xd3, 0x65, 0xf1,
-	0x6e, 0x56, 0x8f, 0xf2, 0xbd, 0xc2, 0x93, 0xcb, 0x92, 0xf8, 0xe2, 0xd9, 0x2e, 0x74, 0xc8, 0x19,
-	0x72, 0xf9, 0x0c, 0xb2, 0xa8, 0x7e, 0x0e, 0xd9, 0xd6, 0xbf, 0x9c, 0x68, 0x4f, 0x5a, 0x56, 0x56,
-	0x3f, 0x85, 0x64, 0xab, 0xd9, 0x91, 0xb6, 0x20, 0x4b, 0x59, 0xb1, 0x1b, 0x09, 0xaf, 0xf1, 0x5c,
-	0x7b, 0x63, 0x75, 0xe6, 0x59, 0xf6, 0xe8, 0xc2, 0x45, 0x1e, 0xd3, 0x2b, 0xc1, 0xba, 0x19, 0xae,
-	0x65, 0x2e, 0x09, 0xb3, 0x92, 0x66, 0xfd, 0xa5, 0x61, 0x62, 0x75, 0xc3, 0xe4, 0x8a, 0x86, 0xa9,
-	0x98, 0xc1, 0x05, 0x48, 0x13, 0xd6, 0x8f, 0x3b, 0x98, 0xd3, 0x83, 0x64, 0x2f, 0xf7, 0xe2, 0xd9,
-	0x6e, 0x9a, 0x3f, 0xa0, 0xfa, 0xa3, 0x08, 0x89, 0xff, 0x68, 0x96, 0xf8, 0xb2, 0xd3, 0xaf, 0x58,
-	0x76, 0x66, 0x61, 0xd9, 0xeb, 0xd1, 0xb4, 0xb2, 0xa8, 0xfa, 0x90, 0xe3, 0x61, 0x67, 0x3c, 0x44,
-	0xaf, 0x9f, 0x7a, 0x1b, 0x20, 0x98, 0x9a, 0x8e, 0x87, 0xb3, 0xed, 0xe4, 0x68, 0xc4, 0x7f, 0xc3,
-	0xc9, 0xd5, 0x0b, 0x48, 0xd5, 0x09, 0x76, 0x5f, 0xf5, 0x06, 0x7c, 0x06, 0x19, 0xc3, 0x21, 0x23,
-	0x37, 0xf8, 0x06, 0x73, 0xb5, 0xfb, 0x57, 0x2f, 0x4b, 0xc2, 0x2f, 0x2f, 0x4b, 0x3b, 0x7d, 0x4c,
-	0x07, 0xa3, 0x5e, 0xc5, 0x24, 0x4e, 0xb5, 0x89, 0x5d, 0xdf, 0x1c, 0x60, 0xa3, 0x7a, 0x1a, 0x06,
-	0xbb, 0xbe, 0x75, 0x56, 0x65, 0xa3, 0xf9, 0x15, 0xcd, 0xa5, 0x7a, 0xa8, 0xb0, 0x97, 0xfd, 0xee,
-	0xb2, 0x24, 0xfc, 0x7e, 0x59, 0x12, 0xd5, 0x2f, 0x21, 0xfd, 0xc8, 0x33, 0x5c, 0x2a, 0xc9, 0xb0,
-	0xd6, 0x67, 0x01, 0x42, 0xb3, 0xc6, 0x61, 0x2a, 0x7d, 0x02, 0x30, 0x44, 0x9e, 0x83, 0x7d, 0x1f,
-	0x13, 0x97, 0x37, 0xdf, 0xb8, 0xbf, 0x5d, 0x59, 0xfa, 0x13, 0x55, 0x8e, 0x23, 0x90, 0x1e, 0x23,
-	0xa8, 0x75, 0xb8, 0xb5, 0x3f, 0xa2, 0x03, 0xe2, 0xe1, 0xaf, 0x0d, 0x06, 0x95, 0x36, 0x21, 0x33,
-	0x20, 0xb6, 0x85, 0xbc, 0xb0, 0x51, 0x98, 0xb1, 0x0d, 0x91, 0x21, 0xf2, 0x0c, 0x4a, 0xbc, 0xd0,
-	0xc8, 0x28, 0x57, 0x1f, 0x40, 0x6e, 0x9f, 0x52, 0x0f, 0xf7, 0x46, 0x14, 0xb1, 0x3f, 0xc5, 0x19,
-	0x1a, 0x87, 0x6c, 0x16, 0xb2, 0x97, 0xf0, 0xdc, 0xb0, 0x47, 0xb3, 0x05, 0x04, 0xc9, 0xce, 0x1f,
-	0x22, 0xc0, 0x7c, 0x28, 0xe9, 0x43, 0xd8, 0x3c, 0x6e, 0xe8, 0x87, 0x5a, 0xbb, 0xad, 0x1d, 0xb5,
-	0xba, 0x27, 0xad, 0xf6, 0x71, 0xa3, 0xae, 0x35, 0xb5, 0xc6, 0xc3, 0xbc, 0x50, 0xdc, 0x9a, 0x4c,
-	0xcb, 0xff, 0x9f, 0x63, 0x4f, 0x5c, 0x7f, 0x88, 0x4c, 0x7c, 0x8a, 0x91, 0x25, 0xbd, 0x07, 0xf9,
-	0x18, 0x4d, 0x6b, 0xb7, 0x4f, 0x1a, 0x79, 0xb1, 0xf8, 0xbf, 0xc9, 0xb4, 0x7c, 0x7b, 0x4e, 0xd0,
-	0x7c, 0x7f, 0x84, 0xa4, 0xf7, 0xe1, 0x4e, 0x0c, 0x7a, 0x78, 0xf4, 0x50, 0x6b, 0x3e, 0xce, 0x27,
-	0x8a, 0x85, 0xc9, 0xb4, 0x9c, 0x9f, 0x63, 0x0f, 0x89, 0x85, 0x4f, 0xc7, 0xd2, 0xbb, 0x70, 0x3b,
-	0x0e, 0xd6, 0x5a, 0x9d, 0x7c, 0xb2, 0x28, 0x4d, 0xa6, 0xe5, 0x8d, 0x18, 0x14, 0xbb, 0x74, 0x01,
-	0x58, 0x3b, 0xd1, 0x5b, 0xf9, 0xd4, 0x22, 0xb0, 0x36, 0xf2, 0xdc, 0x62, 0xea, 0xc9, 0xf7, 0x8a,
-	0xb0, 0xf3, 0x53, 0x02, 0xf2, 0x07, 0xa8, 0x6f, 0x98, 0xe3, 0xd8, 0xb3, 0xd7, 0x60, 0xfb, 0xa0,
-	0xf1, 0x68, 0xbf, 0xfe, 0xb8, 0xfb, 0xb7, 0x16, 0x94, 0x26, 0xd3, 0xf2, 0xdb, 0x8b, 0xc4, 0xb8,
-	0x11, 0x1f, 0xc1, 0x5b, 0xcb, 0x1a, 0x33, 0x3f, 0xb8, 0x81, 0x8b, 0xec, 0xc0, 0x95, 0x8f, 0x41,
-	0x5e, 0xe6, 0x45, 0xe6, 0x14, 0x27, 0xd3, 0xf2, 0xe6, 0x22, 0x31, 0xb4, 0xe8, 0x03, 0xd8, 0x5c,
-	0xc1, 0x0c, 0x9c, 0x92, 0x27, 0xd3, 0x72, 0x61, 0x89, 0xc7, 0xfc, 0x5a, 0xc9, 0x0a, 0x6d, 0x5b,
-	0xc9, 0xe2, 0xe6, 0x65, 0x99, 0x79, 0x4f, 0x7f, 0x50, 0x84, 0xda, 0xd1, 0xd5, 0x6f, 0x8a, 0xf0,
-	0xf4, 0x5a, 0x11, 0xae, 0xae, 0x15, 0xf1, 0xf9, 0xb5, 0x22, 0xfe, 0x7a, 0xad, 0x88, 0xdf, 0xde,
-	0x28, 0xc2, 0xf3, 0x1b, 0x45, 0xf8, 0xf9, 0x46, 0x11, 0xbe, 0xd8, 0x7d, 0xed, 0x27, 0xf7, 0x55,
-	0xec, 0xee, 0xee, 0x65, 0xf8, 0xc5, 0xfb, 0xe0, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0x09,
-	0xbe, 0x0d, 0xe2, 0x07, 0x00, 0x00,
+	// 1235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xfa, 0x47, 0x62, 0xbf, 0xa8, 0xa9, 0xbb, 0x49, 0xd3, 0xed, 0xd2, 0xda, 0xab, 0x45,
+	0x82, 0x12, 0x88, 0xad, 0xa6, 0x05, 0xa1, 0x08, 0x24, 0xe2, 0xc4, 0xa9, 0x16, 0xa5, 0x4e, 0xb4,
+	0xde, 0x08, 0x95, 0x8b, 0x59, 0xaf, 0x27, 0xf6, 0xa8, 0xbb, 0x3b, 0x66, 0x77, 0xdc, 0xd4, 0xfc,
+	0x05, 0x95, 0x2f, 0x54, 0xe2, 0xc2, 0xc5, 0x52, 0x25, 0x38, 0xf4, 0xc2, 0xad, 0x67, 0x0e, 0x9c,
+	0x7a, 0xac, 0x7a, 0x42, 0x1c, 0x0a, 0xa4, 0xaa, 0xc4, 0x8d, 0x03, 0xff, 0x00, 0x9a, 0xd9, 0xf5,
+	0x7a, 0xed, 0xb8, 0x29, 0x2a, 0x82, 0xdb, 0x7b, 0x6f, 0xbe, 0xef, 0xed, 0x7b, 0xdf, 0xbc, 0x99,
+	0x59, 0x50, 0xed, 0xa6, 0x53, 0xb6, 0x88, 0x6d, 0x23, 0x8b, 0x62, 0xe2, 0x96, 0xef, 0x5c, 0x8d,
+	0x79, 0xa5, 0xae, 0x47, 0x28, 0x11, 0xcf, 0xd9, 0x4d, 0xa7, 0x14, 0x8b, 0xde, 0xb9, 0x2a, 0x2f,
+	0xb7, 0x49, 0x9b, 0xf0, 0xd5, 0x32, 0xb3, 0x02, 0xa0, 0x7c, 0xd1, 0x22, 0xbe, 0x43, 0xfc, 0x46,
+	0xb0, 0x10, 0x38, 0xe1, 0x52, 0xb1, 0x4d, 0x48, 0xdb, 0x46, 0x65, 0xee, 0x35, 0x7b, 0x87, 0x65,
+	0x8a, 0x1d, 0xe4, 0x53, 0xd3, 0xe9, 0x06, 0x00, 0x55, 0x87, 0xb9, 0x7d, 0xd3, 0x33, 0x1d, 0x5f,
+	0x7c, 0x13, 0x16, 0x5a, 0xa8, 0x4b, 0x3b, 0x0d, 0x1b, 0x3b, 0x98, 0x4a, 0x82, 0x22, 0x5c, 0x39,
+	0x53, 0x49, 0x4a, 0x82, 0x0e, 0x3c, 0xbc, 0xcb, 0xa2, 0x0c, 0x74, 0x84, 0x5b, 0x11, 0x28, 0x39,
+	0x06, 0xf1, 0x30, 0x07, 0xa9, 0x06, 0x64, 0xb7, 0x88, 0x4b, 0x3d, 0xd3, 0xa2, 0xe2, 0x22, 0x24,
+	0x71, 0x8b, 0x27, 0xcb, 0xe9, 0x49, 0xdc, 0x12, 0x45, 0x48, 0xbb, 0xa6, 0x83, 0x38, 0x33, 0xa7,
+	0x73, 0x9b, 0xc5, 0x1c, 0x44, 0x4d, 0x29, 0x15, 0xc4, 0x98, 0x2d, 0xe6, 0x21, 0xd5, 0xf3, 0xb0,
+	0x94, 0xe6, 0x21, 0x66, 0xaa, 0x5f, 0x0b, 0x30, 0xbf, 0x63, 0x6c, 0xd9, 0xa6, 0xef, 0xbf, 0x76,
+	0x56, 0x19, 0xb2, 0x2d, 0x64, 0x61, 0xc7, 0xb4, 0x7d, 0x9e, 0x3a, 0xa3, 0x47, 0x3e, 0x5b, 0x73,
+	0xb0, 0x4b, 0xcd, 0xa6, 0x8d, 0xa4, 0x8c, 0x22, 0x5c, 0xc9, 0xea, 0x91, 0xbf, 0xb1, 0x7c, 0xef,
+	0x41, 0x51, 0x78, 0xfa, 0x68, 0x0d, 0x0c, 0x72, 0x1b, 0xb9, 0xbc, 0x06, 0x49, 0x50, 0x5f, 0x08,
+	0x90, 0xad, 0xfd, 0xdb, 0x92, 0xae, 0xc3, 0xbc, 0x47, 0xfa, 0xa6, 0x4d, 0xfb, 0xbc, 0xa2, 0x85,
+	0x75, 0xb9, 0x74, 0x62, 0xdf, 0x4b, 0x7a, 0x80, 0xd0, 0x47, 0x50, 0xf1, 0x33, 0xc8, 0x9b, 0x94,
+	0x7a, 0xb8, 0xd9, 0xa3, 0xa8, 0xe1, 0x5b, 0x1d, 0xe4, 0x98, 0x52, 0x46, 0x49, 0x5d, 0x59, 0x58,
+	0x7f, 0x6b, 0x06, 0x7d, 0x73, 0x04, 0xdd, 0x46, 0x87, 0xd8, 0xc5, 0x2c, 0x5c, 0x49, 0x3f, 0x7e,
+	0x56, 0x4c, 0xe8, 0x67, 0xa3, 0x2c, 0x75, 0x9e, 0x64, 0x43, 0x3c, 0xd9, 0xa9, 0xfa, 0x93, 0x00,
+	0xa9, 0xda, 0x8e, 0x21, 0x5e, 0x84, 0x2c, 0x65, 0xd1, 0x46, 0xd4, 0xe8, 0x3c, 0xf7, 0xb5, 0xff,
+	0xba, 0xdb, 0x0a, 0x40, 0x54, 0xa7, 0x1f, 0xf6, 0x79, 0xe9, 0xb4, 0x3e, 0xc3, 0xee, 0x62, 0x2c,
+	0x95, 0xc0, 0x7c, 0x98, 0x57, 0xbc, 0x04, 0x39, 0x0f, 0x59, 0xb8, 0x8b, 0x91, 0x4b, 0xc3, 0x46,
+	0xc6, 0x01, 0x71, 0x07, 0xd2, 0x9e, 0x49, 0xc3, 0x56, 0x2a, 0xeb, 0x2c, 0xd1, 0x2f, 0xcf, 0x8a,
+	0xab, 0x6d, 0x4c, 0x3b, 0xbd, 0x66, 0xc9, 0x22, 0x4e, 0x79, 0x07, 0xbb, 0xbe, 0xd5, 0xc1, 0x66,
+	0xf9, 0x30, 0x34, 0xd6, 0xfc, 0xd6, 0xed, 0x32, 0xed, 0x77, 0x91, 0x5f, 0xda, 0x46, 0x96, 0xce,
+	0xf9, 0x6c, 0x5e, 0xb3, 0x7b, 0x47, 0x2e, 0xf2, 0x98, 0x74, 0x45, 0x58, 0xb0, 0xc2, 0x23, 0x31,
+	0x56, 0x0f, 0x46, 0x21, 0xad, 0x35, 0xa1, 0x6d, 0x72, 0xb6, 0xb6, 0xa9, 0x19, 0xda, 0xa6, 0x63,
+	0xda, 0x2e, 0x43, 0x86, 0xb0, 0xef, 0xf1, 0xe9, 0xcd, 0xe9, 0x81, 0xb3, 0x91, 0x7b, 0xfa, 0x68,
+	0x2d, 0xc3, 0x37, 0x53, 0xfd, 0x41, 0x80, 0xe4, 0xff, 0x54, 0x4b, 0xfc, 0xa0, 0x65, 0x4e, 0x39,
+	0x68, 0x73, 0x53, 0x07, 0x6d, 0x21, 0xaa, 0x56, 0x12, 0x54, 0x1f, 0x72, 0xdc, 0x34, 0xfa, 0x5d,
+	0xf4, 0xea, 0xaa, 0x2f, 0x03, 0x04, 0x55, 0xb3, 0x8d, 0x08, 0xeb, 0xce, 0xd1, 0x88, 0xff, 0x0f,
+	0x2b, 0x57, 0x8f, 0x20, 0xbd, 0x45, 0xb0, 0x7b, 0xda, 0xb0, 0x7f, 0x0a, 0x73, 0xa6, 0x43, 0x7a,
+	0x2e, 0x7d, 0xcd, 0x19, 0xd1, 0x5c, 0xaa, 0x87, 0x19, 0x36, 0xb2, 0xdf, 0x3e, 0x28, 0x26, 0xfe,
+	0x78, 0x50, 0x14, 0xd4, 0x2f, 0x20, 0x73, 0xc3, 0x33, 0x5d, 0x2a, 0x4a, 0x30, 0xdf, 0x66, 0x06,
+	0x42, 0xa3, 0x0f, 0x87, 0xae, 0xf8, 0x31, 0x40, 0x17, 0x79, 0x0e, 0xf6, 0x7d, 0x4c, 0x5c, 0xfe,
+	0xf1, 0xc5, 0xf5, 0xcb, 0x33, 0xce, 0xc1, 0x7e, 0x04, 0xd2, 0x63, 0x04, 0x75, 0x0b, 0xce, 0x6c,
+	0xf6, 0x68, 0x87, 0x78, 0xf8, 0x2b, 0x93, 0x41, 0xc5, 0x15, 0x98, 0xeb, 0x10, 0xbb, 0x85, 0xbc,
+	0xf0, 0x43, 0xa1, 0xc7, 0x76, 0x88, 0x74, 0x91, 0x67, 0x52, 0xe2, 0x85, 0x42, 0x46, 0xbe, 0xfa,
+	0xa7, 0x00, 0xb9, 0x4d, 0xdb, 0x26, 0x47, 0xa6, 0x6b, 0xa1, 0x97, 0x66, 0x90, 0x60, 0xde, 0xef,
+	0x22, 0xb7, 0x85, 0x46, 0x09, 0x46, 0xee, 0x84, 0xae, 0xa9, 0x49, 0x5d, 0xeb, 0xb0, 0xc0, 0x51,
+	0xe1, 0xe3, 0x92, 0x7e, 0x6d, 0x71, 0x81, 0xa7, 0x09, 0x5e, 0xac, 0x4f, 0x00, 0xd0, 0xdd, 0x2e,
+	0xf6, 0x78, 0xc7, 0x52, 0x26, 0xbc, 0x74, 0x82, 0x67, 0xb1, 0x34, 0x7a, 0x16, 0x4b, 0xc6, 0xe8,
+	0x59, 0xac, 0xa4, 0xef, 0xff, 0x5a, 0x14, 0xf4, 0x18, 0x47, 0xbd, 0x06, 0xb9, 0xe8, 0x62, 0x61,
+	0xef, 0xd2, 0x6d, 0xd4, 0x0f, 0xbb, 0x65, 0x26, 0x3b, 0x76, 0x77, 0x4c, 0xbb, 0x37, 0x1a, 0xb9,
+	0xc0, 0x51, 0xbf, 0x11, 0x60, 0x69, 0xc6, 0xb5, 0x3b, 0x83, 0x7f, 0x1d, 0xd2, 0xd1, 0xc4, 0x2e,
+	0xae, 0x2b, 0xa7, 0x5d, 0x6b, 0x6c, 0x90, 0x75, 0x8e, 0x66, 0x5b, 0xe4, 0xa1, 0x2f, 0x7b, 0xd8,
+	0x43, 0x81, 0x8c, 0x59, 0x3d, 0xf2, 0x99, 0xf8, 0x4e, 0x2f, 0x38, 0x5f, 0x69, 0xbe, 0x34, 0x72,
+	0x57, 0xff, 0x12, 0x00, 0xc6, 0xc3, 0x21, 0xbe, 0x0f, 0x2b, 0xfb, 0x55, 0xfd, 0xa6, 0x56, 0xaf,
+	0x6b, 0x7b, 0xb5, 0xc6, 0x41, 0xad, 0xbe, 0x5f, 0xdd, 0xd2, 0x76, 0xb4, 0xea, 0x76, 0x3e, 0x21,
+	0x5f, 0x1c, 0x0c, 0x95, 0xf3, 0x63, 0xec, 0x81, 0xeb, 0x77, 0x91, 0x85, 0x0f, 0x31, 0x6a, 0x89,
+	0xef, 0x40, 0x3e, 0x46, 0xd3, 0xea, 0xf5, 0x83, 0x6a, 0x5e, 0x90, 0x97, 0x06, 0x43, 0xe5, 0xec,
+	0x98, 0xa0, 0xf9, 0x7e, 0x0f, 0x89, 0xef, 0xc2, 0xb9, 0x18, 0xf4, 0xe6, 0xde, 0xb6, 0xb6, 0x73,
+	0x2b, 0x9f, 0x94, 0x97, 0x07, 0x43, 0x25, 0x3f, 0xc6, 0xde, 0x24, 0x2d, 0x7c, 0xd8, 0x17, 0xdf,
+	0x86, 0xb3, 0x71, 0xb0, 0x56, 0x33, 0xf2, 0x29, 0x59, 0x1c, 0x0c, 0x95, 0xc5, 0x18, 0x14, 0xbb,
+	0x74, 0x0a, 0x58, 0x39, 0xd0, 0x6b, 0xf9, 0xf4, 0x34, 0xb0, 0xd2, 0xf3, 0x5c, 0x39, 0x7d, 0xef,
+	0xbb, 0x42, 0x62, 0xf5, 0xc7, 0x24, 0xe4, 0x77, 0x51, 0xdb, 0xb4, 0xfa, 0xb1, 0xde, 0x2b, 0x70,
+	0x79, 0xb7, 0x7a, 0x63, 0x73, 0xeb, 0x56, 0xe3, 0xa5, 0x12, 0x14, 0x07, 0x43, 0xe5, 0x8d, 0x69,
+	0x62, 0x5c, 0x88, 0x0f, 0xe0, 0xc2, 0xc9, 0x1c, 0x23, 0x3d, 0xb8, 0x80, 0xd3, 0xec, 0x40, 0x95,
+	0x0f, 0x41, 0x3a, 0xc9, 0x8b, 0xc4, 0x91, 0x07, 0x43, 0x65, 0x65, 0x9a, 0x18, 0x4a, 0x74, 0x1d,
+	0x56, 0x66, 0x30, 0x03, 0xa5, 0xa4, 0xc1, 0x50, 0x59, 0x3e, 0xc1, 0x63, 0x7a, 0xcd, 0x64, 0x85,
+	0xb2, 0xcd, 0x64, 0x71, 0xf1, 0xb2, 0x4c, 0xbc, 0x87, 0xdf, 0x17, 0x12, 0xab, 0x2f, 0x04, 0x38,
+	0x33, 0x31, 0x84, 0xe2, 0x47, 0x20, 0x6f, 0x1a, 0x86, 0xae, 0x55, 0x0e, 0x8c, 0x6a, 0xc3, 0xb8,
+	0xb5, 0x5f, 0x9d, 0x92, 0xee, 0xd2, 0x60, 0xa8, 0x48, 0x13, 0x94, 0xb8, 0x6e, 0xeb, 0x70, 0x7e,
+	0x8a, 0x5d, 0x37, 0x74, 0xad, 0x76, 0x23, 0x2f, 0xc8, 0x17, 0x06, 0x43, 0x65, 0x69, 0x82, 0x58,
+	0xa7, 0x1e, 0x76, 0xdb, 0xe2, 0x7b, 0x20, 0x4e, 0x71, 0x58, 0xd7, 0xe1, 0x28, 0x4d, 0x10, 0x34,
+	0x97, 0x8a, 0x25, 0x58, 0x9a, 0x42, 0x57, 0xf6, 0xf6, 0x76, 0xf3, 0x29, 0xf9, 0xfc, 0x60, 0xa8,
+	0x9c, 0x9b, 0x80, 0x57, 0x08, 0xb1, 0x83, 0x41, 0xa9, 0xec, 0x3d, 0xfe, 0xbd, 0x90, 0x78, 0x78,
+	0x5c, 0x48, 0x3c, 0x3e, 0x2e, 0x08, 0x4f, 0x8e, 0x0b, 0xc2, 0x6f, 0xc7, 0x05, 0xe1, 0xfe, 0xf3,
+	0x42, 0xe2, 0xc9, 0xf3, 0x42, 0xe2, 0xe7, 0xe7, 0x85, 0xc4, 0xe7, 0x6b, 0xaf, 0xbc, 0x85, 0xee,
+	0xc6, 0x7e, 0xe4, 0x9b, 0x73, 0xfc, 0x82, 0xb9, 0xf6, 0xf7, 0x00, 0x98, 0xab, 0xf8, 0xf0, 0xef,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -976,6 +1201,32 @@ func (m *NFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1020,6 +1271,32 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1044,6 +1321,46 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OwnerNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1331,6 +1648,70 @@ func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintCollection(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Attribute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1368,6 +1749,61 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttributeDefinition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeDefinition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mutable {
+		i--
+		if m.Mutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCollection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCollection(v)
 	base := offset
@@ -1464,6 +1900,16 @@ func (m *NFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1485,20 +1931,45 @@ func (m *NFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *OwnerNFT) Size() (n int) {
+func (m *Royalty) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractId)
+	l = len(m.Recipient)
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
-	l = len(m.TokenId)
+	l = m.Rate.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
+func (m *OwnerNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.TokenId)
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
@@ -1621,6 +2092,33 @@ func (m *Authorization) Size() (n int) {
 	return n
 }
 
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	return n
+}
+
 func (m *Attribute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1638,6 +2136,28 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
+func (m *AttributeDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovCollection(uint64(m.Type))
+	}
+	if m.Required {
+		n += 2
+	}
+	if m.Mutable {
+		n += 2
+	}
+	return n
+}
+
 func sovCollection(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2220,61 +2740,11 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCollection(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCollection
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NFT) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCollection
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2284,61 +2754,33 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCollection
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCollection
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCollection
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2348,23 +2790,25 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.AttributeSchema = append(m.AttributeSchema, AttributeDefinition{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2387,7 +2831,7 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
+func (m *NFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2410,15 +2854,15 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnerNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnerNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2446,11 +2890,11 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2478,11 +2922,11 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2510,13 +2954,13 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2526,29 +2970,33 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2558,23 +3006,25 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2597,7 +3047,7 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FT) Unmarshal(dAtA []byte) error {
+func (m *Royalty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2620,15 +3070,15 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FT: wiretype end group for non-group")
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2656,11 +3106,11 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2688,11 +3138,63 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2720,11 +3222,11 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2752,13 +3254,13 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Decimals = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2768,16 +3270,29 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2787,12 +3302,56 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Mintable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2814,7 +3373,7 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenType) Unmarshal(dAtA []byte) error {
+func (m *FT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2837,10 +3396,10 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenType: wiretype end group for non-group")
+			return fmt.Errorf("proto: FT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2877,7 +3436,7 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2905,7 +3464,7 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2971,6 +3530,45 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2992,7 +3590,7 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Coin) Unmarshal(dAtA []byte) error {
+func (m *TokenType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,15 +3613,15 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Coin: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Coin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3051,11 +3649,11 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3083,63 +3681,11 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCollection(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCollection
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Grant) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCollection
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3167,13 +3713,13 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
-			m.Permission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -3183,11 +3729,24 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= Permission(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -3209,7 +3768,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Authorization) Unmarshal(dAtA []byte) error {
+func (m *Coin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,15 +3791,15 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: Coin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Coin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3268,11 +3827,11 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3300,7 +3859,9 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3323,7 +3884,7 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3346,15 +3907,15 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,7 +3943,438 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Authorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3437,6 +4429,147 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttributeDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mutable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCollection(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
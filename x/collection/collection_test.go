@@ -63,20 +63,55 @@ func TestFTClass(t *testing.T) {
 
 func TestNFTClass(t *testing.T) {
 	nextIDs := collection.DefaultNextClassIDs(TestContractID)
+	recipient := sdk.AccAddress("recipient").String()
 	testCases := map[string]struct {
-		name  string
-		meta  string
-		valid bool
+		name    string
+		meta    string
+		royalty *collection.Royalty
+		schema  []collection.AttributeDefinition
+		valid   bool
 	}{
 		"valid class": {
 			valid: true,
 		},
+		"valid class with royalty": {
+			royalty: &collection.Royalty{Recipient: recipient, Rate: sdk.OneDec()},
+			valid:   true,
+		},
 		"invalid name": {
 			name: string(make([]rune, 21)),
 		},
 		"invalid meta": {
 			meta: string(make([]rune, 1001)),
 		},
+		"invalid royalty recipient": {
+			royalty: &collection.Royalty{Rate: sdk.NewDecWithPrec(1, 1)},
+		},
+		"invalid royalty rate": {
+			royalty: &collection.Royalty{Recipient: recipient, Rate: sdk.ZeroDec()},
+		},
+		"valid class with attribute schema": {
+			schema: []collection.AttributeDefinition{
+				{Key: "level", Type: collection.AttributeTypeInt, Required: true, Mutable: true},
+				{Key: "rare", Type: collection.AttributeTypeBool},
+			},
+			valid: true,
+		},
+		"attribute of invalid key": {
+			schema: []collection.AttributeDefinition{{Key: "Level", Type: collection.AttributeTypeInt}},
+		},
+		"attribute of built-in key": {
+			schema: []collection.AttributeDefinition{{Key: collection.AttributeKeyName.String(), Type: collection.AttributeTypeString}},
+		},
+		"attribute of unspecified type": {
+			schema: []collection.AttributeDefinition{{Key: "level"}},
+		},
+		"duplicate attributes": {
+			schema: []collection.AttributeDefinition{
+				{Key: "level", Type: collection.AttributeTypeInt},
+				{Key: "level", Type: collection.AttributeTypeString},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -85,6 +120,8 @@ func TestNFTClass(t *testing.T) {
 			class.SetId(&nextIDs)
 			class.SetName(tc.name)
 			class.SetMeta(tc.meta)
+			class.Royalty = tc.royalty
+			class.AttributeSchema = tc.schema
 
 			err := class.ValidateBasic()
 			if !tc.valid {
@@ -96,6 +133,75 @@ func TestNFTClass(t *testing.T) {
 	}
 }
 
+func TestValidateNFTAttributes(t *testing.T) {
+	class := collection.NFTClass{
+		AttributeSchema: []collection.AttributeDefinition{
+			{Key: "level", Type: collection.AttributeTypeInt, Required: true},
+			{Key: "rare", Type: collection.AttributeTypeBool},
+			{Key: "habitat", Type: collection.AttributeTypeString},
+		},
+	}
+
+	testCases := map[string]struct {
+		attributes []collection.Attribute
+		valid      bool
+	}{
+		"valid attributes": {
+			attributes: []collection.Attribute{
+				{Key: "level", Value: "-3"},
+				{Key: "rare", Value: "true"},
+				{Key: "habitat", Value: "desert"},
+			},
+			valid: true,
+		},
+		"missing optional attributes": {
+			attributes: []collection.Attribute{{Key: "level", Value: "3"}},
+			valid:      true,
+		},
+		"missing required attribute": {
+			attributes: []collection.Attribute{{Key: "rare", Value: "false"}},
+		},
+		"undeclared attribute": {
+			attributes: []collection.Attribute{
+				{Key: "level", Value: "3"},
+				{Key: "color", Value: "red"},
+			},
+		},
+		"duplicate attributes": {
+			attributes: []collection.Attribute{
+				{Key: "level", Value: "3"},
+				{Key: "level", Value: "4"},
+			},
+		},
+		"non-canonical integer": {
+			attributes: []collection.Attribute{{Key: "level", Value: "+3"}},
+		},
+		"invalid boolean": {
+			attributes: []collection.Attribute{
+				{Key: "level", Value: "3"},
+				{Key: "rare", Value: "True"},
+			},
+		},
+		"empty value": {
+			attributes: []collection.Attribute{
+				{Key: "level", Value: "3"},
+				{Key: "habitat", Value: ""},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := class.ValidateNFTAttributes(tc.attributes)
+			if !tc.valid {
+				require.ErrorIs(t, err, collection.ErrInvalidAttribute)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestParseCoin(t *testing.T) {
 	testCases := map[string]struct {
 		input    string
@@ -53,4 +53,9 @@ var (
 	ErrCompositionTooDeep            = sdkerrors.Register(collectionCodespace, 45, "cannot attach token (composition too deep)")
 	ErrCompositionTooWide            = sdkerrors.Register(collectionCodespace, 46, "cannot attach token (composition too wide)")
 	ErrBurnNonRootNFT                = sdkerrors.Register(collectionCodespace, 47, "cannot burn non-root NFTs")
+	ErrInsufficientAllowance         = sdkerrors.Register(collectionCodespace, 48, "insufficient allowance")
+	ErrInvalidRoyalty                = sdkerrors.Register(collectionCodespace, 49, "invalid royalty")
+	ErrInvalidAttributeSchema        = sdkerrors.Register(collectionCodespace, 50, "invalid attribute schema")
+	ErrInvalidAttribute              = sdkerrors.Register(collectionCodespace, 51, "invalid attribute")
+	ErrImmutableAttribute            = sdkerrors.Register(collectionCodespace, 52, "attribute is immutable")
 )
@@ -5,17 +5,22 @@ package collection
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -205,6 +210,90 @@ func (m *EventAuthorizedOperator) GetOperator() string {
 	return ""
 }
 
+// EventApproved is emitted when a holder sets an allowance of a spender.
+type EventApproved struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the holder which approved the allowance.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// token id of the fungible token.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// number of tokens the spender can send.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"spend_limit"`
+	// time when the allowance expires.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *EventApproved) Reset()         { *m = EventApproved{} }
+func (m *EventApproved) String() string { return proto.CompactTextString(m) }
+func (*EventApproved) ProtoMessage()    {}
+func (*EventApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{2}
+}
+func (m *EventApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApproved.Merge(m, src)
+}
+func (m *EventApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApproved proto.InternalMessageInfo
+
+func (m *EventApproved) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventApproved) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventApproved) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *EventApproved) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *EventApproved) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // EventRevokedOperator is emitted when an authorization is revoked.
 //
 // Since: 0.46.0 (finschia)
@@ -221,7 +310,7 @@ func (m *EventRevokedOperator) Reset()         { *m = EventRevokedOperator{} }
 func (m *EventRevokedOperator) String() string { return proto.CompactTextString(m) }
 func (*EventRevokedOperator) ProtoMessage()    {}
 func (*EventRevokedOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{2}
+	return fileDescriptor_478cfab12ea1b00e, []int{3}
 }
 func (m *EventRevokedOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +380,7 @@ func (m *EventCreatedContract) Reset()         { *m = EventCreatedContract{} }
 func (m *EventCreatedContract) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedContract) ProtoMessage()    {}
 func (*EventCreatedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{3}
+	return fileDescriptor_478cfab12ea1b00e, []int{4}
 }
 func (m *EventCreatedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +470,7 @@ func (m *EventCreatedFTClass) Reset()         { *m = EventCreatedFTClass{} }
 func (m *EventCreatedFTClass) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedFTClass) ProtoMessage()    {}
 func (*EventCreatedFTClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{4}
+	return fileDescriptor_478cfab12ea1b00e, []int{5}
 }
 func (m *EventCreatedFTClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,13 +563,17 @@ type EventCreatedNFTClass struct {
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	// metadata of the token class.
 	Meta string `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty of the token class.
+	Royalty *Royalty `protobuf:"bytes,6,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// attribute schema of the token class.
+	AttributeSchema []AttributeDefinition `protobuf:"bytes,7,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema"`
 }
 
 func (m *EventCreatedNFTClass) Reset()         { *m = EventCreatedNFTClass{} }
 func (m *EventCreatedNFTClass) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedNFTClass) ProtoMessage()    {}
 func (*EventCreatedNFTClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{5}
+	return fileDescriptor_478cfab12ea1b00e, []int{6}
 }
 func (m *EventCreatedNFTClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,6 +637,20 @@ func (m *EventCreatedNFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *EventCreatedNFTClass) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *EventCreatedNFTClass) GetAttributeSchema() []AttributeDefinition {
+	if m != nil {
+		return m.AttributeSchema
+	}
+	return nil
+}
+
 // EventGranted is emitted when a granter grants its permission to a grantee.
 //
 // Info: `granter` would be empty if the permission is granted by an issuance.
@@ -564,7 +671,7 @@ func (m *EventGranted) Reset()         { *m = EventGranted{} }
 func (m *EventGranted) String() string { return proto.CompactTextString(m) }
 func (*EventGranted) ProtoMessage()    {}
 func (*EventGranted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{6}
+	return fileDescriptor_478cfab12ea1b00e, []int{7}
 }
 func (m *EventGranted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +744,7 @@ func (m *EventRenounced) Reset()         { *m = EventRenounced{} }
 func (m *EventRenounced) String() string { return proto.CompactTextString(m) }
 func (*EventRenounced) ProtoMessage()    {}
 func (*EventRenounced) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{7}
+	return fileDescriptor_478cfab12ea1b00e, []int{8}
 }
 func (m *EventRenounced) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +814,7 @@ func (m *EventMintedFT) Reset()         { *m = EventMintedFT{} }
 func (m *EventMintedFT) String() string { return proto.CompactTextString(m) }
 func (*EventMintedFT) ProtoMessage()    {}
 func (*EventMintedFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{8}
+	return fileDescriptor_478cfab12ea1b00e, []int{9}
 }
 func (m *EventMintedFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +889,7 @@ func (m *EventMintedNFT) Reset()         { *m = EventMintedNFT{} }
 func (m *EventMintedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventMintedNFT) ProtoMessage()    {}
 func (*EventMintedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{9}
+	return fileDescriptor_478cfab12ea1b00e, []int{10}
 }
 func (m *EventMintedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +964,7 @@ func (m *EventBurned) Reset()         { *m = EventBurned{} }
 func (m *EventBurned) String() string { return proto.CompactTextString(m) }
 func (*EventBurned) ProtoMessage()    {}
 func (*EventBurned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{10}
+	return fileDescriptor_478cfab12ea1b00e, []int{11}
 }
 func (m *EventBurned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1039,7 @@ func (m *EventModifiedContract) Reset()         { *m = EventModifiedContract{} }
 func (m *EventModifiedContract) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedContract) ProtoMessage()    {}
 func (*EventModifiedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{11}
+	return fileDescriptor_478cfab12ea1b00e, []int{12}
 }
 func (m *EventModifiedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1111,7 @@ func (m *EventModifiedTokenClass) Reset()         { *m = EventModifiedTokenClass
 func (m *EventModifiedTokenClass) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedTokenClass) ProtoMessage()    {}
 func (*EventModifiedTokenClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{12}
+	return fileDescriptor_478cfab12ea1b00e, []int{13}
 }
 func (m *EventModifiedTokenClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1194,7 @@ func (m *EventModifiedNFT) Reset()         { *m = EventModifiedNFT{} }
 func (m *EventModifiedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedNFT) ProtoMessage()    {}
 func (*EventModifiedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{13}
+	return fileDescriptor_478cfab12ea1b00e, []int{14}
 }
 func (m *EventModifiedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1273,7 @@ func (m *EventAttached) Reset()         { *m = EventAttached{} }
 func (m *EventAttached) String() string { return proto.CompactTextString(m) }
 func (*EventAttached) ProtoMessage()    {}
 func (*EventAttached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{14}
+	return fileDescriptor_478cfab12ea1b00e, []int{15}
 }
 func (m *EventAttached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,7 +1359,7 @@ func (m *EventDetached) Reset()         { *m = EventDetached{} }
 func (m *EventDetached) String() string { return proto.CompactTextString(m) }
 func (*EventDetached) ProtoMessage()    {}
 func (*EventDetached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{15}
+	return fileDescriptor_478cfab12ea1b00e, []int{16}
 }
 func (m *EventDetached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1443,7 @@ func (m *EventOwnerChanged) Reset()         { *m = EventOwnerChanged{} }
 func (m *EventOwnerChanged) String() string { return proto.CompactTextString(m) }
 func (*EventOwnerChanged) ProtoMessage()    {}
 func (*EventOwnerChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{16}
+	return fileDescriptor_478cfab12ea1b00e, []int{17}
 }
 func (m *EventOwnerChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1520,7 @@ func (m *EventRootChanged) Reset()         { *m = EventRootChanged{} }
 func (m *EventRootChanged) String() string { return proto.CompactTextString(m) }
 func (*EventRootChanged) ProtoMessage()    {}
 func (*EventRootChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{17}
+	return fileDescriptor_478cfab12ea1b00e, []int{18}
 }
 func (m *EventRootChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1470,10 +1577,95 @@ func (m *EventRootChanged) GetTo() string {
 	return ""
 }
 
+// EventRoyaltySet is emitted when the royalty of a token class or a non-fungible token is set.
+type EventRoyaltySet struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address which triggered the set.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// token type associated with the token class.
+	// refer to TokenType for the definition.
+	TokenType string `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// token index associated with the non-fungible token.
+	// Note: it is empty if the royalty of the token class has been set.
+	TokenIndex string `protobuf:"bytes,4,opt,name=token_index,json=tokenIndex,proto3" json:"token_index,omitempty"`
+	// royalty set, which is empty on its removal.
+	Royalty *Royalty `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
+}
+
+func (m *EventRoyaltySet) Reset()         { *m = EventRoyaltySet{} }
+func (m *EventRoyaltySet) String() string { return proto.CompactTextString(m) }
+func (*EventRoyaltySet) ProtoMessage()    {}
+func (*EventRoyaltySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{19}
+}
+func (m *EventRoyaltySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRoyaltySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRoyaltySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRoyaltySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRoyaltySet.Merge(m, src)
+}
+func (m *EventRoyaltySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRoyaltySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRoyaltySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRoyaltySet proto.InternalMessageInfo
+
+func (m *EventRoyaltySet) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventRoyaltySet) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventRoyaltySet) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+func (m *EventRoyaltySet) GetTokenIndex() string {
+	if m != nil {
+		return m.TokenIndex
+	}
+	return ""
+}
+
+func (m *EventRoyaltySet) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("lbm.collection.v1.AttributeKey", AttributeKey_name, AttributeKey_value)
 	proto.RegisterType((*EventSent)(nil), "lbm.collection.v1.EventSent")
 	proto.RegisterType((*EventAuthorizedOperator)(nil), "lbm.collection.v1.EventAuthorizedOperator")
+	proto.RegisterType((*EventApproved)(nil), "lbm.collection.v1.EventApproved")
 	proto.RegisterType((*EventRevokedOperator)(nil), "lbm.collection.v1.EventRevokedOperator")
 	proto.RegisterType((*EventCreatedContract)(nil), "lbm.collection.v1.EventCreatedContract")
 	proto.RegisterType((*EventCreatedFTClass)(nil), "lbm.collection.v1.EventCreatedFTClass")
@@ -1490,75 +1682,89 @@ func init() {
 	proto.RegisterType((*EventDetached)(nil), "lbm.collection.v1.EventDetached")
 	proto.RegisterType((*EventOwnerChanged)(nil), "lbm.collection.v1.EventOwnerChanged")
 	proto.RegisterType((*EventRootChanged)(nil), "lbm.collection.v1.EventRootChanged")
+	proto.RegisterType((*EventRoyaltySet)(nil), "lbm.collection.v1.EventRoyaltySet")
 }
 
 func init() { proto.RegisterFile("lbm/collection/v1/event.proto", fileDescriptor_478cfab12ea1b00e) }
 
 var fileDescriptor_478cfab12ea1b00e = []byte{
-	// 998 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0x3d, 0xb6, 0xe3, 0x3f, 0x2f, 0x25, 0xdd, 0x6c, 0x42, 0xb2, 0xdd, 0x12, 0xd7, 0xda,
-	0x0b, 0x51, 0x45, 0x6d, 0xb5, 0xc0, 0x25, 0x82, 0x83, 0xed, 0x3a, 0xd1, 0xaa, 0xb2, 0x1b, 0x6d,
-	0xec, 0x03, 0x5c, 0xac, 0xf5, 0x7a, 0x62, 0x2f, 0xf1, 0xce, 0x58, 0xbb, 0x63, 0x97, 0xf0, 0x09,
-	0x90, 0xb9, 0x20, 0x10, 0x42, 0x42, 0xca, 0x85, 0xf6, 0x50, 0x89, 0x2f, 0xd2, 0x63, 0xb8, 0x71,
-	0x42, 0x28, 0xf9, 0x22, 0x68, 0x67, 0x77, 0x9c, 0x75, 0x6c, 0x91, 0x18, 0xb7, 0x70, 0x9b, 0xf7,
-	0xe6, 0xbd, 0x99, 0xdf, 0x7b, 0xf3, 0xe6, 0xcd, 0x2e, 0xec, 0xf4, 0xdb, 0x4e, 0xd1, 0xa2, 0xfd,
-	0x3e, 0xb6, 0x98, 0x4d, 0x49, 0x71, 0xf4, 0xb8, 0x88, 0x47, 0x98, 0xb0, 0xc2, 0xc0, 0xa5, 0x8c,
-	0xca, 0xeb, 0xfd, 0xb6, 0x53, 0xb8, 0x9a, 0x2e, 0x8c, 0x1e, 0xab, 0x9b, 0x5d, 0xda, 0xa5, 0x7c,
-	0xb6, 0xe8, 0x8f, 0x02, 0x43, 0x55, 0x9b, 0x5d, 0x27, 0xe2, 0xc6, 0x6d, 0xb4, 0x97, 0x08, 0xb2,
-	0x55, 0x7f, 0xf1, 0x23, 0x4c, 0x98, 0xfc, 0x00, 0x56, 0x2d, 0x4a, 0x98, 0x6b, 0x5a, 0xac, 0x65,
-	0x77, 0x14, 0x94, 0x47, 0xbb, 0x59, 0x03, 0x84, 0x4a, 0xef, 0xc8, 0x2a, 0x64, 0xe8, 0x00, 0xbb,
-	0x26, 0xa3, 0xae, 0x12, 0xe7, 0xb3, 0x13, 0x59, 0x96, 0x21, 0x79, 0xec, 0x52, 0x47, 0x49, 0x70,
-	0x3d, 0x1f, 0xcb, 0x6b, 0x10, 0x67, 0x54, 0x49, 0x72, 0x4d, 0x9c, 0x51, 0xf9, 0x53, 0x48, 0x99,
-	0x0e, 0x1d, 0x12, 0xa6, 0xac, 0xe4, 0x13, 0xbb, 0xab, 0x4f, 0xb6, 0x0b, 0x33, 0xc1, 0x14, 0x2a,
-	0xd4, 0x26, 0xe5, 0xe4, 0x9b, 0x3f, 0x1f, 0xc4, 0x8c, 0xd0, 0x58, 0x23, 0xb0, 0xcd, 0x21, 0x4b,
-	0x43, 0xd6, 0xa3, 0xae, 0xfd, 0x0d, 0xee, 0x3c, 0x17, 0xbb, 0xde, 0x88, 0xbc, 0x05, 0xa9, 0x1e,
-	0xed, 0x77, 0xb0, 0x00, 0x0e, 0xa5, 0xa9, 0x50, 0x12, 0xd3, 0xa1, 0x68, 0x27, 0xb0, 0xc9, 0xf7,
-	0x33, 0xf0, 0x88, 0x9e, 0xbc, 0xeb, 0xcd, 0xbe, 0x43, 0xe1, 0x6e, 0x15, 0x17, 0x9b, 0x0c, 0x77,
-	0x2a, 0xe1, 0x72, 0xb2, 0x02, 0x69, 0xcb, 0x57, 0x51, 0x37, 0xdc, 0x49, 0x88, 0xd7, 0x39, 0xe2,
-	0x33, 0x1c, 0x32, 0x24, 0x89, 0xe9, 0x60, 0x71, 0x16, 0xfe, 0xd8, 0xd7, 0x39, 0x98, 0x99, 0xe1,
-	0x69, 0xf0, 0xb1, 0x2c, 0x41, 0x62, 0xe8, 0xda, 0xca, 0x0a, 0x57, 0xf9, 0x43, 0xed, 0x77, 0x04,
-	0x1b, 0x51, 0x9a, 0xfd, 0x46, 0xa5, 0x6f, 0x7a, 0xde, 0x72, 0xa5, 0x71, 0x0f, 0x32, 0x8c, 0x9e,
-	0x60, 0xe2, 0x7b, 0x06, 0x48, 0x69, 0x2e, 0x47, 0x48, 0x93, 0x73, 0x48, 0x57, 0x22, 0xa4, 0x2a,
-	0x64, 0x3a, 0xd8, 0xb2, 0x1d, 0xb3, 0xef, 0x29, 0xa9, 0x3c, 0xda, 0x5d, 0x31, 0x26, 0xb2, 0x3f,
-	0xe7, 0xd8, 0x84, 0x99, 0xed, 0x3e, 0x56, 0xd2, 0x79, 0xb4, 0x9b, 0x31, 0x26, 0xf2, 0x5e, 0x5c,
-	0x41, 0xda, 0x2f, 0xd7, 0x32, 0x5c, 0x7f, 0x2b, 0x41, 0xed, 0x00, 0x04, 0x41, 0xb1, 0xd3, 0x81,
-	0xc8, 0x74, 0x96, 0x6b, 0x1a, 0xa7, 0x03, 0x7c, 0xdb, 0xc0, 0xb4, 0x5f, 0x11, 0xdc, 0xe1, 0x70,
-	0x07, 0xae, 0x49, 0x18, 0xee, 0xdc, 0x0c, 0xa5, 0x40, 0xba, 0xcb, 0x6d, 0x05, 0x93, 0x10, 0xaf,
-	0x66, 0x04, 0x8f, 0x10, 0xe5, 0xcf, 0x01, 0x06, 0xd8, 0x75, 0x6c, 0xcf, 0xb3, 0x29, 0xe1, 0x4c,
-	0x6b, 0x4f, 0x76, 0xe6, 0x5c, 0xbe, 0xc3, 0x89, 0x91, 0x11, 0x71, 0xd0, 0xc6, 0x08, 0xd6, 0xc2,
-	0x1b, 0x41, 0xe8, 0x90, 0x58, 0x0b, 0x61, 0xe2, 0x69, 0xcc, 0xeb, 0x30, 0x89, 0x45, 0x61, 0x7e,
-	0x46, 0xf0, 0x1e, 0x87, 0xa9, 0xd9, 0x84, 0x57, 0xe8, 0x72, 0xe7, 0x18, 0xf4, 0xa8, 0xc4, 0x9c,
-	0x1e, 0x95, 0x5c, 0xa0, 0x47, 0xf1, 0x42, 0xfb, 0x51, 0xa4, 0x29, 0x20, 0xab, 0xbf, 0x6d, 0xb4,
-	0x4f, 0x20, 0xc5, 0x0b, 0xcc, 0x0b, 0xd1, 0xb6, 0xe6, 0xa0, 0xd5, 0xf7, 0x1b, 0x82, 0x2c, 0xb0,
-	0xd5, 0x7e, 0x42, 0xb0, 0xca, 0xa9, 0xca, 0x43, 0x97, 0xdc, 0xe6, 0xe4, 0x16, 0xed, 0xf2, 0xff,
-	0x2e, 0x63, 0xda, 0x0f, 0x08, 0xde, 0x0f, 0xb2, 0x45, 0x3b, 0xf6, 0xb1, 0x1d, 0xe9, 0x7c, 0x4b,
-	0x11, 0x7e, 0x06, 0x69, 0xab, 0x67, 0x92, 0x2e, 0xf6, 0x94, 0x04, 0xc7, 0xf9, 0x60, 0x0e, 0x4e,
-	0x89, 0x31, 0xd7, 0x6e, 0x0f, 0x19, 0x0e, 0x99, 0x84, 0x8b, 0x76, 0x8e, 0xc2, 0xb7, 0x46, 0x40,
-	0x35, 0xfc, 0x24, 0xbe, 0xfb, 0x76, 0x11, 0xa1, 0x4e, 0x2e, 0x4c, 0x2d, 0xdf, 0x87, 0xac, 0xbf,
-	0x6c, 0x8b, 0x77, 0x9c, 0xa0, 0xbb, 0x64, 0x7c, 0x45, 0xdd, 0x74, 0xb0, 0xf6, 0x1a, 0x81, 0x34,
-	0x15, 0xd2, 0xd2, 0x75, 0xf9, 0x0f, 0xfd, 0x7c, 0xa9, 0x38, 0xfc, 0x4e, 0x1d, 0x5c, 0xed, 0x12,
-	0x63, 0xa6, 0xd5, 0x5b, 0xb6, 0x58, 0xaf, 0x9e, 0xe3, 0xc4, 0xd4, 0x73, 0xac, 0x40, 0xda, 0x1b,
-	0xb6, 0xbf, 0xc2, 0x16, 0x0b, 0xdb, 0xb3, 0x10, 0x7d, 0x0f, 0x66, 0xba, 0x5d, 0xcc, 0xc2, 0x2c,
-	0x86, 0x12, 0xbf, 0xdd, 0xbf, 0x09, 0xb8, 0xa7, 0xf8, 0xff, 0x81, 0xfb, 0x10, 0xee, 0x0e, 0x5c,
-	0x3c, 0xb2, 0xe9, 0xd0, 0x6b, 0x0d, 0x4c, 0x17, 0x13, 0x41, 0xb9, 0x26, 0xd4, 0x87, 0x5c, 0xcb,
-	0x69, 0x5f, 0xc0, 0x3a, 0x87, 0x7d, 0xfe, 0x82, 0x60, 0xb7, 0xc2, 0xf3, 0x7b, 0x0b, 0xe0, 0xe8,
-	0xc9, 0xc6, 0x67, 0x5e, 0xea, 0x9b, 0xbe, 0xef, 0xf8, 0xc6, 0xa3, 0xb0, 0xda, 0x0c, 0x4a, 0xd9,
-	0x7f, 0xb8, 0xef, 0xc3, 0x57, 0x71, 0xb8, 0x33, 0x29, 0xac, 0x67, 0xf8, 0x54, 0xde, 0x83, 0x7b,
-	0xa5, 0x46, 0xc3, 0xd0, 0xcb, 0xcd, 0x46, 0xb5, 0xf5, 0xac, 0xfa, 0x45, 0xab, 0x59, 0x3f, 0x3a,
-	0xac, 0x56, 0xf4, 0x7d, 0xbd, 0xfa, 0x54, 0x8a, 0xa9, 0xf7, 0xc7, 0x67, 0xf9, 0xed, 0xa8, 0x43,
-	0x93, 0x78, 0x03, 0x6c, 0xf1, 0x1b, 0x22, 0x7f, 0x04, 0xf2, 0xb4, 0x6f, 0xbd, 0x54, 0xab, 0x4a,
-	0x48, 0xdd, 0x1c, 0x9f, 0xe5, 0xa5, 0xa8, 0x93, 0x7f, 0xc3, 0x66, 0xad, 0x6b, 0xd5, 0x46, 0x49,
-	0x8a, 0xcf, 0x5a, 0xd7, 0xfc, 0x4f, 0x99, 0x3d, 0x50, 0xa7, 0xad, 0xcb, 0xa5, 0xa3, 0x6a, 0x4b,
-	0xaf, 0x1d, 0xb4, 0x9a, 0x86, 0x2e, 0x65, 0x54, 0x75, 0x7c, 0x96, 0xdf, 0x8a, 0x7a, 0x95, 0x4d,
-	0x0f, 0xeb, 0x4e, 0xb7, 0x69, 0xe8, 0xf2, 0x43, 0x58, 0xbf, 0x16, 0x93, 0xa1, 0x4b, 0x9b, 0xea,
-	0xc6, 0xf8, 0x2c, 0x7f, 0x77, 0x2a, 0x16, 0x43, 0x57, 0x33, 0xdf, 0xbe, 0xcc, 0xc5, 0x5e, 0xbf,
-	0xca, 0xc5, 0xb4, 0x64, 0x26, 0x21, 0xa5, 0xb5, 0x64, 0x26, 0x2b, 0x6d, 0x94, 0x0f, 0xde, 0x5c,
-	0xe4, 0xd0, 0xf9, 0x45, 0x0e, 0xfd, 0x75, 0x91, 0x43, 0xdf, 0x5f, 0xe6, 0x62, 0xe7, 0x97, 0xb9,
-	0xd8, 0x1f, 0x97, 0xb9, 0xd8, 0x97, 0x8f, 0xba, 0x36, 0xeb, 0x0d, 0xdb, 0x05, 0x8b, 0x3a, 0xc5,
-	0x7d, 0x9b, 0x78, 0x56, 0xcf, 0x36, 0x8b, 0xc7, 0xe1, 0xe0, 0x91, 0xd7, 0x39, 0x29, 0x7e, 0x1d,
-	0xf9, 0x81, 0x68, 0xa7, 0xf8, 0x1f, 0xc4, 0xc7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xab, 0x08,
-	0x5b, 0x3e, 0xaf, 0x0c, 0x00, 0x00,
+	// 1208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0x25, 0xd9, 0x92, 0x46, 0x79, 0x6d, 0x85, 0xf1, 0x1b, 0x33, 0x4c, 0x23, 0x09, 0x3c,
+	0xb4, 0x46, 0xd0, 0x48, 0x88, 0x9b, 0x5e, 0x82, 0x16, 0xa8, 0xa4, 0xc8, 0x01, 0x91, 0x5a, 0x09,
+	0x28, 0x19, 0x45, 0x7b, 0x11, 0x28, 0x72, 0x2d, 0xb1, 0x16, 0x77, 0x09, 0x72, 0xa5, 0xc4, 0xfd,
+	0x05, 0x85, 0x7b, 0x09, 0xfa, 0x81, 0x02, 0x05, 0x7c, 0x69, 0x72, 0x48, 0xd1, 0xbf, 0xd1, 0x43,
+	0x8e, 0xe9, 0xad, 0xe8, 0x21, 0x2d, 0x92, 0x3f, 0x52, 0xec, 0x92, 0xab, 0x50, 0x91, 0xe0, 0x8f,
+	0x28, 0x6e, 0x6f, 0x3b, 0xb3, 0x33, 0x3b, 0xcf, 0x3c, 0x9c, 0x99, 0x5d, 0xc2, 0x95, 0x61, 0xcf,
+	0xad, 0x5a, 0x64, 0x38, 0x44, 0x16, 0x75, 0x08, 0xae, 0x8e, 0xaf, 0x57, 0xd1, 0x18, 0x61, 0x5a,
+	0xf1, 0x7c, 0x42, 0x89, 0x7c, 0x7e, 0xd8, 0x73, 0x2b, 0xaf, 0xb6, 0x2b, 0xe3, 0xeb, 0xea, 0x5a,
+	0x9f, 0xf4, 0x09, 0xdf, 0xad, 0xb2, 0x55, 0x68, 0xa8, 0x96, 0xfa, 0x84, 0xf4, 0x87, 0xa8, 0xca,
+	0xa5, 0xde, 0x68, 0xb7, 0x4a, 0x1d, 0x17, 0x05, 0xd4, 0x74, 0xbd, 0xc8, 0x40, 0x9b, 0x0d, 0x14,
+	0x3b, 0x97, 0xdb, 0x68, 0x8f, 0x24, 0xc8, 0x35, 0x59, 0xf4, 0x36, 0xc2, 0x54, 0x2e, 0x41, 0xde,
+	0x22, 0x98, 0xfa, 0xa6, 0x45, 0xbb, 0x8e, 0xad, 0x48, 0x65, 0x69, 0x23, 0x67, 0x80, 0x50, 0xe9,
+	0xb6, 0xac, 0x42, 0x96, 0x78, 0xc8, 0x37, 0x29, 0xf1, 0x95, 0x24, 0xdf, 0x9d, 0xc8, 0xb2, 0x0c,
+	0xe9, 0x5d, 0x9f, 0xb8, 0x4a, 0x8a, 0xeb, 0xf9, 0x5a, 0x5e, 0x81, 0x24, 0x25, 0x4a, 0x9a, 0x6b,
+	0x92, 0x94, 0xc8, 0x1f, 0xc2, 0xb2, 0xe9, 0x92, 0x11, 0xa6, 0xca, 0x52, 0x39, 0xb5, 0x91, 0xdf,
+	0x5c, 0xaf, 0xcc, 0x64, 0x5b, 0x69, 0x10, 0x07, 0xd7, 0xd3, 0x4f, 0x9f, 0x97, 0x12, 0x46, 0x64,
+	0xac, 0x61, 0x58, 0xe7, 0x20, 0x6b, 0x23, 0x3a, 0x20, 0xbe, 0xf3, 0x15, 0xb2, 0xef, 0x8a, 0xa8,
+	0xc7, 0x42, 0xbe, 0x08, 0xcb, 0x03, 0x32, 0xb4, 0x91, 0x00, 0x1c, 0x49, 0x53, 0xa9, 0xa4, 0xa6,
+	0x53, 0xd1, 0xbe, 0x4f, 0xc2, 0xff, 0xc2, 0x80, 0x9e, 0xe7, 0x93, 0x31, 0xb2, 0xdf, 0x3c, 0x8c,
+	0x02, 0x99, 0xc0, 0x43, 0xd8, 0x46, 0x22, 0x8a, 0x10, 0xe5, 0x4b, 0x90, 0xa5, 0x64, 0x0f, 0x61,
+	0x76, 0x5e, 0xc8, 0x50, 0x86, 0xcb, 0xba, 0x2d, 0xb7, 0x21, 0xcf, 0xad, 0xba, 0x43, 0xc7, 0x75,
+	0x18, 0x57, 0xd2, 0x46, 0xae, 0xbe, 0xc9, 0x28, 0xf9, 0xf3, 0x79, 0xe9, 0x6a, 0xdf, 0xa1, 0x83,
+	0x51, 0xaf, 0x62, 0x11, 0xb7, 0xba, 0xe5, 0xe0, 0xc0, 0x1a, 0x38, 0x66, 0x75, 0x37, 0x5a, 0x5c,
+	0x0b, 0xec, 0xbd, 0x2a, 0xdd, 0xf7, 0x50, 0x50, 0xd1, 0x31, 0x35, 0x80, 0x1f, 0xf3, 0x29, 0x3b,
+	0x45, 0xfe, 0x04, 0x00, 0x3d, 0xf0, 0x1c, 0xdf, 0x64, 0x44, 0x2b, 0xcb, 0x65, 0x69, 0x23, 0xbf,
+	0xa9, 0x56, 0xc2, 0x22, 0xaa, 0x88, 0x22, 0xaa, 0x74, 0x44, 0x11, 0xd5, 0xd3, 0x0f, 0xff, 0x2a,
+	0x49, 0x46, 0xcc, 0x47, 0xdb, 0x83, 0x35, 0xce, 0x8a, 0x81, 0xc6, 0x64, 0xef, 0xac, 0xbf, 0xc1,
+	0x37, 0x52, 0x14, 0xad, 0xe1, 0x23, 0x93, 0x22, 0xbb, 0x11, 0x1d, 0xc7, 0x18, 0xb5, 0x98, 0x8a,
+	0xf8, 0x51, 0x24, 0x21, 0xbe, 0x8e, 0x23, 0x39, 0x83, 0x43, 0x86, 0x34, 0x36, 0x5d, 0x24, 0x4a,
+	0x94, 0xad, 0x99, 0xce, 0x45, 0xd4, 0x8c, 0x3e, 0x01, 0x5f, 0xcb, 0x05, 0x48, 0x8d, 0x7c, 0x27,
+	0xe4, 0xdd, 0x60, 0x4b, 0xed, 0x77, 0x09, 0x2e, 0xc4, 0xd1, 0x6c, 0x75, 0x1a, 0x43, 0x33, 0x08,
+	0x16, 0xeb, 0x98, 0x78, 0x05, 0xa4, 0xa6, 0x2b, 0x40, 0x20, 0x4d, 0xcf, 0x41, 0xba, 0x14, 0x43,
+	0xaa, 0x42, 0xd6, 0x46, 0x96, 0xe3, 0x9a, 0xc3, 0x80, 0x7f, 0xd2, 0x25, 0x63, 0x22, 0xb3, 0x3d,
+	0xd7, 0xc1, 0xd4, 0xec, 0x0d, 0x91, 0x92, 0x29, 0x4b, 0x1b, 0x59, 0x63, 0x22, 0xdf, 0x4c, 0x2a,
+	0x92, 0xf6, 0x4b, 0x72, 0x9a, 0xe1, 0xd6, 0x5b, 0x49, 0xea, 0x0a, 0x40, 0x98, 0x14, 0xab, 0xc2,
+	0x28, 0xad, 0x1c, 0xd7, 0x74, 0xf6, 0x3d, 0x74, 0xe2, 0xc4, 0x6e, 0x40, 0xc6, 0x27, 0xfb, 0xe6,
+	0x90, 0xee, 0x4f, 0x4a, 0x75, 0x76, 0x54, 0x18, 0xa1, 0x85, 0x21, 0x4c, 0xe5, 0xcf, 0xa0, 0x60,
+	0x52, 0xea, 0x3b, 0xbd, 0x11, 0x45, 0xdd, 0xc0, 0x1a, 0x20, 0xd7, 0x54, 0x32, 0x7c, 0xd2, 0xbc,
+	0x3b, 0xc7, 0xbd, 0x26, 0x4c, 0x6f, 0xa1, 0x5d, 0x07, 0x3b, 0x4c, 0x1d, 0x0d, 0x9e, 0xd5, 0xc9,
+	0x29, 0x6d, 0x7e, 0x88, 0xf6, 0xb3, 0x04, 0xe7, 0x38, 0x57, 0xb7, 0x7d, 0x13, 0xd3, 0x93, 0x0c,
+	0x04, 0x05, 0x32, 0x7d, 0x6e, 0x2b, 0x28, 0x12, 0xe2, 0xab, 0x1d, 0x41, 0x8f, 0x10, 0xe5, 0x8f,
+	0x01, 0x3c, 0xe4, 0xbb, 0x4e, 0x10, 0xb0, 0x16, 0x65, 0x14, 0xad, 0x6c, 0x5e, 0x99, 0x03, 0xfc,
+	0xde, 0xc4, 0xc8, 0x88, 0x39, 0x68, 0x07, 0x12, 0xac, 0x44, 0x0d, 0x8a, 0xc9, 0x08, 0x5b, 0xa7,
+	0x82, 0x89, 0x94, 0xe4, 0x51, 0x60, 0x52, 0xa7, 0x05, 0xf3, 0xa3, 0x14, 0xcd, 0xd0, 0x6d, 0x07,
+	0xf3, 0x86, 0x59, 0xac, 0xac, 0xc2, 0x9b, 0x24, 0x35, 0xe7, 0x26, 0x49, 0x9f, 0xe2, 0x26, 0xe1,
+	0x75, 0xff, 0x9d, 0xa0, 0x29, 0x44, 0xd6, 0x7a, 0xdb, 0xd0, 0x6e, 0xc0, 0x32, 0xaf, 0xf7, 0x20,
+	0x82, 0x76, 0x71, 0x0e, 0xb4, 0xd6, 0x56, 0x47, 0x20, 0x0b, 0x6d, 0xb5, 0x1f, 0x24, 0xc8, 0x73,
+	0x54, 0xf5, 0x91, 0x8f, 0x91, 0xbd, 0x18, 0xa4, 0x79, 0x77, 0xf1, 0x9b, 0x31, 0xa6, 0x7d, 0x2b,
+	0xc1, 0xff, 0x43, 0xb6, 0x88, 0xed, 0xec, 0x3a, 0xb1, 0x41, 0xbc, 0x10, 0xc2, 0x8f, 0x20, 0x63,
+	0x0d, 0x4c, 0xdc, 0x47, 0x81, 0x92, 0xe2, 0x70, 0xde, 0x39, 0xaa, 0x41, 0x23, 0x4c, 0xc2, 0x45,
+	0x7b, 0x26, 0xc1, 0xfa, 0x14, 0xa8, 0x0e, 0x23, 0xf1, 0xec, 0xa7, 0x57, 0x0c, 0x75, 0xfa, 0xd4,
+	0xa8, 0xe5, 0xcb, 0x90, 0x63, 0xc7, 0x76, 0xf9, 0x00, 0x0c, 0x87, 0x5d, 0x96, 0x29, 0x5a, 0xa6,
+	0x8b, 0xb4, 0x27, 0x12, 0x14, 0xa6, 0x52, 0x5a, 0xb8, 0x2e, 0x8f, 0xb8, 0x5e, 0x16, 0xca, 0x43,
+	0xfb, 0x49, 0xb4, 0x76, 0x8d, 0x52, 0xd3, 0x1a, 0x2c, 0x5a, 0xac, 0xaf, 0x5e, 0x07, 0xa9, 0x99,
+	0xa7, 0xd3, 0xa8, 0xf7, 0x25, 0xb2, 0xa8, 0x78, 0x1f, 0x45, 0x22, 0xf3, 0xa0, 0xa6, 0xdf, 0x47,
+	0xd1, 0xd3, 0xc8, 0x88, 0x24, 0xde, 0xdd, 0xbf, 0x0a, 0x70, 0xb7, 0xd0, 0x7f, 0x03, 0xee, 0x3d,
+	0x58, 0xf5, 0x7c, 0x34, 0x76, 0xc8, 0x28, 0xe8, 0x7a, 0xa6, 0x8f, 0xb0, 0x40, 0xb9, 0x22, 0xd4,
+	0xf7, 0xb8, 0x96, 0xa3, 0xbd, 0x0f, 0xe7, 0x39, 0xd8, 0xbb, 0xf7, 0x31, 0xf2, 0x1b, 0x9c, 0xdf,
+	0x13, 0x00, 0x8e, 0x7f, 0xd9, 0xe4, 0xcc, 0xc3, 0xe1, 0xb8, 0x57, 0x38, 0x0f, 0x3c, 0x8e, 0xaa,
+	0xcd, 0x20, 0x84, 0xfe, 0x9b, 0x71, 0x7f, 0x93, 0x60, 0x35, 0x0a, 0xcc, 0xaf, 0xec, 0x36, 0xa2,
+	0x67, 0xda, 0xb1, 0x25, 0xc8, 0x47, 0x90, 0xb1, 0x8d, 0x1e, 0x44, 0x60, 0x42, 0x0f, 0x9d, 0x69,
+	0xe2, 0x0f, 0x8d, 0xa5, 0x13, 0x3f, 0x34, 0xae, 0x3e, 0x4e, 0xc2, 0xb9, 0x49, 0x7f, 0xdc, 0x41,
+	0xfb, 0xf2, 0x4d, 0xb8, 0x54, 0xeb, 0x74, 0x0c, 0xbd, 0xbe, 0xd3, 0x69, 0x76, 0xef, 0x34, 0x3f,
+	0xef, 0xee, 0xb4, 0xda, 0xf7, 0x9a, 0x0d, 0x7d, 0x4b, 0x6f, 0xde, 0x2a, 0x24, 0xd4, 0xcb, 0x07,
+	0x87, 0xe5, 0xf5, 0xb8, 0xc3, 0x0e, 0x0e, 0x3c, 0x64, 0xf1, 0x46, 0x97, 0xdf, 0x07, 0x79, 0xda,
+	0xb7, 0x55, 0xdb, 0x6e, 0x16, 0x24, 0x75, 0xed, 0xe0, 0xb0, 0x5c, 0x88, 0x3b, 0xb1, 0x41, 0x31,
+	0x6b, 0xbd, 0xdd, 0xec, 0xd4, 0x0a, 0xc9, 0x59, 0xeb, 0x6d, 0xf6, 0x8e, 0xba, 0x09, 0xea, 0xb4,
+	0x75, 0xbd, 0xd6, 0x6e, 0x76, 0xf5, 0xed, 0xdb, 0xdd, 0x1d, 0x43, 0x2f, 0x64, 0x55, 0xf5, 0xe0,
+	0xb0, 0x7c, 0x31, 0xee, 0x55, 0x37, 0x03, 0xa4, 0xbb, 0xfd, 0x1d, 0x43, 0x97, 0xaf, 0xc2, 0xf9,
+	0xd7, 0x72, 0x32, 0xf4, 0xc2, 0x9a, 0x7a, 0xe1, 0xe0, 0xb0, 0xbc, 0x3a, 0x95, 0x8b, 0xa1, 0xab,
+	0xd9, 0xaf, 0x1f, 0x15, 0x13, 0x4f, 0x1e, 0x17, 0x13, 0x5a, 0x3a, 0x9b, 0x2a, 0x64, 0xb4, 0x74,
+	0x36, 0x57, 0xb8, 0x50, 0xbf, 0xfd, 0xf4, 0x45, 0x51, 0x7a, 0xf6, 0xa2, 0x28, 0xfd, 0xfd, 0xa2,
+	0x28, 0x3d, 0x7c, 0x59, 0x4c, 0x3c, 0x7b, 0x59, 0x4c, 0xfc, 0xf1, 0xb2, 0x98, 0xf8, 0xe2, 0xda,
+	0xb1, 0x7f, 0x31, 0x0f, 0x62, 0x7f, 0xab, 0xbd, 0x65, 0xfe, 0x83, 0xf2, 0xc1, 0x3f, 0x03, 0x00,
+	0x28, 0x11, 0x8a, 0x9e, 0x3d, 0x0f, 0x00, 0x00,
 }
 
 func (m *EventSent) Marshal() (dAtA []byte, err error) {
@@ -1670,6 +1876,77 @@ func (m *EventAuthorizedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventRevokedOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1865,6 +2142,32 @@ func (m *EventCreatedNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -2560,37 +2863,100 @@ func (m *EventRootChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventRoyaltySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventSent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventRoyaltySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRoyaltySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.TokenIndex) > 0 {
+		i -= len(m.TokenIndex)
+		copy(dAtA[i:], m.TokenIndex)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenIndex)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.To)
-	if l > 0 {
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
 	if len(m.Amount) > 0 {
@@ -2623,6 +2989,37 @@ func (m *EventAuthorizedOperator) Size() (n int) {
 	return n
 }
 
+func (m *EventApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventRevokedOperator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2734,6 +3131,16 @@ func (m *EventCreatedNFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3051,6 +3458,35 @@ func (m *EventRootChanged) Size() (n int) {
 	return n
 }
 
+func (m *EventRoyaltySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenIndex)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3415,6 +3851,254 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4051,7 +4735,71 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4079,11 +4827,11 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4111,11 +4859,11 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4143,13 +4891,13 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4159,29 +4907,33 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4191,23 +4943,25 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.AttributeSchema = append(m.AttributeSchema, AttributeDefinition{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6384,6 +7138,220 @@ func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRoyaltySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRoyaltySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRoyaltySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -110,6 +110,12 @@ func ValidateGenesis(data GenesisState) error {
 			if err := validateMeta(token.Meta); err != nil {
 				return err
 			}
+			if err := ValidateRoyalty(token.Royalty); err != nil {
+				return err
+			}
+			if err := ValidateAttributes(token.Attributes); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -149,6 +155,30 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, contractAllowances := range data.Allowances {
+		if err := ValidateContractID(contractAllowances.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractAllowances.Allowances) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowances cannot be empty")
+		}
+		for _, allowance := range contractAllowances.Allowances {
+			if _, err := sdk.AccAddressFromBech32(allowance.Holder); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(allowance.Spender); err != nil {
+				return err
+			}
+			if err := ValidateFTID(allowance.TokenId); err != nil {
+				return err
+			}
+			if err := validateAmount(allowance.SpendLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, contractGrants := range data.Grants {
 		if err := ValidateContractID(contractGrants.ContractId); err != nil {
 			return err
@@ -52,6 +52,8 @@ type GenesisState struct {
 	Supplies []ContractStatistics `protobuf:"bytes,11,rep,name=supplies,proto3" json:"supplies"`
 	// burnts represents the total amount of burnt tokens.
 	Burnts []ContractStatistics `protobuf:"bytes,12,rep,name=burnts,proto3" json:"burnts"`
+	// allowances defines the allowance information.
+	Allowances []ContractAllowances `protobuf:"bytes,13,rep,name=allowances,proto3" json:"allowances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -172,6 +174,13 @@ func (m *GenesisState) GetBurnts() []ContractStatistics {
 	return nil
 }
 
+func (m *GenesisState) GetAllowances() []ContractAllowances {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // ContractBalances defines balances belong to a contract.
 // genesis state.
 type ContractBalances struct {
@@ -549,6 +558,61 @@ func (m *ContractAuthorizations) GetAuthorizations() []Authorization {
 	return nil
 }
 
+// ContractAllowances defines allowances belong to a contract.
+type ContractAllowances struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// allowances
+	Allowances []Allowance `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *ContractAllowances) Reset()         { *m = ContractAllowances{} }
+func (m *ContractAllowances) String() string { return proto.CompactTextString(m) }
+func (*ContractAllowances) ProtoMessage()    {}
+func (*ContractAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{8}
+}
+func (m *ContractAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAllowances.Merge(m, src)
+}
+func (m *ContractAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAllowances proto.InternalMessageInfo
+
+func (m *ContractAllowances) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractAllowances) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // ContractGrant defines grants belong to a contract.
 type ContractGrants struct {
 	// contract id associated with the contract.
@@ -561,7 +625,7 @@ func (m *ContractGrants) Reset()         { *m = ContractGrants{} }
 func (m *ContractGrants) String() string { return proto.CompactTextString(m) }
 func (*ContractGrants) ProtoMessage()    {}
 func (*ContractGrants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{8}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
 }
 func (m *ContractGrants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +682,7 @@ func (m *NextClassIDs) Reset()         { *m = NextClassIDs{} }
 func (m *NextClassIDs) String() string { return proto.CompactTextString(m) }
 func (*NextClassIDs) ProtoMessage()    {}
 func (*NextClassIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
 }
 func (m *NextClassIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +728,7 @@ func (m *ContractNextTokenIDs) Reset()         { *m = ContractNextTokenIDs{} }
 func (m *ContractNextTokenIDs) String() string { return proto.CompactTextString(m) }
 func (*ContractNextTokenIDs) ProtoMessage()    {}
 func (*ContractNextTokenIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
 }
 func (m *ContractNextTokenIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +783,7 @@ func (m *NextTokenID) Reset()         { *m = NextTokenID{} }
 func (m *NextTokenID) String() string { return proto.CompactTextString(m) }
 func (*NextTokenID) ProtoMessage()    {}
 func (*NextTokenID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
 }
 func (m *NextTokenID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +833,7 @@ func (m *ContractTokenRelations) Reset()         { *m = ContractTokenRelations{}
 func (m *ContractTokenRelations) String() string { return proto.CompactTextString(m) }
 func (*ContractTokenRelations) ProtoMessage()    {}
 func (*ContractTokenRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
 }
 func (m *ContractTokenRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,7 +890,7 @@ func (m *TokenRelation) Reset()         { *m = TokenRelation{} }
 func (m *TokenRelation) String() string { return proto.CompactTextString(m) }
 func (*TokenRelation) ProtoMessage()    {}
 func (*TokenRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{14}
 }
 func (m *TokenRelation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +942,7 @@ func init() {
 	proto.RegisterType((*ContractClasses)(nil), "lbm.collection.v1.ContractClasses")
 	proto.RegisterType((*ContractNFTs)(nil), "lbm.collection.v1.ContractNFTs")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.collection.v1.ContractAuthorizations")
+	proto.RegisterType((*ContractAllowances)(nil), "lbm.collection.v1.ContractAllowances")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.collection.v1.ContractGrants")
 	proto.RegisterType((*NextClassIDs)(nil), "lbm.collection.v1.NextClassIDs")
 	proto.RegisterType((*ContractNextTokenIDs)(nil), "lbm.collection.v1.ContractNextTokenIDs")
@@ -889,66 +954,68 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/genesis.proto", fileDescriptor_2b8b3f666cffb1ec) }
 
 var fileDescriptor_2b8b3f666cffb1ec = []byte{
-	// 940 bytes of a gzipped FileDescriptorProto
+	// 974 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x8e, 0xe3, 0x1f, 0xcf, 0x6e, 0x0a, 0xa3, 0xa8, 0x6c, 0x82, 0x64, 0x87, 0x45,
-	0x88, 0x02, 0xca, 0x2e, 0x4d, 0x05, 0x88, 0x0a, 0xa8, 0x62, 0x97, 0x84, 0x50, 0xa9, 0x02, 0x37,
-	0x80, 0xc4, 0xc5, 0x5a, 0xef, 0x8e, 0x9d, 0x51, 0xd7, 0x33, 0x66, 0x67, 0x1c, 0x25, 0xbd, 0x70,
-	0xe0, 0xc4, 0x8d, 0x3f, 0x81, 0x33, 0x67, 0xfe, 0x88, 0x8a, 0x53, 0x8f, 0xa8, 0x87, 0x82, 0x12,
-	0x21, 0xf1, 0x67, 0xa0, 0xf9, 0xb1, 0x9b, 0xb5, 0xbd, 0xf5, 0xd2, 0xdc, 0x76, 0x77, 0xde, 0xf7,
-	0xf3, 0x7d, 0x33, 0x7e, 0xef, 0x8d, 0xa1, 0x1d, 0x0d, 0xc6, 0x5e, 0xc0, 0xa2, 0x08, 0x07, 0x82,
-	0x30, 0xea, 0x9d, 0xdc, 0xf2, 0x46, 0x98, 0x62, 0x4e, 0xb8, 0x3b, 0x89, 0x99, 0x60, 0xe8, 0xd5,
-	0x68, 0x30, 0x76, 0x2f, 0x03, 0xdc, 0x93, 0x5b, 0x5b, 0x9b, 0x23, 0xc6, 0x46, 0x11, 0xf6, 0x54,
-	0xc0, 0x60, 0x3a, 0xf4, 0x7c, 0x7a, 0xa6, 0xa3, 0xb7, 0x36, 0x46, 0x6c, 0xc4, 0xd4, 0xa3, 0x27,
-	0x9f, 0xcc, 0xd7, 0xcd, 0x80, 0xf1, 0x31, 0xe3, 0x7d, 0xbd, 0xa0, 0x5f, 0xcc, 0x92, 0xb3, 0xe8,
-	0x9f, 0x31, 0x53, 0x31, 0xce, 0x3f, 0x15, 0x68, 0x1e, 0xe8, 0xa4, 0x1e, 0x0a, 0x5f, 0x60, 0xf4,
-	0x11, 0x54, 0x26, 0x7e, 0xec, 0x8f, 0xb9, 0x6d, 0x6d, 0x5b, 0x37, 0x1b, 0xbb, 0x9b, 0xee, 0x42,
-	0x92, 0xee, 0x57, 0x2a, 0xa0, 0x53, 0x7e, 0xf2, 0xbc, 0xbd, 0xd2, 0x33, 0xe1, 0xe8, 0x2e, 0xd4,
-	0x03, 0x46, 0x45, 0xec, 0x07, 0x82, 0xdb, 0xa5, 0xed, 0xd5, 0x9b, 0x8d, 0xdd, 0xd7, 0x73, 0xb4,
-	0x5d, 0x13, 0x63, 0xd4, 0x97, 0x1a, 0x74, 0x1f, 0xd6, 0x29, 0x3e, 0x15, 0xfd, 0x20, 0xf2, 0x39,
-	0xef, 0x93, 0x90, 0xdb, 0xab, 0x8a, 0xd2, 0xce, 0xa1, 0x3c, 0xc0, 0xa7, 0xa2, 0x2b, 0xe3, 0x0e,
-	0xef, 0x25, 0x79, 0x34, 0x69, 0xfa, 0x2d, 0xe4, 0xa8, 0x03, 0x55, 0xc5, 0xc1, 0xdc, 0x2e, 0x2b,
-	0x8a, 0xb3, 0x24, 0x97, 0xae, 0x8e, 0x34, 0xa0, 0x44, 0x88, 0x1e, 0x9a, 0x84, 0x04, 0x7b, 0x84,
-	0xa9, 0x4a, 0x68, 0x4d, 0xa1, 0xde, 0x5e, 0x82, 0x92, 0x89, 0x1d, 0xc9, 0xf8, 0xb9, 0xc4, 0xf4,
-	0xb7, 0x90, 0xa3, 0xcf, 0xa1, 0x36, 0xf0, 0x23, 0x9f, 0x06, 0x98, 0xdb, 0x15, 0x85, 0x7b, 0x73,
-	0xd9, 0x29, 0x99, 0x50, 0x83, 0x4a, 0xa5, 0xe8, 0x63, 0x28, 0xd3, 0xa1, 0xe0, 0x76, 0xf5, 0x85,
-	0x47, 0x94, 0x66, 0xb4, 0x7f, 0x94, 0xc8, 0x95, 0x04, 0xdd, 0x87, 0xea, 0xc4, 0x8f, 0x31, 0x15,
-	0xdc, 0xae, 0x29, 0xf5, 0x3b, 0x4b, 0xd4, 0x2a, 0xef, 0x1e, 0x8e, 0x7c, 0xb9, 0xc0, 0x3b, 0x15,
-	0xc9, 0xb1, 0xad, 0x5e, 0x42, 0x40, 0x77, 0xa1, 0x32, 0x8a, 0x7d, 0xc9, 0xaa, 0x2b, 0xd6, 0x1b,
-	0x4b, 0x58, 0x07, 0x2a, 0x30, 0x29, 0x1b, 0x2d, 0x43, 0xdf, 0xc1, 0xba, 0x3f, 0x15, 0xc7, 0x2c,
-	0x26, 0x8f, 0xb5, 0x87, 0x0d, 0x85, 0x49, 0xed, 0xcd, 0x08, 0x0c, 0x70, 0x0e, 0x83, 0x0e, 0xa0,
-	0xc6, 0xa7, 0x93, 0x49, 0x44, 0x30, 0xb7, 0x1b, 0x0a, 0xf9, 0xd6, 0x12, 0xa4, 0x2c, 0x7e, 0xc2,
-	0x05, 0x09, 0xd2, 0xa3, 0x4e, 0xc4, 0xa8, 0x0b, 0x95, 0xc1, 0x34, 0x96, 0x5b, 0x6c, 0xbe, 0x3c,
-	0xc6, 0x48, 0x9d, 0x1f, 0xe0, 0x95, 0xf9, 0xdf, 0x14, 0xb5, 0xa1, 0x91, 0x54, 0x7f, 0x9f, 0x84,
-	0xaa, 0xdf, 0xea, 0x3d, 0x48, 0x3e, 0x1d, 0x86, 0xe8, 0x93, 0x4c, 0xad, 0xe8, 0x8e, 0xda, 0xca,
-	0xf1, 0x36, 0xbc, 0xf9, 0x12, 0x71, 0x7e, 0x04, 0xb4, 0x98, 0x56, 0xb1, 0xe9, 0x17, 0x00, 0x3c,
-	0x0d, 0x37, 0xb6, 0xb9, 0xcd, 0x23, 0xbb, 0x64, 0x61, 0xbf, 0x19, 0xad, 0x73, 0x0a, 0xd7, 0xe7,
-	0x82, 0xd0, 0x26, 0xd4, 0x92, 0xf6, 0x36, 0xd6, 0xba, 0xdb, 0x0e, 0x43, 0xf4, 0x25, 0x54, 0xfc,
-	0x31, 0x9b, 0x52, 0x61, 0x97, 0xe4, 0x42, 0x67, 0x57, 0xf2, 0x9e, 0x3d, 0x6f, 0xbf, 0x3b, 0x22,
-	0xe2, 0x78, 0x3a, 0x70, 0x03, 0x36, 0xf6, 0xf6, 0x09, 0xe5, 0xc1, 0x31, 0xf1, 0xbd, 0xa1, 0x79,
-	0xd8, 0xe1, 0xe1, 0x23, 0x4f, 0x9c, 0x4d, 0x30, 0x77, 0x0f, 0xa9, 0xe8, 0x19, 0x82, 0x43, 0xa0,
-	0x6a, 0x4e, 0x05, 0xd9, 0x50, 0xf5, 0xc3, 0x30, 0xc6, 0x9c, 0x27, 0x86, 0xe6, 0x15, 0x7d, 0x96,
-	0x31, 0x94, 0x9b, 0x7c, 0x2d, 0xf7, 0x77, 0x25, 0xb4, 0x73, 0x4d, 0x66, 0xf2, 0xdb, 0x5f, 0xed,
-	0x35, 0xf9, 0xc6, 0x13, 0x93, 0x3b, 0xe5, 0x7f, 0x7f, 0x6d, 0x5b, 0xce, 0x09, 0x5c, 0x9f, 0x1b,
-	0x23, 0xc5, 0x47, 0x9c, 0x19, 0x4e, 0xda, 0x7a, 0xc3, 0xd5, 0x63, 0xdf, 0x4d, 0xc6, 0xbe, 0xbb,
-	0x47, 0xcf, 0x3a, 0x48, 0xfa, 0xfe, 0xf1, 0xfb, 0x0e, 0xa8, 0x26, 0x54, 0xf4, 0x74, 0x38, 0x39,
-	0x3e, 0x34, 0xb3, 0x1d, 0x5e, 0x6c, 0xfa, 0xbe, 0x99, 0x18, 0xda, 0xf1, 0x46, 0xde, 0x50, 0xdd,
-	0x3f, 0xca, 0x0e, 0x0a, 0xe7, 0x67, 0x0b, 0x6e, 0xe4, 0xb7, 0x5c, 0xb1, 0xdb, 0x83, 0x85, 0xb6,
-	0xd6, 0xbe, 0xdb, 0x39, 0xbe, 0x33, 0xec, 0xfc, 0x6e, 0x76, 0x08, 0xac, 0xcf, 0x8e, 0x91, 0xe2,
-	0x14, 0x3e, 0x4c, 0x47, 0x93, 0xb6, 0xb6, 0x73, 0xac, 0x15, 0x6b, 0x76, 0x22, 0x39, 0xcf, 0x2c,
-	0x68, 0x66, 0xef, 0x97, 0x62, 0xa7, 0xaf, 0xa1, 0x36, 0x9c, 0xd2, 0x11, 0x19, 0x44, 0xd8, 0x14,
-	0xef, 0x07, 0xa6, 0x78, 0xdf, 0xfb, 0x9f, 0xc5, 0xfb, 0x0d, 0xa1, 0xc2, 0xb6, 0x7a, 0x29, 0x06,
-	0x7d, 0x0b, 0x4d, 0xca, 0x68, 0x3f, 0xc5, 0xae, 0x2a, 0xec, 0xed, 0x2b, 0x60, 0x7b, 0x0d, 0xca,
-	0xe8, 0xbe, 0xe1, 0x38, 0x8f, 0x61, 0x23, 0xef, 0xaa, 0x2a, 0xde, 0xe3, 0x1e, 0xd4, 0x2f, 0xef,
-	0x41, 0x7d, 0xa0, 0xad, 0x17, 0x5c, 0xcc, 0x06, 0x9a, 0x0c, 0x24, 0x61, 0xae, 0x3e, 0x67, 0x0c,
-	0x8d, 0xcc, 0xf2, 0xb2, 0x59, 0xd0, 0x85, 0x12, 0x09, 0xcd, 0x51, 0x5e, 0x69, 0xcf, 0x25, 0x12,
-	0x3a, 0x3f, 0x65, 0xca, 0x77, 0xf6, 0x1a, 0x2b, 0xde, 0xed, 0x3d, 0xa8, 0xc7, 0x49, 0xf4, 0x92,
-	0xca, 0x9d, 0xc1, 0x26, 0xff, 0x68, 0x52, 0xe1, 0x9d, 0x92, 0x6d, 0x39, 0x9f, 0xc2, 0xb5, 0x99,
-	0x28, 0x84, 0xa0, 0xcc, 0x71, 0x34, 0x34, 0xa6, 0xea, 0x19, 0x6d, 0xc0, 0x1a, 0x13, 0xc7, 0x38,
-	0xd6, 0x5b, 0xee, 0xe9, 0x17, 0x29, 0xef, 0x1c, 0x3c, 0x39, 0x6f, 0x59, 0x4f, 0xcf, 0x5b, 0xd6,
-	0xdf, 0xe7, 0x2d, 0xeb, 0x97, 0x8b, 0xd6, 0xca, 0xd3, 0x8b, 0xd6, 0xca, 0x9f, 0x17, 0xad, 0x95,
-	0xef, 0x77, 0x0a, 0xcf, 0xe3, 0x34, 0xf3, 0x77, 0x6f, 0x50, 0x51, 0xa3, 0xe5, 0xf6, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa8, 0x3f, 0x52, 0xda, 0x95, 0x0a, 0x00, 0x00,
+	0x14, 0xc7, 0xb3, 0x8e, 0xe3, 0x1f, 0xcf, 0x4e, 0x0a, 0xa3, 0xa8, 0x6c, 0x02, 0xb2, 0xc3, 0x22,
+	0x44, 0x01, 0x65, 0x4d, 0x53, 0x01, 0xa2, 0x02, 0xaa, 0xd8, 0x25, 0x21, 0x44, 0xaa, 0xc0, 0x0d,
+	0x20, 0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x67, 0xd4, 0xf5, 0x8c, 0xd9, 0x19, 0x87, 0xa4, 0x17, 0x0e,
+	0x9c, 0xb8, 0xf1, 0x27, 0x70, 0xe6, 0x88, 0xf8, 0x23, 0x2a, 0x4e, 0x3d, 0xa2, 0x1e, 0x0a, 0x4a,
+	0x2e, 0xfc, 0x19, 0x68, 0x7e, 0xec, 0x66, 0x6d, 0x6f, 0xbc, 0x25, 0xb7, 0xdd, 0x9d, 0xef, 0xfb,
+	0x7c, 0xdf, 0x3c, 0xcf, 0x7b, 0x63, 0x68, 0x86, 0xfd, 0x51, 0xcb, 0x67, 0x61, 0x88, 0x7d, 0x41,
+	0x18, 0x6d, 0x9d, 0xdc, 0x6e, 0x0d, 0x31, 0xc5, 0x9c, 0x70, 0x77, 0x1c, 0x31, 0xc1, 0xd0, 0xcb,
+	0x61, 0x7f, 0xe4, 0x5e, 0x0a, 0xdc, 0x93, 0xdb, 0x9b, 0x1b, 0x43, 0xc6, 0x86, 0x21, 0x6e, 0x29,
+	0x41, 0x7f, 0x32, 0x68, 0x79, 0xf4, 0x4c, 0xab, 0x37, 0xd7, 0x87, 0x6c, 0xc8, 0xd4, 0x63, 0x4b,
+	0x3e, 0x99, 0xaf, 0x1b, 0x3e, 0xe3, 0x23, 0xc6, 0x7b, 0x7a, 0x41, 0xbf, 0x98, 0x25, 0x67, 0xde,
+	0x3f, 0x65, 0xa6, 0x34, 0xce, 0xef, 0x65, 0xa8, 0xef, 0xeb, 0xa4, 0x1e, 0x0a, 0x4f, 0x60, 0xf4,
+	0x21, 0x94, 0xc6, 0x5e, 0xe4, 0x8d, 0xb8, 0x6d, 0x6d, 0x59, 0xb7, 0x6a, 0x3b, 0x1b, 0xee, 0x5c,
+	0x92, 0xee, 0x97, 0x4a, 0xd0, 0x2e, 0x3e, 0x79, 0xde, 0x5c, 0xea, 0x1a, 0x39, 0xba, 0x07, 0x55,
+	0x9f, 0x51, 0x11, 0x79, 0xbe, 0xe0, 0x76, 0x61, 0x6b, 0xf9, 0x56, 0x6d, 0xe7, 0xd5, 0x8c, 0xd8,
+	0x8e, 0xd1, 0x98, 0xe8, 0xcb, 0x18, 0x74, 0x08, 0x6b, 0x14, 0x9f, 0x8a, 0x9e, 0x1f, 0x7a, 0x9c,
+	0xf7, 0x48, 0xc0, 0xed, 0x65, 0x45, 0x69, 0x66, 0x50, 0x1e, 0xe0, 0x53, 0xd1, 0x91, 0xba, 0x83,
+	0xfb, 0x71, 0x1e, 0x75, 0x9a, 0x7c, 0x0b, 0x38, 0x6a, 0x43, 0x59, 0x71, 0x30, 0xb7, 0x8b, 0x8a,
+	0xe2, 0x2c, 0xc8, 0xa5, 0xa3, 0x95, 0x06, 0x14, 0x07, 0xa2, 0x87, 0x26, 0x21, 0xc1, 0x1e, 0x61,
+	0xaa, 0x12, 0x5a, 0x51, 0xa8, 0xb7, 0x16, 0xa0, 0x64, 0x62, 0x47, 0x52, 0x3f, 0x93, 0x98, 0xfe,
+	0x16, 0x70, 0xf4, 0x19, 0x54, 0xfa, 0x5e, 0xe8, 0x51, 0x1f, 0x73, 0xbb, 0xa4, 0x70, 0x6f, 0x2c,
+	0xaa, 0x92, 0x91, 0x1a, 0x54, 0x12, 0x8a, 0x3e, 0x82, 0x22, 0x1d, 0x08, 0x6e, 0x97, 0xaf, 0x2c,
+	0x51, 0x92, 0xd1, 0xde, 0x51, 0x1c, 0xae, 0x42, 0xd0, 0x21, 0x94, 0xc7, 0x5e, 0x84, 0xa9, 0xe0,
+	0x76, 0x45, 0x45, 0xbf, 0xbd, 0x20, 0x5a, 0xe5, 0xdd, 0xc5, 0xa1, 0x27, 0x17, 0x78, 0xbb, 0x24,
+	0x39, 0xb6, 0xd5, 0x8d, 0x09, 0xe8, 0x1e, 0x94, 0x86, 0x91, 0x27, 0x59, 0x55, 0xc5, 0x7a, 0x7d,
+	0x01, 0x6b, 0x5f, 0x09, 0xe3, 0x63, 0xa3, 0xc3, 0xd0, 0xb7, 0xb0, 0xe6, 0x4d, 0xc4, 0x31, 0x8b,
+	0xc8, 0x63, 0xed, 0x61, 0x43, 0x6e, 0x52, 0xbb, 0x53, 0x01, 0x06, 0x38, 0x83, 0x41, 0xfb, 0x50,
+	0xe1, 0x93, 0xf1, 0x38, 0x24, 0x98, 0xdb, 0x35, 0x85, 0x7c, 0x73, 0x01, 0x52, 0x1e, 0x7e, 0xc2,
+	0x05, 0xf1, 0x93, 0x52, 0xc7, 0xc1, 0xa8, 0x03, 0xa5, 0xfe, 0x24, 0x92, 0x5b, 0xac, 0xff, 0x7f,
+	0x8c, 0x09, 0x45, 0x87, 0x00, 0x5e, 0x18, 0xb2, 0x1f, 0xf4, 0x0f, 0xbf, 0x9a, 0x0b, 0xda, 0x4d,
+	0xc4, 0x06, 0x94, 0x0a, 0x77, 0xbe, 0x87, 0x97, 0x66, 0x0f, 0x08, 0x6a, 0x42, 0x2d, 0x6e, 0xa5,
+	0x1e, 0x09, 0x54, 0xf3, 0x56, 0xbb, 0x10, 0x7f, 0x3a, 0x08, 0xd0, 0xc7, 0xa9, 0x83, 0xa7, 0xdb,
+	0x73, 0x33, 0xc3, 0xdf, 0xf0, 0x66, 0xcf, 0x9b, 0xf3, 0x23, 0xa0, 0xf9, 0x3d, 0xe6, 0x9b, 0x7e,
+	0x0e, 0xc0, 0x13, 0xb9, 0x5d, 0xb8, 0xba, 0x13, 0x65, 0xcb, 0xcd, 0x15, 0x2f, 0x15, 0xeb, 0x9c,
+	0xc2, 0x8d, 0x19, 0x11, 0xda, 0x80, 0x4a, 0x3c, 0x2b, 0x8c, 0xb5, 0x6e, 0xdd, 0x83, 0x00, 0x7d,
+	0x01, 0x25, 0x6f, 0xc4, 0x26, 0x54, 0xd8, 0x05, 0xb9, 0xd0, 0xde, 0x91, 0xbc, 0x67, 0xcf, 0x9b,
+	0xef, 0x0c, 0x89, 0x38, 0x9e, 0xf4, 0x5d, 0x9f, 0x8d, 0x5a, 0x7b, 0x84, 0x72, 0xff, 0x98, 0x78,
+	0xad, 0x81, 0x79, 0xd8, 0xe6, 0xc1, 0xa3, 0x96, 0x38, 0x1b, 0x63, 0xee, 0x1e, 0x50, 0xd1, 0x35,
+	0x04, 0x87, 0x40, 0xd9, 0x54, 0x05, 0xd9, 0x50, 0xf6, 0x82, 0x20, 0xc2, 0x9c, 0xc7, 0x86, 0xe6,
+	0x15, 0x7d, 0x9a, 0x32, 0x94, 0x9b, 0x7c, 0x25, 0xf3, 0xb7, 0x25, 0xb4, 0xbd, 0x2a, 0x33, 0xf9,
+	0xed, 0xef, 0xe6, 0x8a, 0x7c, 0xe3, 0xb1, 0xc9, 0xdd, 0xe2, 0xbf, 0xbf, 0x36, 0x2d, 0xe7, 0x04,
+	0x6e, 0xcc, 0xcc, 0xa4, 0xfc, 0x12, 0xa7, 0x26, 0x9d, 0xb6, 0x5e, 0x77, 0xf5, 0x1d, 0xe2, 0xc6,
+	0x77, 0x88, 0xbb, 0x4b, 0xcf, 0xda, 0x48, 0xfa, 0xfe, 0xf9, 0xc7, 0x36, 0xa8, 0x8e, 0x56, 0xf4,
+	0x64, 0xd2, 0x39, 0x1e, 0xd4, 0xd3, 0xe3, 0x22, 0xdf, 0xf4, 0x3d, 0x33, 0x7e, 0xb4, 0xe3, 0xcd,
+	0xac, 0x09, 0xbd, 0x77, 0x94, 0x9e, 0x3a, 0xce, 0xcf, 0x16, 0xdc, 0xcc, 0xee, 0xdf, 0x7c, 0xb7,
+	0x07, 0x73, 0x33, 0x42, 0xfb, 0x6e, 0x65, 0xf8, 0x4e, 0xb1, 0xb3, 0x47, 0x83, 0x73, 0x06, 0x68,
+	0xbe, 0xcf, 0x5e, 0xa4, 0xd2, 0xe9, 0x1e, 0xd6, 0x29, 0xbc, 0x96, 0x95, 0x42, 0x2c, 0xca, 0x68,
+	0x5d, 0x02, 0x6b, 0xd3, 0xe3, 0x30, 0xdf, 0xf6, 0x83, 0x64, 0xc4, 0x6a, 0x4b, 0x3b, 0xc3, 0x52,
+	0xb1, 0xa6, 0x27, 0xab, 0xf3, 0xcc, 0x82, 0x7a, 0xfa, 0x9e, 0xcc, 0x77, 0xfa, 0x0a, 0x2a, 0x83,
+	0x09, 0x1d, 0x92, 0x7e, 0x88, 0x4d, 0xdf, 0xbc, 0x6f, 0xfa, 0xe6, 0xdd, 0x17, 0xec, 0x9b, 0xaf,
+	0x09, 0x15, 0xb6, 0xd5, 0x4d, 0x30, 0xe8, 0x1b, 0xa8, 0x53, 0x46, 0x7b, 0x09, 0x76, 0x59, 0x61,
+	0xef, 0x5c, 0x03, 0xdb, 0xad, 0x51, 0x46, 0xf7, 0x0c, 0xc7, 0x79, 0x0c, 0xeb, 0x59, 0x57, 0x6e,
+	0xfe, 0x1e, 0x77, 0xa1, 0x7a, 0x79, 0x9f, 0xeb, 0x82, 0x36, 0xae, 0xf8, 0x83, 0x61, 0xa0, 0xf1,
+	0x2c, 0x14, 0xe6, 0x0a, 0x77, 0x46, 0x50, 0x4b, 0x2d, 0x2f, 0x1a, 0x43, 0x1d, 0x28, 0x90, 0xc0,
+	0x2e, 0x5c, 0x7f, 0xcf, 0x05, 0x12, 0x38, 0x3f, 0xa5, 0x3a, 0x67, 0xfa, 0x3a, 0xce, 0xdf, 0xed,
+	0x7d, 0xa8, 0x46, 0xb1, 0x7a, 0x41, 0xd3, 0x4c, 0x61, 0xe3, 0x7f, 0x66, 0x49, 0xe0, 0xdd, 0x82,
+	0x6d, 0x39, 0x9f, 0xc0, 0xea, 0x94, 0x0a, 0x21, 0x28, 0x72, 0x1c, 0x0e, 0x8c, 0xa9, 0x7a, 0x46,
+	0xeb, 0xb0, 0xc2, 0xc4, 0x31, 0x8e, 0xf4, 0x96, 0xbb, 0xfa, 0x45, 0x86, 0xb7, 0xf7, 0x9f, 0x9c,
+	0x37, 0xac, 0xa7, 0xe7, 0x0d, 0xeb, 0x9f, 0xf3, 0x86, 0xf5, 0xcb, 0x45, 0x63, 0xe9, 0xe9, 0x45,
+	0x63, 0xe9, 0xaf, 0x8b, 0xc6, 0xd2, 0x77, 0xdb, 0xb9, 0xf5, 0x38, 0x4d, 0xfd, 0x6d, 0xed, 0x97,
+	0xd4, 0x54, 0xbb, 0xf3, 0xdf, 0x00, 0xe9, 0x1b, 0x53, 0xdc, 0x5d, 0x0b, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -1003,6 +1070,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Burnts) > 0 {
 		for iNdEx := len(m.Burnts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1474,6 +1555,50 @@ func (m *ContractAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1818,6 +1943,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1950,6 +2081,25 @@ func (m *ContractAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *ContractAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContractGrants) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2498,6 +2648,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, ContractAllowances{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3331,6 +3515,122 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -238,6 +238,37 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"contract nfts of invalid royalty": {
+			&collection.GenesisState{
+				Nfts: []collection.ContractNFTs{{
+					ContractId: "deadbeef",
+					Nfts: []collection.NFT{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Name:    "tibetian fox",
+						Meta:    "Tibetian Fox",
+						Royalty: &collection.Royalty{
+							Recipient: "invalid",
+							Rate:      sdk.NewDecWithPrec(1, 1),
+						},
+					}},
+				}},
+			},
+			false,
+		},
+		"contract nfts of invalid attributes": {
+			&collection.GenesisState{
+				Nfts: []collection.ContractNFTs{{
+					ContractId: "deadbeef",
+					Nfts: []collection.NFT{{
+						TokenId:    collection.NewNFTID("deadbeef", 1),
+						Name:       "tibetian fox",
+						Meta:       "Tibetian Fox",
+						Attributes: []collection.Attribute{{Key: "level"}},
+					}},
+				}},
+			},
+			false,
+		},
 		"contract parents of invalid contract id": {
 			&collection.GenesisState{
 				Parents: []collection.ContractTokenRelations{{
@@ -320,6 +351,80 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"contract allowances of invalid contract id": {
+			&collection.GenesisState{
+				Allowances: []collection.ContractAllowances{{
+					Allowances: []collection.Allowance{{
+						Holder:     addr.String(),
+						Spender:    addr.String(),
+						TokenId:    collection.NewFTID("00bab10c"),
+						SpendLimit: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract allowances of empty allowances": {
+			&collection.GenesisState{
+				Allowances: []collection.ContractAllowances{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"contract allowances of invalid holder": {
+			&collection.GenesisState{
+				Allowances: []collection.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []collection.Allowance{{
+						Spender:    addr.String(),
+						TokenId:    collection.NewFTID("00bab10c"),
+						SpendLimit: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract allowances of invalid spender": {
+			&collection.GenesisState{
+				Allowances: []collection.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []collection.Allowance{{
+						Holder:     addr.String(),
+						TokenId:    collection.NewFTID("00bab10c"),
+						SpendLimit: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract allowances of invalid token id": {
+			&collection.GenesisState{
+				Allowances: []collection.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []collection.Allowance{{
+						Holder:     addr.String(),
+						Spender:    addr.String(),
+						SpendLimit: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract allowances of invalid spend limit": {
+			&collection.GenesisState{
+				Allowances: []collection.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []collection.Allowance{{
+						Holder:     addr.String(),
+						Spender:    addr.String(),
+						TokenId:    collection.NewFTID("00bab10c"),
+						SpendLimit: sdk.ZeroInt(),
+					}},
+				}},
+			},
+			false,
+		},
 		"contract grants of invalid contract id": {
 			&collection.GenesisState{
 				Grants: []collection.ContractGrants{{
@@ -103,6 +103,23 @@ func (k Keeper) iterateGrantsImpl(ctx sdk.Context, prefix []byte, fn func(contra
 	}
 }
 
+func (k Keeper) iterateContractAllowances(ctx sdk.Context, contractID string, fn func(allowance collection.Allowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, allowanceKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance collection.Allowance
+		k.cdc.MustUnmarshal(iterator.Value(), &allowance)
+
+		stop := fn(allowance)
+		if stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateContractAuthorizations(ctx sdk.Context, contractID string, fn func(authorization collection.Authorization) (stop bool)) {
 	k.iterateAuthorizationsImpl(ctx, authorizationKeyPrefixByContractID(contractID), func(_ string, authorization collection.Authorization) (stop bool) {
 		return fn(authorization)
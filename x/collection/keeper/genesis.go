@@ -167,6 +167,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 		reporter.Tick()
 	}
 
+	reporter = newProgressReporter(k.Logger(ctx), "import allowances", len(data.Allowances))
+	for _, contractAllowances := range data.Allowances {
+		for _, allowance := range contractAllowances.Allowances {
+			k.setAllowance(ctx, contractAllowances.ContractId, allowance)
+		}
+
+		reporter.Tick()
+	}
+
 	reporter = newProgressReporter(k.Logger(ctx), "import grants", len(data.Grants))
 	for _, contractGrants := range data.Grants {
 		for _, grant := range contractGrants.Grants {
@@ -223,6 +232,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 		Authorizations: k.getAuthorizations(ctx, contracts),
 		Supplies:       k.getSupplies(ctx, contracts),
 		Burnts:         k.getBurnts(ctx, contracts),
+		Allowances:     k.getAllowances(ctx, contracts),
 	}
 }
 
@@ -391,6 +401,26 @@ func (k Keeper) getAuthorizations(ctx sdk.Context, contracts []collection.Contra
 	return authorizations
 }
 
+func (k Keeper) getAllowances(ctx sdk.Context, contracts []collection.Contract) []collection.ContractAllowances {
+	var allowances []collection.ContractAllowances
+	for _, contract := range contracts {
+		contractID := contract.Id
+		contractAllowances := collection.ContractAllowances{
+			ContractId: contractID,
+		}
+
+		k.iterateContractAllowances(ctx, contractID, func(allowance collection.Allowance) (stop bool) {
+			contractAllowances.Allowances = append(contractAllowances.Allowances, allowance)
+			return false
+		})
+		if len(contractAllowances.Allowances) != 0 {
+			allowances = append(allowances, contractAllowances)
+		}
+	}
+
+	return allowances
+}
+
 func (k Keeper) getGrants(ctx sdk.Context, contracts []collection.Contract) []collection.ContractGrants {
 	var grants []collection.ContractGrants
 	for _, contract := range contracts {
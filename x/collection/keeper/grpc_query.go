@@ -622,3 +622,229 @@ func (s queryServer) HoldersByOperator(c context.Context, req *collection.QueryH
 
 	return &collection.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes}, nil
 }
+
+func (s queryServer) Allowance(c context.Context, req *collection.QueryAllowanceRequest) (*collection.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	holder, err := s.addressFromBech32GRPC(req.Holder, "holder")
+	if err != nil {
+		return nil, err
+	}
+	spender, err := s.addressFromBech32GRPC(req.Spender, "spender")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := collection.ValidateFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowance := s.keeper.GetAllowance(ctx, req.ContractId, holder, spender, req.TokenId)
+
+	return &collection.QueryAllowanceResponse{Allowance: allowance}, nil
+}
+
+func (s queryServer) NFTsByOwner(c context.Context, req *collection.QueryNFTsByOwnerRequest) (*collection.QueryNFTsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := s.addressFromBech32GRPC(req.Owner, "owner")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	ownedNFTStore := prefix.NewStore(store, ownedNFTKeyPrefixByOwner(req.ContractId, owner))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(ownedNFTStore, req.Pagination, func(key, _ []byte) error {
+		tokenID := string(key)
+		token, err := s.keeper.GetNFT(ctx, req.ContractId, tokenID)
+		if err != nil {
+			panic(err)
+		}
+
+		tokens = append(tokens, *token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByOwnerResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) NFTsByClass(c context.Context, req *collection.QueryNFTsByClassRequest) (*collection.QueryNFTsByClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := s.assertTokenTypeIsNonFungible(ctx, req.ContractId, req.ClassId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	store := ctx.KVStore(s.keeper.storeKey)
+	nftStore := prefix.NewStore(store, nftKeyPrefixByClassID(req.ContractId, req.ClassId))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(nftStore, req.Pagination, func(_, value []byte) error {
+		var token collection.NFT
+		s.keeper.cdc.MustUnmarshal(value, &token)
+
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByClassResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) NFTsByAttribute(c context.Context, req *collection.QueryNFTsByAttributeRequest) (*collection.QueryNFTsByAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := s.assertTokenTypeIsNonFungible(ctx, req.ContractId, req.ClassId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	store := ctx.KVStore(s.keeper.storeKey)
+	nftStore := prefix.NewStore(store, nftKeyPrefixByClassID(req.ContractId, req.ClassId))
+	var tokens []collection.NFT
+	pageRes, err := query.FilteredPaginate(nftStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var token collection.NFT
+		s.keeper.cdc.MustUnmarshal(value, &token)
+
+		for _, attribute := range token.Attributes {
+			if attribute.Key == req.Key && attribute.Value == req.Value {
+				if accumulate {
+					tokens = append(tokens, token)
+				}
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByAttributeResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TokenClasses(c context.Context, req *collection.QueryTokenClassesRequest) (*collection.QueryTokenClassesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	classStore := prefix.NewStore(store, classKeyPrefixByContractID(req.ContractId))
+	var classes []codectypes.Any
+	pageRes, err := query.Paginate(classStore, req.Pagination, func(_, value []byte) error {
+		var class collection.TokenClass
+		if err := s.keeper.cdc.UnmarshalInterface(value, &class); err != nil {
+			panic(err)
+		}
+
+		classes = append(classes, *collection.TokenClassToAny(class))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryTokenClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+func (s queryServer) Contracts(c context.Context, req *collection.QueryContractsRequest) (*collection.QueryContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	contractStore := prefix.NewStore(store, contractKeyPrefix)
+	var contracts []collection.Contract
+	pageRes, err := query.Paginate(contractStore, req.Pagination, func(_, value []byte) error {
+		var contract collection.Contract
+		s.keeper.cdc.MustUnmarshal(value, &contract)
+
+		contracts = append(contracts, contract)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryContractsResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+func (s queryServer) Royalty(c context.Context, req *collection.QueryRoyaltyRequest) (*collection.QueryRoyaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateNFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.SalePrice.IsNil() || req.SalePrice.IsNegative() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sale price: %s", req.SalePrice)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	royalty, err := s.keeper.GetRoyalty(ctx, req.ContractId, req.TokenId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if royalty == nil {
+		return &collection.QueryRoyaltyResponse{Amount: sdk.ZeroInt()}, nil
+	}
+
+	return &collection.QueryRoyaltyResponse{Recipient: royalty.Recipient, Amount: royalty.Calculate(req.SalePrice)}, nil
+}
@@ -1232,3 +1232,529 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryAllowance() {
+	// empty request
+	_, err := s.queryServer.Allowance(s.goCtx, nil)
+	s.Require().Error(err)
+
+	tokenID := collection.NewFTID(s.ftClassID)
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		spender    sdk.AccAddress
+		tokenID    string
+		valid      bool
+		postTest   func(res *collection.QueryAllowanceResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			holder:     s.vendor,
+			spender:    s.stranger,
+			tokenID:    tokenID,
+			valid:      true,
+			postTest: func(res *collection.QueryAllowanceResponse) {
+				s.Require().Equal(s.allowance, res.Allowance.SpendLimit)
+			},
+		},
+		"no allowance": {
+			contractID: s.contractID,
+			holder:     s.customer,
+			spender:    s.stranger,
+			tokenID:    tokenID,
+			valid:      true,
+			postTest: func(res *collection.QueryAllowanceResponse) {
+				s.Require().True(res.Allowance.SpendLimit.IsZero())
+			},
+		},
+		"invalid contract id": {
+			holder:  s.vendor,
+			spender: s.stranger,
+			tokenID: tokenID,
+		},
+		"invalid holder": {
+			contractID: s.contractID,
+			spender:    s.stranger,
+			tokenID:    tokenID,
+		},
+		"invalid spender": {
+			contractID: s.contractID,
+			holder:     s.vendor,
+			tokenID:    tokenID,
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+			holder:     s.vendor,
+			spender:    s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryAllowanceRequest{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Spender:    tc.spender.String(),
+				TokenId:    tc.tokenID,
+			}
+			res, err := s.queryServer.Allowance(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTsByOwner() {
+	// empty request
+	_, err := s.queryServer.NFTsByOwner(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryNFTsByOwnerResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(s.numRoots, len(res.Tokens))
+				s.Require().Equal(collection.NewNFTID(s.nftClassID, 1), res.Tokens[0].TokenId)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(1, len(res.Tokens))
+			},
+		},
+		"no nfts": {
+			contractID: s.contractID,
+			owner:      s.stranger,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(0, len(res.Tokens))
+			},
+		},
+		"invalid contract id": {
+			owner: s.customer,
+		},
+		"invalid owner": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryNFTsByOwnerRequest{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.NFTsByOwner(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTsByClass() {
+	// empty request
+	_, err := s.queryServer.NFTsByClass(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryNFTsByClassResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByClassResponse) {
+				s.Require().Equal(s.numNFTs*3, len(res.Tokens))
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryNFTsByClassResponse) {
+				s.Require().Equal(1, len(res.Tokens))
+				s.Require().Equal(collection.NewNFTID(s.nftClassID, 1), res.Tokens[0].TokenId)
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+		},
+		"invalid class id": {
+			contractID: s.contractID,
+		},
+		"class not found": {
+			contractID: s.contractID,
+			classID:    "deadbeef",
+		},
+		"not a class of nft": {
+			contractID: s.contractID,
+			classID:    s.ftClassID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryNFTsByClassRequest{
+				ContractId: tc.contractID,
+				ClassId:    tc.classID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.NFTsByClass(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTsByAttribute() {
+	// empty request
+	_, err := s.queryServer.NFTsByAttribute(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	tokenIDs := []string{
+		collection.NewNFTID(s.nftClassID, 1),
+		collection.NewNFTID(s.nftClassID, 3),
+	}
+	for _, tokenID := range tokenIDs {
+		err := s.keeper.ModifyNFT(ctx, s.contractID, tokenID, s.vendor, []collection.Attribute{{Key: "level", Value: "7"}})
+		s.Require().NoError(err)
+	}
+	err = s.keeper.ModifyNFT(ctx, s.contractID, collection.NewNFTID(s.nftClassID, 2), s.vendor, []collection.Attribute{{Key: "level", Value: "8"}})
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		key        string
+		value      string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryNFTsByAttributeResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			key:        "level",
+			value:      "7",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByAttributeResponse) {
+				s.Require().Len(res.Tokens, len(tokenIDs))
+				for i, token := range res.Tokens {
+					s.Require().Equal(tokenIDs[i], token.TokenId)
+				}
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			key:        "level",
+			value:      "7",
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryNFTsByAttributeResponse) {
+				s.Require().Len(res.Tokens, 1)
+				s.Require().Equal(tokenIDs[0], res.Tokens[0].TokenId)
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"no matching token": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			key:        "level",
+			value:      "9",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByAttributeResponse) {
+				s.Require().Empty(res.Tokens)
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+			key:     "level",
+			value:   "7",
+		},
+		"invalid class id": {
+			contractID: s.contractID,
+			key:        "level",
+			value:      "7",
+		},
+		"empty key": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			value:      "7",
+		},
+		"class not found": {
+			contractID: s.contractID,
+			classID:    "deadbeef",
+			key:        "level",
+			value:      "7",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryNFTsByAttributeRequest{
+				ContractId: tc.contractID,
+				ClassId:    tc.classID,
+				Key:        tc.key,
+				Value:      tc.value,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.NFTsByAttribute(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryTokenClasses() {
+	// empty request
+	_, err := s.queryServer.TokenClasses(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryTokenClassesResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *collection.QueryTokenClassesResponse) {
+				s.Require().Equal(2, len(res.Classes))
+				s.Require().Equal(s.ftClassID, collection.TokenClassFromAny(&res.Classes[0]).GetId())
+				s.Require().Equal(s.nftClassID, collection.TokenClassFromAny(&res.Classes[1]).GetId())
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryTokenClassesResponse) {
+				s.Require().Equal(1, len(res.Classes))
+			},
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryTokenClassesResponse) {
+				s.Require().Equal(0, len(res.Classes))
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryTokenClassesRequest{
+				ContractId: tc.contractID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.TokenClasses(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryContracts() {
+	// empty request
+	_, err := s.queryServer.Contracts(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		count    uint64
+		postTest func(res *collection.QueryContractsResponse)
+	}{
+		"valid request": {
+			postTest: func(res *collection.QueryContractsResponse) {
+				s.Require().Equal(1, len(res.Contracts))
+				s.Require().Equal(s.contractID, res.Contracts[0].Id)
+			},
+		},
+		"valid request with limit": {
+			count: 1,
+			postTest: func(res *collection.QueryContractsResponse) {
+				s.Require().Equal(1, len(res.Contracts))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryContractsRequest{
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.Contracts(s.goCtx, req)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryRoyalty() {
+	// empty request
+	_, err := s.queryServer.Royalty(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	classRoyalty := &collection.Royalty{
+		Recipient: s.vendor.String(),
+		Rate:      sdk.NewDecWithPrec(1, 1),
+	}
+	err = s.keeper.SetTokenClassRoyalty(ctx, s.contractID, s.nftClassID, classRoyalty)
+	s.Require().NoError(err)
+
+	nftRoyalty := &collection.Royalty{
+		Recipient: s.operator.String(),
+		Rate:      sdk.NewDecWithPrec(3, 2),
+	}
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	err = s.keeper.SetNFTRoyalty(ctx, s.contractID, tokenID, nftRoyalty)
+	s.Require().NoError(err)
+
+	salePrice := sdk.NewInt(1234)
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		salePrice  sdk.Int
+		valid      bool
+		postTest   func(res *collection.QueryRoyaltyResponse)
+	}{
+		"valid request (nft royalty)": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			salePrice:  salePrice,
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyResponse) {
+				s.Require().Equal(s.operator.String(), res.Recipient)
+				s.Require().Equal(sdk.NewInt(37), res.Amount)
+			},
+		},
+		"valid request (class royalty)": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 2),
+			salePrice:  salePrice,
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyResponse) {
+				s.Require().Equal(s.vendor.String(), res.Recipient)
+				s.Require().Equal(sdk.NewInt(123), res.Amount)
+			},
+		},
+		"invalid contract id": {
+			tokenID:   tokenID,
+			salePrice: salePrice,
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+			salePrice:  salePrice,
+		},
+		"invalid sale price": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			salePrice:  sdk.NewInt(-1),
+		},
+		"token not found": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			salePrice:  salePrice,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryRoyaltyRequest{
+				ContractId: tc.contractID,
+				TokenId:    tc.tokenID,
+				SalePrice:  tc.salePrice,
+			}
+			res, err := s.queryServer.Royalty(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+
+	// no royalty applied
+	res, err := s.queryServer.Royalty(s.goCtx, &collection.QueryRoyaltyRequest{
+		ContractId: s.contractID,
+		TokenId:    tokenID,
+		SalePrice:  salePrice,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Recipient)
+	s.Require().True(res.Amount.IsZero())
+}
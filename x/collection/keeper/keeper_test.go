@@ -32,7 +32,8 @@ type KeeperTestSuite struct {
 	ftClassID  string
 	nftClassID string
 
-	balance sdk.Int
+	balance   sdk.Int
+	allowance sdk.Int
 
 	depthLimit int
 
@@ -85,6 +86,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	s.balance = sdk.NewInt(1000000)
+	s.allowance = s.balance.QuoRaw(2)
 
 	// create a contract
 	s.contractID = s.keeper.CreateContract(s.ctx, s.vendor, collection.Contract{
@@ -109,6 +111,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	// create a non-fungible token class
 	nftClassID, err := s.keeper.CreateTokenClass(s.ctx, s.contractID, &collection.NFTClass{
 		Name: "fennec fox",
+		AttributeSchema: []collection.AttributeDefinition{
+			{Key: "level", Type: collection.AttributeTypeInt, Mutable: true},
+			{Key: "habitat", Type: collection.AttributeTypeString},
+		},
 	})
 	s.Require().NoError(err)
 	s.nftClassID = *nftClassID
@@ -170,6 +176,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	err = s.keeper.AuthorizeOperator(s.ctx, s.contractID, s.customer, s.stranger)
 	s.Require().NoError(err)
 
+	// approve stranger
+	err = s.keeper.Approve(s.ctx, s.contractID, s.vendor, s.stranger, collection.NewFTID(s.ftClassID), s.allowance, nil)
+	s.Require().NoError(err)
+
 	// not token contract
 	notTokenContractID := app.ClassKeeper.NewID(s.ctx)
 	err = keeper.ValidateLegacyContract(s.keeper, s.ctx, notTokenContractID)
@@ -13,14 +13,16 @@ var (
 	nextClassIDKeyPrefix = []byte{0x12}
 	nextTokenIDKeyPrefix = []byte{0x13}
 
-	balanceKeyPrefix = []byte{0x20}
-	ownerKeyPrefix   = []byte{0x21}
-	nftKeyPrefix     = []byte{0x22}
-	parentKeyPrefix  = []byte{0x23}
-	childKeyPrefix   = []byte{0x24}
+	balanceKeyPrefix  = []byte{0x20}
+	ownerKeyPrefix    = []byte{0x21}
+	nftKeyPrefix      = []byte{0x22}
+	parentKeyPrefix   = []byte{0x23}
+	childKeyPrefix    = []byte{0x24}
+	ownedNFTKeyPrefix = []byte{0x25}
 
 	authorizationKeyPrefix = []byte{0x30}
 	grantKeyPrefix         = []byte{0x31}
+	allowanceKeyPrefix     = []byte{0x32}
 
 	supplyKeyPrefix = []byte{0x40}
 	mintedKeyPrefix = []byte{0x41}
@@ -113,6 +115,49 @@ func ownerKeyPrefixByContractID(contractID string) []byte {
 	return key
 }
 
+// ----------------------------------------------------------------------------
+// owned nft (reverse index of owner)
+func ownedNFTKey(contractID string, owner sdk.AccAddress, tokenID string) []byte {
+	prefix := ownedNFTKeyPrefixByOwner(contractID, owner)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func ownedNFTKeyPrefixByOwner(contractID string, owner sdk.AccAddress) []byte {
+	prefix := ownedNFTKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(owner))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	return key
+}
+
+func ownedNFTKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(ownedNFTKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, ownedNFTKeyPrefix)
+
+	begin += len(ownedNFTKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
 // ----------------------------------------------------------------------------
 // nft
 func nftKey(contractID, tokenID string) []byte {
@@ -140,6 +185,16 @@ func nftKeyPrefixByContractID(contractID string) []byte {
 	return key
 }
 
+func nftKeyPrefixByClassID(contractID, classID string) []byte {
+	prefix := nftKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(classID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], classID)
+
+	return key
+}
+
 func splitNFTKey(key []byte) (contractID, tokenID string) {
 	begin := len(nftKeyPrefix) + 1
 	end := begin + int(key[begin-1])
@@ -530,3 +585,60 @@ func legacyTokenTypeKeyPrefixByContractID(contractID string) []byte {
 
 	return key
 }
+
+func allowanceKey(contractID string, spender, holder sdk.AccAddress, tokenID string) []byte {
+	prefix := allowanceKeyPrefixByHolder(contractID, spender, holder)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func allowanceKeyPrefixByHolder(contractID string, spender, holder sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixBySpender(contractID, spender)
+	key := make([]byte, len(prefix)+1+len(holder))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(holder))
+
+	begin++
+	copy(key[begin:], holder)
+
+	return key
+}
+
+func allowanceKeyPrefixBySpender(contractID string, spender sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(spender))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(spender))
+
+	begin++
+	copy(key[begin:], spender)
+
+	return key
+}
+
+func allowanceKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(allowanceKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, allowanceKeyPrefix)
+
+	begin += len(allowanceKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// backfill the reverse index of nft owners
+	if err := indexOwnedNFTs(store); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func indexOwnedNFTs(store storetypes.KVStore) error {
+	// the iterator must be closed before writing into the store
+	keys, err := collectOwnedNFTKeys(store)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
+
+func collectOwnedNFTKeys(store storetypes.KVStore) ([][]byte, error) {
+	iterator := sdk.KVStorePrefixIterator(store, ownerKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, tokenID := splitOwnerKey(iterator.Key())
+
+		var owner sdk.AccAddress
+		if err := owner.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, OwnedNFTKey(contractID, owner, tokenID))
+	}
+
+	return keys, nil
+}
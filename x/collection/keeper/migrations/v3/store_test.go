@@ -0,0 +1,50 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(collectionKey, newKey)
+
+	// set state
+	store := ctx.KVStore(collectionKey)
+
+	contractID := "deadbeef"
+	classID := "10000001"
+	owners := []sdk.AccAddress{
+		sdk.AccAddress("fennec"),
+		sdk.AccAddress("penguin"),
+		sdk.AccAddress("cheetah"),
+	}
+	tokenIDs := make([]string, len(owners))
+	for i, owner := range owners {
+		tokenIDs[i] = collection.NewNFTID(classID, i+1)
+
+		bz, err := owner.Marshal()
+		require.NoError(t, err)
+		store.Set(v3.OwnerKey(contractID, tokenIDs[i]), bz)
+	}
+
+	// migrate
+	err := v3.MigrateStore(ctx, collectionKey)
+	require.NoError(t, err)
+
+	// check the index
+	for i, owner := range owners {
+		for j, tokenID := range tokenIDs {
+			name := fmt.Sprintf("owner: %d, token: %d", i, j)
+			require.Equal(t, i == j, store.Has(v3.OwnedNFTKey(contractID, owner, tokenID)), name)
+		}
+	}
+}
@@ -63,7 +63,9 @@ func (s msgServer) OperatorSendFT(c context.Context, req *collection.MsgOperator
 	fromAddr := sdk.MustAccAddressFromBech32(req.From)
 
 	if _, err := s.keeper.GetAuthorization(ctx, req.ContractId, fromAddr, operatorAddr); err != nil {
-		return nil, collection.ErrCollectionNotApproved.Wrap(err.Error())
+		if err := s.keeper.spendAllowances(ctx, req.ContractId, fromAddr, operatorAddr, req.Amount); err != nil {
+			return nil, err
+		}
 	}
 
 	toAddr := sdk.MustAccAddressFromBech32(req.To)
@@ -207,6 +209,35 @@ func (s msgServer) AuthorizeOperator(c context.Context, req *collection.MsgAutho
 	return &collection.MsgAuthorizeOperatorResponse{}, nil
 }
 
+func (s msgServer) Approve(c context.Context, req *collection.MsgApprove) (*collection.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holderAddr := sdk.MustAccAddressFromBech32(req.Holder)
+	spenderAddr := sdk.MustAccAddressFromBech32(req.Spender)
+
+	if err := s.keeper.Approve(ctx, req.ContractId, holderAddr, spenderAddr, req.TokenId, req.SpendLimit, req.Expiration); err != nil {
+		return nil, err
+	}
+
+	event := collection.EventApproved{
+		ContractId: req.ContractId,
+		Holder:     req.Holder,
+		Spender:    req.Spender,
+		TokenId:    req.TokenId,
+		SpendLimit: req.SpendLimit,
+		Expiration: req.Expiration,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgApproveResponse{}, nil
+}
+
 func (s msgServer) RevokeOperator(c context.Context, req *collection.MsgRevokeOperator) (*collection.MsgRevokeOperatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -320,8 +351,10 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	class := &collection.NFTClass{
-		Name: req.Name,
-		Meta: req.Meta,
+		Name:            req.Name,
+		Meta:            req.Meta,
+		Royalty:         req.Royalty,
+		AttributeSchema: req.AttributeSchema,
 	}
 	id, err := s.keeper.CreateTokenClass(ctx, req.ContractId, class)
 	if err != nil {
@@ -329,11 +362,13 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	event := collection.EventCreatedNFTClass{
-		ContractId: req.ContractId,
-		Operator:   req.Owner,
-		TokenType:  *id,
-		Name:       class.Name,
-		Meta:       class.Meta,
+		ContractId:      req.ContractId,
+		Operator:        req.Owner,
+		TokenType:       *id,
+		Name:            class.Name,
+		Meta:            class.Meta,
+		Royalty:         class.Royalty,
+		AttributeSchema: class.AttributeSchema,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -679,6 +714,44 @@ func (s msgServer) Modify(c context.Context, req *collection.MsgModify) (*collec
 	return &collection.MsgModifyResponse{}, nil
 }
 
+func (s msgServer) SetRoyalty(c context.Context, req *collection.MsgSetRoyalty) (*collection.MsgSetRoyaltyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, collection.PermissionModify); err != nil {
+		return nil, collection.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if len(req.TokenIndex) == 0 {
+		if err := s.keeper.SetTokenClassRoyalty(ctx, req.ContractId, req.TokenType, req.Royalty); err != nil {
+			return nil, err
+		}
+	} else {
+		tokenID := req.TokenType + req.TokenIndex
+		if err := s.keeper.SetNFTRoyalty(ctx, req.ContractId, tokenID, req.Royalty); err != nil {
+			return nil, err
+		}
+	}
+
+	event := collection.EventRoyaltySet{
+		ContractId: req.ContractId,
+		Operator:   req.Operator,
+		TokenType:  req.TokenType,
+		TokenIndex: req.TokenIndex,
+		Royalty:    req.Royalty,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgSetRoyaltyResponse{}, nil
+}
+
 func (s msgServer) GrantPermission(c context.Context, req *collection.MsgGrantPermission) (*collection.MsgGrantPermissionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
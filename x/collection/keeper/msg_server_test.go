@@ -5,6 +5,7 @@ import (
 
 	"github.com/Finschia/finschia-sdk/testutil"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/collection"
 	"github.com/Finschia/finschia-sdk/x/token/class"
@@ -158,6 +159,39 @@ func (s *KeeperTestSuite) TestMsgOperatorSendFT() {
 			},
 			expectedError: collection.ErrInsufficientToken,
 		},
+		"valid request by allowance": {
+			req: &collection.MsgOperatorSendFT{
+				ContractId: s.contractID,
+				Operator:   s.stranger.String(),
+				From:       s.vendor.String(),
+				To:         s.customer.String(),
+				Amount:     collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.allowance)),
+			},
+			ftID: collection.NewFTID(s.ftClassID),
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.collection.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.MustJSONMarshal(collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.allowance))), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.vendor.String()), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.stranger.String()), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.customer.String()), Index: false},
+					},
+				},
+			},
+		},
+		"insufficient allowance": {
+			isNegativeCase: true,
+			req: &collection.MsgOperatorSendFT{
+				ContractId: s.contractID,
+				Operator:   s.stranger.String(),
+				From:       s.vendor.String(),
+				To:         s.customer.String(),
+				Amount:     collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.allowance.Add(sdk.OneInt()))),
+			},
+			expectedError: collection.ErrInsufficientAllowance,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -745,6 +779,15 @@ func (s *KeeperTestSuite) TestMsgIssueFT() {
 
 func (s *KeeperTestSuite) TestMsgIssueNFT() {
 	expectedTokenType := "10000002"
+	royalty := &collection.Royalty{
+		Recipient: s.vendor.String(),
+		Rate:      sdk.NewDecWithPrec(5, 2),
+	}
+	schema := []collection.AttributeDefinition{{
+		Key:      "level",
+		Type:     collection.AttributeTypeInt,
+		Required: true,
+	}}
 
 	testCases := map[string]struct {
 		contractID string
@@ -759,10 +802,12 @@ func (s *KeeperTestSuite) TestMsgIssueNFT() {
 				sdk.Event{
 					Type: "lbm.collection.v1.EventCreatedNFTClass",
 					Attributes: []abci.EventAttribute{
+						{Key: []byte("attribute_schema"), Value: []byte(`[{"key":"level","type":"ATTRIBUTE_TYPE_INT","required":true,"mutable":false}]`), Index: false},
 						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
 						{Key: []byte("meta"), Value: testutil.W(""), Index: false},
 						{Key: []byte("name"), Value: testutil.W(""), Index: false},
 						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
+						{Key: []byte("royalty"), Value: testutil.MustJSONMarshal(royalty), Index: false},
 						{Key: []byte("token_type"), Value: testutil.W(expectedTokenType), Index: false},
 					},
 				},
@@ -803,8 +848,10 @@ func (s *KeeperTestSuite) TestMsgIssueNFT() {
 			ctx, _ := s.ctx.CacheContext()
 
 			req := &collection.MsgIssueNFT{
-				ContractId: tc.contractID,
-				Owner:      tc.owner.String(),
+				ContractId:      tc.contractID,
+				Owner:           tc.owner.String(),
+				Royalty:         royalty,
+				AttributeSchema: schema,
 			}
 			res, err := s.msgServer.IssueNFT(sdk.WrapSDKContext(ctx), req)
 			s.Require().ErrorIs(err, tc.err)
@@ -1022,12 +1069,22 @@ func (s *KeeperTestSuite) TestMsgMintNFT() {
 		TokenType: s.nftClassID,
 		Name:      "tester",
 		Meta:      "Mint NFT",
+		Royalty: &collection.Royalty{
+			Recipient: s.vendor.String(),
+			Rate:      sdk.NewDecWithPrec(1, 1),
+		},
+		Attributes: []collection.Attribute{{
+			Key:   "level",
+			Value: "3",
+		}},
 	}}
 	expectedTokens := []collection.NFT{
 		{
-			TokenId: "1000000100000016",
-			Name:    params[0].Name,
-			Meta:    params[0].Meta,
+			TokenId:    "1000000100000016",
+			Name:       params[0].Name,
+			Meta:       params[0].Meta,
+			Royalty:    params[0].Royalty,
+			Attributes: params[0].Attributes,
 		},
 	}
 
@@ -1074,6 +1131,32 @@ func (s *KeeperTestSuite) TestMsgMintNFT() {
 			}},
 			err: collection.ErrTokenTypeNotExist,
 		},
+		"invalid attributes": {
+			contractID: s.contractID,
+			from:       s.vendor,
+			params: []collection.MintNFTParam{{
+				TokenType: s.nftClassID,
+				Name:      "tester",
+				Attributes: []collection.Attribute{{
+					Key:   "level",
+					Value: "high",
+				}},
+			}},
+			err: collection.ErrInvalidAttribute,
+		},
+		"undeclared attribute": {
+			contractID: s.contractID,
+			from:       s.vendor,
+			params: []collection.MintNFTParam{{
+				TokenType: s.nftClassID,
+				Name:      "tester",
+				Attributes: []collection.Attribute{{
+					Key:   "color",
+					Value: "red",
+				}},
+			}},
+			err: collection.ErrInvalidAttribute,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1651,6 +1734,103 @@ func (s *KeeperTestSuite) TestMsgModify() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgSetRoyalty() {
+	expectedTokenIndex := collection.NewNFTID(s.nftClassID, 1)[8:]
+	royalty := &collection.Royalty{
+		Recipient: s.vendor.String(),
+		Rate:      sdk.NewDecWithPrec(25, 3),
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		tokenIndex string
+		royalty    *collection.Royalty
+		err        error
+	}{
+		"valid request (class)": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			royalty:    royalty,
+		},
+		"valid request (nft)": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			tokenIndex: expectedTokenIndex,
+			royalty:    royalty,
+		},
+		"valid request (removal)": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			tokenIndex: expectedTokenIndex,
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			operator:   s.vendor,
+			royalty:    royalty,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			operator:   s.customer,
+			tokenIndex: expectedTokenIndex,
+			royalty:    royalty,
+			err:        collection.ErrTokenNoPermission,
+		},
+		"nft not found": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			tokenIndex: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1)[8:],
+			royalty:    royalty,
+			err:        collection.ErrTokenNotExist,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			req := &collection.MsgSetRoyalty{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				TokenType:  s.nftClassID,
+				TokenIndex: tc.tokenIndex,
+				Royalty:    tc.royalty,
+			}
+			res, err := s.msgServer.SetRoyalty(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			var attrRoyalty []byte
+			if tc.royalty == nil {
+				attrRoyalty = []byte("null")
+			} else {
+				attrRoyalty = testutil.MustJSONMarshal(tc.royalty)
+			}
+			events := sdk.Events{
+				sdk.Event{
+					Type: "lbm.collection.v1.EventRoyaltySet",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(tc.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(tc.operator.String()), Index: false},
+						{Key: []byte("royalty"), Value: attrRoyalty, Index: false},
+						{Key: []byte("token_index"), Value: testutil.W(tc.tokenIndex), Index: false},
+						{Key: []byte("token_type"), Value: testutil.W(s.nftClassID), Index: false},
+					},
+				},
+			}
+			s.Require().Equal(events, ctx.EventManager().Events())
+
+			got, err := s.keeper.GetRoyalty(ctx, tc.contractID, collection.NewNFTID(s.nftClassID, 1))
+			s.Require().NoError(err)
+			s.Require().Equal(tc.royalty, got)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgGrantPermission() {
 	testCases := map[string]struct {
 		contractID string
@@ -2101,3 +2281,81 @@ func (s *KeeperTestSuite) extractChainedNFTIDs(root string) []string {
 	}
 	return allTokenIDs
 }
+
+func (s *KeeperTestSuite) TestMsgApprove() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *collection.MsgApprove
+		events         sdk.Events
+		expectedError  error
+	}{
+		"valid request": {
+			req: &collection.MsgApprove{
+				ContractId: s.contractID,
+				Holder:     s.customer.String(),
+				Spender:    s.vendor.String(),
+				TokenId:    collection.NewFTID(s.ftClassID),
+				SpendLimit: s.balance,
+			},
+			events: sdk.Events{sdk.Event{
+				Type: "lbm.collection.v1.EventApproved",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("expiration"), Value: []byte("null"), Index: false},
+					{Key: []byte("holder"), Value: testutil.W(s.customer.String()), Index: false},
+					{Key: []byte("spend_limit"), Value: testutil.W(s.balance), Index: false},
+					{Key: []byte("spender"), Value: testutil.W(s.vendor.String()), Index: false},
+					{Key: []byte("token_id"), Value: testutil.W(collection.NewFTID(s.ftClassID)), Index: false},
+				},
+			}},
+		},
+		"contract not found": {
+			isNegativeCase: true,
+			req: &collection.MsgApprove{
+				ContractId: "deadbeef",
+				Holder:     s.customer.String(),
+				Spender:    s.vendor.String(),
+				TokenId:    collection.NewFTID(s.ftClassID),
+				SpendLimit: s.balance,
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"token class not found": {
+			isNegativeCase: true,
+			req: &collection.MsgApprove{
+				ContractId: s.contractID,
+				Holder:     s.customer.String(),
+				Spender:    s.vendor.String(),
+				TokenId:    collection.NewFTID("00bab10c"),
+				SpendLimit: s.balance,
+			},
+			expectedError: sdkerrors.ErrNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			s.Require().NoError(tc.req.ValidateBasic())
+			holder, err := sdk.AccAddressFromBech32(tc.req.Holder)
+			s.Require().NoError(err)
+			spender, err := sdk.AccAddressFromBech32(tc.req.Spender)
+			s.Require().NoError(err)
+			ctx, _ := s.ctx.CacheContext()
+
+			// Act
+			res, err := s.msgServer.Approve(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+			allowance := s.keeper.GetAllowance(ctx, tc.req.ContractId, holder, spender, tc.req.TokenId)
+			s.Require().True(tc.req.SpendLimit.Equal(allowance.SpendLimit))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
@@ -183,6 +184,64 @@ func (k Keeper) iterateAncestors(ctx sdk.Context, contractID, tokenID string, fn
 	return nil
 }
 
+// SetTokenClassRoyalty sets the royalty of a class of non-fungible token.
+func (k Keeper) SetTokenClassRoyalty(ctx sdk.Context, contractID, classID string, royalty *collection.Royalty) error {
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		return collection.ErrTokenTypeNotExist.Wrap(err.Error())
+	}
+
+	nftClass, ok := class.(*collection.NFTClass)
+	if !ok {
+		return collection.ErrTokenTypeNotExist.Wrapf("not a class of non-fungible token: %s", classID)
+	}
+
+	nftClass.Royalty = royalty
+	k.setTokenClass(ctx, contractID, nftClass)
+
+	return nil
+}
+
+// SetNFTRoyalty sets the royalty of a non-fungible token.
+func (k Keeper) SetNFTRoyalty(ctx sdk.Context, contractID, tokenID string, royalty *collection.Royalty) error {
+	token, err := k.GetNFT(ctx, contractID, tokenID)
+	if err != nil {
+		return err
+	}
+
+	token.Royalty = royalty
+	k.setNFT(ctx, contractID, *token)
+
+	return nil
+}
+
+// GetRoyalty returns the royalty applied to a non-fungible token.
+// The royalty of the token takes precedence over that of its class,
+// and nil is returned if neither exists.
+func (k Keeper) GetRoyalty(ctx sdk.Context, contractID, tokenID string) (*collection.Royalty, error) {
+	token, err := k.GetNFT(ctx, contractID, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Royalty != nil {
+		return token.Royalty, nil
+	}
+
+	classID := collection.SplitTokenID(tokenID)
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		panic(err)
+	}
+
+	nftClass, ok := class.(*collection.NFTClass)
+	if !ok {
+		panic(sdkerrors.ErrInvalidType.Wrapf("not a class of non-fungible token: %s", classID))
+	}
+
+	return nftClass.Royalty, nil
+}
+
 func (k Keeper) GetRootOwner(ctx sdk.Context, contractID, tokenID string) sdk.AccAddress {
 	rootID := k.GetRoot(ctx, contractID, tokenID)
 	return k.getOwner(ctx, contractID, rootID)
@@ -204,6 +263,8 @@ func (k Keeper) getOwner(ctx sdk.Context, contractID, tokenID string) sdk.AccAdd
 }
 
 func (k Keeper) setOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.AccAddress) {
+	k.deleteOwner(ctx, contractID, tokenID)
+
 	store := ctx.KVStore(k.storeKey)
 	key := ownerKey(contractID, tokenID)
 
@@ -212,11 +273,25 @@ func (k Keeper) setOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.
 		panic(err)
 	}
 	store.Set(key, bz)
+
+	// update the reverse index
+	store.Set(ownedNFTKey(contractID, owner, tokenID), []byte{})
 }
 
 func (k Keeper) deleteOwner(ctx sdk.Context, contractID, tokenID string) {
 	store := ctx.KVStore(k.storeKey)
 	key := ownerKey(contractID, tokenID)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var owner sdk.AccAddress
+	if err := owner.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	store.Delete(ownedNFTKey(contractID, owner, tokenID))
+
 	store.Delete(key)
 }
 
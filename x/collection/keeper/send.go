@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
@@ -136,3 +139,101 @@ func (k Keeper) deleteAuthorization(ctx sdk.Context, contractID string, holder,
 	key := authorizationKey(contractID, operator, holder)
 	store.Delete(key)
 }
+
+// Approve sets the allowance of the spender on the holder's fungible token.
+// A zero spend limit removes the allowance.
+func (k Keeper) Approve(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, tokenID string, spendLimit sdk.Int, expiration *time.Time) error {
+	classID := collection.SplitTokenID(tokenID)
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := class.(*collection.FTClass); !ok {
+		return collection.ErrTokenNotExist.Wrapf("not a class of fungible token: %s", classID)
+	}
+
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expiration %s must be after the block time", expiration)
+	}
+
+	if spendLimit.IsZero() {
+		k.deleteAllowance(ctx, contractID, holder, spender, tokenID)
+		return nil
+	}
+
+	k.setAllowance(ctx, contractID, collection.Allowance{
+		Holder:     holder.String(),
+		Spender:    spender.String(),
+		TokenId:    tokenID,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	})
+
+	return nil
+}
+
+// GetAllowance returns the allowance of the spender on the holder's fungible token.
+// The spend limit is zero if there is no allowance or it has expired.
+func (k Keeper) GetAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, tokenID string) collection.Allowance {
+	allowance := collection.Allowance{
+		Holder:     holder.String(),
+		Spender:    spender.String(),
+		TokenId:    tokenID,
+		SpendLimit: sdk.ZeroInt(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(allowanceKey(contractID, spender, holder, tokenID))
+	if bz == nil {
+		return allowance
+	}
+
+	var stored collection.Allowance
+	k.cdc.MustUnmarshal(bz, &stored)
+	if stored.Expiration != nil && !ctx.BlockTime().Before(*stored.Expiration) {
+		return allowance
+	}
+
+	return stored
+}
+
+// spendAllowances decreases the allowances of the spender by the amount.
+func (k Keeper) spendAllowances(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount []collection.Coin) error {
+	for _, coin := range amount {
+		allowance := k.GetAllowance(ctx, contractID, holder, spender, coin.TokenId)
+		if allowance.SpendLimit.IsZero() {
+			return collection.ErrCollectionNotApproved.Wrapf("no allowance on %s by %s to %s", coin.TokenId, holder, spender)
+		}
+
+		remaining := allowance.SpendLimit.Sub(coin.Amount)
+		if remaining.IsNegative() {
+			return collection.ErrInsufficientAllowance.Wrapf("%s is smaller than %s", allowance.SpendLimit, coin.Amount)
+		}
+
+		if remaining.IsZero() {
+			k.deleteAllowance(ctx, contractID, holder, spender, coin.TokenId)
+		} else {
+			allowance.SpendLimit = remaining
+			k.setAllowance(ctx, contractID, allowance)
+		}
+	}
+
+	return nil
+}
+
+// setAllowance sets the allowance.
+// The caller must validate `allowance`.
+func (k Keeper) setAllowance(ctx sdk.Context, contractID string, allowance collection.Allowance) {
+	store := ctx.KVStore(k.storeKey)
+	holder := sdk.MustAccAddressFromBech32(allowance.Holder)
+	spender := sdk.MustAccAddressFromBech32(allowance.Spender)
+	key := allowanceKey(contractID, spender, holder, allowance.TokenId)
+	store.Set(key, k.cdc.MustMarshal(&allowance))
+}
+
+func (k Keeper) deleteAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	key := allowanceKey(contractID, spender, holder, tokenID)
+	store.Delete(key)
+}
@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
@@ -42,6 +44,10 @@ func (s *KeeperTestSuite) TestSendCoins() {
 			newOperatorBalance := s.keeper.GetBalance(ctx, s.contractID, s.operator, tokenID)
 			s.Require().True(customerBalance.Sub(tc.amount.Amount).Equal(newCustomerBalance))
 			s.Require().True(operatorBalance.Add(tc.amount.Amount).Equal(newOperatorBalance))
+
+			if err := collection.ValidateNFTID(tokenID); err == nil {
+				s.Require().Equal(s.operator, s.keeper.GetRootOwner(ctx, s.contractID, tokenID))
+			}
 		})
 	}
 }
@@ -109,3 +115,93 @@ func (s *KeeperTestSuite) TestRevokeOperator() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestApprove() {
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	pastExpiration := s.ctx.BlockTime()
+
+	testCases := map[string]struct {
+		tokenID    string
+		spendLimit sdk.Int
+		expiration *time.Time
+		err        error
+	}{
+		"set allowance": {
+			tokenID:    collection.NewFTID(s.ftClassID),
+			spendLimit: s.balance,
+		},
+		"set allowance with expiration": {
+			tokenID:    collection.NewFTID(s.ftClassID),
+			spendLimit: s.balance,
+			expiration: &expiration,
+		},
+		"remove allowance": {
+			tokenID:    collection.NewFTID(s.ftClassID),
+			spendLimit: sdk.ZeroInt(),
+		},
+		"token class not found": {
+			tokenID:    collection.NewFTID("00bab10c"),
+			spendLimit: s.balance,
+			err:        sdkerrors.ErrNotFound,
+		},
+		"not a class of fungible token": {
+			tokenID:    collection.NewFTID(s.nftClassID),
+			spendLimit: s.balance,
+			err:        collection.ErrTokenNotExist,
+		},
+		"past expiration": {
+			tokenID:    collection.NewFTID(s.ftClassID),
+			spendLimit: s.balance,
+			expiration: &pastExpiration,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Approve(ctx, s.contractID, s.customer, s.vendor, tc.tokenID, tc.spendLimit, tc.expiration)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			allowance := s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.vendor, tc.tokenID)
+			s.Require().True(tc.spendLimit.Equal(allowance.SpendLimit))
+			s.Require().Equal(tc.expiration, allowance.Expiration)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetAllowance() {
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	tokenID := collection.NewFTID(s.ftClassID)
+
+	testCases := map[string]struct {
+		blockTime  time.Time
+		spendLimit sdk.Int
+	}{
+		"before expiration": {
+			blockTime:  expiration.Add(-time.Second),
+			spendLimit: s.allowance,
+		},
+		"at expiration": {
+			blockTime:  expiration,
+			spendLimit: sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Approve(ctx, s.contractID, s.customer, s.vendor, tokenID, s.allowance, &expiration)
+			s.Require().NoError(err)
+
+			ctx = ctx.WithBlockTime(tc.blockTime)
+			allowance := s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.vendor, tokenID)
+			s.Require().True(tc.spendLimit.Equal(allowance.SpendLimit))
+		})
+	}
+}
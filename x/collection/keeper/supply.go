@@ -203,10 +203,15 @@ func (k Keeper) MintNFT(ctx sdk.Context, contractID string, to sdk.AccAddress, p
 			return nil, collection.ErrTokenTypeNotExist.Wrap(err.Error())
 		}
 
-		if _, ok := class.(*collection.NFTClass); !ok {
+		nftClass, ok := class.(*collection.NFTClass)
+		if !ok {
 			return nil, collection.ErrTokenTypeNotExist.Wrapf("not a class of non-fungible token: %s", classID)
 		}
 
+		if err := nftClass.ValidateNFTAttributes(param.Attributes); err != nil {
+			return nil, err
+		}
+
 		nextTokenID := k.getNextTokenID(ctx, contractID, classID)
 		k.setNextTokenID(ctx, contractID, classID, nextTokenID.Incr())
 		tokenID := collection.NewNFTID(classID, int(nextTokenID.Uint64()))
@@ -217,9 +222,11 @@ func (k Keeper) MintNFT(ctx sdk.Context, contractID string, to sdk.AccAddress, p
 		k.setOwner(ctx, contractID, tokenID, to)
 
 		token := collection.NFT{
-			TokenId: tokenID,
-			Name:    param.Name,
-			Meta:    param.Meta,
+			TokenId:    tokenID,
+			Name:       param.Name,
+			Meta:       param.Meta,
+			Royalty:    param.Royalty,
+			Attributes: param.Attributes,
 		}
 		k.setNFT(ctx, contractID, token)
 
@@ -365,6 +372,22 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID, tokenID string, operator
 		return err
 	}
 
+	classID := collection.SplitTokenID(tokenID)
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		panic(err)
+	}
+
+	nftClass, ok := class.(*collection.NFTClass)
+	if !ok {
+		panic(sdkerrors.ErrInvalidType.Wrapf("not a class of non-fungible token: %s", classID))
+	}
+
+	definitions := make(map[string]collection.AttributeDefinition, len(nftClass.AttributeSchema))
+	for _, definition := range nftClass.AttributeSchema {
+		definitions[definition.Key] = definition
+	}
+
 	modifiers := map[collection.AttributeKey]func(string){
 		collection.AttributeKeyName: func(name string) {
 			token.Name = name
@@ -375,7 +398,23 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID, tokenID string, operator
 	}
 	for _, change := range changes {
 		key := collection.AttributeKeyFromString(change.Key)
-		modifiers[key](change.Value)
+		if modifier, ok := modifiers[key]; ok {
+			modifier(change.Value)
+			continue
+		}
+
+		definition, ok := definitions[change.Key]
+		if !ok {
+			return collection.ErrInvalidAttribute.Wrapf("undeclared attribute: %s", change.Key)
+		}
+		if !definition.Mutable {
+			return collection.ErrImmutableAttribute.Wrap(change.Key)
+		}
+		token.Attributes = updateAttributes(token.Attributes, change)
+	}
+
+	if err := nftClass.ValidateNFTAttributes(token.Attributes); err != nil {
+		return err
 	}
 
 	k.setNFT(ctx, contractID, *token)
@@ -383,6 +422,26 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID, tokenID string, operator
 	return nil
 }
 
+// updateAttributes sets the value of the attribute, or deletes the attribute if the value is empty.
+func updateAttributes(attributes []collection.Attribute, change collection.Attribute) []collection.Attribute {
+	for i, attribute := range attributes {
+		if attribute.Key != change.Key {
+			continue
+		}
+
+		if len(change.Value) == 0 {
+			return append(attributes[:i], attributes[i+1:]...)
+		}
+		attributes[i].Value = change.Value
+		return attributes
+	}
+
+	if len(change.Value) == 0 {
+		return attributes
+	}
+	return append(attributes, change)
+}
+
 func (k Keeper) Grant(ctx sdk.Context, contractID string, granter, grantee sdk.AccAddress, permission collection.Permission) {
 	k.grant(ctx, contractID, grantee, permission)
 
@@ -352,3 +352,52 @@ func (s *KeeperTestSuite) TestModifyNFT() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestModifyNFTAttributes() {
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+
+	testCases := map[string]struct {
+		changes  []collection.Attribute
+		err      error
+		expected []collection.Attribute
+	}{
+		"set an attribute": {
+			changes:  []collection.Attribute{{Key: "level", Value: "7"}},
+			expected: []collection.Attribute{{Key: "level", Value: "7"}},
+		},
+		"set and delete an attribute": {
+			changes: []collection.Attribute{
+				{Key: "level", Value: "7"},
+				{Key: "level", Value: ""},
+			},
+		},
+		"undeclared attribute": {
+			changes: []collection.Attribute{{Key: "color", Value: "red"}},
+			err:     collection.ErrInvalidAttribute,
+		},
+		"immutable attribute": {
+			changes: []collection.Attribute{{Key: "habitat", Value: "desert"}},
+			err:     collection.ErrImmutableAttribute,
+		},
+		"invalid value": {
+			changes: []collection.Attribute{{Key: "level", Value: "07"}},
+			err:     collection.ErrInvalidAttribute,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.ModifyNFT(ctx, s.contractID, tokenID, s.vendor, tc.changes)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			nft, err := s.keeper.GetNFT(ctx, s.contractID, tokenID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, nft.Attributes)
+		})
+	}
+}
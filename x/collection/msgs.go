@@ -17,6 +17,9 @@ const (
 	uriLengthLimit  = 1000
 	metaLengthLimit = 1000
 	changesLimit    = 100
+
+	attributeValueLengthLimit = 1000
+	attributesLimit           = 100
 )
 
 var (
@@ -214,6 +217,23 @@ func validateTokenClassChange(change Attribute) error {
 	return validateChange(change, validators)
 }
 
+// validateNFTChange also accepts the changes on the attributes of the token,
+// where an empty value deletes the attribute.
+func validateNFTChange(change Attribute) error {
+	if AttributeKeyFromString(change.Key) != AttributeKeyUnspecified {
+		return validateTokenClassChange(change)
+	}
+
+	if err := validateAttributeKey(change.Key); err != nil {
+		return ErrInvalidChangesField.Wrap(err.Error())
+	}
+	if err := validateStringSize(change.Value, attributeValueLengthLimit, change.Key); err != nil {
+		return ErrInvalidAttribute.Wrap(err.Error())
+	}
+
+	return nil
+}
+
 func validateChange(change Attribute, validators map[string]func(string) error) error {
 	validator, ok := validators[change.Key]
 	if !ok {
@@ -466,6 +486,64 @@ func (m MsgAuthorizeOperator) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgApprove)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgApprove) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	holderAcc, err := sdk.AccAddressFromBech32(m.Holder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	spenderAcc, err := sdk.AccAddressFromBech32(m.Spender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", m.Spender)
+	}
+
+	if holderAcc.Equals(spenderAcc) {
+		return ErrApproverProxySame
+	}
+
+	if err := ValidateFTID(m.TokenId); err != nil {
+		return err
+	}
+
+	if m.SpendLimit.IsNil() || m.SpendLimit.IsNegative() {
+		return ErrInvalidAmount.Wrapf("spend limit must not be negative: %s", m.SpendLimit)
+	}
+
+	if m.Expiration != nil && m.Expiration.Unix() <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid expiration: %s", m.Expiration)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgApprove) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgApprove) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgRevokeOperator)(nil)
 
 // ValidateBasic implements Msg.
@@ -636,6 +714,14 @@ func (m MsgIssueNFT) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", m.Owner)
 	}
 
+	if err := ValidateRoyalty(m.Royalty); err != nil {
+		return err
+	}
+
+	if err := ValidateAttributeSchema(m.AttributeSchema); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -737,6 +823,14 @@ func (m MsgMintNFT) ValidateBasic() error {
 		if err := validateMeta(param.Meta); err != nil {
 			return err
 		}
+
+		if err := ValidateRoyalty(param.Royalty); err != nil {
+			return err
+		}
+
+		if err := ValidateAttributes(param.Attributes); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -975,6 +1069,9 @@ func (m MsgModify) ValidateBasic() error {
 	}
 
 	validator := validateTokenClassChange
+	if len(m.TokenIndex) != 0 && ValidateNFTID(m.TokenType+m.TokenIndex) == nil {
+		validator = validateNFTChange
+	}
 	if len(m.TokenType) == 0 {
 		if len(m.TokenIndex) == 0 {
 			validator = validateContractChange
@@ -1039,6 +1136,56 @@ func (m MsgModify) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgSetRoyalty)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSetRoyalty) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", m.Operator)
+	}
+
+	if err := ValidateLegacyNFTClassID(m.TokenType); err != nil {
+		return err
+	}
+
+	if len(m.TokenIndex) != 0 {
+		if err := ValidateNFTID(m.TokenType + m.TokenIndex); err != nil {
+			return ErrInvalidTokenIndex.Wrap(err.Error())
+		}
+	}
+
+	if err := ValidateRoyalty(m.Royalty); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSetRoyalty) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSetRoyalty) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSetRoyalty) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSetRoyalty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgGrantPermission)(nil)
 
 // ValidateBasic implements Msg.
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -522,6 +523,123 @@ func TestMsgRevokeOperator(t *testing.T) {
 	}
 }
 
+func TestMsgApprove(t *testing.T) {
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	ftID := collection.NewFTID("00bab10c")
+	expiration := time.Unix(1, 0).UTC()
+	pastExpiration := time.Time{}
+	testCases := map[string]struct {
+		contractID string
+		holder     string
+		spender    string
+		tokenID    string
+		spendLimit sdk.Int
+		expiration *time.Time
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+		},
+		"valid msg (expiration)": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+			expiration: &expiration,
+		},
+		"valid msg (removal)": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.ZeroInt(),
+		},
+		"invalid contract id": {
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+			err:        class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"empty spender": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"spender and holder should be different": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[0],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+			err:        collection.ErrApproverProxySame,
+		},
+		"invalid token id": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			spendLimit: sdk.OneInt(),
+			err:        collection.ErrInvalidTokenID,
+		},
+		"negative spend limit": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt().Neg(),
+			err:        collection.ErrInvalidAmount,
+		},
+		"past expiration": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			tokenID:    ftID,
+			spendLimit: sdk.OneInt(),
+			expiration: &pastExpiration,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgApprove{
+				ContractId: tc.contractID,
+				Holder:     tc.holder,
+				Spender:    tc.spender,
+				TokenId:    tc.tokenID,
+				SpendLimit: tc.spendLimit,
+				Expiration: tc.expiration,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.holder)}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgCreateContract(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
@@ -745,6 +863,7 @@ func TestMsgIssueNFT(t *testing.T) {
 		operator   sdk.AccAddress
 		name       string
 		meta       string
+		schema     []collection.AttributeDefinition
 		err        error
 	}{
 		"valid msg": {
@@ -779,15 +898,24 @@ func TestMsgIssueNFT(t *testing.T) {
 			meta:       string(make([]rune, 1001)),
 			err:        collection.ErrInvalidMetaLength,
 		},
+		"invalid attribute schema": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			schema:     []collection.AttributeDefinition{{Key: "level"}},
+			err:        collection.ErrInvalidAttributeSchema,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			msg := collection.MsgIssueNFT{
-				ContractId: tc.contractID,
-				Owner:      tc.operator.String(),
-				Name:       tc.name,
-				Meta:       tc.meta,
+				ContractId:      tc.contractID,
+				Owner:           tc.operator.String(),
+				Name:            tc.name,
+				Meta:            tc.meta,
+				AttributeSchema: tc.schema,
 			}
 
 			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
@@ -984,6 +1112,17 @@ func TestMsgMintNFT(t *testing.T) {
 			}},
 			err: collection.ErrInvalidMetaLength,
 		},
+		"param of invalid attributes": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType:  "deadbeef",
+				Name:       "tibetian fox",
+				Attributes: []collection.Attribute{{Key: "level"}},
+			}},
+			err: collection.ErrInvalidAttribute,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1350,6 +1489,28 @@ func TestMsgModify(t *testing.T) {
 			owner:      addrs[0],
 			changes:    changes,
 		},
+		"valid nft attribute modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: "level", Value: "3"}},
+		},
+		"invalid nft attribute modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: "Level", Value: "3"}},
+			err:        collection.ErrInvalidChangesField,
+		},
+		"attribute modification on token class": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: "level", Value: "3"}},
+			err:        collection.ErrInvalidChangesField,
+		},
 		"invalid contract id": {
 			owner:   addrs[0],
 			changes: changes,
@@ -1414,6 +1575,110 @@ func TestMsgModify(t *testing.T) {
 	}
 }
 
+func TestMsgSetRoyalty(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	royalty := &collection.Royalty{
+		Recipient: addrs[1].String(),
+		Rate:      sdk.NewDecWithPrec(5, 2),
+	}
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		tokenType  string
+		tokenIndex string
+		royalty    *collection.Royalty
+		err        error
+	}{
+		"valid token class royalty": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "deadbeef",
+			royalty:    royalty,
+		},
+		"valid nft royalty": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			royalty:    royalty,
+		},
+		"valid royalty removal": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "deadbeef",
+		},
+		"invalid contract id": {
+			operator:  addrs[0],
+			tokenType: "deadbeef",
+			royalty:   royalty,
+			err:       class.ErrInvalidContractID,
+		},
+		"invalid operator": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			royalty:    royalty,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid token type": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "00bab10c",
+			royalty:    royalty,
+			err:        collection.ErrInvalidTokenType,
+		},
+		"invalid token index": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "deadbeef",
+			tokenIndex: "00000000",
+			royalty:    royalty,
+			err:        collection.ErrInvalidTokenIndex,
+		},
+		"invalid recipient": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "deadbeef",
+			royalty: &collection.Royalty{
+				Rate: royalty.Rate,
+			},
+			err: collection.ErrInvalidRoyalty,
+		},
+		"invalid rate": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			tokenType:  "deadbeef",
+			royalty: &collection.Royalty{
+				Recipient: royalty.Recipient,
+				Rate:      sdk.NewDecWithPrec(11, 1),
+			},
+			err: collection.ErrInvalidRoyalty,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgSetRoyalty{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				TokenType:  tc.tokenType,
+				TokenIndex: tc.tokenIndex,
+				Royalty:    tc.royalty,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgGrantPermission(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 2)
 	for i := range addrs {
@@ -1893,6 +2158,17 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.collection.v1.MsgAuthorizeOperator",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgAuthorizeOperator\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
 		},
+		"MsgApprove": {
+			&collection.MsgApprove{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Spender:    addrs[1].String(),
+				TokenId:    collection.NewFTID(ftClassId),
+				SpendLimit: sdk.OneInt(),
+			},
+			"/lbm.collection.v1.MsgApprove",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgApprove\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"spend_limit\":\"1\",\"spender\":\"%s\",\"token_id\":\"00bab10c00000000\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
 		"MsgRevokeOperator": {
 			&collection.MsgRevokeOperator{
 				ContractId: contractId,
@@ -2005,6 +2281,20 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.collection.v1.MsgModify",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgModify\",\"value\":{\"changes\":[{\"key\":\"name\",\"value\":\"New test\"}],\"contract_id\":\"deadbeef\",\"owner\":\"%s\",\"token_index\":\"deadbeef\",\"token_type\":\"NewType\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgSetRoyalty": {
+			&collection.MsgSetRoyalty{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+				TokenType:  "deadbeef",
+				TokenIndex: "00000001",
+				Royalty: &collection.Royalty{
+					Recipient: addrs[1].String(),
+					Rate:      sdk.NewDecWithPrec(5, 2),
+				},
+			},
+			"/lbm.collection.v1.MsgSetRoyalty",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSetRoyalty\",\"value\":{\"contract_id\":\"deadbeef\",\"operator\":\"%s\",\"royalty\":{\"rate\":\"0.050000000000000000\",\"recipient\":\"%s\"},\"token_index\":\"00000001\",\"token_type\":\"deadbeef\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
 		"MsgGrantPermission": {
 			&collection.MsgGrantPermission{
 				ContractId: contractId,
@@ -10,18 +10,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -699,6 +703,127 @@ func (m *MsgRevokeOperatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeOperatorResponse proto.InternalMessageInfo
 
+// MsgApprove is the Msg/Approve request type.
+type MsgApprove struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the holder which approves the allowance.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// token id of the fungible token.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// number of tokens the spender can send.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"spend_limit"`
+	// time when the allowance expires. The allowance never expires if not set.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *MsgApprove) Reset()         { *m = MsgApprove{} }
+func (m *MsgApprove) String() string { return proto.CompactTextString(m) }
+func (*MsgApprove) ProtoMessage()    {}
+func (*MsgApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{12}
+}
+func (m *MsgApprove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprove.Merge(m, src)
+}
+func (m *MsgApprove) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApprove proto.InternalMessageInfo
+
+func (m *MsgApprove) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgApprove) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *MsgApprove) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *MsgApprove) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *MsgApprove) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// MsgApproveResponse is the Msg/Approve response type.
+type MsgApproveResponse struct {
+}
+
+func (m *MsgApproveResponse) Reset()         { *m = MsgApproveResponse{} }
+func (m *MsgApproveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveResponse) ProtoMessage()    {}
+func (*MsgApproveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{13}
+}
+func (m *MsgApproveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveResponse.Merge(m, src)
+}
+func (m *MsgApproveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveResponse proto.InternalMessageInfo
+
 // MsgCreateContract is the Msg/CreateContract request type.
 //
 // Signer: `owner`
@@ -717,7 +842,7 @@ func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
 func (m *MsgCreateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContract) ProtoMessage()    {}
 func (*MsgCreateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{12}
+	return fileDescriptor_eaee77977a3cfe12, []int{14}
 }
 func (m *MsgCreateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +909,7 @@ func (m *MsgCreateContractResponse) Reset()         { *m = MsgCreateContractResp
 func (m *MsgCreateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContractResponse) ProtoMessage()    {}
 func (*MsgCreateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{13}
+	return fileDescriptor_eaee77977a3cfe12, []int{15}
 }
 func (m *MsgCreateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +975,7 @@ func (m *MsgIssueFT) Reset()         { *m = MsgIssueFT{} }
 func (m *MsgIssueFT) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueFT) ProtoMessage()    {}
 func (*MsgIssueFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{14}
+	return fileDescriptor_eaee77977a3cfe12, []int{16}
 }
 func (m *MsgIssueFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1065,7 @@ func (m *MsgIssueFTResponse) Reset()         { *m = MsgIssueFTResponse{} }
 func (m *MsgIssueFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueFTResponse) ProtoMessage()    {}
 func (*MsgIssueFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{15}
+	return fileDescriptor_eaee77977a3cfe12, []int{17}
 }
 func (m *MsgIssueFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,13 +1113,18 @@ type MsgIssueNFT struct {
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	// the address of the grantee which must have the permission to issue a token.
 	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// royalty applied to the tokens of the class (optional).
+	Royalty *Royalty `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// attribute schema which the attributes of the tokens must conform to (optional).
+	// Note: it cannot be changed after the issuance.
+	AttributeSchema []AttributeDefinition `protobuf:"bytes,6,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema,omitempty"`
 }
 
 func (m *MsgIssueNFT) Reset()         { *m = MsgIssueNFT{} }
 func (m *MsgIssueNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueNFT) ProtoMessage()    {}
 func (*MsgIssueNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{16}
+	return fileDescriptor_eaee77977a3cfe12, []int{18}
 }
 func (m *MsgIssueNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1051,6 +1181,20 @@ func (m *MsgIssueNFT) GetOwner() string {
 	return ""
 }
 
+func (m *MsgIssueNFT) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *MsgIssueNFT) GetAttributeSchema() []AttributeDefinition {
+	if m != nil {
+		return m.AttributeSchema
+	}
+	return nil
+}
+
 // MsgIssueNFTResponse is the Msg/IssueNFT response type.
 type MsgIssueNFTResponse struct {
 	// id of the new token type.
@@ -1062,7 +1206,7 @@ func (m *MsgIssueNFTResponse) Reset()         { *m = MsgIssueNFTResponse{} }
 func (m *MsgIssueNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueNFTResponse) ProtoMessage()    {}
 func (*MsgIssueNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{17}
+	return fileDescriptor_eaee77977a3cfe12, []int{19}
 }
 func (m *MsgIssueNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,7 +1263,7 @@ func (m *MsgMintFT) Reset()         { *m = MsgMintFT{} }
 func (m *MsgMintFT) String() string { return proto.CompactTextString(m) }
 func (*MsgMintFT) ProtoMessage()    {}
 func (*MsgMintFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{18}
+	return fileDescriptor_eaee77977a3cfe12, []int{20}
 }
 func (m *MsgMintFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1330,7 @@ func (m *MsgMintFTResponse) Reset()         { *m = MsgMintFTResponse{} }
 func (m *MsgMintFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintFTResponse) ProtoMessage()    {}
 func (*MsgMintFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{19}
+	return fileDescriptor_eaee77977a3cfe12, []int{21}
 }
 func (m *MsgMintFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1377,7 @@ func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
 func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgMintNFT) ProtoMessage()    {}
 func (*MsgMintNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{20}
+	return fileDescriptor_eaee77977a3cfe12, []int{22}
 }
 func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1444,7 @@ func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
 func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintNFTResponse) ProtoMessage()    {}
 func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{21}
+	return fileDescriptor_eaee77977a3cfe12, []int{23}
 }
 func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,13 +1492,18 @@ type MintNFTParam struct {
 	// meta is a brief description of the nft.
 	// Note: it has an app-specific limit in length.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty applied to the nft (optional).
+	// Note: it overrides the royalty of its class.
+	Royalty *Royalty `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// attributes of the nft, which must conform to the attribute schema of its class.
+	Attributes []Attribute `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (m *MintNFTParam) Reset()         { *m = MintNFTParam{} }
 func (m *MintNFTParam) String() string { return proto.CompactTextString(m) }
 func (*MintNFTParam) ProtoMessage()    {}
 func (*MintNFTParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{22}
+	return fileDescriptor_eaee77977a3cfe12, []int{24}
 }
 func (m *MintNFTParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,6 +1553,20 @@ func (m *MintNFTParam) GetMeta() string {
 	return ""
 }
 
+func (m *MintNFTParam) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *MintNFTParam) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // MsgBurnFT is the Msg/BurnFT request type.
 //
 // Deprecated: Do not use.
@@ -1422,7 +1585,7 @@ func (m *MsgBurnFT) Reset()         { *m = MsgBurnFT{} }
 func (m *MsgBurnFT) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnFT) ProtoMessage()    {}
 func (*MsgBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{23}
+	return fileDescriptor_eaee77977a3cfe12, []int{25}
 }
 func (m *MsgBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,7 +1645,7 @@ func (m *MsgBurnFTResponse) Reset()         { *m = MsgBurnFTResponse{} }
 func (m *MsgBurnFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnFTResponse) ProtoMessage()    {}
 func (*MsgBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{24}
+	return fileDescriptor_eaee77977a3cfe12, []int{26}
 }
 func (m *MsgBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1695,7 @@ func (m *MsgOperatorBurnFT) Reset()         { *m = MsgOperatorBurnFT{} }
 func (m *MsgOperatorBurnFT) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnFT) ProtoMessage()    {}
 func (*MsgOperatorBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{25}
+	return fileDescriptor_eaee77977a3cfe12, []int{27}
 }
 func (m *MsgOperatorBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1599,7 +1762,7 @@ func (m *MsgOperatorBurnFTResponse) Reset()         { *m = MsgOperatorBurnFTResp
 func (m *MsgOperatorBurnFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnFTResponse) ProtoMessage()    {}
 func (*MsgOperatorBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{26}
+	return fileDescriptor_eaee77977a3cfe12, []int{28}
 }
 func (m *MsgOperatorBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1644,7 +1807,7 @@ func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
 func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnNFT) ProtoMessage()    {}
 func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{27}
+	return fileDescriptor_eaee77977a3cfe12, []int{29}
 }
 func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +1865,7 @@ func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
 func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnNFTResponse) ProtoMessage()    {}
 func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{28}
+	return fileDescriptor_eaee77977a3cfe12, []int{30}
 }
 func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1750,7 +1913,7 @@ func (m *MsgOperatorBurnNFT) Reset()         { *m = MsgOperatorBurnNFT{} }
 func (m *MsgOperatorBurnNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnNFT) ProtoMessage()    {}
 func (*MsgOperatorBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{29}
+	return fileDescriptor_eaee77977a3cfe12, []int{31}
 }
 func (m *MsgOperatorBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +1978,7 @@ func (m *MsgOperatorBurnNFTResponse) Reset()         { *m = MsgOperatorBurnNFTRe
 func (m *MsgOperatorBurnNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnNFTResponse) ProtoMessage()    {}
 func (*MsgOperatorBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{30}
+	return fileDescriptor_eaee77977a3cfe12, []int{32}
 }
 func (m *MsgOperatorBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1868,7 +2031,7 @@ func (m *MsgModify) Reset()         { *m = MsgModify{} }
 func (m *MsgModify) String() string { return proto.CompactTextString(m) }
 func (*MsgModify) ProtoMessage()    {}
 func (*MsgModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{31}
+	return fileDescriptor_eaee77977a3cfe12, []int{33}
 }
 func (m *MsgModify) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1940,7 +2103,7 @@ func (m *MsgModifyResponse) Reset()         { *m = MsgModifyResponse{} }
 func (m *MsgModifyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyResponse) ProtoMessage()    {}
 func (*MsgModifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{32}
+	return fileDescriptor_eaee77977a3cfe12, []int{34}
 }
 func (m *MsgModifyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1969,6 +2132,130 @@ func (m *MsgModifyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgModifyResponse proto.InternalMessageInfo
 
+// MsgSetRoyalty is the Msg/SetRoyalty request type.
+//
+// Signer: `operator`
+type MsgSetRoyalty struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address of the grantee which must have modify permission.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// token type of the non-fungible token.
+	// refer to TokenType for the definition.
+	TokenType string `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// token index of the non-fungible token.
+	// if index is empty, it would set the royalty of the corresponding token type.
+	// if index is not empty, it would set the royalty of the corresponding nft.
+	TokenIndex string `protobuf:"bytes,4,opt,name=token_index,json=tokenIndex,proto3" json:"token_index,omitempty"`
+	// royalty to set.
+	Royalty *Royalty `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
+}
+
+func (m *MsgSetRoyalty) Reset()         { *m = MsgSetRoyalty{} }
+func (m *MsgSetRoyalty) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoyalty) ProtoMessage()    {}
+func (*MsgSetRoyalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{35}
+}
+func (m *MsgSetRoyalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoyalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoyalty.Merge(m, src)
+}
+func (m *MsgSetRoyalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoyalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRoyalty proto.InternalMessageInfo
+
+func (m *MsgSetRoyalty) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgSetRoyalty) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgSetRoyalty) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+func (m *MsgSetRoyalty) GetTokenIndex() string {
+	if m != nil {
+		return m.TokenIndex
+	}
+	return ""
+}
+
+func (m *MsgSetRoyalty) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+// MsgSetRoyaltyResponse is the Msg/SetRoyalty response type.
+type MsgSetRoyaltyResponse struct {
+}
+
+func (m *MsgSetRoyaltyResponse) Reset()         { *m = MsgSetRoyaltyResponse{} }
+func (m *MsgSetRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoyaltyResponse) ProtoMessage()    {}
+func (*MsgSetRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{36}
+}
+func (m *MsgSetRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoyaltyResponse.Merge(m, src)
+}
+func (m *MsgSetRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRoyaltyResponse proto.InternalMessageInfo
+
 // MsgGrantPermission is the Msg/GrantPermission request type.
 type MsgGrantPermission struct {
 	// contract id associated with the contract.
@@ -1985,7 +2272,7 @@ func (m *MsgGrantPermission) Reset()         { *m = MsgGrantPermission{} }
 func (m *MsgGrantPermission) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantPermission) ProtoMessage()    {}
 func (*MsgGrantPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{33}
+	return fileDescriptor_eaee77977a3cfe12, []int{37}
 }
 func (m *MsgGrantPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2050,7 +2337,7 @@ func (m *MsgGrantPermissionResponse) Reset()         { *m = MsgGrantPermissionRe
 func (m *MsgGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantPermissionResponse) ProtoMessage()    {}
 func (*MsgGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{34}
+	return fileDescriptor_eaee77977a3cfe12, []int{38}
 }
 func (m *MsgGrantPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,7 +2380,7 @@ func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
 func (m *MsgRevokePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokePermission) ProtoMessage()    {}
 func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{35}
+	return fileDescriptor_eaee77977a3cfe12, []int{39}
 }
 func (m *MsgRevokePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2151,7 +2438,7 @@ func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermission
 func (m *MsgRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokePermissionResponse) ProtoMessage()    {}
 func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{36}
+	return fileDescriptor_eaee77977a3cfe12, []int{40}
 }
 func (m *MsgRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2487,7 @@ func (m *MsgAttach) Reset()         { *m = MsgAttach{} }
 func (m *MsgAttach) String() string { return proto.CompactTextString(m) }
 func (*MsgAttach) ProtoMessage()    {}
 func (*MsgAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{37}
+	return fileDescriptor_eaee77977a3cfe12, []int{41}
 }
 func (m *MsgAttach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2267,7 +2554,7 @@ func (m *MsgAttachResponse) Reset()         { *m = MsgAttachResponse{} }
 func (m *MsgAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAttachResponse) ProtoMessage()    {}
 func (*MsgAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{38}
+	return fileDescriptor_eaee77977a3cfe12, []int{42}
 }
 func (m *MsgAttachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2601,7 @@ func (m *MsgDetach) Reset()         { *m = MsgDetach{} }
 func (m *MsgDetach) String() string { return proto.CompactTextString(m) }
 func (*MsgDetach) ProtoMessage()    {}
 func (*MsgDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{39}
+	return fileDescriptor_eaee77977a3cfe12, []int{43}
 }
 func (m *MsgDetach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,7 +2661,7 @@ func (m *MsgDetachResponse) Reset()         { *m = MsgDetachResponse{} }
 func (m *MsgDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDetachResponse) ProtoMessage()    {}
 func (*MsgDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{40}
+	return fileDescriptor_eaee77977a3cfe12, []int{44}
 }
 func (m *MsgDetachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,7 +2710,7 @@ func (m *MsgOperatorAttach) Reset()         { *m = MsgOperatorAttach{} }
 func (m *MsgOperatorAttach) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorAttach) ProtoMessage()    {}
 func (*MsgOperatorAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{41}
+	return fileDescriptor_eaee77977a3cfe12, []int{45}
 }
 func (m *MsgOperatorAttach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +2784,7 @@ func (m *MsgOperatorAttachResponse) Reset()         { *m = MsgOperatorAttachResp
 func (m *MsgOperatorAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorAttachResponse) ProtoMessage()    {}
 func (*MsgOperatorAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{42}
+	return fileDescriptor_eaee77977a3cfe12, []int{46}
 }
 func (m *MsgOperatorAttachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2544,7 +2831,7 @@ func (m *MsgOperatorDetach) Reset()         { *m = MsgOperatorDetach{} }
 func (m *MsgOperatorDetach) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorDetach) ProtoMessage()    {}
 func (*MsgOperatorDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{43}
+	return fileDescriptor_eaee77977a3cfe12, []int{47}
 }
 func (m *MsgOperatorDetach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +2898,7 @@ func (m *MsgOperatorDetachResponse) Reset()         { *m = MsgOperatorDetachResp
 func (m *MsgOperatorDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorDetachResponse) ProtoMessage()    {}
 func (*MsgOperatorDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{44}
+	return fileDescriptor_eaee77977a3cfe12, []int{48}
 }
 func (m *MsgOperatorDetachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2653,6 +2940,8 @@ func init() {
 	proto.RegisterType((*MsgAuthorizeOperatorResponse)(nil), "lbm.collection.v1.MsgAuthorizeOperatorResponse")
 	proto.RegisterType((*MsgRevokeOperator)(nil), "lbm.collection.v1.MsgRevokeOperator")
 	proto.RegisterType((*MsgRevokeOperatorResponse)(nil), "lbm.collection.v1.MsgRevokeOperatorResponse")
+	proto.RegisterType((*MsgApprove)(nil), "lbm.collection.v1.MsgApprove")
+	proto.RegisterType((*MsgApproveResponse)(nil), "lbm.collection.v1.MsgApproveResponse")
 	proto.RegisterType((*MsgCreateContract)(nil), "lbm.collection.v1.MsgCreateContract")
 	proto.RegisterType((*MsgCreateContractResponse)(nil), "lbm.collection.v1.MsgCreateContractResponse")
 	proto.RegisterType((*MsgIssueFT)(nil), "lbm.collection.v1.MsgIssueFT")
@@ -2674,6 +2963,8 @@ func init() {
 	proto.RegisterType((*MsgOperatorBurnNFTResponse)(nil), "lbm.collection.v1.MsgOperatorBurnNFTResponse")
 	proto.RegisterType((*MsgModify)(nil), "lbm.collection.v1.MsgModify")
 	proto.RegisterType((*MsgModifyResponse)(nil), "lbm.collection.v1.MsgModifyResponse")
+	proto.RegisterType((*MsgSetRoyalty)(nil), "lbm.collection.v1.MsgSetRoyalty")
+	proto.RegisterType((*MsgSetRoyaltyResponse)(nil), "lbm.collection.v1.MsgSetRoyaltyResponse")
 	proto.RegisterType((*MsgGrantPermission)(nil), "lbm.collection.v1.MsgGrantPermission")
 	proto.RegisterType((*MsgGrantPermissionResponse)(nil), "lbm.collection.v1.MsgGrantPermissionResponse")
 	proto.RegisterType((*MsgRevokePermission)(nil), "lbm.collection.v1.MsgRevokePermission")
@@ -2691,92 +2982,109 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/tx.proto", fileDescriptor_eaee77977a3cfe12) }
 
 var fileDescriptor_eaee77977a3cfe12 = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xe3, 0x24, 0x4d, 0x5f, 0xa1, 0xb4, 0xde, 0x8a, 0xcd, 0xa6, 0x6d, 0x52, 0x59, 0x74,
-	0xa9, 0x50, 0x9b, 0xa8, 0x5d, 0xb8, 0xa0, 0x05, 0xa9, 0x2d, 0x2a, 0x2a, 0xd0, 0xee, 0x36, 0x94,
-	0x0b, 0x07, 0x2a, 0x27, 0x99, 0x26, 0xde, 0xc6, 0x9e, 0xc8, 0x9e, 0x94, 0x16, 0x0e, 0x48, 0xec,
-	0x05, 0x89, 0xcb, 0x0a, 0x71, 0x45, 0x1c, 0xb8, 0x20, 0x2e, 0x7c, 0x05, 0x8e, 0x7b, 0xdc, 0x23,
-	0xe2, 0xb0, 0xa0, 0xf6, 0x8b, 0x20, 0x8f, 0xc7, 0x13, 0x8f, 0xed, 0x49, 0xdc, 0x6e, 0x76, 0x6f,
-	0xb6, 0xdf, 0x9b, 0xf9, 0xfd, 0xde, 0x9f, 0x79, 0xef, 0x8d, 0xa1, 0xd4, 0x6d, 0x58, 0xb5, 0x26,
-	0xee, 0x76, 0x51, 0x93, 0x98, 0xd8, 0xae, 0x9d, 0x6d, 0xd4, 0xc8, 0x79, 0xb5, 0xe7, 0x60, 0x82,
-	0xb5, 0xb9, 0x6e, 0xc3, 0xaa, 0x0e, 0x64, 0xd5, 0xb3, 0x8d, 0xd2, 0x7c, 0x1b, 0xb7, 0x31, 0x95,
-	0xd6, 0xbc, 0x27, 0x5f, 0xb1, 0xa4, 0xc7, 0x37, 0x09, 0x2d, 0xa3, 0x3a, 0xfa, 0x8f, 0x0a, 0x4c,
-	0xed, 0xbb, 0xed, 0xcf, 0x91, 0xdd, 0xda, 0x3d, 0xd2, 0x2a, 0x30, 0xdd, 0xc4, 0x36, 0x71, 0x8c,
-	0x26, 0x39, 0x36, 0x5b, 0x45, 0x65, 0x59, 0x59, 0x9d, 0xaa, 0x43, 0xf0, 0x69, 0xaf, 0xa5, 0x69,
-	0x90, 0x3d, 0x71, 0xb0, 0x55, 0xcc, 0x50, 0x09, 0x7d, 0xd6, 0x66, 0x20, 0x43, 0x70, 0x51, 0xa5,
-	0x5f, 0x32, 0x04, 0x6b, 0xef, 0x41, 0xde, 0xb0, 0x70, 0xdf, 0x26, 0xc5, 0xec, 0xb2, 0xba, 0x3a,
-	0xbd, 0x79, 0xbb, 0x1a, 0x23, 0x5c, 0xdd, 0xc1, 0xa6, 0xbd, 0x9d, 0x7d, 0xfa, 0xbc, 0x32, 0x51,
-	0x67, 0xca, 0xef, 0x67, 0x8a, 0x8a, 0x7e, 0x1b, 0xe6, 0x38, 0x99, 0x3a, 0x72, 0x7b, 0xd8, 0x76,
-	0x11, 0x15, 0xfc, 0xa9, 0x50, 0xc9, 0x83, 0x1e, 0x72, 0x0c, 0x82, 0x9d, 0xb4, 0x74, 0x4b, 0x50,
-	0xc0, 0x6c, 0x09, 0xa3, 0xcc, 0xdf, 0xb9, 0x29, 0x6a, 0xcc, 0x94, 0x6c, 0x82, 0x29, 0xb9, 0xeb,
-	0x9a, 0x52, 0x81, 0x3b, 0x31, 0xc2, 0x82, 0x49, 0x36, 0x00, 0xb3, 0xf5, 0x60, 0x5c, 0x9e, 0x5f,
-	0x80, 0x29, 0x82, 0x4f, 0x91, 0x7d, 0x6c, 0xb6, 0x5c, 0xea, 0xfc, 0xa9, 0x7a, 0x81, 0x7e, 0xd8,
-	0x6b, 0xb9, 0xfa, 0x3c, 0x68, 0x03, 0xbc, 0x80, 0x89, 0xfe, 0x93, 0x42, 0x3f, 0x87, 0x79, 0x1e,
-	0xbc, 0x0a, 0xcf, 0x0a, 0x54, 0x73, 0x11, 0xaa, 0x8b, 0x50, 0x8a, 0x73, 0xe2, 0x94, 0x4f, 0x61,
-	0x7e, 0xdf, 0x6d, 0x6f, 0xf5, 0x49, 0x07, 0x3b, 0xe6, 0x37, 0x28, 0x50, 0x1b, 0xcd, 0xf9, 0x4d,
-	0xc8, 0x77, 0x70, 0xb7, 0x85, 0x02, 0xc6, 0xec, 0x4d, 0xb0, 0x45, 0x15, 0x6d, 0xd1, 0xcb, 0xb0,
-	0x98, 0x04, 0xc6, 0xc9, 0x74, 0x68, 0x5e, 0xd6, 0xd1, 0x19, 0x3e, 0x7d, 0xc9, 0x4c, 0x16, 0x68,
-	0x42, 0x89, 0x48, 0x9c, 0x46, 0x93, 0xd2, 0xd8, 0x71, 0x90, 0x41, 0xd0, 0x0e, 0xc3, 0xd1, 0xe6,
-	0x21, 0x87, 0xbf, 0xb6, 0x91, 0xc3, 0x08, 0xf8, 0x2f, 0x5e, 0x74, 0x6c, 0xc3, 0x42, 0x41, 0x22,
-	0x79, 0xcf, 0xda, 0x2c, 0xa8, 0x7d, 0xc7, 0x64, 0x90, 0xde, 0xa3, 0xa7, 0x65, 0x21, 0x62, 0xb0,
-	0x88, 0xd1, 0x67, 0xfd, 0x3e, 0x65, 0x20, 0x82, 0x04, 0x0c, 0x46, 0xda, 0xac, 0x3f, 0xce, 0xd0,
-	0x84, 0xdf, 0x73, 0xdd, 0x3e, 0x4a, 0x99, 0xf0, 0x31, 0x9e, 0x01, 0x2b, 0x75, 0xc0, 0xca, 0xf3,
-	0x59, 0x0b, 0x35, 0x4d, 0xcb, 0xe8, 0xba, 0x94, 0x6d, 0xae, 0xce, 0xdf, 0x3d, 0x99, 0x65, 0xda,
-	0xc4, 0x68, 0x74, 0x51, 0x31, 0xb7, 0xac, 0xac, 0x16, 0xea, 0xfc, 0x7d, 0xe0, 0x9d, 0x7c, 0xd8,
-	0x3b, 0x7e, 0x9e, 0x4e, 0xf2, 0x3c, 0xfd, 0x84, 0x57, 0x80, 0x82, 0xf7, 0x6d, 0x7b, 0xd3, 0x3b,
-	0xe8, 0xff, 0x3c, 0xaf, 0xbc, 0xd3, 0x36, 0x49, 0xa7, 0xdf, 0xa8, 0x36, 0xb1, 0x55, 0xdb, 0x35,
-	0x6d, 0xb7, 0xd9, 0x31, 0x8d, 0xda, 0x09, 0x7b, 0x58, 0x77, 0x5b, 0xa7, 0x35, 0x72, 0xd1, 0x43,
-	0x6e, 0x75, 0xcf, 0x26, 0x42, 0x59, 0xb8, 0x47, 0x8f, 0x1b, 0x73, 0x02, 0x77, 0xde, 0x1d, 0x28,
-	0x04, 0xa7, 0x81, 0x79, 0x62, 0x92, 0x1d, 0x06, 0xba, 0xa8, 0x0b, 0xd3, 0xc1, 0xa2, 0x83, 0x71,
-	0xba, 0x8e, 0xbb, 0x20, 0x1b, 0x72, 0x81, 0xfe, 0x2e, 0xdc, 0x0a, 0xa1, 0x71, 0x8e, 0x4b, 0x00,
-	0x3e, 0x47, 0xcf, 0x30, 0x06, 0xea, 0x9f, 0xe1, 0xa3, 0x8b, 0x1e, 0xd2, 0x7f, 0xf6, 0x1b, 0xc9,
-	0xbe, 0x69, 0x93, 0x71, 0x95, 0xb3, 0x0f, 0xd3, 0x36, 0x92, 0xd7, 0xbd, 0xa0, 0xfc, 0xf1, 0x6f,
-	0x25, 0xe7, 0xbd, 0xb9, 0x09, 0x1d, 0xc5, 0x67, 0x25, 0x94, 0xdf, 0x27, 0x0a, 0x4d, 0x47, 0x4f,
-	0x32, 0xb6, 0xfa, 0xfb, 0x01, 0xe4, 0x7b, 0x86, 0x63, 0x58, 0x2e, 0x23, 0x5c, 0x49, 0x20, 0xcc,
-	0x00, 0x1f, 0x7a, 0x7a, 0x41, 0xdb, 0xf0, 0x17, 0xe9, 0x1b, 0x34, 0x37, 0x98, 0x02, 0xf7, 0xbb,
-	0x50, 0x29, 0x95, 0x48, 0xa5, 0xfc, 0x02, 0x5e, 0x0b, 0x6f, 0x38, 0x22, 0x48, 0x69, 0x13, 0x43,
-	0xff, 0x96, 0xc6, 0x72, 0xbb, 0xef, 0xd8, 0x37, 0x75, 0xcd, 0xa0, 0x73, 0xaa, 0x37, 0x1b, 0x02,
-	0x7c, 0x70, 0x21, 0x64, 0xbf, 0x8a, 0x43, 0x40, 0x5a, 0x7a, 0xd7, 0x6d, 0x55, 0x2f, 0x30, 0xbf,
-	0x88, 0x4d, 0x3f, 0xc1, 0x84, 0xaf, 0x68, 0xd2, 0x79, 0x82, 0x1b, 0x27, 0x9d, 0x90, 0x0f, 0x6a,
-	0x62, 0x93, 0x67, 0xfb, 0xf3, 0xee, 0xf0, 0xbd, 0xd8, 0xe4, 0x53, 0xc3, 0x5f, 0xd7, 0x73, 0x43,
-	0xe7, 0x0f, 0xb1, 0xa9, 0x47, 0x29, 0xfe, 0xc5, 0xca, 0x07, 0x6e, 0x99, 0x27, 0x17, 0xa3, 0x99,
-	0xf1, 0xca, 0x95, 0x09, 0x17, 0x6f, 0x31, 0xfb, 0xd5, 0x68, 0xf6, 0x57, 0x60, 0x9a, 0xd1, 0xb3,
-	0x5b, 0xe8, 0x9c, 0x15, 0x3d, 0x7f, 0xc5, 0x9e, 0xf7, 0x45, 0xbb, 0x0f, 0x93, 0xcd, 0x8e, 0x61,
-	0xb7, 0x91, 0xcb, 0xe6, 0xbd, 0xc5, 0x84, 0xd0, 0x6f, 0x11, 0xe2, 0x98, 0x8d, 0x3e, 0x41, 0x2c,
-	0xfe, 0xc1, 0x12, 0xfd, 0x96, 0x5f, 0x6a, 0xa8, 0x05, 0xdc, 0xae, 0x0b, 0xea, 0xf9, 0x8f, 0x1d,
-	0xc3, 0x26, 0x0f, 0x91, 0x63, 0x99, 0xae, 0x6b, 0x62, 0x7b, 0x3c, 0xd5, 0xa6, 0x0c, 0xd0, 0xe3,
-	0x5b, 0x06, 0xd6, 0x0c, 0xbe, 0x30, 0x87, 0x47, 0xa0, 0x39, 0xb1, 0x47, 0xb4, 0xca, 0xfb, 0xe3,
-	0xc4, 0x8b, 0x32, 0x13, 0x99, 0xa8, 0x31, 0x26, 0x4b, 0xb0, 0x90, 0x80, 0xc5, 0xa9, 0x7c, 0x47,
-	0x43, 0xbf, 0x45, 0x88, 0xd1, 0xec, 0xdc, 0x8c, 0x40, 0xb8, 0x7f, 0xaa, 0x42, 0xff, 0xd4, 0xca,
-	0x5e, 0xd0, 0x8f, 0xb9, 0x34, 0x1b, 0x24, 0xc5, 0x51, 0xa8, 0xbf, 0xfa, 0x15, 0xc7, 0x27, 0x20,
-	0x1c, 0xd7, 0x63, 0xca, 0xec, 0x23, 0xf4, 0x32, 0x98, 0x85, 0x90, 0x7d, 0x00, 0x01, 0xf9, 0x37,
-	0xb1, 0xd6, 0xa5, 0x75, 0xce, 0x75, 0x4f, 0x6c, 0x98, 0x5e, 0x76, 0xa8, 0xe3, 0x72, 0x49, 0x8e,
-	0x13, 0xeb, 0x5d, 0x82, 0x03, 0x1f, 0x8b, 0x66, 0xa4, 0xf5, 0xe4, 0xf8, 0xcc, 0x48, 0xa0, 0x19,
-	0xf7, 0xf6, 0xe6, 0x2f, 0xb3, 0xa0, 0xee, 0xbb, 0x6d, 0xed, 0x10, 0xf2, 0xec, 0x6a, 0x99, 0x74,
-	0xf2, 0xf9, 0xd5, 0xb4, 0xf4, 0xd6, 0x30, 0x29, 0xcf, 0x6b, 0xf5, 0x87, 0x8c, 0xa2, 0x99, 0x30,
-	0x13, 0xb9, 0xb5, 0x4a, 0x16, 0x8b, 0x5a, 0xa5, 0xb5, 0x34, 0x5a, 0x22, 0xd4, 0x03, 0x98, 0x0c,
-	0xee, 0x6f, 0x4b, 0x72, 0x82, 0x07, 0xbb, 0x47, 0xa5, 0x95, 0xa1, 0x62, 0x3e, 0x7a, 0xb4, 0xe1,
-	0x8d, 0xe8, 0xc5, 0x70, 0x65, 0x34, 0x2d, 0x0f, 0x60, 0x3d, 0x95, 0x1a, 0x07, 0xb2, 0x60, 0x2e,
-	0x7e, 0x9f, 0x7b, 0x3b, 0x79, 0x8f, 0x98, 0x62, 0xa9, 0x96, 0x52, 0x91, 0xc3, 0xb5, 0x60, 0x26,
-	0x72, 0x63, 0x93, 0xc4, 0x44, 0xd4, 0x92, 0xc5, 0x24, 0xf9, 0x4e, 0xe6, 0xa1, 0x44, 0x2e, 0x64,
-	0x12, 0x14, 0x51, 0x4b, 0x86, 0x22, 0xb9, 0x77, 0x1d, 0xc1, 0x64, 0x70, 0xa5, 0x92, 0x04, 0x9d,
-	0x89, 0x65, 0x41, 0x8f, 0xdc, 0x45, 0xfc, 0x54, 0xaa, 0x43, 0x81, 0x5f, 0x37, 0xca, 0x43, 0xd6,
-	0x79, 0xb1, 0xbe, 0x3b, 0x5c, 0xce, 0x99, 0x1e, 0x42, 0x9e, 0xdd, 0x0e, 0x24, 0x87, 0xcb, 0x97,
-	0xca, 0x0e, 0x97, 0x38, 0xc3, 0xf3, 0x8c, 0x0f, 0x06, 0xf8, 0x25, 0xf9, 0xaa, 0x21, 0x19, 0x1f,
-	0x1d, 0xb6, 0x0f, 0x21, 0xcf, 0xc6, 0x4a, 0x09, 0x47, 0x5f, 0x2a, 0xe3, 0x28, 0x4e, 0x7c, 0xb1,
-	0x02, 0xc0, 0xb6, 0x1e, 0x51, 0x00, 0x18, 0xc4, 0x5a, 0x1a, 0xad, 0x98, 0x3b, 0x82, 0xd9, 0x6e,
-	0x49, 0x4e, 0x70, 0x88, 0x3b, 0x22, 0x53, 0x59, 0xb8, 0x00, 0x04, 0x1b, 0xaf, 0x8c, 0xa6, 0x95,
-	0xa2, 0x00, 0x44, 0x81, 0x3e, 0x83, 0x3c, 0x1b, 0xfd, 0x64, 0xb9, 0x41, 0xa5, 0xd2, 0xdc, 0x10,
-	0x86, 0x2e, 0x8f, 0x76, 0x74, 0xe2, 0x92, 0xd0, 0x8e, 0xa8, 0xc9, 0x68, 0x4b, 0x86, 0x28, 0xed,
-	0x11, 0xcc, 0xc6, 0x26, 0xa8, 0xbb, 0xc3, 0x8a, 0x44, 0x08, 0xaa, 0x9a, 0x4e, 0x2f, 0x9c, 0x9a,
-	0x6c, 0x0a, 0x90, 0xb8, 0xc8, 0x97, 0xca, 0x5c, 0x24, 0x36, 0x67, 0x3f, 0x5f, 0x0e, 0x21, 0xcf,
-	0x3a, 0xb2, 0x64, 0x4b, 0x5f, 0x2a, 0xdb, 0x52, 0x6c, 0xa4, 0xb1, 0x6c, 0x67, 0x6c, 0x47, 0x64,
-	0x3b, 0x63, 0xbd, 0x96, 0x46, 0x4b, 0x0a, 0xc5, 0xac, 0x18, 0x01, 0xc5, 0xac, 0x59, 0x4b, 0xa3,
-	0x25, 0x40, 0x6d, 0x7f, 0xfa, 0xfb, 0x65, 0x79, 0xe2, 0xe9, 0x65, 0x59, 0x79, 0x76, 0x59, 0x56,
-	0xfe, 0xbb, 0x2c, 0x2b, 0x4f, 0xae, 0xca, 0x13, 0xcf, 0xae, 0xca, 0x13, 0x7f, 0x5f, 0x95, 0x27,
-	0xbe, 0x5c, 0x1f, 0xf9, 0x2f, 0xe8, 0x3c, 0xf4, 0xe3, 0xbd, 0x91, 0xa7, 0x7f, 0xde, 0xef, 0xfd,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x50, 0xa5, 0x9b, 0x1f, 0xe4, 0x17, 0x00, 0x00,
+	// 1619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x89, 0x9d, 0xbc, 0x7c, 0xfb, 0x23, 0xdb, 0x7c, 0xa9, 0xb3, 0x4d, 0xec, 0x68,
+	0x95, 0x94, 0x08, 0x25, 0xb6, 0x9a, 0x96, 0x0b, 0x2a, 0x88, 0xa4, 0x56, 0x51, 0xa0, 0x49, 0x1b,
+	0x37, 0x07, 0xd4, 0x03, 0xd1, 0xda, 0x9e, 0xd8, 0xdb, 0x78, 0x77, 0xac, 0xdd, 0x71, 0x88, 0xe1,
+	0x80, 0x44, 0x2f, 0x48, 0x5c, 0x0a, 0xe2, 0xc2, 0x89, 0x03, 0x17, 0xc4, 0x85, 0x7f, 0x01, 0x71,
+	0xea, 0xb1, 0x47, 0xc4, 0xa1, 0x45, 0xe9, 0x01, 0x89, 0xbf, 0x02, 0xed, 0xec, 0xec, 0x78, 0x67,
+	0x7f, 0x79, 0xe3, 0xa4, 0xbd, 0x79, 0xf7, 0xbd, 0x79, 0xef, 0xf3, 0x3e, 0xf3, 0xde, 0x9b, 0xb7,
+	0x63, 0x50, 0x3a, 0x75, 0xa3, 0xd2, 0xc0, 0x9d, 0x0e, 0x6a, 0x10, 0x1d, 0x9b, 0x95, 0xa3, 0x1b,
+	0x15, 0x72, 0x5c, 0xee, 0x5a, 0x98, 0x60, 0x79, 0xa6, 0x53, 0x37, 0xca, 0x03, 0x59, 0xf9, 0xe8,
+	0x86, 0x32, 0xdb, 0xc2, 0x2d, 0x4c, 0xa5, 0x15, 0xe7, 0x97, 0xab, 0xa8, 0x94, 0x5a, 0x18, 0xb7,
+	0x3a, 0xa8, 0x42, 0x9f, 0xea, 0xbd, 0x83, 0x0a, 0xd1, 0x0d, 0x64, 0x13, 0xcd, 0xe8, 0x32, 0x05,
+	0x35, 0xec, 0xc5, 0x67, 0x97, 0xea, 0xa8, 0xdf, 0x4a, 0x30, 0xb5, 0x6d, 0xb7, 0x1e, 0x22, 0xb3,
+	0x79, 0x77, 0x4f, 0x2e, 0xc1, 0x74, 0x03, 0x9b, 0xc4, 0xd2, 0x1a, 0x64, 0x5f, 0x6f, 0x16, 0xa4,
+	0x45, 0x69, 0x65, 0xaa, 0x06, 0xde, 0xab, 0xad, 0xa6, 0x2c, 0xc3, 0xf8, 0x81, 0x85, 0x8d, 0x42,
+	0x86, 0x4a, 0xe8, 0x6f, 0xf9, 0x22, 0x64, 0x08, 0x2e, 0x64, 0xe9, 0x9b, 0x0c, 0xc1, 0xf2, 0xbb,
+	0x90, 0xd3, 0x0c, 0xdc, 0x33, 0x49, 0x61, 0x7c, 0x31, 0xbb, 0x32, 0xbd, 0x7e, 0xb5, 0x1c, 0x8a,
+	0xa8, 0x7c, 0x07, 0xeb, 0xe6, 0xe6, 0xf8, 0xb3, 0x17, 0xa5, 0xb1, 0x1a, 0x53, 0x7e, 0x2f, 0x53,
+	0x90, 0xd4, 0xab, 0x30, 0xc3, 0xc1, 0xd4, 0x90, 0xdd, 0xc5, 0xa6, 0x8d, 0xa8, 0xe0, 0x37, 0x89,
+	0x4a, 0xee, 0x77, 0x91, 0xa5, 0x11, 0x6c, 0xa5, 0x85, 0xab, 0xc0, 0x24, 0x66, 0x4b, 0x18, 0x64,
+	0xfe, 0xcc, 0x43, 0xc9, 0x86, 0x42, 0x19, 0x8f, 0x08, 0x65, 0xe2, 0xb4, 0xa1, 0x94, 0x60, 0x2e,
+	0x04, 0x58, 0x08, 0xc9, 0x04, 0x60, 0xb1, 0xee, 0x9c, 0x17, 0xf3, 0xd7, 0x60, 0x8a, 0xe0, 0x43,
+	0x64, 0xee, 0xeb, 0x4d, 0x9b, 0x92, 0x3f, 0x55, 0x9b, 0xa4, 0x2f, 0xb6, 0x9a, 0xb6, 0x3a, 0x0b,
+	0xf2, 0xc0, 0x9f, 0x87, 0x44, 0xfd, 0x5e, 0x02, 0x39, 0x80, 0x73, 0xe7, 0x4d, 0x30, 0x2b, 0x40,
+	0x9d, 0x08, 0x40, 0x9d, 0x07, 0x25, 0x8c, 0x89, 0x43, 0x3e, 0x84, 0xd9, 0x6d, 0xbb, 0xb5, 0xd1,
+	0x23, 0x6d, 0x6c, 0xe9, 0x5f, 0x20, 0x4f, 0x6d, 0x38, 0xe6, 0xb7, 0x20, 0xd7, 0xc6, 0x9d, 0x26,
+	0xf2, 0x10, 0xb3, 0x27, 0x21, 0x96, 0xac, 0x18, 0x8b, 0x5a, 0x84, 0xf9, 0x28, 0x67, 0x1c, 0x4c,
+	0x9b, 0xe6, 0x65, 0x0d, 0x1d, 0xe1, 0xc3, 0xd7, 0x8c, 0xe4, 0x1a, 0xcc, 0x85, 0x3c, 0x71, 0x18,
+	0xdf, 0x65, 0x68, 0x36, 0x6d, 0x74, 0xbb, 0x16, 0x3e, 0x42, 0xa3, 0x03, 0x28, 0x40, 0xde, 0xee,
+	0x22, 0xb3, 0x89, 0x3c, 0xff, 0xde, 0xa3, 0x3c, 0x07, 0x93, 0xde, 0x86, 0xb1, 0x6d, 0xcc, 0xb3,
+	0xfd, 0x92, 0x1f, 0xc2, 0x34, 0xd5, 0xda, 0xef, 0xe8, 0x86, 0xee, 0x94, 0x8a, 0xb4, 0x32, 0xb5,
+	0xb9, 0xee, 0x54, 0xc4, 0x5f, 0x2f, 0x4a, 0xef, 0xb4, 0x74, 0xd2, 0xee, 0xd5, 0xcb, 0x0d, 0x6c,
+	0x54, 0xee, 0xea, 0xa6, 0xdd, 0x68, 0xeb, 0x5a, 0xe5, 0x80, 0xfd, 0x58, 0xb3, 0x9b, 0x87, 0x15,
+	0xd2, 0xef, 0x22, 0xbb, 0xbc, 0x65, 0x92, 0x1a, 0x50, 0x33, 0xf7, 0x1c, 0x2b, 0xf2, 0x87, 0x00,
+	0xe8, 0xb8, 0xab, 0x5b, 0x9a, 0x53, 0x67, 0x85, 0xdc, 0xa2, 0xb4, 0x32, 0xbd, 0xae, 0x94, 0xdd,
+	0x96, 0x57, 0xf6, 0x5a, 0x5e, 0x79, 0xcf, 0x6b, 0x79, 0x9b, 0xe3, 0x4f, 0x5f, 0x96, 0xa4, 0x9a,
+	0x6f, 0x0d, 0x4b, 0x78, 0x46, 0x09, 0x67, 0xaa, 0x41, 0x37, 0xec, 0x8e, 0x85, 0x34, 0x82, 0xee,
+	0x30, 0x42, 0xe4, 0x59, 0x98, 0xc0, 0x9f, 0x9b, 0xc8, 0x62, 0x4c, 0xb9, 0x0f, 0x4e, 0x1e, 0x9b,
+	0x9a, 0x81, 0xbc, 0x92, 0x73, 0x7e, 0xcb, 0x97, 0x21, 0xdb, 0xb3, 0x74, 0x46, 0x8e, 0xf3, 0xd3,
+	0xd1, 0x32, 0x10, 0xd1, 0x18, 0x29, 0xf4, 0xb7, 0x7a, 0x1b, 0xe6, 0x42, 0x4e, 0x3c, 0x04, 0x43,
+	0x37, 0x47, 0x7d, 0xe2, 0x6e, 0xe6, 0x96, 0x6d, 0xf7, 0x50, 0xca, 0xd6, 0x10, 0xc2, 0xe9, 0xa1,
+	0xca, 0x0e, 0x50, 0x39, 0xd9, 0xd5, 0x44, 0x0d, 0xdd, 0xd0, 0x3a, 0x36, 0x45, 0x3b, 0x51, 0xe3,
+	0xcf, 0x8e, 0xcc, 0xd0, 0x4d, 0xa2, 0xd5, 0x3b, 0x88, 0x6e, 0xe0, 0x64, 0x8d, 0x3f, 0x0f, 0xd8,
+	0xc9, 0xf9, 0xd9, 0x71, 0x2b, 0x3a, 0xcf, 0x2b, 0xfa, 0x63, 0xde, 0x2b, 0x27, 0x47, 0x4e, 0x00,
+	0x7f, 0x03, 0xbd, 0x09, 0xf2, 0x80, 0x04, 0x4e, 0x9e, 0x3f, 0x0d, 0x25, 0x21, 0x0d, 0xe9, 0xa2,
+	0x1f, 0x33, 0x30, 0xed, 0xad, 0xda, 0x39, 0x4f, 0xee, 0x38, 0x07, 0xe3, 0x7e, 0x0e, 0x6e, 0x41,
+	0xde, 0xc2, 0x7d, 0xad, 0x43, 0xfa, 0x85, 0x09, 0x96, 0xa1, 0xe1, 0x03, 0xa2, 0xe6, 0x6a, 0xd4,
+	0x3c, 0x55, 0xb9, 0x07, 0x97, 0x35, 0x42, 0x2c, 0xbd, 0xde, 0x23, 0x68, 0xdf, 0x6e, 0xb4, 0x91,
+	0xa1, 0x15, 0x72, 0xf4, 0x7c, 0xb9, 0x1e, 0xb1, 0x7c, 0xc3, 0x53, 0xad, 0xa2, 0x03, 0xdd, 0xd4,
+	0x9d, 0xd7, 0x9b, 0xaa, 0xc3, 0xed, 0xbf, 0x2f, 0x4a, 0x4a, 0xd0, 0xce, 0x2a, 0x36, 0x74, 0x82,
+	0x8c, 0x2e, 0xe9, 0xd7, 0x2e, 0x71, 0xd9, 0x43, 0x2a, 0x52, 0x6f, 0xc1, 0x15, 0x1f, 0x35, 0x9c,
+	0xd1, 0x05, 0x00, 0x97, 0x51, 0x67, 0x1b, 0x18, 0x43, 0x6e, 0x6f, 0xde, 0xeb, 0x77, 0x91, 0xfa,
+	0x83, 0x3b, 0x20, 0x6c, 0xeb, 0x26, 0x39, 0xaf, 0x63, 0xea, 0x83, 0xb4, 0x03, 0xc2, 0x05, 0x27,
+	0xcc, 0x5f, 0x5f, 0x96, 0x26, 0x9c, 0x27, 0x3b, 0x62, 0x52, 0x70, 0x51, 0x09, 0xc7, 0xea, 0x53,
+	0x09, 0x80, 0x49, 0xce, 0xed, 0x5c, 0x7d, 0x1f, 0x72, 0x5d, 0xcd, 0xd2, 0x0c, 0x9b, 0x01, 0x2e,
+	0x45, 0x00, 0x66, 0x0e, 0x1f, 0x38, 0x7a, 0xde, 0x38, 0xe0, 0x2e, 0x52, 0x6f, 0xd0, 0x4c, 0x66,
+	0x0a, 0x9c, 0x77, 0xe1, 0x04, 0x94, 0x02, 0x27, 0xe0, 0x3f, 0x12, 0xfc, 0xcf, 0x6f, 0x71, 0xc8,
+	0x2e, 0xa5, 0x4e, 0x63, 0x5f, 0xc2, 0x8e, 0xa7, 0x4f, 0xd8, 0x47, 0x00, 0x3c, 0x99, 0x6c, 0x36,
+	0x0a, 0xcd, 0x27, 0xa5, 0xea, 0xe6, 0x3c, 0x4b, 0xd0, 0xd9, 0xc1, 0x3a, 0x5f, 0x6a, 0xfa, 0xac,
+	0xa9, 0x5f, 0xd2, 0xf4, 0xda, 0xec, 0x59, 0xe6, 0xa8, 0xbb, 0x35, 0x18, 0xd2, 0xb2, 0xa3, 0xcd,
+	0x9b, 0xae, 0x73, 0x21, 0x8b, 0x7e, 0x12, 0xe7, 0xcd, 0xb4, 0xf0, 0x4e, 0x3b, 0x15, 0x9d, 0x61,
+	0x54, 0x16, 0xe7, 0xcb, 0x88, 0x10, 0x3e, 0xa3, 0x75, 0xe0, 0x08, 0x46, 0xae, 0x03, 0x21, 0x45,
+	0xb3, 0x91, 0xf3, 0x24, 0xb3, 0xcf, 0x8f, 0xd7, 0xaf, 0xc5, 0x79, 0x32, 0xb5, 0xfb, 0xd3, 0x32,
+	0x97, 0x38, 0xea, 0x8a, 0xf3, 0x63, 0x10, 0xe2, 0xef, 0xac, 0xa3, 0xe1, 0xa6, 0x7e, 0xd0, 0x1f,
+	0x8e, 0x8c, 0x77, 0xfe, 0x8c, 0xbf, 0xf3, 0x8b, 0xf5, 0x98, 0x0d, 0xd6, 0x63, 0x09, 0xa6, 0x19,
+	0x3c, 0xb3, 0x89, 0x8e, 0xd9, 0xa1, 0xe1, 0xae, 0xd8, 0x72, 0xde, 0xc8, 0xb7, 0x21, 0xdf, 0x68,
+	0x6b, 0x66, 0x2b, 0x65, 0x3d, 0xb9, 0xfb, 0xef, 0x2d, 0x51, 0xaf, 0xc0, 0x0c, 0x8f, 0x80, 0xc7,
+	0xf5, 0x87, 0x04, 0x17, 0xe8, 0x84, 0x4f, 0x58, 0x01, 0x9f, 0x8d, 0xf5, 0xb3, 0x46, 0x38, 0xd2,
+	0xd9, 0xa8, 0x5e, 0x85, 0xff, 0x0b, 0x31, 0xf0, 0xe8, 0xfa, 0x34, 0xaf, 0x3e, 0xb2, 0x34, 0x93,
+	0x3c, 0x40, 0x96, 0xa1, 0xdb, 0xb6, 0x8e, 0xcd, 0xf3, 0x69, 0xef, 0x45, 0x80, 0x2e, 0x37, 0xe9,
+	0x45, 0x32, 0x78, 0xc3, 0xd2, 0x29, 0xe0, 0x9a, 0x03, 0x7b, 0x0c, 0x57, 0xf8, 0x5c, 0x7e, 0x56,
+	0x64, 0x22, 0x92, 0x6c, 0x08, 0xc9, 0x02, 0x5c, 0x8b, 0xf0, 0xc5, 0xa1, 0x7c, 0x45, 0x13, 0x7b,
+	0x83, 0x10, 0xad, 0xd1, 0x1e, 0x0d, 0x80, 0x7f, 0xbc, 0xca, 0x8a, 0x53, 0x7e, 0xd1, 0xd9, 0xf0,
+	0xfd, 0xc0, 0x37, 0xc0, 0x14, 0xc1, 0x7b, 0xbe, 0xf1, 0xcb, 0xed, 0xa7, 0x2e, 0x00, 0xa1, 0x19,
+	0xed, 0x53, 0x64, 0x55, 0xf4, 0x3a, 0x90, 0xf9, 0x3c, 0x57, 0x51, 0xc8, 0xf3, 0xcf, 0x62, 0x27,
+	0x4f, 0x4b, 0xce, 0x69, 0xfb, 0x51, 0xc2, 0xe7, 0x51, 0x80, 0xb8, 0x89, 0x28, 0xe2, 0xc4, 0x6e,
+	0x1e, 0x41, 0xe0, 0x13, 0x31, 0x8c, 0x2a, 0x7a, 0xd3, 0x61, 0x44, 0xc0, 0x0c, 0xb3, 0xbd, 0xfe,
+	0x6c, 0x06, 0xb2, 0xdb, 0x76, 0x4b, 0xde, 0x85, 0x1c, 0xbb, 0xa3, 0x89, 0xea, 0x6b, 0xfc, 0x8e,
+	0x47, 0x59, 0x4a, 0x92, 0xf2, 0xbc, 0xce, 0x7e, 0x93, 0x91, 0x64, 0x1d, 0x2e, 0x06, 0xae, 0x7f,
+	0x62, 0x16, 0x8b, 0x5a, 0xca, 0x6a, 0x1a, 0x2d, 0xd1, 0xd5, 0x7d, 0xc8, 0x7b, 0x17, 0x21, 0x0b,
+	0xf1, 0x00, 0x77, 0xee, 0xee, 0x29, 0xcb, 0x89, 0x62, 0xcf, 0xaa, 0xdc, 0x82, 0x4b, 0xc1, 0x1b,
+	0x96, 0xe5, 0xe1, 0xb0, 0x1c, 0x07, 0x6b, 0xa9, 0xd4, 0xb8, 0x23, 0x03, 0x66, 0xc2, 0x17, 0x23,
+	0x6f, 0x47, 0xdb, 0x08, 0x29, 0x2a, 0x95, 0x94, 0x8a, 0xdc, 0x5d, 0x13, 0x2e, 0x06, 0xae, 0x3e,
+	0x62, 0xf6, 0x44, 0xd4, 0x52, 0x56, 0xd3, 0x68, 0x71, 0x2f, 0xf7, 0x21, 0xef, 0x5d, 0x6c, 0xc4,
+	0x6c, 0x07, 0x13, 0x2b, 0xcb, 0x89, 0x62, 0x3f, 0xec, 0xc0, 0x05, 0x40, 0x0c, 0x6c, 0x51, 0x4b,
+	0x59, 0x4d, 0xa3, 0xc5, 0xbd, 0xec, 0x41, 0xde, 0xfb, 0x84, 0x8f, 0x81, 0xcd, 0xc4, 0xca, 0x72,
+	0xa2, 0x58, 0xcc, 0xcd, 0x1a, 0x4c, 0xf2, 0xaf, 0xdb, 0x62, 0xc2, 0x3a, 0x27, 0x79, 0xae, 0x27,
+	0xcb, 0x39, 0xd2, 0x5d, 0xc8, 0xb1, 0xef, 0xbb, 0x98, 0x6a, 0x75, 0xa5, 0xca, 0x52, 0x92, 0x34,
+	0x54, 0x42, 0xde, 0x27, 0xd8, 0x42, 0xfc, 0xaa, 0x84, 0x12, 0x0a, 0x7e, 0x2e, 0xed, 0x42, 0x8e,
+	0x4d, 0xe1, 0x31, 0x18, 0x5d, 0xa9, 0xb2, 0x94, 0x24, 0x8d, 0xed, 0x28, 0xcc, 0xf4, 0x90, 0x8e,
+	0xc2, 0x5c, 0xac, 0xa6, 0xd1, 0x0a, 0xd1, 0xe1, 0x8d, 0xc2, 0x0b, 0xf1, 0x00, 0x13, 0xe8, 0x08,
+	0x0c, 0xb1, 0xfe, 0x8e, 0xe2, 0x19, 0x5e, 0x1e, 0x0e, 0x2b, 0x45, 0x47, 0x09, 0x3a, 0xba, 0x07,
+	0x39, 0x36, 0x29, 0xc7, 0xe5, 0x06, 0x95, 0x2a, 0x4b, 0x49, 0x52, 0x6e, 0xed, 0x53, 0x00, 0xdf,
+	0x7c, 0xba, 0x18, 0xd7, 0x3d, 0x3d, 0x0d, 0x65, 0x65, 0x98, 0x86, 0x9f, 0x90, 0xe0, 0x70, 0x18,
+	0x43, 0x48, 0x40, 0x4d, 0x59, 0x4b, 0xa5, 0xc6, 0x1d, 0x3d, 0x86, 0xcb, 0xa1, 0x61, 0xef, 0x7a,
+	0x52, 0x3f, 0xf3, 0xb9, 0x2a, 0xa7, 0xd3, 0xf3, 0x27, 0x3d, 0x1b, 0x58, 0x62, 0xc8, 0x77, 0xa5,
+	0xca, 0x52, 0x92, 0x54, 0xcc, 0xc4, 0x5d, 0xc8, 0x55, 0x51, 0x92, 0xc9, 0x2a, 0x4a, 0x32, 0x59,
+	0x45, 0x61, 0x93, 0xbe, 0x3a, 0x62, 0x68, 0x87, 0xd4, 0x11, 0x43, 0xbd, 0x9a, 0x46, 0x2b, 0xd6,
+	0x55, 0x15, 0xa5, 0x71, 0x55, 0x45, 0x69, 0x5c, 0x45, 0x44, 0xb5, 0xf9, 0xc9, 0x2f, 0x27, 0xc5,
+	0xb1, 0x67, 0x27, 0x45, 0xe9, 0xf9, 0x49, 0x51, 0xfa, 0xfb, 0xa4, 0x28, 0x3d, 0x7d, 0x55, 0x1c,
+	0x7b, 0xfe, 0xaa, 0x38, 0xf6, 0xe7, 0xab, 0xe2, 0xd8, 0xa3, 0xb5, 0xa1, 0xb7, 0x9a, 0xc7, 0xbe,
+	0x3f, 0xdb, 0xea, 0x39, 0x7a, 0x63, 0x7d, 0xf3, 0xbf, 0x01, 0x00, 0x83, 0xa1, 0x38, 0x33, 0xf9,
+	0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2797,6 +3105,8 @@ type MsgClient interface {
 	// - transfer_ft (deprecated, not typed)
 	SendFT(ctx context.Context, in *MsgSendFT, opts ...grpc.CallOption) (*MsgSendFTResponse, error)
 	// OperatorSendFT defines a method to send fungible tokens from one account to another account by the operator.
+	// The operator must be authorized by the holder, or have an allowance covering the amount of each token.
+	// In the latter case, the allowances decrease by the amount.
 	// Fires:
 	// - EventSent
 	// - transfer_ft_from (deprecated, not typed)
@@ -2823,6 +3133,11 @@ type MsgClient interface {
 	// - EventRevokedOperator
 	// - disapprove_collection (deprecated, not typed)
 	RevokeOperator(ctx context.Context, in *MsgRevokeOperator, opts ...grpc.CallOption) (*MsgRevokeOperatorResponse, error)
+	// Approve sets the allowance of the spender on the holder's fungible token, replacing the existing one.
+	// A zero spend limit removes the allowance.
+	// Fires:
+	// - EventApproved
+	Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error)
 	// CreateContract defines a method to create a contract for collection.
 	// it grants `mint`, `burn`, `modify` and `issue` permissions on the contract to its creator.
 	// Fires:
@@ -2889,6 +3204,11 @@ type MsgClient interface {
 	// - modify_token (deprecated, not typed)
 	// - EventModifiedNFT
 	Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error)
+	// SetRoyalty sets the royalty of a class of non-fungible token or a non-fungible token.
+	// An empty royalty removes the existing one.
+	// Fires:
+	// - EventRoyaltySet
+	SetRoyalty(ctx context.Context, in *MsgSetRoyalty, opts ...grpc.CallOption) (*MsgSetRoyaltyResponse, error)
 	// GrantPermission allows one to mint or burn tokens or modify metadata.
 	// Fires:
 	// - EventGranted
@@ -2989,6 +3309,15 @@ func (c *msgClient) RevokeOperator(ctx context.Context, in *MsgRevokeOperator, o
 	return out, nil
 }
 
+func (c *msgClient) Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error) {
+	out := new(MsgApproveResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Approve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error) {
 	out := new(MsgCreateContractResponse)
 	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/CreateContract", in, out, opts...)
@@ -3083,6 +3412,15 @@ func (c *msgClient) Modify(ctx context.Context, in *MsgModify, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) SetRoyalty(ctx context.Context, in *MsgSetRoyalty, opts ...grpc.CallOption) (*MsgSetRoyaltyResponse, error) {
+	out := new(MsgSetRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SetRoyalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) GrantPermission(ctx context.Context, in *MsgGrantPermission, opts ...grpc.CallOption) (*MsgGrantPermissionResponse, error) {
 	out := new(MsgGrantPermissionResponse)
 	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/GrantPermission", in, out, opts...)
@@ -3149,6 +3487,8 @@ type MsgServer interface {
 	// - transfer_ft (deprecated, not typed)
 	SendFT(context.Context, *MsgSendFT) (*MsgSendFTResponse, error)
 	// OperatorSendFT defines a method to send fungible tokens from one account to another account by the operator.
+	// The operator must be authorized by the holder, or have an allowance covering the amount of each token.
+	// In the latter case, the allowances decrease by the amount.
 	// Fires:
 	// - EventSent
 	// - transfer_ft_from (deprecated, not typed)
@@ -3175,6 +3515,11 @@ type MsgServer interface {
 	// - EventRevokedOperator
 	// - disapprove_collection (deprecated, not typed)
 	RevokeOperator(context.Context, *MsgRevokeOperator) (*MsgRevokeOperatorResponse, error)
+	// Approve sets the allowance of the spender on the holder's fungible token, replacing the existing one.
+	// A zero spend limit removes the allowance.
+	// Fires:
+	// - EventApproved
+	Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error)
 	// CreateContract defines a method to create a contract for collection.
 	// it grants `mint`, `burn`, `modify` and `issue` permissions on the contract to its creator.
 	// Fires:
@@ -3241,6 +3586,11 @@ type MsgServer interface {
 	// - modify_token (deprecated, not typed)
 	// - EventModifiedNFT
 	Modify(context.Context, *MsgModify) (*MsgModifyResponse, error)
+	// SetRoyalty sets the royalty of a class of non-fungible token or a non-fungible token.
+	// An empty royalty removes the existing one.
+	// Fires:
+	// - EventRoyaltySet
+	SetRoyalty(context.Context, *MsgSetRoyalty) (*MsgSetRoyaltyResponse, error)
 	// GrantPermission allows one to mint or burn tokens or modify metadata.
 	// Fires:
 	// - EventGranted
@@ -3299,6 +3649,9 @@ func (*UnimplementedMsgServer) AuthorizeOperator(ctx context.Context, req *MsgAu
 func (*UnimplementedMsgServer) RevokeOperator(ctx context.Context, req *MsgRevokeOperator) (*MsgRevokeOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperator not implemented")
 }
+func (*UnimplementedMsgServer) Approve(ctx context.Context, req *MsgApprove) (*MsgApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
 func (*UnimplementedMsgServer) CreateContract(ctx context.Context, req *MsgCreateContract) (*MsgCreateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
 }
@@ -3329,6 +3682,9 @@ func (*UnimplementedMsgServer) OperatorBurnNFT(ctx context.Context, req *MsgOper
 func (*UnimplementedMsgServer) Modify(ctx context.Context, req *MsgModify) (*MsgModifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Modify not implemented")
 }
+func (*UnimplementedMsgServer) SetRoyalty(ctx context.Context, req *MsgSetRoyalty) (*MsgSetRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoyalty not implemented")
+}
 func (*UnimplementedMsgServer) GrantPermission(ctx context.Context, req *MsgGrantPermission) (*MsgGrantPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GrantPermission not implemented")
 }
@@ -3460,6 +3816,24 @@ func _Msg_RevokeOperator_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Approve(ctx, req.(*MsgApprove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateContract)
 	if err := dec(in); err != nil {
@@ -3640,6 +4014,24 @@ func _Msg_Modify_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRoyalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SetRoyalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRoyalty(ctx, req.(*MsgSetRoyalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_GrantPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgGrantPermission)
 	if err := dec(in); err != nil {
@@ -3776,6 +4168,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeOperator",
 			Handler:    _Msg_RevokeOperator_Handler,
 		},
+		{
+			MethodName: "Approve",
+			Handler:    _Msg_Approve_Handler,
+		},
 		{
 			MethodName: "CreateContract",
 			Handler:    _Msg_CreateContract_Handler,
@@ -3816,6 +4212,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Modify",
 			Handler:    _Msg_Modify_Handler,
 		},
+		{
+			MethodName: "SetRoyalty",
+			Handler:    _Msg_SetRoyalty_Handler,
+		},
 		{
 			MethodName: "GrantPermission",
 			Handler:    _Msg_GrantPermission_Handler,
@@ -4307,6 +4707,100 @@ func (m *MsgRevokeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgApprove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4521,6 +5015,32 @@ func (m *MsgIssueNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -4773,6 +5293,32 @@ func (m *MintNFTParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -5185,6 +5731,92 @@ func (m *MsgModifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenIndex) > 0 {
+		i -= len(m.TokenIndex)
+		copy(dAtA[i:], m.TokenIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIndex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgGrantPermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5845,6 +6477,46 @@ func (m *MsgRevokeOperatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgApprove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateContract) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5955,6 +6627,16 @@ func (m *MsgIssueNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6067,6 +6749,16 @@ func (m *MintNFTParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6246,6 +6938,44 @@ func (m *MsgModifyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgGrantPermission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7824,7 +8554,7 @@ func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgApprove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7847,15 +8577,15 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApprove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApprove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7883,11 +8613,11 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7915,11 +8645,11 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7947,11 +8677,11 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7979,7 +8709,77 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8002,7 +8802,7 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8025,44 +8825,12 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8084,7 +8852,7 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8107,15 +8875,15 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8143,7 +8911,7 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -8179,7 +8947,7 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8207,50 +8975,11 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Mintable = bool(v != 0)
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8278,49 +9007,348 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -8606,6 +9634,76 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeSchema = append(m.AttributeSchema, AttributeDefinition{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9324,7 +10422,77 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10461,6 +11629,270 @@ func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRoyaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoyaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
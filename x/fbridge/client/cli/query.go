@@ -13,7 +13,11 @@ import (
 )
 
 const (
-	FlagSequences = "sequences"
+	FlagSequences   = "sequences"
+	FlagDenom       = "denom"
+	FlagDestChainID = "dest-chain-id"
+
+	FlagHaltDuration = "halt-duration"
 )
 
 // NewQueryCmd returns the query commands for fbridge module
@@ -27,13 +31,25 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryParamsCmd(),
 		NewQueryNextSeqSendCmd(),
 		NewQuerySeqToBlocknumsCmd(),
+		NewQueryRoutesCmd(),
+		NewQueryRouteCmd(),
+		NewQueryTransferQuotaCmd(),
+		NewQueryGreatestSeqByOperatorCmd(),
+		NewQueryGreatestConsecutiveConfirmedSeqCmd(),
+		NewQuerySubmittedProvisionCmd(),
+		NewQueryConfirmedProvisionCmd(),
+		NewQueryNeededSubmissionSeqsCmd(),
+		NewQueryCommitmentsCmd(),
 		NewQueryMembersCmd(),
 		NewQueryMemberCmd(),
 		NewQueryProposalsCmd(),
 		NewQueryProposalCmd(),
+		NewQueryProposalResultsCmd(),
+		NewQueryProposalResultCmd(),
 		NewQueryVotesCmd(),
 		NewQueryVoteCmd(),
 		NewQueryBridgeStatusCmd(),
+		NewQueryBridgeHaltsCmd(),
 	)
 
 	return cmd
@@ -74,7 +90,12 @@ func NewQueryNextSeqSendCmd() *cobra.Command {
 				return err
 			}
 			qc := types.NewQueryClient(clientCtx)
-			res, err := qc.NextSeqSend(cmd.Context(), &types.QueryNextSeqSendRequest{})
+			denom, chainID, err := readRouteFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.NextSeqSend(cmd.Context(), &types.QueryNextSeqSendRequest{Denom: denom, ChainId: chainID})
 			if err != nil {
 				return err
 			}
@@ -83,6 +104,7 @@ func NewQueryNextSeqSendCmd() *cobra.Command {
 		},
 	}
 
+	addRouteFlagsToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -110,7 +132,12 @@ func NewQuerySeqToBlocknumsCmd() *cobra.Command {
 				seqs[i] = uint64(seq)
 			}
 
-			res, err := qc.SeqToBlocknums(cmd.Context(), &types.QuerySeqToBlocknumsRequest{Seqs: seqs})
+			denom, chainID, err := readRouteFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.SeqToBlocknums(cmd.Context(), &types.QuerySeqToBlocknumsRequest{Seqs: seqs, Denom: denom, ChainId: chainID})
 			if err != nil {
 				return err
 			}
@@ -120,6 +147,282 @@ func NewQuerySeqToBlocknumsCmd() *cobra.Command {
 	}
 
 	cmd.Flags().Int64Slice(FlagSequences, []int64{}, "comma separated list of bridge sequnece numbers")
+	addRouteFlagsToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func addRouteFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDenom, "", "denom of the route (default route if omitted)")
+	cmd.Flags().String(FlagDestChainID, "", "chain ID of the destination chain of the route (default route if omitted)")
+}
+
+func readRouteFlags(cmd *cobra.Command) (denom, chainID string, err error) {
+	if denom, err = cmd.Flags().GetString(FlagDenom); err != nil {
+		return "", "", err
+	}
+	if chainID, err = cmd.Flags().GetString(FlagDestChainID); err != nil {
+		return "", "", err
+	}
+
+	return denom, chainID, nil
+}
+
+func NewQueryRoutesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "routes",
+		Short:   "Query all the bridge routes other than the default route",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s routes", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.Routes(cmd.Context(), &types.QueryRoutesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all routes")
+	return cmd
+}
+
+func NewQueryRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "route [denom] [dest_chain_id]",
+		Short:   "Query a bridge route of a denom to a destination chain",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s route cony 8217", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.Route(cmd.Context(), &types.QueryRouteRequest{Denom: args[0], ChainId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryTransferQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-quota",
+		Short:   "Query the outbound transfer limits and the remaining volume of the current window",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s transfer-quota", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.TransferQuota(cmd.Context(), &types.QueryTransferQuotaRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryGreatestSeqByOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "greatest-seq-by-operator [operator]",
+		Short:   "Query the greatest sequence number submitted by a specific operator",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s greatest-seq-by-operator link1...", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GreatestSeqByOperator(cmd.Context(), &types.QueryGreatestSeqByOperatorRequest{Operator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryGreatestConsecutiveConfirmedSeqCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "greatest-consecutive-confirmed-seq",
+		Short:   "Query the greatest consecutive sequence number confirmed by n-of-m operators",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s greatest-consecutive-confirmed-seq", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GreatestConsecutiveConfirmedSeq(cmd.Context(), &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQuerySubmittedProvisionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submitted-provision [operator] [seq]",
+		Short:   "Query a provision submitted by a specific operator",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s submitted-provision link1... 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.SubmittedProvision(cmd.Context(), &types.QuerySubmittedProvisionRequest{Operator: args[0], Seq: seq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryConfirmedProvisionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "confirmed-provision [seq]",
+		Short:   "Query a provision confirmed by n-of-m operators",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s confirmed-provision 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.ConfirmedProvision(cmd.Context(), &types.QueryConfirmedProvisionRequest{Seq: seq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryNeededSubmissionSeqsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "needed-submission-seqs [operator] [range]",
+		Short:   "Query a list of sequence numbers that need to be submitted by a specific operator",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s needed-submission-seqs link1... 100", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			r, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.NeededSubmissionSeqs(cmd.Context(), &types.QueryNeededSubmissionSeqsRequest{Operator: args[0], Range: r})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCommitmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "commitments [seq]",
+		Short:   "Query the commitments submitted by operators for a specific sequence number",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s commitments 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.Commitments(cmd.Context(), &types.QueryCommitmentsRequest{Seq: seq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -238,6 +541,68 @@ func NewQueryProposalCmd() *cobra.Command {
 	return cmd
 }
 
+func NewQueryProposalResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposal-results",
+		Short:   "Query the final tallies of all the past role proposals",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s proposal-results", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.ProposalResults(cmd.Context(), &types.QueryProposalResultsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all proposal results")
+	return cmd
+}
+
+func NewQueryProposalResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposal-result [proposal_id]",
+		Short:   "Query the final tally of a specific past role proposal",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s proposal-result 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.ProposalResult(cmd.Context(), &types.QueryProposalResultRequest{ProposalId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewQueryVotesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "votes [proposal_id]",
@@ -322,3 +687,26 @@ func NewQueryBridgeStatusCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewQueryBridgeHaltsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halts",
+		Short: "Query the bridge switches of the guardians who have halted the bridge and their expiry times",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+			res, err := qc.BridgeHalts(cmd.Context(), &types.QueryBridgeHaltsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
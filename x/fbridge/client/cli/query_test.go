@@ -6,11 +6,14 @@ import (
 	"io"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/codec"
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/client/cli"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
@@ -19,14 +22,26 @@ const FlagOutput = "output"
 
 func (s *CLITestSuite) TestNewQueryCmd() {
 	cmdQuery := []string{
+		"commitments",
+		"confirmed-provision",
+		"greatest-consecutive-confirmed-seq",
+		"greatest-seq-by-operator",
+		"halts",
 		"member",
 		"members",
+		"needed-submission-seqs",
 		"params",
 		"proposal",
+		"proposal-result",
+		"proposal-results",
 		"proposals",
+		"route",
+		"routes",
 		"sending-next-seq",
 		"seq-to-blocknums",
 		"status",
+		"submitted-provision",
+		"transfer-quota",
 		"vote",
 		"votes",
 	}
@@ -577,3 +592,189 @@ func (s *CLITestSuite) TestQueryBridgeStatusCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQueryProvisionCmds() {
+	tcs := []struct {
+		name         string
+		cmd          *cobra.Command
+		resp         codec.ProtoMarshaler
+		args         []string
+		expectResult proto.Message
+		expectErr    bool
+	}{
+		{
+			"proposal-results",
+			cli.NewQueryProposalResultsCmd(),
+			&types.QueryProposalResultsResponse{Results: []types.RoleProposalResult{{Proposal: types.RoleProposal{Id: 1}, Status: types.ProposalStatusPassed}}},
+			[]string{},
+			&types.QueryProposalResultsResponse{},
+			false,
+		},
+		{
+			"proposal-result",
+			cli.NewQueryProposalResultCmd(),
+			&types.QueryProposalResultResponse{Result: types.RoleProposalResult{Proposal: types.RoleProposal{Id: 1}, Status: types.ProposalStatusRejected}},
+			[]string{"1"},
+			&types.QueryProposalResultResponse{},
+			false,
+		},
+		{
+			"proposal-result with invalid id",
+			cli.NewQueryProposalResultCmd(),
+			&types.QueryProposalResultResponse{},
+			[]string{"a"},
+			nil,
+			true,
+		},
+		{
+			"halts",
+			cli.NewQueryBridgeHaltsCmd(),
+			&types.QueryBridgeHaltsResponse{Halts: []types.BridgeSwitch{{Guardian: s.addrs[0].String(), Status: types.StatusInactive}}},
+			[]string{},
+			&types.QueryBridgeHaltsResponse{},
+			false,
+		},
+		{
+			"routes",
+			cli.NewQueryRoutesCmd(),
+			&types.QueryRoutesResponse{Routes: []types.Route{{Denom: "cony", ChainId: "8217", ReceiverValidator: types.ReceiverValidatorEVM, Status: types.StatusActive}}},
+			[]string{},
+			&types.QueryRoutesResponse{},
+			false,
+		},
+		{
+			"route",
+			cli.NewQueryRouteCmd(),
+			&types.QueryRouteResponse{Route: types.Route{Denom: "cony", ChainId: "8217", ReceiverValidator: types.ReceiverValidatorEVM, Status: types.StatusActive}, NextSeq: 1},
+			[]string{"cony", "8217"},
+			&types.QueryRouteResponse{},
+			false,
+		},
+		{
+			"route - missing chain id",
+			cli.NewQueryRouteCmd(),
+			&types.QueryRouteResponse{},
+			[]string{"cony"},
+			&types.QueryRouteResponse{},
+			true,
+		},
+		{
+			"sending-next-seq of a route",
+			cli.NewQueryNextSeqSendCmd(),
+			&types.QueryNextSeqSendResponse{Seq: 3},
+			[]string{fmt.Sprintf("--%s=cony", cli.FlagDenom), fmt.Sprintf("--%s=8217", cli.FlagDestChainID)},
+			&types.QueryNextSeqSendResponse{},
+			false,
+		},
+		{
+			"transfer-quota",
+			cli.NewQueryTransferQuotaCmd(),
+			&types.QueryTransferQuotaResponse{
+				MaxTransferAmount: sdk.NewInt(100),
+				TransferVolumeCap: sdk.NewInt(1000),
+				UsedVolume:        sdk.NewInt(100),
+				RemainingVolume:   sdk.NewInt(900),
+			},
+			[]string{},
+			&types.QueryTransferQuotaResponse{},
+			false,
+		},
+		{
+			"greatest-seq-by-operator",
+			cli.NewQueryGreatestSeqByOperatorCmd(),
+			&types.QueryGreatestSeqByOperatorResponse{Seq: 1},
+			[]string{s.addrs[0].String()},
+			&types.QueryGreatestSeqByOperatorResponse{},
+			false,
+		},
+		{
+			"greatest-consecutive-confirmed-seq",
+			cli.NewQueryGreatestConsecutiveConfirmedSeqCmd(),
+			&types.QueryGreatestConsecutiveConfirmedSeqResponse{Seq: 1},
+			[]string{},
+			&types.QueryGreatestConsecutiveConfirmedSeqResponse{},
+			false,
+		},
+		{
+			"submitted-provision",
+			cli.NewQuerySubmittedProvisionCmd(),
+			&types.QuerySubmittedProvisionResponse{},
+			[]string{s.addrs[0].String(), "1"},
+			&types.QuerySubmittedProvisionResponse{},
+			false,
+		},
+		{
+			"submitted-provision - invalid seq",
+			cli.NewQuerySubmittedProvisionCmd(),
+			&types.QuerySubmittedProvisionResponse{},
+			[]string{s.addrs[0].String(), "-1"},
+			&types.QuerySubmittedProvisionResponse{},
+			true,
+		},
+		{
+			"confirmed-provision",
+			cli.NewQueryConfirmedProvisionCmd(),
+			&types.QueryConfirmedProvisionResponse{},
+			[]string{"1"},
+			&types.QueryConfirmedProvisionResponse{},
+			false,
+		},
+		{
+			"confirmed-provision - invalid seq",
+			cli.NewQueryConfirmedProvisionCmd(),
+			&types.QueryConfirmedProvisionResponse{},
+			[]string{"one"},
+			&types.QueryConfirmedProvisionResponse{},
+			true,
+		},
+		{
+			"needed-submission-seqs",
+			cli.NewQueryNeededSubmissionSeqsCmd(),
+			&types.QueryNeededSubmissionSeqsResponse{Seqs: []uint64{1, 2}},
+			[]string{s.addrs[0].String(), "10"},
+			&types.QueryNeededSubmissionSeqsResponse{},
+			false,
+		},
+		{
+			"needed-submission-seqs - invalid range",
+			cli.NewQueryNeededSubmissionSeqsCmd(),
+			&types.QueryNeededSubmissionSeqsResponse{},
+			[]string{s.addrs[0].String(), "ten"},
+			&types.QueryNeededSubmissionSeqsResponse{},
+			true,
+		},
+		{
+			"commitments",
+			cli.NewQueryCommitmentsCmd(),
+			&types.QueryCommitmentsResponse{Commitments: []string{"commitment"}},
+			[]string{"1"},
+			&types.QueryCommitmentsResponse{},
+			false,
+		},
+		{
+			"commitments - invalid seq",
+			cli.NewQueryCommitmentsCmd(),
+			&types.QueryCommitmentsResponse{},
+			[]string{"one"},
+			&types.QueryCommitmentsResponse{},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			bz, _ := s.encCfg.Codec.Marshal(tc.resp)
+			clientCtx := s.baseCtx.WithClient(clitestutil.NewMockTendermintRPC(abci.ResponseQuery{
+				Value: bz,
+			}))
+			args := append(tc.args, fmt.Sprintf("--%s=json", FlagOutput))
+			res, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(res.Bytes(), tc.expectResult))
+			}
+		})
+	}
+}
@@ -27,9 +27,16 @@ func NewTxCmd() *cobra.Command {
 
 	TxCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewProvisionTxCmd(),
+		NewHoldTransferTxCmd(),
+		NewReleaseTransferTxCmd(),
+		NewRemoveProvisionTxCmd(),
+		NewClaimBatchTxCmd(),
+		NewClaimTxCmd(),
 		NewSuggestRoleTxCmd(),
 		NewAddVoteForRoleTxCmd(),
 		NewSetBridgeStatusTxCmd(),
+		NewHaltRouteTxCmd(),
 	)
 
 	return TxCmd
@@ -37,10 +44,11 @@ func NewTxCmd() *cobra.Command {
 
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "transfer [to_address] [amount]",
-		Short:   `Transfer token from current chain to counterparty chain`,
-		Example: fmt.Sprintf("%s tx %s transfer link1... 1000cony --from mykey", version.AppName, types.ModuleName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "transfer [to_address] [amount]",
+		Short: `Transfer token from current chain to counterparty chain`,
+		Example: fmt.Sprintf("%s tx %s transfer 0x1A7C... 1000cony --from mykey\n"+
+			"%s tx %s transfer 0x1A7C... 1000cony --dest-chain-id 8217 --from mykey\n", version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -65,6 +73,234 @@ func NewTransferTxCmd() *cobra.Command {
 				Amount:   coins[0].Amount,
 			}
 
+			chainID, err := cmd.Flags().GetString(FlagDestChainID)
+			if err != nil {
+				return err
+			}
+			if len(chainID) != 0 {
+				msg.Denom = coins[0].Denom
+				msg.ChainId = chainID
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDestChainID, "", "chain ID of the destination chain of the route (default route if omitted)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewProvisionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provision [seq] [sender] [receiver] [amount]",
+		Short:   `Submit a provision of the bridge request from counterparty chain (operator only)`,
+		Args:    cobra.ExactArgs(4),
+		Example: fmt.Sprintf("%s tx %s provision 1 0x1A7C... link1... 1000 --from operatorkey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", args[3])
+			}
+
+			msg := types.MsgProvision{
+				From:     from,
+				Seq:      seq,
+				Sender:   args[1],
+				Receiver: args[2],
+				Amount:   amount,
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			msg.Denom = denom
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "denom of the route (default route if omitted)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewHoldTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hold-transfer [seq]",
+		Short:   `Hold a confirmed provision so that it cannot be claimed (guardian only)`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s hold-transfer 1 --from guardiankey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgHoldTransfer{
+				From: from,
+				Seq:  seq,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewReleaseTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-transfer [seq]",
+		Short:   `Release the time lock of a confirmed provision (guardian only)`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s release-transfer 1 --from guardiankey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgReleaseTransfer{
+				From: from,
+				Seq:  seq,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveProvisionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-provision [seq]",
+		Short:   `Remove a confirmed provision, so that operators can submit it again (judge only)`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s remove-provision 1 --from judgekey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgRemoveProvision{
+				From: from,
+				Seq:  seq,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimBatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-batch [max_claims]",
+		Short:   `Claim multiple claimable provisions at once`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s claim-batch 10 --from mykey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			maxClaims, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid max claims: %s", args[0])
+			}
+
+			msg := types.MsgClaimBatch{
+				From:      from,
+				MaxClaims: maxClaims,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim [seq]",
+		Short:   `Claim a provision with a specific sequence number`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s claim 1 --from mykey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgClaim{
+				From: from,
+				Seq:  seq,
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
@@ -159,8 +395,12 @@ func NewSetBridgeStatusTxCmd() *cobra.Command {
 		Use:   "set-bridge-status [status]",
 		Short: `Set sender's bridge switch for halting/resuming the bridge module. Each guardian has their own switch. (halt|resume)`,
 		Args:  cobra.ExactArgs(1),
+		Long: `Set sender's bridge switch for halting/resuming the bridge module. Each guardian has their own switch. (halt|resume)
+If --halt-duration is given on halting, the switch is resumed automatically after the duration.`,
 		Example: fmt.Sprintf("%s tx %s set-bridge-status halt --from guardiankey\n"+
-			"%s tx %s set-bridge-status resume --from guardiankey\n", version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+			"%s tx %s set-bridge-status halt --halt-duration 1h --from guardiankey\n"+
+			"%s tx %s set-bridge-status resume --from guardiankey\n",
+			version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -180,9 +420,50 @@ func NewSetBridgeStatusTxCmd() *cobra.Command {
 				return sdkerrors.ErrInvalidRequest.Wrapf("invalid bridge status: %s", args[0])
 			}
 
+			haltDuration, err := cmd.Flags().GetDuration(FlagHaltDuration)
+			if err != nil {
+				return err
+			}
+			if haltDuration < 0 {
+				return sdkerrors.ErrInvalidRequest.Wrapf("negative halt duration: %s", haltDuration)
+			}
+
 			msg := types.MsgSetBridgeStatus{
+				Guardian:     from,
+				Status:       bs,
+				HaltDuration: uint64(haltDuration),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagHaltDuration, 0, "duration of the halt after which the switch is resumed automatically (e.g. 1h). Zero means no expiry.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewHaltRouteTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "halt-route [denom] [dest_chain_id]",
+		Short:   `Halt a bridge route of a denom to a destination chain (guardian only)`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx %s halt-route cony 8217 --from guardiankey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+
+			msg := types.MsgHaltRoute{
 				Guardian: from,
-				Status:   bs,
+				Denom:    args[0],
+				ChainId:  args[1],
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
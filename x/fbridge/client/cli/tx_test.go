@@ -8,6 +8,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	rpcclientmock "github.com/tendermint/tendermint/rpc/client/mock"
@@ -101,6 +102,13 @@ func cliArgs(args ...string) []string {
 func (s *CLITestSuite) TestNewTxCmd() {
 	cmdQuery := []string{
 		"add-vote-for-role",
+		"claim",
+		"claim-batch",
+		"halt-route",
+		"hold-transfer",
+		"provision",
+		"release-transfer",
+		"remove-provision",
 		"set-bridge-status",
 		"suggest-role",
 		"transfer",
@@ -134,6 +142,18 @@ func (s *CLITestSuite) TestNewTransferTxCmd() {
 			respType:     &sdk.TxResponse{},
 			expectedCode: 0,
 		},
+		{
+			name: "valid request through a route",
+			args: cliArgs(
+				s.addrs[1].String(),
+				"10stake",
+				fmt.Sprintf("--%s=%s", cli.FlagDestChainID, "8217"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
 		{
 			name: "invalid from address",
 			args: cliArgs(
@@ -178,6 +198,53 @@ func (s *CLITestSuite) TestNewTransferTxCmd() {
 	}
 }
 
+func (s *CLITestSuite) TestNewHaltRouteTxCmd() {
+	cmd := cli.NewHaltRouteTxCmd()
+	s.Require().NotNil(cmd)
+
+	tcs := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			name: "valid request",
+			args: cliArgs(
+				"stake",
+				"8217",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "missing chain id",
+			args: cliArgs(
+				"stake",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				tsResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, tsResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestNewSuggestRoleTxCmd() {
 	cmd := cli.NewSuggestRoleTxCmd()
 	s.Require().NotNil(cmd)
@@ -313,8 +380,6 @@ func (s *CLITestSuite) TestNewAddVoteForRoleTxCmd() {
 }
 
 func (s *CLITestSuite) TestNewSetBridgeStatusTxCmd() {
-	cmd := cli.NewSetBridgeStatusTxCmd()
-	s.Require().NotNil(cmd)
 	tcs := []struct {
 		name         string
 		args         []string
@@ -348,6 +413,35 @@ func (s *CLITestSuite) TestNewSetBridgeStatusTxCmd() {
 			respType:     &sdk.TxResponse{},
 			expectedCode: 0,
 		},
+		{
+			name: "invalid halt duration",
+			args: cliArgs(
+				"halt",
+				fmt.Sprintf("--%s=%s", cli.FlagHaltDuration, "-1h"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0].String()),
+			),
+			expectErr: true,
+		},
+		{
+			name: "valid request - halt with duration",
+			args: cliArgs(
+				"halt",
+				fmt.Sprintf("--%s=%s", cli.FlagHaltDuration, "1h"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0].String()),
+			),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "resume with halt duration",
+			args: cliArgs(
+				"resume",
+				fmt.Sprintf("--%s=%s", cli.FlagHaltDuration, "1h"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0].String()),
+			),
+			expectErr: true,
+		},
 		{
 			name: "valid request - resume",
 			args: cliArgs(
@@ -360,6 +454,82 @@ func (s *CLITestSuite) TestNewSetBridgeStatusTxCmd() {
 		},
 	}
 
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			// a fresh command, not to carry the flags over from the previous case
+			cmd := cli.NewSetBridgeStatusTxCmd()
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				tsResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, tsResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewProvisionTxCmd() {
+	cmd := cli.NewProvisionTxCmd()
+	s.Require().NotNil(cmd)
+
+	tcs := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			name: "valid request",
+			args: cliArgs(
+				"1",
+				"0x1A7C26B0437Aa2d3c8454383650a5D3c35087f91",
+				s.addrs[1].String(),
+				"10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "invalid from address",
+			args: cliArgs(
+				"1",
+				"0x1A7C26B0437Aa2d3c8454383650a5D3c35087f91",
+				s.addrs[1].String(),
+				"10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, "link1..."),
+			),
+			expectErr: true,
+		},
+		{
+			name: "invalid sequence",
+			args: cliArgs(
+				"-1",
+				"0x1A7C26B0437Aa2d3c8454383650a5D3c35087f91",
+				s.addrs[1].String(),
+				"10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr: true,
+		},
+		{
+			name: "invalid amount",
+			args: cliArgs(
+				"1",
+				"0x1A7C26B0437Aa2d3c8454383650a5D3c35087f91",
+				s.addrs[1].String(),
+				"10stake",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr: true,
+		},
+	}
+
 	for _, tc := range tcs {
 		s.Run(tc.name, func() {
 			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
@@ -374,3 +544,64 @@ func (s *CLITestSuite) TestNewSetBridgeStatusTxCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestNewSeqTxCmds() {
+	cmds := map[string]*cobra.Command{
+		"hold-transfer":    cli.NewHoldTransferTxCmd(),
+		"release-transfer": cli.NewReleaseTransferTxCmd(),
+		"remove-provision": cli.NewRemoveProvisionTxCmd(),
+		"claim-batch":      cli.NewClaimBatchTxCmd(),
+		"claim":            cli.NewClaimTxCmd(),
+	}
+
+	tcs := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			name: "valid request",
+			args: cliArgs(
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "invalid from address",
+			args: cliArgs(
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, "link1..."),
+			),
+			expectErr: true,
+		},
+		{
+			name: "invalid number",
+			args: cliArgs(
+				"0xf",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			),
+			expectErr: true,
+		},
+	}
+
+	for name, cmd := range cmds {
+		for _, tc := range tcs {
+			s.Run(fmt.Sprintf("%s: %s", name, tc.name), func() {
+				out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+				if tc.expectErr {
+					s.Require().Error(err)
+				} else {
+					s.Require().NoError(err, out.String())
+					s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+					tsResp := tc.respType.(*sdk.TxResponse)
+					s.Require().Equal(tc.expectedCode, tsResp.Code, out.String())
+				}
+			})
+		}
+	}
+}
@@ -10,10 +10,12 @@ import (
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	k.InitMemStore(ctx)
 
+	k.expireBridgeHalts(ctx)
+
 	proposals := k.GetRoleProposals(ctx)
 	for _, proposal := range proposals {
 		if ctx.BlockTime().After(proposal.ExpiredAt) {
-			if err := k.deleteRoleProposal(ctx, proposal.Id); err != nil {
+			if err := k.finalizeRoleProposal(ctx, proposal, types.ProposalStatusExpired); err != nil {
 				panic(err)
 			}
 		}
@@ -24,21 +26,22 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 	guardianTrustLevel := k.GetParams(ctx).GuardianTrustLevel
 	proposals := k.GetRoleProposals(ctx)
 	for _, proposal := range proposals {
-		votes := k.GetProposalVotes(ctx, proposal.Id)
+		nGuardian := k.GetRoleMetadata(ctx).Guardian
+		voteYes, voteNo := k.tallyRoleProposal(ctx, proposal.Id)
 
-		var voteYes uint64 = 0
-		for _, vote := range votes {
-			if vote.Option == types.OptionYes {
-				voteYes++
+		switch {
+		case types.CheckTrustLevelThreshold(nGuardian, voteYes, guardianTrustLevel) || proposal.Proposer == k.GetAuthority():
+			if err := k.updateRole(ctx, proposal.Role, sdk.MustAccAddressFromBech32(proposal.Target)); err != nil {
+				panic(err)
 			}
-		}
 
-		if types.CheckTrustLevelThreshold(k.GetRoleMetadata(ctx).Guardian, voteYes, guardianTrustLevel) || proposal.Proposer == k.GetAuthority() {
-			if err := k.updateRole(ctx, proposal.Role, sdk.MustAccAddressFromBech32(proposal.Target)); err != nil {
+			if err := k.finalizeRoleProposal(ctx, proposal, types.ProposalStatusPassed); err != nil {
 				panic(err)
 			}
 
-			if err := k.deleteRoleProposal(ctx, proposal.Id); err != nil {
+		// the proposal can never pass even if all the remaining guardians vote yes
+		case voteNo >= nGuardian || !types.CheckTrustLevelThreshold(nGuardian, nGuardian-voteNo, guardianTrustLevel):
+			if err := k.finalizeRoleProposal(ctx, proposal, types.ProposalStatusRejected); err != nil {
 				panic(err)
 			}
 		}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
@@ -19,3 +21,77 @@ func (s *IntegrationTestSuite) TestBeginBlocker() {
 	_, err = s.app.FbridgeKeeper.RegisterRoleProposal(s.ctx, s.guardians[0], dummy, types.RoleGuardian)
 	s.Require().NoError(err)
 }
+
+func (s *IntegrationTestSuite) TestEndBlockerTally() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	targets := simapp.AddTestAddrs(s.app, s.ctx, 3, sdk.NewInt(1000000000))
+
+	// the role proposals of the guardians in SetupTest have been passed by the authority
+	res, err := s.queryClient.ProposalResults(goctx, &types.QueryProposalResultsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 4)
+	for _, result := range res.Results {
+		s.Require().Equal(types.ProposalStatusPassed, result.Status)
+	}
+
+	vote := func(id uint64, voter sdk.AccAddress, option types.VoteOption) {
+		_, err := s.msgServer.AddVoteForRole(goctx, &types.MsgAddVoteForRole{From: voter.String(), ProposalId: id, Option: option})
+		s.Require().NoError(err)
+	}
+
+	rejected, err := s.app.FbridgeKeeper.RegisterRoleProposal(s.ctx, s.guardians[0], targets[0], types.RoleJudge)
+	s.Require().NoError(err)
+	passed, err := s.app.FbridgeKeeper.RegisterRoleProposal(s.ctx, s.guardians[0], targets[1], types.RoleJudge)
+	s.Require().NoError(err)
+	expired, err := s.app.FbridgeKeeper.RegisterRoleProposal(s.ctx, s.guardians[0], targets[2], types.RoleJudge)
+	s.Require().NoError(err)
+
+	// a single NO vote does not make the threshold (2/3) unreachable
+	vote(rejected.Id, s.guardians[0], types.OptionYes)
+	vote(rejected.Id, s.guardians[1], types.OptionNo)
+	vote(passed.Id, s.guardians[0], types.OptionYes)
+	vote(expired.Id, s.guardians[0], types.OptionNo)
+	s.app.FbridgeKeeper.EndBlocker(s.ctx)
+	s.Require().Len(s.app.FbridgeKeeper.GetRoleProposals(s.ctx), 3)
+
+	vote(rejected.Id, s.guardians[2], types.OptionNo)
+	vote(passed.Id, s.guardians[1], types.OptionYes)
+	s.app.FbridgeKeeper.EndBlocker(s.ctx)
+	s.Require().Equal([]types.RoleProposal{expired}, s.app.FbridgeKeeper.GetRoleProposals(s.ctx))
+	s.Require().Equal(types.RoleEmpty, s.app.FbridgeKeeper.GetRole(s.ctx, targets[0]))
+	s.Require().Equal(types.RoleJudge, s.app.FbridgeKeeper.GetRole(s.ctx, targets[1]))
+
+	result, err := s.queryClient.ProposalResult(goctx, &types.QueryProposalResultRequest{ProposalId: rejected.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(types.RoleProposalResult{
+		Proposal:      rejected,
+		Status:        types.ProposalStatusRejected,
+		YesCount:      1,
+		NoCount:       2,
+		GuardianCount: 3,
+		FinalizedAt:   s.ctx.BlockTime().UTC(),
+	}, result.Result)
+
+	result, err = s.queryClient.ProposalResult(goctx, &types.QueryProposalResultRequest{ProposalId: passed.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusPassed, result.Result.Status)
+	s.Require().EqualValues(2, result.Result.YesCount)
+
+	_, err = s.queryClient.ProposalResult(goctx, &types.QueryProposalResultRequest{ProposalId: expired.Id})
+	s.Require().Error(err)
+
+	bh := s.ctx.BlockHeader()
+	bh.Time = expired.ExpiredAt.Add(time.Second)
+	s.ctx = s.ctx.WithBlockHeader(bh)
+	s.app.FbridgeKeeper.BeginBlocker(s.ctx)
+	s.Require().Empty(s.app.FbridgeKeeper.GetRoleProposals(s.ctx))
+
+	result, err = s.queryClient.ProposalResult(goctx, &types.QueryProposalResultRequest{ProposalId: expired.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusExpired, result.Result.Status)
+	s.Require().EqualValues(1, result.Result.NoCount)
+
+	res, err = s.queryClient.ProposalResults(goctx, &types.QueryProposalResultsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 7)
+}
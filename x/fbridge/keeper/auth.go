@@ -81,6 +81,7 @@ func (k Keeper) updateRole(ctx sdk.Context, role types.Role, addr sdk.AccAddress
 		}
 
 		k.deleteBridgeSwitch(ctx, addr)
+		k.deleteBridgeHalt(ctx, addr)
 
 	case types.RoleOperator:
 		roleMeta.Operator--
@@ -115,13 +116,23 @@ func (k Keeper) updateRole(ctx sdk.Context, role types.Role, addr sdk.AccAddress
 	return nil
 }
 
-func (k Keeper) updateBridgeSwitch(ctx sdk.Context, guardian sdk.AccAddress, status types.BridgeStatus) error {
+// updateBridgeSwitch updates the bridge switch of the guardian.
+// If haltDuration is positive, the halt expires after the duration and the switch falls back to active in BeginBlocker.
+func (k Keeper) updateBridgeSwitch(ctx sdk.Context, guardian sdk.AccAddress, status types.BridgeStatus, haltDuration time.Duration) error {
 	if sw, err := k.GetBridgeSwitch(ctx, guardian); err == nil && sw.Status == status {
 		return sdkerrors.ErrInvalidRequest.Wrapf("%s already set %s", guardian, status)
 	} else if err != nil {
 		return err
 	}
 
+	if haltDuration < 0 || haltDuration > types.MaxHaltDuration {
+		return sdkerrors.ErrInvalidRequest.Wrapf("halt duration must be between 0 and %s", types.MaxHaltDuration)
+	}
+
+	if haltDuration != 0 && status != types.StatusInactive {
+		return sdkerrors.ErrInvalidRequest.Wrap("halt duration is only allowed for halting the bridge")
+	}
+
 	nInactive := k.GetBridgeInactiveCounter(ctx)
 	switch status {
 	case types.StatusActive:
@@ -137,9 +148,38 @@ func (k Keeper) updateBridgeSwitch(ctx sdk.Context, guardian sdk.AccAddress, sta
 		return err
 	}
 
+	if haltDuration > 0 {
+		k.setBridgeHalt(ctx, guardian, ctx.BlockTime().Add(haltDuration))
+	} else {
+		k.deleteBridgeHalt(ctx, guardian)
+	}
+
 	return nil
 }
 
+// expireBridgeHalts turns the bridge switches whose halt has expired back to active.
+func (k Keeper) expireBridgeHalts(ctx sdk.Context) {
+	var expired []sdk.AccAddress
+	k.iterateBridgeHalts(ctx, func(guardian sdk.AccAddress, expiresAt time.Time) bool {
+		if !ctx.BlockTime().Before(expiresAt) {
+			expired = append(expired, guardian)
+		}
+		return false
+	})
+
+	for _, guardian := range expired {
+		if err := k.updateBridgeSwitch(ctx, guardian, types.StatusActive, 0); err != nil {
+			panic(err)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeHaltExpired{
+			Guardian: guardian.String(),
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func (k Keeper) setNextProposalID(ctx sdk.Context, seq uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := make([]byte, 8)
@@ -184,6 +224,73 @@ func (k Keeper) deleteRoleProposal(ctx sdk.Context, id uint64) error {
 	return nil
 }
 
+// finalizeRoleProposal records the final tally of the role proposal and removes it from the active proposals.
+func (k Keeper) finalizeRoleProposal(ctx sdk.Context, proposal types.RoleProposal, status types.ProposalStatus) error {
+	voteYes, voteNo := k.tallyRoleProposal(ctx, proposal.Id)
+	result := types.RoleProposalResult{
+		Proposal:      proposal,
+		Status:        status,
+		YesCount:      voteYes,
+		NoCount:       voteNo,
+		GuardianCount: k.GetRoleMetadata(ctx).Guardian,
+		FinalizedAt:   ctx.BlockTime().UTC(),
+	}
+
+	if err := k.deleteRoleProposal(ctx, proposal.Id); err != nil {
+		return err
+	}
+	k.setRoleProposalResult(ctx, result)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFinalizeRoleProposal{Result: result})
+}
+
+// tallyRoleProposal returns the number of yes and no votes of the role proposal
+func (k Keeper) tallyRoleProposal(ctx sdk.Context, proposalID uint64) (voteYes, voteNo uint64) {
+	for _, vote := range k.GetProposalVotes(ctx, proposalID) {
+		switch vote.Option {
+		case types.OptionYes:
+			voteYes++
+		case types.OptionNo:
+			voteNo++
+		}
+	}
+
+	return voteYes, voteNo
+}
+
+func (k Keeper) setRoleProposalResult(ctx sdk.Context, result types.RoleProposalResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&result)
+	store.Set(types.ProposalResultKey(result.Proposal.Id), bz)
+}
+
+func (k Keeper) GetRoleProposalResult(ctx sdk.Context, id uint64) (result types.RoleProposalResult, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProposalResultKey(id))
+	if bz == nil {
+		return result, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// GetRoleProposalResults returns the final tallies of all the past role proposals from store
+func (k Keeper) GetRoleProposalResults(ctx sdk.Context) []types.RoleProposalResult {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyProposalResultPrefix)
+	defer iterator.Close()
+
+	results := make([]types.RoleProposalResult, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.RoleProposalResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+
+	return results
+}
+
 // IterateProposals iterates over the all the role proposals and performs a callback function
 func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.RoleProposal) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -314,7 +421,11 @@ func (k Keeper) GetBridgeSwitch(ctx sdk.Context, guardian sdk.AccAddress) (types
 		panic("bridge switch should have been set when granting the guardian role")
 	}
 
-	return types.BridgeSwitch{Guardian: guardian.String(), Status: types.BridgeStatus(binary.BigEndian.Uint32(bz))}, nil
+	return types.BridgeSwitch{
+		Guardian:      guardian.String(),
+		Status:        types.BridgeStatus(binary.BigEndian.Uint32(bz)),
+		HaltExpiresAt: k.getBridgeHalt(ctx, guardian),
+	}, nil
 }
 
 func (k Keeper) GetBridgeSwitches(ctx sdk.Context) []types.BridgeSwitch {
@@ -325,8 +436,66 @@ func (k Keeper) GetBridgeSwitches(ctx sdk.Context) []types.BridgeSwitch {
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		addr := types.SplitBridgeSwitchKey(iterator.Key())
-		bws = append(bws, types.BridgeSwitch{Guardian: addr.String(), Status: types.BridgeStatus(binary.BigEndian.Uint32(iterator.Value()))})
+		bws = append(bws, types.BridgeSwitch{
+			Guardian:      addr.String(),
+			Status:        types.BridgeStatus(binary.BigEndian.Uint32(iterator.Value())),
+			HaltExpiresAt: k.getBridgeHalt(ctx, addr),
+		})
 	}
 
 	return bws
 }
+
+// GetBridgeHalts returns the bridge switches of the guardians who have halted the bridge
+func (k Keeper) GetBridgeHalts(ctx sdk.Context) []types.BridgeSwitch {
+	halts := make([]types.BridgeSwitch, 0)
+	for _, sw := range k.GetBridgeSwitches(ctx) {
+		if sw.Status == types.StatusInactive {
+			halts = append(halts, sw)
+		}
+	}
+
+	return halts
+}
+
+func (k Keeper) setBridgeHalt(ctx sdk.Context, guardian sdk.AccAddress, expiresAt time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BridgeHaltKey(guardian), sdk.FormatTimeBytes(expiresAt))
+}
+
+func (k Keeper) deleteBridgeHalt(ctx sdk.Context, guardian sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BridgeHaltKey(guardian))
+}
+
+// getBridgeHalt returns the halt expiry time of the guardian's bridge switch, or nil if it does not expire.
+func (k Keeper) getBridgeHalt(ctx sdk.Context, guardian sdk.AccAddress) *time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BridgeHaltKey(guardian))
+	if bz == nil {
+		return nil
+	}
+
+	expiresAt, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return &expiresAt
+}
+
+func (k Keeper) iterateBridgeHalts(ctx sdk.Context, cb func(guardian sdk.AccAddress, expiresAt time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyBridgeHaltPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		guardian := types.SplitBridgeHaltKey(iterator.Key())
+		expiresAt, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		if cb(guardian, expiresAt) {
+			break
+		}
+	}
+}
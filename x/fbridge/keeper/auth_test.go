@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
@@ -12,7 +15,7 @@ import (
 func TestAssignRole(t *testing.T) {
 	key, memKey, ctx, encCfg, authKeeper, bankKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
 	auth := types.DefaultAuthority()
-	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, auth.String())
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, nil, auth.String())
 	err := k.InitGenesis(ctx, types.DefaultGenesisState())
 	require.NoError(t, err)
 
@@ -83,7 +86,7 @@ func TestAssignRole(t *testing.T) {
 func TestBridgeHaltAndResume(t *testing.T) {
 	key, memKey, ctx, encCfg, authKeeper, bankKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
 	auth := types.DefaultAuthority()
-	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, auth.String())
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, nil, auth.String())
 	err := k.InitGenesis(ctx, types.DefaultGenesisState())
 	require.NoError(t, err)
 	for _, addr := range addrs {
@@ -94,21 +97,84 @@ func TestBridgeHaltAndResume(t *testing.T) {
 	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx), "bridge status must be active (3/3)")
 	require.Equal(t, types.BridgeStatusMetadata{Active: 3, Inactive: 0}, k.GetBridgeStatusMetadata(ctx))
 
-	err = k.updateBridgeSwitch(ctx, addrs[0], types.StatusInactive)
+	err = k.updateBridgeSwitch(ctx, addrs[0], types.StatusInactive, 0)
 	require.NoError(t, err)
 	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx), "bridge status must be active (2/3)")
 	require.Equal(t, types.BridgeStatusMetadata{Active: 2, Inactive: 1}, k.GetBridgeStatusMetadata(ctx))
 
-	err = k.updateBridgeSwitch(ctx, addrs[1], types.StatusInactive)
+	err = k.updateBridgeSwitch(ctx, addrs[1], types.StatusInactive, 0)
 	require.NoError(t, err)
 	require.Equal(t, types.StatusInactive, k.GetBridgeStatus(ctx), "bridge status must be inactive (1/3)")
 	require.Equal(t, types.BridgeStatusMetadata{Active: 1, Inactive: 2}, k.GetBridgeStatusMetadata(ctx))
 
-	err = k.updateBridgeSwitch(ctx, addrs[0], types.StatusActive)
+	err = k.updateBridgeSwitch(ctx, addrs[0], types.StatusActive, 0)
 	require.NoError(t, err)
 	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx), "bridge status must be active (2/3)")
 	require.Equal(t, types.BridgeStatusMetadata{Active: 2, Inactive: 1}, k.GetBridgeStatusMetadata(ctx))
 
-	err = k.updateBridgeSwitch(ctx, addrs[0], 3)
+	err = k.updateBridgeSwitch(ctx, addrs[0], 3, 0)
 	require.Error(t, err, "invalid bridge status must be rejected")
 }
+
+func TestBridgeHaltExpiry(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
+	auth := types.DefaultAuthority()
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, nil, auth.String())
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	err := k.InitGenesis(ctx, types.DefaultGenesisState())
+	require.NoError(t, err)
+	for _, addr := range addrs {
+		err = k.updateRole(ctx, types.RoleGuardian, addr)
+		require.NoError(t, err)
+	}
+
+	err = k.updateBridgeSwitch(ctx, addrs[0], types.StatusActive, 0)
+	require.Error(t, err, "already active")
+	err = k.updateBridgeSwitch(ctx, addrs[0], types.StatusInactive, time.Hour)
+	require.NoError(t, err)
+	err = k.updateBridgeSwitch(ctx, addrs[1], types.StatusInactive, 2*time.Hour)
+	require.NoError(t, err)
+	err = k.updateBridgeSwitch(ctx, addrs[2], types.StatusInactive, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusInactive, k.GetBridgeStatus(ctx))
+
+	halts := k.GetBridgeHalts(ctx)
+	require.Len(t, halts, 3)
+	expiries := make(map[string]*time.Time)
+	for _, halt := range halts {
+		expiries[halt.Guardian] = halt.HaltExpiresAt
+	}
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), *expiries[addrs[0].String()])
+	require.Equal(t, ctx.BlockTime().Add(2*time.Hour), *expiries[addrs[1].String()])
+	require.Nil(t, expiries[addrs[2].String()])
+
+	// the first halt expires
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+	require.Equal(t, types.BridgeStatusMetadata{Active: 1, Inactive: 2}, k.GetBridgeStatusMetadata(ctx))
+	require.Len(t, k.GetBridgeHalts(ctx), 2)
+	sw, err := k.GetBridgeSwitch(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.StatusActive, sw.Status)
+	require.Nil(t, sw.HaltExpiresAt)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBridgeHaltExpired{Guardian: addrs[0].String()}, event)
+
+	// resuming manually cancels the expiry
+	err = k.updateBridgeSwitch(ctx, addrs[1], types.StatusActive, 0)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the halt without expiry remains
+	ctx = ctx.WithBlockTime(ctx.BlockTime().AddDate(1, 0, 0))
+	k.BeginBlocker(ctx)
+	require.Equal(t, types.BridgeStatusMetadata{Active: 2, Inactive: 1}, k.GetBridgeStatusMetadata(ctx))
+
+	err = k.updateBridgeSwitch(ctx, addrs[2], types.StatusActive, time.Hour)
+	require.Error(t, err, "halt duration must be rejected when resuming")
+}
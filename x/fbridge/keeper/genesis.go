@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
@@ -16,6 +17,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) error {
 	for _, info := range gs.SendingState.SeqToBlocknum {
 		k.setSeqToBlocknum(ctx, info.Seq, info.Blocknum)
 	}
+	k.initTransferVolumes(ctx, defaultTransferVolumeKeys(), gs.SendingState.TransferVolumes)
 
 	for _, pair := range gs.Roles {
 		if err := k.setRole(ctx, pair.Role, sdk.MustAccAddressFromBech32(pair.Address)); err != nil {
@@ -24,9 +26,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) error {
 	}
 
 	for _, sw := range gs.BridgeSwitches {
-		if err := k.setBridgeSwitch(ctx, sdk.MustAccAddressFromBech32(sw.Guardian), sw.Status); err != nil {
+		guardian := sdk.MustAccAddressFromBech32(sw.Guardian)
+		if err := k.setBridgeSwitch(ctx, guardian, sw.Status); err != nil {
 			return err
 		}
+
+		if sw.HaltExpiresAt != nil {
+			k.setBridgeHalt(ctx, guardian, *sw.HaltExpiresAt)
+		}
 	}
 
 	k.setNextProposalID(ctx, gs.NextRoleProposalId)
@@ -38,7 +45,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) error {
 		k.setVote(ctx, vote.ProposalId, sdk.MustAccAddressFromBech32(vote.Voter), vote.Option)
 	}
 
-	// TODO: we initialize the appropriate genesis parameters whenever the feature is added
+	for _, result := range gs.RoleProposalResults {
+		k.setRoleProposalResult(ctx, result)
+	}
+
+	if err := k.initReceivingState(ctx, gs.ReceivingState); err != nil {
+		return err
+	}
+
+	if err := k.initRouteStates(ctx, gs.Routes); err != nil {
+		return err
+	}
 
 	k.InitMemStore(ctx)
 
@@ -49,15 +66,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
 		Params: k.GetParams(ctx),
 		SendingState: types.SendingState{
-			NextSeq:       k.GetNextSequence(ctx),
-			SeqToBlocknum: k.getAllSeqToBlocknums(ctx),
+			NextSeq:         k.GetNextSequence(ctx),
+			SeqToBlocknum:   k.getAllSeqToBlocknums(ctx),
+			TransferVolumes: k.GetTransferVolumes(ctx),
 		},
-		ReceivingState:     types.ReceivingState{},
-		NextRoleProposalId: k.GetNextProposalID(ctx),
-		RoleProposals:      k.GetRoleProposals(ctx),
-		Votes:              k.GetAllVotes(ctx),
-		Roles:              k.GetRolePairs(ctx),
-		BridgeSwitches:     k.GetBridgeSwitches(ctx),
+		ReceivingState:      k.exportReceivingState(ctx),
+		NextRoleProposalId:  k.GetNextProposalID(ctx),
+		RoleProposals:       k.GetRoleProposals(ctx),
+		Votes:               k.GetAllVotes(ctx),
+		Roles:               k.GetRolePairs(ctx),
+		BridgeSwitches:      k.GetBridgeSwitches(ctx),
+		Routes:              k.getRouteStates(ctx),
+		RoleProposalResults: k.GetRoleProposalResults(ctx),
 	}
 }
 
@@ -77,6 +97,116 @@ func (k Keeper) getAllSeqToBlocknums(ctx sdk.Context) []types.BlockSeqInfo {
 	return infos
 }
 
+func (k Keeper) initReceivingState(ctx sdk.Context, state types.ReceivingState) error {
+	for _, info := range state.GreatestSeqByOperator {
+		k.setGreatestSeqByOperator(ctx, sdk.MustAccAddressFromBech32(info.Operator), info.Seq)
+	}
+
+	for _, info := range state.GreatestConsecutiveSeqByOperator {
+		k.setGreatestConsecutiveSeqByOperator(ctx, sdk.MustAccAddressFromBech32(info.Operator), info.Seq)
+	}
+
+	k.setGreatestConsecutiveConfirmedSeq(ctx, state.GreatestConsecutiveSeq)
+
+	for _, seq := range state.PendingClaimSeqs {
+		k.setPendingClaimSeq(ctx, seq)
+	}
+
+	for _, c := range state.Commitments {
+		commitment, err := types.DecodeCommitment(c.Commitment)
+		if err != nil {
+			return err
+		}
+		k.setCommitment(ctx, c.Seq, sdk.MustAccAddressFromBech32(c.Operator), commitment)
+	}
+
+	for _, p := range state.Provisions {
+		commitment, err := types.DecodeCommitment(p.Commitment)
+		if err != nil {
+			return err
+		}
+		k.setProvisionData(ctx, commitment, *p.Data)
+		k.setProvisionStatus(ctx, commitment, *p.Status)
+	}
+
+	for _, cp := range state.ConfirmedSeqToCommitment {
+		commitment, err := types.DecodeCommitment(cp.Commitment)
+		if err != nil {
+			return err
+		}
+		k.setConfirmedCommitment(ctx, cp.Seq, commitment)
+	}
+
+	return nil
+}
+
+func (k Keeper) exportReceivingState(ctx sdk.Context) types.ReceivingState {
+	store := ctx.KVStore(k.storeKey)
+	state := types.ReceivingState{
+		GreatestConsecutiveSeq: k.GetGreatestConsecutiveConfirmedSeq(ctx),
+		PendingClaimSeqs:       k.GetPendingClaimSeqs(ctx),
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyGreatestSeqByOperatorPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		operator := types.SplitOperatorSeqKey(iterator.Key())
+		state.GreatestSeqByOperator = append(state.GreatestSeqByOperator, &types.OperatorSeqInfo{
+			Operator: operator.String(),
+			Seq:      binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	consecutiveIterator := sdk.KVStorePrefixIterator(store, types.KeyGreatestConsecutiveSeqByOperatorPrefix)
+	defer consecutiveIterator.Close()
+	for ; consecutiveIterator.Valid(); consecutiveIterator.Next() {
+		operator := types.SplitOperatorSeqKey(consecutiveIterator.Key())
+		state.GreatestConsecutiveSeqByOperator = append(state.GreatestConsecutiveSeqByOperator, &types.OperatorSeqInfo{
+			Operator: operator.String(),
+			Seq:      binary.BigEndian.Uint64(consecutiveIterator.Value()),
+		})
+	}
+
+	commitmentIterator := sdk.KVStorePrefixIterator(store, types.KeyCommitmentPrefix)
+	defer commitmentIterator.Close()
+	for ; commitmentIterator.Valid(); commitmentIterator.Next() {
+		seq, operator := types.SplitCommitmentKey(commitmentIterator.Key())
+		state.Commitments = append(state.Commitments, &types.Commitment{
+			Operator:   operator.String(),
+			Seq:        seq,
+			Commitment: types.EncodeCommitment(commitmentIterator.Value()),
+		})
+	}
+
+	provisionIterator := sdk.KVStorePrefixIterator(store, types.KeyProvisionDataPrefix)
+	defer provisionIterator.Close()
+	for ; provisionIterator.Valid(); provisionIterator.Next() {
+		commitment := provisionIterator.Key()[1:]
+		var data types.ProvisionData
+		k.cdc.MustUnmarshal(provisionIterator.Value(), &data)
+		status, found := k.GetProvisionStatus(ctx, commitment)
+		if !found {
+			panic(fmt.Errorf("provision status of seq %d must exist", data.Seq))
+		}
+		state.Provisions = append(state.Provisions, &types.Provision{
+			Commitment: types.EncodeCommitment(commitment),
+			Data:       &data,
+			Status:     &status,
+		})
+	}
+
+	confirmedIterator := sdk.KVStorePrefixIterator(store, types.KeyConfirmedSeqPrefix)
+	defer confirmedIterator.Close()
+	for ; confirmedIterator.Valid(); confirmedIterator.Next() {
+		state.ConfirmedSeqToCommitment = append(state.ConfirmedSeqToCommitment, &types.ConfirmedProvision{
+			Seq:        binary.BigEndian.Uint64(confirmedIterator.Key()[1:]),
+			Commitment: types.EncodeCommitment(confirmedIterator.Value()),
+		})
+	}
+
+	return state
+}
+
 // IterateVotes iterates over the all the votes for role proposals and performs a callback function
 func (k Keeper) IterateVotes(ctx sdk.Context, cb func(proposal types.Vote) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -1,14 +1,22 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
 
 func (s *IntegrationTestSuite) TestExportImportGenesis() {
+	s.ctx = s.ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	expiresAt := s.ctx.BlockTime().Add(time.Hour)
 	goctx := sdk.WrapSDKContext(s.ctx)
 	const expProposalID uint64 = 5
 
+	params := s.app.FbridgeKeeper.GetParams(s.ctx)
+	params.TransferVolumeCap = sdk.NewInt(1000)
+	s.Require().NoError(s.app.FbridgeKeeper.SetParams(s.ctx, params))
+
 	_, err := s.msgServer.Transfer(goctx, &types.MsgTransfer{
 		Sender:   s.guardians[0].String(),
 		Receiver: s.ethAddr,
@@ -30,11 +38,55 @@ func (s *IntegrationTestSuite) TestExportImportGenesis() {
 	})
 	s.Require().NoError(err)
 
+	_, err = s.msgServer.Provision(goctx, &types.MsgProvision{
+		From:     s.operator.String(),
+		Seq:      1,
+		Sender:   s.ethAddr,
+		Receiver: s.guardians[0].String(),
+		Amount:   sdk.NewInt(100),
+	})
+	s.Require().NoError(err)
+
+	s.setRoute(sdk.DefaultBondDenom, "8217", types.ReceiverValidatorEVM)
+	_, err = s.msgServer.Transfer(goctx, &types.MsgTransfer{
+		Sender:   s.guardians[0].String(),
+		Receiver: s.ethAddr,
+		Amount:   sdk.NewInt(100),
+		Denom:    sdk.DefaultBondDenom,
+		ChainId:  "8217",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetBridgeStatus(goctx, &types.MsgSetBridgeStatus{
+		Guardian:     s.guardians[1].String(),
+		Status:       types.StatusInactive,
+		HaltDuration: uint64(time.Hour),
+	})
+	s.Require().NoError(err)
+
 	gen := s.app.FbridgeKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(gen.ReceivingState.Commitments, 1)
+	s.Require().Len(gen.ReceivingState.Provisions, 1)
+	s.Require().Len(gen.ReceivingState.ConfirmedSeqToCommitment, 1)
+	s.Require().Equal([]uint64{1}, gen.ReceivingState.PendingClaimSeqs)
+	s.Require().Len(gen.SendingState.TransferVolumes, 1)
+	s.Require().Len(gen.Routes, 1)
+	s.Require().EqualValues(2, gen.Routes[0].NextSeq)
+	s.Require().Equal(s.app.FbridgeKeeper.GetBridgeHalts(s.ctx), []types.BridgeSwitch{{
+		Guardian:      s.guardians[1].String(),
+		Status:        types.StatusInactive,
+		HaltExpiresAt: &expiresAt,
+	}})
 	gen.SendingState.SeqToBlocknum[0].Blocknum = 1
+	gen.Routes[0].SeqToBlocknum[0].Blocknum = 1
 	err = types.ValidateGenesis(*gen)
 	s.Require().NoError(err)
 
 	err = s.app.FbridgeKeeper.InitGenesis(s.ctx, gen)
 	s.Require().NoError(err)
+	s.Require().Equal(gen.ReceivingState, s.app.FbridgeKeeper.ExportGenesis(s.ctx).ReceivingState)
+	s.Require().Equal(gen.Routes, s.app.FbridgeKeeper.ExportGenesis(s.ctx).Routes)
+	s.Require().Equal(gen.BridgeSwitches, s.app.FbridgeKeeper.ExportGenesis(s.ctx).BridgeSwitches)
+	s.Require().Equal(gen.SendingState.TransferVolumes, s.app.FbridgeKeeper.ExportGenesis(s.ctx).SendingState.TransferVolumes)
+	s.Require().Equal(sdk.NewInt(100), s.app.FbridgeKeeper.GetUsedTransferVolume(s.ctx))
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/Finschia/finschia-sdk/store/prefix"
 	sdk "github.com/Finschia/finschia-sdk/types"
-	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
@@ -33,9 +32,15 @@ func (k Keeper) NextSeqSend(goCtx context.Context, req *types.QueryNextSeqSendRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	seq := k.GetNextSequence(ctx)
+	if IsDefaultRoute(req.Denom, req.ChainId) {
+		return &types.QueryNextSeqSendResponse{Seq: k.GetNextSequence(ctx)}, nil
+	}
+
+	if _, found := k.GetRoute(ctx, req.Denom, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("route %s/%s", req.Denom, req.ChainId))
+	}
 
-	return &types.QueryNextSeqSendResponse{Seq: seq}, nil
+	return &types.QueryNextSeqSendResponse{Seq: k.GetRouteNextSequence(ctx, req.Denom, req.ChainId)}, nil
 }
 
 func (k Keeper) SeqToBlocknums(goCtx context.Context, req *types.QuerySeqToBlocknumsRequest) (*types.QuerySeqToBlocknumsResponse, error) {
@@ -50,9 +55,16 @@ func (k Keeper) SeqToBlocknums(goCtx context.Context, req *types.QuerySeqToBlock
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	getBlocknum := k.GetSeqToBlocknum
+	if !IsDefaultRoute(req.Denom, req.ChainId) {
+		getBlocknum = func(ctx sdk.Context, seq uint64) (uint64, error) {
+			return k.GetRouteSeqToBlocknum(ctx, req.Denom, req.ChainId, seq)
+		}
+	}
+
 	bhList := make([]uint64, len(req.Seqs))
 	for i, seq := range req.Seqs {
-		bh, err := k.GetSeqToBlocknum(ctx, seq)
+		bh, err := getBlocknum(ctx, seq)
 		if err != nil {
 			return nil, err
 		}
@@ -62,28 +74,164 @@ func (k Keeper) SeqToBlocknums(goCtx context.Context, req *types.QuerySeqToBlock
 	return &types.QuerySeqToBlocknumsResponse{Blocknums: bhList}, nil
 }
 
-func (k Keeper) GreatestSeqByOperator(ctx context.Context, request *types.QueryGreatestSeqByOperatorRequest) (*types.QueryGreatestSeqByOperatorResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) Routes(goCtx context.Context, req *types.QueryRoutesRequest) (*types.QueryRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRoutePrefix)
+	routes := make([]types.Route, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var route types.Route
+		k.cdc.MustUnmarshal(value, &route)
+		routes = append(routes, route)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoutesResponse{Routes: routes, Pagination: pageRes}, nil
 }
 
-func (k Keeper) GreatestConsecutiveConfirmedSeq(ctx context.Context, request *types.QueryGreatestConsecutiveConfirmedSeqRequest) (*types.QueryGreatestConsecutiveConfirmedSeqResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) Route(goCtx context.Context, req *types.QueryRouteRequest) (*types.QueryRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	route, found := k.GetRoute(ctx, req.Denom, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("route %s/%s", req.Denom, req.ChainId))
+	}
+
+	return &types.QueryRouteResponse{Route: route, NextSeq: k.GetRouteNextSequence(ctx, req.Denom, req.ChainId)}, nil
 }
 
-func (k Keeper) SubmittedProvision(ctx context.Context, request *types.QuerySubmittedProvisionRequest) (*types.QuerySubmittedProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) TransferQuota(goCtx context.Context, req *types.QueryTransferQuotaRequest) (*types.QueryTransferQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	res := &types.QueryTransferQuotaResponse{
+		MaxTransferAmount: sdk.ZeroInt(),
+		TransferVolumeCap: sdk.ZeroInt(),
+		UsedVolume:        k.GetUsedTransferVolume(ctx),
+		RemainingVolume:   sdk.ZeroInt(),
+	}
+	if params.HasTransferLimit() {
+		res.MaxTransferAmount = params.MaxTransferAmount
+	}
+	if params.HasTransferVolumeCap() {
+		res.TransferVolumeCap = params.TransferVolumeCap
+		res.RemainingVolume = sdk.MaxInt(params.TransferVolumeCap.Sub(res.UsedVolume), sdk.ZeroInt())
+	}
+
+	return res, nil
+}
+
+func (k Keeper) GreatestSeqByOperator(goCtx context.Context, req *types.QueryGreatestSeqByOperatorRequest) (*types.QueryGreatestSeqByOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seq := k.GetGreatestSeqByOperator(ctx, operator)
+
+	return &types.QueryGreatestSeqByOperatorResponse{Seq: seq}, nil
+}
+
+func (k Keeper) GreatestConsecutiveConfirmedSeq(goCtx context.Context, req *types.QueryGreatestConsecutiveConfirmedSeqRequest) (*types.QueryGreatestConsecutiveConfirmedSeqResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seq := k.GetGreatestConsecutiveConfirmedSeq(ctx)
+
+	return &types.QueryGreatestConsecutiveConfirmedSeqResponse{Seq: seq}, nil
+}
+
+func (k Keeper) SubmittedProvision(goCtx context.Context, req *types.QuerySubmittedProvisionRequest) (*types.QuerySubmittedProvisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	commitment, found := k.GetCommitment(ctx, req.Seq, operator)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("provision of seq %d submitted by %s", req.Seq, req.Operator))
+	}
+
+	data, pStatus := k.mustGetProvision(ctx, commitment)
+	return &types.QuerySubmittedProvisionResponse{Data: data, Status: pStatus}, nil
 }
 
-func (k Keeper) ConfirmedProvision(ctx context.Context, request *types.QueryConfirmedProvisionRequest) (*types.QueryConfirmedProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) ConfirmedProvision(goCtx context.Context, req *types.QueryConfirmedProvisionRequest) (*types.QueryConfirmedProvisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	commitment, found := k.GetConfirmedCommitment(ctx, req.Seq)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("confirmed provision of seq %d", req.Seq))
+	}
+
+	data, pStatus := k.mustGetProvision(ctx, commitment)
+	return &types.QueryConfirmedProvisionResponse{Data: data, Status: pStatus}, nil
 }
 
-func (k Keeper) NeededSubmissionSeqs(ctx context.Context, request *types.QueryNeededSubmissionSeqsRequest) (*types.QueryNeededSubmissionSeqsResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) NeededSubmissionSeqs(goCtx context.Context, req *types.QueryNeededSubmissionSeqsRequest) (*types.QueryNeededSubmissionSeqsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	const lowerBound uint64 = 1
+	const upperBound uint64 = 1000
+	if req.Range < lowerBound || req.Range > upperBound {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("range must be between %d and %d", lowerBound, upperBound))
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.GetRole(ctx, operator) != types.RoleOperator {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s is not an operator", req.Operator))
+	}
+
+	seqs := k.GetNeededSubmissionSeqs(ctx, operator, req.Range)
+	return &types.QueryNeededSubmissionSeqsResponse{Seqs: seqs}, nil
 }
 
-func (k Keeper) Commitments(ctx context.Context, request *types.QueryCommitmentsRequest) (*types.QueryCommitmentsResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) Commitments(goCtx context.Context, req *types.QueryCommitmentsRequest) (*types.QueryCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	commitments := make([]string, 0)
+	for _, c := range k.GetCommitments(ctx, req.Seq) {
+		commitments = append(commitments, c.Commitment)
+	}
+
+	return &types.QueryCommitmentsResponse{Commitments: commitments}, nil
 }
 
 func (k Keeper) Members(goCtx context.Context, req *types.QueryMembersRequest) (*types.QueryMembersResponse, error) {
@@ -164,6 +312,41 @@ func (k Keeper) Proposal(goCtx context.Context, req *types.QueryProposalRequest)
 	return &types.QueryProposalResponse{Proposal: proposal}, nil
 }
 
+func (k Keeper) ProposalResults(goCtx context.Context, req *types.QueryProposalResultsRequest) (*types.QueryProposalResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyProposalResultPrefix)
+	results := make([]types.RoleProposalResult, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.RoleProposalResult
+		k.cdc.MustUnmarshal(value, &result)
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalResultsResponse{Results: results, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProposalResult(goCtx context.Context, req *types.QueryProposalResultRequest) (*types.QueryProposalResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	result, found := k.GetRoleProposalResult(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("result of role proposal %d", req.ProposalId))
+	}
+
+	return &types.QueryProposalResultResponse{Result: result}, nil
+}
+
 func (k Keeper) Votes(goCtx context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -196,3 +379,12 @@ func (k Keeper) BridgeStatus(goCtx context.Context, req *types.QueryBridgeStatus
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.QueryBridgeStatusResponse{Status: k.GetBridgeStatus(ctx), Metadata: k.GetBridgeStatusMetadata(ctx)}, nil
 }
+
+func (k Keeper) BridgeHalts(goCtx context.Context, req *types.QueryBridgeHaltsRequest) (*types.QueryBridgeHaltsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryBridgeHaltsResponse{Halts: k.GetBridgeHalts(ctx)}, nil
+}
@@ -52,32 +52,196 @@ func (s *IntegrationTestSuite) SetupTest() {
 	s.ethAddr = "0x1A7C26B0437Aa2d3c8454383650a5D3c35087f91"
 }
 
-func (s *IntegrationTestSuite) TestInactiveQuries() {
+func (s *IntegrationTestSuite) TestTransferQuota() {
 	goctx := sdk.WrapSDKContext(s.ctx)
 
-	s.Require().Panics(func() {
-		_, _ = s.queryClient.GreatestSeqByOperator(goctx, &types.QueryGreatestSeqByOperatorRequest{})
+	res, err := s.queryClient.TransferQuota(goctx, &types.QueryTransferQuotaRequest{})
+	s.Require().NoError(err)
+	s.Require().True(res.MaxTransferAmount.IsZero())
+	s.Require().True(res.TransferVolumeCap.IsZero())
+	s.Require().True(res.UsedVolume.IsZero())
+	s.Require().True(res.RemainingVolume.IsZero())
+
+	params := s.app.FbridgeKeeper.GetParams(s.ctx)
+	params.MaxTransferAmount = sdk.NewInt(100)
+	params.TransferVolumeCap = sdk.NewInt(1000)
+	s.Require().NoError(s.app.FbridgeKeeper.SetParams(s.ctx, params))
+	_, err = s.msgServer.Transfer(goctx, &types.MsgTransfer{
+		Sender:   s.guardians[0].String(),
+		Receiver: s.ethAddr,
+		Amount:   sdk.NewInt(100),
 	})
+	s.Require().NoError(err)
 
-	s.Require().Panics(func() {
-		_, _ = s.queryClient.GreatestConsecutiveConfirmedSeq(goctx, &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
-	})
+	res, err = s.queryClient.TransferQuota(goctx, &types.QueryTransferQuotaRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(100), res.MaxTransferAmount)
+	s.Require().Equal(sdk.NewInt(1000), res.TransferVolumeCap)
+	s.Require().Equal(sdk.NewInt(100), res.UsedVolume)
+	s.Require().Equal(sdk.NewInt(900), res.RemainingVolume)
+}
 
-	s.Require().Panics(func() {
-		_, _ = s.queryClient.SubmittedProvision(goctx, &types.QuerySubmittedProvisionRequest{})
-	})
+func (s *IntegrationTestSuite) provisionSeqs(seqs ...uint64) {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	for _, seq := range seqs {
+		_, err := s.msgServer.Provision(goctx, s.provisionMsg(seq))
+		s.Require().NoError(err)
+	}
+}
 
-	s.Require().Panics(func() {
-		_, _ = s.queryClient.ConfirmedProvision(goctx, &types.QueryConfirmedProvisionRequest{})
-	})
+func (s *IntegrationTestSuite) TestGreatestSeqByOperator() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.provisionSeqs(1, 2, 4)
 
-	s.Require().Panics(func() {
-		_, _ = s.queryClient.NeededSubmissionSeqs(goctx, &types.QueryNeededSubmissionSeqsRequest{})
-	})
+	res, err := s.queryClient.GreatestSeqByOperator(goctx, &types.QueryGreatestSeqByOperatorRequest{Operator: s.operator.String()})
+	s.Require().NoError(err)
+	s.Require().EqualValues(4, res.Seq)
 
-	s.Require().Panics(func() {
-		_, _ = s.queryClient.Commitments(goctx, &types.QueryCommitmentsRequest{})
-	})
+	res, err = s.queryClient.GreatestSeqByOperator(goctx, &types.QueryGreatestSeqByOperatorRequest{Operator: s.guardians[0].String()})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, res.Seq)
+
+	_, err = s.queryClient.GreatestSeqByOperator(goctx, &types.QueryGreatestSeqByOperatorRequest{Operator: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestGreatestConsecutiveConfirmedSeq() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+
+	res, err := s.queryClient.GreatestConsecutiveConfirmedSeq(goctx, &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, res.Seq)
+
+	s.provisionSeqs(1, 2, 4)
+	res, err = s.queryClient.GreatestConsecutiveConfirmedSeq(goctx, &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, res.Seq)
+
+	s.provisionSeqs(3)
+	res, err = s.queryClient.GreatestConsecutiveConfirmedSeq(goctx, &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(4, res.Seq)
+}
+
+func (s *IntegrationTestSuite) TestSubmittedProvision() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.provisionSeqs(1)
+
+	tcs := map[string]struct {
+		req    *types.QuerySubmittedProvisionRequest
+		expErr bool
+	}{
+		"valid request": {
+			req: &types.QuerySubmittedProvisionRequest{Operator: s.operator.String(), Seq: 1},
+		},
+		"invalid operator": {
+			req:    &types.QuerySubmittedProvisionRequest{Operator: "invalid", Seq: 1},
+			expErr: true,
+		},
+		"not submitted by the operator": {
+			req:    &types.QuerySubmittedProvisionRequest{Operator: s.guardians[0].String(), Seq: 1},
+			expErr: true,
+		},
+		"seq not found": {
+			req:    &types.QuerySubmittedProvisionRequest{Operator: s.operator.String(), Seq: 2},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		s.Run(name, func() {
+			res, err := s.queryClient.SubmittedProvision(goctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Seq, res.Data.Seq)
+			s.Require().Equal(s.ethAddr, res.Data.Sender)
+			s.Require().Equal(s.guardians[0].String(), res.Data.Receiver)
+			s.Require().Equal(sdk.NewInt(100), res.Data.Amount)
+			s.Require().EqualValues(1, res.Status.ConfirmCounts)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestConfirmedProvision() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.provisionSeqs(1)
+
+	res, err := s.queryClient.ConfirmedProvision(goctx, &types.QueryConfirmedProvisionRequest{Seq: 1})
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, res.Data.Seq)
+	s.Require().False(res.Status.IsClaimed)
+	s.Require().NotZero(res.Status.TimelockEnd)
+
+	_, err = s.queryClient.ConfirmedProvision(goctx, &types.QueryConfirmedProvisionRequest{Seq: 2})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestNeededSubmissionSeqs() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.provisionSeqs(1, 2, 4, 6)
+
+	tcs := map[string]struct {
+		req     *types.QueryNeededSubmissionSeqsRequest
+		expErr  bool
+		expSeqs []uint64
+	}{
+		"valid request": {
+			req:     &types.QueryNeededSubmissionSeqsRequest{Operator: s.operator.String(), Range: 10},
+			expSeqs: []uint64{3, 5},
+		},
+		"limited range": {
+			req:     &types.QueryNeededSubmissionSeqsRequest{Operator: s.operator.String(), Range: 2},
+			expSeqs: []uint64{3},
+		},
+		"zero range": {
+			req:    &types.QueryNeededSubmissionSeqsRequest{Operator: s.operator.String(), Range: 0},
+			expErr: true,
+		},
+		"exceed upper bound (1000)": {
+			req:    &types.QueryNeededSubmissionSeqsRequest{Operator: s.operator.String(), Range: 1001},
+			expErr: true,
+		},
+		"invalid operator": {
+			req:    &types.QueryNeededSubmissionSeqsRequest{Operator: "invalid", Range: 10},
+			expErr: true,
+		},
+		"not an operator": {
+			req:    &types.QueryNeededSubmissionSeqsRequest{Operator: s.guardians[0].String(), Range: 10},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		s.Run(name, func() {
+			res, err := s.queryClient.NeededSubmissionSeqs(goctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expSeqs, res.Seqs)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCommitments() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.provisionSeqs(1)
+
+	res, err := s.queryClient.Commitments(goctx, &types.QueryCommitmentsRequest{Seq: 1})
+	s.Require().NoError(err)
+	s.Require().Len(res.Commitments, 1)
+
+	data, found := s.app.FbridgeKeeper.GetProvisionData(s.ctx, types.MustDecodeCommitment(res.Commitments[0]))
+	s.Require().True(found)
+	s.Require().EqualValues(1, data.Seq)
+
+	res, err = s.queryClient.Commitments(goctx, &types.QueryCommitmentsRequest{Seq: 2})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Commitments)
 }
 
 func (s *IntegrationTestSuite) TestParams() {
@@ -18,6 +18,8 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	authKeeper types.AccountKeeper
 	bankKeeper types.BankKeeper
+	// foundationKeeper is used to fund transfer fees to the x/foundation treasury
+	foundationKeeper types.FoundationKeeper
 
 	// authority can give a role to a specific address like guardian
 	authority string
@@ -28,6 +30,7 @@ func NewKeeper(
 	key, memKey sdk.StoreKey,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	foundationKeeper types.FoundationKeeper,
 	authority string,
 ) Keeper {
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -47,12 +50,13 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:   key,
-		memKey:     memKey,
-		cdc:        cdc,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		authority:  authority,
+		storeKey:         key,
+		memKey:           memKey,
+		cdc:              cdc,
+		authKeeper:       authKeeper,
+		bankKeeper:       bankKeeper,
+		foundationKeeper: foundationKeeper,
+		authority:        authority,
 	}
 }
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
@@ -53,49 +54,227 @@ func (m msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*typ
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 
-	if err := IsValidEthereumAddress(msg.Receiver); err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid receiver address")
+	var seq uint64
+	fee := sdk.ZeroInt()
+	if IsDefaultRoute(msg.Denom, msg.ChainId) {
+		if err := IsValidEthereumAddress(msg.Receiver); err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid receiver address")
+		}
+
+		if seq, fee, err = m.handleBridgeTransfer(ctx, from, msg.Amount); err != nil {
+			return nil, err
+		}
+	} else {
+		route, found := m.GetRoute(ctx, msg.Denom, msg.ChainId)
+		if !found {
+			return nil, types.ErrUnknownRoute.Wrapf("%s/%s", msg.Denom, msg.ChainId)
+		}
+
+		if route.Status != types.StatusActive {
+			return nil, types.ErrInactiveRoute.Wrapf("%s/%s", msg.Denom, msg.ChainId)
+		}
+
+		if err := ValidateRouteReceiver(route, msg.Receiver); err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid receiver address")
+		}
+
+		if seq, fee, err = m.handleRouteTransfer(ctx, route, from, msg.Amount); err != nil {
+			return nil, err
+		}
 	}
 
-	seq, err := m.handleBridgeTransfer(ctx, from, msg.Amount)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+		Amount:   msg.Amount.Sub(fee).String(),
+		Seq:      seq,
+		Fee:      fee.String(),
+		Denom:    msg.Denom,
+		ChainId:  msg.ChainId,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgTransferResponse{}, nil
+}
+
+func (m msgServer) Provision(goCtx context.Context, msg *types.MsgProvision) (*types.MsgProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.IsBridgeHalted(ctx) {
+		return nil, types.ErrInactiveBridge
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if err := IsValidEthereumAddress(msg.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	confirmed, err := m.RegisterProvision(ctx, operator, types.ProvisionData{
+		Seq:      msg.Seq,
+		Amount:   msg.Amount,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+		Denom:    msg.Denom,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransfer{
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventProvision{
+		Seq:      msg.Seq,
 		Sender:   msg.Sender,
 		Receiver: msg.Receiver,
 		Amount:   msg.Amount.String(),
-		Seq:      seq,
+		Operator: msg.From,
+		Denom:    msg.Denom,
 	}); err != nil {
 		panic(err)
 	}
 
-	return &types.MsgTransferResponse{}, nil
-}
+	if confirmed {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventConfirmProvision{
+			Seq: msg.Seq,
+		}); err != nil {
+			panic(err)
+		}
+	}
 
-func (m msgServer) Provision(ctx context.Context, msg *types.MsgProvision) (*types.MsgProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+	return &types.MsgProvisionResponse{}, nil
 }
 
-func (m msgServer) HoldTransfer(ctx context.Context, msg *types.MsgHoldTransfer) (*types.MsgHoldTransferResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) HoldTransfer(goCtx context.Context, msg *types.MsgHoldTransfer) (*types.MsgHoldTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardian, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
+	}
+
+	if err := m.Keeper.HoldTransfer(ctx, guardian, msg.Seq); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventHoldTransfer{
+		Seq:      msg.Seq,
+		Guardian: msg.From,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgHoldTransferResponse{}, nil
 }
 
-func (m msgServer) ReleaseTransfer(ctx context.Context, msg *types.MsgReleaseTransfer) (*types.MsgReleaseTransferResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) ReleaseTransfer(goCtx context.Context, msg *types.MsgReleaseTransfer) (*types.MsgReleaseTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardian, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
+	}
+
+	if err := m.Keeper.ReleaseTransfer(ctx, guardian, msg.Seq); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventReleaseTransfer{
+		Seq:      msg.Seq,
+		Guardian: msg.From,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgReleaseTransferResponse{}, nil
 }
 
-func (m msgServer) RemoveProvision(ctx context.Context, msg *types.MsgRemoveProvision) (*types.MsgRemoveProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) RemoveProvision(goCtx context.Context, msg *types.MsgRemoveProvision) (*types.MsgRemoveProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	judge, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid judge address (%s)", err)
+	}
+
+	if err := m.Keeper.RemoveProvision(ctx, judge, msg.Seq); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRemoveProvision{
+		Seq:   msg.Seq,
+		Judge: msg.From,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgRemoveProvisionResponse{}, nil
 }
 
-func (m msgServer) ClaimBatch(ctx context.Context, msg *types.MsgClaimBatch) (*types.MsgClaimBatchResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) ClaimBatch(goCtx context.Context, msg *types.MsgClaimBatch) (*types.MsgClaimBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.IsBridgeHalted(ctx) {
+		return nil, types.ErrInactiveBridge
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimer address (%s)", err)
+	}
+
+	claimed, err := m.Keeper.ClaimBatch(ctx, msg.MaxClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, data := range claimed {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+			Seq:      data.Seq,
+			Sender:   data.Sender,
+			Receiver: data.Receiver,
+			Amount:   data.Amount.String(),
+			Denom:    data.Denom,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	return &types.MsgClaimBatchResponse{}, nil
 }
 
-func (m msgServer) Claim(ctx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.IsBridgeHalted(ctx) {
+		return nil, types.ErrInactiveBridge
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimer address (%s)", err)
+	}
+
+	data, err := m.ClaimProvision(ctx, msg.Seq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+		Seq:      data.Seq,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Amount:   data.Amount.String(),
+		Denom:    data.Denom,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgClaimResponse{}, nil
 }
 
 func (m msgServer) SuggestRole(goCtx context.Context, msg *types.MsgSuggestRole) (*types.MsgSuggestRoleResponse, error) {
@@ -160,16 +339,73 @@ func (m msgServer) SetBridgeStatus(goCtx context.Context, msg *types.MsgSetBridg
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
 	}
 
-	if err := m.updateBridgeSwitch(ctx, addr, msg.Status); err != nil {
+	if err := m.updateBridgeSwitch(ctx, addr, msg.Status, time.Duration(msg.HaltDuration)); err != nil {
 		return nil, err
 	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetBridgeStatus{
-		Guardian: msg.Guardian,
-		Status:   msg.Status,
+		Guardian:     msg.Guardian,
+		Status:       msg.Status,
+		HaltDuration: msg.HaltDuration,
 	}); err != nil {
 		panic(err)
 	}
 
 	return &types.MsgSetBridgeStatusResponse{}, nil
 }
+
+func (m msgServer) SetRoute(goCtx context.Context, msg *types.MsgSetRoute) (*types.MsgSetRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	route := types.Route{
+		Denom:                msg.Denom,
+		ChainId:              msg.ChainId,
+		ReceiverValidator:    msg.ReceiverValidator,
+		Status:               msg.Status,
+		MaxTransferAmount:    msg.MaxTransferAmount,
+		TransferVolumeCap:    msg.TransferVolumeCap,
+		TransferVolumeWindow: msg.TransferVolumeWindow,
+		TransferFee:          msg.TransferFee,
+	}
+	if route.TransferFee.IsSet() && len(m.GetParams(ctx).FeeDestination) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("fee destination must be set in params to charge a transfer fee")
+	}
+	if err := m.Keeper.SetRoute(ctx, route); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetRoute{
+		Route: route,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgSetRouteResponse{}, nil
+}
+
+func (m msgServer) HaltRoute(goCtx context.Context, msg *types.MsgHaltRoute) (*types.MsgHaltRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
+	}
+
+	if err := m.Keeper.HaltRoute(ctx, addr, msg.Denom, msg.ChainId); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventHaltRoute{
+		Guardian: msg.Guardian,
+		Denom:    msg.Denom,
+		ChainId:  msg.ChainId,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgHaltRouteResponse{}, nil
+}
@@ -4,38 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
-func (s *IntegrationTestSuite) TestInactiveTxs() {
-	goctx := sdk.WrapSDKContext(s.ctx)
-
-	s.Require().Panics(func() {
-		_, _ = s.msgServer.Provision(goctx, &types.MsgProvision{})
-	})
-
-	s.Require().Panics(func() {
-		_, _ = s.msgServer.HoldTransfer(goctx, &types.MsgHoldTransfer{})
-	})
-
-	s.Require().Panics(func() {
-		_, _ = s.msgServer.ReleaseTransfer(goctx, &types.MsgReleaseTransfer{})
-	})
-
-	s.Require().Panics(func() {
-		_, _ = s.msgServer.RemoveProvision(goctx, &types.MsgRemoveProvision{})
-	})
-
-	s.Require().Panics(func() {
-		_, _ = s.msgServer.ClaimBatch(goctx, &types.MsgClaimBatch{})
-	})
-
-	s.Require().Panics(func() {
-		_, _ = s.msgServer.Claim(goctx, &types.MsgClaim{})
-	})
-}
-
 func (s *IntegrationTestSuite) TestUpdateParams() {
 	tcs := map[string]struct {
 		msg    types.MsgUpdateParams
@@ -177,6 +153,41 @@ func (s *IntegrationTestSuite) TestTransfer() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestTransferFee() {
+	params := s.app.FbridgeKeeper.GetParams(s.ctx)
+	params.TransferFee = types.TransferFee{FlatAmount: sdk.ZeroInt(), RateBps: 100, MinAmount: sdk.NewInt(1)}
+	params.FeeDestination = foundation.TreasuryName
+	s.Require().NoError(s.app.FbridgeKeeper.SetParams(s.ctx, params))
+
+	treasury := s.app.AccountKeeper.GetModuleAddress(foundation.TreasuryName)
+	before := s.app.BankKeeper.GetBalance(s.ctx, treasury, params.TargetDenom)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := s.msgServer.Transfer(sdk.WrapSDKContext(ctx), &types.MsgTransfer{
+		Sender:   s.guardians[0].String(),
+		Receiver: s.ethAddr,
+		Amount:   sdk.NewInt(1000),
+	})
+	s.Require().NoError(err)
+
+	after := s.app.BankKeeper.GetBalance(s.ctx, treasury, params.TargetDenom)
+	s.Require().Equal(sdk.NewInt(10), after.Amount.Sub(before.Amount))
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.EventTransfer{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		s.Require().NoError(err)
+		transfer := msg.(*types.EventTransfer)
+		s.Require().Equal("990", transfer.Amount)
+		s.Require().Equal("10", transfer.Fee)
+		found = true
+	}
+	s.Require().True(found)
+}
+
 func (s *IntegrationTestSuite) TestSuggestRole() {
 	var msg types.MsgSuggestRole
 	tcs := map[string]struct {
@@ -11,7 +11,7 @@ import (
 
 func TestSetParams(t *testing.T) {
 	key, memKey, ctx, encCfg, authKeeper, bankKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
-	keeper := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, types.DefaultAuthority().String())
+	keeper := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, nil, types.DefaultAuthority().String())
 
 	tcs := map[string]struct {
 		malleate func() types.Params
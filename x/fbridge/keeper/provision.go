@@ -0,0 +1,500 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+// RegisterProvision records the provision submitted by an operator.
+// It returns true if the provision has been confirmed by n-of-m operators by this submission.
+func (k Keeper) RegisterProvision(ctx sdk.Context, operator sdk.AccAddress, data types.ProvisionData) (bool, error) {
+	if k.GetRole(ctx, operator) != types.RoleOperator {
+		return false, sdkerrors.ErrUnauthorized.Wrap("only operator can execute this action")
+	}
+
+	if data.Seq == 0 {
+		return false, sdkerrors.ErrInvalidRequest.Wrap("sequence must be positive")
+	}
+
+	if !data.Amount.IsPositive() {
+		return false, sdkerrors.ErrInvalidRequest.Wrap("amount must be positive")
+	}
+
+	if len(data.Denom) != 0 && !k.hasRouteOfDenom(ctx, data.Denom) {
+		return false, types.ErrUnknownRoute.Wrapf("no route of %s", data.Denom)
+	}
+
+	if _, found := k.GetCommitment(ctx, data.Seq, operator); found {
+		return false, sdkerrors.ErrInvalidRequest.Wrapf("%s already submitted a provision for seq %d", operator, data.Seq)
+	}
+
+	commitment := data.Commitment()
+	k.setCommitment(ctx, data.Seq, operator, commitment)
+
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		k.setProvisionData(ctx, commitment, data)
+	}
+	status.ConfirmCounts++
+	k.setProvisionStatus(ctx, commitment, status)
+
+	k.updateOperatorSeqs(ctx, operator, data.Seq)
+
+	if _, confirmed := k.GetConfirmedCommitment(ctx, data.Seq); confirmed {
+		return false, nil
+	}
+
+	operatorTrustLevel := k.GetParams(ctx).OperatorTrustLevel
+	if !types.CheckTrustLevelThreshold(k.GetRoleMetadata(ctx).Operator, uint64(status.ConfirmCounts), operatorTrustLevel) {
+		return false, nil
+	}
+
+	status.TimelockEnd = uint64(ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).TimelockPeriod)).Unix())
+	k.setProvisionStatus(ctx, commitment, status)
+	k.setConfirmedCommitment(ctx, data.Seq, commitment)
+	k.setPendingClaimSeq(ctx, data.Seq)
+
+	seq := k.GetGreatestConsecutiveConfirmedSeq(ctx)
+	for {
+		if _, found := k.GetConfirmedCommitment(ctx, seq+1); !found {
+			break
+		}
+		seq++
+	}
+	k.setGreatestConsecutiveConfirmedSeq(ctx, seq)
+
+	return true, nil
+}
+
+func (k Keeper) updateOperatorSeqs(ctx sdk.Context, operator sdk.AccAddress, seq uint64) {
+	if seq > k.GetGreatestSeqByOperator(ctx, operator) {
+		k.setGreatestSeqByOperator(ctx, operator, seq)
+	}
+
+	consecutive := k.GetGreatestConsecutiveSeqByOperator(ctx, operator)
+	for {
+		if _, found := k.GetCommitment(ctx, consecutive+1, operator); !found {
+			break
+		}
+		consecutive++
+	}
+	k.setGreatestConsecutiveSeqByOperator(ctx, operator, consecutive)
+}
+
+// HoldTransfer prevents the confirmed provision from being claimed until it is released.
+func (k Keeper) HoldTransfer(ctx sdk.Context, guardian sdk.AccAddress, seq uint64) error {
+	if k.GetRole(ctx, guardian) != types.RoleGuardian {
+		return sdkerrors.ErrUnauthorized.Wrap("only guardian can execute this action")
+	}
+
+	commitment, status, err := k.getUnclaimedProvision(ctx, seq)
+	if err != nil {
+		return err
+	}
+
+	if status.TimelockEnd == math.MaxUint64 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("seq %d is already held", seq)
+	}
+
+	status.HeldTimelockEnd = status.TimelockEnd
+	status.TimelockEnd = math.MaxUint64
+	k.setProvisionStatus(ctx, commitment, status)
+
+	return nil
+}
+
+// ReleaseTransfer releases the held provision, restoring the time lock it had before it was held.
+func (k Keeper) ReleaseTransfer(ctx sdk.Context, guardian sdk.AccAddress, seq uint64) error {
+	if k.GetRole(ctx, guardian) != types.RoleGuardian {
+		return sdkerrors.ErrUnauthorized.Wrap("only guardian can execute this action")
+	}
+
+	commitment, status, err := k.getUnclaimedProvision(ctx, seq)
+	if err != nil {
+		return err
+	}
+
+	if status.TimelockEnd != math.MaxUint64 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("seq %d is not held", seq)
+	}
+
+	status.TimelockEnd = status.HeldTimelockEnd
+	status.HeldTimelockEnd = 0
+	k.setProvisionStatus(ctx, commitment, status)
+
+	return nil
+}
+
+// RemoveProvision removes the confirmed provision and all the submissions for its sequence,
+// so the operators can submit the provision of the sequence again.
+func (k Keeper) RemoveProvision(ctx sdk.Context, judge sdk.AccAddress, seq uint64) error {
+	if k.GetRole(ctx, judge) != types.RoleJudge {
+		return sdkerrors.ErrUnauthorized.Wrap("only judge can execute this action")
+	}
+
+	if _, _, err := k.getUnclaimedProvision(ctx, seq); err != nil {
+		return err
+	}
+
+	for _, c := range k.GetCommitments(ctx, seq) {
+		operator := sdk.MustAccAddressFromBech32(c.Operator)
+		k.deleteCommitment(ctx, seq, operator)
+		if k.GetGreatestConsecutiveSeqByOperator(ctx, operator) >= seq {
+			k.setGreatestConsecutiveSeqByOperator(ctx, operator, seq-1)
+		}
+
+		commitment := types.MustDecodeCommitment(c.Commitment)
+		k.deleteProvisionData(ctx, commitment)
+		k.deleteProvisionStatus(ctx, commitment)
+	}
+
+	k.deleteConfirmedCommitment(ctx, seq)
+	k.deletePendingClaimSeq(ctx, seq)
+	if k.GetGreatestConsecutiveConfirmedSeq(ctx) >= seq {
+		k.setGreatestConsecutiveConfirmedSeq(ctx, seq-1)
+	}
+
+	return nil
+}
+
+// ClaimProvision mints the amount of the confirmed provision to its receiver once the time lock has ended.
+// The provision of the default route is minted in params.TargetDenom.
+func (k Keeper) ClaimProvision(ctx sdk.Context, seq uint64) (types.ProvisionData, error) {
+	commitment, status, err := k.getUnclaimedProvision(ctx, seq)
+	if err != nil {
+		return types.ProvisionData{}, err
+	}
+
+	if uint64(ctx.BlockTime().Unix()) < status.TimelockEnd {
+		return types.ProvisionData{}, types.ErrTimelocked.Wrapf("seq %d", seq)
+	}
+
+	data, found := k.GetProvisionData(ctx, commitment)
+	if !found {
+		panic(fmt.Errorf("provision data of seq %d must exist", seq))
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return types.ProvisionData{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	denom := data.Denom
+	if len(denom) == 0 {
+		denom = k.GetParams(ctx).TargetDenom
+	}
+
+	token := sdk.Coins{sdk.Coin{Denom: denom, Amount: data.Amount}}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, token...); err != nil {
+		return types.ProvisionData{}, err
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, token); err != nil {
+		return types.ProvisionData{}, sdkerrors.Wrap(err, "cannot mint coins for the bridge claim")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, token); err != nil {
+		return types.ProvisionData{}, err
+	}
+
+	status.IsClaimed = true
+	k.setProvisionStatus(ctx, commitment, status)
+	k.deletePendingClaimSeq(ctx, seq)
+
+	return data, nil
+}
+
+// ClaimBatch claims the claimable provisions in ascending order of the sequence, up to maxClaims.
+// The provisions which are still time-locked are skipped, and the other failures are reported by EventClaimFailure.
+func (k Keeper) ClaimBatch(ctx sdk.Context, maxClaims uint64) ([]types.ProvisionData, error) {
+	if maxClaims == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("max claims must be positive")
+	}
+
+	claimed := make([]types.ProvisionData, 0)
+	for _, seq := range k.GetPendingClaimSeqs(ctx) {
+		if uint64(len(claimed)) >= maxClaims {
+			break
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		data, err := k.ClaimProvision(cacheCtx, seq)
+		if err != nil {
+			if !errors.Is(err, types.ErrTimelocked) {
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventClaimFailure{
+					Seq:    seq,
+					Reason: err.Error(),
+				}); err != nil {
+					panic(err)
+				}
+			}
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		claimed = append(claimed, data)
+	}
+
+	return claimed, nil
+}
+
+// GetNeededSubmissionSeqs returns the sequences which neither have been submitted by the operator nor confirmed yet.
+// The search starts right after the greatest consecutive sequence of the operator (or the greatest consecutive
+// confirmed sequence if it is greater) and covers up to rangeSize sequences, not exceeding the greatest sequence
+// submitted by any operator.
+func (k Keeper) GetNeededSubmissionSeqs(ctx sdk.Context, operator sdk.AccAddress, rangeSize uint64) []uint64 {
+	from := k.GetGreatestConsecutiveSeqByOperator(ctx, operator)
+	if confirmed := k.GetGreatestConsecutiveConfirmedSeq(ctx); confirmed > from {
+		from = confirmed
+	}
+
+	to := from + rangeSize
+	if greatest := k.getGreatestSubmittedSeq(ctx); greatest < to {
+		to = greatest
+	}
+
+	seqs := make([]uint64, 0)
+	for seq := from + 1; seq <= to; seq++ {
+		if _, found := k.GetCommitment(ctx, seq, operator); found {
+			continue
+		}
+		if _, found := k.GetConfirmedCommitment(ctx, seq); found {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+
+	return seqs
+}
+
+func (k Keeper) getGreatestSubmittedSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyGreatestSeqByOperatorPrefix)
+	defer iterator.Close()
+
+	greatest := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if seq := binary.BigEndian.Uint64(iterator.Value()); seq > greatest {
+			greatest = seq
+		}
+	}
+
+	return greatest
+}
+
+func (k Keeper) mustGetProvision(ctx sdk.Context, commitment []byte) (types.ProvisionData, types.ProvisionStatus) {
+	data, found := k.GetProvisionData(ctx, commitment)
+	if !found {
+		panic(fmt.Errorf("provision data of commitment %s must exist", types.EncodeCommitment(commitment)))
+	}
+
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		panic(fmt.Errorf("provision status of commitment %s must exist", types.EncodeCommitment(commitment)))
+	}
+
+	return data, status
+}
+
+func (k Keeper) getUnclaimedProvision(ctx sdk.Context, seq uint64) ([]byte, types.ProvisionStatus, error) {
+	commitment, found := k.GetConfirmedCommitment(ctx, seq)
+	if !found {
+		return nil, types.ProvisionStatus{}, types.ErrUnknownProvision.Wrapf("seq %d has not been confirmed", seq)
+	}
+
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		panic(fmt.Errorf("provision status of seq %d must exist", seq))
+	}
+
+	if status.IsClaimed {
+		return nil, types.ProvisionStatus{}, types.ErrAlreadyClaimed.Wrapf("seq %d", seq)
+	}
+
+	return commitment, status, nil
+}
+
+func (k Keeper) setGreatestSeqByOperator(ctx sdk.Context, operator sdk.AccAddress, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	store.Set(types.GreatestSeqByOperatorKey(operator), bz)
+}
+
+func (k Keeper) GetGreatestSeqByOperator(ctx sdk.Context, operator sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GreatestSeqByOperatorKey(operator))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setGreatestConsecutiveSeqByOperator(ctx sdk.Context, operator sdk.AccAddress, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	store.Set(types.GreatestConsecutiveSeqByOperatorKey(operator), bz)
+}
+
+func (k Keeper) GetGreatestConsecutiveSeqByOperator(ctx sdk.Context, operator sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GreatestConsecutiveSeqByOperatorKey(operator))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setGreatestConsecutiveConfirmedSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	store.Set(types.KeyGreatestConsecutiveSeq, bz)
+}
+
+func (k Keeper) GetGreatestConsecutiveConfirmedSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyGreatestConsecutiveSeq)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setCommitment(ctx sdk.Context, seq uint64, operator sdk.AccAddress, commitment []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CommitmentKey(seq, operator), commitment)
+}
+
+func (k Keeper) deleteCommitment(ctx sdk.Context, seq uint64, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CommitmentKey(seq, operator))
+}
+
+func (k Keeper) GetCommitment(ctx sdk.Context, seq uint64, operator sdk.AccAddress) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CommitmentKey(seq, operator))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// GetCommitments returns all the commitments submitted by operators for a specific sequence
+func (k Keeper) GetCommitments(ctx sdk.Context, seq uint64) []types.Commitment {
+	store := ctx.KVStore(k.storeKey)
+	commitments := make([]types.Commitment, 0)
+	iterator := sdk.KVStorePrefixIterator(store, types.CommitmentsKey(seq))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, operator := types.SplitCommitmentKey(iterator.Key())
+		commitments = append(commitments, types.Commitment{
+			Operator:   operator.String(),
+			Seq:        seq,
+			Commitment: types.EncodeCommitment(iterator.Value()),
+		})
+	}
+
+	return commitments
+}
+
+func (k Keeper) setProvisionData(ctx sdk.Context, commitment []byte, data types.ProvisionData) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&data)
+	store.Set(types.ProvisionDataKey(commitment), bz)
+}
+
+func (k Keeper) deleteProvisionData(ctx sdk.Context, commitment []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ProvisionDataKey(commitment))
+}
+
+func (k Keeper) GetProvisionData(ctx sdk.Context, commitment []byte) (data types.ProvisionData, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProvisionDataKey(commitment))
+	if bz == nil {
+		return data, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &data)
+	return data, true
+}
+
+func (k Keeper) setProvisionStatus(ctx sdk.Context, commitment []byte, status types.ProvisionStatus) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(types.ProvisionStatusKey(commitment), bz)
+}
+
+func (k Keeper) deleteProvisionStatus(ctx sdk.Context, commitment []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ProvisionStatusKey(commitment))
+}
+
+func (k Keeper) GetProvisionStatus(ctx sdk.Context, commitment []byte) (status types.ProvisionStatus, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProvisionStatusKey(commitment))
+	if bz == nil {
+		return status, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &status)
+	return status, true
+}
+
+func (k Keeper) setConfirmedCommitment(ctx sdk.Context, seq uint64, commitment []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConfirmedSeqKey(seq), commitment)
+}
+
+func (k Keeper) deleteConfirmedCommitment(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConfirmedSeqKey(seq))
+}
+
+// GetConfirmedCommitment returns the commitment of the provision confirmed by n-of-m operators for a specific sequence
+func (k Keeper) GetConfirmedCommitment(ctx sdk.Context, seq uint64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConfirmedSeqKey(seq))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+func (k Keeper) setPendingClaimSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingClaimSeqKey(seq), []byte{0x01})
+}
+
+func (k Keeper) deletePendingClaimSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingClaimSeqKey(seq))
+}
+
+// GetPendingClaimSeqs returns the confirmed sequences which have not been claimed yet in ascending order
+func (k Keeper) GetPendingClaimSeqs(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	seqs := make([]uint64, 0)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPendingClaimSeqPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		seqs = append(seqs, binary.BigEndian.Uint64(iterator.Key()[1:]))
+	}
+
+	return seqs
+}
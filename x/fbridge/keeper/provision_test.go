@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+func (s *IntegrationTestSuite) provisionMsg(seq uint64) *types.MsgProvision {
+	return &types.MsgProvision{
+		From:     s.operator.String(),
+		Seq:      seq,
+		Sender:   s.ethAddr,
+		Receiver: s.guardians[0].String(),
+		Amount:   sdk.NewInt(100),
+	}
+}
+
+func (s *IntegrationTestSuite) TestProvision() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+
+	tcs := map[string]struct {
+		msg    *types.MsgProvision
+		expErr bool
+	}{
+		"valid request": {
+			msg: s.provisionMsg(1),
+		},
+		"not an operator": {
+			msg: func() *types.MsgProvision {
+				msg := s.provisionMsg(2)
+				msg.From = s.guardians[0].String()
+				return msg
+			}(),
+			expErr: true,
+		},
+		"invalid sender": {
+			msg: func() *types.MsgProvision {
+				msg := s.provisionMsg(2)
+				msg.Sender = "invalid"
+				return msg
+			}(),
+			expErr: true,
+		},
+		"invalid receiver": {
+			msg: func() *types.MsgProvision {
+				msg := s.provisionMsg(2)
+				msg.Receiver = "invalid"
+				return msg
+			}(),
+			expErr: true,
+		},
+		"zero sequence": {
+			msg:    s.provisionMsg(0),
+			expErr: true,
+		},
+		"zero amount": {
+			msg: func() *types.MsgProvision {
+				msg := s.provisionMsg(2)
+				msg.Amount = sdk.ZeroInt()
+				return msg
+			}(),
+			expErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		s.Run(name, func() {
+			_, err := s.msgServer.Provision(goctx, tc.msg)
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	_, err := s.msgServer.Provision(goctx, s.provisionMsg(1))
+	s.Require().Error(err, "duplicate submission")
+
+	// a single operator satisfies the operator trust level
+	commitment, found := s.app.FbridgeKeeper.GetConfirmedCommitment(s.ctx, 1)
+	s.Require().True(found)
+	status, found := s.app.FbridgeKeeper.GetProvisionStatus(s.ctx, commitment)
+	s.Require().True(found)
+	s.Require().Equal(int32(1), status.ConfirmCounts)
+	s.Require().Equal(uint64(1), s.app.FbridgeKeeper.GetGreatestConsecutiveConfirmedSeq(s.ctx))
+	s.Require().Equal(uint64(1), s.app.FbridgeKeeper.GetGreatestSeqByOperator(s.ctx, s.operator))
+	s.Require().Equal([]uint64{1}, s.app.FbridgeKeeper.GetPendingClaimSeqs(s.ctx))
+}
+
+func (s *IntegrationTestSuite) TestClaim() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	_, err := s.msgServer.Provision(goctx, s.provisionMsg(1))
+	s.Require().NoError(err)
+
+	// time-locked
+	_, err = s.msgServer.Claim(goctx, &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().ErrorIs(err, types.ErrTimelocked)
+
+	// not confirmed
+	_, err = s.msgServer.Claim(goctx, &types.MsgClaim{From: s.guardians[1].String(), Seq: 2})
+	s.Require().ErrorIs(err, types.ErrUnknownProvision)
+
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.guardians[0], sdk.DefaultBondDenom)
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.DefaultParams().TimelockPeriod)))
+	_, err = s.msgServer.Claim(sdk.WrapSDKContext(ctx), &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().NoError(err)
+	after := s.app.BankKeeper.GetBalance(ctx, s.guardians[0], sdk.DefaultBondDenom)
+	s.Require().Equal(before.Amount.AddRaw(100), after.Amount)
+
+	// already claimed
+	_, err = s.msgServer.Claim(sdk.WrapSDKContext(ctx), &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().ErrorIs(err, types.ErrAlreadyClaimed)
+	s.Require().Empty(s.app.FbridgeKeeper.GetPendingClaimSeqs(ctx))
+}
+
+func (s *IntegrationTestSuite) TestClaimRouteDenom() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	denom := "kaia"
+	msg := s.provisionMsg(1)
+	msg.Denom = denom
+
+	// no route of the denom
+	_, err := s.msgServer.Provision(goctx, msg)
+	s.Require().ErrorIs(err, types.ErrUnknownRoute)
+
+	s.setRoute(denom, "8217", types.ReceiverValidatorEVM)
+	_, err = s.msgServer.Provision(goctx, msg)
+	s.Require().NoError(err)
+
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.guardians[0], sdk.DefaultBondDenom)
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.DefaultParams().TimelockPeriod)))
+	_, err = s.msgServer.Claim(sdk.WrapSDKContext(ctx), &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(100), s.app.BankKeeper.GetBalance(ctx, s.guardians[0], denom).Amount)
+	s.Require().Equal(before, s.app.BankKeeper.GetBalance(ctx, s.guardians[0], sdk.DefaultBondDenom))
+}
+
+func (s *IntegrationTestSuite) TestClaimBatch() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	for seq := uint64(1); seq <= 3; seq++ {
+		_, err := s.msgServer.Provision(goctx, s.provisionMsg(seq))
+		s.Require().NoError(err)
+	}
+	_, err := s.msgServer.HoldTransfer(goctx, &types.MsgHoldTransfer{From: s.guardians[0].String(), Seq: 2})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ClaimBatch(goctx, &types.MsgClaimBatch{From: s.guardians[1].String(), MaxClaims: 0})
+	s.Require().Error(err)
+
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.DefaultParams().TimelockPeriod)))
+	_, err = s.msgServer.ClaimBatch(sdk.WrapSDKContext(ctx), &types.MsgClaimBatch{From: s.guardians[1].String(), MaxClaims: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2, 3}, s.app.FbridgeKeeper.GetPendingClaimSeqs(ctx))
+
+	// the held provision is skipped
+	_, err = s.msgServer.ClaimBatch(sdk.WrapSDKContext(ctx), &types.MsgClaimBatch{From: s.guardians[1].String(), MaxClaims: 10})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2}, s.app.FbridgeKeeper.GetPendingClaimSeqs(ctx))
+}
+
+func (s *IntegrationTestSuite) TestClaimBatchFailure() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	denom := "kaia"
+	s.setRoute(denom, "8217", types.ReceiverValidatorEVM)
+	for seq := uint64(1); seq <= 2; seq++ {
+		msg := s.provisionMsg(seq)
+		msg.Denom = denom
+		_, err := s.msgServer.Provision(goctx, msg)
+		s.Require().NoError(err)
+	}
+	_, err := s.msgServer.Provision(goctx, s.provisionMsg(3))
+	s.Require().NoError(err)
+
+	params := s.app.BankKeeper.GetParams(s.ctx)
+	s.app.BankKeeper.SetParams(s.ctx, params.SetSendEnabledParam(denom, false))
+
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.DefaultParams().TimelockPeriod))).
+		WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.ClaimBatch(sdk.WrapSDKContext(ctx), &types.MsgClaimBatch{From: s.guardians[1].String(), MaxClaims: 10})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2}, s.app.FbridgeKeeper.GetPendingClaimSeqs(ctx))
+
+	failed := make([]uint64, 0)
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&types.EventClaimFailure{}) {
+			continue
+		}
+		event, err := sdk.ParseTypedEvent(abci.Event(e))
+		s.Require().NoError(err)
+		failed = append(failed, event.(*types.EventClaimFailure).Seq)
+	}
+	s.Require().Equal([]uint64{1, 2}, failed)
+}
+
+func (s *IntegrationTestSuite) TestHoldAndReleaseTransfer() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	_, err := s.msgServer.Provision(goctx, s.provisionMsg(1))
+	s.Require().NoError(err)
+
+	// not held
+	_, err = s.msgServer.ReleaseTransfer(goctx, &types.MsgReleaseTransfer{From: s.guardians[1].String(), Seq: 1})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.msgServer.HoldTransfer(goctx, &types.MsgHoldTransfer{From: s.operator.String(), Seq: 1})
+	s.Require().Error(err)
+	_, err = s.msgServer.HoldTransfer(goctx, &types.MsgHoldTransfer{From: s.guardians[0].String(), Seq: 2})
+	s.Require().ErrorIs(err, types.ErrUnknownProvision)
+	_, err = s.msgServer.HoldTransfer(goctx, &types.MsgHoldTransfer{From: s.guardians[0].String(), Seq: 1})
+	s.Require().NoError(err)
+	_, err = s.msgServer.HoldTransfer(goctx, &types.MsgHoldTransfer{From: s.guardians[1].String(), Seq: 1})
+	s.Require().Error(err)
+
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(types.DefaultParams().TimelockPeriod)))
+	_, err = s.msgServer.Claim(sdk.WrapSDKContext(ctx), &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().ErrorIs(err, types.ErrTimelocked)
+
+	_, err = s.msgServer.ReleaseTransfer(goctx, &types.MsgReleaseTransfer{From: s.operator.String(), Seq: 1})
+	s.Require().Error(err)
+	_, err = s.msgServer.ReleaseTransfer(goctx, &types.MsgReleaseTransfer{From: s.guardians[1].String(), Seq: 1})
+	s.Require().NoError(err)
+	_, err = s.msgServer.ReleaseTransfer(goctx, &types.MsgReleaseTransfer{From: s.guardians[1].String(), Seq: 1})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// released provision is subject to the original time lock
+	_, err = s.msgServer.Claim(goctx, &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().ErrorIs(err, types.ErrTimelocked)
+	_, err = s.msgServer.Claim(sdk.WrapSDKContext(ctx), &types.MsgClaim{From: s.guardians[1].String(), Seq: 1})
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestRemoveProvision() {
+	judge := simapp.AddTestAddrs(s.app, s.ctx, 1, sdk.NewInt(1000000000))[0]
+	goctx := sdk.WrapSDKContext(s.ctx)
+	_, err := s.msgServer.SuggestRole(goctx, &types.MsgSuggestRole{From: s.guardians[0].String(), Target: judge.String(), Role: types.RoleJudge})
+	s.Require().NoError(err)
+	for _, guardian := range s.guardians {
+		_, err = s.msgServer.AddVoteForRole(goctx, &types.MsgAddVoteForRole{From: guardian.String(), ProposalId: 5, Option: types.OptionYes})
+		s.Require().NoError(err)
+	}
+	s.app.FbridgeKeeper.EndBlocker(s.ctx)
+	s.Require().Equal(types.RoleJudge, s.app.FbridgeKeeper.GetRole(s.ctx, judge))
+
+	for seq := uint64(1); seq <= 2; seq++ {
+		_, err := s.msgServer.Provision(goctx, s.provisionMsg(seq))
+		s.Require().NoError(err)
+	}
+
+	_, err = s.msgServer.RemoveProvision(goctx, &types.MsgRemoveProvision{From: s.guardians[0].String(), Seq: 1})
+	s.Require().Error(err)
+	_, err = s.msgServer.RemoveProvision(goctx, &types.MsgRemoveProvision{From: judge.String(), Seq: 3})
+	s.Require().ErrorIs(err, types.ErrUnknownProvision)
+	_, err = s.msgServer.RemoveProvision(goctx, &types.MsgRemoveProvision{From: judge.String(), Seq: 2})
+	s.Require().NoError(err)
+
+	_, found := s.app.FbridgeKeeper.GetConfirmedCommitment(s.ctx, 2)
+	s.Require().False(found)
+	s.Require().Empty(s.app.FbridgeKeeper.GetCommitments(s.ctx, 2))
+	s.Require().Equal([]uint64{1}, s.app.FbridgeKeeper.GetPendingClaimSeqs(s.ctx))
+	s.Require().Equal(uint64(1), s.app.FbridgeKeeper.GetGreatestConsecutiveConfirmedSeq(s.ctx))
+	s.Require().Equal(uint64(1), s.app.FbridgeKeeper.GetGreatestConsecutiveSeqByOperator(s.ctx, s.operator))
+
+	// the operator can submit the removed sequence again
+	msg := s.provisionMsg(2)
+	msg.Amount = sdk.NewInt(50)
+	_, err = s.msgServer.Provision(goctx, msg)
+	s.Require().NoError(err)
+	_, found = s.app.FbridgeKeeper.GetConfirmedCommitment(s.ctx, 2)
+	s.Require().True(found)
+}
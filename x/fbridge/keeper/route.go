@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"regexp"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+var evmAddressRegex = regexp.MustCompile(`^0x[a-fA-F0-9]{40}$`)
+
+// IsDefaultRoute returns whether the denom and chain ID designate the default route of params.TargetDenom.
+func IsDefaultRoute(denom, chainID string) bool {
+	return len(denom) == 0 && len(chainID) == 0
+}
+
+func (k Keeper) SetRoute(ctx sdk.Context, route types.Route) error {
+	if err := route.ValidateBasic(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RouteStoreKey(route.Denom, route.ChainId), k.cdc.MustMarshal(&route))
+	return nil
+}
+
+func (k Keeper) GetRoute(ctx sdk.Context, denom, chainID string) (types.Route, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RouteStoreKey(denom, chainID))
+	if bz == nil {
+		return types.Route{}, false
+	}
+
+	var route types.Route
+	k.cdc.MustUnmarshal(bz, &route)
+	return route, true
+}
+
+func (k Keeper) GetRoutes(ctx sdk.Context) []types.Route {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRoutePrefix)
+	defer iterator.Close()
+
+	routes := make([]types.Route, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.Route
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+func (k Keeper) hasRouteOfDenom(ctx sdk.Context, denom string) bool {
+	for _, route := range k.GetRoutes(ctx) {
+		if route.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HaltRoute halts the route. Only the authority can resume it.
+func (k Keeper) HaltRoute(ctx sdk.Context, guardian sdk.AccAddress, denom, chainID string) error {
+	if k.GetRole(ctx, guardian) != types.RoleGuardian {
+		return sdkerrors.ErrUnauthorized.Wrapf("only guardian can execute this action")
+	}
+
+	route, found := k.GetRoute(ctx, denom, chainID)
+	if !found {
+		return types.ErrUnknownRoute.Wrapf("%s/%s", denom, chainID)
+	}
+
+	route.Status = types.StatusInactive
+	return k.SetRoute(ctx, route)
+}
+
+// ValidateRouteReceiver validates the receiver address on the destination chain of the route.
+func ValidateRouteReceiver(route types.Route, receiver string) error {
+	switch route.ReceiverValidator {
+	case types.ReceiverValidatorEVMChecksum:
+		return IsValidEthereumAddress(receiver)
+	case types.ReceiverValidatorEVM:
+		if !evmAddressRegex.MatchString(receiver) {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid evm address: %s", receiver)
+		}
+		return nil
+	}
+
+	return sdkerrors.ErrInvalidRequest.Wrapf("unsupported receiver validator: %s", route.ReceiverValidator)
+}
+
+func (k Keeper) handleRouteTransfer(ctx sdk.Context, route types.Route, sender sdk.AccAddress, amount sdk.Int) (uint64, sdk.Int, error) {
+	fee := sdk.ZeroInt()
+	if route.TransferFee.IsSet() {
+		fee = route.TransferFee.Compute(amount)
+	}
+	if !amount.GT(fee) {
+		return 0, sdk.ZeroInt(), types.ErrInsufficientAmount.Wrapf("amount %s, fee %s", amount, fee)
+	}
+
+	bridged := amount.Sub(fee)
+	limits := routeTransferLimits(route)
+	if err := k.checkTransferLimits(ctx, limits, bridged); err != nil {
+		return 0, sdk.ZeroInt(), err
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, sdk.NewCoin(route.Denom, amount)); err != nil {
+		return 0, sdk.ZeroInt(), err
+	}
+
+	if fee.IsPositive() {
+		destination := k.GetParams(ctx).FeeDestination
+		if len(destination) == 0 {
+			return 0, sdk.ZeroInt(), sdkerrors.ErrInvalidRequest.Wrap("fee destination is not set")
+		}
+		if err := k.payTransferFee(ctx, sender, destination, sdk.NewCoins(sdk.NewCoin(route.Denom, fee))); err != nil {
+			return 0, sdk.ZeroInt(), err
+		}
+	}
+
+	token := sdk.Coins{sdk.Coin{Denom: route.Denom, Amount: bridged}}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token); err != nil {
+		return 0, sdk.ZeroInt(), err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, token); err != nil {
+		panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %w", err))
+	}
+
+	seq := k.GetRouteNextSequence(ctx, route.Denom, route.ChainId)
+	k.setRouteNextSequence(ctx, route.Denom, route.ChainId, seq+1)
+	k.setRouteSeqToBlocknum(ctx, route.Denom, route.ChainId, seq, uint64(ctx.BlockHeight()))
+
+	if !limits.volumeCap.IsNil() {
+		k.addTransferVolume(ctx, limits.volumes, types.TransferVolume{Seq: seq, Time: ctx.BlockTime(), Amount: bridged})
+	}
+
+	return seq, fee, nil
+}
+
+// GetRouteNextSequence returns the next sequence number of the route. It starts from 1.
+func (k Keeper) GetRouteNextSequence(ctx sdk.Context, denom, chainID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RouteNextSeqSendKey(denom, chainID))
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setRouteNextSequence(ctx sdk.Context, denom, chainID string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	store.Set(types.RouteNextSeqSendKey(denom, chainID), bz)
+}
+
+func (k Keeper) setRouteSeqToBlocknum(ctx sdk.Context, denom, chainID string, seq, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	store.Set(types.RouteSeqToBlocknumKey(denom, chainID, seq), bz)
+}
+
+func (k Keeper) GetRouteSeqToBlocknum(ctx sdk.Context, denom, chainID string, seq uint64) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RouteSeqToBlocknumKey(denom, chainID, seq))
+	if len(bz) == 0 {
+		return 0, sdkerrors.ErrNotFound.Wrapf("sequence %d of route %s/%s not found", seq, denom, chainID)
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func (k Keeper) getAllRouteSeqToBlocknums(ctx sdk.Context, denom, chainID string) []types.BlockSeqInfo {
+	infos := make([]types.BlockSeqInfo, 0)
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.RouteSeqToBlocknumsKey(denom, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		seq := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+		v := binary.BigEndian.Uint64(iterator.Value())
+		infos = append(infos, types.BlockSeqInfo{Seq: seq, Blocknum: v})
+	}
+
+	return infos
+}
+
+func (k Keeper) getRouteStates(ctx sdk.Context) []types.RouteState {
+	states := make([]types.RouteState, 0)
+	for _, route := range k.GetRoutes(ctx) {
+		states = append(states, types.RouteState{
+			Route:           route,
+			NextSeq:         k.GetRouteNextSequence(ctx, route.Denom, route.ChainId),
+			SeqToBlocknum:   k.getAllRouteSeqToBlocknums(ctx, route.Denom, route.ChainId),
+			TransferVolumes: k.getTransferVolumes(ctx, routeTransferVolumeKeys(route.Denom, route.ChainId)),
+		})
+	}
+
+	return states
+}
+
+func (k Keeper) initRouteStates(ctx sdk.Context, states []types.RouteState) error {
+	for _, state := range states {
+		route := state.Route
+		if err := k.SetRoute(ctx, route); err != nil {
+			return err
+		}
+
+		k.setRouteNextSequence(ctx, route.Denom, route.ChainId, state.NextSeq)
+		for _, info := range state.SeqToBlocknum {
+			k.setRouteSeqToBlocknum(ctx, route.Denom, route.ChainId, info.Seq, info.Blocknum)
+		}
+		k.initTransferVolumes(ctx, routeTransferVolumeKeys(route.Denom, route.ChainId), state.TransferVolumes)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+func (s *IntegrationTestSuite) setRoute(denom, chainID string, validator types.ReceiverValidator) {
+	_, err := s.msgServer.SetRoute(sdk.WrapSDKContext(s.ctx), &types.MsgSetRoute{
+		Authority:         types.DefaultAuthority().String(),
+		Denom:             denom,
+		ChainId:           chainID,
+		ReceiverValidator: validator,
+		Status:            types.StatusActive,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestSetRoute() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	valid := types.MsgSetRoute{
+		Authority:         types.DefaultAuthority().String(),
+		Denom:             sdk.DefaultBondDenom,
+		ChainId:           "8217",
+		ReceiverValidator: types.ReceiverValidatorEVM,
+		Status:            types.StatusActive,
+	}
+
+	tcs := map[string]struct {
+		malleate func(msg *types.MsgSetRoute)
+		expErr   bool
+	}{
+		"valid request": {},
+		"invalid authority": {
+			malleate: func(msg *types.MsgSetRoute) {
+				msg.Authority = s.guardians[0].String()
+			},
+			expErr: true,
+		},
+		"invalid denom": {
+			malleate: func(msg *types.MsgSetRoute) {
+				msg.Denom = "invalid_denom"
+			},
+			expErr: true,
+		},
+		"empty chain id": {
+			malleate: func(msg *types.MsgSetRoute) {
+				msg.ChainId = ""
+			},
+			expErr: true,
+		},
+		"unspecified receiver validator": {
+			malleate: func(msg *types.MsgSetRoute) {
+				msg.ReceiverValidator = types.ReceiverValidatorEmpty
+			},
+			expErr: true,
+		},
+		"unspecified status": {
+			malleate: func(msg *types.MsgSetRoute) {
+				msg.Status = types.StatusEmpty
+			},
+			expErr: true,
+		},
+		"volume cap without window": {
+			malleate: func(msg *types.MsgSetRoute) {
+				msg.TransferVolumeCap = sdk.NewInt(100)
+			},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		s.Run(name, func() {
+			msg := valid
+			if tc.malleate != nil {
+				tc.malleate(&msg)
+			}
+
+			_, err := s.msgServer.SetRoute(goctx, &msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			route, found := s.app.FbridgeKeeper.GetRoute(s.ctx, msg.Denom, msg.ChainId)
+			s.Require().True(found)
+			s.Require().Equal(types.ReceiverValidatorEVM, route.ReceiverValidator)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestRouteTransfer() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.setRoute(sdk.DefaultBondDenom, "8217", types.ReceiverValidatorEVM)
+	s.setRoute(sdk.DefaultBondDenom, "1", types.ReceiverValidatorEVMChecksum)
+
+	transfer := func(chainID, receiver string) error {
+		_, err := s.msgServer.Transfer(goctx, &types.MsgTransfer{
+			Sender:   s.guardians[0].String(),
+			Receiver: receiver,
+			Amount:   sdk.NewInt(100),
+			Denom:    sdk.DefaultBondDenom,
+			ChainId:  chainID,
+		})
+		return err
+	}
+	lowercase := "0x1a7c26b0437aa2d3c8454383650a5d3c35087f91"
+
+	// each route has its own receiver validator
+	s.Require().NoError(transfer("8217", lowercase))
+	s.Require().Error(transfer("1", lowercase))
+	s.Require().NoError(transfer("1", s.ethAddr))
+	s.Require().Error(transfer("8217", "invalid"))
+	s.Require().ErrorIs(transfer("56", s.ethAddr), types.ErrUnknownRoute)
+
+	// each route has its own sequence counter
+	s.Require().NoError(transfer("8217", s.ethAddr))
+	s.Require().EqualValues(3, s.app.FbridgeKeeper.GetRouteNextSequence(s.ctx, sdk.DefaultBondDenom, "8217"))
+	s.Require().EqualValues(2, s.app.FbridgeKeeper.GetRouteNextSequence(s.ctx, sdk.DefaultBondDenom, "1"))
+	s.Require().EqualValues(1, s.app.FbridgeKeeper.GetNextSequence(s.ctx))
+
+	res, err := s.queryClient.NextSeqSend(goctx, &types.QueryNextSeqSendRequest{Denom: sdk.DefaultBondDenom, ChainId: "8217"})
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, res.Seq)
+	_, err = s.queryClient.NextSeqSend(goctx, &types.QueryNextSeqSendRequest{Denom: sdk.DefaultBondDenom, ChainId: "56"})
+	s.Require().Error(err)
+
+	blocknums, err := s.queryClient.SeqToBlocknums(goctx, &types.QuerySeqToBlocknumsRequest{Seqs: []uint64{1, 2}, Denom: sdk.DefaultBondDenom, ChainId: "8217"})
+	s.Require().NoError(err)
+	s.Require().Len(blocknums.Blocknums, 2)
+	_, err = s.queryClient.SeqToBlocknums(goctx, &types.QuerySeqToBlocknumsRequest{Seqs: []uint64{2}, Denom: sdk.DefaultBondDenom, ChainId: "1"})
+	s.Require().Error(err)
+
+	// each route has its own halt status
+	_, err = s.msgServer.HaltRoute(goctx, &types.MsgHaltRoute{Guardian: s.operator.String(), Denom: sdk.DefaultBondDenom, ChainId: "8217"})
+	s.Require().Error(err)
+	_, err = s.msgServer.HaltRoute(goctx, &types.MsgHaltRoute{Guardian: s.guardians[0].String(), Denom: sdk.DefaultBondDenom, ChainId: "56"})
+	s.Require().ErrorIs(err, types.ErrUnknownRoute)
+	_, err = s.msgServer.HaltRoute(goctx, &types.MsgHaltRoute{Guardian: s.guardians[0].String(), Denom: sdk.DefaultBondDenom, ChainId: "8217"})
+	s.Require().NoError(err)
+	s.Require().ErrorIs(transfer("8217", s.ethAddr), types.ErrInactiveRoute)
+	s.Require().NoError(transfer("1", s.ethAddr))
+
+	s.setRoute(sdk.DefaultBondDenom, "8217", types.ReceiverValidatorEVM)
+	s.Require().NoError(transfer("8217", s.ethAddr))
+}
+
+func (s *IntegrationTestSuite) TestRouteTransferLimits() {
+	ctx := s.ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	goctx := sdk.WrapSDKContext(ctx)
+	msg := types.MsgSetRoute{
+		Authority:            types.DefaultAuthority().String(),
+		Denom:                sdk.DefaultBondDenom,
+		ChainId:              "8217",
+		ReceiverValidator:    types.ReceiverValidatorEVM,
+		Status:               types.StatusActive,
+		MaxTransferAmount:    sdk.NewInt(100),
+		TransferVolumeCap:    sdk.NewInt(250),
+		TransferVolumeWindow: uint64(time.Hour),
+		TransferFee:          types.TransferFee{FlatAmount: sdk.NewInt(10)},
+	}
+
+	// the fee requires the fee destination
+	_, err := s.msgServer.SetRoute(goctx, &msg)
+	s.Require().Error(err)
+
+	params := s.app.FbridgeKeeper.GetParams(ctx)
+	params.FeeDestination = authtypes.FeeCollectorName
+	s.Require().NoError(s.app.FbridgeKeeper.SetParams(ctx, params))
+	_, err = s.msgServer.SetRoute(goctx, &msg)
+	s.Require().NoError(err)
+
+	transfer := func(chainID string, amount int64) error {
+		_, err := s.msgServer.Transfer(goctx, &types.MsgTransfer{
+			Sender:   s.guardians[0].String(),
+			Receiver: s.ethAddr,
+			Amount:   sdk.NewInt(amount),
+			Denom:    sdk.DefaultBondDenom,
+			ChainId:  chainID,
+		})
+		return err
+	}
+
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fees := s.app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	s.Require().ErrorIs(transfer("8217", 111), types.ErrExceedsTransferLimit)
+	s.Require().ErrorIs(transfer("8217", 10), types.ErrInsufficientAmount)
+	s.Require().NoError(transfer("8217", 110))
+	s.Require().NoError(transfer("8217", 110))
+	s.Require().ErrorIs(transfer("8217", 61), types.ErrExceedsVolumeCap)
+	s.Require().NoError(transfer("8217", 60))
+	s.Require().Equal(fees.AddAmount(sdk.NewInt(30)), s.app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom))
+
+	// the limits apply to each route separately
+	s.setRoute(sdk.DefaultBondDenom, "1", types.ReceiverValidatorEVMChecksum)
+	s.Require().NoError(transfer("1", 1000))
+	s.Require().True(s.app.FbridgeKeeper.GetUsedTransferVolume(ctx).IsZero())
+
+	// the volumes of the route survive the genesis export
+	genesis := s.app.FbridgeKeeper.ExportGenesis(ctx)
+	for _, state := range genesis.Routes {
+		if state.Route.ChainId == "8217" {
+			s.Require().Len(state.TransferVolumes, 3)
+		} else {
+			s.Require().Empty(state.TransferVolumes)
+		}
+	}
+}
+
+func (s *IntegrationTestSuite) TestRouteQueries() {
+	goctx := sdk.WrapSDKContext(s.ctx)
+	s.setRoute(sdk.DefaultBondDenom, "8217", types.ReceiverValidatorEVM)
+	s.setRoute(sdk.DefaultBondDenom, "1", types.ReceiverValidatorEVMChecksum)
+
+	routes, err := s.queryClient.Routes(goctx, &types.QueryRoutesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(routes.Routes, 2)
+
+	route, err := s.queryClient.Route(goctx, &types.QueryRouteRequest{Denom: sdk.DefaultBondDenom, ChainId: "1"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.ReceiverValidatorEVMChecksum, route.Route.ReceiverValidator)
+	s.Require().EqualValues(1, route.NextSeq)
+
+	_, err = s.queryClient.Route(goctx, &types.QueryRouteRequest{Denom: sdk.DefaultBondDenom, ChainId: "56"})
+	s.Require().Error(err)
+}
@@ -5,25 +5,49 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/sha3"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
-func (k Keeper) handleBridgeTransfer(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int) (uint64, error) {
-	token := sdk.Coins{sdk.Coin{Denom: k.GetParams(ctx).TargetDenom, Amount: amount}}
-	if err := k.bankKeeper.IsSendEnabledCoins(ctx, token...); err != nil {
-		return 0, err
+func (k Keeper) handleBridgeTransfer(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int) (uint64, sdk.Int, error) {
+	params := k.GetParams(ctx)
+	fee := sdk.ZeroInt()
+	if params.TransferFee.IsSet() {
+		fee = params.TransferFee.Compute(amount)
+	}
+	if !amount.GT(fee) {
+		return 0, sdk.ZeroInt(), types.ErrInsufficientAmount.Wrapf("amount %s, fee %s", amount, fee)
+	}
+
+	bridged := amount.Sub(fee)
+	limits := paramsTransferLimits(params)
+	if err := k.checkTransferLimits(ctx, limits, bridged); err != nil {
+		return 0, sdk.ZeroInt(), err
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, sdk.NewCoin(params.TargetDenom, amount)); err != nil {
+		return 0, sdk.ZeroInt(), err
+	}
+
+	if fee.IsPositive() {
+		if err := k.payTransferFee(ctx, sender, params.FeeDestination, sdk.NewCoins(sdk.NewCoin(params.TargetDenom, fee))); err != nil {
+			return 0, sdk.ZeroInt(), err
+		}
 	}
 
+	token := sdk.Coins{sdk.Coin{Denom: params.TargetDenom, Amount: bridged}}
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token); err != nil {
-		return 0, err
+		return 0, sdk.ZeroInt(), err
 	}
 
 	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, token); err != nil {
@@ -34,7 +58,224 @@ func (k Keeper) handleBridgeTransfer(ctx sdk.Context, sender sdk.AccAddress, amo
 	k.setNextSequence(ctx, seq+1)
 	k.setSeqToBlocknum(ctx, seq, uint64(ctx.BlockHeight()))
 
-	return seq, nil
+	if !limits.volumeCap.IsNil() {
+		k.addTransferVolume(ctx, limits.volumes, types.TransferVolume{Seq: seq, Time: ctx.BlockTime(), Amount: bridged})
+	}
+
+	return seq, fee, nil
+}
+
+// payTransferFee sends the fee to the destination module account.
+// The fees sent to the x/foundation treasury are funded through x/foundation to keep its pool in sync.
+func (k Keeper) payTransferFee(ctx sdk.Context, sender sdk.AccAddress, destination string, fee sdk.Coins) error {
+	if destination == foundation.TreasuryName {
+		return k.foundationKeeper.FundTreasury(ctx, sender, fee)
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, destination, fee)
+}
+
+// transferLimits defines the limits on the outbound transfers of a route.
+type transferLimits struct {
+	maxAmount sdk.Int // nil if the amount of a single transfer is not limited
+	volumeCap sdk.Int // nil if the volume is not capped
+	window    uint64
+	volumes   transferVolumeKeys
+}
+
+func paramsTransferLimits(params types.Params) transferLimits {
+	limits := transferLimits{window: params.TransferVolumeWindow, volumes: defaultTransferVolumeKeys()}
+	if params.HasTransferLimit() {
+		limits.maxAmount = params.MaxTransferAmount
+	}
+	if params.HasTransferVolumeCap() {
+		limits.volumeCap = params.TransferVolumeCap
+	}
+
+	return limits
+}
+
+func routeTransferLimits(route types.Route) transferLimits {
+	limits := transferLimits{window: route.TransferVolumeWindow, volumes: routeTransferVolumeKeys(route.Denom, route.ChainId)}
+	if route.HasTransferLimit() {
+		limits.maxAmount = route.MaxTransferAmount
+	}
+	if route.HasTransferVolumeCap() {
+		limits.volumeCap = route.TransferVolumeCap
+	}
+
+	return limits
+}
+
+// transferVolumeKeys locates the outbound transfer volumes of a route in the store.
+type transferVolumeKeys struct {
+	prefix []byte
+	total  []byte
+	volume func(t time.Time, seq uint64) []byte
+}
+
+func defaultTransferVolumeKeys() transferVolumeKeys {
+	return transferVolumeKeys{
+		prefix: types.KeyTransferVolumePrefix,
+		total:  types.KeyTransferVolumeTotal,
+		volume: types.TransferVolumeKey,
+	}
+}
+
+func routeTransferVolumeKeys(denom, chainID string) transferVolumeKeys {
+	return transferVolumeKeys{
+		prefix: types.RouteTransferVolumesKey(denom, chainID),
+		total:  types.RouteTransferVolumeTotalKey(denom, chainID),
+		volume: func(t time.Time, seq uint64) []byte {
+			return types.RouteTransferVolumeKey(denom, chainID, t, seq)
+		},
+	}
+}
+
+func (k Keeper) checkTransferLimits(ctx sdk.Context, limits transferLimits, amount sdk.Int) error {
+	if !limits.maxAmount.IsNil() && amount.GT(limits.maxAmount) {
+		return types.ErrExceedsTransferLimit.Wrapf("amount %s exceeds the limit %s", amount, limits.maxAmount)
+	}
+
+	if !limits.volumeCap.IsNil() {
+		k.pruneTransferVolumes(ctx, limits.volumes, limits.window)
+		remaining := limits.volumeCap.Sub(k.getTransferVolumeTotal(ctx, limits.volumes))
+		if amount.GT(remaining) {
+			return types.ErrExceedsVolumeCap.Wrapf("amount %s exceeds the remaining volume %s", amount, sdk.MaxInt(remaining, sdk.ZeroInt()))
+		}
+	}
+
+	return nil
+}
+
+// GetUsedTransferVolume returns the total amount of outbound transfers within the current rolling window.
+func (k Keeper) GetUsedTransferVolume(ctx sdk.Context) sdk.Int {
+	params := k.GetParams(ctx)
+	if !params.HasTransferVolumeCap() {
+		return sdk.ZeroInt()
+	}
+
+	return k.getUsedTransferVolume(ctx, defaultTransferVolumeKeys(), params.TransferVolumeWindow)
+}
+
+func (k Keeper) getUsedTransferVolume(ctx sdk.Context, keys transferVolumeKeys, window uint64) sdk.Int {
+	used := k.getTransferVolumeTotal(ctx, keys)
+	k.iterateExpiredTransferVolumes(ctx, keys, window, func(volume types.TransferVolume) {
+		used = used.Sub(volume.Amount)
+	})
+
+	return used
+}
+
+func (k Keeper) pruneTransferVolumes(ctx sdk.Context, keys transferVolumeKeys, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+	total := k.getTransferVolumeTotal(ctx, keys)
+	k.iterateExpiredTransferVolumes(ctx, keys, window, func(volume types.TransferVolume) {
+		store.Delete(keys.volume(volume.Time, volume.Seq))
+		total = total.Sub(volume.Amount)
+	})
+	k.setTransferVolumeTotal(ctx, keys, total)
+}
+
+// iterateExpiredTransferVolumes iterates over the transfer volumes which have fallen out of the rolling window.
+func (k Keeper) iterateExpiredTransferVolumes(ctx sdk.Context, keys transferVolumeKeys, window uint64, cb func(volume types.TransferVolume)) {
+	windowStart := ctx.BlockTime().Add(-time.Duration(window))
+	if windowStart.UnixNano() < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := keys.volume(windowStart, math.MaxUint64)
+	iterator := store.Iterator(keys.prefix, sdk.PrefixEndBytes(end))
+	defer iterator.Close()
+
+	var volumes []types.TransferVolume
+	for ; iterator.Valid(); iterator.Next() {
+		volumes = append(volumes, k.unmarshalTransferVolume(iterator.Key(), iterator.Value()))
+	}
+
+	for _, volume := range volumes {
+		cb(volume)
+	}
+}
+
+func (k Keeper) GetTransferVolumes(ctx sdk.Context) []types.TransferVolume {
+	return k.getTransferVolumes(ctx, defaultTransferVolumeKeys())
+}
+
+func (k Keeper) getTransferVolumes(ctx sdk.Context, keys transferVolumeKeys) []types.TransferVolume {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keys.prefix)
+	defer iterator.Close()
+
+	volumes := make([]types.TransferVolume, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		volumes = append(volumes, k.unmarshalTransferVolume(iterator.Key(), iterator.Value()))
+	}
+
+	return volumes
+}
+
+func (k Keeper) unmarshalTransferVolume(key, value []byte) types.TransferVolume {
+	t, seq := types.SplitTransferVolumeKey(key)
+	var amount sdk.Int
+	if err := amount.Unmarshal(value); err != nil {
+		panic(err)
+	}
+
+	return types.TransferVolume{Seq: seq, Time: t, Amount: amount}
+}
+
+func (k Keeper) addTransferVolume(ctx sdk.Context, keys transferVolumeKeys, volume types.TransferVolume) {
+	k.setTransferVolume(ctx, keys, volume)
+	k.setTransferVolumeTotal(ctx, keys, k.getTransferVolumeTotal(ctx, keys).Add(volume.Amount))
+}
+
+func (k Keeper) initTransferVolumes(ctx sdk.Context, keys transferVolumeKeys, volumes []types.TransferVolume) {
+	total := sdk.ZeroInt()
+	for _, volume := range volumes {
+		k.setTransferVolume(ctx, keys, volume)
+		total = total.Add(volume.Amount)
+	}
+	k.setTransferVolumeTotal(ctx, keys, total)
+}
+
+func (k Keeper) setTransferVolume(ctx sdk.Context, keys transferVolumeKeys, volume types.TransferVolume) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := volume.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(keys.volume(volume.Time, volume.Seq), bz)
+}
+
+func (k Keeper) getTransferVolumeTotal(ctx sdk.Context, keys transferVolumeKeys) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(keys.total)
+	if len(bz) == 0 {
+		return sdk.ZeroInt()
+	}
+
+	var total sdk.Int
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return total
+}
+
+func (k Keeper) setTransferVolumeTotal(ctx sdk.Context, keys transferVolumeKeys, total sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if total.IsZero() {
+		store.Delete(keys.total)
+		return
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(keys.total, bz)
 }
 
 func (k Keeper) setSeqToBlocknum(ctx sdk.Context, seq, height uint64) {
@@ -4,12 +4,16 @@ import (
 	"encoding/binary"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
 func TestHandleBridgeTransfer(t *testing.T) {
@@ -23,7 +27,7 @@ func TestHandleBridgeTransfer(t *testing.T) {
 	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token).Return(nil).Times(1)
 	bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, token).Return(nil).Times(1)
 
-	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, types.DefaultAuthority().String())
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, nil, types.DefaultAuthority().String())
 	params := types.DefaultParams()
 	params.TargetDenom = denom
 	err := k.SetParams(ctx, params)
@@ -33,9 +37,10 @@ func TestHandleBridgeTransfer(t *testing.T) {
 	binary.BigEndian.PutUint64(bz, targetSeq)
 	ctx.KVStore(key).Set(types.KeyNextSeqSend, bz)
 
-	handledSeq, err := k.handleBridgeTransfer(ctx, sender, amt)
+	handledSeq, fee, err := k.handleBridgeTransfer(ctx, sender, amt)
 	require.NoError(t, err)
 	require.Equal(t, targetSeq, handledSeq)
+	require.True(t, fee.IsZero())
 	afterSeq := k.GetNextSequence(ctx)
 	require.Equal(t, targetSeq+1, afterSeq)
 	h, err := k.GetSeqToBlocknum(ctx, handledSeq)
@@ -45,13 +50,113 @@ func TestHandleBridgeTransfer(t *testing.T) {
 	// test error cases
 	bankKeeper.EXPECT().IsSendEnabledCoins(ctx, token).Return(nil).Times(1)
 	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token).Return(errors.New("insufficient funds")).Times(1)
-	_, err = k.handleBridgeTransfer(ctx, sender, amt)
+	_, _, err = k.handleBridgeTransfer(ctx, sender, amt)
 	require.Error(t, err)
 
 	bankKeeper.EXPECT().IsSendEnabledCoins(ctx, token).Return(nil).Times(1)
 	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token).Return(nil).Times(1)
 	bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, token).Return(errors.New("failed to burn coins")).Times(1)
-	require.Panics(t, func() { _, _ = k.handleBridgeTransfer(ctx, sender, amt) }, "cannot burn coins after a successful send to a module account: failed to burn coins")
+	require.Panics(t, func() { _, _, _ = k.handleBridgeTransfer(ctx, sender, amt) }, "cannot burn coins after a successful send to a module account: failed to burn coins")
+}
+
+func TestHandleBridgeTransferLimits(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	sender := sdk.AccAddress("test")
+	bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender, types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, nil, types.DefaultAuthority().String())
+	params := types.DefaultParams()
+	params.MaxTransferAmount = sdk.NewInt(100)
+	params.TransferVolumeCap = sdk.NewInt(250)
+	params.TransferVolumeWindow = uint64(time.Hour)
+	require.NoError(t, k.SetParams(ctx, params))
+	k.setNextSequence(ctx, 1)
+
+	_, _, err := k.handleBridgeTransfer(ctx, sender, sdk.NewInt(101))
+	require.ErrorIs(t, err, types.ErrExceedsTransferLimit)
+
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(100))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(200), k.GetUsedTransferVolume(ctx))
+
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(51))
+	require.ErrorIs(t, err, types.ErrExceedsVolumeCap)
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(50))
+	require.NoError(t, err)
+
+	// the first transfer falls out of the rolling window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	require.Equal(t, sdk.NewInt(150), k.GetUsedTransferVolume(ctx))
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(250), k.getTransferVolumeTotal(ctx, defaultTransferVolumeKeys()))
+	require.Len(t, k.GetTransferVolumes(ctx), 3)
+
+	// lifting the cap stops the volume from being tracked
+	params.TransferVolumeCap = sdk.ZeroInt()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.True(t, k.GetUsedTransferVolume(ctx).IsZero())
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Len(t, k.GetTransferVolumes(ctx), 3)
+}
+
+func TestHandleBridgeTransferFee(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	foundationKeeper := testutil.NewMockFoundationKeeper(gomock.NewController(t))
+	authKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName)).AnyTimes()
+	authKeeper.EXPECT().GetModuleAddress(foundation.TreasuryName).Return(authtypes.NewModuleAddress(foundation.TreasuryName)).AnyTimes()
+	authKeeper.EXPECT().GetModuleAddress("unknown").Return(nil).AnyTimes()
+
+	sender := sdk.AccAddress("test")
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	k.setNextSequence(ctx, 1)
+	params := types.DefaultParams()
+	params.TransferFee.FlatAmount = sdk.NewInt(10)
+	params.FeeDestination = "unknown"
+	require.Error(t, k.SetParams(ctx, params))
+
+	// flat fee sent to the fee collector
+	params.FeeDestination = authtypes.FeeCollectorName
+	require.NoError(t, k.SetParams(ctx, params))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(params.TargetDenom, 10))
+	bridged := sdk.NewCoins(sdk.NewInt64Coin(params.TargetDenom, 90))
+	bankKeeper.EXPECT().IsSendEnabledCoins(ctx, sdk.NewInt64Coin(params.TargetDenom, 100)).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, fee).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, bridged).Return(nil).Times(1)
+	bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, bridged).Return(nil).Times(1)
+	_, charged, err := k.handleBridgeTransfer(ctx, sender, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(10), charged)
+
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(10))
+	require.ErrorIs(t, err, types.ErrInsufficientAmount)
+
+	// rate fee funded to the foundation treasury
+	params.TransferFee = types.TransferFee{FlatAmount: sdk.ZeroInt(), RateBps: 100, MinAmount: sdk.NewInt(2)}
+	params.FeeDestination = foundation.TreasuryName
+	require.NoError(t, k.SetParams(ctx, params))
+	fee = sdk.NewCoins(sdk.NewInt64Coin(params.TargetDenom, 10))
+	bridged = sdk.NewCoins(sdk.NewInt64Coin(params.TargetDenom, 990))
+	bankKeeper.EXPECT().IsSendEnabledCoins(ctx, sdk.NewInt64Coin(params.TargetDenom, 1000)).Return(nil).Times(1)
+	foundationKeeper.EXPECT().FundTreasury(ctx, sender, fee).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, bridged).Return(nil).Times(1)
+	bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, bridged).Return(nil).Times(1)
+	_, charged, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(10), charged)
+
+	bankKeeper.EXPECT().IsSendEnabledCoins(ctx, sdk.NewInt64Coin(params.TargetDenom, 100)).Return(nil).Times(1)
+	foundationKeeper.EXPECT().FundTreasury(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(params.TargetDenom, 2))).Return(errors.New("insufficient funds")).Times(1)
+	_, _, err = k.handleBridgeTransfer(ctx, sender, sdk.NewInt(100))
+	require.Error(t, err)
 }
 
 func TestIsValidEthereumAddress(t *testing.T) {
@@ -24,6 +24,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSuggestRole{}, "lbm-sdk/MsgSuggestRole")
 	legacy.RegisterAminoMsg(cdc, &MsgAddVoteForRole{}, "lbm-sdk/MsgAddVoteForRole")
 	legacy.RegisterAminoMsg(cdc, &MsgSetBridgeStatus{}, "lbm-sdk/MsgSetBridgeStatus")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRoute{}, "lbm-sdk/fbridge/MsgSetRoute")
+	legacy.RegisterAminoMsg(cdc, &MsgHaltRoute{}, "lbm-sdk/MsgHaltRoute")
 }
 
 func RegisterInterfaces(registrar types.InterfaceRegistry) {
@@ -40,6 +42,8 @@ func RegisterInterfaces(registrar types.InterfaceRegistry) {
 		&MsgSuggestRole{},
 		&MsgAddVoteForRole{},
 		&MsgSetBridgeStatus{},
+		&MsgSetRoute{},
+		&MsgHaltRoute{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
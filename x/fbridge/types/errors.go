@@ -3,7 +3,15 @@ package types
 import sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 
 var (
-	ErrUnknownProposal = sdkerrors.Register(ModuleName, 2, "unknown proposal")
-	ErrUnknownVote     = sdkerrors.Register(ModuleName, 3, "unknown vote")
-	ErrInactiveBridge  = sdkerrors.Register(ModuleName, 4, "the bridge has halted")
+	ErrUnknownProposal      = sdkerrors.Register(ModuleName, 2, "unknown proposal")
+	ErrUnknownVote          = sdkerrors.Register(ModuleName, 3, "unknown vote")
+	ErrInactiveBridge       = sdkerrors.Register(ModuleName, 4, "the bridge has halted")
+	ErrUnknownProvision     = sdkerrors.Register(ModuleName, 5, "unknown provision")
+	ErrAlreadyClaimed       = sdkerrors.Register(ModuleName, 6, "provision already claimed")
+	ErrTimelocked           = sdkerrors.Register(ModuleName, 7, "provision is still time-locked")
+	ErrExceedsTransferLimit = sdkerrors.Register(ModuleName, 8, "transfer amount exceeds the per-transfer limit")
+	ErrExceedsVolumeCap     = sdkerrors.Register(ModuleName, 9, "transfer exceeds the outbound volume cap")
+	ErrInsufficientAmount   = sdkerrors.Register(ModuleName, 10, "transfer amount does not cover the fee")
+	ErrUnknownRoute         = sdkerrors.Register(ModuleName, 11, "unknown route")
+	ErrInactiveRoute        = sdkerrors.Register(ModuleName, 12, "the route has halted")
 )
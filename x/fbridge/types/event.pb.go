@@ -76,6 +76,12 @@ type EventTransfer struct {
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be transferred
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the fee charged on the transfer
+	Fee string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// the denom of the route. Empty for the default route.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route. Empty for the default route.
+	ChainId string `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
@@ -139,6 +145,27 @@ func (m *EventTransfer) GetAmount() string {
 	return ""
 }
 
+func (m *EventTransfer) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventTransfer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 type EventSuggestRole struct {
 	Proposal RoleProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
@@ -183,6 +210,50 @@ func (m *EventSuggestRole) GetProposal() RoleProposal {
 	return RoleProposal{}
 }
 
+type EventFinalizeRoleProposal struct {
+	Result RoleProposalResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+}
+
+func (m *EventFinalizeRoleProposal) Reset()         { *m = EventFinalizeRoleProposal{} }
+func (m *EventFinalizeRoleProposal) String() string { return proto.CompactTextString(m) }
+func (*EventFinalizeRoleProposal) ProtoMessage()    {}
+func (*EventFinalizeRoleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{3}
+}
+func (m *EventFinalizeRoleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalizeRoleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalizeRoleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalizeRoleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalizeRoleProposal.Merge(m, src)
+}
+func (m *EventFinalizeRoleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalizeRoleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalizeRoleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalizeRoleProposal proto.InternalMessageInfo
+
+func (m *EventFinalizeRoleProposal) GetResult() RoleProposalResult {
+	if m != nil {
+		return m.Result
+	}
+	return RoleProposalResult{}
+}
+
 type EventAddVoteForRole struct {
 	// the voter address
 	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
@@ -196,7 +267,7 @@ func (m *EventAddVoteForRole) Reset()         { *m = EventAddVoteForRole{} }
 func (m *EventAddVoteForRole) String() string { return proto.CompactTextString(m) }
 func (*EventAddVoteForRole) ProtoMessage()    {}
 func (*EventAddVoteForRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{3}
+	return fileDescriptor_a36aa6e56f2275b8, []int{4}
 }
 func (m *EventAddVoteForRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,13 +328,15 @@ type EventProvision struct {
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	// the address of the operator
 	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the denom of the route. Empty for the default route.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *EventProvision) Reset()         { *m = EventProvision{} }
 func (m *EventProvision) String() string { return proto.CompactTextString(m) }
 func (*EventProvision) ProtoMessage()    {}
 func (*EventProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{4}
+	return fileDescriptor_a36aa6e56f2275b8, []int{5}
 }
 func (m *EventProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,6 +400,13 @@ func (m *EventProvision) GetOperator() string {
 	return ""
 }
 
+func (m *EventProvision) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type EventConfirmProvision struct {
 	// the sequence number of the bridge request
 	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
@@ -336,7 +416,7 @@ func (m *EventConfirmProvision) Reset()         { *m = EventConfirmProvision{} }
 func (m *EventConfirmProvision) String() string { return proto.CompactTextString(m) }
 func (*EventConfirmProvision) ProtoMessage()    {}
 func (*EventConfirmProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{5}
+	return fileDescriptor_a36aa6e56f2275b8, []int{6}
 }
 func (m *EventConfirmProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,6 +452,168 @@ func (m *EventConfirmProvision) GetSeq() uint64 {
 	return 0
 }
 
+type EventHoldTransfer struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the guardian address who holds the transfer
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventHoldTransfer) Reset()         { *m = EventHoldTransfer{} }
+func (m *EventHoldTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventHoldTransfer) ProtoMessage()    {}
+func (*EventHoldTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{7}
+}
+func (m *EventHoldTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHoldTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHoldTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHoldTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHoldTransfer.Merge(m, src)
+}
+func (m *EventHoldTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHoldTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHoldTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHoldTransfer proto.InternalMessageInfo
+
+func (m *EventHoldTransfer) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EventHoldTransfer) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+type EventReleaseTransfer struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the guardian address who releases the transfer
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventReleaseTransfer) Reset()         { *m = EventReleaseTransfer{} }
+func (m *EventReleaseTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventReleaseTransfer) ProtoMessage()    {}
+func (*EventReleaseTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{8}
+}
+func (m *EventReleaseTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReleaseTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReleaseTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReleaseTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReleaseTransfer.Merge(m, src)
+}
+func (m *EventReleaseTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReleaseTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReleaseTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReleaseTransfer proto.InternalMessageInfo
+
+func (m *EventReleaseTransfer) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EventReleaseTransfer) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+type EventRemoveProvision struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the judge address who removes the provision
+	Judge string `protobuf:"bytes,2,opt,name=judge,proto3" json:"judge,omitempty"`
+}
+
+func (m *EventRemoveProvision) Reset()         { *m = EventRemoveProvision{} }
+func (m *EventRemoveProvision) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveProvision) ProtoMessage()    {}
+func (*EventRemoveProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{9}
+}
+func (m *EventRemoveProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveProvision.Merge(m, src)
+}
+func (m *EventRemoveProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveProvision proto.InternalMessageInfo
+
+func (m *EventRemoveProvision) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EventRemoveProvision) GetJudge() string {
+	if m != nil {
+		return m.Judge
+	}
+	return ""
+}
+
 type EventClaim struct {
 	// the sequence number of the bridge request
 	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
@@ -381,13 +623,15 @@ type EventClaim struct {
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be claimed
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the denom of the route. Empty for the default route.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *EventClaim) Reset()         { *m = EventClaim{} }
 func (m *EventClaim) String() string { return proto.CompactTextString(m) }
 func (*EventClaim) ProtoMessage()    {}
 func (*EventClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{6}
+	return fileDescriptor_a36aa6e56f2275b8, []int{10}
 }
 func (m *EventClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,18 +688,81 @@ func (m *EventClaim) GetAmount() string {
 	return ""
 }
 
+func (m *EventClaim) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type EventClaimFailure struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the reason why the claim failed
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventClaimFailure) Reset()         { *m = EventClaimFailure{} }
+func (m *EventClaimFailure) String() string { return proto.CompactTextString(m) }
+func (*EventClaimFailure) ProtoMessage()    {}
+func (*EventClaimFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{11}
+}
+func (m *EventClaimFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimFailure.Merge(m, src)
+}
+func (m *EventClaimFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimFailure proto.InternalMessageInfo
+
+func (m *EventClaimFailure) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EventClaimFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type EventSetBridgeStatus struct {
 	// the guardian address who modifies the bridge status (a.k.a. bridge switch)
 	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
 	// the new status of the guardian's bridge switch
 	Status BridgeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"status,omitempty"`
+	// the duration of the halt (nanoseconds). Zero means the halt does not expire.
+	HaltDuration uint64 `protobuf:"varint,3,opt,name=halt_duration,json=haltDuration,proto3" json:"halt_duration,omitempty"`
 }
 
 func (m *EventSetBridgeStatus) Reset()         { *m = EventSetBridgeStatus{} }
 func (m *EventSetBridgeStatus) String() string { return proto.CompactTextString(m) }
 func (*EventSetBridgeStatus) ProtoMessage()    {}
 func (*EventSetBridgeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{7}
+	return fileDescriptor_a36aa6e56f2275b8, []int{12}
 }
 func (m *EventSetBridgeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,95 +805,276 @@ func (m *EventSetBridgeStatus) GetStatus() BridgeStatus {
 	return StatusEmpty
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "lbm.fbridge.v1.EventUpdateParams")
-	proto.RegisterType((*EventTransfer)(nil), "lbm.fbridge.v1.EventTransfer")
-	proto.RegisterType((*EventSuggestRole)(nil), "lbm.fbridge.v1.EventSuggestRole")
-	proto.RegisterType((*EventAddVoteForRole)(nil), "lbm.fbridge.v1.EventAddVoteForRole")
-	proto.RegisterType((*EventProvision)(nil), "lbm.fbridge.v1.EventProvision")
-	proto.RegisterType((*EventConfirmProvision)(nil), "lbm.fbridge.v1.EventConfirmProvision")
-	proto.RegisterType((*EventClaim)(nil), "lbm.fbridge.v1.EventClaim")
-	proto.RegisterType((*EventSetBridgeStatus)(nil), "lbm.fbridge.v1.EventSetBridgeStatus")
+func (m *EventSetBridgeStatus) GetHaltDuration() uint64 {
+	if m != nil {
+		return m.HaltDuration
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("lbm/fbridge/v1/event.proto", fileDescriptor_a36aa6e56f2275b8) }
-
-var fileDescriptor_a36aa6e56f2275b8 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0xbf, 0xa6, 0x51, 0x3b, 0xd5, 0x17, 0x15, 0x13, 0x2a, 0xcb, 0xaa, 0xdc, 0xca, 0xa7,
-	0x72, 0xc0, 0xa6, 0x81, 0x33, 0x12, 0x41, 0x54, 0x2a, 0x17, 0x22, 0x17, 0x38, 0x70, 0x41, 0xeb,
-	0x78, 0xe3, 0x2c, 0xd8, 0x1e, 0xb3, 0xbb, 0xb6, 0xe0, 0xc6, 0x99, 0x13, 0x3f, 0xab, 0xc7, 0x1e,
-	0x39, 0x21, 0x94, 0xfc, 0x11, 0xb4, 0xe3, 0x4d, 0x04, 0x41, 0xe2, 0xd6, 0xdb, 0x3c, 0xcf, 0x7b,
-	0x6f, 0xde, 0x7a, 0x76, 0xc1, 0x2f, 0xd2, 0x32, 0x9e, 0xa7, 0x52, 0x64, 0x39, 0x8f, 0xdb, 0xf3,
-	0x98, 0xb7, 0xbc, 0xd2, 0x51, 0x2d, 0x51, 0xa3, 0x3b, 0x2c, 0xd2, 0x32, 0xb2, 0xbd, 0xa8, 0x3d,
-	0xf7, 0x47, 0x39, 0xe6, 0x48, 0xad, 0xd8, 0x54, 0x1d, 0xcb, 0x3f, 0xde, 0x72, 0x58, 0x0b, 0xa8,
-	0x1b, 0x5e, 0xc2, 0x9d, 0xe7, 0xc6, 0xf2, 0x75, 0x9d, 0x31, 0xcd, 0xa7, 0x4c, 0xb2, 0x52, 0xb9,
-	0x8f, 0x61, 0x50, 0x53, 0xe5, 0x39, 0xa7, 0xce, 0xd9, 0xc1, 0xf8, 0x28, 0xfa, 0x73, 0x52, 0xd4,
-	0xf1, 0x26, 0xfd, 0xeb, 0x1f, 0x27, 0xbd, 0xc4, 0x72, 0xc3, 0x12, 0xfe, 0x27, 0xab, 0x57, 0x92,
-	0x55, 0x6a, 0xce, 0xa5, 0x7b, 0x08, 0x3b, 0x8a, 0x7f, 0x24, 0x8f, 0x7e, 0x62, 0x4a, 0xf7, 0x08,
-	0x06, 0x8a, 0x57, 0x19, 0x97, 0xde, 0x7f, 0xa7, 0xce, 0xd9, 0x7e, 0x62, 0x91, 0xeb, 0xc3, 0x9e,
-	0xe4, 0x33, 0x2e, 0x5a, 0x2e, 0xbd, 0x1d, 0xea, 0x6c, 0xb0, 0xd1, 0xb0, 0x12, 0x9b, 0x4a, 0x7b,
-	0xfd, 0x4e, 0xd3, 0xa1, 0x30, 0x81, 0x43, 0x1a, 0x77, 0xd5, 0xe4, 0x39, 0x57, 0x3a, 0xc1, 0x82,
-	0xbb, 0x4f, 0x60, 0xaf, 0x96, 0x58, 0xa3, 0x62, 0x85, 0x8d, 0x7e, 0xbc, 0x1d, 0xdd, 0xf0, 0xa6,
-	0x96, 0x63, 0x0f, 0xb0, 0xd1, 0x84, 0x5f, 0x1c, 0xb8, 0x4b, 0xa6, 0x4f, 0xb3, 0xec, 0x0d, 0x6a,
-	0x7e, 0x81, 0x92, 0x7c, 0x47, 0xb0, 0xdb, 0xa2, 0xe6, 0x92, 0x4c, 0xf7, 0x93, 0x0e, 0xb8, 0x27,
-	0x70, 0xb0, 0x56, 0xbe, 0x13, 0x19, 0x1d, 0xa9, 0x9f, 0xc0, 0xfa, 0xd3, 0x65, 0xe6, 0x8e, 0x61,
-	0x80, 0xb5, 0x16, 0x58, 0xd1, 0xa1, 0x86, 0x63, 0x7f, 0x3b, 0x8c, 0x99, 0xf1, 0x92, 0x18, 0x89,
-	0x65, 0x86, 0x5f, 0x1d, 0x18, 0x52, 0x84, 0xa9, 0xc4, 0x56, 0x28, 0x81, 0xd5, 0xed, 0xfe, 0x47,
-	0xa3, 0xc1, 0x9a, 0x4b, 0xa6, 0x51, 0x7a, 0xbb, 0x9d, 0x66, 0x8d, 0xc3, 0xfb, 0x70, 0x8f, 0xb2,
-	0x3c, 0xc3, 0x6a, 0x2e, 0x64, 0xf9, 0x8f, 0x48, 0xe1, 0x7b, 0x80, 0x8e, 0x5a, 0x30, 0x51, 0xde,
-	0xf2, 0xea, 0x17, 0x30, 0xea, 0x56, 0xcf, 0xf5, 0x84, 0xfe, 0xe5, 0x95, 0x66, 0xba, 0x51, 0xc6,
-	0x2b, 0x6f, 0x98, 0xcc, 0x04, 0xab, 0xec, 0xa6, 0x36, 0xd8, 0xdc, 0x69, 0x45, 0x2c, 0x9a, 0x3f,
-	0xfc, 0xfb, 0x62, 0xfc, 0xee, 0x94, 0x58, 0xee, 0xe4, 0xc5, 0xf5, 0x32, 0x70, 0x6e, 0x96, 0x81,
-	0xf3, 0x73, 0x19, 0x38, 0xdf, 0x56, 0x41, 0xef, 0x66, 0x15, 0xf4, 0xbe, 0xaf, 0x82, 0xde, 0xdb,
-	0x87, 0xb9, 0xd0, 0x8b, 0x26, 0x8d, 0x66, 0x58, 0xc6, 0x17, 0xa2, 0x52, 0xb3, 0x85, 0x60, 0xf1,
-	0xdc, 0x16, 0x0f, 0x54, 0xf6, 0x21, 0xfe, 0xb4, 0x79, 0x75, 0xfa, 0x73, 0xcd, 0x55, 0x3a, 0xa0,
-	0x17, 0xf7, 0xe8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x84, 0xa9, 0x5c, 0xd3, 0x03, 0x00,
-	0x00,
+type EventBridgeHaltExpired struct {
+	// the guardian address whose halt has expired
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventBridgeHaltExpired) Reset()         { *m = EventBridgeHaltExpired{} }
+func (m *EventBridgeHaltExpired) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeHaltExpired) ProtoMessage()    {}
+func (*EventBridgeHaltExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{13}
 }
-
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventBridgeHaltExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventBridgeHaltExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeHaltExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventBridgeHaltExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeHaltExpired.Merge(m, src)
+}
+func (m *EventBridgeHaltExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeHaltExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeHaltExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeHaltExpired proto.InternalMessageInfo
+
+func (m *EventBridgeHaltExpired) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+type EventSetRoute struct {
+	Route Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+}
+
+func (m *EventSetRoute) Reset()         { *m = EventSetRoute{} }
+func (m *EventSetRoute) String() string { return proto.CompactTextString(m) }
+func (*EventSetRoute) ProtoMessage()    {}
+func (*EventSetRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{14}
+}
+func (m *EventSetRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetRoute.Merge(m, src)
+}
+func (m *EventSetRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetRoute proto.InternalMessageInfo
+
+func (m *EventSetRoute) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+type EventHaltRoute struct {
+	// the guardian address
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// the denom of the route
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EventHaltRoute) Reset()         { *m = EventHaltRoute{} }
+func (m *EventHaltRoute) String() string { return proto.CompactTextString(m) }
+func (*EventHaltRoute) ProtoMessage()    {}
+func (*EventHaltRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{15}
+}
+func (m *EventHaltRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHaltRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHaltRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHaltRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHaltRoute.Merge(m, src)
+}
+func (m *EventHaltRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHaltRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHaltRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHaltRoute proto.InternalMessageInfo
+
+func (m *EventHaltRoute) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *EventHaltRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventHaltRoute) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "lbm.fbridge.v1.EventUpdateParams")
+	proto.RegisterType((*EventTransfer)(nil), "lbm.fbridge.v1.EventTransfer")
+	proto.RegisterType((*EventSuggestRole)(nil), "lbm.fbridge.v1.EventSuggestRole")
+	proto.RegisterType((*EventFinalizeRoleProposal)(nil), "lbm.fbridge.v1.EventFinalizeRoleProposal")
+	proto.RegisterType((*EventAddVoteForRole)(nil), "lbm.fbridge.v1.EventAddVoteForRole")
+	proto.RegisterType((*EventProvision)(nil), "lbm.fbridge.v1.EventProvision")
+	proto.RegisterType((*EventConfirmProvision)(nil), "lbm.fbridge.v1.EventConfirmProvision")
+	proto.RegisterType((*EventHoldTransfer)(nil), "lbm.fbridge.v1.EventHoldTransfer")
+	proto.RegisterType((*EventReleaseTransfer)(nil), "lbm.fbridge.v1.EventReleaseTransfer")
+	proto.RegisterType((*EventRemoveProvision)(nil), "lbm.fbridge.v1.EventRemoveProvision")
+	proto.RegisterType((*EventClaim)(nil), "lbm.fbridge.v1.EventClaim")
+	proto.RegisterType((*EventClaimFailure)(nil), "lbm.fbridge.v1.EventClaimFailure")
+	proto.RegisterType((*EventSetBridgeStatus)(nil), "lbm.fbridge.v1.EventSetBridgeStatus")
+	proto.RegisterType((*EventBridgeHaltExpired)(nil), "lbm.fbridge.v1.EventBridgeHaltExpired")
+	proto.RegisterType((*EventSetRoute)(nil), "lbm.fbridge.v1.EventSetRoute")
+	proto.RegisterType((*EventHaltRoute)(nil), "lbm.fbridge.v1.EventHaltRoute")
+}
+
+func init() { proto.RegisterFile("lbm/fbridge/v1/event.proto", fileDescriptor_a36aa6e56f2275b8) }
+
+var fileDescriptor_a36aa6e56f2275b8 = []byte{
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x21, 0x09, 0xe1, 0xf2, 0x88, 0xc0, 0x2f, 0xa0, 0x10, 0xa1, 0x80, 0xfc, 0x36, 0xbc,
+	0xc5, 0x4b, 0x1e, 0x94, 0x6d, 0x51, 0x49, 0x21, 0x82, 0x6e, 0x8a, 0x9c, 0xb6, 0x8b, 0x4a, 0x08,
+	0x4d, 0x32, 0x37, 0xce, 0xb4, 0xb6, 0xc7, 0x9d, 0x19, 0x5b, 0xb4, 0xab, 0xfe, 0x40, 0xa5, 0x7e,
+	0x42, 0x3f, 0xa1, 0x9f, 0xc1, 0x92, 0x65, 0x57, 0x55, 0x05, 0x3f, 0x52, 0x79, 0x3c, 0x71, 0x09,
+	0xd0, 0x2c, 0x2a, 0xb1, 0xbb, 0x77, 0xe6, 0xcc, 0xf1, 0xb9, 0xe7, 0xce, 0x1d, 0x43, 0xc3, 0xef,
+	0x07, 0xed, 0x61, 0x5f, 0x30, 0xea, 0x61, 0x3b, 0xd9, 0x6e, 0x63, 0x82, 0xa1, 0x6a, 0x45, 0x82,
+	0x2b, 0x6e, 0x57, 0xfd, 0x7e, 0xd0, 0x32, 0x7b, 0xad, 0x64, 0xbb, 0x51, 0xf3, 0xb8, 0xc7, 0xf5,
+	0x56, 0x3b, 0x8d, 0x32, 0x54, 0x63, 0xfd, 0x16, 0xc3, 0xf8, 0x80, 0xde, 0x75, 0x8e, 0x61, 0xf9,
+	0x30, 0xa5, 0x7c, 0x19, 0x51, 0xa2, 0xf0, 0x84, 0x08, 0x12, 0x48, 0x7b, 0x17, 0xca, 0x91, 0x8e,
+	0xea, 0xd6, 0xa6, 0xb5, 0xb5, 0xb0, 0xb3, 0xda, 0x9a, 0xfc, 0x52, 0x2b, 0xc3, 0x75, 0x8a, 0x17,
+	0xdf, 0x37, 0x0a, 0xae, 0xc1, 0x3a, 0x5f, 0x2d, 0x58, 0xd4, 0x5c, 0x2f, 0x04, 0x09, 0xe5, 0x10,
+	0x85, 0xbd, 0x04, 0xb3, 0x12, 0xdf, 0x69, 0x92, 0xa2, 0x9b, 0x86, 0xf6, 0x2a, 0x94, 0x25, 0x86,
+	0x14, 0x45, 0x7d, 0x66, 0xd3, 0xda, 0x9a, 0x77, 0x4d, 0x66, 0x37, 0xa0, 0x22, 0x70, 0x80, 0x2c,
+	0x41, 0x51, 0x9f, 0xd5, 0x3b, 0x79, 0x9e, 0x9e, 0x21, 0x01, 0x8f, 0x43, 0x55, 0x2f, 0x66, 0x67,
+	0xb2, 0x2c, 0x65, 0x1f, 0x22, 0xd6, 0x4b, 0x7a, 0x31, 0x0d, 0xed, 0x1a, 0x94, 0x28, 0x86, 0x3c,
+	0xa8, 0x97, 0xf5, 0x5a, 0x96, 0xd8, 0x6b, 0x50, 0x19, 0x8c, 0x08, 0x0b, 0xcf, 0x18, 0xad, 0xcf,
+	0xe9, 0x8d, 0x39, 0x9d, 0x1f, 0x53, 0xc7, 0x85, 0x25, 0xad, 0xb8, 0x17, 0x7b, 0x1e, 0x4a, 0xe5,
+	0x72, 0x1f, 0xed, 0x3d, 0xa8, 0x44, 0x82, 0x47, 0x5c, 0x12, 0xdf, 0x94, 0xbf, 0x7e, 0xbb, 0xfc,
+	0x14, 0x77, 0x62, 0x30, 0xc6, 0x84, 0xfc, 0x8c, 0x73, 0x0a, 0x6b, 0x9a, 0xb3, 0xcb, 0x42, 0xe2,
+	0xb3, 0x0f, 0x78, 0x13, 0x6c, 0x3f, 0x81, 0xb2, 0x40, 0x19, 0xfb, 0xca, 0x50, 0x3b, 0xd3, 0xa8,
+	0x5d, 0x8d, 0x1c, 0xbb, 0x9c, 0x9d, 0x73, 0x3e, 0x5a, 0xf0, 0xb7, 0xe6, 0xdf, 0xa7, 0xf4, 0x15,
+	0x57, 0xd8, 0xe5, 0x42, 0xcb, 0xae, 0x41, 0x29, 0xe1, 0x0a, 0x85, 0x26, 0x9e, 0x77, 0xb3, 0xc4,
+	0xde, 0x80, 0x85, 0xb1, 0xb0, 0xb4, 0xfc, 0x19, 0xdd, 0x09, 0x18, 0x2f, 0x1d, 0x53, 0x7b, 0x07,
+	0xca, 0x3c, 0x52, 0x8c, 0x87, 0xda, 0xf6, 0xea, 0x4e, 0xe3, 0xb6, 0xa0, 0xf4, 0x1b, 0xcf, 0x35,
+	0xc2, 0x35, 0x48, 0xe7, 0x8b, 0x05, 0x55, 0x2d, 0xe1, 0x44, 0xf0, 0x84, 0x49, 0xc6, 0xc3, 0x07,
+	0xee, 0x74, 0x03, 0x2a, 0x3c, 0x42, 0x41, 0x14, 0x17, 0xa6, 0xdd, 0x79, 0x7e, 0x7f, 0xcf, 0x9d,
+	0x7f, 0x61, 0x45, 0x2b, 0x7c, 0xca, 0xc3, 0x21, 0x13, 0xc1, 0x14, 0xa1, 0xce, 0xbe, 0x99, 0x80,
+	0x23, 0xee, 0xd3, 0x29, 0x37, 0xb7, 0x01, 0x15, 0x2f, 0x26, 0x82, 0x32, 0x12, 0x9a, 0x8a, 0xf2,
+	0xdc, 0x39, 0x80, 0x9a, 0xa6, 0x70, 0xd1, 0x47, 0x22, 0xf1, 0x0f, 0x59, 0xf6, 0x72, 0x96, 0x80,
+	0x27, 0x38, 0xcd, 0xdb, 0x1a, 0x94, 0xde, 0xc4, 0xd4, 0x43, 0x43, 0x91, 0x25, 0xe9, 0xcd, 0x80,
+	0xac, 0x68, 0x9f, 0xb0, 0xe0, 0x81, 0x5b, 0x92, 0xdb, 0x5e, 0xba, 0x69, 0xfb, 0x63, 0x58, 0xfe,
+	0xa5, 0xa0, 0x4b, 0x98, 0x1f, 0x0b, 0xbc, 0x5f, 0x88, 0x40, 0x22, 0xf9, 0xd8, 0x03, 0x93, 0x39,
+	0x9f, 0x2c, 0x63, 0x41, 0x0f, 0x55, 0x47, 0xdf, 0xc0, 0x9e, 0x22, 0x2a, 0x96, 0x13, 0xb6, 0x59,
+	0x93, 0xb6, 0xa5, 0x8f, 0x95, 0xd4, 0x28, 0x4d, 0x56, 0xbd, 0x3b, 0xad, 0x37, 0x99, 0x5c, 0x83,
+	0xb5, 0xff, 0x81, 0xc5, 0x11, 0xf1, 0xd5, 0x19, 0x8d, 0x05, 0xc9, 0xaf, 0x7f, 0xd1, 0xfd, 0x2b,
+	0x5d, 0x3c, 0x30, 0x6b, 0xce, 0x2e, 0xac, 0x6a, 0x39, 0x19, 0xc3, 0x11, 0xf1, 0xd5, 0xe1, 0x79,
+	0xc4, 0x04, 0xd2, 0x69, 0x82, 0x9c, 0x8e, 0x79, 0x06, 0x7b, 0xa8, 0x5c, 0x1e, 0x2b, 0xb4, 0xb7,
+	0xa1, 0x24, 0xd2, 0xc0, 0xcc, 0xfc, 0xca, 0xdd, 0x99, 0x8f, 0x15, 0x9a, 0x31, 0xcf, 0x90, 0xce,
+	0xa9, 0x99, 0xb0, 0xf4, 0x9b, 0x19, 0xc9, 0x34, 0x0b, 0xf2, 0x66, 0xcc, 0xfc, 0xee, 0xdd, 0x9b,
+	0x9d, 0x78, 0xf7, 0x3a, 0xcf, 0x2e, 0xae, 0x9a, 0xd6, 0xe5, 0x55, 0xd3, 0xfa, 0x71, 0xd5, 0xb4,
+	0x3e, 0x5f, 0x37, 0x0b, 0x97, 0xd7, 0xcd, 0xc2, 0xb7, 0xeb, 0x66, 0xe1, 0xf5, 0xff, 0x1e, 0x53,
+	0xa3, 0xb8, 0xdf, 0x1a, 0xf0, 0xa0, 0xdd, 0x65, 0xa1, 0x1c, 0x8c, 0x18, 0x69, 0x0f, 0x4d, 0xf0,
+	0x9f, 0xa4, 0x6f, 0xdb, 0xe7, 0xf9, 0xcf, 0x44, 0xbd, 0x8f, 0x50, 0xf6, 0xcb, 0xfa, 0x47, 0xf2,
+	0xe8, 0xe7, 0x00, 0x72, 0xd3, 0xb7, 0x1c, 0xaa, 0x06, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *EventTransfer) MarshalTo(dAtA []byte) (int, error) {
@@ -599,6 +1087,27 @@ func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -661,6 +1170,39 @@ func (m *EventSuggestRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFinalizeRoleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalizeRoleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalizeRoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAddVoteForRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -721,6 +1263,13 @@ func (m *EventProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -785,7 +1334,7 @@ func (m *EventConfirmProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventClaim) Marshal() (dAtA []byte, err error) {
+func (m *EventHoldTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -795,34 +1344,20 @@ func (m *EventClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventHoldTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventHoldTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Guardian)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -834,7 +1369,7 @@ func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetBridgeStatus) Marshal() (dAtA []byte, err error) {
+func (m *EventReleaseTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -844,148 +1379,327 @@ func (m *EventSetBridgeStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetBridgeStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventReleaseTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetBridgeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventReleaseTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.Guardian) > 0 {
 		i -= len(m.Guardian)
 		copy(dAtA[i:], m.Guardian)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Guardian)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventRemoveProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+
+func (m *EventRemoveProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventRemoveProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovEvent(uint64(m.Seq))
+	if len(m.Judge) > 0 {
+		i -= len(m.Judge)
+		copy(dAtA[i:], m.Judge)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Judge)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSuggestRole) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventClaimFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAddVoteForRole) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBridgeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBridgeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBridgeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.HaltDuration != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.HaltDuration))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ProposalId != 0 {
-		n += 1 + sovEvent(uint64(m.ProposalId))
+	if m.Status != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Option != 0 {
-		n += 1 + sovEvent(uint64(m.Option))
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventProvision) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBridgeHaltExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeHaltExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeHaltExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovEvent(uint64(m.Seq))
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventSetRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHaltRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventHaltRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHaltRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventConfirmProvision) Size() (n int) {
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovEvent(uint64(m.Seq))
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
-func (m *EventClaim) Size() (n int) {
+func (m *EventTransfer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1006,22 +1720,259 @@ func (m *EventClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
-func (m *EventSetBridgeStatus) Size() (n int) {
+func (m *EventSuggestRole) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Guardian)
-	if l > 0 {
+	l = m.Proposal.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventFinalizeRoleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventAddVoteForRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	if m.Option != 0 {
+		n += 1 + sovEvent(uint64(m.Option))
+	}
+	return n
+}
+
+func (m *EventProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConfirmProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *EventHoldTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReleaseTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Judge)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClaimFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetBridgeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
 	if m.Status != 0 {
 		n += 1 + sovEvent(uint64(m.Status))
 	}
+	if m.HaltDuration != 0 {
+		n += 1 + sovEvent(uint64(m.HaltDuration))
+	}
+	return n
+}
+
+func (m *EventBridgeHaltExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventHaltRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -1041,30 +1992,807 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEvent
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSuggestRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSuggestRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSuggestRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalizeRoleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalizeRoleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalizeRoleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAddVoteForRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAddVoteForRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1074,24 +2802,23 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1114,7 +2841,7 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTransfer) Unmarshal(dAtA []byte) error {
+func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,10 +2864,10 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConfirmProvision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConfirmProvision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1162,43 +2889,61 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHoldTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHoldTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHoldTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1208,27 +2953,14 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1256,7 +2988,7 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1279,7 +3011,7 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSuggestRole) Unmarshal(dAtA []byte) error {
+func (m *EventReleaseTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1302,17 +3034,36 @@ func (m *EventSuggestRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSuggestRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReleaseTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSuggestRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReleaseTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1322,24 +3073,23 @@ func (m *EventSuggestRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1362,7 +3112,7 @@ func (m *EventSuggestRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
+func (m *EventRemoveProvision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,17 +3135,17 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddVoteForRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRemoveProvision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddVoteForRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRemoveProvision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			var stringLen uint64
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1405,29 +3155,16 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Judge", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1437,30 +3174,24 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Judge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1482,7 +3213,7 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventProvision) Unmarshal(dAtA []byte) error {
+func (m *EventClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1505,10 +3236,10 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1628,7 +3359,7 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1656,7 +3387,7 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1679,7 +3410,7 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
+func (m *EventClaimFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,10 +3433,10 @@ func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConfirmProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClaimFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConfirmProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClaimFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1727,6 +3458,38 @@ func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1748,7 +3511,7 @@ func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaim) Unmarshal(dAtA []byte) error {
+func (m *EventSetBridgeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1771,17 +3534,17 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBridgeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBridgeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
-			m.Seq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1791,16 +3554,29 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1810,27 +3586,83 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= BridgeStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltDuration", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.HaltDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBridgeHaltExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeHaltExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeHaltExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1858,13 +3690,63 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1874,23 +3756,24 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1913,7 +3796,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetBridgeStatus) Unmarshal(dAtA []byte) error {
+func (m *EventHaltRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,10 +3819,10 @@ func (m *EventSetBridgeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetBridgeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventHaltRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetBridgeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventHaltRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1975,10 +3858,10 @@ func (m *EventSetBridgeStatus) Unmarshal(dAtA []byte) error {
 			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1988,11 +3871,56 @@ func (m *EventSetBridgeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BridgeStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1,7 +1,13 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
 )
 
 var QueryParamToRole = map[string]Role{
@@ -29,6 +35,71 @@ func IsValidVoteOption(option VoteOption) error {
 	return errors.New("unsupported vote option")
 }
 
+func IsValidProposalStatus(status ProposalStatus) error {
+	switch status {
+	case ProposalStatusPassed, ProposalStatusRejected, ProposalStatusExpired:
+		return nil
+	}
+
+	return errors.New("unsupported proposal status")
+}
+
+func IsValidReceiverValidator(validator ReceiverValidator) error {
+	switch validator {
+	case ReceiverValidatorEVMChecksum, ReceiverValidatorEVM:
+		return nil
+	}
+
+	return errors.New("unsupported receiver validator")
+}
+
+// ValidateBasic performs stateless validation of the route.
+func (r Route) ValidateBasic() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	if len(r.ChainId) == 0 {
+		return errors.New("chain ID cannot be empty")
+	}
+
+	if err := IsValidReceiverValidator(r.ReceiverValidator); err != nil {
+		return err
+	}
+
+	if err := IsValidBridgeStatus(r.Status); err != nil {
+		return err
+	}
+
+	if !r.MaxTransferAmount.IsNil() && r.MaxTransferAmount.IsNegative() {
+		return errors.New("max transfer amount cannot be negative")
+	}
+
+	if !r.TransferVolumeCap.IsNil() && r.TransferVolumeCap.IsNegative() {
+		return errors.New("transfer volume cap cannot be negative")
+	}
+
+	if r.HasTransferVolumeCap() && r.TransferVolumeWindow == 0 {
+		return errors.New("transfer volume window cannot be 0 if the volume cap is set")
+	}
+
+	if err := r.TransferFee.ValidateBasic(); err != nil {
+		return fmt.Errorf("transfer fee: %w", err)
+	}
+
+	return nil
+}
+
+// HasTransferLimit returns whether the amount of a single transfer through the route is limited.
+func (r Route) HasTransferLimit() bool {
+	return !r.MaxTransferAmount.IsNil() && r.MaxTransferAmount.IsPositive()
+}
+
+// HasTransferVolumeCap returns whether the total amount of transfers through the route within the rolling window is capped.
+func (r Route) HasTransferVolumeCap() bool {
+	return !r.TransferVolumeCap.IsNil() && r.TransferVolumeCap.IsPositive()
+}
+
 func IsValidBridgeStatus(status BridgeStatus) error {
 	switch status {
 	case StatusActive, StatusInactive:
@@ -37,3 +108,44 @@ func IsValidBridgeStatus(status BridgeStatus) error {
 
 	return errors.New("unsupported bridge status")
 }
+
+// Commitment returns the hash value which identifies the provision data.
+func (p ProvisionData) Commitment() []byte {
+	bz, err := p.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}
+
+// EncodeCommitment returns the string representation of the commitment.
+func EncodeCommitment(commitment []byte) string {
+	return hex.EncodeToString(commitment)
+}
+
+// DecodeCommitment parses the string representation of the commitment.
+func DecodeCommitment(commitment string) ([]byte, error) {
+	bz, err := hex.DecodeString(commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != 32 {
+		return nil, fmt.Errorf("invalid commitment length: %d", len(bz))
+	}
+
+	return bz, nil
+}
+
+// MustDecodeCommitment parses the string representation of the commitment and panics on error.
+func MustDecodeCommitment(commitment string) []byte {
+	bz, err := DecodeCommitment(commitment)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
@@ -92,6 +92,42 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_62374d75fc6aa1ba, []int{1}
 }
 
+// ProposalStatus enumerates the final statuses of a role proposal.
+type ProposalStatus int32
+
+const (
+	// PROPOSAL_STATUS_UNSPECIFIED defines an unspecified proposal status.
+	ProposalStatusEmpty ProposalStatus = 0
+	// PROPOSAL_STATUS_PASSED defines a proposal whose role update has been applied.
+	ProposalStatusPassed ProposalStatus = 1
+	// PROPOSAL_STATUS_REJECTED defines a proposal which can no longer reach the guardian trust level.
+	ProposalStatusRejected ProposalStatus = 2
+	// PROPOSAL_STATUS_EXPIRED defines a proposal which has not been decided until it expired.
+	ProposalStatusExpired ProposalStatus = 3
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PROPOSAL_STATUS_UNSPECIFIED",
+	1: "PROPOSAL_STATUS_PASSED",
+	2: "PROPOSAL_STATUS_REJECTED",
+	3: "PROPOSAL_STATUS_EXPIRED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PROPOSAL_STATUS_UNSPECIFIED": 0,
+	"PROPOSAL_STATUS_PASSED":      1,
+	"PROPOSAL_STATUS_REJECTED":    2,
+	"PROPOSAL_STATUS_EXPIRED":     3,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{2}
+}
+
 type BridgeStatus int32
 
 const (
@@ -120,7 +156,39 @@ func (x BridgeStatus) String() string {
 }
 
 func (BridgeStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{2}
+	return fileDescriptor_62374d75fc6aa1ba, []int{3}
+}
+
+// ReceiverValidator defines how the receiver address on the destination chain is validated.
+type ReceiverValidator int32
+
+const (
+	// RECEIVER_VALIDATOR_UNSPECIFIED defines an unspecified receiver validator.
+	ReceiverValidatorEmpty ReceiverValidator = 0
+	// RECEIVER_VALIDATOR_EVM_CHECKSUM accepts EVM addresses in the EIP-55 mixed-case checksum encoding only.
+	ReceiverValidatorEVMChecksum ReceiverValidator = 1
+	// RECEIVER_VALIDATOR_EVM accepts hex-encoded EVM addresses regardless of letter case.
+	ReceiverValidatorEVM ReceiverValidator = 2
+)
+
+var ReceiverValidator_name = map[int32]string{
+	0: "RECEIVER_VALIDATOR_UNSPECIFIED",
+	1: "RECEIVER_VALIDATOR_EVM_CHECKSUM",
+	2: "RECEIVER_VALIDATOR_EVM",
+}
+
+var ReceiverValidator_value = map[string]int32{
+	"RECEIVER_VALIDATOR_UNSPECIFIED":  0,
+	"RECEIVER_VALIDATOR_EVM_CHECKSUM": 1,
+	"RECEIVER_VALIDATOR_EVM":          2,
+}
+
+func (x ReceiverValidator) String() string {
+	return proto.EnumName(ReceiverValidator_name, int32(x))
+}
+
+func (ReceiverValidator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{4}
 }
 
 type Params struct {
@@ -136,6 +204,18 @@ type Params struct {
 	ProposalPeriod uint64 `protobuf:"varint,5,opt,name=proposal_period,json=proposalPeriod,proto3" json:"proposal_period,omitempty"`
 	// target denom of the bridge module. This is the base denom of Finschia normally.
 	TargetDenom string `protobuf:"bytes,6,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	// maximum amount of a single outbound transfer through the default route. Zero means no limit.
+	MaxTransferAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,7,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_transfer_amount"`
+	// maximum total amount of outbound transfers through the default route within the rolling window.
+	// Zero means no cap.
+	TransferVolumeCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=transfer_volume_cap,json=transferVolumeCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_volume_cap"`
+	// length of the rolling window for transfer_volume_cap (nanoseconds)
+	TransferVolumeWindow uint64 `protobuf:"varint,9,opt,name=transfer_volume_window,json=transferVolumeWindow,proto3" json:"transfer_volume_window,omitempty"`
+	// fee charged on each outbound transfer through the default route
+	TransferFee TransferFee `protobuf:"bytes,10,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee"`
+	// name of the module account receiving the transfer fees.
+	// If it is the x/foundation treasury, the fees are funded to the treasury.
+	FeeDestination string `protobuf:"bytes,11,opt,name=fee_destination,json=feeDestination,proto3" json:"fee_destination,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -213,6 +293,78 @@ func (m *Params) GetTargetDenom() string {
 	return ""
 }
 
+func (m *Params) GetTransferVolumeWindow() uint64 {
+	if m != nil {
+		return m.TransferVolumeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
+func (m *Params) GetFeeDestination() string {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return ""
+}
+
+// TransferFee defines the fee schedule of outbound transfers.
+// Either a flat amount or a basis-point rate can be set. Both zero means no fee.
+type TransferFee struct {
+	// flat amount charged on each transfer
+	FlatAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,1,opt,name=flat_amount,json=flatAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"flat_amount"`
+	// rate charged on each transfer in basis points (1/10000)
+	RateBps uint32 `protobuf:"varint,2,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	// minimum amount charged on each transfer if rate_bps is set
+	MinAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"min_amount"`
+}
+
+func (m *TransferFee) Reset()         { *m = TransferFee{} }
+func (m *TransferFee) String() string { return proto.CompactTextString(m) }
+func (*TransferFee) ProtoMessage()    {}
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{1}
+}
+func (m *TransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFee.Merge(m, src)
+}
+func (m *TransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFee proto.InternalMessageInfo
+
+func (m *TransferFee) GetRateBps() uint32 {
+	if m != nil {
+		return m.RateBps
+	}
+	return 0
+}
+
 // Provision is a struct that represents a provision internally.
 type ProvisionData struct {
 	// the sequence number of the bridge request
@@ -223,13 +375,15 @@ type ProvisionData struct {
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the denom to be minted on claim. Empty for params.target_denom of the default route.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *ProvisionData) Reset()         { *m = ProvisionData{} }
 func (m *ProvisionData) String() string { return proto.CompactTextString(m) }
 func (*ProvisionData) ProtoMessage()    {}
 func (*ProvisionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{1}
+	return fileDescriptor_62374d75fc6aa1ba, []int{2}
 }
 func (m *ProvisionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +433,13 @@ func (m *ProvisionData) GetReceiver() string {
 	return ""
 }
 
+func (m *ProvisionData) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ProvisionStatus is a struct that represents the status of a provision.
 // To optimize computational cost, we have collected frequently changing values from provision.
 type ProvisionStatus struct {
@@ -288,13 +449,15 @@ type ProvisionStatus struct {
 	ConfirmCounts int32 `protobuf:"varint,2,opt,name=confirm_counts,json=confirmCounts,proto3" json:"confirm_counts,omitempty"`
 	// whether the provision has been claimed
 	IsClaimed bool `protobuf:"varint,3,opt,name=is_claimed,json=isClaimed,proto3" json:"is_claimed,omitempty"`
+	// the timelock end before the provision was held, which is restored when it is released
+	HeldTimelockEnd uint64 `protobuf:"varint,4,opt,name=held_timelock_end,json=heldTimelockEnd,proto3" json:"held_timelock_end,omitempty"`
 }
 
 func (m *ProvisionStatus) Reset()         { *m = ProvisionStatus{} }
 func (m *ProvisionStatus) String() string { return proto.CompactTextString(m) }
 func (*ProvisionStatus) ProtoMessage()    {}
 func (*ProvisionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{2}
+	return fileDescriptor_62374d75fc6aa1ba, []int{3}
 }
 func (m *ProvisionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +507,13 @@ func (m *ProvisionStatus) GetIsClaimed() bool {
 	return false
 }
 
+func (m *ProvisionStatus) GetHeldTimelockEnd() uint64 {
+	if m != nil {
+		return m.HeldTimelockEnd
+	}
+	return 0
+}
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -355,7 +525,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{3}
+	return fileDescriptor_62374d75fc6aa1ba, []int{4}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +577,7 @@ func (m *RolePair) Reset()         { *m = RolePair{} }
 func (m *RolePair) String() string { return proto.CompactTextString(m) }
 func (*RolePair) ProtoMessage()    {}
 func (*RolePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{4}
+	return fileDescriptor_62374d75fc6aa1ba, []int{5}
 }
 func (m *RolePair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +640,7 @@ func (m *RoleProposal) Reset()         { *m = RoleProposal{} }
 func (m *RoleProposal) String() string { return proto.CompactTextString(m) }
 func (*RoleProposal) ProtoMessage()    {}
 func (*RoleProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{5}
+	return fileDescriptor_62374d75fc6aa1ba, []int{6}
 }
 func (m *RoleProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,6 +704,95 @@ func (m *RoleProposal) GetExpiredAt() time.Time {
 	return time.Time{}
 }
 
+// RoleProposalResult defines the final tally of a role proposal which has been removed from the active proposals.
+type RoleProposalResult struct {
+	Proposal RoleProposal   `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+	Status   ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.fbridge.v1.ProposalStatus" json:"status,omitempty"`
+	// the number of yes votes
+	YesCount uint64 `protobuf:"varint,3,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
+	// the number of no votes
+	NoCount uint64 `protobuf:"varint,4,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
+	// the number of guardians when the proposal was finalized
+	GuardianCount uint64 `protobuf:"varint,5,opt,name=guardian_count,json=guardianCount,proto3" json:"guardian_count,omitempty"`
+	// the time the proposal was finalized
+	FinalizedAt time.Time `protobuf:"bytes,6,opt,name=finalized_at,json=finalizedAt,proto3,stdtime" json:"finalized_at"`
+}
+
+func (m *RoleProposalResult) Reset()         { *m = RoleProposalResult{} }
+func (m *RoleProposalResult) String() string { return proto.CompactTextString(m) }
+func (*RoleProposalResult) ProtoMessage()    {}
+func (*RoleProposalResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{7}
+}
+func (m *RoleProposalResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleProposalResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleProposalResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleProposalResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleProposalResult.Merge(m, src)
+}
+func (m *RoleProposalResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleProposalResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleProposalResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleProposalResult proto.InternalMessageInfo
+
+func (m *RoleProposalResult) GetProposal() RoleProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return RoleProposal{}
+}
+
+func (m *RoleProposalResult) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatusEmpty
+}
+
+func (m *RoleProposalResult) GetYesCount() uint64 {
+	if m != nil {
+		return m.YesCount
+	}
+	return 0
+}
+
+func (m *RoleProposalResult) GetNoCount() uint64 {
+	if m != nil {
+		return m.NoCount
+	}
+	return 0
+}
+
+func (m *RoleProposalResult) GetGuardianCount() uint64 {
+	if m != nil {
+		return m.GuardianCount
+	}
+	return 0
+}
+
+func (m *RoleProposalResult) GetFinalizedAt() time.Time {
+	if m != nil {
+		return m.FinalizedAt
+	}
+	return time.Time{}
+}
+
 // Vote defines a vote on a role proposal.
 type Vote struct {
 	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -545,7 +804,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{6}
+	return fileDescriptor_62374d75fc6aa1ba, []int{8}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +868,7 @@ func (m *RoleMetadata) Reset()         { *m = RoleMetadata{} }
 func (m *RoleMetadata) String() string { return proto.CompactTextString(m) }
 func (*RoleMetadata) ProtoMessage()    {}
 func (*RoleMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{7}
+	return fileDescriptor_62374d75fc6aa1ba, []int{9}
 }
 func (m *RoleMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -671,7 +930,7 @@ func (m *BridgeStatusMetadata) Reset()         { *m = BridgeStatusMetadata{} }
 func (m *BridgeStatusMetadata) String() string { return proto.CompactTextString(m) }
 func (*BridgeStatusMetadata) ProtoMessage()    {}
 func (*BridgeStatusMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{8}
+	return fileDescriptor_62374d75fc6aa1ba, []int{10}
 }
 func (m *BridgeStatusMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,87 +973,227 @@ func (m *BridgeStatusMetadata) GetActive() uint64 {
 	return 0
 }
 
+// Route defines a bridge route of a denom to a destination chain.
+// The default route bridges params.target_denom and is not represented as a Route.
+type Route struct {
+	// the bank denom to be bridged
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the validator of the receiver address on the destination chain
+	ReceiverValidator ReceiverValidator `protobuf:"varint,3,opt,name=receiver_validator,json=receiverValidator,proto3,enum=lbm.fbridge.v1.ReceiverValidator" json:"receiver_validator,omitempty"`
+	// the status of the route. Transfers through an inactive route are rejected.
+	Status BridgeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"status,omitempty"`
+	// maximum amount of a single outbound transfer through the route. Zero means no limit.
+	MaxTransferAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_transfer_amount"`
+	// maximum total amount of outbound transfers through the route within the rolling window.
+	// Zero means no cap.
+	TransferVolumeCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,6,opt,name=transfer_volume_cap,json=transferVolumeCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_volume_cap"`
+	// length of the rolling window for transfer_volume_cap (nanoseconds)
+	TransferVolumeWindow uint64 `protobuf:"varint,7,opt,name=transfer_volume_window,json=transferVolumeWindow,proto3" json:"transfer_volume_window,omitempty"`
+	// fee charged on each outbound transfer through the route.
+	// The fees are sent to params.fee_destination.
+	TransferFee TransferFee `protobuf:"bytes,8,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{11}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return m.Size()
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Route) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Route) GetReceiverValidator() ReceiverValidator {
+	if m != nil {
+		return m.ReceiverValidator
+	}
+	return ReceiverValidatorEmpty
+}
+
+func (m *Route) GetStatus() BridgeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusEmpty
+}
+
+func (m *Route) GetTransferVolumeWindow() uint64 {
+	if m != nil {
+		return m.TransferVolumeWindow
+	}
+	return 0
+}
+
+func (m *Route) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
 func init() {
 	proto.RegisterEnum("lbm.fbridge.v1.Role", Role_name, Role_value)
 	proto.RegisterEnum("lbm.fbridge.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("lbm.fbridge.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("lbm.fbridge.v1.BridgeStatus", BridgeStatus_name, BridgeStatus_value)
+	proto.RegisterEnum("lbm.fbridge.v1.ReceiverValidator", ReceiverValidator_name, ReceiverValidator_value)
 	proto.RegisterType((*Params)(nil), "lbm.fbridge.v1.Params")
+	proto.RegisterType((*TransferFee)(nil), "lbm.fbridge.v1.TransferFee")
 	proto.RegisterType((*ProvisionData)(nil), "lbm.fbridge.v1.ProvisionData")
 	proto.RegisterType((*ProvisionStatus)(nil), "lbm.fbridge.v1.ProvisionStatus")
 	proto.RegisterType((*Fraction)(nil), "lbm.fbridge.v1.Fraction")
 	proto.RegisterType((*RolePair)(nil), "lbm.fbridge.v1.RolePair")
 	proto.RegisterType((*RoleProposal)(nil), "lbm.fbridge.v1.RoleProposal")
+	proto.RegisterType((*RoleProposalResult)(nil), "lbm.fbridge.v1.RoleProposalResult")
 	proto.RegisterType((*Vote)(nil), "lbm.fbridge.v1.Vote")
 	proto.RegisterType((*RoleMetadata)(nil), "lbm.fbridge.v1.RoleMetadata")
 	proto.RegisterType((*BridgeStatusMetadata)(nil), "lbm.fbridge.v1.BridgeStatusMetadata")
+	proto.RegisterType((*Route)(nil), "lbm.fbridge.v1.Route")
 }
 
 func init() { proto.RegisterFile("lbm/fbridge/v1/fbridge.proto", fileDescriptor_62374d75fc6aa1ba) }
 
 var fileDescriptor_62374d75fc6aa1ba = []byte{
-	// 990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x8f, 0x22, 0x45,
-	0x14, 0xa7, 0xd9, 0x1e, 0x84, 0xc7, 0x0c, 0x83, 0x15, 0xb2, 0x62, 0x67, 0x05, 0x24, 0x31, 0x4e,
-	0x26, 0xda, 0xb8, 0x78, 0xf3, 0x06, 0x03, 0x33, 0x81, 0x28, 0x90, 0x1a, 0x66, 0x92, 0x35, 0x26,
-	0xa4, 0xa1, 0x6b, 0xd8, 0x72, 0xe9, 0x2e, 0xac, 0x2e, 0x70, 0xd7, 0x0f, 0x60, 0xcc, 0x9c, 0xf6,
-	0x0b, 0x90, 0xac, 0xf1, 0x93, 0x78, 0xdb, 0xe3, 0x1e, 0x8d, 0x87, 0xd5, 0xcc, 0x5c, 0xbc, 0xf8,
-	0x1d, 0x4c, 0x55, 0x75, 0xf5, 0xc0, 0xc6, 0x64, 0xf7, 0x56, 0xef, 0xd5, 0xef, 0xfd, 0xde, 0xef,
-	0xfd, 0xa9, 0x6e, 0x78, 0xb0, 0x98, 0x06, 0x8d, 0xab, 0x29, 0xa7, 0xfe, 0x9c, 0x34, 0xd6, 0x0f,
-	0xcd, 0xd1, 0x5d, 0x72, 0x26, 0x18, 0x2a, 0x2c, 0xa6, 0x81, 0x6b, 0x5c, 0xeb, 0x87, 0x4e, 0x75,
-	0xce, 0xd8, 0x7c, 0x41, 0x1a, 0xea, 0x76, 0xba, 0xba, 0x6a, 0x08, 0x1a, 0x90, 0x48, 0x78, 0xc1,
-	0x52, 0x07, 0x38, 0xa5, 0x39, 0x9b, 0x33, 0x75, 0x6c, 0xc8, 0x93, 0xf6, 0xd6, 0xff, 0x4d, 0x43,
-	0x66, 0xe4, 0x71, 0x2f, 0x88, 0xd0, 0x08, 0x4a, 0x6c, 0x49, 0xb8, 0x27, 0x18, 0x9f, 0x08, 0xbe,
-	0x8a, 0xc4, 0x64, 0x41, 0xd6, 0x64, 0x51, 0xb6, 0x6a, 0xd6, 0x51, 0xbe, 0x59, 0x76, 0x77, 0x13,
-	0xba, 0xa7, 0xdc, 0x9b, 0x09, 0xca, 0xc2, 0xb6, 0xfd, 0xf2, 0x75, 0x35, 0x85, 0x91, 0x89, 0x1d,
-	0xcb, 0xd0, 0xaf, 0x65, 0xa4, 0x64, 0x9c, 0xaf, 0x3c, 0xee, 0x53, 0x2f, 0xdc, 0x61, 0x4c, 0xbf,
-	0x1b, 0xa3, 0x89, 0xdd, 0x62, 0xec, 0xc3, 0xfb, 0xdf, 0xaf, 0xfc, 0x39, 0xd9, 0xa1, 0xbb, 0xf7,
-	0x4e, 0x74, 0x87, 0x2a, 0x70, 0x8b, 0xeb, 0x53, 0x38, 0x94, 0x3d, 0x5a, 0xb0, 0xd9, 0x93, 0xc9,
-	0x92, 0x70, 0xca, 0xfc, 0xb2, 0x5d, 0xb3, 0x8e, 0x6c, 0x5c, 0x30, 0xee, 0x91, 0xf2, 0x4a, 0xe0,
-	0x92, 0xb3, 0x25, 0x8b, 0xbc, 0x85, 0x01, 0xee, 0x69, 0xa0, 0x71, 0xc7, 0xc0, 0x8f, 0x61, 0x5f,
-	0x78, 0x7c, 0x4e, 0xc4, 0xc4, 0x27, 0x21, 0x0b, 0xca, 0x99, 0x9a, 0x75, 0x94, 0xc3, 0x79, 0xed,
-	0xeb, 0x48, 0x57, 0xfd, 0x57, 0x0b, 0x0e, 0x46, 0x9c, 0xad, 0x69, 0x44, 0x59, 0xd8, 0xf1, 0x84,
-	0x87, 0x8a, 0x70, 0x2f, 0x22, 0x3f, 0xa8, 0x2e, 0xdb, 0x58, 0x1e, 0x51, 0x1f, 0x32, 0x5e, 0xc0,
-	0x56, 0xa1, 0x50, 0x8d, 0xca, 0xb5, 0x9b, 0x52, 0xff, 0x9f, 0xaf, 0xab, 0xc7, 0x73, 0x2a, 0x1e,
-	0xaf, 0xa6, 0xee, 0x8c, 0x05, 0x8d, 0x53, 0x1a, 0x46, 0xb3, 0xc7, 0xd4, 0x6b, 0x5c, 0xc5, 0x87,
-	0xcf, 0x23, 0xff, 0x49, 0x43, 0x3c, 0x5b, 0x92, 0xc8, 0xed, 0x85, 0x02, 0xc7, 0x0c, 0xe8, 0x3e,
-	0x64, 0x22, 0x12, 0xfa, 0x84, 0xab, 0x2e, 0xe5, 0x70, 0x6c, 0x21, 0x07, 0xb2, 0x9c, 0xcc, 0x08,
-	0x5d, 0x13, 0xae, 0xaa, 0xce, 0xe1, 0xc4, 0xae, 0xff, 0x04, 0x87, 0x89, 0xc4, 0x73, 0xe1, 0x89,
-	0x55, 0xa4, 0x2a, 0x33, 0xbd, 0x22, 0xa1, 0x1f, 0xab, 0xcd, 0x1b, 0x5f, 0x37, 0xf4, 0xd1, 0x27,
-	0x50, 0x98, 0xb1, 0xf0, 0x8a, 0xf2, 0x60, 0x32, 0x93, 0xa9, 0x23, 0xa5, 0x7e, 0x0f, 0x1f, 0xc4,
-	0xde, 0x13, 0xe5, 0x44, 0x1f, 0x01, 0xd0, 0x68, 0x32, 0x5b, 0x78, 0x34, 0x20, 0xbe, 0x12, 0x95,
-	0xc5, 0x39, 0x1a, 0x9d, 0x68, 0x47, 0xbd, 0x0f, 0x59, 0x33, 0x37, 0xf4, 0x00, 0x72, 0xe1, 0x2a,
-	0xd0, 0x5b, 0x15, 0x67, 0xbc, 0x73, 0xa0, 0x1a, 0xe4, 0x55, 0x97, 0x69, 0xa8, 0xee, 0xd3, 0x5a,
-	0xd1, 0x96, 0xab, 0x3e, 0x80, 0x2c, 0x66, 0x0b, 0x32, 0xf2, 0x28, 0x47, 0x65, 0x78, 0xcf, 0xf3,
-	0x7d, 0x4e, 0xa2, 0x48, 0x31, 0xe5, 0xb0, 0x31, 0xd1, 0x11, 0xd8, 0x9c, 0x2d, 0x88, 0x22, 0x28,
-	0x34, 0x4b, 0x6f, 0x6e, 0x91, 0x64, 0xc0, 0x0a, 0x51, 0xff, 0xdd, 0x82, 0x7d, 0x45, 0x18, 0x4f,
-	0x1d, 0x15, 0x20, 0x4d, 0x4d, 0x2f, 0xd2, 0xd4, 0x97, 0x4d, 0xd5, 0x1b, 0x41, 0xb4, 0x9e, 0x1c,
-	0x4e, 0x6c, 0x39, 0x08, 0xbd, 0x07, 0x66, 0x10, 0xda, 0x4a, 0xd2, 0xdb, 0x6f, 0x4b, 0x8f, 0x4e,
-	0x00, 0xc8, 0xd3, 0x25, 0xe5, 0xc4, 0x9f, 0x78, 0x42, 0x6d, 0x60, 0xbe, 0xe9, 0xb8, 0xfa, 0xd9,
-	0xbb, 0xe6, 0xd9, 0xbb, 0x63, 0xf3, 0xec, 0xdb, 0x59, 0xb9, 0x36, 0xcf, 0xff, 0xaa, 0x5a, 0x38,
-	0x17, 0xc7, 0xb5, 0x44, 0xfd, 0x47, 0xb0, 0x2f, 0x99, 0x20, 0xa8, 0x0a, 0xf9, 0x64, 0xa7, 0x93,
-	0x1a, 0xc0, 0xb8, 0x7a, 0x3e, 0x2a, 0xc1, 0xde, 0x9a, 0x89, 0xa4, 0x10, 0x6d, 0xa0, 0x26, 0x64,
-	0xd8, 0x52, 0x0e, 0x47, 0x55, 0x51, 0x68, 0x3a, 0x6f, 0xea, 0x95, 0xe4, 0x43, 0x85, 0xc0, 0x31,
-	0xf2, 0x2b, 0xfb, 0x9f, 0x17, 0xd5, 0x54, 0xfd, 0x3b, 0xdd, 0xbb, 0x6f, 0x88, 0xf0, 0x7c, 0xb9,
-	0xf6, 0x0e, 0x64, 0xcd, 0xfb, 0x8e, 0xb3, 0x27, 0xb6, 0xbc, 0x33, 0x5f, 0x93, 0x78, 0xae, 0x89,
-	0x2d, 0x75, 0xa9, 0x87, 0xac, 0x04, 0xd8, 0x58, 0x1b, 0xf5, 0x3e, 0x94, 0xda, 0x4a, 0x83, 0xde,
-	0xd7, 0xed, 0x2c, 0x34, 0x94, 0xeb, 0xb4, 0x26, 0x26, 0x8b, 0xb1, 0xe5, 0x44, 0xe2, 0x1b, 0x9d,
-	0x23, 0xb6, 0x8e, 0x7f, 0xb6, 0xc0, 0x96, 0x52, 0x51, 0x05, 0xf2, 0x17, 0x83, 0xf3, 0x51, 0xf7,
-	0xa4, 0x77, 0xda, 0xeb, 0x76, 0x8a, 0x29, 0xe7, 0xe0, 0x7a, 0x53, 0xcb, 0xc9, 0xab, 0x6e, 0xb0,
-	0x14, 0xcf, 0x50, 0x05, 0xb2, 0x67, 0x17, 0x2d, 0xdc, 0xe9, 0xb5, 0x06, 0x45, 0xcb, 0x29, 0x5e,
-	0x6f, 0x6a, 0xaa, 0xc4, 0x33, 0x53, 0x46, 0x05, 0xb2, 0xc3, 0x51, 0x17, 0xb7, 0xc6, 0x43, 0x5c,
-	0x4c, 0xdf, 0xdd, 0x0f, 0x4d, 0x29, 0x65, 0xd8, 0xeb, 0x5f, 0x74, 0xce, 0xba, 0xc5, 0x7b, 0x77,
-	0xcc, 0x7d, 0x59, 0x8e, 0x63, 0xff, 0xf2, 0x5b, 0x25, 0x25, 0x85, 0xc0, 0x5d, 0x3f, 0xd1, 0x67,
-	0xf0, 0xc1, 0xe5, 0x70, 0xdc, 0x9d, 0x0c, 0x47, 0xe3, 0xde, 0x70, 0x30, 0xd9, 0x95, 0x76, 0x78,
-	0xbd, 0xa9, 0xe5, 0x35, 0x50, 0x8b, 0xab, 0xc3, 0xe1, 0x36, 0xfa, 0x51, 0xf7, 0xbc, 0x68, 0xe9,
-	0x34, 0x1a, 0xf5, 0x88, 0x44, 0xa8, 0x06, 0x85, 0x6d, 0xcc, 0x60, 0x58, 0x4c, 0x3b, 0xfb, 0xd7,
-	0x9b, 0x5a, 0x56, 0x43, 0x06, 0x2c, 0x16, 0xf2, 0xc2, 0x82, 0xfd, 0xed, 0xf6, 0x22, 0x17, 0x3e,
-	0x6c, 0xe3, 0x5e, 0xe7, 0xac, 0x3b, 0x39, 0x1f, 0xb7, 0xc6, 0x17, 0xe7, 0xff, 0x27, 0x46, 0x43,
-	0xb5, 0x98, 0x63, 0x28, 0xed, 0xe2, 0x5b, 0x27, 0xe3, 0xde, 0x65, 0xd7, 0x74, 0x4d, 0x43, 0x5b,
-	0x7a, 0x2c, 0x2e, 0xdc, 0xdf, 0xc5, 0xf6, 0x06, 0x31, 0x3a, 0xed, 0xa0, 0xeb, 0x4d, 0xad, 0xa0,
-	0xd1, 0xbd, 0x78, 0x8c, 0x5a, 0x62, 0xbb, 0xff, 0xf2, 0xa6, 0x62, 0xbd, 0xba, 0xa9, 0x58, 0x7f,
-	0xdf, 0x54, 0xac, 0xe7, 0xb7, 0x95, 0xd4, 0xab, 0xdb, 0x4a, 0xea, 0x8f, 0xdb, 0x4a, 0xea, 0xdb,
-	0x2f, 0xde, 0xfa, 0xd5, 0x7c, 0x9a, 0xfc, 0x65, 0xd5, 0xf7, 0x73, 0x9a, 0x51, 0x8f, 0xe9, 0xcb,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x54, 0x04, 0x35, 0x81, 0x07, 0x00, 0x00,
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x22, 0xc9,
+	0x15, 0x77, 0x63, 0xc0, 0xf0, 0xb0, 0x31, 0xae, 0x25, 0x1e, 0xa6, 0x67, 0x82, 0x7b, 0x91, 0x56,
+	0x19, 0x8d, 0x12, 0xc8, 0x3a, 0xa3, 0xd5, 0x2a, 0x87, 0x95, 0x30, 0xf4, 0x38, 0x90, 0x19, 0x43,
+	0x0a, 0x4c, 0xb2, 0x51, 0xa4, 0x56, 0x41, 0x17, 0xb8, 0x77, 0xfa, 0x0f, 0xe9, 0x6e, 0x98, 0x71,
+	0xce, 0x51, 0x14, 0x71, 0xda, 0x2f, 0x80, 0xb4, 0x52, 0xa4, 0x7c, 0x82, 0x48, 0x39, 0xe7, 0xb6,
+	0xc7, 0x39, 0x45, 0xd1, 0x1e, 0x36, 0xd1, 0xcc, 0x25, 0x1f, 0x21, 0xc7, 0xa8, 0xaa, 0xba, 0x9a,
+	0x3f, 0xf6, 0x6a, 0x77, 0x7d, 0x98, 0x5b, 0xbf, 0x57, 0xef, 0xf7, 0xfe, 0xbf, 0x57, 0xd5, 0xf0,
+	0xd0, 0x1e, 0x3a, 0xb5, 0xf1, 0xd0, 0xb7, 0xcc, 0x09, 0xad, 0xcd, 0x3f, 0x94, 0x9f, 0xd5, 0xa9,
+	0xef, 0x85, 0x1e, 0xca, 0xdb, 0x43, 0xa7, 0x2a, 0x59, 0xf3, 0x0f, 0xd5, 0x93, 0x89, 0xe7, 0x4d,
+	0x6c, 0x5a, 0xe3, 0xa7, 0xc3, 0xd9, 0xb8, 0x16, 0x5a, 0x0e, 0x0d, 0x42, 0xe2, 0x4c, 0x05, 0x40,
+	0x2d, 0x4e, 0xbc, 0x89, 0xc7, 0x3f, 0x6b, 0xec, 0x4b, 0x70, 0x2b, 0x5f, 0xa5, 0x20, 0xdd, 0x25,
+	0x3e, 0x71, 0x02, 0xd4, 0x85, 0xa2, 0x37, 0xa5, 0x3e, 0x09, 0x3d, 0xdf, 0x08, 0xfd, 0x59, 0x10,
+	0x1a, 0x36, 0x9d, 0x53, 0xbb, 0xa4, 0x68, 0xca, 0xa3, 0xdc, 0x69, 0xa9, 0xba, 0x69, 0xb0, 0xfa,
+	0xd4, 0x27, 0xa3, 0xd0, 0xf2, 0xdc, 0xb3, 0xe4, 0x97, 0x5f, 0x9f, 0xec, 0x60, 0x24, 0xb1, 0x7d,
+	0x06, 0x7d, 0xc6, 0x90, 0x4c, 0xe3, 0x64, 0x46, 0x7c, 0xd3, 0x22, 0xee, 0x86, 0xc6, 0xc4, 0x77,
+	0xd3, 0x28, 0xb1, 0x6b, 0x1a, 0xdb, 0x70, 0xf4, 0xd9, 0xcc, 0x9c, 0xd0, 0x0d, 0x75, 0xbb, 0xdf,
+	0x49, 0xdd, 0x21, 0x07, 0xae, 0xe9, 0xfa, 0x11, 0x1c, 0xb2, 0x1c, 0xd9, 0xde, 0xe8, 0x85, 0x31,
+	0xa5, 0xbe, 0xe5, 0x99, 0xa5, 0xa4, 0xa6, 0x3c, 0x4a, 0xe2, 0xbc, 0x64, 0x77, 0x39, 0x97, 0x09,
+	0x4e, 0x7d, 0x6f, 0xea, 0x05, 0xc4, 0x96, 0x82, 0x29, 0x21, 0x28, 0xd9, 0x91, 0xe0, 0xfb, 0xb0,
+	0x1f, 0x12, 0x7f, 0x42, 0x43, 0xc3, 0xa4, 0xae, 0xe7, 0x94, 0xd2, 0x9a, 0xf2, 0x28, 0x8b, 0x73,
+	0x82, 0xd7, 0x64, 0x2c, 0x34, 0x84, 0xf7, 0x1c, 0xf2, 0xca, 0x08, 0x7d, 0xe2, 0x06, 0x63, 0xea,
+	0x1b, 0xc4, 0xf1, 0x66, 0x6e, 0x58, 0xda, 0x63, 0x92, 0x67, 0xa7, 0xcc, 0xd1, 0xaf, 0xbe, 0x3e,
+	0x79, 0x3c, 0xb1, 0xc2, 0xab, 0xd9, 0xb0, 0x3a, 0xf2, 0x9c, 0xda, 0x53, 0xcb, 0x0d, 0x46, 0x57,
+	0x16, 0xa9, 0x8d, 0xa3, 0x8f, 0x9f, 0x04, 0xe6, 0x8b, 0x5a, 0x78, 0x3d, 0xa5, 0x41, 0xb5, 0xe5,
+	0x86, 0xf8, 0xc8, 0x21, 0xaf, 0xfa, 0x91, 0xb6, 0x3a, 0x57, 0xc6, 0x6c, 0xc4, 0xfa, 0xe7, 0x9e,
+	0x3d, 0x73, 0xa8, 0x31, 0x22, 0xd3, 0x52, 0xe6, 0xee, 0x36, 0xa4, 0xba, 0x01, 0xd7, 0xd6, 0x20,
+	0x53, 0xf4, 0x04, 0x8e, 0xb7, 0x6d, 0xbc, 0xb4, 0x5c, 0xd3, 0x7b, 0x59, 0xca, 0xf2, 0xd4, 0x14,
+	0x37, 0x21, 0xbf, 0xe6, 0x67, 0xa8, 0x09, 0xfb, 0x31, 0x6a, 0x4c, 0x69, 0x09, 0x78, 0xe5, 0x1e,
+	0x6c, 0x57, 0x4e, 0xc6, 0xf3, 0x94, 0xd2, 0xa8, 0x78, 0xb9, 0x70, 0xc5, 0x62, 0xf5, 0x18, 0x53,
+	0x6a, 0x98, 0x34, 0x08, 0x2d, 0x97, 0xb0, 0x12, 0x97, 0x72, 0x3c, 0xd3, 0xf9, 0x31, 0xa5, 0xcd,
+	0x15, 0xb7, 0xf2, 0x5a, 0x81, 0xdc, 0x9a, 0x2e, 0xd4, 0x83, 0xdc, 0xd8, 0x26, 0xa1, 0x4c, 0xba,
+	0x72, 0xe7, 0x84, 0x00, 0x53, 0x13, 0x65, 0xfb, 0x3e, 0x64, 0x7c, 0x12, 0x52, 0x63, 0x38, 0x0d,
+	0x78, 0x63, 0x1f, 0xe0, 0x3d, 0x46, 0x9f, 0x4d, 0x03, 0xf4, 0x2b, 0x00, 0xc7, 0x72, 0xa5, 0xb9,
+	0xdd, 0x3b, 0x9b, 0xcb, 0x3a, 0x96, 0x2b, 0xac, 0x55, 0xfe, 0xae, 0xc0, 0x41, 0xd7, 0xf7, 0xe6,
+	0x56, 0x60, 0x79, 0x6e, 0x93, 0x84, 0x04, 0x15, 0x60, 0x37, 0xa0, 0xbf, 0xe7, 0xc1, 0x24, 0x31,
+	0xfb, 0x44, 0x6d, 0x48, 0x47, 0x26, 0x13, 0x77, 0x36, 0x19, 0x69, 0x40, 0xc7, 0x90, 0x0e, 0xa8,
+	0x6b, 0x52, 0x5f, 0xb8, 0x8f, 0x23, 0x0a, 0xa9, 0x90, 0xf1, 0xe9, 0x88, 0x5a, 0x73, 0xea, 0xf3,
+	0xa9, 0xc9, 0xe2, 0x98, 0x46, 0x45, 0x48, 0x89, 0xfe, 0x4f, 0xf1, 0x03, 0x41, 0x54, 0xfe, 0xaa,
+	0xc0, 0x61, 0xec, 0x79, 0x2f, 0x24, 0xe1, 0x2c, 0xe0, 0x03, 0x23, 0x47, 0x90, 0xba, 0x66, 0x14,
+	0x44, 0x4e, 0xf2, 0x74, 0xd7, 0x44, 0x1f, 0x40, 0x7e, 0xe4, 0xb9, 0x63, 0xcb, 0x77, 0x8c, 0x11,
+	0xf3, 0x48, 0x24, 0x39, 0x85, 0x0f, 0x22, 0x6e, 0x83, 0x33, 0xd1, 0x0f, 0x01, 0xac, 0xc0, 0x18,
+	0xd9, 0xc4, 0x72, 0xa8, 0xc9, 0x7d, 0xcd, 0xe0, 0xac, 0x15, 0x34, 0x04, 0x03, 0x3d, 0x86, 0xa3,
+	0x2b, 0x6a, 0x9b, 0xc6, 0x86, 0x35, 0x31, 0xed, 0x87, 0xec, 0xa0, 0xbf, 0xb2, 0x58, 0x69, 0x43,
+	0x46, 0xae, 0x0e, 0xf4, 0x10, 0xb2, 0xee, 0xcc, 0x11, 0x8b, 0x2d, 0xf2, 0x6e, 0xc5, 0x40, 0x1a,
+	0xe4, 0x78, 0x6c, 0xac, 0xdf, 0x3c, 0x9f, 0x3b, 0x96, 0xc4, 0xeb, 0xac, 0xca, 0x05, 0x64, 0xb0,
+	0x67, 0xd3, 0x2e, 0xb1, 0x7c, 0x54, 0x82, 0x3d, 0x62, 0x9a, 0x3e, 0x0d, 0x02, 0xd1, 0x79, 0x58,
+	0x92, 0xe8, 0x11, 0x24, 0x7d, 0xcf, 0xa6, 0x5c, 0x41, 0xfe, 0xb4, 0xb8, 0x3d, 0x0e, 0x4c, 0x03,
+	0xe6, 0x12, 0x95, 0x7f, 0x28, 0xb0, 0xcf, 0x15, 0x46, 0x8b, 0x07, 0xe5, 0x21, 0x61, 0xc9, 0xbc,
+	0x25, 0x2c, 0x93, 0xd5, 0x45, 0x2c, 0x25, 0x2a, 0xfc, 0xc9, 0xe2, 0x98, 0x66, 0xb5, 0x14, 0xab,
+	0x48, 0xd6, 0x52, 0x50, 0xb1, 0xf9, 0xe4, 0xb7, 0x99, 0x47, 0x0d, 0x00, 0xfa, 0x6a, 0x6a, 0xf9,
+	0xd4, 0x34, 0x48, 0xc8, 0xcb, 0x9b, 0x3b, 0x55, 0xab, 0xe2, 0xe6, 0xa9, 0xca, 0x9b, 0xa7, 0xda,
+	0x97, 0x37, 0xcf, 0x59, 0x86, 0x75, 0xde, 0xe7, 0xff, 0x3e, 0x51, 0x70, 0x36, 0xc2, 0xd5, 0xc3,
+	0xca, 0xdf, 0x12, 0x80, 0xd6, 0x63, 0xc0, 0x34, 0x98, 0xd9, 0x21, 0xfa, 0x44, 0x7a, 0x4e, 0xe4,
+	0x95, 0xf3, 0xf0, 0x36, 0x4f, 0x24, 0x2a, 0x5a, 0x0c, 0x31, 0x06, 0x7d, 0x04, 0xe9, 0x80, 0x77,
+	0x55, 0x94, 0xc6, 0xf2, 0x36, 0x5a, 0x22, 0x45, 0xef, 0xe1, 0x48, 0x1a, 0x3d, 0x80, 0xec, 0x35,
+	0x0d, 0x44, 0x73, 0xf1, 0xc4, 0x24, 0x71, 0xe6, 0x9a, 0x06, 0x0d, 0x39, 0xdc, 0xae, 0x17, 0x9d,
+	0x89, 0x76, 0xd9, 0x73, 0x3d, 0x71, 0xf4, 0x01, 0xe4, 0xe3, 0xcb, 0x4d, 0x08, 0x88, 0x4b, 0xe1,
+	0x40, 0x72, 0x85, 0xd8, 0x39, 0xec, 0x8f, 0x2d, 0x97, 0xd8, 0xd6, 0x1f, 0x44, 0xd2, 0xd2, 0xdf,
+	0x23, 0x69, 0xb9, 0x18, 0x59, 0x0f, 0x2b, 0x2f, 0x21, 0x39, 0xf0, 0x42, 0x8a, 0x4e, 0x20, 0x17,
+	0xdf, 0x46, 0x71, 0xe9, 0x41, 0xb2, 0x5a, 0x26, 0x1b, 0xbf, 0xb9, 0x17, 0xc6, 0xf5, 0x17, 0x04,
+	0x3a, 0x85, 0xb4, 0x37, 0xe5, 0xbb, 0x72, 0x97, 0xa7, 0x47, 0xdd, 0x4e, 0x0f, 0x53, 0xde, 0xe1,
+	0x12, 0x38, 0x92, 0xfc, 0x79, 0xf2, 0xbf, 0x5f, 0x9c, 0xec, 0x54, 0x7e, 0x27, 0x5a, 0xee, 0x39,
+	0x0d, 0x89, 0xc9, 0x16, 0x8e, 0x0a, 0x19, 0x19, 0x62, 0x64, 0x3d, 0xa6, 0xd9, 0x99, 0x7c, 0x07,
+	0x44, 0xe3, 0x10, 0xd3, 0xcc, 0x2f, 0x7e, 0x05, 0x47, 0x49, 0x16, 0x44, 0xa5, 0x0d, 0xc5, 0x33,
+	0xee, 0x83, 0x28, 0xcb, 0xba, 0x15, 0xcb, 0x65, 0x53, 0x38, 0xa7, 0xd2, 0x8a, 0xa4, 0x59, 0x23,
+	0x47, 0x27, 0xc2, 0x46, 0x44, 0x55, 0xfe, 0x98, 0x84, 0x14, 0xf6, 0x66, 0x21, 0x5d, 0xad, 0x20,
+	0x65, 0x6d, 0x05, 0xb1, 0x6a, 0x8e, 0xae, 0x88, 0xe5, 0xb2, 0xbc, 0x89, 0xe4, 0xec, 0x71, 0xba,
+	0x65, 0xa2, 0x2e, 0x20, 0xb9, 0xbf, 0x8c, 0x39, 0xb1, 0x2d, 0x93, 0x87, 0x20, 0x52, 0xf5, 0xfe,
+	0x8d, 0x3e, 0x8c, 0x24, 0x07, 0x52, 0x10, 0x1f, 0xf9, 0xdb, 0x2c, 0xf4, 0x24, 0xee, 0x47, 0x31,
+	0x57, 0x37, 0xba, 0x79, 0x3d, 0xec, 0xb8, 0x1b, 0xbf, 0xe1, 0x7d, 0x90, 0x7a, 0x07, 0xef, 0x83,
+	0xf4, 0xbb, 0x79, 0x1f, 0xec, 0x7d, 0x8f, 0xf7, 0x41, 0xe6, 0x2e, 0xef, 0x83, 0xc7, 0x7f, 0x52,
+	0x20, 0xc9, 0x3a, 0x16, 0x95, 0x21, 0x77, 0x79, 0xd1, 0xeb, 0xea, 0x8d, 0xd6, 0xd3, 0x96, 0xde,
+	0x2c, 0xec, 0xa8, 0x07, 0x8b, 0xa5, 0x96, 0x65, 0x47, 0xba, 0x33, 0x0d, 0xaf, 0x51, 0x19, 0x32,
+	0xe7, 0x97, 0x75, 0xdc, 0x6c, 0xd5, 0x2f, 0x0a, 0x8a, 0x5a, 0x58, 0x2c, 0x35, 0xde, 0xe9, 0xe7,
+	0xb2, 0x9b, 0xcb, 0x90, 0xe9, 0x74, 0x75, 0x5c, 0xef, 0x77, 0x70, 0x21, 0xb1, 0x3a, 0xef, 0xc8,
+	0x8e, 0x2e, 0x41, 0xaa, 0x7d, 0xd9, 0x3c, 0xd7, 0x0b, 0xbb, 0x2b, 0xcd, 0x6d, 0xd6, 0xd5, 0x6a,
+	0xf2, 0xcf, 0x7f, 0x29, 0xef, 0x30, 0x47, 0x60, 0x35, 0x56, 0xe8, 0xc7, 0x70, 0x6f, 0xd0, 0xe9,
+	0xeb, 0x46, 0xa7, 0xdb, 0x6f, 0x75, 0x2e, 0x8c, 0x4d, 0xd7, 0x0e, 0x17, 0x4b, 0x2d, 0x27, 0x04,
+	0x85, 0x73, 0x15, 0x38, 0x5c, 0x97, 0xfe, 0x54, 0xef, 0x15, 0x14, 0x61, 0x46, 0x48, 0x7d, 0x4a,
+	0x03, 0xa4, 0x41, 0x7e, 0x5d, 0xe6, 0xa2, 0x53, 0x48, 0xa8, 0xfb, 0x8b, 0xa5, 0x96, 0x11, 0x22,
+	0x17, 0x5e, 0xe4, 0xc8, 0xff, 0x14, 0xc8, 0x6f, 0xae, 0x3f, 0xf4, 0x31, 0x3c, 0xe8, 0xe2, 0x4e,
+	0xb7, 0xd3, 0xab, 0x3f, 0x33, 0x7a, 0xfd, 0x7a, 0xff, 0xb2, 0xb7, 0xe5, 0xd0, 0xbd, 0xc5, 0x52,
+	0x7b, 0x6f, 0x13, 0x24, 0x1c, 0x7b, 0x02, 0xc7, 0xdb, 0xc8, 0x6e, 0xbd, 0xd7, 0xd3, 0x9b, 0x05,
+	0x45, 0x2d, 0x2d, 0x96, 0x5a, 0x71, 0x13, 0xd4, 0x25, 0x41, 0x40, 0x4d, 0xf4, 0x31, 0x94, 0xb6,
+	0x51, 0x58, 0x6f, 0xeb, 0x8d, 0xbe, 0xde, 0x2c, 0x24, 0x54, 0x75, 0xb1, 0xd4, 0x8e, 0x37, 0x71,
+	0x98, 0x7e, 0x46, 0x47, 0x21, 0x35, 0xd1, 0x47, 0x70, 0x6f, 0x1b, 0xa9, 0xff, 0xa6, 0xdb, 0xc2,
+	0x7a, 0xb3, 0xb0, 0xab, 0xde, 0x5f, 0x2c, 0xb5, 0x1f, 0x6c, 0x79, 0x29, 0x6e, 0x9a, 0x28, 0xf4,
+	0x2f, 0x14, 0xd8, 0x5f, 0x9f, 0x34, 0x54, 0x85, 0xfb, 0x67, 0xb8, 0xd5, 0x3c, 0xd7, 0x6f, 0x0f,
+	0x9b, 0xd7, 0x61, 0x3d, 0xdc, 0xc7, 0x50, 0xdc, 0x94, 0xaf, 0x37, 0xfa, 0xad, 0x81, 0x2e, 0x1b,
+	0x46, 0x88, 0xd6, 0xc5, 0x62, 0xaa, 0xc2, 0xf1, 0xa6, 0x6c, 0xeb, 0x22, 0x92, 0x4e, 0xa8, 0x68,
+	0xb1, 0xd4, 0xf2, 0x42, 0xba, 0x15, 0x2d, 0xb2, 0xc8, 0xc5, 0x7f, 0x2a, 0x70, 0x74, 0x63, 0xa5,
+	0xa0, 0x4f, 0xa0, 0x8c, 0xf5, 0x86, 0xde, 0x1a, 0xe8, 0xd8, 0x18, 0xd4, 0x9f, 0xb5, 0x9a, 0xac,
+	0x0d, 0xb7, 0x9c, 0xe5, 0x69, 0xbb, 0x01, 0x15, 0x7e, 0xeb, 0x70, 0x72, 0x0b, 0x5e, 0x1f, 0x3c,
+	0x37, 0x1a, 0xbf, 0xd0, 0x1b, 0xbf, 0xec, 0x5d, 0x3e, 0x2f, 0x28, 0xaa, 0xb6, 0x58, 0x6a, 0x0f,
+	0x6f, 0x2a, 0x18, 0x3c, 0x6f, 0x5c, 0xd1, 0xd1, 0x8b, 0x60, 0xe6, 0xb0, 0x6a, 0xdf, 0xae, 0xa6,
+	0x90, 0x10, 0xd5, 0xbe, 0x0d, 0x2d, 0x02, 0x3b, 0x6b, 0x7f, 0xf9, 0xa6, 0xac, 0xbc, 0x7e, 0x53,
+	0x56, 0xfe, 0xf3, 0xa6, 0xac, 0x7c, 0xfe, 0xb6, 0xbc, 0xf3, 0xfa, 0x6d, 0x79, 0xe7, 0x5f, 0x6f,
+	0xcb, 0x3b, 0xbf, 0xfd, 0xe9, 0xb7, 0x6e, 0x97, 0x57, 0xf1, 0xaf, 0x2f, 0xdf, 0x33, 0xc3, 0x34,
+	0xbf, 0x29, 0x7f, 0xf6, 0xff, 0x01, 0x00, 0xb8, 0x10, 0xd0, 0xa0, 0x16, 0x0f, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -817,6 +1216,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeDestination) > 0 {
+		i -= len(m.FeeDestination)
+		copy(dAtA[i:], m.FeeDestination)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.FeeDestination)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.TransferVolumeWindow != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.TransferVolumeWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.TransferVolumeCap.Size()
+		i -= size
+		if _, err := m.TransferVolumeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxTransferAmount.Size()
+		i -= size
+		if _, err := m.MaxTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.TargetDenom) > 0 {
 		i -= len(m.TargetDenom)
 		copy(dAtA[i:], m.TargetDenom)
@@ -867,6 +1308,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RateBps != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.RateBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.FlatAmount.Size()
+		i -= size
+		if _, err := m.FlatAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProvisionData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -887,6 +1376,13 @@ func (m *ProvisionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -939,6 +1435,11 @@ func (m *ProvisionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HeldTimelockEnd != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.HeldTimelockEnd))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IsClaimed {
 		i--
 		if m.IsClaimed {
@@ -1050,12 +1551,12 @@ func (m *RoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiredAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiredAt):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiredAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiredAt):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintFbridge(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintFbridge(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
 	if m.Role != 0 {
@@ -1085,6 +1586,67 @@ func (m *RoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoleProposalResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleProposalResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleProposalResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FinalizedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FinalizedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFbridge(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	if m.GuardianCount != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.GuardianCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NoCount != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.NoCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.YesCount != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.YesCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1196,6 +1758,88 @@ func (m *BridgeStatusMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TransferVolumeWindow != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.TransferVolumeWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TransferVolumeCap.Size()
+		i -= size
+		if _, err := m.TransferVolumeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxTransferAmount.Size()
+		i -= size
+		if _, err := m.MaxTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceiverValidator != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.ReceiverValidator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFbridge(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFbridge(v)
 	base := offset
@@ -1229,6 +1873,35 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFbridge(uint64(l))
 	}
+	l = m.MaxTransferAmount.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.TransferVolumeCap.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	if m.TransferVolumeWindow != 0 {
+		n += 1 + sovFbridge(uint64(m.TransferVolumeWindow))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = len(m.FeeDestination)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FlatAmount.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	if m.RateBps != 0 {
+		n += 1 + sovFbridge(uint64(m.RateBps))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovFbridge(uint64(l))
 	return n
 }
 
@@ -1251,6 +1924,10 @@ func (m *ProvisionData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFbridge(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
 	return n
 }
 
@@ -1269,6 +1946,9 @@ func (m *ProvisionStatus) Size() (n int) {
 	if m.IsClaimed {
 		n += 2
 	}
+	if m.HeldTimelockEnd != 0 {
+		n += 1 + sovFbridge(uint64(m.HeldTimelockEnd))
+	}
 	return n
 }
 
@@ -1328,6 +2008,31 @@ func (m *RoleProposal) Size() (n int) {
 	return n
 }
 
+func (m *RoleProposalResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovFbridge(uint64(m.Status))
+	}
+	if m.YesCount != 0 {
+		n += 1 + sovFbridge(uint64(m.YesCount))
+	}
+	if m.NoCount != 0 {
+		n += 1 + sovFbridge(uint64(m.NoCount))
+	}
+	if m.GuardianCount != 0 {
+		n += 1 + sovFbridge(uint64(m.GuardianCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FinalizedAt)
+	n += 1 + l + sovFbridge(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1380,6 +2085,38 @@ func (m *BridgeStatusMetadata) Size() (n int) {
 	return n
 }
 
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
+	if m.ReceiverValidator != 0 {
+		n += 1 + sovFbridge(uint64(m.ReceiverValidator))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFbridge(uint64(m.Status))
+	}
+	l = m.MaxTransferAmount.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.TransferVolumeCap.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	if m.TransferVolumeWindow != 0 {
+		n += 1 + sovFbridge(uint64(m.TransferVolumeWindow))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	return n
+}
+
 func sovFbridge(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1584,78 +2321,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFbridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFbridge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProvisionData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFbridge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProvisionData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProvisionData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-			}
-			m.Seq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFbridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1683,13 +2351,13 @@ func (m *ProvisionData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,13 +2385,15 @@ func (m *ProvisionData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.TransferVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeWindow", wireType)
 			}
-			var stringLen uint64
+			m.TransferVolumeWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFbridge
@@ -1733,28 +2403,416 @@ func (m *ProvisionData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TransferVolumeWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFbridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFbridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFbridge(dAtA[iNdEx:])
-			if err != nil {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateBps", wireType)
+			}
+			m.RateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -1859,6 +2917,25 @@ func (m *ProvisionStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsClaimed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldTimelockEnd", wireType)
+			}
+			m.HeldTimelockEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldTimelockEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFbridge(dAtA[iNdEx:])
@@ -2183,9 +3260,201 @@ func (m *RoleProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleProposalResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleProposalResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleProposalResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			m.YesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			m.NoCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianCount", wireType)
 			}
-			m.Role = 0
+			m.GuardianCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFbridge
@@ -2195,14 +3464,14 @@ func (m *RoleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= Role(b&0x7F) << shift
+				m.GuardianCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2229,7 +3498,7 @@ func (m *RoleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiredAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FinalizedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2569,6 +3838,278 @@ func (m *BridgeStatusMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverValidator", wireType)
+			}
+			m.ReceiverValidator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverValidator |= ReceiverValidator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeWindow", wireType)
+			}
+			m.TransferVolumeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferVolumeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFbridge(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
@@ -40,6 +41,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := validateReceivingState(data.ReceivingState); err != nil {
+		return err
+	}
+
 	if data.NextRoleProposalId < 1 {
 		return errors.New("next role proposal ID must be positive")
 	}
@@ -55,6 +60,20 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	chkResult := make(map[uint64]struct{})
+	for _, v := range data.RoleProposalResults {
+		if v.Proposal.Id < 1 || v.Proposal.Id >= data.NextRoleProposalId {
+			return fmt.Errorf("invalid role proposal ID of the result: %d", v.Proposal.Id)
+		}
+		if _, ok := chkResult[v.Proposal.Id]; ok {
+			return fmt.Errorf("duplicate result of role proposal %d", v.Proposal.Id)
+		}
+		chkResult[v.Proposal.Id] = struct{}{}
+		if err := IsValidProposalStatus(v.Status); err != nil {
+			return err
+		}
+	}
+
 	for _, v := range data.Votes {
 		if v.ProposalId < 1 {
 			return errors.New("role proposal ID must be positive")
@@ -77,22 +96,72 @@ func ValidateGenesis(data GenesisState) error {
 		if err := IsValidBridgeStatus(v.Status); err != nil {
 			return err
 		}
+		if v.HaltExpiresAt != nil && v.Status != StatusInactive {
+			return errors.New("halt expiry time of an active bridge switch")
+		}
+	}
+
+	chkRoute := make(map[string]struct{})
+	for _, v := range data.Routes {
+		if err := v.Route.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(RouteKey(v.Route.Denom, v.Route.ChainId))
+		if _, ok := chkRoute[key]; ok {
+			return errors.New("duplicate route")
+		}
+		chkRoute[key] = struct{}{}
+
+		if err := validateSeqToBlocknums(v.NextSeq, v.SeqToBlocknum); err != nil {
+			return fmt.Errorf("route %s/%s: %w", v.Route.Denom, v.Route.ChainId, err)
+		}
 	}
 
 	return nil
 }
 
 func validateSendingState(state SendingState) error {
-	if state.NextSeq < 1 {
+	if err := validateSeqToBlocknums(state.NextSeq, state.SeqToBlocknum); err != nil {
+		return err
+	}
+
+	chkSeq := make(map[uint64]struct{})
+	for _, v := range state.SeqToBlocknum {
+		chkSeq[v.Seq] = struct{}{}
+	}
+
+	chkVolumeSeq := make(map[uint64]struct{})
+	for _, v := range state.TransferVolumes {
+		if _, ok := chkSeq[v.Seq]; !ok {
+			return errors.New("transfer volume of unknown sequence")
+		}
+
+		if _, ok := chkVolumeSeq[v.Seq]; ok {
+			return errors.New("duplicate transfer volume")
+		}
+
+		if v.Amount.IsNil() || !v.Amount.IsPositive() {
+			return errors.New("transfer volume amount must be positive")
+		}
+
+		chkVolumeSeq[v.Seq] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateSeqToBlocknums(nextSeq uint64, infos []BlockSeqInfo) error {
+	if nextSeq < 1 {
 		return errors.New("next sequence must be positive")
 	}
 
-	if state.NextSeq-1 != uint64(len(state.SeqToBlocknum)) {
+	if nextSeq-1 != uint64(len(infos)) {
 		return errors.New("sequence to blocknum mapping is invalid")
 	}
 
 	chkSeq := make(map[uint64]struct{})
-	for _, v := range state.SeqToBlocknum {
+	for _, v := range infos {
 		if v.Blocknum == 0 || v.Seq == 0 {
 			return errors.New("blocknum and seq must be positive")
 		}
@@ -106,3 +175,60 @@ func validateSendingState(state SendingState) error {
 
 	return nil
 }
+
+func validateReceivingState(state ReceivingState) error {
+	for _, v := range state.GreatestSeqByOperator {
+		if _, err := sdk.AccAddressFromBech32(v.Operator); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range state.GreatestConsecutiveSeqByOperator {
+		if _, err := sdk.AccAddressFromBech32(v.Operator); err != nil {
+			return err
+		}
+	}
+
+	for _, seq := range state.PendingClaimSeqs {
+		if seq == 0 {
+			return errors.New("pending claim seq must be positive")
+		}
+	}
+
+	for _, v := range state.Commitments {
+		if _, err := sdk.AccAddressFromBech32(v.Operator); err != nil {
+			return err
+		}
+		if v.Seq == 0 {
+			return errors.New("commitment seq must be positive")
+		}
+		if _, err := DecodeCommitment(v.Commitment); err != nil {
+			return err
+		}
+	}
+
+	provisions := make(map[string]struct{})
+	for _, v := range state.Provisions {
+		if v.Data == nil || v.Status == nil {
+			return errors.New("provision data and status must be set")
+		}
+		if v.Data.Seq == 0 {
+			return errors.New("provision seq must be positive")
+		}
+		if EncodeCommitment(v.Data.Commitment()) != v.Commitment {
+			return errors.New("commitment does not match the provision data")
+		}
+		provisions[v.Commitment] = struct{}{}
+	}
+
+	for _, v := range state.ConfirmedSeqToCommitment {
+		if v.Seq == 0 {
+			return errors.New("confirmed seq must be positive")
+		}
+		if _, ok := provisions[v.Commitment]; !ok {
+			return errors.New("confirmed commitment has no provision")
+		}
+	}
+
+	return nil
+}
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -41,6 +46,10 @@ type GenesisState struct {
 	Roles []RolePair `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles"`
 	// bridge_switches defines the status of whether each guardian has allowed the bridge to operate.
 	BridgeSwitches []BridgeSwitch `protobuf:"bytes,8,rep,name=bridge_switches,json=bridgeSwitches,proto3" json:"bridge_switches"`
+	// routes defines the bridge routes other than the default route and their sending states.
+	Routes []RouteState `protobuf:"bytes,9,rep,name=routes,proto3" json:"routes"`
+	// role_proposal_results defines the final tallies of the past role proposals.
+	RoleProposalResults []RoleProposalResult `protobuf:"bytes,10,rep,name=role_proposal_results,json=roleProposalResults,proto3" json:"role_proposal_results"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,11 +141,27 @@ func (m *GenesisState) GetBridgeSwitches() []BridgeSwitch {
 	return nil
 }
 
+func (m *GenesisState) GetRoutes() []RouteState {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleProposalResults() []RoleProposalResult {
+	if m != nil {
+		return m.RoleProposalResults
+	}
+	return nil
+}
+
 type SendingState struct {
 	// the next sequence number of the bridge request (greatest sequence number + 1)
 	NextSeq uint64 `protobuf:"varint,1,opt,name=next_seq,json=nextSeq,proto3" json:"next_seq,omitempty"`
 	// sequence-per-block number mapping
 	SeqToBlocknum []BlockSeqInfo `protobuf:"bytes,2,rep,name=seq_to_blocknum,json=seqToBlocknum,proto3" json:"seq_to_blocknum"`
+	// outbound transfers still counted against the rolling volume cap
+	TransferVolumes []TransferVolume `protobuf:"bytes,3,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
 }
 
 func (m *SendingState) Reset()         { *m = SendingState{} }
@@ -172,6 +197,77 @@ func (m *SendingState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SendingState proto.InternalMessageInfo
 
+type RouteState struct {
+	Route Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+	// the next sequence number of the route (greatest sequence number + 1)
+	NextSeq uint64 `protobuf:"varint,2,opt,name=next_seq,json=nextSeq,proto3" json:"next_seq,omitempty"`
+	// sequence-per-block number mapping of the route
+	SeqToBlocknum []BlockSeqInfo `protobuf:"bytes,3,rep,name=seq_to_blocknum,json=seqToBlocknum,proto3" json:"seq_to_blocknum"`
+	// outbound transfers of the route counted against its volume cap
+	TransferVolumes []TransferVolume `protobuf:"bytes,4,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
+}
+
+func (m *RouteState) Reset()         { *m = RouteState{} }
+func (m *RouteState) String() string { return proto.CompactTextString(m) }
+func (*RouteState) ProtoMessage()    {}
+func (*RouteState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc3cc4535a29f6d, []int{2}
+}
+func (m *RouteState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteState.Merge(m, src)
+}
+func (m *RouteState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteState proto.InternalMessageInfo
+
+func (m *RouteState) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+func (m *RouteState) GetNextSeq() uint64 {
+	if m != nil {
+		return m.NextSeq
+	}
+	return 0
+}
+
+func (m *RouteState) GetSeqToBlocknum() []BlockSeqInfo {
+	if m != nil {
+		return m.SeqToBlocknum
+	}
+	return nil
+}
+
+func (m *RouteState) GetTransferVolumes() []TransferVolume {
+	if m != nil {
+		return m.TransferVolumes
+	}
+	return nil
+}
+
 type BlockSeqInfo struct {
 	Seq      uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 	Blocknum uint64 `protobuf:"varint,2,opt,name=blocknum,proto3" json:"blocknum,omitempty"`
@@ -181,7 +277,7 @@ func (m *BlockSeqInfo) Reset()         { *m = BlockSeqInfo{} }
 func (m *BlockSeqInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockSeqInfo) ProtoMessage()    {}
 func (*BlockSeqInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{2}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{3}
 }
 func (m *BlockSeqInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,6 +320,62 @@ func (m *BlockSeqInfo) GetBlocknum() uint64 {
 	return 0
 }
 
+type TransferVolume struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the block time at which the transfer was made
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// the amount of token transferred
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *TransferVolume) Reset()         { *m = TransferVolume{} }
+func (m *TransferVolume) String() string { return proto.CompactTextString(m) }
+func (*TransferVolume) ProtoMessage()    {}
+func (*TransferVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc3cc4535a29f6d, []int{4}
+}
+func (m *TransferVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferVolume.Merge(m, src)
+}
+func (m *TransferVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferVolume proto.InternalMessageInfo
+
+func (m *TransferVolume) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *TransferVolume) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 type ReceivingState struct {
 	// the greatest consecutive sequence number confirmed by each operator
 	GreatestConsecutiveSeqByOperator []*OperatorSeqInfo `protobuf:"bytes,1,rep,name=greatest_consecutive_seq_by_operator,json=greatestConsecutiveSeqByOperator,proto3" json:"greatest_consecutive_seq_by_operator,omitempty"`
@@ -246,7 +398,7 @@ func (m *ReceivingState) Reset()         { *m = ReceivingState{} }
 func (m *ReceivingState) String() string { return proto.CompactTextString(m) }
 func (*ReceivingState) ProtoMessage()    {}
 func (*ReceivingState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{3}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{5}
 }
 func (m *ReceivingState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +438,7 @@ func (m *OperatorSeqInfo) Reset()         { *m = OperatorSeqInfo{} }
 func (m *OperatorSeqInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorSeqInfo) ProtoMessage()    {}
 func (*OperatorSeqInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{4}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{6}
 }
 func (m *OperatorSeqInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +494,7 @@ func (m *Commitment) Reset()         { *m = Commitment{} }
 func (m *Commitment) String() string { return proto.CompactTextString(m) }
 func (*Commitment) ProtoMessage()    {}
 func (*Commitment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{5}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{7}
 }
 func (m *Commitment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +554,7 @@ func (m *Provision) Reset()         { *m = Provision{} }
 func (m *Provision) String() string { return proto.CompactTextString(m) }
 func (*Provision) ProtoMessage()    {}
 func (*Provision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{6}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{8}
 }
 func (m *Provision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +615,7 @@ func (m *ConfirmedProvision) Reset()         { *m = ConfirmedProvision{} }
 func (m *ConfirmedProvision) String() string { return proto.CompactTextString(m) }
 func (*ConfirmedProvision) ProtoMessage()    {}
 func (*ConfirmedProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{7}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{9}
 }
 func (m *ConfirmedProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,13 +662,15 @@ type BridgeSwitch struct {
 	// the guardian address
 	Guardian string       `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
 	Status   BridgeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"status,omitempty"`
+	// the time the halt of the guardian expires. It is nil if the halt does not expire or the switch is active.
+	HaltExpiresAt *time.Time `protobuf:"bytes,3,opt,name=halt_expires_at,json=haltExpiresAt,proto3,stdtime" json:"halt_expires_at,omitempty"`
 }
 
 func (m *BridgeSwitch) Reset()         { *m = BridgeSwitch{} }
 func (m *BridgeSwitch) String() string { return proto.CompactTextString(m) }
 func (*BridgeSwitch) ProtoMessage()    {}
 func (*BridgeSwitch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{8}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{10}
 }
 func (m *BridgeSwitch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,10 +713,19 @@ func (m *BridgeSwitch) GetStatus() BridgeStatus {
 	return StatusEmpty
 }
 
+func (m *BridgeSwitch) GetHaltExpiresAt() *time.Time {
+	if m != nil {
+		return m.HaltExpiresAt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.fbridge.v1.GenesisState")
 	proto.RegisterType((*SendingState)(nil), "lbm.fbridge.v1.SendingState")
+	proto.RegisterType((*RouteState)(nil), "lbm.fbridge.v1.RouteState")
 	proto.RegisterType((*BlockSeqInfo)(nil), "lbm.fbridge.v1.BlockSeqInfo")
+	proto.RegisterType((*TransferVolume)(nil), "lbm.fbridge.v1.TransferVolume")
 	proto.RegisterType((*ReceivingState)(nil), "lbm.fbridge.v1.ReceivingState")
 	proto.RegisterType((*OperatorSeqInfo)(nil), "lbm.fbridge.v1.OperatorSeqInfo")
 	proto.RegisterType((*Commitment)(nil), "lbm.fbridge.v1.Commitment")
@@ -574,58 +737,72 @@ func init() {
 func init() { proto.RegisterFile("lbm/fbridge/v1/genesis.proto", fileDescriptor_0fc3cc4535a29f6d) }
 
 var fileDescriptor_0fc3cc4535a29f6d = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x13, 0x6f, 0xba, 0x7d, 0x4d, 0x93, 0x6a, 0xd4, 0x56, 0x6e, 0x28, 0x4e, 0x64, 0x71,
-	0xc8, 0x01, 0x92, 0x06, 0x2a, 0xf1, 0x43, 0x95, 0x90, 0xb2, 0xa8, 0xd5, 0x16, 0x21, 0x2a, 0x1b,
-	0x21, 0xd4, 0x8b, 0x19, 0xdb, 0x13, 0xef, 0xa8, 0xb1, 0xc7, 0xf1, 0x4c, 0x42, 0x57, 0xfc, 0x03,
-	0xdc, 0xe0, 0xc6, 0x95, 0x0b, 0xff, 0x4b, 0x8f, 0x7b, 0xe4, 0x84, 0xd0, 0xee, 0x3f, 0x82, 0x66,
-	0x32, 0x76, 0x1c, 0x27, 0x91, 0xe0, 0x36, 0x33, 0xef, 0x7b, 0xdf, 0xfb, 0xe6, 0xf9, 0xf3, 0x1b,
-	0x78, 0xbc, 0x08, 0x92, 0xc9, 0x3c, 0xc8, 0x69, 0x14, 0x93, 0xc9, 0x7a, 0x3a, 0x89, 0x49, 0x4a,
-	0x38, 0xe5, 0xe3, 0x2c, 0x67, 0x82, 0xa1, 0xee, 0x22, 0x48, 0xc6, 0x3a, 0x3a, 0x5e, 0x4f, 0xfb,
-	0xf7, 0x63, 0x16, 0x33, 0x15, 0x9a, 0xc8, 0xd5, 0x06, 0xd5, 0xaf, 0x73, 0x14, 0x09, 0x2a, 0xea,
-	0xfc, 0x6a, 0x42, 0xe7, 0xc5, 0x86, 0xd5, 0x13, 0x58, 0x10, 0xf4, 0x14, 0xda, 0x19, 0xce, 0x71,
-	0xc2, 0x2d, 0x63, 0x68, 0x8c, 0xee, 0x7c, 0xfc, 0x70, 0xbc, 0x5b, 0x65, 0xfc, 0x4a, 0x45, 0x67,
-	0xe6, 0xbb, 0xbf, 0x07, 0x0d, 0x57, 0x63, 0xd1, 0x0b, 0xb8, 0xcb, 0x49, 0x1a, 0xd1, 0x34, 0xf6,
-	0xb9, 0xa4, 0xb1, 0x9a, 0x2a, 0xf9, 0x71, 0x3d, 0xd9, 0xdb, 0x80, 0x54, 0x29, 0x4d, 0xd1, 0xe1,
-	0x95, 0x33, 0xf4, 0x0d, 0xf4, 0x72, 0x12, 0x12, 0xba, 0xde, 0x52, 0xb5, 0x14, 0x95, 0x5d, 0xa7,
-	0x72, 0x0b, 0x58, 0x95, 0xac, 0x9b, 0xef, 0x9c, 0xa2, 0x29, 0x3c, 0x48, 0xc9, 0x5b, 0xe1, 0xe7,
-	0x6c, 0x41, 0xfc, 0x2c, 0x67, 0x19, 0xe3, 0x78, 0xe1, 0xd3, 0xc8, 0x32, 0x87, 0xc6, 0xc8, 0x74,
-	0x91, 0x0c, 0xba, 0x6c, 0x41, 0x5e, 0xe9, 0xd0, 0x79, 0x84, 0xce, 0xa1, 0xbb, 0x83, 0xe6, 0xd6,
-	0xc9, 0xb0, 0x75, 0xe8, 0x2e, 0xd5, 0x3c, 0x5d, 0xfe, 0x6e, 0x5e, 0x39, 0xe3, 0xe8, 0x09, 0x9c,
-	0xac, 0x99, 0x20, 0xdc, 0x6a, 0x2b, 0x86, 0xfb, 0x75, 0x86, 0xef, 0x59, 0x29, 0x7c, 0x03, 0x44,
-	0x4f, 0xe1, 0x44, 0x52, 0x70, 0xeb, 0x96, 0xca, 0xb0, 0x0e, 0xd6, 0xc4, 0x34, 0x2f, 0xb2, 0x14,
-	0x18, 0x7d, 0x0d, 0xbd, 0x0d, 0xc4, 0xe7, 0x3f, 0x51, 0x11, 0x5e, 0x10, 0x6e, 0x9d, 0x1e, 0xd6,
-	0x3c, 0x53, 0x2b, 0x4f, 0xa1, 0x8a, 0x96, 0x05, 0x95, 0x33, 0xc2, 0x9d, 0x9f, 0xa1, 0x53, 0xfd,
-	0x4a, 0xe8, 0x11, 0x9c, 0xaa, 0x16, 0x72, 0xb2, 0x54, 0x96, 0x30, 0xdd, 0x5b, 0x72, 0xef, 0x91,
-	0x25, 0x7a, 0x09, 0x3d, 0x4e, 0x96, 0xbe, 0x60, 0x7e, 0xb0, 0x60, 0xe1, 0x9b, 0x74, 0x95, 0x58,
-	0xcd, 0x23, 0x75, 0x65, 0xdc, 0x23, 0xcb, 0xf3, 0x74, 0xce, 0x8a, 0x5e, 0x71, 0xb2, 0xfc, 0x8e,
-	0xcd, 0x74, 0xe2, 0x17, 0xe6, 0x2f, 0x7f, 0x0c, 0x1a, 0xce, 0x33, 0xe8, 0x54, 0xa1, 0xe8, 0x1e,
-	0xb4, 0xb6, 0x75, 0xe5, 0x12, 0xf5, 0xe1, 0xb4, 0x52, 0x4c, 0x1e, 0x97, 0x7b, 0xe7, 0x4f, 0x13,
-	0xba, 0xbb, 0xb6, 0x40, 0x0c, 0x3e, 0x88, 0x73, 0x82, 0x05, 0xe1, 0xc2, 0x0f, 0x59, 0xca, 0x49,
-	0xb8, 0x12, 0x74, 0x4d, 0xe4, 0x6d, 0xfc, 0xe0, 0xd2, 0x67, 0x19, 0xc9, 0xb1, 0x60, 0xb9, 0x65,
-	0x28, 0xdd, 0x83, 0xba, 0xee, 0x6f, 0x75, 0x5c, 0xeb, 0x71, 0x87, 0x05, 0xd9, 0xd9, 0x96, 0xcb,
-	0x23, 0xcb, 0xd9, 0x65, 0x01, 0x44, 0x3f, 0x80, 0x55, 0x16, 0xac, 0x17, 0x69, 0xfe, 0xb7, 0x22,
-	0x0f, 0x0a, 0x82, 0x5d, 0xe6, 0xcf, 0x2a, 0xcc, 0xb5, 0xab, 0xa8, 0x7f, 0xc4, 0x74, 0x1f, 0x1e,
-	0x56, 0x87, 0x3e, 0x04, 0x94, 0xe9, 0xbf, 0x33, 0x5c, 0x60, 0x9a, 0xc8, 0x14, 0x6e, 0x99, 0xc3,
-	0xd6, 0xc8, 0x74, 0xef, 0xe9, 0xc8, 0x99, 0x0c, 0x78, 0x64, 0xc9, 0xd1, 0x33, 0xb8, 0x13, 0xb2,
-	0x24, 0xa1, 0x22, 0x21, 0xa9, 0x28, 0xbc, 0xdb, 0xaf, 0x8b, 0x3e, 0x2b, 0x21, 0x6e, 0x15, 0x8e,
-	0x3e, 0x07, 0xc8, 0x72, 0xb6, 0xa6, 0x9c, 0xb2, 0xb4, 0xb0, 0xf1, 0xa3, 0xbd, 0x19, 0x52, 0x20,
-	0xdc, 0x0a, 0x18, 0x61, 0x78, 0x2f, 0x64, 0xe9, 0x9c, 0xe6, 0x09, 0x89, 0x7c, 0x6d, 0xac, 0x2d,
-	0xb5, 0xb6, 0xb4, 0xb3, 0x2f, 0x44, 0xa7, 0x6c, 0x49, 0xad, 0x92, 0xc6, 0x93, 0x1e, 0xdb, 0x4a,
-	0xd5, 0x2e, 0xfb, 0x12, 0x7a, 0xb5, 0x9e, 0x4b, 0x5b, 0x55, 0xbc, 0x60, 0x8c, 0x6e, 0xbb, 0xe5,
-	0xbe, 0x30, 0x61, 0xb3, 0x34, 0xa1, 0xf3, 0x1a, 0x60, 0x4b, 0xfa, 0xff, 0x72, 0x91, 0x0d, 0x50,
-	0xb9, 0x54, 0x4b, 0xe1, 0x2b, 0x27, 0xce, 0xef, 0x06, 0xdc, 0x2e, 0xaf, 0x52, 0x43, 0x1b, 0x75,
-	0x34, 0x9a, 0x82, 0x19, 0x61, 0x81, 0xf5, 0xbc, 0x7d, 0xff, 0x68, 0xa3, 0xbf, 0xc2, 0x02, 0xbb,
-	0x0a, 0x8a, 0x3e, 0x85, 0xb6, 0x1c, 0xac, 0x2b, 0xae, 0x27, 0xeb, 0xe0, 0x68, 0x92, 0xa7, 0x60,
-	0xae, 0x86, 0x3b, 0xcf, 0x01, 0xed, 0x37, 0xfb, 0xc0, 0x2f, 0xba, 0xab, 0xb9, 0xb9, 0x77, 0xc3,
-	0x1f, 0xa1, 0x53, 0x9d, 0x43, 0xb2, 0x7f, 0xf1, 0x0a, 0xe7, 0x11, 0xc5, 0x69, 0xd1, 0xbf, 0x62,
-	0x2f, 0x9f, 0x23, 0x2d, 0x56, 0xf2, 0x74, 0x8f, 0x4e, 0xb4, 0x1d, 0xa5, 0xb3, 0x97, 0xef, 0xae,
-	0x6d, 0xe3, 0xea, 0xda, 0x36, 0xfe, 0xb9, 0xb6, 0x8d, 0xdf, 0x6e, 0xec, 0xc6, 0xd5, 0x8d, 0xdd,
-	0xf8, 0xeb, 0xc6, 0x6e, 0xbc, 0x7e, 0x12, 0x53, 0x71, 0xb1, 0x0a, 0xc6, 0x21, 0x4b, 0x26, 0xcf,
-	0x69, 0xca, 0xc3, 0x0b, 0x8a, 0x27, 0x73, 0xbd, 0xf8, 0x88, 0x47, 0x6f, 0x26, 0x6f, 0xcb, 0xc7,
-	0x52, 0x5c, 0x66, 0x84, 0x07, 0x6d, 0xf5, 0x50, 0x7e, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd3, 0xf5, 0xbb, 0x75, 0x8c, 0x07, 0x00, 0x00,
+	// 1040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x77, 0x9d, 0x6d, 0xf2, 0x9a, 0xee, 0x46, 0x43, 0x53, 0xb9, 0xa1, 0xec, 0x46, 0x16,
+	0x87, 0x08, 0x81, 0xb7, 0x29, 0x95, 0x08, 0xa8, 0x12, 0x62, 0x03, 0x2d, 0x09, 0x42, 0xad, 0xbc,
+	0x51, 0x85, 0x2a, 0x24, 0x6b, 0xd6, 0x3b, 0xeb, 0x58, 0xb5, 0x3d, 0xde, 0x99, 0xf1, 0x92, 0x7c,
+	0x03, 0x8e, 0xbd, 0x71, 0xe5, 0x82, 0x40, 0x7c, 0x92, 0x1e, 0x38, 0xf4, 0x88, 0x38, 0x14, 0x94,
+	0x7c, 0x08, 0xae, 0x68, 0x66, 0xc7, 0xbb, 0xb6, 0x77, 0x43, 0x40, 0x70, 0x1b, 0xcf, 0xfb, 0xbd,
+	0xdf, 0xfb, 0x33, 0x6f, 0x7e, 0x63, 0xb8, 0x13, 0x0d, 0xe2, 0xee, 0x68, 0xc0, 0xc2, 0x61, 0x40,
+	0xba, 0x93, 0xbd, 0x6e, 0x40, 0x12, 0xc2, 0x43, 0xee, 0xa4, 0x8c, 0x0a, 0x8a, 0x9a, 0xd1, 0x20,
+	0x76, 0xb4, 0xd5, 0x99, 0xec, 0x6d, 0x77, 0x02, 0x4a, 0x83, 0x88, 0x74, 0x95, 0x75, 0x90, 0x8d,
+	0xba, 0x22, 0x8c, 0x09, 0x17, 0x38, 0x4e, 0xa7, 0x0e, 0xdb, 0x37, 0x03, 0x1a, 0x50, 0xb5, 0xec,
+	0xca, 0x95, 0xde, 0xad, 0x06, 0xc9, 0x19, 0x95, 0xd5, 0xfe, 0x71, 0x15, 0x36, 0x1e, 0x4d, 0xc3,
+	0xf6, 0x05, 0x16, 0x04, 0xdd, 0x87, 0x46, 0x8a, 0x19, 0x8e, 0xb9, 0x65, 0xec, 0x18, 0xbb, 0xd7,
+	0xef, 0xdd, 0x72, 0xca, 0x69, 0x38, 0x4f, 0x94, 0xb5, 0x67, 0xbe, 0x7c, 0xdd, 0x59, 0x71, 0x35,
+	0x16, 0x3d, 0x82, 0x1b, 0x9c, 0x24, 0xc3, 0x30, 0x09, 0x3c, 0x2e, 0x69, 0xac, 0x9a, 0x72, 0xbe,
+	0x53, 0x75, 0xee, 0x4f, 0x41, 0x2a, 0x94, 0xa6, 0xd8, 0xe0, 0x85, 0x3d, 0xf4, 0x25, 0xb4, 0x18,
+	0xf1, 0x49, 0x38, 0x99, 0x53, 0xd5, 0x15, 0x55, 0xbb, 0x4a, 0xe5, 0xe6, 0xb0, 0x22, 0x59, 0x93,
+	0x95, 0x76, 0xd1, 0x1e, 0x6c, 0x25, 0xe4, 0x54, 0x78, 0x8c, 0x46, 0xc4, 0x4b, 0x19, 0x4d, 0x29,
+	0xc7, 0x91, 0x17, 0x0e, 0x2d, 0x73, 0xc7, 0xd8, 0x35, 0x5d, 0x24, 0x8d, 0x2e, 0x8d, 0xc8, 0x13,
+	0x6d, 0x3a, 0x1c, 0xa2, 0x43, 0x68, 0x96, 0xd0, 0xdc, 0x5a, 0xdd, 0xa9, 0x2f, 0xab, 0xa5, 0xe8,
+	0xa7, 0xc3, 0xdf, 0x60, 0x85, 0x3d, 0x8e, 0xee, 0xc2, 0xea, 0x84, 0x0a, 0xc2, 0xad, 0x86, 0x62,
+	0xb8, 0x59, 0x65, 0x78, 0x4a, 0x67, 0x89, 0x4f, 0x81, 0xe8, 0x3e, 0xac, 0x4a, 0x0a, 0x6e, 0x5d,
+	0x53, 0x1e, 0xd6, 0xd2, 0x98, 0x38, 0x64, 0xb9, 0x97, 0x02, 0xa3, 0x2f, 0xa0, 0x35, 0x85, 0x78,
+	0xfc, 0x9b, 0x50, 0xf8, 0x27, 0x84, 0x5b, 0x6b, 0xcb, 0x73, 0xee, 0xa9, 0x55, 0x5f, 0xa1, 0xf2,
+	0x96, 0x0d, 0x0a, 0x7b, 0x84, 0xa3, 0x7d, 0x68, 0x30, 0x9a, 0xc9, 0xac, 0xd7, 0x15, 0xc7, 0xf6,
+	0x62, 0x0e, 0x99, 0x20, 0xc5, 0xa6, 0x6b, 0x3c, 0xfa, 0x1a, 0xb6, 0xca, 0x7d, 0x66, 0x84, 0x67,
+	0x91, 0xe0, 0x16, 0x28, 0x22, 0xfb, 0xef, 0x1a, 0xe8, 0x2a, 0xa8, 0x26, 0x7c, 0x83, 0x2d, 0x58,
+	0xb8, 0xfd, 0x8b, 0x01, 0x1b, 0xc5, 0xf1, 0x41, 0xb7, 0x61, 0x4d, 0x9d, 0x2d, 0x27, 0x63, 0x35,
+	0xab, 0xa6, 0x7b, 0x4d, 0x7e, 0xf7, 0xc9, 0x18, 0x1d, 0x41, 0x8b, 0x93, 0xb1, 0x27, 0xa8, 0x37,
+	0x88, 0xa8, 0xff, 0x3c, 0xc9, 0x62, 0xab, 0x76, 0x49, 0x43, 0xa4, 0xbd, 0x4f, 0xc6, 0x87, 0xc9,
+	0x88, 0xe6, 0x87, 0xc8, 0xc9, 0xf8, 0x98, 0xf6, 0xb4, 0x23, 0x7a, 0x0c, 0x9b, 0x82, 0xe1, 0x84,
+	0x8f, 0x08, 0xf3, 0x26, 0x34, 0xca, 0x62, 0xc2, 0xad, 0xfa, 0x4e, 0x7d, 0xd9, 0x48, 0x1e, 0x6b,
+	0xdc, 0x53, 0x05, 0xd3, 0x74, 0x2d, 0x51, 0xda, 0xe5, 0x1f, 0x99, 0xdf, 0x7e, 0xdf, 0x59, 0xb1,
+	0xff, 0x34, 0x00, 0xe6, 0x9d, 0x44, 0x7b, 0xf2, 0xe0, 0x33, 0x41, 0xf4, 0xad, 0xdb, 0x5a, 0xda,
+	0xf4, 0xf9, 0xa9, 0x67, 0x95, 0xfa, 0x6b, 0x57, 0xd6, 0x5f, 0xff, 0x3f, 0xeb, 0x37, 0xff, 0x43,
+	0xfd, 0xf6, 0x03, 0xd8, 0x28, 0x46, 0x45, 0x9b, 0x50, 0x9f, 0x1f, 0xa1, 0x5c, 0xa2, 0x6d, 0x58,
+	0x2b, 0x9c, 0x9b, 0xdc, 0x9e, 0x7d, 0xdb, 0x3f, 0x19, 0xd0, 0x2c, 0xc7, 0x59, 0x42, 0xb0, 0x0f,
+	0xa6, 0x14, 0x47, 0xad, 0x42, 0xdb, 0xce, 0x54, 0x39, 0x9d, 0x5c, 0x39, 0x9d, 0xe3, 0x5c, 0x39,
+	0x7b, 0x6b, 0x32, 0xc7, 0x17, 0xbf, 0x77, 0x0c, 0x57, 0x79, 0xa0, 0x23, 0x68, 0xe0, 0x98, 0x66,
+	0x89, 0x50, 0xb2, 0xb3, 0xde, 0xbb, 0x27, 0xed, 0xbf, 0xbd, 0xee, 0xbc, 0x13, 0x84, 0xe2, 0x24,
+	0x1b, 0x38, 0x3e, 0x8d, 0xbb, 0x0f, 0xc3, 0x84, 0xfb, 0x27, 0x21, 0xee, 0x8e, 0xf4, 0xe2, 0x3d,
+	0x3e, 0x7c, 0xde, 0x15, 0x67, 0x29, 0xe1, 0xce, 0x61, 0x22, 0x5c, 0xcd, 0x60, 0xff, 0x60, 0x42,
+	0xb3, 0xac, 0x52, 0x88, 0xc2, 0xdb, 0x01, 0x23, 0x58, 0x10, 0x2e, 0x3c, 0x9f, 0x26, 0x9c, 0xf8,
+	0x99, 0x08, 0x27, 0x44, 0x9e, 0xa1, 0x37, 0x38, 0xf3, 0x68, 0x4a, 0x18, 0x16, 0x94, 0x59, 0x86,
+	0x6a, 0x70, 0xa7, 0xda, 0xe0, 0xc7, 0xda, 0xae, 0x5b, 0xe7, 0xee, 0xe4, 0x64, 0x07, 0x73, 0xae,
+	0x3e, 0x19, 0xf7, 0xce, 0x72, 0x20, 0xfa, 0x0a, 0xac, 0x59, 0xc0, 0x6a, 0x90, 0xda, 0x3f, 0x0b,
+	0xb2, 0x95, 0x13, 0x94, 0x99, 0xf7, 0xc1, 0xba, 0xac, 0x14, 0xd5, 0x3b, 0xd3, 0xbd, 0xb5, 0x3c,
+	0x3b, 0xf4, 0x2e, 0xa0, 0x54, 0x3f, 0x16, 0x7e, 0x84, 0xc3, 0x58, 0xba, 0x4c, 0x67, 0xca, 0x74,
+	0x37, 0xb5, 0xe5, 0x40, 0x1a, 0xfa, 0x64, 0xcc, 0xd1, 0x03, 0xb8, 0xee, 0xd3, 0x38, 0x0e, 0x45,
+	0x4c, 0x12, 0x91, 0x4b, 0xe9, 0x82, 0x28, 0x1d, 0xcc, 0x20, 0x6e, 0x11, 0x8e, 0x3e, 0x04, 0x48,
+	0x19, 0x9d, 0x84, 0x3c, 0xa4, 0x49, 0xae, 0xaa, 0xb7, 0x17, 0x9e, 0xb4, 0x1c, 0xe1, 0x16, 0xc0,
+	0x08, 0xc3, 0x9b, 0x3e, 0x4d, 0x46, 0x21, 0x8b, 0xc9, 0xd0, 0xd3, 0xd7, 0x69, 0x4e, 0x6d, 0xad,
+	0x2d, 0x17, 0xb5, 0x83, 0xdc, 0x65, 0x4e, 0x6a, 0xcd, 0x68, 0xfa, 0xf2, 0x66, 0xcd, 0x53, 0xd5,
+	0x52, 0xf0, 0x31, 0xb4, 0x2a, 0x3d, 0x97, 0x37, 0xa0, 0x30, 0x0b, 0xc6, 0xee, 0xba, 0x3b, 0xfb,
+	0xce, 0xc7, 0xbd, 0x36, 0x1b, 0x77, 0xfb, 0x19, 0xc0, 0x9c, 0xf4, 0xdf, 0xf9, 0xa2, 0x36, 0x40,
+	0xa1, 0x28, 0x35, 0xf4, 0x6e, 0x61, 0xc7, 0xfe, 0xce, 0x80, 0xf5, 0x59, 0x29, 0x15, 0xb4, 0x51,
+	0x45, 0xa3, 0x3d, 0x30, 0x87, 0x58, 0x60, 0x7d, 0xf1, 0xde, 0xba, 0xb4, 0xd1, 0x9f, 0x62, 0x81,
+	0x5d, 0x05, 0x45, 0x1f, 0x40, 0x43, 0xbe, 0xf3, 0x19, 0xd7, 0x0f, 0x7d, 0xe7, 0x52, 0xa7, 0xbe,
+	0x82, 0xb9, 0x1a, 0x6e, 0x3f, 0x04, 0xb4, 0xd8, 0xec, 0x25, 0x62, 0x50, 0xce, 0xb9, 0xb6, 0x50,
+	0xe1, 0xcf, 0x06, 0x6c, 0x14, 0xdf, 0x45, 0xd9, 0xc0, 0x20, 0xc3, 0x6c, 0x18, 0xe2, 0x24, 0x6f,
+	0x60, 0xfe, 0x2d, 0x7f, 0x8f, 0x74, 0xb6, 0x92, 0xa8, 0x79, 0xe9, 0x0b, 0x5b, 0x4a, 0x15, 0x7d,
+	0x0e, 0xad, 0x13, 0x1c, 0x09, 0x8f, 0x9c, 0xa6, 0x21, 0x23, 0xdc, 0xc3, 0xc2, 0xaa, 0x5f, 0x29,
+	0x4d, 0xa6, 0x92, 0xa5, 0x1b, 0xd2, 0xf1, 0xb3, 0xa9, 0xdf, 0x27, 0xa2, 0x77, 0xf4, 0xf2, 0xbc,
+	0x6d, 0xbc, 0x3a, 0x6f, 0x1b, 0x7f, 0x9c, 0xb7, 0x8d, 0x17, 0x17, 0xed, 0x95, 0x57, 0x17, 0xed,
+	0x95, 0x5f, 0x2f, 0xda, 0x2b, 0xcf, 0xee, 0x5e, 0xa9, 0x50, 0xa7, 0xb3, 0xdf, 0x40, 0xa5, 0x55,
+	0x83, 0x86, 0x0a, 0xfa, 0xfe, 0x5f, 0x03, 0x00, 0x39, 0x7b, 0xf3, 0x86, 0x87, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -648,6 +825,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoleProposalResults) > 0 {
+		for iNdEx := len(m.RoleProposalResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleProposalResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.BridgeSwitches) > 0 {
 		for iNdEx := len(m.BridgeSwitches) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -762,6 +967,20 @@ func (m *SendingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.SeqToBlocknum) > 0 {
 		for iNdEx := len(m.SeqToBlocknum) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -784,6 +1003,72 @@ func (m *SendingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RouteState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SeqToBlocknum) > 0 {
+		for iNdEx := len(m.SeqToBlocknum) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeqToBlocknum[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NextSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockSeqInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -817,6 +1102,52 @@ func (m *BlockSeqInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Seq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReceivingState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -880,20 +1211,20 @@ func (m *ReceivingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PendingClaimSeqs) > 0 {
-		dAtA5 := make([]byte, len(m.PendingClaimSeqs)*10)
-		var j4 int
+		dAtA7 := make([]byte, len(m.PendingClaimSeqs)*10)
+		var j6 int
 		for _, num := range m.PendingClaimSeqs {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j6++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGenesis(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1119,6 +1450,16 @@ func (m *BridgeSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HaltExpiresAt != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.HaltExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltExpiresAt):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGenesis(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Status != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
 		i--
@@ -1184,6 +1525,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleProposalResults) > 0 {
+		for _, e := range m.RoleProposalResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1202,23 +1555,71 @@ func (m *SendingState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *BlockSeqInfo) Size() (n int) {
+func (m *RouteState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovGenesis(uint64(m.Seq))
+	l = m.Route.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSeq))
 	}
-	if m.Blocknum != 0 {
-		n += 1 + sovGenesis(uint64(m.Blocknum))
+	if len(m.SeqToBlocknum) > 0 {
+		for _, e := range m.SeqToBlocknum {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockSeqInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovGenesis(uint64(m.Seq))
+	}
+	if m.Blocknum != 0 {
+		n += 1 + sovGenesis(uint64(m.Blocknum))
+	}
+	return n
+}
+
+func (m *TransferVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovGenesis(uint64(m.Seq))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
 
 func (m *ReceivingState) Size() (n int) {
 	if m == nil {
@@ -1355,6 +1756,10 @@ func (m *BridgeSwitch) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovGenesis(uint64(m.Status))
 	}
+	if m.HaltExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.HaltExpiresAt)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1394,10 +1799,382 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendingState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendingState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivingState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRoleProposalId", wireType)
+			}
+			m.NextRoleProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRoleProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleProposals = append(m.RoleProposals, RoleProposal{})
+			if err := m.RoleProposals[len(m.RoleProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RolePair{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeSwitches = append(m.BridgeSwitches, BridgeSwitch{})
+			if err := m.BridgeSwitches[len(m.BridgeSwitches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, RouteState{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleProposalResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleProposalResults = append(m.RoleProposalResults, RoleProposalResult{})
+			if err := m.RoleProposalResults[len(m.RoleProposalResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendingState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendingState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendingState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeq", wireType)
 			}
-			var msglen int
+			m.NextSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1407,28 +2184,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendingState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqToBlocknum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1455,13 +2218,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendingState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SeqToBlocknum = append(m.SeqToBlocknum, BlockSeqInfo{})
+			if err := m.SeqToBlocknum[len(m.SeqToBlocknum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1488,32 +2252,64 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReceivingState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRoleProposalId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.NextRoleProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextRoleProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleProposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1540,16 +2336,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleProposals = append(m.RoleProposals, RoleProposal{})
-			if err := m.RoleProposals[len(m.RoleProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeq", wireType)
 			}
-			var msglen int
+			m.NextSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1559,29 +2354,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqToBlocknum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1608,14 +2388,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, RolePair{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SeqToBlocknum = append(m.SeqToBlocknum, BlockSeqInfo{})
+			if err := m.SeqToBlocknum[len(m.SeqToBlocknum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1642,8 +2422,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeSwitches = append(m.BridgeSwitches, BridgeSwitch{})
-			if err := m.BridgeSwitches[len(m.BridgeSwitches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1668,7 +2448,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendingState) Unmarshal(dAtA []byte) error {
+func (m *BlockSeqInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1691,17 +2471,17 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendingState: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockSeqInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendingState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockSeqInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSeq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.NextSeq = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1711,16 +2491,16 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSeq |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeqToBlocknum", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocknum", wireType)
 			}
-			var msglen int
+			m.Blocknum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1730,26 +2510,11 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Blocknum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SeqToBlocknum = append(m.SeqToBlocknum, BlockSeqInfo{})
-			if err := m.SeqToBlocknum[len(m.SeqToBlocknum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1771,7 +2536,7 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockSeqInfo) Unmarshal(dAtA []byte) error {
+func (m *TransferVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1794,10 +2559,10 @@ func (m *BlockSeqInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockSeqInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockSeqInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1820,10 +2585,10 @@ func (m *BlockSeqInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocknum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Blocknum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1833,11 +2598,59 @@ func (m *BlockSeqInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Blocknum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2743,6 +3556,42 @@ func (m *BridgeSwitch) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HaltExpiresAt == nil {
+				m.HaltExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.HaltExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/address"
@@ -28,27 +29,63 @@ const (
 // - 0x01: params
 // - 0x02: next sequence number for bridge sending
 // - 0x03<sequence (8-byte)>: block number of sequence
+// - 0x04<time (8-byte)><sequence (8-byte)>: amount of an outbound transfer counted against the volume cap
+// - 0x05: total amount of outbound transfers counted against the volume cap
+// - 0x06<denomLen (1-byte)><denom><chainIDLen (1-byte)><chainID>: route
+// - 0x07<denomLen (1-byte)><denom><chainIDLen (1-byte)><chainID>: next sequence number of the route
+// - 0x08<denomLen (1-byte)><denom><chainIDLen (1-byte)><chainID><sequence (8-byte)>: block number of sequence of the route
+// - 0x09<denomLen (1-byte)><denom><chainIDLen (1-byte)><chainID><time (8-byte)><sequence (8-byte)>: amount of an outbound transfer of the route counted against its volume cap
+// - 0x0A<denomLen (1-byte)><denom><chainIDLen (1-byte)><chainID>: total amount of outbound transfers of the route counted against its volume cap
 //
 // - 0x10: next proposal ID
 // 	 0x11<proposalID (8-byte)>: proposal
 //   0x12<proposalID (8-byte)><voterAddrLen (1-byte)><voterAddr>: vote
 // - 0x13<addrLen (1-byte)><targetAddr>: role
 // - 0x14<addrLen (1-byte)><guardianAddr>: bridge switch
+// - 0x15<addrLen (1-byte)><guardianAddr>: halt expiry time of the bridge switch
+// - 0x16<proposalID (8-byte)>: final tally of the past proposal
+//
+// - 0x20<addrLen (1-byte)><operatorAddr>: greatest sequence number submitted by the operator
+// - 0x21<addrLen (1-byte)><operatorAddr>: greatest consecutive sequence number submitted by the operator
+// - 0x22: greatest consecutive sequence number confirmed by n-of-m operators
+// - 0x23<sequence (8-byte)><addrLen (1-byte)><operatorAddr>: commitment submitted by the operator
+// - 0x24<commitment>: provision data
+// - 0x25<commitment>: provision status
+// - 0x26<sequence (8-byte)>: confirmed commitment
+// - 0x27<sequence (8-byte)>: pending claim sequence
 //
 // - 0xF0: memstore initialized
 // - 0xF1: role metadata
 // - 0xF2: bridge status
 
 var (
-	KeyParams              = []byte{0x01} // key for fbridge module params
-	KeyNextSeqSend         = []byte{0x02} // key for the next bridge send sequence
-	KeySeqToBlocknumPrefix = []byte{0x03} // key prefix for the sequence to block number mapping
+	KeyParams                         = []byte{0x01} // key for fbridge module params
+	KeyNextSeqSend                    = []byte{0x02} // key for the next bridge send sequence
+	KeySeqToBlocknumPrefix            = []byte{0x03} // key prefix for the sequence to block number mapping
+	KeyTransferVolumePrefix           = []byte{0x04} // key prefix for the outbound transfer volume
+	KeyTransferVolumeTotal            = []byte{0x05} // key for the total outbound transfer volume
+	KeyRoutePrefix                    = []byte{0x06} // key prefix for the bridge route
+	KeyRouteNextSeqSendPrefix         = []byte{0x07} // key prefix for the next bridge send sequence of the route
+	KeyRouteSeqToBlocknumPrefix       = []byte{0x08} // key prefix for the sequence to block number mapping of the route
+	KeyRouteTransferVolumePrefix      = []byte{0x09} // key prefix for the outbound transfer volume of the route
+	KeyRouteTransferVolumeTotalPrefix = []byte{0x0A} // key prefix for the total outbound transfer volume of the route
+
+	KeyNextProposalID       = []byte{0x10} // key for the next role proposal ID
+	KeyProposalPrefix       = []byte{0x11} // key prefix for the role proposal
+	KeyProposalVotePrefix   = []byte{0x12} // key prefix for the role proposal vote
+	KeyRolePrefix           = []byte{0x13} // key prefix for the role of an address
+	KeyBridgeSwitchPrefix   = []byte{0x14} // key for the switch to halt
+	KeyBridgeHaltPrefix     = []byte{0x15} // key prefix for the halt expiry time of the switch
+	KeyProposalResultPrefix = []byte{0x16} // key prefix for the final tally of the past role proposal
 
-	KeyNextProposalID     = []byte{0x10} // key for the next role proposal ID
-	KeyProposalPrefix     = []byte{0x11} // key prefix for the role proposal
-	KeyProposalVotePrefix = []byte{0x12} // key prefix for the role proposal vote
-	KeyRolePrefix         = []byte{0x13} // key prefix for the role of an address
-	KeyBridgeSwitchPrefix = []byte{0x14} // key for the switch to halt
+	KeyGreatestSeqByOperatorPrefix            = []byte{0x20} // key prefix for the greatest sequence number submitted by an operator
+	KeyGreatestConsecutiveSeqByOperatorPrefix = []byte{0x21} // key prefix for the greatest consecutive sequence number submitted by an operator
+	KeyGreatestConsecutiveSeq                 = []byte{0x22} // key for the greatest consecutive sequence number confirmed by n-of-m operators
+	KeyCommitmentPrefix                       = []byte{0x23} // key prefix for the commitment submitted by an operator
+	KeyProvisionDataPrefix                    = []byte{0x24} // key prefix for the provision data
+	KeyProvisionStatusPrefix                  = []byte{0x25} // key prefix for the provision status
+	KeyConfirmedSeqPrefix                     = []byte{0x26} // key prefix for the confirmed commitment of a sequence
+	KeyPendingClaimSeqPrefix                  = []byte{0x27} // key prefix for the sequence waiting to be claimed
 
 	KeyMemInitialized           = []byte{0xF0}
 	KeyMemRoleMetadata          = []byte{0xF1} // key for the role metadata
@@ -61,6 +98,83 @@ func SeqToBlocknumKey(seq uint64) []byte {
 	return append(KeySeqToBlocknumPrefix, bz...)
 }
 
+// TransferVolumeKey key of the outbound transfer volume of a specific sequence
+func TransferVolumeKey(t time.Time, seq uint64) []byte {
+	return transferVolumeKey(KeyTransferVolumePrefix, t, seq)
+}
+
+func transferVolumeKey(prefix []byte, t time.Time, seq uint64) []byte {
+	key := make([]byte, len(prefix)+16)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], uint64(t.UnixNano()))
+	binary.BigEndian.PutUint64(key[len(prefix)+8:], seq)
+	return key
+}
+
+// SplitTransferVolumeKey split the transfer volume key and returns the time and sequence.
+// It also applies to the transfer volume key of a route.
+func SplitTransferVolumeKey(key []byte) (time.Time, uint64) {
+	kv.AssertKeyAtLeastLength(key, 17)
+	bz := key[len(key)-16:]
+	t := time.Unix(0, int64(binary.BigEndian.Uint64(bz[:8]))).UTC()
+	seq := binary.BigEndian.Uint64(bz[8:])
+	return t, seq
+}
+
+// RouteKey returns the length-prefixed denom and chain ID identifying a route
+func RouteKey(denom, chainID string) []byte {
+	return append(address.MustLengthPrefix([]byte(denom)), address.MustLengthPrefix([]byte(chainID))...)
+}
+
+// SplitRouteKey split the route key and returns the denom and chain ID
+func SplitRouteKey(key []byte) (denom, chainID string) {
+	kv.AssertKeyAtLeastLength(key, 1)
+	denomLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, denomLen+2)
+	denom = string(key[1 : 1+denomLen])
+	chainIDLen := int(key[1+denomLen])
+	kv.AssertKeyLength(key, denomLen+chainIDLen+2)
+	chainID = string(key[2+denomLen:])
+	return denom, chainID
+}
+
+// RouteStoreKey key of a specific route
+func RouteStoreKey(denom, chainID string) []byte {
+	return append(KeyRoutePrefix, RouteKey(denom, chainID)...)
+}
+
+// RouteNextSeqSendKey key of the next sequence number of a specific route
+func RouteNextSeqSendKey(denom, chainID string) []byte {
+	return append(KeyRouteNextSeqSendPrefix, RouteKey(denom, chainID)...)
+}
+
+// RouteSeqToBlocknumsKey gets the first part of the block number key of a specific route
+func RouteSeqToBlocknumsKey(denom, chainID string) []byte {
+	return append(KeyRouteSeqToBlocknumPrefix, RouteKey(denom, chainID)...)
+}
+
+// RouteSeqToBlocknumKey key of the block number of a specific sequence of the route
+func RouteSeqToBlocknumKey(denom, chainID string, seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(RouteSeqToBlocknumsKey(denom, chainID), bz...)
+}
+
+// RouteTransferVolumesKey gets the first part of the transfer volume key of a specific route
+func RouteTransferVolumesKey(denom, chainID string) []byte {
+	return append(KeyRouteTransferVolumePrefix, RouteKey(denom, chainID)...)
+}
+
+// RouteTransferVolumeKey key of the outbound transfer volume of a specific sequence of the route
+func RouteTransferVolumeKey(denom, chainID string, t time.Time, seq uint64) []byte {
+	return transferVolumeKey(RouteTransferVolumesKey(denom, chainID), t, seq)
+}
+
+// RouteTransferVolumeTotalKey key of the total outbound transfer volume of a specific route
+func RouteTransferVolumeTotalKey(denom, chainID string) []byte {
+	return append(KeyRouteTransferVolumeTotalPrefix, RouteKey(denom, chainID)...)
+}
+
 // GetProposalIDBytes returns the byte representation of the proposalID
 func GetProposalIDBytes(proposalID uint64) []byte {
 	bz := make([]byte, 8)
@@ -91,6 +205,11 @@ func SplitVoterVoteKey(key []byte) (uint64, sdk.AccAddress) {
 	return proposalID, voter
 }
 
+// ProposalResultKey key of the final tally of a specific role proposal from the store
+func ProposalResultKey(proposalID uint64) []byte {
+	return append(KeyProposalResultPrefix, GetProposalIDBytes(proposalID)...)
+}
+
 // RoleKey key of a specific role of the address from the store
 func RoleKey(target sdk.AccAddress) []byte {
 	return append(KeyRolePrefix, address.MustLengthPrefix(target.Bytes())...)
@@ -111,3 +230,74 @@ func SplitBridgeSwitchKey(key []byte) sdk.AccAddress {
 	kv.AssertKeyAtLeastLength(key, 3)
 	return key[2:]
 }
+
+// BridgeHaltKey key of the halt expiry time of the guardian's bridge switch
+func BridgeHaltKey(guardian sdk.AccAddress) []byte {
+	return append(KeyBridgeHaltPrefix, address.MustLengthPrefix(guardian.Bytes())...)
+}
+
+// SplitBridgeHaltKey split the bridge halt key and returns the guardian address
+func SplitBridgeHaltKey(key []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:]
+}
+
+// GreatestSeqByOperatorKey key of the greatest sequence number submitted by the operator
+func GreatestSeqByOperatorKey(operator sdk.AccAddress) []byte {
+	return append(KeyGreatestSeqByOperatorPrefix, address.MustLengthPrefix(operator.Bytes())...)
+}
+
+// GreatestConsecutiveSeqByOperatorKey key of the greatest consecutive sequence number submitted by the operator
+func GreatestConsecutiveSeqByOperatorKey(operator sdk.AccAddress) []byte {
+	return append(KeyGreatestConsecutiveSeqByOperatorPrefix, address.MustLengthPrefix(operator.Bytes())...)
+}
+
+// SplitOperatorSeqKey split the operator sequence key and returns the operator address
+func SplitOperatorSeqKey(key []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:]
+}
+
+// CommitmentsKey gets the first part of the commitment key based on the sequence
+func CommitmentsKey(seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(KeyCommitmentPrefix, bz...)
+}
+
+// CommitmentKey key of the commitment submitted by the operator for a specific sequence
+func CommitmentKey(seq uint64, operator sdk.AccAddress) []byte {
+	return append(CommitmentsKey(seq), address.MustLengthPrefix(operator.Bytes())...)
+}
+
+// SplitCommitmentKey split the commitment key and returns the sequence and operator address
+func SplitCommitmentKey(key []byte) (uint64, sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 11)
+	seq := binary.BigEndian.Uint64(key[1:9])
+	operator := sdk.AccAddress(key[10:])
+	return seq, operator
+}
+
+// ProvisionDataKey key of the provision data of a specific commitment
+func ProvisionDataKey(commitment []byte) []byte {
+	return append(KeyProvisionDataPrefix, commitment...)
+}
+
+// ProvisionStatusKey key of the provision status of a specific commitment
+func ProvisionStatusKey(commitment []byte) []byte {
+	return append(KeyProvisionStatusPrefix, commitment...)
+}
+
+// ConfirmedSeqKey key of the confirmed commitment of a specific sequence
+func ConfirmedSeqKey(seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(KeyConfirmedSeqPrefix, bz...)
+}
+
+// PendingClaimSeqKey key of the sequence waiting to be claimed
+func PendingClaimSeqKey(seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return append(KeyPendingClaimSeqPrefix, bz...)
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -29,4 +30,27 @@ func TestFbridgeKeys(t *testing.T) {
 	require.Equal(t, []byte{types.KeyBridgeSwitchPrefix[0], 0x8, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e}, bskey)
 	guardian := types.SplitBridgeSwitchKey(bskey)
 	require.Equal(t, sdk.AccAddress("guardian"), guardian)
+
+	ckey := types.CommitmentKey(1, []byte("operator"))
+	require.Equal(t, []byte{types.KeyCommitmentPrefix[0], 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x8, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72}, ckey)
+	seq, operator := types.SplitCommitmentKey(ckey)
+	require.Equal(t, uint64(1), seq)
+	require.Equal(t, sdk.AccAddress("operator"), operator)
+
+	gskey := types.GreatestSeqByOperatorKey([]byte("operator"))
+	require.Equal(t, []byte{types.KeyGreatestSeqByOperatorPrefix[0], 0x8, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72}, gskey)
+	require.Equal(t, sdk.AccAddress("operator"), types.SplitOperatorSeqKey(gskey))
+
+	routeKey := types.RouteKey("cony", "8217")
+	require.Equal(t, []byte{0x4, 0x63, 0x6f, 0x6e, 0x79, 0x4, 0x38, 0x32, 0x31, 0x37}, routeKey)
+	denom, chainID := types.SplitRouteKey(routeKey)
+	require.Equal(t, "cony", denom)
+	require.Equal(t, "8217", chainID)
+	require.Equal(t, append([]byte{types.KeyRouteSeqToBlocknumPrefix[0]}, append(routeKey, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1)...), types.RouteSeqToBlocknumKey("cony", "8217", 1))
+
+	tvkey := types.TransferVolumeKey(time.Unix(0, 1).UTC(), 2)
+	require.Equal(t, []byte{types.KeyTransferVolumePrefix[0], 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2}, tvkey)
+	tm, seq := types.SplitTransferVolumeKey(tvkey)
+	require.Equal(t, time.Unix(0, 1).UTC(), tm)
+	require.Equal(t, uint64(2), seq)
 }
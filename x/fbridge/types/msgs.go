@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/Finschia/finschia-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
 
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
@@ -14,6 +19,8 @@ var (
 	_ sdk.Msg = &MsgSuggestRole{}
 	_ sdk.Msg = &MsgAddVoteForRole{}
 	_ sdk.Msg = &MsgSetBridgeStatus{}
+	_ sdk.Msg = &MsgSetRoute{}
+	_ sdk.Msg = &MsgHaltRoute{}
 )
 
 func (m MsgUpdateParams) ValidateBasic() error { return nil }
@@ -57,7 +64,31 @@ func (m MsgTransfer) Route() string {
 	return RouterKey
 }
 
-func (m MsgProvision) ValidateBasic() error { return nil }
+func (m MsgProvision) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	if len(m.Sender) == 0 {
+		return sdkerrors.ErrInvalidAddress.Wrap("sender address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address (%s)", err)
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("amount must be positive: %s", m.Amount)
+	}
+
+	if len(m.Denom) != 0 {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
 
 func (m MsgProvision) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.From)}
@@ -225,7 +256,20 @@ func (m MsgAddVoteForRole) Route() string {
 	return RouterKey
 }
 
-func (m MsgSetBridgeStatus) ValidateBasic() error { return nil }
+// MaxHaltDuration is the maximum duration of a halt which expires.
+const MaxHaltDuration = 365 * 24 * time.Hour
+
+func (m MsgSetBridgeStatus) ValidateBasic() error {
+	if m.HaltDuration > uint64(MaxHaltDuration) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("halt duration must not exceed %s", MaxHaltDuration)
+	}
+
+	if m.HaltDuration != 0 && m.Status != StatusInactive {
+		return sdkerrors.ErrInvalidRequest.Wrap("halt duration is only allowed for halting the bridge")
+	}
+
+	return nil
+}
 
 func (m MsgSetBridgeStatus) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Guardian)}
@@ -245,3 +289,45 @@ func (m MsgSetBridgeStatus) Type() string {
 func (m MsgSetBridgeStatus) Route() string {
 	return RouterKey
 }
+
+func (m MsgSetRoute) ValidateBasic() error { return nil }
+
+func (m MsgSetRoute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSetRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSetRoute) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSetRoute) Route() string {
+	return RouterKey
+}
+
+func (m MsgHaltRoute) ValidateBasic() error { return nil }
+
+func (m MsgHaltRoute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Guardian)}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgHaltRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgHaltRoute) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgHaltRoute) Route() string {
+	return RouterKey
+}
@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/auth/legacy/legacytx"
 	fbridgetypes "github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
@@ -46,7 +49,7 @@ func TestAminoJSON(t *testing.T) {
 				},
 			},
 			"/lbm.fbridge.v1.MsgUpdateParams",
-			"{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/fbridge/MsgUpdateParams\",\"value\":{\"authority\":\"link1zf469e6y5zvsvkjz8vpr27j6txseyfnsh3ydze\",\"params\":{\"guardian_trust_level\":{\"denominator\":\"3\",\"numerator\":\"2\"},\"judge_trust_level\":{\"denominator\":\"3\",\"numerator\":\"2\"},\"operator_trust_level\":{\"denominator\":\"3\",\"numerator\":\"2\"},\"proposal_period\":\"3600000000000\",\"target_denom\":\"kaia\",\"timelock_period\":\"86400000000000\"}}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}",
+			"{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/fbridge/MsgUpdateParams\",\"value\":{\"authority\":\"link1zf469e6y5zvsvkjz8vpr27j6txseyfnsh3ydze\",\"params\":{\"guardian_trust_level\":{\"denominator\":\"3\",\"numerator\":\"2\"},\"judge_trust_level\":{\"denominator\":\"3\",\"numerator\":\"2\"},\"max_transfer_amount\":\"0\",\"operator_trust_level\":{\"denominator\":\"3\",\"numerator\":\"2\"},\"proposal_period\":\"3600000000000\",\"target_denom\":\"kaia\",\"timelock_period\":\"86400000000000\",\"transfer_fee\":{\"flat_amount\":\"0\",\"min_amount\":\"0\"},\"transfer_volume_cap\":\"0\"}}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}",
 		},
 		"MsgTransfer": {
 			&fbridgetypes.MsgTransfer{
@@ -145,3 +148,93 @@ func TestAminoJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgProvisionValidateBasic(t *testing.T) {
+	addr := "link1zf469e6y5zvsvkjz8vpr27j6txseyfnsh3ydze"
+	valid := fbridgetypes.MsgProvision{
+		From:     addr,
+		Seq:      1,
+		Sender:   "0xf7bAc63fc7CEaCf0589F25454Ecf5C2ce904997c",
+		Receiver: addr,
+		Amount:   sdk.NewInt(100),
+	}
+
+	testCases := map[string]struct {
+		malleate func(msg *fbridgetypes.MsgProvision)
+		err      error
+	}{
+		"valid msg": {},
+		"invalid operator": {
+			malleate: func(msg *fbridgetypes.MsgProvision) {
+				msg.From = "invalid"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"empty sender": {
+			malleate: func(msg *fbridgetypes.MsgProvision) {
+				msg.Sender = ""
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"invalid receiver": {
+			malleate: func(msg *fbridgetypes.MsgProvision) {
+				msg.Receiver = "invalid"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"nil amount": {
+			malleate: func(msg *fbridgetypes.MsgProvision) {
+				msg.Amount = sdk.Int{}
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		"zero amount": {
+			malleate: func(msg *fbridgetypes.MsgProvision) {
+				msg.Amount = sdk.ZeroInt()
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := valid
+			if tc.malleate != nil {
+				tc.malleate(&msg)
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+		})
+	}
+}
+
+func TestMsgSetBridgeStatusValidateBasic(t *testing.T) {
+	testCases := map[string]struct {
+		msg fbridgetypes.MsgSetBridgeStatus
+		err error
+	}{
+		"halt": {
+			msg: fbridgetypes.MsgSetBridgeStatus{Status: fbridgetypes.StatusInactive},
+		},
+		"halt with duration": {
+			msg: fbridgetypes.MsgSetBridgeStatus{Status: fbridgetypes.StatusInactive, HaltDuration: uint64(fbridgetypes.MaxHaltDuration)},
+		},
+		"halt duration too long": {
+			msg: fbridgetypes.MsgSetBridgeStatus{Status: fbridgetypes.StatusInactive, HaltDuration: math.MaxUint64},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"resume": {
+			msg: fbridgetypes.MsgSetBridgeStatus{Status: fbridgetypes.StatusActive},
+		},
+		"resume with duration": {
+			msg: fbridgetypes.MsgSetBridgeStatus{Status: fbridgetypes.StatusActive, HaltDuration: uint64(time.Hour)},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tc.msg.ValidateBasic(), tc.err)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	sdktypes "github.com/Finschia/finschia-sdk/types"
@@ -9,12 +11,19 @@ import (
 
 func DefaultParams() Params {
 	return Params{
-		GuardianTrustLevel: Fraction{Numerator: 2, Denominator: 3},
-		OperatorTrustLevel: Fraction{Numerator: 2, Denominator: 3},
-		JudgeTrustLevel:    Fraction{Numerator: 1, Denominator: 1},
-		ProposalPeriod:     uint64(time.Minute * 60),
-		TimelockPeriod:     uint64(time.Hour * 24),
-		TargetDenom:        sdktypes.DefaultBondDenom,
+		GuardianTrustLevel:   Fraction{Numerator: 2, Denominator: 3},
+		OperatorTrustLevel:   Fraction{Numerator: 2, Denominator: 3},
+		JudgeTrustLevel:      Fraction{Numerator: 1, Denominator: 1},
+		ProposalPeriod:       uint64(time.Minute * 60),
+		TimelockPeriod:       uint64(time.Hour * 24),
+		TargetDenom:          sdktypes.DefaultBondDenom,
+		MaxTransferAmount:    sdktypes.ZeroInt(),
+		TransferVolumeCap:    sdktypes.ZeroInt(),
+		TransferVolumeWindow: uint64(time.Hour * 24),
+		TransferFee: TransferFee{
+			FlatAmount: sdktypes.ZeroInt(),
+			MinAmount:  sdktypes.ZeroInt(),
+		},
 	}
 }
 
@@ -43,9 +52,39 @@ func (p Params) ValidateParams() error {
 		return err
 	}
 
+	if !p.MaxTransferAmount.IsNil() && p.MaxTransferAmount.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrap("max transfer amount cannot be negative")
+	}
+
+	if !p.TransferVolumeCap.IsNil() && p.TransferVolumeCap.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer volume cap cannot be negative")
+	}
+
+	if p.HasTransferVolumeCap() && p.TransferVolumeWindow == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer volume window cannot be 0 if the volume cap is set")
+	}
+
+	if err := p.TransferFee.ValidateBasic(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer fee: " + err.Error())
+	}
+
+	if p.TransferFee.IsSet() && len(p.FeeDestination) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("fee destination cannot be empty if the transfer fee is set")
+	}
+
 	return nil
 }
 
+// HasTransferLimit returns whether the amount of a single transfer is limited.
+func (p Params) HasTransferLimit() bool {
+	return !p.MaxTransferAmount.IsNil() && p.MaxTransferAmount.IsPositive()
+}
+
+// HasTransferVolumeCap returns whether the total amount of transfers within the rolling window is capped.
+func (p Params) HasTransferVolumeCap() bool {
+	return !p.TransferVolumeCap.IsNil() && p.TransferVolumeCap.IsPositive()
+}
+
 func CheckTrustLevelThreshold(total, current uint64, trustLevel Fraction) bool {
 	if err := ValidateTrustLevel(trustLevel); err != nil {
 		panic(err)
@@ -69,3 +108,53 @@ func ValidateTrustLevel(trustLevel Fraction) error {
 
 	return nil
 }
+
+const maxFeeRateBps = 10000
+
+func (f TransferFee) ValidateBasic() error {
+	if !f.FlatAmount.IsNil() && f.FlatAmount.IsNegative() {
+		return errors.New("flat amount cannot be negative")
+	}
+
+	if !f.MinAmount.IsNil() && f.MinAmount.IsNegative() {
+		return errors.New("min amount cannot be negative")
+	}
+
+	if f.RateBps > maxFeeRateBps {
+		return fmt.Errorf("rate cannot exceed %d bps", maxFeeRateBps)
+	}
+
+	hasFlat := !f.FlatAmount.IsNil() && f.FlatAmount.IsPositive()
+	hasMin := !f.MinAmount.IsNil() && f.MinAmount.IsPositive()
+	if hasFlat && f.RateBps != 0 {
+		return errors.New("flat amount and rate cannot be set at the same time")
+	}
+
+	if hasMin && f.RateBps == 0 {
+		return errors.New("min amount requires rate to be set")
+	}
+
+	return nil
+}
+
+// IsSet returns whether a fee is charged on transfers.
+func (f TransferFee) IsSet() bool {
+	return f.RateBps != 0 || (!f.FlatAmount.IsNil() && f.FlatAmount.IsPositive())
+}
+
+// Compute returns the fee to be charged on a transfer of the given amount.
+func (f TransferFee) Compute(amount sdktypes.Int) sdktypes.Int {
+	if f.RateBps == 0 {
+		if f.FlatAmount.IsNil() {
+			return sdktypes.ZeroInt()
+		}
+		return f.FlatAmount
+	}
+
+	fee := amount.MulRaw(int64(f.RateBps)).QuoRaw(maxFeeRateBps)
+	if !f.MinAmount.IsNil() && fee.LT(f.MinAmount) {
+		fee = f.MinAmount
+	}
+
+	return fee
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
 
@@ -54,6 +55,68 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"negative max transfer amount": {
+			malleate: func(p *types.Params) {
+				p.MaxTransferAmount = sdk.NewInt(-1)
+			},
+			expErr: true,
+		},
+		"negative transfer volume cap": {
+			malleate: func(p *types.Params) {
+				p.TransferVolumeCap = sdk.NewInt(-1)
+			},
+			expErr: true,
+		},
+		"transfer volume cap without window": {
+			malleate: func(p *types.Params) {
+				p.TransferVolumeCap = sdk.NewInt(100)
+				p.TransferVolumeWindow = 0
+			},
+			expErr: true,
+		},
+		"flat transfer fee": {
+			malleate: func(p *types.Params) {
+				p.TransferFee.FlatAmount = sdk.NewInt(10)
+				p.FeeDestination = "fee_collector"
+			},
+			expErr: false,
+		},
+		"transfer fee without destination": {
+			malleate: func(p *types.Params) {
+				p.TransferFee.RateBps = 10
+			},
+			expErr: true,
+		},
+		"both flat and rate transfer fee": {
+			malleate: func(p *types.Params) {
+				p.TransferFee.FlatAmount = sdk.NewInt(10)
+				p.TransferFee.RateBps = 10
+				p.FeeDestination = "fee_collector"
+			},
+			expErr: true,
+		},
+		"transfer fee rate exceeds 10000 bps": {
+			malleate: func(p *types.Params) {
+				p.TransferFee.RateBps = 10001
+				p.FeeDestination = "fee_collector"
+			},
+			expErr: true,
+		},
+		"min transfer fee without rate": {
+			malleate: func(p *types.Params) {
+				p.TransferFee.MinAmount = sdk.NewInt(10)
+				p.FeeDestination = "fee_collector"
+			},
+			expErr: true,
+		},
+		"unset transfer limits": {
+			malleate: func(p *types.Params) {
+				p.MaxTransferAmount = sdk.Int{}
+				p.TransferVolumeCap = sdk.Int{}
+				p.TransferVolumeWindow = 0
+			},
+			expErr: false,
+		},
 	}
 
 	for name, tc := range tcs {
@@ -72,6 +135,48 @@ func TestValidateParams(t *testing.T) {
 	}
 }
 
+func TestComputeTransferFee(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		fee    types.TransferFee
+		amount int64
+		expFee int64
+	}{
+		"no fee": {
+			fee:    types.TransferFee{},
+			amount: 1000,
+			expFee: 0,
+		},
+		"flat fee": {
+			fee:    types.TransferFee{FlatAmount: sdk.NewInt(7)},
+			amount: 1000,
+			expFee: 7,
+		},
+		"rate fee": {
+			fee:    types.TransferFee{RateBps: 30, MinAmount: sdk.NewInt(1)},
+			amount: 10000,
+			expFee: 30,
+		},
+		"rate fee truncated": {
+			fee:    types.TransferFee{RateBps: 30},
+			amount: 999,
+			expFee: 2,
+		},
+		"rate fee below minimum": {
+			fee:    types.TransferFee{RateBps: 30, MinAmount: sdk.NewInt(5)},
+			amount: 1000,
+			expFee: 5,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, sdk.NewInt(tc.expFee), tc.fee.Compute(sdk.NewInt(tc.amount)))
+		})
+	}
+}
+
 func TestCheckTrustLevelThreshold(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	query "github.com/Finschia/finschia-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -111,6 +112,10 @@ func (m *QueryParamsResponse) GetParams() Params {
 }
 
 type QueryNextSeqSendRequest struct {
+	// the denom of the route. Leave both denom and chain_id empty to query the default route.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryNextSeqSendRequest) Reset()         { *m = QueryNextSeqSendRequest{} }
@@ -146,6 +151,20 @@ func (m *QueryNextSeqSendRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNextSeqSendRequest proto.InternalMessageInfo
 
+func (m *QueryNextSeqSendRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryNextSeqSendRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 type QueryNextSeqSendResponse struct {
 	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
@@ -193,6 +212,10 @@ func (m *QueryNextSeqSendResponse) GetSeq() uint64 {
 type QuerySeqToBlocknumsRequest struct {
 	// list of sequence number of the bridge request
 	Seqs []uint64 `protobuf:"varint,1,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	// the denom of the route. Leave both denom and chain_id empty to query the default route.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QuerySeqToBlocknumsRequest) Reset()         { *m = QuerySeqToBlocknumsRequest{} }
@@ -235,6 +258,20 @@ func (m *QuerySeqToBlocknumsRequest) GetSeqs() []uint64 {
 	return nil
 }
 
+func (m *QuerySeqToBlocknumsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QuerySeqToBlocknumsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 type QuerySeqToBlocknumsResponse struct {
 	Blocknums []uint64 `protobuf:"varint,1,rep,packed,name=blocknums,proto3" json:"blocknums,omitempty"`
 }
@@ -279,6 +316,290 @@ func (m *QuerySeqToBlocknumsResponse) GetBlocknums() []uint64 {
 	return nil
 }
 
+type QueryRoutesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()    {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{6}
+}
+func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesRequest.Merge(m, src)
+}
+func (m *QueryRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryRoutesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRoutesResponse struct {
+	Routes     []Route             `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()    {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{7}
+}
+func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesResponse.Merge(m, src)
+}
+func (m *QueryRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryRoutesResponse) GetRoutes() []Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryRoutesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRouteRequest struct {
+	// the denom of the route
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryRouteRequest) Reset()         { *m = QueryRouteRequest{} }
+func (m *QueryRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteRequest) ProtoMessage()    {}
+func (*QueryRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{8}
+}
+func (m *QueryRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteRequest.Merge(m, src)
+}
+func (m *QueryRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteRequest proto.InternalMessageInfo
+
+func (m *QueryRouteRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryRouteRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryRouteResponse struct {
+	Route Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+	// the next sequence number of the route
+	NextSeq uint64 `protobuf:"varint,2,opt,name=next_seq,json=nextSeq,proto3" json:"next_seq,omitempty"`
+}
+
+func (m *QueryRouteResponse) Reset()         { *m = QueryRouteResponse{} }
+func (m *QueryRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteResponse) ProtoMessage()    {}
+func (*QueryRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{9}
+}
+func (m *QueryRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteResponse.Merge(m, src)
+}
+func (m *QueryRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteResponse proto.InternalMessageInfo
+
+func (m *QueryRouteResponse) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+func (m *QueryRouteResponse) GetNextSeq() uint64 {
+	if m != nil {
+		return m.NextSeq
+	}
+	return 0
+}
+
+type QueryTransferQuotaRequest struct {
+}
+
+func (m *QueryTransferQuotaRequest) Reset()         { *m = QueryTransferQuotaRequest{} }
+func (m *QueryTransferQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferQuotaRequest) ProtoMessage()    {}
+func (*QueryTransferQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{10}
+}
+func (m *QueryTransferQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferQuotaRequest.Merge(m, src)
+}
+func (m *QueryTransferQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferQuotaRequest proto.InternalMessageInfo
+
+type QueryTransferQuotaResponse struct {
+	// maximum amount of a single transfer. Zero means no limit.
+	MaxTransferAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,1,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_transfer_amount"`
+	// maximum total amount of transfers within the rolling window. Zero means no cap.
+	TransferVolumeCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=transfer_volume_cap,json=transferVolumeCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_volume_cap"`
+	// total amount transferred within the current rolling window
+	UsedVolume github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=used_volume,json=usedVolume,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"used_volume"`
+	// amount that can still be transferred within the current rolling window.
+	// Only meaningful if transfer_volume_cap is set.
+	RemainingVolume github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining_volume,json=remainingVolume,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"remaining_volume"`
+}
+
+func (m *QueryTransferQuotaResponse) Reset()         { *m = QueryTransferQuotaResponse{} }
+func (m *QueryTransferQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferQuotaResponse) ProtoMessage()    {}
+func (*QueryTransferQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{11}
+}
+func (m *QueryTransferQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferQuotaResponse.Merge(m, src)
+}
+func (m *QueryTransferQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferQuotaResponse proto.InternalMessageInfo
+
 type QueryGreatestSeqByOperatorRequest struct {
 	// the address of the operator
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
@@ -288,7 +609,7 @@ func (m *QueryGreatestSeqByOperatorRequest) Reset()         { *m = QueryGreatest
 func (m *QueryGreatestSeqByOperatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGreatestSeqByOperatorRequest) ProtoMessage()    {}
 func (*QueryGreatestSeqByOperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{6}
+	return fileDescriptor_5e7780f9db9d346e, []int{12}
 }
 func (m *QueryGreatestSeqByOperatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,7 +653,7 @@ func (m *QueryGreatestSeqByOperatorResponse) Reset()         { *m = QueryGreates
 func (m *QueryGreatestSeqByOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGreatestSeqByOperatorResponse) ProtoMessage()    {}
 func (*QueryGreatestSeqByOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{7}
+	return fileDescriptor_5e7780f9db9d346e, []int{13}
 }
 func (m *QueryGreatestSeqByOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +700,7 @@ func (m *QueryGreatestConsecutiveConfirmedSeqRequest) String() string {
 }
 func (*QueryGreatestConsecutiveConfirmedSeqRequest) ProtoMessage() {}
 func (*QueryGreatestConsecutiveConfirmedSeqRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{8}
+	return fileDescriptor_5e7780f9db9d346e, []int{14}
 }
 func (m *QueryGreatestConsecutiveConfirmedSeqRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +741,7 @@ func (m *QueryGreatestConsecutiveConfirmedSeqResponse) String() string {
 }
 func (*QueryGreatestConsecutiveConfirmedSeqResponse) ProtoMessage() {}
 func (*QueryGreatestConsecutiveConfirmedSeqResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{9}
+	return fileDescriptor_5e7780f9db9d346e, []int{15}
 }
 func (m *QueryGreatestConsecutiveConfirmedSeqResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +788,7 @@ func (m *QuerySubmittedProvisionRequest) Reset()         { *m = QuerySubmittedPr
 func (m *QuerySubmittedProvisionRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySubmittedProvisionRequest) ProtoMessage()    {}
 func (*QuerySubmittedProvisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{10}
+	return fileDescriptor_5e7780f9db9d346e, []int{16}
 }
 func (m *QuerySubmittedProvisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +840,7 @@ func (m *QuerySubmittedProvisionResponse) Reset()         { *m = QuerySubmittedP
 func (m *QuerySubmittedProvisionResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySubmittedProvisionResponse) ProtoMessage()    {}
 func (*QuerySubmittedProvisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{11}
+	return fileDescriptor_5e7780f9db9d346e, []int{17}
 }
 func (m *QuerySubmittedProvisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +894,7 @@ func (m *QueryNeededSubmissionSeqsRequest) Reset()         { *m = QueryNeededSub
 func (m *QueryNeededSubmissionSeqsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNeededSubmissionSeqsRequest) ProtoMessage()    {}
 func (*QueryNeededSubmissionSeqsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{12}
+	return fileDescriptor_5e7780f9db9d346e, []int{18}
 }
 func (m *QueryNeededSubmissionSeqsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +945,7 @@ func (m *QueryNeededSubmissionSeqsResponse) Reset()         { *m = QueryNeededSu
 func (m *QueryNeededSubmissionSeqsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNeededSubmissionSeqsResponse) ProtoMessage()    {}
 func (*QueryNeededSubmissionSeqsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{13}
+	return fileDescriptor_5e7780f9db9d346e, []int{19}
 }
 func (m *QueryNeededSubmissionSeqsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +990,7 @@ func (m *QueryConfirmedProvisionRequest) Reset()         { *m = QueryConfirmedPr
 func (m *QueryConfirmedProvisionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConfirmedProvisionRequest) ProtoMessage()    {}
 func (*QueryConfirmedProvisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{14}
+	return fileDescriptor_5e7780f9db9d346e, []int{20}
 }
 func (m *QueryConfirmedProvisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,7 +1035,7 @@ func (m *QueryConfirmedProvisionResponse) Reset()         { *m = QueryConfirmedP
 func (m *QueryConfirmedProvisionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfirmedProvisionResponse) ProtoMessage()    {}
 func (*QueryConfirmedProvisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{15}
+	return fileDescriptor_5e7780f9db9d346e, []int{21}
 }
 func (m *QueryConfirmedProvisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,7 +1087,7 @@ func (m *QueryCommitmentsRequest) Reset()         { *m = QueryCommitmentsRequest
 func (m *QueryCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommitmentsRequest) ProtoMessage()    {}
 func (*QueryCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{16}
+	return fileDescriptor_5e7780f9db9d346e, []int{22}
 }
 func (m *QueryCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +1131,7 @@ func (m *QueryCommitmentsResponse) Reset()         { *m = QueryCommitmentsRespon
 func (m *QueryCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommitmentsResponse) ProtoMessage()    {}
 func (*QueryCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{17}
+	return fileDescriptor_5e7780f9db9d346e, []int{23}
 }
 func (m *QueryCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1176,7 @@ func (m *QueryMembersRequest) Reset()         { *m = QueryMembersRequest{} }
 func (m *QueryMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMembersRequest) ProtoMessage()    {}
 func (*QueryMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{18}
+	return fileDescriptor_5e7780f9db9d346e, []int{24}
 }
 func (m *QueryMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1220,7 @@ func (m *QueryMembersResponse) Reset()         { *m = QueryMembersResponse{} }
 func (m *QueryMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMembersResponse) ProtoMessage()    {}
 func (*QueryMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{19}
+	return fileDescriptor_5e7780f9db9d346e, []int{25}
 }
 func (m *QueryMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +1264,7 @@ func (m *QueryMemberRequest) Reset()         { *m = QueryMemberRequest{} }
 func (m *QueryMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMemberRequest) ProtoMessage()    {}
 func (*QueryMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{20}
+	return fileDescriptor_5e7780f9db9d346e, []int{26}
 }
 func (m *QueryMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +1308,7 @@ func (m *QueryMemberResponse) Reset()         { *m = QueryMemberResponse{} }
 func (m *QueryMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMemberResponse) ProtoMessage()    {}
 func (*QueryMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{21}
+	return fileDescriptor_5e7780f9db9d346e, []int{27}
 }
 func (m *QueryMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1353,7 @@ func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
 func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsRequest) ProtoMessage()    {}
 func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{22}
+	return fileDescriptor_5e7780f9db9d346e, []int{28}
 }
 func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,7 +1399,7 @@ func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{}
 func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsResponse) ProtoMessage()    {}
 func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{23}
+	return fileDescriptor_5e7780f9db9d346e, []int{29}
 }
 func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,7 +1451,7 @@ func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
 func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalRequest) ProtoMessage()    {}
 func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{24}
+	return fileDescriptor_5e7780f9db9d346e, []int{30}
 }
 func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,7 +1495,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{25}
+	return fileDescriptor_5e7780f9db9d346e, []int{31}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,25 +1531,23 @@ func (m *QueryProposalResponse) GetProposal() RoleProposal {
 	return RoleProposal{}
 }
 
-type QueryVoteRequest struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter defines the oter address for the proposals.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+type QueryProposalResultsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
-func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteRequest) ProtoMessage()    {}
-func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{26}
+func (m *QueryProposalResultsRequest) Reset()         { *m = QueryProposalResultsRequest{} }
+func (m *QueryProposalResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResultsRequest) ProtoMessage()    {}
+func (*QueryProposalResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{32}
 }
-func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalResultsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalResultsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1238,23 +1557,212 @@ func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+func (m *QueryProposalResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResultsRequest.Merge(m, src)
 }
-func (m *QueryVoteRequest) XXX_Size() int {
+func (m *QueryProposalResultsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
+func (m *QueryProposalResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResultsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalResultsRequest proto.InternalMessageInfo
 
-func (m *QueryVoteRequest) GetProposalId() uint64 {
+func (m *QueryProposalResultsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ProposalId
+		return m.Pagination
 	}
-	return 0
+	return nil
+}
+
+type QueryProposalResultsResponse struct {
+	Results []RoleProposalResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalResultsResponse) Reset()         { *m = QueryProposalResultsResponse{} }
+func (m *QueryProposalResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResultsResponse) ProtoMessage()    {}
+func (*QueryProposalResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{33}
+}
+func (m *QueryProposalResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResultsResponse.Merge(m, src)
+}
+func (m *QueryProposalResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalResultsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalResultsResponse) GetResults() []RoleProposalResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryProposalResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryProposalResultRequest struct {
+	// the proposal id
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalResultRequest) Reset()         { *m = QueryProposalResultRequest{} }
+func (m *QueryProposalResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResultRequest) ProtoMessage()    {}
+func (*QueryProposalResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{34}
+}
+func (m *QueryProposalResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResultRequest.Merge(m, src)
+}
+func (m *QueryProposalResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalResultRequest proto.InternalMessageInfo
+
+func (m *QueryProposalResultRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type QueryProposalResultResponse struct {
+	Result RoleProposalResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+}
+
+func (m *QueryProposalResultResponse) Reset()         { *m = QueryProposalResultResponse{} }
+func (m *QueryProposalResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResultResponse) ProtoMessage()    {}
+func (*QueryProposalResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{35}
+}
+func (m *QueryProposalResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResultResponse.Merge(m, src)
+}
+func (m *QueryProposalResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalResultResponse proto.InternalMessageInfo
+
+func (m *QueryProposalResultResponse) GetResult() RoleProposalResult {
+	if m != nil {
+		return m.Result
+	}
+	return RoleProposalResult{}
+}
+
+type QueryVoteRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter defines the oter address for the proposals.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
+func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRequest) ProtoMessage()    {}
+func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{36}
+}
+func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+}
+func (m *QueryVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+
+func (m *QueryVoteRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
 func (m *QueryVoteRequest) GetVoter() string {
@@ -1273,7 +1781,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{27}
+	return fileDescriptor_5e7780f9db9d346e, []int{37}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1318,7 +1826,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{28}
+	return fileDescriptor_5e7780f9db9d346e, []int{38}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1363,7 +1871,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{29}
+	return fileDescriptor_5e7780f9db9d346e, []int{39}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1406,7 +1914,7 @@ func (m *QueryBridgeStatusRequest) Reset()         { *m = QueryBridgeStatusReque
 func (m *QueryBridgeStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBridgeStatusRequest) ProtoMessage()    {}
 func (*QueryBridgeStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{30}
+	return fileDescriptor_5e7780f9db9d346e, []int{40}
 }
 func (m *QueryBridgeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1952,7 @@ func (m *QueryBridgeStatusResponse) Reset()         { *m = QueryBridgeStatusResp
 func (m *QueryBridgeStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBridgeStatusResponse) ProtoMessage()    {}
 func (*QueryBridgeStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e7780f9db9d346e, []int{31}
+	return fileDescriptor_5e7780f9db9d346e, []int{41}
 }
 func (m *QueryBridgeStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,6 +1995,86 @@ func (m *QueryBridgeStatusResponse) GetMetadata() BridgeStatusMetadata {
 	return BridgeStatusMetadata{}
 }
 
+type QueryBridgeHaltsRequest struct {
+}
+
+func (m *QueryBridgeHaltsRequest) Reset()         { *m = QueryBridgeHaltsRequest{} }
+func (m *QueryBridgeHaltsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeHaltsRequest) ProtoMessage()    {}
+func (*QueryBridgeHaltsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{42}
+}
+func (m *QueryBridgeHaltsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeHaltsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeHaltsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeHaltsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeHaltsRequest.Merge(m, src)
+}
+func (m *QueryBridgeHaltsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeHaltsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeHaltsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeHaltsRequest proto.InternalMessageInfo
+
+type QueryBridgeHaltsResponse struct {
+	Halts []BridgeSwitch `protobuf:"bytes,1,rep,name=halts,proto3" json:"halts"`
+}
+
+func (m *QueryBridgeHaltsResponse) Reset()         { *m = QueryBridgeHaltsResponse{} }
+func (m *QueryBridgeHaltsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeHaltsResponse) ProtoMessage()    {}
+func (*QueryBridgeHaltsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e7780f9db9d346e, []int{43}
+}
+func (m *QueryBridgeHaltsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeHaltsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeHaltsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeHaltsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeHaltsResponse.Merge(m, src)
+}
+func (m *QueryBridgeHaltsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeHaltsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeHaltsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeHaltsResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeHaltsResponse) GetHalts() []BridgeSwitch {
+	if m != nil {
+		return m.Halts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lbm.fbridge.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lbm.fbridge.v1.QueryParamsResponse")
@@ -1494,6 +2082,12 @@ func init() {
 	proto.RegisterType((*QueryNextSeqSendResponse)(nil), "lbm.fbridge.v1.QueryNextSeqSendResponse")
 	proto.RegisterType((*QuerySeqToBlocknumsRequest)(nil), "lbm.fbridge.v1.QuerySeqToBlocknumsRequest")
 	proto.RegisterType((*QuerySeqToBlocknumsResponse)(nil), "lbm.fbridge.v1.QuerySeqToBlocknumsResponse")
+	proto.RegisterType((*QueryRoutesRequest)(nil), "lbm.fbridge.v1.QueryRoutesRequest")
+	proto.RegisterType((*QueryRoutesResponse)(nil), "lbm.fbridge.v1.QueryRoutesResponse")
+	proto.RegisterType((*QueryRouteRequest)(nil), "lbm.fbridge.v1.QueryRouteRequest")
+	proto.RegisterType((*QueryRouteResponse)(nil), "lbm.fbridge.v1.QueryRouteResponse")
+	proto.RegisterType((*QueryTransferQuotaRequest)(nil), "lbm.fbridge.v1.QueryTransferQuotaRequest")
+	proto.RegisterType((*QueryTransferQuotaResponse)(nil), "lbm.fbridge.v1.QueryTransferQuotaResponse")
 	proto.RegisterType((*QueryGreatestSeqByOperatorRequest)(nil), "lbm.fbridge.v1.QueryGreatestSeqByOperatorRequest")
 	proto.RegisterType((*QueryGreatestSeqByOperatorResponse)(nil), "lbm.fbridge.v1.QueryGreatestSeqByOperatorResponse")
 	proto.RegisterType((*QueryGreatestConsecutiveConfirmedSeqRequest)(nil), "lbm.fbridge.v1.QueryGreatestConsecutiveConfirmedSeqRequest")
@@ -1514,107 +2108,147 @@ func init() {
 	proto.RegisterType((*QueryProposalsResponse)(nil), "lbm.fbridge.v1.QueryProposalsResponse")
 	proto.RegisterType((*QueryProposalRequest)(nil), "lbm.fbridge.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "lbm.fbridge.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposalResultsRequest)(nil), "lbm.fbridge.v1.QueryProposalResultsRequest")
+	proto.RegisterType((*QueryProposalResultsResponse)(nil), "lbm.fbridge.v1.QueryProposalResultsResponse")
+	proto.RegisterType((*QueryProposalResultRequest)(nil), "lbm.fbridge.v1.QueryProposalResultRequest")
+	proto.RegisterType((*QueryProposalResultResponse)(nil), "lbm.fbridge.v1.QueryProposalResultResponse")
 	proto.RegisterType((*QueryVoteRequest)(nil), "lbm.fbridge.v1.QueryVoteRequest")
 	proto.RegisterType((*QueryVoteResponse)(nil), "lbm.fbridge.v1.QueryVoteResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "lbm.fbridge.v1.QueryVotesRequest")
 	proto.RegisterType((*QueryVotesResponse)(nil), "lbm.fbridge.v1.QueryVotesResponse")
 	proto.RegisterType((*QueryBridgeStatusRequest)(nil), "lbm.fbridge.v1.QueryBridgeStatusRequest")
 	proto.RegisterType((*QueryBridgeStatusResponse)(nil), "lbm.fbridge.v1.QueryBridgeStatusResponse")
+	proto.RegisterType((*QueryBridgeHaltsRequest)(nil), "lbm.fbridge.v1.QueryBridgeHaltsRequest")
+	proto.RegisterType((*QueryBridgeHaltsResponse)(nil), "lbm.fbridge.v1.QueryBridgeHaltsResponse")
 }
 
 func init() { proto.RegisterFile("lbm/fbridge/v1/query.proto", fileDescriptor_5e7780f9db9d346e) }
 
 var fileDescriptor_5e7780f9db9d346e = []byte{
-	// 1422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb6, 0x6e, 0x9a, 0x3c, 0xa3, 0xaa, 0x4c, 0xd3, 0x36, 0xd9, 0x06, 0x3b, 0xd9, 0xfe,
-	0x48, 0xd3, 0x34, 0x3b, 0xb1, 0xdb, 0x12, 0x44, 0x7f, 0x50, 0x25, 0x90, 0xaa, 0xa0, 0x96, 0xe2,
-	0x54, 0x20, 0x71, 0x89, 0xd6, 0xde, 0xa9, 0xbb, 0xaa, 0x77, 0xc7, 0xde, 0x59, 0x5b, 0xad, 0x42,
-	0x2e, 0x20, 0x24, 0xe0, 0x84, 0x04, 0x1c, 0x7a, 0xe0, 0xc4, 0x85, 0xbf, 0x01, 0x89, 0x7b, 0xc5,
-	0xa9, 0x12, 0x12, 0xe2, 0x84, 0x50, 0xc3, 0x1f, 0x82, 0x76, 0xf6, 0xcd, 0xda, 0x5e, 0xef, 0xda,
-	0x0e, 0x17, 0x6e, 0xe3, 0xd9, 0xef, 0xbd, 0xef, 0x7b, 0x6f, 0x66, 0xdf, 0x7e, 0x32, 0xe8, 0x8d,
-	0xaa, 0x4b, 0x1f, 0x55, 0x7d, 0xc7, 0xae, 0x33, 0xda, 0x29, 0xd1, 0x56, 0x9b, 0xf9, 0xcf, 0xcc,
-	0xa6, 0xcf, 0x03, 0x4e, 0x8e, 0x35, 0xaa, 0xae, 0x89, 0xcf, 0xcc, 0x4e, 0x49, 0x9f, 0xaf, 0x73,
-	0x5e, 0x6f, 0x30, 0x6a, 0x35, 0x1d, 0x6a, 0x79, 0x1e, 0x0f, 0xac, 0xc0, 0xe1, 0x9e, 0x88, 0xd0,
-	0xfa, 0x4c, 0x9d, 0xd7, 0xb9, 0x5c, 0xd2, 0x70, 0x85, 0xbb, 0x97, 0x6a, 0x5c, 0xb8, 0x5c, 0xd0,
-	0xaa, 0x25, 0x58, 0x94, 0x9c, 0x76, 0x4a, 0x55, 0x16, 0x58, 0x25, 0xda, 0xb4, 0xea, 0x8e, 0x27,
-	0x53, 0x20, 0x76, 0x3e, 0xa1, 0x45, 0x51, 0xcb, 0xa7, 0xc6, 0x0c, 0x90, 0x8f, 0xc2, 0xf8, 0x07,
-	0x96, 0x6f, 0xb9, 0xa2, 0xc2, 0x5a, 0x6d, 0x26, 0x02, 0xe3, 0x03, 0x38, 0xd1, 0xb7, 0x2b, 0x9a,
-	0xdc, 0x13, 0x8c, 0x5c, 0x85, 0xc9, 0xa6, 0xdc, 0x99, 0xd5, 0x16, 0xb4, 0x8b, 0xf9, 0xf2, 0x29,
-	0xb3, 0xbf, 0x16, 0x33, 0xc2, 0x6f, 0xe4, 0x5e, 0xfc, 0x55, 0x9c, 0xa8, 0x20, 0xd6, 0x98, 0x83,
-	0xd3, 0x32, 0xd9, 0x7d, 0xf6, 0x34, 0xd8, 0x66, 0xad, 0x6d, 0xe6, 0xd9, 0x8a, 0xe7, 0x32, 0xcc,
-	0x0e, 0x3e, 0x42, 0xb2, 0xe3, 0x70, 0x58, 0xb0, 0x96, 0x64, 0xca, 0x55, 0xc2, 0xa5, 0xb1, 0x06,
-	0xba, 0x44, 0x6f, 0xb3, 0xd6, 0x43, 0xbe, 0xd1, 0xe0, 0xb5, 0x27, 0x5e, 0x3b, 0xd6, 0x4c, 0x08,
-	0xe4, 0x04, 0x6b, 0x85, 0xd2, 0x0e, 0x5f, 0xcc, 0x55, 0xe4, 0xda, 0xb8, 0x0e, 0x67, 0x52, 0x23,
-	0x90, 0x62, 0x1e, 0xa6, 0xab, 0x6a, 0x13, 0xe3, 0xba, 0x1b, 0xc6, 0x3b, 0xb0, 0x28, 0x83, 0xef,
-	0xf8, 0xcc, 0x0a, 0x98, 0x08, 0x05, 0x6e, 0x3c, 0xfb, 0xb0, 0xc9, 0x7c, 0x2b, 0xe0, 0xbe, 0x62,
-	0xd5, 0x61, 0x8a, 0xe3, 0x96, 0x94, 0x3a, 0x5d, 0x89, 0x7f, 0x1b, 0x6f, 0x82, 0x31, 0x2c, 0x41,
-	0x66, 0x9d, 0xab, 0xb0, 0xd2, 0x17, 0xb7, 0x19, 0xe2, 0x6a, 0xed, 0xc0, 0xe9, 0xb0, 0x4d, 0xee,
-	0x3d, 0x72, 0x7c, 0x97, 0xd9, 0xdb, 0xac, 0xa5, 0x9a, 0x78, 0x1b, 0x2e, 0x8f, 0x07, 0xcf, 0x24,
-	0xbc, 0x0f, 0x85, 0xa8, 0x4d, 0xed, 0xaa, 0xeb, 0x04, 0x01, 0xb3, 0x1f, 0xf8, 0xbc, 0xe3, 0x08,
-	0x87, 0x7b, 0x63, 0x94, 0xa9, 0xf2, 0x1d, 0xea, 0xe6, 0x7b, 0xae, 0x41, 0x31, 0x33, 0x21, 0xaa,
-	0x58, 0x87, 0x9c, 0x6d, 0x05, 0x16, 0xde, 0xa4, 0x37, 0x06, 0x6e, 0x92, 0x0a, 0x78, 0xd7, 0x0a,
-	0x2c, 0xbc, 0x50, 0x32, 0x80, 0xdc, 0x84, 0x49, 0x11, 0x58, 0x41, 0x5b, 0x48, 0xc6, 0x7c, 0xb9,
-	0x98, 0x19, 0xba, 0x2d, 0x61, 0xea, 0x36, 0x46, 0x41, 0xc6, 0x43, 0x58, 0xc0, 0x2b, 0xc7, 0x6c,
-	0x66, 0x4b, 0x81, 0x42, 0x82, 0x59, 0x4b, 0x8c, 0x53, 0xed, 0x0c, 0x1c, 0xf1, 0x2d, 0xaf, 0xce,
-	0xb0, 0xde, 0xe8, 0x87, 0xb1, 0x8e, 0x77, 0x25, 0x3d, 0x2b, 0x96, 0x9c, 0x76, 0x43, 0xcb, 0xd8,
-	0xfa, 0xf8, 0xa4, 0x06, 0x5a, 0x3f, 0x78, 0x5c, 0x71, 0x7b, 0xd3, 0x82, 0xfe, 0xe7, 0xf6, 0xae,
-	0xe0, 0xcb, 0xbe, 0xc9, 0x5d, 0xd7, 0x09, 0x5c, 0xe6, 0x05, 0x22, 0xbb, 0x90, 0x1b, 0xf8, 0xfa,
-	0xf7, 0x81, 0xb1, 0x80, 0x05, 0xc8, 0xd7, 0xba, 0xdb, 0xb2, 0x67, 0xd3, 0x95, 0xde, 0x2d, 0x63,
-	0x19, 0x87, 0xd4, 0x3d, 0xe6, 0x56, 0x99, 0xdf, 0x3b, 0x07, 0x7c, 0xde, 0x60, 0x78, 0x70, 0x72,
-	0x6d, 0xac, 0xc1, 0x4c, 0x3f, 0x14, 0x49, 0x66, 0xe1, 0xa8, 0x1b, 0x6d, 0x21, 0x81, 0xfa, 0x69,
-	0x98, 0x38, 0x17, 0xa3, 0x08, 0x95, 0x7b, 0x16, 0x8e, 0x5a, 0xb6, 0xed, 0x33, 0x21, 0x30, 0xbd,
-	0xfa, 0x99, 0x10, 0xd3, 0x7b, 0xe4, 0x03, 0x62, 0x76, 0xe0, 0x64, 0x34, 0x5c, 0x7d, 0xde, 0xe4,
-	0xc2, 0x6a, 0xc4, 0xca, 0xb7, 0x00, 0xba, 0xd3, 0x1b, 0x4f, 0xee, 0x82, 0x19, 0x8d, 0x7a, 0x33,
-	0x1c, 0xf5, 0x66, 0xf4, 0x1d, 0xc1, 0x51, 0x6f, 0x3e, 0xb0, 0xea, 0x0c, 0x63, 0x2b, 0x3d, 0x91,
-	0xc6, 0x4f, 0x1a, 0x9c, 0x4a, 0x32, 0xa0, 0x9e, 0xdb, 0x30, 0xdd, 0x54, 0x9b, 0xb2, 0xe4, 0x7c,
-	0x79, 0x3e, 0x79, 0xc0, 0x15, 0xde, 0x60, 0x2a, 0x12, 0x4f, 0xb7, 0x1b, 0x44, 0xee, 0xf4, 0x89,
-	0x8c, 0xee, 0xc8, 0xd2, 0x48, 0x91, 0x11, 0x7d, 0x9f, 0xca, 0x75, 0x3c, 0x13, 0x45, 0xa5, 0xba,
-	0x50, 0x84, 0xbc, 0x62, 0xdb, 0x71, 0x6c, 0xbc, 0x2e, 0xa0, 0xb6, 0xee, 0xda, 0xc6, 0x27, 0x89,
-	0xfe, 0xc5, 0xc5, 0xdd, 0x82, 0x29, 0x05, 0xc3, 0xee, 0x8d, 0x53, 0x5b, 0x1c, 0x63, 0xdc, 0x85,
-	0xe3, 0x32, 0xf1, 0xc7, 0x3c, 0x60, 0xe3, 0xaa, 0x09, 0xe7, 0x41, 0x87, 0x07, 0xcc, 0x97, 0xad,
-	0x98, 0xae, 0x44, 0x3f, 0x8c, 0x4d, 0x78, 0xbd, 0x27, 0x15, 0xea, 0x33, 0x21, 0x17, 0x3e, 0x45,
-	0x6d, 0x33, 0x49, 0x6d, 0x21, 0x56, 0xbd, 0x8a, 0x21, 0xce, 0xb8, 0xda, 0x93, 0x44, 0x8c, 0xdd,
-	0x9e, 0x2d, 0xbc, 0xb9, 0x18, 0x85, 0xdc, 0x6b, 0x91, 0x4c, 0x75, 0xe8, 0xc3, 0xc8, 0x23, 0xa0,
-	0xa1, 0xe3, 0xcb, 0xb9, 0x21, 0x31, 0xd1, 0xcb, 0xae, 0x3e, 0x39, 0xcf, 0x35, 0x98, 0x4b, 0x79,
-	0xd8, 0xb5, 0x09, 0x38, 0x42, 0x42, 0x75, 0xc7, 0x06, 0x4f, 0xa1, 0x2f, 0x0a, 0xb1, 0x64, 0x0b,
-	0xa6, 0x5c, 0x16, 0x58, 0x72, 0x6a, 0x45, 0xd7, 0xea, 0xdc, 0xb0, 0xb8, 0x7b, 0x88, 0x55, 0xa7,
-	0xa8, 0x62, 0xcb, 0x3f, 0x9e, 0x80, 0x23, 0x52, 0x1b, 0x69, 0xc1, 0x64, 0x64, 0x48, 0x88, 0x91,
-	0xcc, 0x34, 0xe8, 0x79, 0xf4, 0xb3, 0x43, 0x31, 0x51, 0x69, 0x46, 0xe1, 0xf3, 0xdf, 0xff, 0xf9,
-	0xee, 0xd0, 0x2c, 0x39, 0x45, 0x13, 0xae, 0x2a, 0xf2, 0x3a, 0xe4, 0x6b, 0x0d, 0xf2, 0x3d, 0x66,
-	0x86, 0x2c, 0xa5, 0x26, 0x1d, 0x74, 0x42, 0xfa, 0xc5, 0xd1, 0x40, 0x94, 0xb0, 0x24, 0x25, 0x2c,
-	0x92, 0x62, 0x52, 0x82, 0x60, 0x9e, 0xed, 0x78, 0x75, 0xea, 0xb1, 0xa7, 0x81, 0x60, 0x2d, 0xf2,
-	0xbd, 0x06, 0xc7, 0xfa, 0x8d, 0x0f, 0xb9, 0x94, 0xca, 0x92, 0xea, 0xa7, 0xf4, 0x95, 0xb1, 0xb0,
-	0x28, 0x6a, 0x59, 0x8a, 0x3a, 0x4b, 0x16, 0xb3, 0x44, 0xc5, 0xb6, 0x8a, 0xfc, 0xa2, 0xc1, 0xc9,
-	0x54, 0x47, 0x44, 0x4a, 0xa9, 0x8c, 0xc3, 0xec, 0x97, 0x5e, 0x3e, 0x48, 0x08, 0x6a, 0x7d, 0x5b,
-	0x6a, 0xbd, 0x4a, 0xca, 0x49, 0xad, 0x3e, 0xab, 0x31, 0xa7, 0x13, 0xaa, 0x55, 0x5f, 0x7b, 0x41,
-	0x77, 0xd5, 0x72, 0x8f, 0x86, 0x3d, 0xfd, 0x43, 0x83, 0xe2, 0x08, 0x9f, 0x45, 0xae, 0x0f, 0xd5,
-	0x34, 0xdc, 0xcc, 0xe9, 0x37, 0xfe, 0x5b, 0x30, 0x96, 0xf6, 0x96, 0x2c, 0xad, 0x4c, 0xd6, 0xb2,
-	0x4b, 0xab, 0x63, 0xaa, 0x9d, 0x9a, 0x4a, 0xb0, 0x13, 0x16, 0xf6, 0xab, 0x06, 0x64, 0xd0, 0xad,
-	0x11, 0x33, 0xfd, 0x12, 0x64, 0xf9, 0x44, 0x9d, 0x8e, 0x8d, 0x47, 0xc5, 0x5b, 0x52, 0xf1, 0x6d,
-	0x72, 0xeb, 0x80, 0x87, 0xd1, 0x54, 0x99, 0xe8, 0xae, 0x60, 0xad, 0x3d, 0xf2, 0xb3, 0x06, 0x64,
-	0xd0, 0x0e, 0x65, 0xe8, 0xcf, 0x34, 0x5b, 0x19, 0xfa, 0xb3, 0x7d, 0x96, 0x51, 0x92, 0xfa, 0x57,
-	0xc8, 0x72, 0xb6, 0xfe, 0xa4, 0xd4, 0xdf, 0x34, 0x98, 0x49, 0xf3, 0x89, 0x64, 0x2d, 0x63, 0x06,
-	0x64, 0x1a, 0x55, 0xbd, 0x74, 0x80, 0x08, 0x14, 0x7c, 0x4f, 0x0a, 0xbe, 0x43, 0xde, 0x3b, 0x60,
-	0xc3, 0x3d, 0x99, 0x74, 0x47, 0xc4, 0x59, 0xc3, 0x6b, 0x23, 0xc8, 0x0f, 0x1a, 0xe4, 0x7b, 0xec,
-	0x5b, 0xc6, 0xc0, 0x1b, 0x74, 0x83, 0x19, 0x03, 0x2f, 0xc5, 0x09, 0x1a, 0x57, 0xa4, 0xe2, 0x55,
-	0xb2, 0x92, 0xad, 0xb8, 0xc7, 0x16, 0x62, 0x93, 0xdb, 0x70, 0x14, 0xcd, 0x1e, 0x49, 0x1f, 0xec,
-	0xfd, 0xae, 0x51, 0x3f, 0x37, 0x1c, 0x84, 0x52, 0x8a, 0x52, 0xca, 0x1c, 0x39, 0x9d, 0x94, 0x82,
-	0xb6, 0x91, 0x7c, 0x06, 0x93, 0x51, 0x4c, 0xc6, 0x27, 0xa7, 0xcf, 0x4e, 0xea, 0x67, 0x87, 0x62,
-	0x46, 0x8d, 0x56, 0xe4, 0xa4, 0xbb, 0xe8, 0x41, 0xf7, 0xc8, 0x1e, 0x4c, 0xc7, 0x96, 0x8f, 0x9c,
-	0x4f, 0xff, 0x9e, 0x25, 0x4c, 0xa7, 0x7e, 0x61, 0x14, 0x0c, 0x65, 0x2c, 0x4a, 0x19, 0x67, 0xc8,
-	0xdc, 0xc0, 0x97, 0x2f, 0x66, 0xfc, 0x4a, 0x83, 0x29, 0x15, 0x48, 0xce, 0x0d, 0xcd, 0xab, 0xd8,
-	0xcf, 0x8f, 0x40, 0x21, 0x39, 0x95, 0xe4, 0xcb, 0x64, 0x29, 0x93, 0x9c, 0xee, 0xf6, 0xb8, 0xa2,
-	0x3d, 0xf2, 0x8d, 0x06, 0xb9, 0xd0, 0xd2, 0x90, 0x85, 0x54, 0x82, 0x1e, 0x87, 0xa7, 0x2f, 0x0e,
-	0x41, 0x20, 0xfd, 0x4d, 0x49, 0xbf, 0x4e, 0xae, 0x8d, 0x49, 0x4f, 0xa5, 0x83, 0xa2, 0xbb, 0xd2,
-	0x0b, 0xee, 0x91, 0x2f, 0x35, 0x38, 0x22, 0xdd, 0x18, 0xc9, 0xe6, 0x8a, 0xcf, 0xc3, 0x18, 0x06,
-	0x41, 0x3d, 0xd7, 0xa4, 0x1e, 0x4a, 0x56, 0x0f, 0xa4, 0x87, 0x7c, 0xa1, 0xc1, 0x6b, 0xbd, 0x16,
-	0x8a, 0xa4, 0xbf, 0x83, 0x29, 0x86, 0x4f, 0x5f, 0x1e, 0x03, 0x39, 0xca, 0x22, 0x45, 0x3e, 0x6f,
-	0xe3, 0xfd, 0x17, 0xaf, 0x0a, 0xda, 0xcb, 0x57, 0x05, 0xed, 0xef, 0x57, 0x05, 0xed, 0xdb, 0xfd,
-	0xc2, 0xc4, 0xcb, 0xfd, 0xc2, 0xc4, 0x9f, 0xfb, 0x85, 0x89, 0x4f, 0xd7, 0xea, 0x4e, 0xf0, 0xb8,
-	0x5d, 0x35, 0x6b, 0xdc, 0xa5, 0x5b, 0x8e, 0x27, 0x6a, 0x8f, 0x1d, 0x8b, 0x3e, 0xc2, 0xc5, 0xaa,
-	0xb0, 0x9f, 0xd0, 0xa7, 0x71, 0xbe, 0xe0, 0x59, 0x93, 0x89, 0xea, 0xa4, 0xfc, 0x13, 0xeb, 0xca,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xe6, 0xb2, 0x67, 0x70, 0x13, 0x00, 0x00,
+	// 1955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xef, 0x6f, 0x1c, 0x47,
+	0x19, 0xce, 0x3a, 0xfe, 0xf9, 0x1a, 0xd2, 0x74, 0xea, 0xa4, 0xf6, 0xc6, 0xbd, 0xb3, 0x27, 0xbf,
+	0xec, 0x38, 0xb9, 0xf5, 0x39, 0x69, 0x53, 0x68, 0x53, 0x82, 0x5d, 0x1c, 0x52, 0x94, 0x92, 0x9e,
+	0xa3, 0x22, 0x21, 0xd0, 0x69, 0xee, 0x76, 0x72, 0x5e, 0xf5, 0x76, 0xf7, 0x6e, 0x67, 0xcf, 0x38,
+	0x32, 0xf7, 0x05, 0x04, 0xa2, 0x48, 0x48, 0x48, 0x05, 0x44, 0x3f, 0x22, 0xbe, 0x20, 0xf1, 0x1f,
+	0x20, 0xf1, 0xbd, 0xe2, 0x53, 0x25, 0x24, 0x84, 0xf8, 0x10, 0xa1, 0x84, 0xbf, 0x82, 0x4f, 0x68,
+	0x67, 0xde, 0xb9, 0xdb, 0xbd, 0xdb, 0xdd, 0x3b, 0x5b, 0x96, 0xf8, 0x76, 0x3b, 0xfb, 0xbe, 0xcf,
+	0xf3, 0xbc, 0x33, 0xef, 0xce, 0xcc, 0x63, 0x83, 0xd9, 0xac, 0xb9, 0xd6, 0xd3, 0x5a, 0xe0, 0xd8,
+	0x0d, 0x6e, 0x1d, 0x94, 0xad, 0x76, 0x87, 0x07, 0xcf, 0x4a, 0xad, 0xc0, 0x0f, 0x7d, 0x72, 0xae,
+	0x59, 0x73, 0x4b, 0xf8, 0xae, 0x74, 0x50, 0x36, 0x97, 0x1b, 0xbe, 0xdf, 0x68, 0x72, 0x8b, 0xb5,
+	0x1c, 0x8b, 0x79, 0x9e, 0x1f, 0xb2, 0xd0, 0xf1, 0x3d, 0xa1, 0xa2, 0xcd, 0x85, 0x86, 0xdf, 0xf0,
+	0xe5, 0x4f, 0x2b, 0xfa, 0x85, 0xa3, 0x37, 0xea, 0xbe, 0x70, 0x7d, 0x61, 0xd5, 0x98, 0xe0, 0x0a,
+	0xdc, 0x3a, 0x28, 0xd7, 0x78, 0xc8, 0xca, 0x56, 0x8b, 0x35, 0x1c, 0x4f, 0x42, 0x60, 0xec, 0xf2,
+	0x80, 0x16, 0x4d, 0x9d, 0xfe, 0xb6, 0xc1, 0x3d, 0x2e, 0x1c, 0x64, 0xa7, 0x0b, 0x40, 0x3e, 0x8a,
+	0xd0, 0x1f, 0xb3, 0x80, 0xb9, 0xa2, 0xc2, 0xdb, 0x1d, 0x2e, 0x42, 0xfa, 0x1d, 0x78, 0x2d, 0x31,
+	0x2a, 0x5a, 0xbe, 0x27, 0x38, 0xb9, 0x03, 0xd3, 0x2d, 0x39, 0xb2, 0x68, 0xac, 0x18, 0x6b, 0xf3,
+	0x5b, 0x17, 0x4b, 0xc9, 0x4a, 0x4b, 0x2a, 0x7e, 0x7b, 0xf2, 0x8b, 0xe7, 0xc5, 0x33, 0x15, 0x8c,
+	0xa5, 0x1f, 0xc0, 0xeb, 0x12, 0xec, 0x43, 0x7e, 0x18, 0xee, 0xf1, 0xf6, 0x1e, 0xf7, 0x6c, 0xe4,
+	0x21, 0x0b, 0x30, 0x65, 0x73, 0xcf, 0x77, 0x25, 0xde, 0x5c, 0x45, 0x3d, 0x90, 0x25, 0x98, 0xad,
+	0xef, 0x33, 0xc7, 0xab, 0x3a, 0xf6, 0xe2, 0x84, 0x7c, 0x31, 0x23, 0x9f, 0x1f, 0xda, 0xf4, 0x26,
+	0x2c, 0x0e, 0x63, 0xa1, 0xba, 0xf3, 0x70, 0x56, 0xf0, 0xb6, 0x84, 0x9a, 0xac, 0x44, 0x3f, 0x29,
+	0x03, 0x53, 0x46, 0xef, 0xf1, 0xf6, 0x13, 0x7f, 0xbb, 0xe9, 0xd7, 0x3f, 0xf1, 0x3a, 0xbd, 0x22,
+	0x09, 0x81, 0x49, 0xc1, 0xdb, 0x51, 0x2d, 0x67, 0xd7, 0x26, 0x2b, 0xf2, 0x77, 0x5f, 0xd0, 0x44,
+	0x96, 0xa0, 0xb3, 0x49, 0x41, 0xef, 0xc0, 0xa5, 0x54, 0x0a, 0xd4, 0xb4, 0x0c, 0x73, 0x35, 0x3d,
+	0x88, 0x44, 0xfd, 0x01, 0xfa, 0x03, 0x9c, 0xfc, 0x8a, 0xdf, 0x09, 0x79, 0x4f, 0xd7, 0x2e, 0x40,
+	0x7f, 0x89, 0x71, 0xa6, 0xaf, 0x95, 0x54, 0x3f, 0x94, 0xa2, 0x7e, 0x28, 0xa9, 0x66, 0xc3, 0x7e,
+	0x28, 0x3d, 0x66, 0x0d, 0x8e, 0xb9, 0x95, 0x58, 0x26, 0xfd, 0xcc, 0xc0, 0x55, 0xd4, 0xf0, 0xa8,
+	0xe9, 0x36, 0x4c, 0x07, 0x72, 0x44, 0x0a, 0x9a, 0xdf, 0xba, 0x30, 0xb8, 0x8a, 0x32, 0x5e, 0x2f,
+	0xa2, 0x0a, 0x25, 0x0f, 0x12, 0xa2, 0x26, 0xa4, 0xa8, 0xeb, 0x23, 0x45, 0x29, 0xc6, 0x84, 0xaa,
+	0xf7, 0xe1, 0xd5, 0xbe, 0xa8, 0x13, 0xf7, 0x41, 0x2d, 0x3e, 0x73, 0xbd, 0xca, 0xca, 0x30, 0x25,
+	0xe5, 0xe2, 0xa4, 0xe5, 0x16, 0xa6, 0x22, 0x23, 0x0e, 0x8f, 0x1f, 0x86, 0xd5, 0xa8, 0x73, 0x26,
+	0x64, 0xe7, 0xcc, 0x78, 0xaa, 0xb7, 0xe8, 0x25, 0x58, 0x92, 0x1c, 0x4f, 0x02, 0xe6, 0x89, 0xa7,
+	0x3c, 0xf8, 0xa8, 0xe3, 0x87, 0x4c, 0x7f, 0x21, 0xbf, 0x3f, 0x8b, 0xbd, 0x35, 0xf0, 0x16, 0x95,
+	0xd4, 0xe0, 0x35, 0x97, 0x1d, 0x56, 0x43, 0x7c, 0x59, 0x65, 0xae, 0xdf, 0xf1, 0x42, 0x55, 0xde,
+	0xf6, 0x56, 0x24, 0xe0, 0x5f, 0xcf, 0x8b, 0x37, 0x1a, 0x4e, 0xb8, 0xdf, 0xa9, 0x95, 0xea, 0xbe,
+	0x6b, 0xed, 0x3a, 0x9e, 0xa8, 0xef, 0x3b, 0xcc, 0x7a, 0x8a, 0x3f, 0x6e, 0x09, 0xfb, 0x13, 0x2b,
+	0x7c, 0xd6, 0xe2, 0xa2, 0xf4, 0xd0, 0x0b, 0x2b, 0xaf, 0xba, 0xec, 0x50, 0x53, 0x7d, 0x53, 0x82,
+	0x45, 0x1c, 0x3d, 0xfc, 0x03, 0xbf, 0xd9, 0x71, 0x79, 0xb5, 0xce, 0x5a, 0x6a, 0xa6, 0x4e, 0xc6,
+	0xa1, 0xe1, 0x3e, 0x96, 0x68, 0x3b, 0xac, 0x45, 0xf6, 0x60, 0xbe, 0x23, 0xb8, 0x8d, 0xf8, 0xaa,
+	0xf9, 0x4f, 0x84, 0x0d, 0x11, 0x8c, 0xc2, 0x25, 0x3f, 0x84, 0xf3, 0x01, 0x77, 0x99, 0xe3, 0x39,
+	0x5e, 0x43, 0x23, 0x4f, 0x9e, 0x18, 0xf9, 0x95, 0x1e, 0x96, 0x82, 0xa7, 0xdf, 0x80, 0x55, 0xb9,
+	0x32, 0x0f, 0x02, 0xce, 0x42, 0x2e, 0xa2, 0xb5, 0xdc, 0x7e, 0xf6, 0xdd, 0x16, 0x0f, 0x58, 0xe8,
+	0x07, 0xba, 0xe3, 0x4c, 0x98, 0xf5, 0x71, 0x08, 0x9b, 0xae, 0xf7, 0x4c, 0xdf, 0x02, 0x9a, 0x07,
+	0x90, 0xb9, 0xdd, 0xdc, 0x82, 0x8d, 0x44, 0xde, 0x4e, 0x14, 0x57, 0xef, 0x84, 0xce, 0x01, 0xdf,
+	0xf1, 0xbd, 0xa7, 0x4e, 0xe0, 0x72, 0x7b, 0x8f, 0xb7, 0x75, 0x0b, 0xdd, 0x87, 0x9b, 0xe3, 0x85,
+	0x67, 0x12, 0x7e, 0x08, 0x05, 0xb5, 0xf9, 0x74, 0x6a, 0xae, 0x13, 0x86, 0xdc, 0x7e, 0x1c, 0xf8,
+	0x07, 0x8e, 0x70, 0x7c, 0x6f, 0x8c, 0x32, 0x35, 0xde, 0x44, 0x1f, 0xef, 0x73, 0x03, 0x8a, 0x99,
+	0x80, 0xa8, 0xe2, 0x2e, 0x4c, 0xda, 0x2c, 0x64, 0xf8, 0x89, 0xbd, 0x31, 0x74, 0x02, 0xe8, 0x84,
+	0xf7, 0x59, 0xc8, 0xf0, 0x53, 0x93, 0x09, 0xe4, 0x1e, 0x4c, 0x8b, 0x90, 0x85, 0x1d, 0x81, 0xbb,
+	0x47, 0x31, 0x33, 0x75, 0x4f, 0x86, 0xe9, 0x0d, 0x48, 0x25, 0xd1, 0x27, 0xb0, 0x82, 0x3b, 0x3f,
+	0xb7, 0xb9, 0x2d, 0x05, 0x0a, 0x19, 0xcc, 0xdb, 0x62, 0x9c, 0x6a, 0x17, 0x60, 0x2a, 0x60, 0x5e,
+	0x83, 0x63, 0xbd, 0xea, 0x81, 0xde, 0xc5, 0x5e, 0x49, 0x47, 0xc5, 0x92, 0x53, 0x0e, 0x0a, 0xba,
+	0x85, 0x53, 0xdf, 0x5b, 0xa9, 0xa1, 0xa9, 0x1f, 0x5e, 0xae, 0xde, 0xf4, 0xa6, 0x25, 0xfd, 0x9f,
+	0xa7, 0x77, 0x03, 0x0f, 0xe9, 0x1d, 0xdf, 0x75, 0x9d, 0xd0, 0xe5, 0x5e, 0x28, 0xb2, 0x0b, 0x79,
+	0x17, 0x4f, 0xe1, 0x44, 0x30, 0x16, 0xb0, 0x02, 0xf3, 0xf5, 0xfe, 0xb0, 0x9c, 0xb3, 0xb9, 0x4a,
+	0x7c, 0x88, 0xae, 0xe3, 0xb1, 0xf4, 0x88, 0xbb, 0x35, 0x1e, 0xc4, 0x8f, 0xe3, 0xc0, 0x6f, 0x72,
+	0x5c, 0x38, 0xf9, 0x9b, 0x6e, 0xc2, 0x42, 0x32, 0x14, 0x49, 0x16, 0x61, 0xc6, 0x55, 0x43, 0x48,
+	0xa0, 0x1f, 0x69, 0x09, 0x0f, 0x06, 0x95, 0xa1, 0xb1, 0x17, 0x61, 0x86, 0xd9, 0x76, 0xc0, 0x85,
+	0x40, 0x78, 0xfd, 0x38, 0x20, 0x26, 0xbe, 0xe4, 0x43, 0x62, 0xaa, 0x70, 0x41, 0x5d, 0x8a, 0x02,
+	0xbf, 0xe5, 0x0b, 0xd6, 0x3c, 0xf5, 0x03, 0xfb, 0x8f, 0x06, 0x5c, 0x1c, 0x64, 0x40, 0x3d, 0xf7,
+	0x61, 0xae, 0xa5, 0x07, 0xf1, 0xd8, 0x5e, 0x1e, 0x3e, 0xdd, 0x9a, 0x5c, 0x67, 0xe2, 0xea, 0xf6,
+	0x93, 0x4e, 0xef, 0x00, 0xbf, 0x8b, 0x6b, 0xa2, 0xa9, 0xf4, 0x2c, 0x14, 0x61, 0x5e, 0xb3, 0x45,
+	0x07, 0xb6, 0x6a, 0x17, 0xd0, 0x43, 0x0f, 0x6d, 0xfa, 0xbd, 0x81, 0xf9, 0xeb, 0x15, 0xf7, 0x1e,
+	0xcc, 0xea, 0x30, 0x9c, 0xbd, 0x71, 0x6a, 0xeb, 0xe5, 0x50, 0x8e, 0x77, 0xb0, 0x18, 0x70, 0xa7,
+	0x19, 0x9e, 0xfa, 0xf2, 0xfc, 0xd9, 0x80, 0xe5, 0x74, 0x1e, 0xac, 0x63, 0x1b, 0x66, 0x02, 0x35,
+	0x84, 0x4b, 0x44, 0xf3, 0xca, 0x50, 0xd9, 0x58, 0x8c, 0x4e, 0x3c, 0xbd, 0x65, 0xba, 0x87, 0xf7,
+	0x93, 0x24, 0xdd, 0xd8, 0x8b, 0x55, 0x4d, 0x9d, 0xd3, 0x58, 0x3f, 0x4e, 0x2b, 0xc5, 0x38, 0x9f,
+	0xe3, 0x57, 0x8a, 0x79, 0xf4, 0x21, 0x9c, 0x97, 0x04, 0x1f, 0xfb, 0xfd, 0x6b, 0xe0, 0x28, 0x55,
+	0xd1, 0x26, 0x7e, 0xe0, 0x87, 0x3c, 0xd0, 0xd7, 0x73, 0xf9, 0x40, 0x77, 0xf0, 0x4a, 0xa9, 0xa0,
+	0x50, 0x61, 0x09, 0x26, 0xa3, 0xb7, 0xa8, 0x6f, 0x61, 0x50, 0x5f, 0x14, 0xab, 0xf7, 0xcf, 0x28,
+	0x8e, 0xde, 0x89, 0x81, 0x88, 0xb1, 0xa7, 0x69, 0x17, 0xb7, 0x1b, 0xcc, 0x42, 0xee, 0x4d, 0x25,
+	0x53, 0xb7, 0x41, 0x1e, 0xb9, 0x0a, 0xa4, 0x26, 0xee, 0xa8, 0xdb, 0x32, 0x46, 0xed, 0xd0, 0xfa,
+	0x9e, 0xf0, 0xb9, 0x81, 0x17, 0xd1, 0xe4, 0xcb, 0xbe, 0x27, 0xc3, 0x7d, 0x3f, 0x52, 0x77, 0x6e,
+	0xf8, 0xd3, 0x49, 0x64, 0x61, 0x2c, 0xd9, 0x85, 0x59, 0x97, 0x87, 0x4c, 0x1e, 0x35, 0xaa, 0xc9,
+	0xae, 0xe4, 0xe5, 0x3d, 0xc2, 0x58, 0xfd, 0xe9, 0xe9, 0x5c, 0xba, 0x84, 0xc7, 0x86, 0x0a, 0xfe,
+	0x36, 0xeb, 0x7f, 0x76, 0xf4, 0x49, 0xa2, 0x24, 0x7c, 0x85, 0xa2, 0xdf, 0x86, 0xa9, 0x7d, 0xd6,
+	0xff, 0x4e, 0xb2, 0x34, 0xff, 0xc8, 0x09, 0xeb, 0xfb, 0x7a, 0xa2, 0x64, 0xc2, 0xd6, 0x7f, 0x97,
+	0x60, 0x4a, 0xc2, 0x92, 0x36, 0x4c, 0x2b, 0xbb, 0x49, 0x86, 0x9a, 0x6f, 0xd8, 0xd1, 0x9a, 0x97,
+	0x73, 0x63, 0x94, 0x2c, 0x5a, 0xf8, 0xc9, 0xdf, 0xff, 0xf3, 0xd9, 0xc4, 0x22, 0xb9, 0x68, 0x0d,
+	0x78, 0x66, 0xe5, 0x64, 0xc9, 0xa7, 0x06, 0xcc, 0xc7, 0x9c, 0x27, 0xb9, 0x9e, 0x0a, 0x3a, 0xec,
+	0x73, 0xcd, 0xb5, 0xd1, 0x81, 0x28, 0xe1, 0xba, 0x94, 0xb0, 0x4a, 0x8a, 0x83, 0x12, 0x04, 0xf7,
+	0x6c, 0xc7, 0x6b, 0x58, 0x91, 0x3b, 0x11, 0xbc, 0x4d, 0x7e, 0x63, 0xc0, 0xb9, 0xa4, 0xe9, 0x24,
+	0x37, 0x52, 0x59, 0x52, 0xcd, 0xaf, 0xb9, 0x31, 0x56, 0x2c, 0x8a, 0x5a, 0x97, 0xa2, 0x2e, 0x93,
+	0xd5, 0x2c, 0x51, 0x3d, 0x4b, 0x4b, 0x9e, 0xc1, 0xb4, 0xb2, 0x9b, 0x19, 0xab, 0x92, 0xb0, 0xba,
+	0x19, 0xab, 0x92, 0xf4, 0xab, 0xf4, 0x9a, 0x64, 0x5f, 0x21, 0x85, 0x2c, 0x76, 0xb4, 0xa8, 0x9f,
+	0x1a, 0x30, 0x25, 0x53, 0xc9, 0x6a, 0x36, 0xac, 0x66, 0xa6, 0x79, 0x21, 0x48, 0xfc, 0x35, 0x49,
+	0x7c, 0x9b, 0x94, 0xf3, 0x89, 0xad, 0x23, 0x69, 0x57, 0xbb, 0xd6, 0x91, 0x76, 0xab, 0x5d, 0xf2,
+	0x2b, 0x03, 0xbe, 0x9a, 0x70, 0x86, 0x64, 0x3d, 0x95, 0x30, 0xcd, 0x5b, 0x9a, 0x37, 0xc6, 0x09,
+	0x45, 0x8d, 0x57, 0xa5, 0xc6, 0x22, 0x79, 0x23, 0x4b, 0x63, 0x5b, 0xb2, 0xff, 0xc5, 0x80, 0x0b,
+	0xa9, 0x76, 0x86, 0x94, 0x53, 0xc9, 0xf2, 0xbc, 0x93, 0xb9, 0x75, 0x9c, 0x14, 0xd4, 0xf9, 0x75,
+	0xa9, 0xf3, 0x0e, 0xd9, 0x1a, 0xd4, 0x19, 0xf0, 0x3a, 0x77, 0x0e, 0x22, 0xa5, 0xfa, 0xaa, 0x2e,
+	0xac, 0x23, 0xfd, 0xb3, 0x6b, 0x45, 0xad, 0xfe, 0x0f, 0x03, 0x8a, 0x23, 0x4c, 0x12, 0x79, 0x27,
+	0x57, 0x53, 0xbe, 0x13, 0x33, 0xdf, 0x3d, 0x59, 0x32, 0x96, 0xf6, 0xb6, 0x2c, 0x6d, 0x8b, 0x6c,
+	0x66, 0x97, 0xd6, 0x40, 0xa8, 0x6a, 0x5d, 0x03, 0x54, 0xa3, 0xc2, 0xfe, 0x6a, 0x00, 0x19, 0xb6,
+	0x5a, 0xa4, 0x94, 0xfe, 0x6d, 0x66, 0x99, 0x3c, 0xd3, 0x1a, 0x3b, 0x1e, 0x15, 0xef, 0x4a, 0xc5,
+	0xf7, 0xc9, 0x7b, 0xc7, 0x5c, 0x8c, 0x96, 0x46, 0xb2, 0x8e, 0x04, 0x6f, 0x77, 0xc9, 0x9f, 0x0c,
+	0x20, 0xc3, 0x5e, 0x26, 0x43, 0x7f, 0xa6, 0x53, 0xca, 0xd0, 0x9f, 0x6d, 0x92, 0x68, 0x59, 0xea,
+	0xdf, 0x20, 0xeb, 0xd9, 0xfa, 0x07, 0xa5, 0xfe, 0xcd, 0x80, 0x85, 0x34, 0x93, 0x47, 0x36, 0x33,
+	0xb6, 0xe6, 0x4c, 0x97, 0x69, 0x96, 0x8f, 0x91, 0x81, 0x82, 0x1f, 0x49, 0xc1, 0x0f, 0xc8, 0xb7,
+	0x8e, 0x39, 0xe1, 0x9e, 0x04, 0xad, 0x8a, 0x1e, 0x6a, 0x55, 0xfe, 0x95, 0xf2, 0xb7, 0x06, 0xcc,
+	0xc7, 0xbc, 0x57, 0xc6, 0x39, 0x34, 0x6c, 0xe5, 0x32, 0xce, 0xa1, 0x14, 0x1b, 0x47, 0x6f, 0x4b,
+	0xc5, 0xb7, 0xc8, 0x46, 0xb6, 0xe2, 0x98, 0xa7, 0xc3, 0x49, 0xee, 0xc0, 0x0c, 0x3a, 0x35, 0x92,
+	0xbe, 0xb3, 0x27, 0x2d, 0x9f, 0x79, 0x25, 0x3f, 0x08, 0xa5, 0x14, 0xa5, 0x94, 0x25, 0xf2, 0xfa,
+	0xa0, 0x14, 0xf4, 0x7c, 0xe4, 0xc7, 0x30, 0xad, 0x72, 0x32, 0xce, 0x9c, 0x84, 0x17, 0x34, 0x2f,
+	0xe7, 0xc6, 0x8c, 0x3a, 0xf1, 0x90, 0xd3, 0x3a, 0x42, 0x03, 0xd9, 0x25, 0x5d, 0x98, 0xeb, 0xf9,
+	0x35, 0x72, 0x35, 0xfd, 0x9a, 0x31, 0xe0, 0x18, 0xcd, 0x6b, 0xa3, 0xc2, 0x50, 0xc6, 0xaa, 0x94,
+	0x71, 0x89, 0x2c, 0x0d, 0x5d, 0x48, 0x7a, 0x8c, 0xbf, 0x30, 0x60, 0x56, 0x27, 0x92, 0x2b, 0xb9,
+	0xb8, 0x9a, 0xfd, 0xea, 0x88, 0x28, 0x24, 0xb7, 0x24, 0xf9, 0x3a, 0xb9, 0x9e, 0x49, 0x6e, 0x1d,
+	0xc5, 0x6e, 0xc7, 0x5d, 0xf2, 0x3b, 0x03, 0x5e, 0x19, 0xf0, 0x46, 0x64, 0x63, 0x14, 0x57, 0xcc,
+	0xa9, 0x99, 0x37, 0xc7, 0x0b, 0x46, 0x7d, 0x6b, 0x52, 0x1f, 0x25, 0x2b, 0x59, 0xfa, 0xaa, 0xda,
+	0x54, 0xfd, 0xc1, 0x80, 0x73, 0x49, 0x94, 0x8c, 0xbb, 0x52, 0xaa, 0x59, 0x32, 0x37, 0xc6, 0x8a,
+	0x45, 0x55, 0x6f, 0x49, 0x55, 0x9b, 0xa4, 0x34, 0x4a, 0xd5, 0xc0, 0xe4, 0xfd, 0xd2, 0x80, 0xc9,
+	0xc8, 0x17, 0x90, 0x95, 0x54, 0xb6, 0x98, 0x4d, 0x32, 0x57, 0x73, 0x22, 0x50, 0xc5, 0x3d, 0xa9,
+	0xe2, 0x2e, 0x79, 0x73, 0xcc, 0xb5, 0xb3, 0xa4, 0x0d, 0xb1, 0x8e, 0xa4, 0xa1, 0xea, 0x92, 0x9f,
+	0x19, 0x30, 0x25, 0x2d, 0x0d, 0xc9, 0xe6, 0x12, 0xf9, 0x57, 0xa9, 0x84, 0x23, 0xa2, 0x6f, 0x4a,
+	0x3d, 0x16, 0xb9, 0x75, 0x2c, 0x3d, 0xe4, 0xa7, 0x06, 0x7c, 0x25, 0xee, 0x43, 0x48, 0xfa, 0x06,
+	0x96, 0xe2, 0x9a, 0xcc, 0xf5, 0x31, 0x22, 0x47, 0x5d, 0xfb, 0xd1, 0x2c, 0xfd, 0xdc, 0x80, 0xf9,
+	0x98, 0x8b, 0xc9, 0xd8, 0x6e, 0x87, 0x2d, 0x90, 0xb9, 0x36, 0x3a, 0x10, 0x25, 0x5c, 0x91, 0x12,
+	0x0a, 0x64, 0x39, 0x5d, 0x82, 0x25, 0xcd, 0xcf, 0xf6, 0x07, 0x5f, 0xbc, 0x28, 0x18, 0x5f, 0xbe,
+	0x28, 0x18, 0xff, 0x7e, 0x51, 0x30, 0x7e, 0xfd, 0xb2, 0x70, 0xe6, 0xcb, 0x97, 0x85, 0x33, 0xff,
+	0x7c, 0x59, 0x38, 0xf3, 0xfd, 0xcd, 0x91, 0x7f, 0x30, 0x3f, 0xec, 0xa1, 0xca, 0x3f, 0x9d, 0xd7,
+	0xa6, 0xe5, 0xff, 0xff, 0x6e, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x3f, 0xe4, 0x0c, 0xc9,
+	0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1635,6 +2269,12 @@ type QueryClient interface {
 	NextSeqSend(ctx context.Context, in *QueryNextSeqSendRequest, opts ...grpc.CallOption) (*QueryNextSeqSendResponse, error)
 	// BlocknumToSeqs queries a list of block numbers for which each sequence has been confirmed.
 	SeqToBlocknums(ctx context.Context, in *QuerySeqToBlocknumsRequest, opts ...grpc.CallOption) (*QuerySeqToBlocknumsResponse, error)
+	// Routes queries all the bridge routes other than the default route
+	Routes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
+	// Route queries a bridge route of a denom to a destination chain
+	Route(ctx context.Context, in *QueryRouteRequest, opts ...grpc.CallOption) (*QueryRouteResponse, error)
+	// TransferQuota queries the outbound transfer limits and the remaining volume of the current window
+	TransferQuota(ctx context.Context, in *QueryTransferQuotaRequest, opts ...grpc.CallOption) (*QueryTransferQuotaResponse, error)
 	// GreatestSeqByOperator queries a greatest sequence number confirmed by a particular operator
 	GreatestSeqByOperator(ctx context.Context, in *QueryGreatestSeqByOperatorRequest, opts ...grpc.CallOption) (*QueryGreatestSeqByOperatorResponse, error)
 	// GreatestConsecutiveConfirmedSeq queries a greatest consecutive sequence number confirmed by n-of-m operators
@@ -1658,12 +2298,18 @@ type QueryClient interface {
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
 	// Proposal queries a SuggestRole Proposal
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// ProposalResults queries the final tallies of the past role proposals
+	ProposalResults(ctx context.Context, in *QueryProposalResultsRequest, opts ...grpc.CallOption) (*QueryProposalResultsResponse, error)
+	// ProposalResult queries the final tally of a past role proposal
+	ProposalResult(ctx context.Context, in *QueryProposalResultRequest, opts ...grpc.CallOption) (*QueryProposalResultResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 	// BridgeStatus queries the status of the bridge
 	BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error)
+	// BridgeHalts queries the bridge switches of the guardians who have halted the bridge and their expiry times
+	BridgeHalts(ctx context.Context, in *QueryBridgeHaltsRequest, opts ...grpc.CallOption) (*QueryBridgeHaltsResponse, error)
 }
 
 type queryClient struct {
@@ -1701,6 +2347,33 @@ func (c *queryClient) SeqToBlocknums(ctx context.Context, in *QuerySeqToBlocknum
 	return out, nil
 }
 
+func (c *queryClient) Routes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/Routes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Route(ctx context.Context, in *QueryRouteRequest, opts ...grpc.CallOption) (*QueryRouteResponse, error) {
+	out := new(QueryRouteResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/Route", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferQuota(ctx context.Context, in *QueryTransferQuotaRequest, opts ...grpc.CallOption) (*QueryTransferQuotaResponse, error) {
+	out := new(QueryTransferQuotaResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/TransferQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GreatestSeqByOperator(ctx context.Context, in *QueryGreatestSeqByOperatorRequest, opts ...grpc.CallOption) (*QueryGreatestSeqByOperatorResponse, error) {
 	out := new(QueryGreatestSeqByOperatorResponse)
 	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/GreatestSeqByOperator", in, out, opts...)
@@ -1791,6 +2464,24 @@ func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ProposalResults(ctx context.Context, in *QueryProposalResultsRequest, opts ...grpc.CallOption) (*QueryProposalResultsResponse, error) {
+	out := new(QueryProposalResultsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/ProposalResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalResult(ctx context.Context, in *QueryProposalResultRequest, opts ...grpc.CallOption) (*QueryProposalResultResponse, error) {
+	out := new(QueryProposalResultResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/ProposalResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
 	out := new(QueryVoteResponse)
 	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/Vote", in, out, opts...)
@@ -1818,6 +2509,15 @@ func (c *queryClient) BridgeStatus(ctx context.Context, in *QueryBridgeStatusReq
 	return out, nil
 }
 
+func (c *queryClient) BridgeHalts(ctx context.Context, in *QueryBridgeHaltsRequest, opts ...grpc.CallOption) (*QueryBridgeHaltsResponse, error) {
+	out := new(QueryBridgeHaltsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Query/BridgeHalts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/fbridge module.
@@ -1826,6 +2526,12 @@ type QueryServer interface {
 	NextSeqSend(context.Context, *QueryNextSeqSendRequest) (*QueryNextSeqSendResponse, error)
 	// BlocknumToSeqs queries a list of block numbers for which each sequence has been confirmed.
 	SeqToBlocknums(context.Context, *QuerySeqToBlocknumsRequest) (*QuerySeqToBlocknumsResponse, error)
+	// Routes queries all the bridge routes other than the default route
+	Routes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
+	// Route queries a bridge route of a denom to a destination chain
+	Route(context.Context, *QueryRouteRequest) (*QueryRouteResponse, error)
+	// TransferQuota queries the outbound transfer limits and the remaining volume of the current window
+	TransferQuota(context.Context, *QueryTransferQuotaRequest) (*QueryTransferQuotaResponse, error)
 	// GreatestSeqByOperator queries a greatest sequence number confirmed by a particular operator
 	GreatestSeqByOperator(context.Context, *QueryGreatestSeqByOperatorRequest) (*QueryGreatestSeqByOperatorResponse, error)
 	// GreatestConsecutiveConfirmedSeq queries a greatest consecutive sequence number confirmed by n-of-m operators
@@ -1849,12 +2555,18 @@ type QueryServer interface {
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
 	// Proposal queries a SuggestRole Proposal
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// ProposalResults queries the final tallies of the past role proposals
+	ProposalResults(context.Context, *QueryProposalResultsRequest) (*QueryProposalResultsResponse, error)
+	// ProposalResult queries the final tally of a past role proposal
+	ProposalResult(context.Context, *QueryProposalResultRequest) (*QueryProposalResultResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	// BridgeStatus queries the status of the bridge
 	BridgeStatus(context.Context, *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error)
+	// BridgeHalts queries the bridge switches of the guardians who have halted the bridge and their expiry times
+	BridgeHalts(context.Context, *QueryBridgeHaltsRequest) (*QueryBridgeHaltsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1870,6 +2582,15 @@ func (*UnimplementedQueryServer) NextSeqSend(ctx context.Context, req *QueryNext
 func (*UnimplementedQueryServer) SeqToBlocknums(ctx context.Context, req *QuerySeqToBlocknumsRequest) (*QuerySeqToBlocknumsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SeqToBlocknums not implemented")
 }
+func (*UnimplementedQueryServer) Routes(ctx context.Context, req *QueryRoutesRequest) (*QueryRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Routes not implemented")
+}
+func (*UnimplementedQueryServer) Route(ctx context.Context, req *QueryRouteRequest) (*QueryRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Route not implemented")
+}
+func (*UnimplementedQueryServer) TransferQuota(ctx context.Context, req *QueryTransferQuotaRequest) (*QueryTransferQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferQuota not implemented")
+}
 func (*UnimplementedQueryServer) GreatestSeqByOperator(ctx context.Context, req *QueryGreatestSeqByOperatorRequest) (*QueryGreatestSeqByOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GreatestSeqByOperator not implemented")
 }
@@ -1900,6 +2621,12 @@ func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryPropos
 func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
 }
+func (*UnimplementedQueryServer) ProposalResults(ctx context.Context, req *QueryProposalResultsRequest) (*QueryProposalResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalResults not implemented")
+}
+func (*UnimplementedQueryServer) ProposalResult(ctx context.Context, req *QueryProposalResultRequest) (*QueryProposalResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalResult not implemented")
+}
 func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
@@ -1909,6 +2636,9 @@ func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesReque
 func (*UnimplementedQueryServer) BridgeStatus(ctx context.Context, req *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeStatus not implemented")
 }
+func (*UnimplementedQueryServer) BridgeHalts(ctx context.Context, req *QueryBridgeHaltsRequest) (*QueryBridgeHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeHalts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1968,81 +2698,135 @@ func _Query_SeqToBlocknums_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GreatestSeqByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGreatestSeqByOperatorRequest)
+func _Query_Routes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GreatestSeqByOperator(ctx, in)
+		return srv.(QueryServer).Routes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.fbridge.v1.Query/GreatestSeqByOperator",
+		FullMethod: "/lbm.fbridge.v1.Query/Routes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GreatestSeqByOperator(ctx, req.(*QueryGreatestSeqByOperatorRequest))
+		return srv.(QueryServer).Routes(ctx, req.(*QueryRoutesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GreatestConsecutiveConfirmedSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGreatestConsecutiveConfirmedSeqRequest)
+func _Query_Route_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GreatestConsecutiveConfirmedSeq(ctx, in)
+		return srv.(QueryServer).Route(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.fbridge.v1.Query/GreatestConsecutiveConfirmedSeq",
+		FullMethod: "/lbm.fbridge.v1.Query/Route",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GreatestConsecutiveConfirmedSeq(ctx, req.(*QueryGreatestConsecutiveConfirmedSeqRequest))
+		return srv.(QueryServer).Route(ctx, req.(*QueryRouteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_SubmittedProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySubmittedProvisionRequest)
+func _Query_TransferQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferQuotaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).SubmittedProvision(ctx, in)
+		return srv.(QueryServer).TransferQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.fbridge.v1.Query/SubmittedProvision",
+		FullMethod: "/lbm.fbridge.v1.Query/TransferQuota",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SubmittedProvision(ctx, req.(*QuerySubmittedProvisionRequest))
+		return srv.(QueryServer).TransferQuota(ctx, req.(*QueryTransferQuotaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ConfirmedProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConfirmedProvisionRequest)
+func _Query_GreatestSeqByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGreatestSeqByOperatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ConfirmedProvision(ctx, in)
+		return srv.(QueryServer).GreatestSeqByOperator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.fbridge.v1.Query/ConfirmedProvision",
+		FullMethod: "/lbm.fbridge.v1.Query/GreatestSeqByOperator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConfirmedProvision(ctx, req.(*QueryConfirmedProvisionRequest))
+		return srv.(QueryServer).GreatestSeqByOperator(ctx, req.(*QueryGreatestSeqByOperatorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_NeededSubmissionSeqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNeededSubmissionSeqsRequest)
-	if err := dec(in); err != nil {
+func _Query_GreatestConsecutiveConfirmedSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGreatestConsecutiveConfirmedSeqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GreatestConsecutiveConfirmedSeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Query/GreatestConsecutiveConfirmedSeq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GreatestConsecutiveConfirmedSeq(ctx, req.(*QueryGreatestConsecutiveConfirmedSeqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubmittedProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubmittedProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubmittedProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Query/SubmittedProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubmittedProvision(ctx, req.(*QuerySubmittedProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConfirmedProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfirmedProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConfirmedProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Query/ConfirmedProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConfirmedProvision(ctx, req.(*QueryConfirmedProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NeededSubmissionSeqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNeededSubmissionSeqsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -2148,6 +2932,42 @@ func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Query/ProposalResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalResults(ctx, req.(*QueryProposalResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Query/ProposalResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalResult(ctx, req.(*QueryProposalResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteRequest)
 	if err := dec(in); err != nil {
@@ -2202,6 +3022,24 @@ func _Query_BridgeStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BridgeHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Query/BridgeHalts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeHalts(ctx, req.(*QueryBridgeHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.fbridge.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2218,6 +3056,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SeqToBlocknums",
 			Handler:    _Query_SeqToBlocknums_Handler,
 		},
+		{
+			MethodName: "Routes",
+			Handler:    _Query_Routes_Handler,
+		},
+		{
+			MethodName: "Route",
+			Handler:    _Query_Route_Handler,
+		},
+		{
+			MethodName: "TransferQuota",
+			Handler:    _Query_TransferQuota_Handler,
+		},
 		{
 			MethodName: "GreatestSeqByOperator",
 			Handler:    _Query_GreatestSeqByOperator_Handler,
@@ -2258,6 +3108,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Proposal",
 			Handler:    _Query_Proposal_Handler,
 		},
+		{
+			MethodName: "ProposalResults",
+			Handler:    _Query_ProposalResults_Handler,
+		},
+		{
+			MethodName: "ProposalResult",
+			Handler:    _Query_ProposalResult_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
@@ -2270,6 +3128,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeStatus",
 			Handler:    _Query_BridgeStatus_Handler,
 		},
+		{
+			MethodName: "BridgeHalts",
+			Handler:    _Query_BridgeHalts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/fbridge/v1/query.proto",
@@ -2351,6 +3213,20 @@ func (m *QueryNextSeqSendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -2402,6 +3278,20 @@ func (m *QuerySeqToBlocknumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Seqs) > 0 {
 		dAtA3 := make([]byte, len(m.Seqs)*10)
 		var j2 int
@@ -2464,7 +3354,7 @@ func (m *QuerySeqToBlocknumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGreatestSeqByOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2474,27 +3364,32 @@ func (m *QueryGreatestSeqByOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGreatestSeqByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGreatestSeqByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGreatestSeqByOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRoutesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2504,25 +3399,46 @@ func (m *QueryGreatestSeqByOperatorResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGreatestSeqByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGreatestSeqByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRouteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2532,20 +3448,34 @@ func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRouteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRouteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2555,25 +3485,35 @@ func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRouteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
+	if m.NextSeq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSeq))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySubmittedProvisionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTransferQuotaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2583,32 +3523,20 @@ func (m *QuerySubmittedProvisionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySubmittedProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTransferQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySubmittedProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTransferQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySubmittedProvisionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTransferQuotaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2618,32 +3546,52 @@ func (m *QuerySubmittedProvisionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySubmittedProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTransferQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySubmittedProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTransferQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.RemainingVolume.Size()
+		i -= size
+		if _, err := m.RemainingVolume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UsedVolume.Size()
 		i -= size
+		if _, err := m.UsedVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	{
-		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TransferVolumeCap.Size()
+		i -= size
+		if _, err := m.TransferVolumeCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxTransferAmount.Size()
 		i -= size
+		if _, err := m.MaxTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -2651,7 +3599,7 @@ func (m *QuerySubmittedProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNeededSubmissionSeqsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGreatestSeqByOperatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2661,21 +3609,16 @@ func (m *QueryNeededSubmissionSeqsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNeededSubmissionSeqsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGreatestSeqByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNeededSubmissionSeqsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGreatestSeqByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Range != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Range))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -2686,7 +3629,7 @@ func (m *QueryNeededSubmissionSeqsRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNeededSubmissionSeqsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGreatestSeqByOperatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2696,38 +3639,25 @@ func (m *QueryNeededSubmissionSeqsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNeededSubmissionSeqsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGreatestSeqByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNeededSubmissionSeqsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGreatestSeqByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Seqs) > 0 {
-		dAtA9 := make([]byte, len(m.Seqs)*10)
-		var j8 int
-		for _, num := range m.Seqs {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintQuery(dAtA, i, uint64(j8))
+	if m.Seq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConfirmedProvisionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2737,7 +3667,212 @@ func (m *QueryConfirmedProvisionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConfirmedProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGreatestConsecutiveConfirmedSeqRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGreatestConsecutiveConfirmedSeqRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGreatestConsecutiveConfirmedSeqResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGreatestConsecutiveConfirmedSeqResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubmittedProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubmittedProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubmittedProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubmittedProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubmittedProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubmittedProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNeededSubmissionSeqsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNeededSubmissionSeqsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNeededSubmissionSeqsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Range != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Range))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNeededSubmissionSeqsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNeededSubmissionSeqsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNeededSubmissionSeqsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seqs) > 0 {
+		dAtA12 := make([]byte, len(m.Seqs)*10)
+		var j11 int
+		for _, num := range m.Seqs {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfirmedProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfirmedProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -3125,7 +4260,7 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProposalResultsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3135,32 +4270,32 @@ func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProposalResultsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProposalResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryProposalResultsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3170,30 +4305,46 @@ func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProposalResultsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProposalResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProposalResultRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3203,12 +4354,12 @@ func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProposalResultRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProposalResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3221,7 +4372,7 @@ func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryProposalResultResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3231,7 +4382,136 @@ func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProposalResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -3319,6 +4599,66 @@ func (m *QueryBridgeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBridgeHaltsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeHaltsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeHaltsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeHaltsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeHaltsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeHaltsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Halts) > 0 {
+		for iNdEx := len(m.Halts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Halts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3356,6 +4696,14 @@ func (m *QueryNextSeqSendRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3384,6 +4732,14 @@ func (m *QuerySeqToBlocknumsRequest) Size() (n int) {
 		}
 		n += 1 + sovQuery(uint64(l)) + l
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3403,82 +4759,96 @@ func (m *QuerySeqToBlocknumsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGreatestSeqByOperatorRequest) Size() (n int) {
+func (m *QueryRoutesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGreatestSeqByOperatorResponse) Size() (n int) {
+func (m *QueryRoutesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovQuery(uint64(m.Seq))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Size() (n int) {
+func (m *QueryRouteRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Size() (n int) {
+func (m *QueryRouteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovQuery(uint64(m.Seq))
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextSeq != 0 {
+		n += 1 + sovQuery(uint64(m.NextSeq))
 	}
 	return n
 }
 
-func (m *QuerySubmittedProvisionRequest) Size() (n int) {
+func (m *QueryTransferQuotaRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Seq != 0 {
-		n += 1 + sovQuery(uint64(m.Seq))
-	}
 	return n
 }
 
-func (m *QuerySubmittedProvisionResponse) Size() (n int) {
+func (m *QueryTransferQuotaResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Data.Size()
+	l = m.MaxTransferAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = m.Status.Size()
+	l = m.TransferVolumeCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UsedVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingVolume.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryNeededSubmissionSeqsRequest) Size() (n int) {
+func (m *QueryGreatestSeqByOperatorRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3488,51 +4858,126 @@ func (m *QueryNeededSubmissionSeqsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Range != 0 {
-		n += 1 + sovQuery(uint64(m.Range))
-	}
 	return n
 }
 
-func (m *QueryNeededSubmissionSeqsResponse) Size() (n int) {
+func (m *QueryGreatestSeqByOperatorResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Seqs) > 0 {
-		l = 0
-		for _, e := range m.Seqs {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	if m.Seq != 0 {
+		n += 1 + sovQuery(uint64(m.Seq))
 	}
 	return n
 }
 
-func (m *QueryConfirmedProvisionRequest) Size() (n int) {
+func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovQuery(uint64(m.Seq))
-	}
 	return n
 }
 
-func (m *QueryConfirmedProvisionResponse) Size() (n int) {
+func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Data.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Seq != 0 {
+		n += 1 + sovQuery(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *QuerySubmittedProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovQuery(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *QuerySubmittedProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNeededSubmissionSeqsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Range != 0 {
+		n += 1 + sovQuery(uint64(m.Range))
+	}
+	return n
+}
+
+func (m *QueryNeededSubmissionSeqsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Seqs) > 0 {
+		l = 0
+		for _, e := range m.Seqs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryConfirmedProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovQuery(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *QueryConfirmedProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryCommitmentsRequest) Size() (n int) {
@@ -3671,6 +5116,61 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryVoteRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3748,6 +5248,30 @@ func (m *QueryBridgeStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBridgeHaltsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgeHaltsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Halts) > 0 {
+		for _, e := range m.Halts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3916,6 +5440,70 @@ func (m *QueryNextSeqSendRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryNextSeqSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4111,25 +5699,89 @@ func (m *QuerySeqToBlocknumsRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Seqs", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *QuerySeqToBlocknumsResponse) Unmarshal(dAtA []byte) error {
@@ -4258,7 +5910,7 @@ func (m *QuerySeqToBlocknumsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGreatestSeqByOperatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4281,17 +5933,17 @@ func (m *QueryGreatestSeqByOperatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGreatestSeqByOperatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGreatestSeqByOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4301,23 +5953,27 @@ func (m *QueryGreatestSeqByOperatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4340,7 +5996,7 @@ func (m *QueryGreatestSeqByOperatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGreatestSeqByOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4363,17 +6019,17 @@ func (m *QueryGreatestSeqByOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGreatestSeqByOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGreatestSeqByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.Seq = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4383,11 +6039,62 @@ func (m *QueryGreatestSeqByOperatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4409,7 +6116,7 @@ func (m *QueryGreatestSeqByOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4432,12 +6139,76 @@ func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4459,7 +6230,7 @@ func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4478,21 +6249,763 @@ func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Unmarshal(dAtA []byte) er
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeq", wireType)
+			}
+			m.NextSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsedVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGreatestSeqByOperatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGreatestSeqByOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGreatestSeqByOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGreatestSeqByOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGreatestSeqByOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGreatestSeqByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGreatestConsecutiveConfirmedSeqRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGreatestConsecutiveConfirmedSeqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubmittedProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubmittedProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubmittedProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubmittedProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubmittedProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubmittedProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Seq = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4502,11 +7015,25 @@ func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4528,7 +7055,7 @@ func (m *QueryGreatestConsecutiveConfirmedSeqResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QuerySubmittedProvisionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNeededSubmissionSeqsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4551,10 +7078,10 @@ func (m *QuerySubmittedProvisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubmittedProvisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNeededSubmissionSeqsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubmittedProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNeededSubmissionSeqsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4591,9 +7118,9 @@ func (m *QuerySubmittedProvisionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
-			m.Seq = 0
+			m.Range = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4603,7 +7130,7 @@ func (m *QuerySubmittedProvisionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				m.Range |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4629,7 +7156,7 @@ func (m *QuerySubmittedProvisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubmittedProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNeededSubmissionSeqsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4652,50 +7179,143 @@ func (m *QuerySubmittedProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubmittedProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNeededSubmissionSeqsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubmittedProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNeededSubmissionSeqsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Seqs = append(m.Seqs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Seqs) == 0 {
+					m.Seqs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Seqs = append(m.Seqs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seqs", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfirmedProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfirmedProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfirmedProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4705,25 +7325,11 @@ func (m *QuerySubmittedProvisionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4745,7 +7351,7 @@ func (m *QuerySubmittedProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNeededSubmissionSeqsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConfirmedProvisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4768,17 +7374,17 @@ func (m *QueryNeededSubmissionSeqsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNeededSubmissionSeqsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConfirmedProvisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNeededSubmissionSeqsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConfirmedProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4788,29 +7394,30 @@ func (m *QueryNeededSubmissionSeqsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Range = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4820,11 +7427,25 @@ func (m *QueryNeededSubmissionSeqsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Range |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4846,7 +7467,7 @@ func (m *QueryNeededSubmissionSeqsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNeededSubmissionSeqsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4869,87 +7490,30 @@ func (m *QueryNeededSubmissionSeqsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNeededSubmissionSeqsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNeededSubmissionSeqsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Seqs = append(m.Seqs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Seqs) == 0 {
-					m.Seqs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Seqs = append(m.Seqs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seqs", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4972,7 +7536,7 @@ func (m *QueryNeededSubmissionSeqsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfirmedProvisionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4995,17 +7559,17 @@ func (m *QueryConfirmedProvisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfirmedProvisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfirmedProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
-			m.Seq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5015,11 +7579,24 @@ func (m *QueryConfirmedProvisionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5041,7 +7618,7 @@ func (m *QueryConfirmedProvisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfirmedProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5064,17 +7641,17 @@ func (m *QueryConfirmedProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfirmedProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfirmedProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5084,30 +7661,79 @@ func (m *QueryConfirmedProvisionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5117,24 +7743,23 @@ func (m *QueryConfirmedProvisionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5157,7 +7782,7 @@ func (m *QueryConfirmedProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5180,17 +7805,17 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Seq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5200,11 +7825,24 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5226,7 +7864,7 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5249,15 +7887,15 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5285,7 +7923,7 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5308,7 +7946,7 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5331,17 +7969,17 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5351,23 +7989,27 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5390,7 +8032,7 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5413,17 +8055,17 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5433,23 +8075,61 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.Proposals = append(m.Proposals, RoleProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5472,7 +8152,7 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5495,17 +8175,17 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5515,24 +8195,11 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5554,7 +8221,7 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5577,17 +8244,17 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5597,23 +8264,24 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5636,7 +8304,7 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5659,10 +8327,10 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5722,7 +8390,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5745,15 +8413,15 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5780,8 +8448,8 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, RoleProposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, RoleProposalResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5842,7 +8510,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5865,10 +8533,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5911,7 +8579,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5934,15 +8602,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5969,7 +8637,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6483,6 +9151,140 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBridgeHaltsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeHaltsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeHaltsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeHaltsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeHaltsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeHaltsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Halts = append(m.Halts, BridgeSwitch{})
+			if err := m.Halts[len(m.Halts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -129,6 +129,10 @@ type MsgTransfer struct {
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be transferred
 	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// the denom of the route. Leave both denom and chain_id empty to use the default route.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route
+	ChainId string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -178,6 +182,20 @@ func (m *MsgTransfer) GetReceiver() string {
 	return ""
 }
 
+func (m *MsgTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTransfer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 type MsgTransferResponse struct {
 }
 
@@ -226,6 +244,8 @@ type MsgProvision struct {
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be claimed
 	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// the denom of the route. Leave it empty to use the default route.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgProvision) Reset()         { *m = MsgProvision{} }
@@ -289,6 +309,13 @@ func (m *MsgProvision) GetReceiver() string {
 	return ""
 }
 
+func (m *MsgProvision) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type MsgProvisionResponse struct {
 }
 
@@ -327,7 +354,7 @@ var xxx_messageInfo_MsgProvisionResponse proto.InternalMessageInfo
 
 // MsgHoldTransfer is input values required for holding transfer
 type MsgHoldTransfer struct {
-	// the judge address
+	// the guardian address
 	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	// the sequence number of the bridge request
 	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
@@ -988,6 +1015,10 @@ type MsgSetBridgeStatus struct {
 	// the guardian address
 	Guardian string       `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
 	Status   BridgeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"status,omitempty"`
+	// duration of the halt (nanoseconds). The switch of the guardian falls back to active automatically after the
+	// duration. Zero means the halt lasts until the guardian resumes it. It must be zero if the status is not inactive,
+	// and must not exceed one year.
+	HaltDuration uint64 `protobuf:"varint,3,opt,name=halt_duration,json=haltDuration,proto3" json:"halt_duration,omitempty"`
 }
 
 func (m *MsgSetBridgeStatus) Reset()         { *m = MsgSetBridgeStatus{} }
@@ -1037,6 +1068,13 @@ func (m *MsgSetBridgeStatus) GetStatus() BridgeStatus {
 	return StatusEmpty
 }
 
+func (m *MsgSetBridgeStatus) GetHaltDuration() uint64 {
+	if m != nil {
+		return m.HaltDuration
+	}
+	return 0
+}
+
 type MsgSetBridgeStatusResponse struct {
 }
 
@@ -1073,6 +1111,247 @@ func (m *MsgSetBridgeStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBridgeStatusResponse proto.InternalMessageInfo
 
+// MsgSetRoute is input values required for registering or updating a bridge route
+type MsgSetRoute struct {
+	// the authority address
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the bank denom to be bridged
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the validator of the receiver address on the destination chain
+	ReceiverValidator ReceiverValidator `protobuf:"varint,4,opt,name=receiver_validator,json=receiverValidator,proto3,enum=lbm.fbridge.v1.ReceiverValidator" json:"receiver_validator,omitempty"`
+	// the status of the route
+	Status BridgeStatus `protobuf:"varint,5,opt,name=status,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"status,omitempty"`
+	// maximum amount of a single outbound transfer through the route. Zero means no limit.
+	MaxTransferAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,6,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_transfer_amount"`
+	// maximum total amount of outbound transfers through the route within the rolling window.
+	// Zero means no cap.
+	TransferVolumeCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,7,opt,name=transfer_volume_cap,json=transferVolumeCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_volume_cap"`
+	// length of the rolling window for transfer_volume_cap (nanoseconds)
+	TransferVolumeWindow uint64 `protobuf:"varint,8,opt,name=transfer_volume_window,json=transferVolumeWindow,proto3" json:"transfer_volume_window,omitempty"`
+	// fee charged on each outbound transfer through the route
+	TransferFee TransferFee `protobuf:"bytes,9,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee"`
+}
+
+func (m *MsgSetRoute) Reset()         { *m = MsgSetRoute{} }
+func (m *MsgSetRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoute) ProtoMessage()    {}
+func (*MsgSetRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a336bc5ea063bb, []int{22}
+}
+func (m *MsgSetRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoute.Merge(m, src)
+}
+func (m *MsgSetRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRoute proto.InternalMessageInfo
+
+func (m *MsgSetRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetRoute) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgSetRoute) GetReceiverValidator() ReceiverValidator {
+	if m != nil {
+		return m.ReceiverValidator
+	}
+	return ReceiverValidatorEmpty
+}
+
+func (m *MsgSetRoute) GetStatus() BridgeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusEmpty
+}
+
+func (m *MsgSetRoute) GetTransferVolumeWindow() uint64 {
+	if m != nil {
+		return m.TransferVolumeWindow
+	}
+	return 0
+}
+
+func (m *MsgSetRoute) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
+type MsgSetRouteResponse struct {
+}
+
+func (m *MsgSetRouteResponse) Reset()         { *m = MsgSetRouteResponse{} }
+func (m *MsgSetRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRouteResponse) ProtoMessage()    {}
+func (*MsgSetRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a336bc5ea063bb, []int{23}
+}
+func (m *MsgSetRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRouteResponse.Merge(m, src)
+}
+func (m *MsgSetRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRouteResponse proto.InternalMessageInfo
+
+// MsgHaltRoute is input values required for halting a bridge route
+type MsgHaltRoute struct {
+	// the guardian address
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// the denom of the route
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the chain ID of the destination chain of the route
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgHaltRoute) Reset()         { *m = MsgHaltRoute{} }
+func (m *MsgHaltRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgHaltRoute) ProtoMessage()    {}
+func (*MsgHaltRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a336bc5ea063bb, []int{24}
+}
+func (m *MsgHaltRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHaltRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHaltRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHaltRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHaltRoute.Merge(m, src)
+}
+func (m *MsgHaltRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHaltRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHaltRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHaltRoute proto.InternalMessageInfo
+
+func (m *MsgHaltRoute) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *MsgHaltRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgHaltRoute) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type MsgHaltRouteResponse struct {
+}
+
+func (m *MsgHaltRouteResponse) Reset()         { *m = MsgHaltRouteResponse{} }
+func (m *MsgHaltRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHaltRouteResponse) ProtoMessage()    {}
+func (*MsgHaltRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a336bc5ea063bb, []int{25}
+}
+func (m *MsgHaltRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHaltRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHaltRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHaltRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHaltRouteResponse.Merge(m, src)
+}
+func (m *MsgHaltRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHaltRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHaltRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHaltRouteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "lbm.fbridge.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lbm.fbridge.v1.MsgUpdateParamsResponse")
@@ -1096,64 +1375,84 @@ func init() {
 	proto.RegisterType((*MsgAddVoteForRoleResponse)(nil), "lbm.fbridge.v1.MsgAddVoteForRoleResponse")
 	proto.RegisterType((*MsgSetBridgeStatus)(nil), "lbm.fbridge.v1.MsgSetBridgeStatus")
 	proto.RegisterType((*MsgSetBridgeStatusResponse)(nil), "lbm.fbridge.v1.MsgSetBridgeStatusResponse")
+	proto.RegisterType((*MsgSetRoute)(nil), "lbm.fbridge.v1.MsgSetRoute")
+	proto.RegisterType((*MsgSetRouteResponse)(nil), "lbm.fbridge.v1.MsgSetRouteResponse")
+	proto.RegisterType((*MsgHaltRoute)(nil), "lbm.fbridge.v1.MsgHaltRoute")
+	proto.RegisterType((*MsgHaltRouteResponse)(nil), "lbm.fbridge.v1.MsgHaltRouteResponse")
 }
 
 func init() { proto.RegisterFile("lbm/fbridge/v1/tx.proto", fileDescriptor_54a336bc5ea063bb) }
 
 var fileDescriptor_54a336bc5ea063bb = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0x49, 0x1a, 0x36, 0x27, 0x4b, 0x5a, 0x86, 0x6d, 0x36, 0x75, 0xd3, 0x64, 0x31, 0x7f,
-	0x4b, 0x25, 0x92, 0x36, 0x20, 0x21, 0x71, 0x47, 0x2a, 0x55, 0xb4, 0xc2, 0x6a, 0xe5, 0xa5, 0xa8,
-	0xe2, 0x82, 0x6a, 0x12, 0x4f, 0x26, 0x16, 0xb6, 0xc7, 0xcc, 0x4c, 0xa2, 0x54, 0xe2, 0x15, 0x90,
-	0x78, 0x18, 0x1e, 0xa2, 0x97, 0xe5, 0x0e, 0x71, 0x51, 0xa1, 0xdd, 0x17, 0x41, 0x9e, 0x9d, 0xcc,
-	0xda, 0x5e, 0x27, 0xe9, 0x8a, 0xbb, 0x19, 0x7f, 0xdf, 0xf9, 0xce, 0x77, 0x3c, 0x73, 0x8e, 0x0d,
-	0x87, 0xe1, 0x24, 0x1a, 0xce, 0x26, 0x3c, 0xf0, 0x29, 0x19, 0x2e, 0xef, 0x0f, 0xe5, 0x6a, 0x90,
-	0x70, 0x26, 0x19, 0x6a, 0x85, 0x93, 0x68, 0xa0, 0x81, 0xc1, 0xf2, 0xbe, 0x7d, 0x40, 0x19, 0x65,
-	0x0a, 0x1a, 0xa6, 0xab, 0x73, 0x96, 0xdd, 0x2d, 0x84, 0xaf, 0x03, 0x14, 0xea, 0x10, 0xb8, 0xee,
-	0x0a, 0xfa, 0x2c, 0xf1, 0xb1, 0x24, 0x4f, 0x31, 0xc7, 0x91, 0x40, 0x5d, 0x68, 0xe0, 0x85, 0x9c,
-	0x33, 0x1e, 0xc8, 0x97, 0x1d, 0xeb, 0xc8, 0x3a, 0x6e, 0x78, 0x17, 0x0f, 0xd0, 0x57, 0x50, 0x4f,
-	0x14, 0xaf, 0xf3, 0xce, 0x91, 0x75, 0xdc, 0x1c, 0xb5, 0x07, 0x79, 0x17, 0x83, 0x73, 0x95, 0x71,
-	0xed, 0xd5, 0x9b, 0x7e, 0xc5, 0xd3, 0x5c, 0xe7, 0x16, 0x1c, 0x16, 0xd2, 0x78, 0x44, 0x24, 0x2c,
-	0x16, 0xc4, 0xf9, 0xdd, 0x82, 0xa6, 0x2b, 0xe8, 0x0f, 0x1c, 0xc7, 0x62, 0x46, 0x38, 0x6a, 0x43,
-	0x5d, 0x90, 0xd8, 0x27, 0x5c, 0xe7, 0xd6, 0x3b, 0x64, 0xc3, 0x1e, 0x27, 0x53, 0x12, 0x2c, 0x09,
-	0x57, 0xa9, 0x1b, 0x9e, 0xd9, 0xa3, 0xc7, 0x50, 0xc7, 0x11, 0x5b, 0xc4, 0xb2, 0x53, 0x4d, 0x91,
-	0xf1, 0x28, 0x4d, 0xfe, 0xcf, 0x9b, 0xfe, 0x5d, 0x1a, 0xc8, 0xf9, 0x62, 0x32, 0x98, 0xb2, 0x68,
-	0xf8, 0x30, 0x88, 0xc5, 0x74, 0x1e, 0xe0, 0xe1, 0x4c, 0x2f, 0xbe, 0x10, 0xfe, 0x2f, 0x43, 0xf9,
-	0x32, 0x21, 0x62, 0xf0, 0x28, 0x96, 0x9e, 0x56, 0x70, 0x6e, 0xc2, 0x07, 0x19, 0x3b, 0xc6, 0xe6,
-	0x9f, 0x16, 0xec, 0xbb, 0x82, 0x3e, 0xe5, 0x6c, 0x19, 0x88, 0x80, 0xc5, 0x08, 0x41, 0x6d, 0xc6,
-	0x59, 0xa4, 0x5d, 0xaa, 0x35, 0xba, 0x01, 0x55, 0x41, 0x7e, 0x55, 0xf6, 0x6a, 0x5e, 0xba, 0xcc,
-	0x54, 0x53, 0xdd, 0x58, 0x4d, 0x6d, 0x63, 0x35, 0xd7, 0xfe, 0x77, 0x35, 0x6d, 0x38, 0xc8, 0xba,
-	0x36, 0xe5, 0x7c, 0xad, 0xce, 0xfd, 0x3b, 0x16, 0xfa, 0xe6, 0xc5, 0xbf, 0x55, 0x41, 0xfa, 0x24,
-	0xb3, 0x81, 0x46, 0xf3, 0x1b, 0x40, 0xae, 0xa0, 0x1e, 0x09, 0x09, 0x16, 0xe4, 0x8a, 0xb2, 0x5d,
-	0xb0, 0x2f, 0xc7, 0x5e, 0x52, 0x8e, 0xd8, 0x92, 0x5c, 0xf1, 0x04, 0x8c, 0x72, 0x2e, 0xd6, 0x28,
-	0x8f, 0xe1, 0x3d, 0x57, 0xd0, 0x07, 0x21, 0x0e, 0xa2, 0x31, 0x96, 0xd3, 0x79, 0xa9, 0xe8, 0x1d,
-	0x80, 0x08, 0xaf, 0x5e, 0x4c, 0x53, 0x96, 0xd0, 0xda, 0x8d, 0x08, 0xaf, 0x54, 0x98, 0x70, 0x0e,
-	0xe1, 0x66, 0x4e, 0xc3, 0x88, 0xdf, 0x83, 0xbd, 0x35, 0xf0, 0x96, 0x66, 0x11, 0xdc, 0x58, 0x47,
-	0x18, 0x95, 0x19, 0xb4, 0x5c, 0x41, 0x4f, 0x16, 0x94, 0x12, 0x21, 0x3d, 0x16, 0x92, 0x52, 0xad,
-	0x36, 0xd4, 0x25, 0xe6, 0x94, 0x48, 0xdd, 0x1c, 0x7a, 0x87, 0x8e, 0xa1, 0xc6, 0x59, 0x48, 0xd4,
-	0xf5, 0x6b, 0x8d, 0x0e, 0x8a, 0xdd, 0x9a, 0xea, 0x79, 0x8a, 0xe1, 0x74, 0xa0, 0x9d, 0xcf, 0x63,
-	0x1c, 0xfc, 0x06, 0xef, 0xbb, 0x82, 0x7e, 0xeb, 0xfb, 0x3f, 0x32, 0x49, 0x1e, 0x32, 0xbe, 0xd1,
-	0x44, 0x1f, 0x9a, 0x09, 0x67, 0x09, 0x13, 0x38, 0x7c, 0x11, 0xf8, 0xba, 0x30, 0x58, 0x3f, 0x7a,
-	0xe4, 0xa3, 0x11, 0xd4, 0x59, 0x22, 0x03, 0x16, 0x6b, 0x3f, 0x76, 0xd1, 0x4f, 0x9a, 0xe1, 0x89,
-	0x62, 0x78, 0x9a, 0xe9, 0xdc, 0x86, 0x5b, 0x97, 0xb2, 0x67, 0x5e, 0x4e, 0x7a, 0x33, 0x4e, 0x88,
-	0x1c, 0x2b, 0x89, 0x13, 0x89, 0xe5, 0x42, 0xa4, 0xdd, 0x45, 0x17, 0x98, 0xfb, 0x01, 0x8e, 0xb5,
-	0x3f, 0xb3, 0x4f, 0x07, 0x98, 0x50, 0x2c, 0x65, 0xaf, 0x35, 0xea, 0x16, 0x2d, 0x64, 0x95, 0x3c,
-	0xcd, 0xd5, 0xb7, 0xa8, 0x90, 0x67, 0xed, 0x62, 0xf4, 0xd7, 0xbb, 0x50, 0x75, 0x05, 0x45, 0xcf,
-	0x61, 0x3f, 0x37, 0x4a, 0xfb, 0x45, 0xed, 0xc2, 0x10, 0xb4, 0x3f, 0xdb, 0x41, 0x58, 0x67, 0x40,
-	0xdf, 0xc3, 0x9e, 0xe9, 0xa8, 0xdb, 0x25, 0x41, 0x6b, 0xd0, 0xfe, 0x68, 0x0b, 0x68, 0xd4, 0x9e,
-	0x40, 0xe3, 0xa2, 0x8d, 0xba, 0x25, 0x11, 0x06, 0xb5, 0x3f, 0xde, 0x86, 0x1a, 0xc1, 0xe7, 0xb0,
-	0x9f, 0x9b, 0x25, 0x65, 0x85, 0x67, 0x09, 0xa5, 0x85, 0x97, 0x0d, 0x15, 0x84, 0xe1, 0x7a, 0x71,
-	0xa2, 0x38, 0x25, 0xb1, 0x05, 0x8e, 0x7d, 0x77, 0x37, 0x27, 0x9f, 0x22, 0x3f, 0x5a, 0xca, 0x53,
-	0xe4, 0x38, 0x1b, 0x52, 0x94, 0x8e, 0x19, 0xe4, 0x01, 0x64, 0x66, 0xcc, 0x9d, 0x92, 0xc8, 0x0b,
-	0xd8, 0xfe, 0x64, 0x2b, 0x6c, 0x34, 0x1f, 0xc0, 0xb5, 0xf3, 0xd1, 0xd2, 0xd9, 0xc4, 0xb7, 0x8f,
-	0x36, 0x21, 0x46, 0xe4, 0x19, 0x34, 0xb3, 0x93, 0xa5, 0x57, 0x12, 0x90, 0xc1, 0xed, 0x4f, 0xb7,
-	0xe3, 0x46, 0xf6, 0x67, 0x68, 0x15, 0xc6, 0xc5, 0x87, 0x25, 0x91, 0x79, 0x8a, 0xfd, 0xf9, 0x4e,
-	0x4a, 0xf6, 0xc8, 0x8a, 0x3d, 0x5f, 0x76, 0x64, 0x05, 0x4e, 0xe9, 0x91, 0x6d, 0xe8, 0xe9, 0xf1,
-	0xe3, 0x57, 0xa7, 0x3d, 0xeb, 0xf5, 0x69, 0xcf, 0xfa, 0xf7, 0xb4, 0x67, 0xfd, 0x71, 0xd6, 0xab,
-	0xbc, 0x3e, 0xeb, 0x55, 0xfe, 0x3e, 0xeb, 0x55, 0x7e, 0xba, 0xb7, 0xf3, 0x3b, 0xbc, 0x32, 0x3f,
-	0x5c, 0xea, 0x8b, 0x3c, 0xa9, 0xab, 0x9f, 0xad, 0x2f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x99,
-	0x66, 0xc5, 0x96, 0xcb, 0x09, 0x00, 0x00,
+	// 1085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xc1, 0x6e, 0xdb, 0x46,
+	0x13, 0xb6, 0x62, 0x59, 0x91, 0xc6, 0x8e, 0x12, 0xaf, 0x1d, 0x99, 0xa6, 0x1d, 0xd9, 0x91, 0xf3,
+	0xff, 0x71, 0x0d, 0x54, 0x4a, 0xd4, 0x00, 0x05, 0x7a, 0x8b, 0x1c, 0x18, 0x71, 0x50, 0x21, 0x06,
+	0xdd, 0xb8, 0x41, 0x0b, 0x54, 0x58, 0x89, 0x2b, 0x8a, 0x28, 0xc9, 0x65, 0xb9, 0x2b, 0xc5, 0x01,
+	0xfa, 0x06, 0xbd, 0xf4, 0x31, 0xfa, 0x14, 0x3d, 0x07, 0x3d, 0xe5, 0x58, 0xf4, 0x10, 0x14, 0xf6,
+	0x0b, 0xf4, 0x11, 0x0a, 0xae, 0x96, 0x2b, 0x92, 0xa6, 0xa4, 0x38, 0xe8, 0x6d, 0x77, 0xbf, 0x6f,
+	0xbe, 0x99, 0xe1, 0xee, 0xcc, 0x48, 0xb0, 0xe1, 0x74, 0xdd, 0x46, 0xbf, 0x1b, 0xd8, 0xa6, 0x45,
+	0x1a, 0xa3, 0xc7, 0x0d, 0x7e, 0x5e, 0xf7, 0x03, 0xca, 0x29, 0x2a, 0x3b, 0x5d, 0xb7, 0x2e, 0x81,
+	0xfa, 0xe8, 0xb1, 0xbe, 0x6e, 0x51, 0x8b, 0x0a, 0xa8, 0x11, 0xae, 0xc6, 0x2c, 0x7d, 0x3b, 0x65,
+	0x1e, 0x19, 0x08, 0xb4, 0x46, 0xe0, 0x76, 0x9b, 0x59, 0xaf, 0x7c, 0x13, 0x73, 0x72, 0x82, 0x03,
+	0xec, 0x32, 0xb4, 0x0d, 0x25, 0x3c, 0xe4, 0x03, 0x1a, 0xd8, 0xfc, 0xad, 0x96, 0xdb, 0xcd, 0xed,
+	0x97, 0x8c, 0xc9, 0x01, 0x7a, 0x02, 0x05, 0x5f, 0xf0, 0xb4, 0x1b, 0xbb, 0xb9, 0xfd, 0xe5, 0x66,
+	0xa5, 0x9e, 0x8c, 0xa2, 0x3e, 0x56, 0x69, 0xe5, 0xdf, 0x7d, 0xd8, 0x59, 0x30, 0x24, 0xb7, 0xb6,
+	0x09, 0x1b, 0x29, 0x37, 0x06, 0x61, 0x3e, 0xf5, 0x18, 0xa9, 0xfd, 0x9e, 0x83, 0xe5, 0x36, 0xb3,
+	0xbe, 0x09, 0xb0, 0xc7, 0xfa, 0x24, 0x40, 0x15, 0x28, 0x30, 0xe2, 0x99, 0x24, 0x90, 0xbe, 0xe5,
+	0x0e, 0xe9, 0x50, 0x0c, 0x48, 0x8f, 0xd8, 0x23, 0x12, 0x08, 0xd7, 0x25, 0x43, 0xed, 0xd1, 0x0b,
+	0x28, 0x60, 0x97, 0x0e, 0x3d, 0xae, 0x2d, 0x86, 0x48, 0xab, 0x19, 0x3a, 0xff, 0xeb, 0xc3, 0xce,
+	0x81, 0x65, 0xf3, 0xc1, 0xb0, 0x5b, 0xef, 0x51, 0xb7, 0x71, 0x64, 0x7b, 0xac, 0x37, 0xb0, 0x71,
+	0xa3, 0x2f, 0x17, 0x9f, 0x33, 0xf3, 0xc7, 0x06, 0x7f, 0xeb, 0x13, 0x56, 0x3f, 0xf6, 0xb8, 0x21,
+	0x15, 0xd0, 0x3a, 0x2c, 0x99, 0xc4, 0xa3, 0xae, 0x96, 0x17, 0x4e, 0xc6, 0x1b, 0xb4, 0x09, 0xc5,
+	0xde, 0x00, 0xdb, 0x5e, 0xc7, 0x36, 0xb5, 0x25, 0x01, 0xdc, 0x14, 0xfb, 0x63, 0xb3, 0x76, 0x17,
+	0xd6, 0x62, 0xf1, 0xab, 0xbc, 0xfe, 0xc8, 0xc1, 0x4a, 0x9b, 0x59, 0x27, 0x01, 0x1d, 0xd9, 0xcc,
+	0xa6, 0x1e, 0x42, 0x90, 0xef, 0x07, 0xd4, 0x95, 0x69, 0x89, 0x35, 0xba, 0x03, 0x8b, 0x8c, 0xfc,
+	0x24, 0xf2, 0xc9, 0x1b, 0xe1, 0x32, 0x96, 0xfe, 0xe2, 0xd4, 0xf4, 0xf3, 0x53, 0xd3, 0x5f, 0xfa,
+	0xef, 0xd2, 0x2f, 0xc4, 0xd2, 0xaf, 0x55, 0x60, 0x3d, 0x9e, 0x8b, 0x4a, 0xf2, 0x4b, 0xf1, 0x7c,
+	0x9e, 0x53, 0xc7, 0x54, 0xf7, 0xf7, 0x51, 0x69, 0xca, 0x07, 0x11, 0x37, 0x54, 0x9a, 0x5f, 0x01,
+	0x6a, 0x33, 0xcb, 0x20, 0x0e, 0xc1, 0x8c, 0x5c, 0x53, 0x76, 0x1b, 0xf4, 0xab, 0xb6, 0x57, 0x94,
+	0x5d, 0x3a, 0x22, 0xd7, 0xbc, 0x17, 0xa5, 0x9c, 0xb0, 0x55, 0xca, 0x2d, 0xb8, 0xd5, 0x66, 0xd6,
+	0xa1, 0x83, 0x6d, 0xb7, 0x85, 0x79, 0x6f, 0x90, 0x29, 0x7a, 0x0f, 0xc0, 0xc5, 0xe7, 0x9d, 0x5e,
+	0xc8, 0x62, 0x52, 0xbb, 0xe4, 0xe2, 0x73, 0x61, 0xc6, 0x6a, 0x1b, 0x70, 0x37, 0xa1, 0xa1, 0xc4,
+	0x1f, 0x41, 0x31, 0x02, 0x3e, 0x32, 0x58, 0x04, 0x77, 0x22, 0x0b, 0xa5, 0xd2, 0x87, 0x72, 0x9b,
+	0x59, 0xa7, 0x43, 0xcb, 0x22, 0x8c, 0x1b, 0xd4, 0x21, 0x99, 0x5a, 0x15, 0x28, 0x70, 0x1c, 0x58,
+	0x84, 0xcb, 0x1a, 0x93, 0x3b, 0xb4, 0x0f, 0xf9, 0x80, 0x3a, 0x44, 0x3c, 0xca, 0x72, 0x73, 0x3d,
+	0x5d, 0xf4, 0xa1, 0x9e, 0x21, 0x18, 0x35, 0x0d, 0x2a, 0x49, 0x3f, 0x2a, 0x82, 0x9f, 0x61, 0xb5,
+	0xcd, 0xac, 0xa7, 0xa6, 0x79, 0x46, 0x39, 0x39, 0xa2, 0xc1, 0xd4, 0x20, 0x76, 0x60, 0xd9, 0x0f,
+	0xa8, 0x4f, 0x19, 0x76, 0xc2, 0x7a, 0x1b, 0x27, 0x06, 0xd1, 0xd1, 0xb1, 0x89, 0x9a, 0x50, 0xa0,
+	0x3e, 0xb7, 0xa9, 0x27, 0xe3, 0xd1, 0xd3, 0xf1, 0x84, 0x1e, 0x5e, 0x0a, 0x86, 0x21, 0x99, 0xb5,
+	0x2d, 0xd8, 0xbc, 0xe2, 0x5d, 0x85, 0xf6, 0x4b, 0x4e, 0x3c, 0x8d, 0x53, 0xc2, 0x5b, 0x42, 0xe3,
+	0x94, 0x63, 0x3e, 0x64, 0x61, 0xd1, 0x59, 0x43, 0x1c, 0x98, 0x36, 0xf6, 0x64, 0x80, 0x6a, 0x1f,
+	0x36, 0x42, 0x26, 0x58, 0x22, 0xbe, 0x72, 0x73, 0x3b, 0x1d, 0x43, 0x5c, 0xc9, 0x90, 0x5c, 0xb4,
+	0x07, 0xb7, 0x06, 0xd8, 0xe1, 0x1d, 0x73, 0x18, 0x60, 0x95, 0x40, 0xde, 0x58, 0x09, 0x0f, 0x9f,
+	0xc9, 0x33, 0xf9, 0xd6, 0x52, 0xc1, 0xa8, 0x58, 0x7f, 0xcb, 0x8b, 0x86, 0x79, 0x4a, 0xb8, 0x41,
+	0x87, 0x9c, 0xcc, 0xe9, 0xd7, 0xaa, 0x9e, 0x6f, 0x4c, 0x6b, 0x67, 0x8b, 0x89, 0x76, 0x86, 0x4e,
+	0x00, 0x45, 0x8d, 0xa5, 0x33, 0xc2, 0x8e, 0x6d, 0x62, 0x4e, 0xc7, 0x2d, 0xa7, 0xdc, 0xbc, 0x7f,
+	0xe5, 0xde, 0x25, 0xf3, 0x2c, 0x22, 0x1a, 0xab, 0x41, 0xfa, 0x28, 0xf6, 0xa5, 0x96, 0xae, 0xf1,
+	0xa5, 0xba, 0xb0, 0x16, 0x56, 0x0b, 0x97, 0x45, 0xdc, 0x91, 0x1d, 0xae, 0xf0, 0xc9, 0x1d, 0x6e,
+	0xd5, 0xc5, 0xe7, 0x51, 0x4b, 0x78, 0x3a, 0x6e, 0x76, 0x5d, 0x58, 0x53, 0xfa, 0x23, 0xea, 0x0c,
+	0x5d, 0xd2, 0xe9, 0x61, 0x5f, 0xbb, 0xf9, 0xe9, 0x3e, 0x22, 0xb9, 0x33, 0xa1, 0x76, 0x88, 0x7d,
+	0xf4, 0x04, 0x2a, 0x69, 0x1f, 0x6f, 0x6c, 0xcf, 0xa4, 0x6f, 0xb4, 0xa2, 0xb8, 0xfa, 0xf5, 0xa4,
+	0xc9, 0xb7, 0x02, 0x43, 0xcf, 0x60, 0x45, 0x59, 0xf5, 0x09, 0xd1, 0x4a, 0x62, 0xd8, 0x6e, 0xa5,
+	0xbf, 0x5c, 0x94, 0xcf, 0x11, 0x21, 0x72, 0xe2, 0x2e, 0xf3, 0xc9, 0x91, 0x1c, 0x4d, 0xd1, 0x4b,
+	0x51, 0x2f, 0xe8, 0x7b, 0x31, 0x99, 0x9e, 0x63, 0x67, 0x7c, 0x3e, 0xf3, 0x99, 0x5f, 0xf7, 0xfd,
+	0xc8, 0x51, 0xa1, 0xc4, 0x23, 0xa7, 0xcd, 0x7f, 0x8a, 0xb0, 0xd8, 0x66, 0x16, 0x7a, 0x0d, 0x2b,
+	0x89, 0x9f, 0x1b, 0x3b, 0xe9, 0x9c, 0x52, 0x3f, 0x14, 0xf4, 0x87, 0x73, 0x08, 0x91, 0x07, 0xf4,
+	0x35, 0x14, 0xd5, 0xb8, 0xd8, 0xca, 0x30, 0x8a, 0x40, 0x7d, 0x6f, 0x06, 0xa8, 0xd4, 0x5e, 0x42,
+	0x69, 0x32, 0x23, 0xb6, 0x33, 0x2c, 0x14, 0xaa, 0x3f, 0x98, 0x85, 0x2a, 0xc1, 0xd7, 0xb0, 0x92,
+	0x18, 0x94, 0x59, 0x89, 0xc7, 0x09, 0xfa, 0xc3, 0x39, 0x04, 0xa5, 0x8c, 0xe1, 0x76, 0x7a, 0x5c,
+	0xd6, 0x32, 0x6c, 0x53, 0x1c, 0xfd, 0x60, 0x3e, 0x27, 0xe9, 0x22, 0x39, 0x37, 0xb3, 0x5d, 0x24,
+	0x38, 0xfa, 0xc1, 0x7c, 0x8e, 0x72, 0x61, 0x00, 0xc4, 0x06, 0xe8, 0xbd, 0x0c, 0xcb, 0x09, 0xac,
+	0xff, 0x6f, 0x26, 0xac, 0x34, 0x0f, 0x61, 0x49, 0x9c, 0x22, 0x6d, 0x1a, 0x5f, 0xdf, 0x9d, 0x86,
+	0x28, 0x91, 0x57, 0xb0, 0x1c, 0x1f, 0x9b, 0xd5, 0x0c, 0x83, 0x18, 0xae, 0xff, 0x7f, 0x36, 0xae,
+	0x64, 0x7f, 0x80, 0x72, 0x6a, 0x16, 0xde, 0xcf, 0xb0, 0x4c, 0x52, 0xf4, 0xcf, 0xe6, 0x52, 0xe2,
+	0x57, 0x96, 0x9e, 0x67, 0x59, 0x57, 0x96, 0xe2, 0xe8, 0x07, 0xf3, 0x39, 0xf1, 0x8a, 0x53, 0x63,
+	0x68, 0x2b, 0xdb, 0x4e, 0x80, 0xfa, 0xde, 0x0c, 0x30, 0x5e, 0x71, 0x93, 0x9e, 0x94, 0x55, 0x71,
+	0x0a, 0xd5, 0x1f, 0xcc, 0x42, 0x23, 0xc1, 0xd6, 0x8b, 0x77, 0x17, 0xd5, 0xdc, 0xfb, 0x8b, 0x6a,
+	0xee, 0xef, 0x8b, 0x6a, 0xee, 0xd7, 0xcb, 0xea, 0xc2, 0xfb, 0xcb, 0xea, 0xc2, 0x9f, 0x97, 0xd5,
+	0x85, 0xef, 0x1e, 0xcd, 0xed, 0xe9, 0xe7, 0xea, 0x3f, 0x93, 0xe8, 0xee, 0xdd, 0x82, 0xf8, 0xbf,
+	0xf4, 0xc5, 0xbf, 0x03, 0x00, 0x80, 0xd9, 0x50, 0xef, 0x8e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1176,7 +1475,7 @@ type MsgClient interface {
 	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
 	// Set the time lock value from default value to uint64.max for specific confirmed provision.
 	HoldTransfer(ctx context.Context, in *MsgHoldTransfer, opts ...grpc.CallOption) (*MsgHoldTransferResponse, error)
-	// Set the time lock value to 0 for specific confirmed provision.
+	// Restore the time lock value of a held provision to the value before it was held.
 	ReleaseTransfer(ctx context.Context, in *MsgReleaseTransfer, opts ...grpc.CallOption) (*MsgReleaseTransferResponse, error)
 	// Remove a specific confirmed provision (reset for specific sequence number).
 	RemoveProvision(ctx context.Context, in *MsgRemoveProvision, opts ...grpc.CallOption) (*MsgRemoveProvisionResponse, error)
@@ -1193,6 +1492,10 @@ type MsgClient interface {
 	// SetBridgeStatus operates a switch to halt/resume the bridge module.
 	// If the ratio of inactive bridge switches exceed TrustLevel, the bridge module halts.
 	SetBridgeStatus(ctx context.Context, in *MsgSetBridgeStatus, opts ...grpc.CallOption) (*MsgSetBridgeStatusResponse, error)
+	// SetRoute registers or updates a bridge route of a denom to a destination chain.
+	SetRoute(ctx context.Context, in *MsgSetRoute, opts ...grpc.CallOption) (*MsgSetRouteResponse, error)
+	// HaltRoute halts a bridge route. Only the authority can resume the route by SetRoute.
+	HaltRoute(ctx context.Context, in *MsgHaltRoute, opts ...grpc.CallOption) (*MsgHaltRouteResponse, error)
 }
 
 type msgClient struct {
@@ -1302,6 +1605,24 @@ func (c *msgClient) SetBridgeStatus(ctx context.Context, in *MsgSetBridgeStatus,
 	return out, nil
 }
 
+func (c *msgClient) SetRoute(ctx context.Context, in *MsgSetRoute, opts ...grpc.CallOption) (*MsgSetRouteResponse, error) {
+	out := new(MsgSetRouteResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Msg/SetRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HaltRoute(ctx context.Context, in *MsgHaltRoute, opts ...grpc.CallOption) (*MsgHaltRouteResponse, error) {
+	out := new(MsgHaltRouteResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Msg/HaltRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the x/fbridge parameters.
@@ -1312,7 +1633,7 @@ type MsgServer interface {
 	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
 	// Set the time lock value from default value to uint64.max for specific confirmed provision.
 	HoldTransfer(context.Context, *MsgHoldTransfer) (*MsgHoldTransferResponse, error)
-	// Set the time lock value to 0 for specific confirmed provision.
+	// Restore the time lock value of a held provision to the value before it was held.
 	ReleaseTransfer(context.Context, *MsgReleaseTransfer) (*MsgReleaseTransferResponse, error)
 	// Remove a specific confirmed provision (reset for specific sequence number).
 	RemoveProvision(context.Context, *MsgRemoveProvision) (*MsgRemoveProvisionResponse, error)
@@ -1329,6 +1650,10 @@ type MsgServer interface {
 	// SetBridgeStatus operates a switch to halt/resume the bridge module.
 	// If the ratio of inactive bridge switches exceed TrustLevel, the bridge module halts.
 	SetBridgeStatus(context.Context, *MsgSetBridgeStatus) (*MsgSetBridgeStatusResponse, error)
+	// SetRoute registers or updates a bridge route of a denom to a destination chain.
+	SetRoute(context.Context, *MsgSetRoute) (*MsgSetRouteResponse, error)
+	// HaltRoute halts a bridge route. Only the authority can resume the route by SetRoute.
+	HaltRoute(context.Context, *MsgHaltRoute) (*MsgHaltRouteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1368,6 +1693,12 @@ func (*UnimplementedMsgServer) AddVoteForRole(ctx context.Context, req *MsgAddVo
 func (*UnimplementedMsgServer) SetBridgeStatus(ctx context.Context, req *MsgSetBridgeStatus) (*MsgSetBridgeStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeStatus not implemented")
 }
+func (*UnimplementedMsgServer) SetRoute(ctx context.Context, req *MsgSetRoute) (*MsgSetRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoute not implemented")
+}
+func (*UnimplementedMsgServer) HaltRoute(ctx context.Context, req *MsgHaltRoute) (*MsgHaltRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltRoute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1571,6 +1902,42 @@ func _Msg_SetBridgeStatus_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Msg/SetRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRoute(ctx, req.(*MsgSetRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HaltRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHaltRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HaltRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Msg/HaltRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HaltRoute(ctx, req.(*MsgHaltRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.fbridge.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1619,6 +1986,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBridgeStatus",
 			Handler:    _Msg_SetBridgeStatus_Handler,
 		},
+		{
+			MethodName: "SetRoute",
+			Handler:    _Msg_SetRoute_Handler,
+		},
+		{
+			MethodName: "HaltRoute",
+			Handler:    _Msg_HaltRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/fbridge/v1/tx.proto",
@@ -1707,6 +2082,20 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -1777,6 +2166,13 @@ func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -2277,6 +2673,11 @@ func (m *MsgSetBridgeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HaltDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HaltDuration))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Status != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Status))
 		i--
@@ -2315,48 +2716,227 @@ func (m *MsgSetBridgeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TransferVolumeWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferVolumeWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TransferVolumeCap.Size()
+		i -= size
+		if _, err := m.TransferVolumeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxTransferAmount.Size()
+		i -= size
+		if _, err := m.MaxTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReceiverValidator != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiverValidator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHaltRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHaltRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHaltRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHaltRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHaltRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHaltRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2366,6 +2946,14 @@ func (m *MsgTransfer) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2401,6 +2989,10 @@ func (m *MsgProvision) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2608,6 +3200,9 @@ func (m *MsgSetBridgeStatus) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovTx(uint64(m.Status))
 	}
+	if m.HaltDuration != 0 {
+		n += 1 + sovTx(uint64(m.HaltDuration))
+	}
 	return n
 }
 
@@ -2620,6 +3215,81 @@ func (m *MsgSetBridgeStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceiverValidator != 0 {
+		n += 1 + sovTx(uint64(m.ReceiverValidator))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = m.MaxTransferAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TransferVolumeCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TransferVolumeWindow != 0 {
+		n += 1 + sovTx(uint64(m.TransferVolumeWindow))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHaltRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgHaltRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2918,13 +3588,77 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -3167,6 +3901,38 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4426,6 +5192,25 @@ func (m *MsgSetBridgeStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltDuration", wireType)
+			}
+			m.HaltDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4497,6 +5282,556 @@ func (m *MsgSetBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverValidator", wireType)
+			}
+			m.ReceiverValidator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverValidator |= ReceiverValidator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumeWindow", wireType)
+			}
+			m.TransferVolumeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferVolumeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHaltRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHaltRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHaltRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHaltRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHaltRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHaltRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
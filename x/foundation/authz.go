@@ -1,6 +1,8 @@
 package foundation
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -46,14 +48,92 @@ func (a ReceiveFromTreasuryAuthorization) MsgTypeURL() string {
 }
 
 func (a ReceiveFromTreasuryAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
-	_, ok := msg.(*MsgWithdrawFromTreasury)
+	mWithdraw, ok := msg.(*MsgWithdrawFromTreasury)
 	if !ok {
 		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
 
-	return AcceptResponse{Accept: true}, nil
+	now := ctx.BlockTime()
+	if a.Expiration != nil && !now.Before(*a.Expiration) {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization expired")
+	}
+
+	// no limits at all
+	if a.ReceiveLimit.Empty() && a.Period == 0 {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	if a.Period != 0 {
+		a.tryResetPeriod(now)
+
+		left, isNeg := a.PeriodCanReceive.SafeSub(mWithdraw.Amount)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than the period receive limit")
+		}
+		a.PeriodCanReceive = left
+	}
+
+	if !a.ReceiveLimit.Empty() {
+		left, isNeg := a.ReceiveLimit.SafeSub(mWithdraw.Amount)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than the receive limit")
+		}
+		if left.IsZero() {
+			return AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		a.ReceiveLimit = left
+	}
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod will reset the period if we hit the end of the current period.
+func (a *ReceiveFromTreasuryAuthorization) tryResetPeriod(now time.Time) {
+	if now.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanReceive = a.PeriodReceiveLimit
+
+	// If we are within the period, step from expiration (eg. if you always do
+	// one tx per day, it will always reset the same time).
+	// If we are more than one period out (eg. no activity in a week), reset
+	// is one period from this time.
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if now.After(a.PeriodReset) {
+		a.PeriodReset = now.Add(a.Period)
+	}
 }
 
 func (a ReceiveFromTreasuryAuthorization) ValidateBasic() error {
+	if err := a.ReceiveLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid receive limit: %s", err)
+	}
+
+	if a.Period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("negative period")
+	}
+
+	if a.Period == 0 {
+		if !a.PeriodReceiveLimit.Empty() || !a.PeriodCanReceive.Empty() {
+			return sdkerrors.ErrInvalidRequest.Wrap("period limits without period")
+		}
+		return nil
+	}
+
+	if err := a.PeriodReceiveLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid period receive limit: %s", err)
+	}
+	if a.PeriodReceiveLimit.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("empty period receive limit")
+	}
+
+	if err := a.PeriodCanReceive.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid period can receive: %s", err)
+	}
+	if !a.PeriodCanReceive.IsAllLTE(a.PeriodReceiveLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period can receive exceeds period receive limit")
+	}
+
 	return nil
 }
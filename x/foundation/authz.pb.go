@@ -5,18 +5,25 @@ package foundation
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +34,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ReceiveFromTreasuryAuthorization allows the grantee to receive coins
 // up to receive_limit from the treasury.
 type ReceiveFromTreasuryAuthorization struct {
+	// receive_limit specifies the maximum amount of coins that can be received
+	// by the grantee. Each reception spends down the limit, and the authorization
+	// is removed once the limit is exhausted. If empty, there is no limit.
+	ReceiveLimit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=receive_limit,json=receiveLimit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"receive_limit"`
+	// expiration specifies an optional time when the authorization expires.
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// period specifies the time duration in which period_receive_limit coins can
+	// be received before that allowance is reset. Zero means no periodic limit.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// period_receive_limit specifies the maximum number of coins that can be
+	// received in the period.
+	PeriodReceiveLimit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_receive_limit,json=periodReceiveLimit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"period_receive_limit"`
+	// period_can_receive is the number of coins left to be received before the
+	// period_reset time.
+	PeriodCanReceive github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_can_receive,json=periodCanReceive,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"period_can_receive"`
+	// period_reset is the time at which this period resets and a new one begins.
+	// It is calculated from the start time of the first reception after the last
+	// period ended.
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Reset()         { *m = ReceiveFromTreasuryAuthorization{} }
@@ -62,6 +88,48 @@ func (m *ReceiveFromTreasuryAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReceiveFromTreasuryAuthorization proto.InternalMessageInfo
 
+func (m *ReceiveFromTreasuryAuthorization) GetReceiveLimit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.ReceiveLimit
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriodReceiveLimit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodReceiveLimit
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriodCanReceive() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanReceive
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ReceiveFromTreasuryAuthorization)(nil), "lbm.foundation.v1.ReceiveFromTreasuryAuthorization")
 }
@@ -69,20 +137,36 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/authz.proto", fileDescriptor_8bdb89c90659aa0e) }
 
 var fileDescriptor_8bdb89c90659aa0e = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x49, 0xca, 0xd5,
-	0x4f, 0xcb, 0x2f, 0xcd, 0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x2c,
-	0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcc, 0x49, 0xca, 0xd5, 0x43,
-	0x48, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf5, 0x41, 0x2c, 0x88,
-	0x42, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91, 0x52,
-	0x4a, 0xe6, 0x52, 0x08, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x4b, 0x75, 0x2b, 0xca, 0xcf, 0x0d, 0x29,
-	0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0x74, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x02, 0x9b,
-	0x6b, 0x65, 0x7f, 0x69, 0x8b, 0xae, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0xbe, 0x5b, 0x66, 0x5e, 0x71, 0x72, 0x46, 0x66, 0xa2, 0x7e, 0x1a, 0x94, 0xa1, 0x5b, 0x9c,
-	0x92, 0xad, 0x5f, 0x81, 0xe4, 0x52, 0x3d, 0x14, 0x03, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x97, 0x24, 0x63, 0x93, 0xd8, 0xc0, 0x8e, 0x36, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x24, 0x13, 0x54, 0x19, 0x01, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0xda, 0x46, 0xe8, 0x52, 0x24, 0xb0, 0x3a, 0x38, 0x91, 0x70, 0x22, 0xa6, 0x0e,
+	0xe4, 0x4e, 0x29, 0x4c, 0x74, 0x00, 0x52, 0xd4, 0x2e, 0x4c, 0x51, 0x27, 0x96, 0xe8, 0x6c, 0x5f,
+	0xec, 0x13, 0x3e, 0x3f, 0xeb, 0xee, 0x6c, 0xb5, 0x95, 0x18, 0xf8, 0x06, 0x1d, 0xf9, 0x00, 0x4c,
+	0xcc, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x45, 0xc9, 0x17, 0x41, 0xbe, 0x3b, 0x43, 0x0a, 0x43,
+	0xc5, 0x90, 0xed, 0xde, 0xbd, 0xf7, 0x7f, 0xef, 0xf7, 0x7f, 0x67, 0xa3, 0xc7, 0x79, 0x24, 0xc8,
+	0x02, 0xaa, 0x22, 0xa1, 0x9a, 0x43, 0x41, 0xea, 0x09, 0xa1, 0x95, 0xce, 0x2e, 0x70, 0x29, 0x41,
+	0x83, 0xff, 0x28, 0x8f, 0x04, 0xfe, 0x93, 0xc6, 0xf5, 0x64, 0xb0, 0x97, 0x42, 0x0a, 0x26, 0x4b,
+	0x9a, 0x93, 0x2d, 0x1c, 0xf4, 0x63, 0x50, 0x02, 0xd4, 0xdc, 0x26, 0x6c, 0xe0, 0x52, 0xa1, 0x8d,
+	0x48, 0x44, 0x15, 0x23, 0xf5, 0x24, 0x62, 0x9a, 0x4e, 0x48, 0x0c, 0xbc, 0x70, 0xf9, 0x61, 0x0a,
+	0x90, 0xe6, 0x8c, 0x98, 0x28, 0xaa, 0x16, 0x44, 0x73, 0xc1, 0x94, 0xa6, 0xa2, 0x6c, 0x1b, 0xfc,
+	0x5d, 0x90, 0x54, 0xd2, 0xe2, 0x98, 0x9b, 0x27, 0x9f, 0x77, 0xd0, 0x68, 0xc6, 0x62, 0xc6, 0x6b,
+	0x76, 0x2c, 0x41, 0x9c, 0x4a, 0x46, 0x55, 0x25, 0xcf, 0x5f, 0x57, 0x3a, 0x03, 0xc9, 0x2f, 0x4c,
+	0xa9, 0xaf, 0xd1, 0x03, 0x69, 0x6b, 0xe6, 0x39, 0x17, 0x5c, 0x07, 0xde, 0x68, 0x6b, 0xbf, 0x77,
+	0xd0, 0xc7, 0x8e, 0xb5, 0xa1, 0xc3, 0x8e, 0x0e, 0x1f, 0x01, 0x2f, 0xa6, 0xcf, 0xaf, 0x7e, 0x0c,
+	0x3b, 0x5f, 0x6e, 0x86, 0x4f, 0x53, 0xae, 0xb3, 0x2a, 0xc2, 0x31, 0x08, 0x72, 0xcc, 0x0b, 0x15,
+	0x67, 0x9c, 0x92, 0x85, 0x3b, 0x8c, 0x55, 0xf2, 0x9e, 0xe8, 0xf3, 0x92, 0x29, 0x23, 0x52, 0xb3,
+	0x5d, 0x37, 0xe5, 0x6d, 0x33, 0xc4, 0x7f, 0x85, 0x10, 0x3b, 0x2b, 0xb9, 0xc5, 0x0d, 0xee, 0x8d,
+	0xbc, 0xfd, 0xde, 0xc1, 0x00, 0x5b, 0x3f, 0xb8, 0xf5, 0x83, 0x4f, 0x5b, 0xc3, 0xd3, 0xed, 0xcb,
+	0x9b, 0xa1, 0x37, 0x5b, 0xd3, 0xf8, 0x87, 0xa8, 0x5b, 0x32, 0xc9, 0x21, 0x09, 0xb6, 0x8c, 0xba,
+	0xff, 0x8f, 0xfa, 0x8d, 0xdb, 0xc6, 0xf4, 0x7e, 0x03, 0xfc, 0xa9, 0x69, 0xe0, 0x24, 0xfe, 0x47,
+	0x0f, 0xed, 0xd9, 0xe3, 0xfc, 0xb6, 0xf9, 0xed, 0xcd, 0x98, 0xf7, 0xed, 0xb0, 0xd9, 0xfa, 0x0a,
+	0x3e, 0x20, 0x77, 0x3b, 0x8f, 0x69, 0xd1, 0x62, 0x04, 0x3b, 0x9b, 0x01, 0x78, 0x68, 0x47, 0x1d,
+	0xd1, 0xc2, 0x31, 0xf8, 0x27, 0x68, 0xf7, 0xf7, 0x06, 0x14, 0xd3, 0x41, 0xf7, 0xce, 0x37, 0x30,
+	0x6b, 0x34, 0xef, 0xd0, 0x6b, 0xed, 0x28, 0xa6, 0x5f, 0xbc, 0xfc, 0xf6, 0x75, 0x7c, 0x78, 0x27,
+	0xcc, 0xd9, 0xda, 0xcf, 0x84, 0x6f, 0x7d, 0x81, 0xd3, 0x93, 0xab, 0x65, 0xe8, 0x5d, 0x2f, 0x43,
+	0xef, 0xe7, 0x32, 0xf4, 0x2e, 0x57, 0x61, 0xe7, 0x7a, 0x15, 0x76, 0xbe, 0xaf, 0xc2, 0xce, 0xbb,
+	0xf1, 0x7f, 0xb5, 0x8d, 0xba, 0x06, 0xfa, 0xd9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x11,
+	0x10, 0x46, 0xbc, 0x03, 0x00, 0x00,
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
@@ -105,6 +189,74 @@ func (m *ReceiveFromTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.PeriodCanReceive) > 0 {
+		for iNdEx := len(m.PeriodCanReceive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanReceive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PeriodReceiveLimit) > 0 {
+		for iNdEx := len(m.PeriodReceiveLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodReceiveLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Expiration != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReceiveLimit) > 0 {
+		for iNdEx := len(m.ReceiveLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -125,6 +277,32 @@ func (m *ReceiveFromTreasuryAuthorization) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.ReceiveLimit) > 0 {
+		for _, e := range m.ReceiveLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodReceiveLimit) > 0 {
+		for _, e := range m.PeriodReceiveLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanReceive) > 0 {
+		for _, e := range m.PeriodCanReceive {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -163,6 +341,210 @@ func (m *ReceiveFromTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ReceiveFromTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveLimit = append(m.ReceiveLimit, types.Coin{})
+			if err := m.ReceiveLimit[len(m.ReceiveLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReceiveLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodReceiveLimit = append(m.PeriodReceiveLimit, types.Coin{})
+			if err := m.PeriodReceiveLimit[len(m.PeriodReceiveLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanReceive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanReceive = append(m.PeriodCanReceive, types.Coin{})
+			if err := m.PeriodCanReceive[len(m.PeriodCanReceive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
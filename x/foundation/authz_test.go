@@ -2,6 +2,7 @@ package foundation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -10,26 +11,158 @@ import (
 )
 
 func TestReceiveFromTreasuryAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithBlockTime(now)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	timeAt := func(t time.Time) *time.Time {
+		return &t
+	}
+
 	testCases := map[string]struct {
-		msg    sdk.Msg
-		valid  bool
-		accept bool
+		authorization foundation.ReceiveFromTreasuryAuthorization
+		msg           sdk.Msg
+		valid         bool
+		accept        bool
+		delete        bool
+		updated       foundation.Authorization
 	}{
 		"valid": {
-			msg:    &foundation.MsgWithdrawFromTreasury{},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(10),
+			},
 			valid:  true,
 			accept: true,
 		},
 		"msg mismatch": {
 			msg: &foundation.MsgVote{},
 		},
+		"spend down the receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: coins(10),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: coins(7),
+			},
+		},
+		"exhaust the receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: coins(10),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(10),
+			},
+			valid:  true,
+			accept: true,
+			delete: true,
+		},
+		"exceed the receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: coins(10),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(11),
+			},
+		},
+		"not expired yet": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Expiration: timeAt(now.Add(time.Second)),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(10),
+			},
+			valid:  true,
+			accept: true,
+		},
+		"expired": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Expiration: timeAt(now),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(10),
+			},
+		},
+		"first period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(7),
+				PeriodReset:        now.Add(time.Hour),
+			},
+		},
+		"within the period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(7),
+				PeriodReset:        now.Add(time.Minute),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(4),
+				PeriodReset:        now.Add(time.Minute),
+			},
+		},
+		"exceed the period receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(2),
+				PeriodReset:        now.Add(time.Minute),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+		},
+		"reset the period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(2),
+				PeriodReset:        now.Add(-time.Minute),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(7),
+				PeriodReset:        now.Add(-time.Minute).Add(time.Hour),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			authorization := &foundation.ReceiveFromTreasuryAuthorization{}
+			err := tc.authorization.ValidateBasic()
+			require.NoError(t, err)
 
-			resp, err := authorization.Accept(sdk.Context{}, tc.msg)
+			resp, err := tc.authorization.Accept(ctx, tc.msg)
 			if !tc.valid {
 				require.Error(t, err)
 				return
@@ -37,6 +170,78 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Equal(t, tc.accept, resp.Accept)
+			require.Equal(t, tc.delete, resp.Delete)
+			if tc.updated != nil {
+				require.Equal(t, tc.updated, resp.Updated)
+			}
+		})
+	}
+}
+
+func TestReceiveFromTreasuryAuthorizationValidateBasic(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	testCases := map[string]struct {
+		authorization foundation.ReceiveFromTreasuryAuthorization
+		valid         bool
+	}{
+		"no limits": {
+			valid: true,
+		},
+		"receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: coins(10),
+			},
+			valid: true,
+		},
+		"invalid receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}},
+			},
+		},
+		"periodic limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(10),
+			},
+			valid: true,
+		},
+		"negative period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             -time.Hour,
+				PeriodReceiveLimit: coins(10),
+			},
+		},
+		"period limit without period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				PeriodReceiveLimit: coins(10),
+			},
+		},
+		"empty period receive limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period: time.Hour,
+			},
+		},
+		"period can receive exceeds the limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period:             time.Hour,
+				PeriodReceiveLimit: coins(10),
+				PeriodCanReceive:   coins(11),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -31,6 +31,8 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdVotes(),
 		NewQueryCmdTallyResult(),
 		NewQueryCmdCensorships(),
+		NewQueryCmdMsgTypeDecisionPolicies(),
+		NewQueryCmdPaymentStreams(),
 		NewQueryCmdGrants(),
 	)
 
@@ -394,6 +396,80 @@ func NewQueryCmdCensorships() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdMsgTypeDecisionPolicies returns the query decision policies of the message types command.
+func NewQueryCmdMsgTypeDecisionPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-type-decision-policies",
+		Short: "Query the decision policies of the message types",
+		Long:  "Gets the decision policies of the message types",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryMsgTypeDecisionPoliciesRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.MsgTypeDecisionPolicies(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "msg-type-decision-policies")
+
+	return cmd
+}
+
+// NewQueryCmdPaymentStreams returns the query payment streams command.
+func NewQueryCmdPaymentStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-streams",
+		Short: "Query the payment streams from the treasury",
+		Long:  "Gets the payment streams from the treasury",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryPaymentStreamsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.PaymentStreams(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "payment-streams")
+
+	return cmd
+}
+
 // NewQueryCmdGrants returns grants on a grantee
 func NewQueryCmdGrants() *cobra.Command {
 	cmd := &cobra.Command{
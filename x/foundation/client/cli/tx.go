@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,16 @@ const (
 	ExecTry  = "try"
 )
 
+// Payment stream flags
+const (
+	FlagPeriod = "period"
+)
+
+// Censorship flags
+const (
+	FlagExpiration = "expiration"
+)
+
 func validateGenerateOnly(cmd *cobra.Command) error {
 	generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
 	if err != nil {
@@ -161,10 +172,14 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdWithdrawFromTreasury(),
 		NewTxCmdUpdateMembers(),
 		NewTxCmdUpdateDecisionPolicy(),
+		NewTxCmdUpdateMsgTypeDecisionPolicy(),
+		NewTxCmdCreatePaymentStream(),
+		NewTxCmdCancelPaymentStream(),
 		NewTxCmdSubmitProposal(),
 		NewTxCmdWithdrawProposal(),
 		NewTxCmdVote(),
 		NewTxCmdExec(),
+		NewTxCmdVetoProposal(),
 		NewTxCmdLeaveFoundation(),
 		NewTxCmdGrant(),
 		NewTxCmdRevoke(),
@@ -262,7 +277,8 @@ Example of the content of members-json:
   {
     "address": "addr1",
     "participating": true,
-    "metadata": "some new metadata"
+    "metadata": "some new metadata",
+    "weight": "2"
   },
   {
     "address": "addr2",
@@ -272,6 +288,7 @@ Example of the content of members-json:
 ]
 
 Set a member's participating to false to delete it.
+The weight of a member defaults to 1 if omitted.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := validateGenerateOnly(cmd); err != nil {
@@ -317,7 +334,8 @@ Example of the content of policy-json:
   "threshold": "10",
   "windows": {
     "voting_period": "24h",
-    "min_execution_period": "0s"
+    "min_execution_period": "0s",
+    "execution_delay": "0s"
   }
 }
 `,
@@ -353,6 +371,172 @@ Example of the content of policy-json:
 	return cmd
 }
 
+func NewTxCmdUpdateMsgTypeDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-msg-type-decision-policy [authority] [msg-type-url] [policy-json]?",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Update the decision policy of a message type",
+		Long: `Update the decision policy of a message type
+
+Parameters:
+    authority: the address of the foundation authority
+    msg-type-url: the message type url of the decision policy
+    policy-json: the decision policy in json format.
+        omit it to remove the decision policy of the message type.
+
+Example of the content of policy-json:
+
+{
+  "@type": "/lbm.foundation.v1.PercentageDecisionPolicy",
+  "percentage": "0.5",
+  "windows": {
+    "voting_period": "24h",
+    "min_execution_period": "0s"
+  }
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgUpdateMsgTypeDecisionPolicy{
+				Authority:  args[0],
+				MsgTypeUrl: args[1],
+			}
+			if len(args) == 3 {
+				policy, err := parseDecisionPolicy(clientCtx.Codec, args[2])
+				if err != nil {
+					return err
+				}
+				if err := msg.SetDecisionPolicy(policy); err != nil {
+					return err
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdCreatePaymentStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-payment-stream [authority] [recipient] [amount] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a payment stream from the treasury",
+		Long: `Create a payment stream from the treasury
+
+Parameters:
+    authority: the address of the foundation authority
+    recipient: the address which receives the payments
+    amount: the total amount of coins to be paid
+    start-time: the time at which the stream starts, in RFC3339 format
+    end-time: the time at which the whole amount has been released, in RFC3339 format
+
+The coins are released linearly, unless --period is provided,
+in which case they are released in equal installments of the period.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return err
+			}
+
+			period, err := cmd.Flags().GetDuration(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgCreatePaymentStream{
+				Authority: args[0],
+				Recipient: args[1],
+				Amount:    amount,
+				StartTime: startTime,
+				EndTime:   endTime,
+				Period:    period,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Duration(FlagPeriod, 0, "The interval of the installments. Omit it to release the coins linearly.")
+
+	return cmd
+}
+
+func NewTxCmdCancelPaymentStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-payment-stream [authority] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a payment stream",
+		Long: `Cancel a payment stream
+
+The coins which have not been paid yet remain in the treasury.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgCancelPaymentStream{
+				Authority: args[0],
+				Id:        id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-proposal [metadata] [proposers-json] [messages-json]",
@@ -574,6 +758,48 @@ func NewTxCmdExec() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdVetoProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-proposal [authority] [proposal-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Veto an accepted proposal during its timelock",
+		Long: `Veto an accepted proposal during its timelock
+
+Parameters:
+    authority: the address of x/gov, or of the censorship authority over
+        any message of the proposal
+    proposal-id: unique ID of the proposal
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgVetoProposal{
+				Authority:  args[0],
+				ProposalId: proposalID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdUpdateCensorship() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-censorship [authority] [msg-type-url] [new-authority]",
@@ -588,6 +814,9 @@ Parameters:
         unspecified: no authority, which means removing the censorship
         governance: x/gov
         foundation: x/foundation
+
+The censorship would be lifted automatically at the time given by --expiration,
+in RFC3339 format.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := validateGenerateOnly(cmd); err != nil {
@@ -611,6 +840,19 @@ Parameters:
 					Authority:  newAuthority,
 				},
 			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if len(expirationStr) != 0 {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				msg.Censorship.Expiration = &expiration
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -619,6 +861,7 @@ Parameters:
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The time at which the censorship is lifted, in RFC3339 format. Omit it to keep the censorship until updated.")
 	return cmd
 }
 
@@ -664,8 +907,18 @@ Example of the content of authorization-json:
 {
   "@type": "/lbm.foundation.v1.ReceiveFromTreasuryAuthorization",
   "receive_limit": [
-    "denom": "stake",
-    "amount": "10000"
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "expiration": "2030-01-01T00:00:00Z",
+  "period": "86400s",
+  "period_receive_limit": [
+    {
+      "denom": "stake",
+      "amount": "1000"
+    }
   ]
 }
 `,
@@ -28,6 +28,7 @@ func (s *IntegrationTestSuite) TestGRPCParams() {
 			&foundation.QueryParamsResponse{
 				Params: foundation.Params{
 					FoundationTax: sdk.MustNewDecFromStr("0.2"),
+					InflationTax:  sdk.ZeroDec(),
 				},
 			},
 		},
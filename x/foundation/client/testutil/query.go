@@ -31,6 +31,8 @@ func (s *IntegrationTestSuite) TestNewQueryCmdParams() {
 			&foundation.QueryParamsResponse{
 				Params: foundation.Params{
 					FoundationTax: sdk.MustNewDecFromStr("0.2"),
+					DenomTaxes:    []foundation.DenomTax{},
+					InflationTax:  sdk.ZeroDec(),
 				},
 			},
 		},
@@ -158,6 +160,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdMember() {
 			&foundation.Member{
 				Address:  s.permanentMember.String(),
 				Metadata: "permanent member",
+				Weight:   sdk.OneDec(),
 			},
 		},
 		"wrong number of args": {
@@ -517,6 +520,92 @@ func (s *IntegrationTestSuite) TestNewQueryCmdCensorships() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdMsgTypeDecisionPolicies() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			1,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdMsgTypeDecisionPolicies()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryMsgTypeDecisionPoliciesResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.DecisionPolicies, tc.expected)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdPaymentStreams() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			1,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdPaymentStreams()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryPaymentStreamsResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.PaymentStreams, tc.expected)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdGrants() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
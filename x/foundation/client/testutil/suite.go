@@ -58,6 +58,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	// enable foundation tax
 	params := foundation.Params{
 		FoundationTax: sdk.MustNewDecFromStr("0.2"),
+		InflationTax:  sdk.ZeroDec(),
 	}
 	foundationData.Params = params
 
@@ -72,10 +73,12 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		{
 			Address:  s.leavingMember.String(),
 			Metadata: "leaving member",
+			Weight:   sdk.OneDec(),
 		},
 		{
 			Address:  s.permanentMember.String(),
 			Metadata: "permanent member",
+			Weight:   sdk.OneDec(),
 		},
 	}
 
@@ -99,6 +102,17 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	}
 	foundationData.Censorships = censorships
 
+	msgTypePolicy := foundation.MsgTypeDecisionPolicy{
+		MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgUpdateCensorship)(nil)),
+	}.WithDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: 7 * 24 * time.Hour,
+		},
+	})
+	s.Require().NotNil(msgTypePolicy)
+	foundationData.MsgTypeDecisionPolicies = []foundation.MsgTypeDecisionPolicy{*msgTypePolicy}
+
 	treasuryReceivers := []sdk.AccAddress{s.stranger, s.leavingMember}
 	for _, receiver := range treasuryReceivers {
 		ga := foundation.GrantAuthorization{
@@ -108,6 +122,18 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		foundationData.Authorizations = append(foundationData.Authorizations, *ga)
 	}
 
+	// a payment stream which has not started yet
+	foundationData.PreviousPaymentStreamId = 1
+	foundationData.PaymentStreams = []foundation.PaymentStream{
+		{
+			Id:        1,
+			Recipient: s.stranger.String(),
+			Amount:    sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())),
+			StartTime: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
 	foundationDataBz, err := s.cfg.Codec.MarshalJSON(&foundationData)
 	s.Require().NoError(err)
 	genesisState[foundation.ModuleName] = foundationDataBz
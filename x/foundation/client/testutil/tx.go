@@ -207,6 +207,243 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateDecisionPolicy() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateMsgTypeDecisionPolicy() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	doMarshal := func(policy foundation.DecisionPolicy) string {
+		bz, err := val.ClientCtx.Codec.MarshalInterfaceJSON(policy)
+		s.Require().NoError(err)
+		return string(bz)
+	}
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+	policy := doMarshal(&foundation.PercentageDecisionPolicy{
+		Percentage: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	})
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				msgTypeURL,
+				policy,
+			},
+			true,
+		},
+		"valid removal": {
+			[]string{
+				s.authority.String(),
+				msgTypeURL,
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				msgTypeURL,
+				policy,
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateMsgTypeDecisionPolicy()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdVetoProposal() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				fmt.Sprint(s.proposalID),
+			},
+			true,
+		},
+		"invalid proposal id": {
+			[]string{
+				s.authority.String(),
+				"one",
+			},
+			false,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				fmt.Sprint(s.proposalID),
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdVetoProposal()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdCreatePaymentStream() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())).String()
+	startTime := "2100-01-01T00:00:00Z"
+	endTime := "2101-01-01T00:00:00Z"
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				amount,
+				startTime,
+				endTime,
+			},
+			true,
+		},
+		"valid transaction with period": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				amount,
+				startTime,
+				endTime,
+				fmt.Sprintf("--%s=%s", cli.FlagPeriod, 24*time.Hour),
+			},
+			true,
+		},
+		"invalid time": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				amount,
+				"2100-01-01",
+				endTime,
+			},
+			false,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				amount,
+				startTime,
+				endTime,
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdCreatePaymentStream()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdCancelPaymentStream() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				"1",
+			},
+			true,
+		},
+		"invalid id": {
+			[]string{
+				s.authority.String(),
+				"one",
+			},
+			false,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdCancelPaymentStream()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdSubmitProposal() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -430,6 +667,15 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateCensorship() {
 			},
 			true,
 		},
+		"with expiration": {
+			[]string{
+				s.authority.String(),
+				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				foundation.CensorshipAuthorityGovernance.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "2100-01-01T00:00:00Z"),
+			},
+			true,
+		},
 		"wrong number of args": {
 			[]string{
 				s.authority.String(),
@@ -439,6 +685,24 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateCensorship() {
 			},
 			false,
 		},
+		"invalid expiration": {
+			[]string{
+				s.authority.String(),
+				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				foundation.CensorshipAuthorityGovernance.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "tomorrow"),
+			},
+			false,
+		},
+		"expiration on removal": {
+			[]string{
+				s.authority.String(),
+				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				foundation.CensorshipAuthorityUnspecified.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "2100-01-01T00:00:00Z"),
+			},
+			false,
+		},
 		"invalid new authority": {
 			[]string{
 				s.authority.String(),
@@ -20,6 +20,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "lbm-sdk/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveFoundation{}, "lbm-sdk/MsgLeaveFoundation")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "lbm-sdk/MsgWithdrawProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "lbm-sdk/MsgVetoProposal")
 
 	// proposal from foundation operator
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "lbm-sdk/MsgUpdateParams")
@@ -27,6 +28,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMembers{}, "lbm-sdk/MsgUpdateMembers")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateDecisionPolicy{}, "lbm-sdk/MsgUpdateDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateCensorship{}, "lbm-sdk/MsgUpdateCensorship")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMsgTypeDecisionPolicy{}, "lbm-sdk/MsgUpdateMsgTypeDecisionPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgCreatePaymentStream{}, "lbm-sdk/MsgCreatePaymentStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPaymentStream{}, "lbm-sdk/MsgCancelPaymentStream")
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "lbm-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "lbm-sdk/MsgRevoke")
 
@@ -50,8 +54,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawProposal{},
 		&MsgVote{},
 		&MsgExec{},
+		&MsgVetoProposal{},
 		&MsgLeaveFoundation{},
 		&MsgUpdateCensorship{},
+		&MsgUpdateMsgTypeDecisionPolicy{},
+		&MsgCreatePaymentStream{},
+		&MsgCancelPaymentStream{},
 		&MsgGrant{},
 		&MsgRevoke{},
 	)
@@ -80,6 +80,61 @@ func (m *EventFundTreasury) GetAmount() github_com_Finschia_finschia_sdk_types.C
 	return nil
 }
 
+// EventFoundationTaxCollected is an event emitted when the foundation tax is collected into the treasury.
+type EventFoundationTaxCollected struct {
+	// fee_tax is the tax collected from the fees.
+	FeeTax github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_tax,json=feeTax,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"fee_tax"`
+	// inflation_tax is the tax collected from the block provisions of x/mint.
+	InflationTax github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,2,rep,name=inflation_tax,json=inflationTax,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"inflation_tax"`
+}
+
+func (m *EventFoundationTaxCollected) Reset()         { *m = EventFoundationTaxCollected{} }
+func (m *EventFoundationTaxCollected) String() string { return proto.CompactTextString(m) }
+func (*EventFoundationTaxCollected) ProtoMessage()    {}
+func (*EventFoundationTaxCollected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{1}
+}
+func (m *EventFoundationTaxCollected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFoundationTaxCollected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFoundationTaxCollected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFoundationTaxCollected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFoundationTaxCollected.Merge(m, src)
+}
+func (m *EventFoundationTaxCollected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFoundationTaxCollected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFoundationTaxCollected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFoundationTaxCollected proto.InternalMessageInfo
+
+func (m *EventFoundationTaxCollected) GetFeeTax() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.FeeTax
+	}
+	return nil
+}
+
+func (m *EventFoundationTaxCollected) GetInflationTax() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.InflationTax
+	}
+	return nil
+}
+
 // EventWithdrawFromTreasury is an event emitted when coins are withdrawn from the treasury.
 type EventWithdrawFromTreasury struct {
 	To     string                                       `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
@@ -90,7 +145,7 @@ func (m *EventWithdrawFromTreasury) Reset()         { *m = EventWithdrawFromTrea
 func (m *EventWithdrawFromTreasury) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawFromTreasury) ProtoMessage()    {}
 func (*EventWithdrawFromTreasury) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{1}
+	return fileDescriptor_2b66c645bbb34fbc, []int{2}
 }
 func (m *EventWithdrawFromTreasury) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,7 +197,7 @@ func (m *EventUpdateMembers) Reset()         { *m = EventUpdateMembers{} }
 func (m *EventUpdateMembers) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateMembers) ProtoMessage()    {}
 func (*EventUpdateMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{2}
+	return fileDescriptor_2b66c645bbb34fbc, []int{3}
 }
 func (m *EventUpdateMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +242,7 @@ func (m *EventUpdateDecisionPolicy) Reset()         { *m = EventUpdateDecisionPo
 func (m *EventUpdateDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateDecisionPolicy) ProtoMessage()    {}
 func (*EventUpdateDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{3}
+	return fileDescriptor_2b66c645bbb34fbc, []int{4}
 }
 func (m *EventUpdateDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +281,7 @@ func (m *EventSubmitProposal) Reset()         { *m = EventSubmitProposal{} }
 func (m *EventSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*EventSubmitProposal) ProtoMessage()    {}
 func (*EventSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+	return fileDescriptor_2b66c645bbb34fbc, []int{5}
 }
 func (m *EventSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +327,7 @@ func (m *EventWithdrawProposal) Reset()         { *m = EventWithdrawProposal{} }
 func (m *EventWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawProposal) ProtoMessage()    {}
 func (*EventWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{5}
+	return fileDescriptor_2b66c645bbb34fbc, []int{6}
 }
 func (m *EventWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +372,7 @@ func (m *EventVote) Reset()         { *m = EventVote{} }
 func (m *EventVote) String() string { return proto.CompactTextString(m) }
 func (*EventVote) ProtoMessage()    {}
 func (*EventVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{6}
+	return fileDescriptor_2b66c645bbb34fbc, []int{7}
 }
 func (m *EventVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +422,7 @@ func (m *EventExec) Reset()         { *m = EventExec{} }
 func (m *EventExec) String() string { return proto.CompactTextString(m) }
 func (*EventExec) ProtoMessage()    {}
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{7}
+	return fileDescriptor_2b66c645bbb34fbc, []int{8}
 }
 func (m *EventExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +472,61 @@ func (m *EventExec) GetLogs() string {
 	return ""
 }
 
+// EventVetoProposal is an event emitted when a proposal is vetoed.
+type EventVetoProposal struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// authority is the address which vetoed the proposal.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *EventVetoProposal) Reset()         { *m = EventVetoProposal{} }
+func (m *EventVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*EventVetoProposal) ProtoMessage()    {}
+func (*EventVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{9}
+}
+func (m *EventVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVetoProposal.Merge(m, src)
+}
+func (m *EventVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVetoProposal proto.InternalMessageInfo
+
+func (m *EventVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventVetoProposal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 // EventLeaveFoundation is an event emitted when a foundation member leaves the foundation.
 type EventLeaveFoundation struct {
 	// address is the account address of the foundation member.
@@ -427,7 +537,7 @@ func (m *EventLeaveFoundation) Reset()         { *m = EventLeaveFoundation{} }
 func (m *EventLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveFoundation) ProtoMessage()    {}
 func (*EventLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{8}
+	return fileDescriptor_2b66c645bbb34fbc, []int{10}
 }
 func (m *EventLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +582,7 @@ func (m *EventUpdateCensorship) Reset()         { *m = EventUpdateCensorship{} }
 func (m *EventUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateCensorship) ProtoMessage()    {}
 func (*EventUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{9}
+	return fileDescriptor_2b66c645bbb34fbc, []int{11}
 }
 func (m *EventUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +618,249 @@ func (m *EventUpdateCensorship) GetCensorship() Censorship {
 	return Censorship{}
 }
 
+// EventLiftCensorship is emitted when a censorship is lifted on its expiration.
+type EventLiftCensorship struct {
+	Censorship Censorship `protobuf:"bytes,1,opt,name=censorship,proto3" json:"censorship"`
+}
+
+func (m *EventLiftCensorship) Reset()         { *m = EventLiftCensorship{} }
+func (m *EventLiftCensorship) String() string { return proto.CompactTextString(m) }
+func (*EventLiftCensorship) ProtoMessage()    {}
+func (*EventLiftCensorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{12}
+}
+func (m *EventLiftCensorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLiftCensorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLiftCensorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLiftCensorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLiftCensorship.Merge(m, src)
+}
+func (m *EventLiftCensorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLiftCensorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLiftCensorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLiftCensorship proto.InternalMessageInfo
+
+func (m *EventLiftCensorship) GetCensorship() Censorship {
+	if m != nil {
+		return m.Censorship
+	}
+	return Censorship{}
+}
+
+// EventUpdateMsgTypeDecisionPolicy is an event emitted when the decision policy of a message type have been updated.
+type EventUpdateMsgTypeDecisionPolicy struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// decision_policy is the new decision policy of the message type.
+	// it is empty if the decision policy has been removed.
+	DecisionPolicy *types1.Any `protobuf:"bytes,2,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) Reset()         { *m = EventUpdateMsgTypeDecisionPolicy{} }
+func (m *EventUpdateMsgTypeDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateMsgTypeDecisionPolicy) ProtoMessage()    {}
+func (*EventUpdateMsgTypeDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{13}
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy.Merge(m, src)
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy proto.InternalMessageInfo
+
+// EventCreatePaymentStream is an event emitted when a payment stream is created.
+type EventCreatePaymentStream struct {
+	// payment_stream is the created payment stream.
+	PaymentStream PaymentStream `protobuf:"bytes,1,opt,name=payment_stream,json=paymentStream,proto3" json:"payment_stream"`
+}
+
+func (m *EventCreatePaymentStream) Reset()         { *m = EventCreatePaymentStream{} }
+func (m *EventCreatePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*EventCreatePaymentStream) ProtoMessage()    {}
+func (*EventCreatePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{14}
+}
+func (m *EventCreatePaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatePaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreatePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatePaymentStream.Merge(m, src)
+}
+func (m *EventCreatePaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatePaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatePaymentStream proto.InternalMessageInfo
+
+func (m *EventCreatePaymentStream) GetPaymentStream() PaymentStream {
+	if m != nil {
+		return m.PaymentStream
+	}
+	return PaymentStream{}
+}
+
+// EventCancelPaymentStream is an event emitted when a payment stream is cancelled.
+type EventCancelPaymentStream struct {
+	// id is the unique id of the payment stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventCancelPaymentStream) Reset()         { *m = EventCancelPaymentStream{} }
+func (m *EventCancelPaymentStream) String() string { return proto.CompactTextString(m) }
+func (*EventCancelPaymentStream) ProtoMessage()    {}
+func (*EventCancelPaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{15}
+}
+func (m *EventCancelPaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelPaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelPaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelPaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelPaymentStream.Merge(m, src)
+}
+func (m *EventCancelPaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelPaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelPaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelPaymentStream proto.InternalMessageInfo
+
+func (m *EventCancelPaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// EventReleasePaymentStream is an event emitted when coins are paid by a payment stream.
+type EventReleasePaymentStream struct {
+	// id is the unique id of the payment stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address which receives the payments.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of coins paid.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventReleasePaymentStream) Reset()         { *m = EventReleasePaymentStream{} }
+func (m *EventReleasePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*EventReleasePaymentStream) ProtoMessage()    {}
+func (*EventReleasePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{16}
+}
+func (m *EventReleasePaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReleasePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReleasePaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReleasePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReleasePaymentStream.Merge(m, src)
+}
+func (m *EventReleasePaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReleasePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReleasePaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReleasePaymentStream proto.InternalMessageInfo
+
+func (m *EventReleasePaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventReleasePaymentStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventReleasePaymentStream) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // EventGrant is emitted on Msg/Grant
 type EventGrant struct {
 	// the address of the grantee.
@@ -520,7 +873,7 @@ func (m *EventGrant) Reset()         { *m = EventGrant{} }
 func (m *EventGrant) String() string { return proto.CompactTextString(m) }
 func (*EventGrant) ProtoMessage()    {}
 func (*EventGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{10}
+	return fileDescriptor_2b66c645bbb34fbc, []int{17}
 }
 func (m *EventGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +928,7 @@ func (m *EventRevoke) Reset()         { *m = EventRevoke{} }
 func (m *EventRevoke) String() string { return proto.CompactTextString(m) }
 func (*EventRevoke) ProtoMessage()    {}
 func (*EventRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{11}
+	return fileDescriptor_2b66c645bbb34fbc, []int{18}
 }
 func (m *EventRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,6 +973,7 @@ func (m *EventRevoke) GetMsgTypeUrl() string {
 
 func init() {
 	proto.RegisterType((*EventFundTreasury)(nil), "lbm.foundation.v1.EventFundTreasury")
+	proto.RegisterType((*EventFoundationTaxCollected)(nil), "lbm.foundation.v1.EventFoundationTaxCollected")
 	proto.RegisterType((*EventWithdrawFromTreasury)(nil), "lbm.foundation.v1.EventWithdrawFromTreasury")
 	proto.RegisterType((*EventUpdateMembers)(nil), "lbm.foundation.v1.EventUpdateMembers")
 	proto.RegisterType((*EventUpdateDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateDecisionPolicy")
@@ -627,8 +981,14 @@ func init() {
 	proto.RegisterType((*EventWithdrawProposal)(nil), "lbm.foundation.v1.EventWithdrawProposal")
 	proto.RegisterType((*EventVote)(nil), "lbm.foundation.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "lbm.foundation.v1.EventExec")
+	proto.RegisterType((*EventVetoProposal)(nil), "lbm.foundation.v1.EventVetoProposal")
 	proto.RegisterType((*EventLeaveFoundation)(nil), "lbm.foundation.v1.EventLeaveFoundation")
 	proto.RegisterType((*EventUpdateCensorship)(nil), "lbm.foundation.v1.EventUpdateCensorship")
+	proto.RegisterType((*EventLiftCensorship)(nil), "lbm.foundation.v1.EventLiftCensorship")
+	proto.RegisterType((*EventUpdateMsgTypeDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateMsgTypeDecisionPolicy")
+	proto.RegisterType((*EventCreatePaymentStream)(nil), "lbm.foundation.v1.EventCreatePaymentStream")
+	proto.RegisterType((*EventCancelPaymentStream)(nil), "lbm.foundation.v1.EventCancelPaymentStream")
+	proto.RegisterType((*EventReleasePaymentStream)(nil), "lbm.foundation.v1.EventReleasePaymentStream")
 	proto.RegisterType((*EventGrant)(nil), "lbm.foundation.v1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "lbm.foundation.v1.EventRevoke")
 }
@@ -636,52 +996,65 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/event.proto", fileDescriptor_2b66c645bbb34fbc) }
 
 var fileDescriptor_2b66c645bbb34fbc = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xd0, 0xf0, 0xfb, 0x31, 0x95, 0x1a, 0x56, 0x8c, 0x05, 0x43, 0xdb, 0xec, 0x09,
-	0x13, 0xbb, 0x6b, 0xd1, 0x18, 0x43, 0xa2, 0x09, 0x45, 0x4a, 0x48, 0x24, 0xc1, 0x15, 0x34, 0x31,
-	0x26, 0xcd, 0xec, 0xee, 0x74, 0x3b, 0x61, 0x77, 0xdf, 0x3a, 0x33, 0xbb, 0x52, 0xae, 0x5e, 0x3c,
-	0x72, 0xf0, 0x6c, 0x3c, 0x7b, 0xe6, 0x8f, 0x20, 0x9c, 0x38, 0x7a, 0x52, 0x03, 0xff, 0x88, 0xd9,
-	0xd9, 0x69, 0xa1, 0x01, 0xc1, 0x93, 0xb7, 0xf7, 0x66, 0xde, 0xf7, 0xfb, 0x3e, 0xf3, 0x66, 0x06,
-	0xcd, 0x07, 0x4e, 0x68, 0x75, 0x21, 0x89, 0x3c, 0x2c, 0x28, 0x44, 0x56, 0xda, 0xb4, 0x48, 0x4a,
-	0x22, 0x61, 0xc6, 0x0c, 0x04, 0xe8, 0xd3, 0x81, 0x13, 0x9a, 0x67, 0xdb, 0x66, 0xda, 0x9c, 0x9b,
-	0xf1, 0xc1, 0x07, 0xb9, 0x6b, 0x65, 0x51, 0x5e, 0x38, 0x37, 0xeb, 0x03, 0xf8, 0x01, 0xb1, 0x64,
-	0xe6, 0x24, 0x5d, 0x0b, 0x47, 0xfd, 0xc1, 0x96, 0x0b, 0x3c, 0x04, 0xde, 0xc9, 0x35, 0x79, 0xa2,
-	0xb6, 0xaa, 0x79, 0x66, 0x39, 0x98, 0x13, 0x2b, 0x6d, 0x3a, 0x44, 0xe0, 0xa6, 0xe5, 0x02, 0x8d,
-	0xd4, 0xbe, 0x71, 0x91, 0xee, 0x1c, 0x8c, 0xac, 0x31, 0xf6, 0x35, 0x34, 0xbd, 0x9a, 0x21, 0xb7,
-	0x93, 0xc8, 0xdb, 0x62, 0x04, 0xf3, 0x84, 0xf5, 0x75, 0x1d, 0x15, 0xbb, 0x0c, 0xc2, 0x8a, 0x56,
-	0xd7, 0x16, 0x26, 0x6d, 0x19, 0xeb, 0x3e, 0x9a, 0xc0, 0x21, 0x24, 0x91, 0xa8, 0x8c, 0xd5, 0xc7,
-	0x17, 0x4a, 0x8b, 0xb3, 0xa6, 0x82, 0xc9, 0xda, 0x9b, 0xaa, 0xbd, 0xb9, 0x02, 0x34, 0x6a, 0x3d,
-	0x3a, 0xfc, 0x51, 0x2b, 0x7c, 0xfb, 0x59, 0xbb, 0xef, 0x53, 0xd1, 0x4b, 0x1c, 0xd3, 0x85, 0xd0,
-	0x6a, 0xd3, 0x88, 0xbb, 0x3d, 0x8a, 0xad, 0xae, 0x0a, 0x1a, 0xdc, 0xdb, 0xb1, 0x44, 0x3f, 0x26,
-	0x5c, 0x8a, 0xb8, 0xad, 0xec, 0x8d, 0xcf, 0x1a, 0x9a, 0x95, 0x48, 0x6f, 0xa8, 0xe8, 0x79, 0x0c,
-	0x7f, 0x68, 0x33, 0x08, 0x87, 0x68, 0x65, 0x34, 0x26, 0x40, 0x81, 0x8d, 0x09, 0xf8, 0x77, 0x58,
-	0x2e, 0xd2, 0x25, 0xd5, 0x76, 0xec, 0x61, 0x41, 0x36, 0x48, 0xe8, 0x10, 0xc6, 0xf5, 0x0d, 0x54,
-	0x0e, 0x65, 0xd8, 0x49, 0xe4, 0x3a, 0xaf, 0x68, 0x12, 0xa3, 0x6e, 0x5e, 0xb8, 0x7b, 0x33, 0xd7,
-	0xd8, 0xe4, 0x7d, 0x42, 0xb8, 0x68, 0x15, 0x33, 0x1a, 0x7b, 0x2a, 0x57, 0xe7, 0xa6, 0xdc, 0x10,
-	0xea, 0xe8, 0x79, 0xfe, 0x9c, 0xb8, 0x94, 0x53, 0x88, 0x36, 0x21, 0xa0, 0x6e, 0x5f, 0x7f, 0x89,
-	0x6e, 0x7a, 0x6a, 0xa5, 0x13, 0xcb, 0x25, 0x39, 0x87, 0xd2, 0xe2, 0x8c, 0x99, 0xbf, 0x1f, 0x73,
-	0xf0, 0x7e, 0xcc, 0xe5, 0xa8, 0xdf, 0xd2, 0x8f, 0x0e, 0x1a, 0xe5, 0x51, 0x0b, 0xbb, 0xec, 0x8d,
-	0xe4, 0x4b, 0xc5, 0x4f, 0x5f, 0x6b, 0x05, 0x63, 0x0b, 0xdd, 0x92, 0x5d, 0x5f, 0x25, 0x4e, 0x48,
-	0xc5, 0x26, 0x83, 0x18, 0x38, 0x0e, 0xf4, 0xa7, 0xe8, 0xff, 0x58, 0xc5, 0xaa, 0xd1, 0xdd, 0x4b,
-	0x4e, 0x35, 0x28, 0x57, 0x07, 0x1a, 0x4a, 0x8c, 0x27, 0xe8, 0xf6, 0xc8, 0x35, 0x0e, 0x7d, 0x6b,
-	0xa8, 0x34, 0x28, 0xea, 0x50, 0x4f, 0x5a, 0x17, 0x6d, 0x34, 0x58, 0x5a, 0xf7, 0x8c, 0x67, 0x68,
-	0x52, 0x2a, 0x5f, 0x83, 0x20, 0x7a, 0x13, 0x15, 0x53, 0x10, 0x44, 0x11, 0xdc, 0xb9, 0x84, 0x20,
-	0x2b, 0x53, 0xdd, 0x65, 0xa9, 0xf1, 0x51, 0x53, 0x06, 0xab, 0xbb, 0xc4, 0xbd, 0xb6, 0x9d, 0xbe,
-	0x8c, 0x26, 0x18, 0xe1, 0x49, 0x90, 0x3d, 0x21, 0x6d, 0xa1, 0xbc, 0x78, 0xef, 0x8a, 0x53, 0x66,
-	0x8e, 0x89, 0x00, 0x66, 0x4b, 0x81, 0xad, 0x84, 0xd9, 0x87, 0x09, 0xc0, 0xe7, 0x95, 0xf1, 0xfc,
-	0xc3, 0x64, 0xb1, 0xf1, 0x00, 0xcd, 0x48, 0x88, 0x17, 0x04, 0xa7, 0xa4, 0x3d, 0x74, 0xd3, 0x2b,
-	0xe8, 0x3f, 0xec, 0x79, 0x8c, 0x70, 0xae, 0x9e, 0xf1, 0x20, 0x35, 0xde, 0xa9, 0x89, 0xe5, 0xb7,
-	0xbf, 0x42, 0x22, 0x0e, 0x8c, 0xf7, 0x68, 0xac, 0xaf, 0x20, 0xe4, 0x0e, 0x33, 0x35, 0x89, 0xf9,
-	0x4b, 0x28, 0xcf, 0x24, 0x6a, 0x1e, 0xe7, 0x64, 0xc6, 0x17, 0x0d, 0x21, 0x69, 0xbf, 0xc6, 0x70,
-	0x24, 0x32, 0x0c, 0x3f, 0x0b, 0x08, 0x19, 0x60, 0xa8, 0x54, 0x4f, 0xd1, 0x14, 0x4e, 0x44, 0x0f,
-	0x18, 0xdd, 0x93, 0xce, 0x72, 0x2c, 0x7f, 0x7a, 0x65, 0x4b, 0x47, 0x07, 0x8d, 0xc7, 0xd7, 0x7e,
-	0xa8, 0x5d, 0x2b, 0x73, 0xdc, 0x33, 0x97, 0xcf, 0xfb, 0xda, 0xa3, 0x6d, 0x8c, 0x75, 0x54, 0x92,
-	0x7c, 0x36, 0x49, 0x61, 0x87, 0x5c, 0x01, 0x58, 0x47, 0x37, 0x42, 0xee, 0x77, 0xb2, 0x5f, 0xda,
-	0x49, 0x58, 0x20, 0xf9, 0x26, 0x6d, 0x14, 0x72, 0x7f, 0xab, 0x1f, 0x93, 0x6d, 0x16, 0xb4, 0xd6,
-	0x0e, 0x4f, 0xaa, 0xda, 0xf1, 0x49, 0x55, 0xfb, 0x75, 0x52, 0xd5, 0xf6, 0x4f, 0xab, 0x85, 0xe3,
-	0xd3, 0x6a, 0xe1, 0xfb, 0x69, 0xb5, 0xf0, 0xb6, 0xf1, 0x17, 0xac, 0x67, 0x43, 0x75, 0x26, 0xe4,
-	0x61, 0x1f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x6e, 0x65, 0xdb, 0xea, 0x05, 0x00, 0x00,
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xba, 0x56, 0x4a, 0x5e, 0x1a, 0xa3, 0x0e, 0x41, 0x38, 0x2d, 0x75, 0xac, 0x3d, 0x05,
+	0x44, 0x76, 0x71, 0x40, 0x08, 0x55, 0x02, 0x29, 0x31, 0x4d, 0x55, 0xa9, 0x95, 0xc2, 0xe2, 0x16,
+	0xa9, 0x42, 0xb2, 0x66, 0x77, 0x9f, 0xd7, 0xa3, 0xee, 0xee, 0x2c, 0x33, 0xb3, 0xc6, 0xee, 0x95,
+	0x0b, 0xc7, 0x1e, 0xb8, 0x82, 0x38, 0x73, 0x44, 0xfd, 0x10, 0x55, 0x4f, 0x3d, 0x72, 0x02, 0x94,
+	0x7c, 0x0e, 0x24, 0xb4, 0xb3, 0xb3, 0xfe, 0x93, 0x5a, 0x69, 0x0f, 0x0d, 0xb7, 0xf7, 0xde, 0xbc,
+	0x3f, 0xbf, 0x37, 0xef, 0xf7, 0x1e, 0xdc, 0x88, 0xfd, 0xc4, 0x1d, 0xf2, 0x3c, 0x0d, 0xa9, 0x62,
+	0x3c, 0x75, 0xc7, 0x5d, 0x17, 0xc7, 0x98, 0x2a, 0x27, 0x13, 0x5c, 0x71, 0x72, 0x35, 0xf6, 0x13,
+	0x67, 0xfe, 0xec, 0x8c, 0xbb, 0xd7, 0xb6, 0x22, 0x1e, 0x71, 0xfd, 0xea, 0x16, 0x52, 0xe9, 0x78,
+	0x6d, 0x3b, 0xe2, 0x3c, 0x8a, 0xd1, 0xd5, 0x9a, 0x9f, 0x0f, 0x5d, 0x9a, 0x4e, 0xab, 0xa7, 0x80,
+	0xcb, 0x84, 0xcb, 0x41, 0x19, 0x53, 0x2a, 0xe6, 0xa9, 0x5d, 0x6a, 0xae, 0x4f, 0x25, 0xba, 0xe3,
+	0xae, 0x8f, 0x8a, 0x76, 0xdd, 0x80, 0xb3, 0xd4, 0xbc, 0xdb, 0x2f, 0xa3, 0x5b, 0x00, 0xa3, 0x7d,
+	0xec, 0x27, 0x16, 0x5c, 0xbd, 0x55, 0x40, 0x3e, 0xca, 0xd3, 0xb0, 0x2f, 0x90, 0xca, 0x5c, 0x4c,
+	0x09, 0x81, 0xc6, 0x50, 0xf0, 0xa4, 0x65, 0x75, 0xac, 0xdd, 0x75, 0x4f, 0xcb, 0x24, 0x82, 0x35,
+	0x9a, 0xf0, 0x3c, 0x55, 0xad, 0x7a, 0xe7, 0xd2, 0xee, 0xc6, 0xfe, 0xb6, 0x63, 0xc0, 0x14, 0xe5,
+	0x1d, 0x53, 0xde, 0xe9, 0x71, 0x96, 0x1e, 0x7e, 0xfa, 0xec, 0xaf, 0x9d, 0xda, 0xef, 0x7f, 0xef,
+	0x7c, 0x14, 0x31, 0x35, 0xca, 0x7d, 0x27, 0xe0, 0x89, 0x7b, 0xc4, 0x52, 0x19, 0x8c, 0x18, 0x75,
+	0x87, 0x46, 0xd8, 0x93, 0xe1, 0x23, 0x57, 0x4d, 0x33, 0x94, 0x3a, 0x48, 0x7a, 0x26, 0xbd, 0xfd,
+	0xaf, 0x05, 0xd7, 0x4b, 0x48, 0x33, 0xb0, 0x7d, 0x3a, 0xe9, 0xf1, 0x38, 0xc6, 0x40, 0x61, 0x48,
+	0x46, 0x70, 0x79, 0x88, 0x38, 0x50, 0x74, 0xd2, 0xb2, 0x2e, 0x08, 0xc9, 0x10, 0xb1, 0x4f, 0x27,
+	0x44, 0xc1, 0x26, 0x4b, 0x87, 0xb1, 0x86, 0xa0, 0xeb, 0x5d, 0x50, 0xe7, 0x57, 0x66, 0x55, 0xfa,
+	0x74, 0x62, 0xff, 0x6c, 0xc1, 0xb6, 0xee, 0xff, 0x5b, 0xa6, 0x46, 0xa1, 0xa0, 0x3f, 0x1c, 0x09,
+	0x9e, 0xcc, 0x46, 0xd3, 0x84, 0xba, 0xe2, 0x66, 0x30, 0x75, 0xc5, 0xff, 0xbf, 0xb1, 0x04, 0x40,
+	0x34, 0xaa, 0xfb, 0x59, 0x48, 0x15, 0xde, 0xc3, 0xc4, 0x47, 0x21, 0xc9, 0x3d, 0x68, 0x26, 0x5a,
+	0x1c, 0xe4, 0xda, 0x2e, 0xcd, 0x4c, 0x3a, 0xce, 0x4b, 0xdc, 0x77, 0xca, 0x18, 0x0f, 0xbf, 0xcf,
+	0x51, 0xaa, 0xc3, 0x46, 0x81, 0xc6, 0xdb, 0x2c, 0xa3, 0xcb, 0xa4, 0xd2, 0x56, 0xa6, 0xf5, 0x52,
+	0xff, 0x0a, 0x03, 0x26, 0x19, 0x4f, 0x8f, 0x79, 0xcc, 0x82, 0x29, 0xf9, 0x1a, 0xde, 0x0e, 0x8d,
+	0x65, 0x90, 0x69, 0x93, 0xfe, 0x87, 0x8d, 0xfd, 0x2d, 0xa7, 0xdc, 0x1f, 0xa7, 0xda, 0x1f, 0xe7,
+	0x20, 0x9d, 0x1e, 0x92, 0xe7, 0x4f, 0xf7, 0x9a, 0xcb, 0x29, 0xbc, 0x66, 0xb8, 0xa4, 0xdf, 0x6c,
+	0xfc, 0xf4, 0xdb, 0x4e, 0xcd, 0xee, 0xc3, 0x3b, 0xba, 0xea, 0x37, 0xb9, 0x9f, 0x30, 0x75, 0x2c,
+	0x78, 0xc6, 0x25, 0x8d, 0xc9, 0x17, 0xf0, 0x56, 0x66, 0x64, 0x53, 0xe8, 0xfa, 0x8a, 0xae, 0x2a,
+	0x77, 0xd3, 0xd0, 0x2c, 0xc4, 0xfe, 0x1c, 0xde, 0x5d, 0x1a, 0xe3, 0x2c, 0xef, 0x0e, 0x6c, 0x54,
+	0x4e, 0x03, 0x16, 0xea, 0xd4, 0x0d, 0x0f, 0x2a, 0xd3, 0x9d, 0xd0, 0xfe, 0x12, 0xd6, 0x75, 0xe4,
+	0x03, 0xae, 0x90, 0x74, 0xa1, 0x31, 0xe6, 0x0a, 0x0d, 0x82, 0xf7, 0x56, 0x20, 0x28, 0xdc, 0x4c,
+	0x75, 0xed, 0x6a, 0xff, 0x68, 0x99, 0x04, 0xb7, 0x26, 0x18, 0xbc, 0xb2, 0x1c, 0x39, 0x80, 0x35,
+	0x81, 0x32, 0x8f, 0x0b, 0x0a, 0x59, 0xbb, 0xcd, 0xfd, 0x0f, 0xce, 0xe9, 0xb2, 0xc8, 0x98, 0x2b,
+	0x2e, 0x3c, 0x1d, 0xe0, 0x99, 0xc0, 0xe2, 0x60, 0xc4, 0x3c, 0x92, 0xad, 0x4b, 0xe5, 0xc1, 0x28,
+	0x64, 0xdb, 0x33, 0x97, 0xe5, 0x01, 0x2a, 0xfe, 0xda, 0xbd, 0x93, 0xf7, 0x61, 0x9d, 0xe6, 0x6a,
+	0xc4, 0x05, 0x53, 0x53, 0x8d, 0x67, 0xdd, 0x9b, 0x1b, 0xec, 0x8f, 0x61, 0x4b, 0xe7, 0xbc, 0x8b,
+	0x74, 0x8c, 0xf3, 0xfb, 0x40, 0x5a, 0x70, 0x99, 0x86, 0xa1, 0x40, 0x29, 0xcd, 0x6a, 0x54, 0xaa,
+	0xfd, 0x9d, 0x99, 0x42, 0xc9, 0xa8, 0x1e, 0xa6, 0x92, 0x0b, 0x39, 0x62, 0x19, 0xe9, 0x01, 0x04,
+	0x33, 0xcd, 0xfc, 0xee, 0x8d, 0x15, 0x9d, 0xcf, 0x43, 0xcc, 0x1f, 0x2f, 0x84, 0xd9, 0x0f, 0x0d,
+	0x73, 0xee, 0xb2, 0xa1, 0x7a, 0xd3, 0xb9, 0x7f, 0xb1, 0xa0, 0xb3, 0xb8, 0x71, 0x32, 0xea, 0x4f,
+	0xb3, 0xb3, 0x3b, 0xd1, 0x81, 0x2b, 0x89, 0x8c, 0x06, 0xc5, 0xc2, 0x0e, 0x72, 0x11, 0x9b, 0xee,
+	0x21, 0x29, 0x9d, 0xef, 0x8b, 0x78, 0xd5, 0xd6, 0xd4, 0xdf, 0xc8, 0xd6, 0x30, 0x68, 0x69, 0x78,
+	0x3d, 0x81, 0x54, 0xe1, 0x31, 0x9d, 0x26, 0xc5, 0x0a, 0x29, 0x81, 0x34, 0x29, 0xce, 0x42, 0x56,
+	0x1a, 0x06, 0x52, 0x5b, 0xcc, 0x27, 0xac, 0x3a, 0x0b, 0x4b, 0x91, 0xd5, 0x59, 0xc8, 0x16, 0x8d,
+	0xf6, 0x87, 0x55, 0x29, 0x9a, 0x06, 0x18, 0x2f, 0x97, 0x6a, 0x42, 0x7d, 0x46, 0xa4, 0x3a, 0x0b,
+	0xed, 0x3f, 0xaa, 0xf3, 0xe9, 0x61, 0x8c, 0x54, 0xe2, 0xb9, 0xde, 0x05, 0xdd, 0x04, 0x06, 0x2c,
+	0x63, 0x98, 0xaa, 0x8a, 0x6e, 0x33, 0xc3, 0xc2, 0x71, 0xbd, 0x74, 0xb1, 0xc7, 0xf5, 0x57, 0x0b,
+	0x40, 0x83, 0xbe, 0x2d, 0x68, 0xaa, 0x0a, 0x3a, 0x47, 0x85, 0x80, 0x58, 0xd1, 0xd9, 0xa8, 0x64,
+	0x0c, 0x9b, 0x66, 0x1b, 0x1e, 0xeb, 0x0f, 0x3c, 0x77, 0x96, 0x37, 0x9f, 0x3f, 0xdd, 0xfb, 0xec,
+	0x95, 0x78, 0x26, 0x6e, 0x91, 0xf1, 0xb1, 0x73, 0xb0, 0x98, 0xd7, 0x5b, 0x2e, 0x63, 0xdf, 0x81,
+	0x0d, 0xf3, 0xa9, 0x63, 0xfe, 0x08, 0xcf, 0x01, 0x78, 0x96, 0x90, 0xf5, 0xb3, 0x84, 0x3c, 0xbc,
+	0xfd, 0xec, 0xa4, 0x6d, 0xbd, 0x38, 0x69, 0x5b, 0xff, 0x9c, 0xb4, 0xad, 0x27, 0xa7, 0xed, 0xda,
+	0x8b, 0xd3, 0x76, 0xed, 0xcf, 0xd3, 0x76, 0xed, 0xe1, 0xde, 0x6b, 0x60, 0x9d, 0x73, 0xc7, 0x5f,
+	0xd3, 0xcd, 0x7e, 0xf2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xe1, 0x34, 0x3e, 0x86, 0x09,
+	0x00, 0x00,
 }
 
 func (m *EventFundTreasury) Marshal() (dAtA []byte, err error) {
@@ -728,7 +1101,7 @@ func (m *EventFundTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdrawFromTreasury) Marshal() (dAtA []byte, err error) {
+func (m *EventFoundationTaxCollected) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -738,20 +1111,20 @@ func (m *EventWithdrawFromTreasury) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWithdrawFromTreasury) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFoundationTaxCollected) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWithdrawFromTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFoundationTaxCollected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InflationTax) > 0 {
+		for iNdEx := len(m.InflationTax) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InflationTax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -762,17 +1135,24 @@ func (m *EventWithdrawFromTreasury) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.FeeTax) > 0 {
+		for iNdEx := len(m.FeeTax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
+func (m *EventWithdrawFromTreasury) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -782,20 +1162,64 @@ func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateMembers) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventWithdrawFromTreasury) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventWithdrawFromTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MemberUpdates) > 0 {
-		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemberUpdates) > 0 {
+		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -978,6 +1402,41 @@ func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventLeaveFoundation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1041,6 +1500,191 @@ func (m *EventUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventLiftCensorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLiftCensorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLiftCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreatePaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatePaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PaymentStream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelPaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelPaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelPaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReleasePaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReleasePaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReleasePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventGrant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1150,6 +1794,27 @@ func (m *EventFundTreasury) Size() (n int) {
 	return n
 }
 
+func (m *EventFoundationTaxCollected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTax) > 0 {
+		for _, e := range m.FeeTax {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.InflationTax) > 0 {
+		for _, e := range m.InflationTax {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventWithdrawFromTreasury) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1250,30 +1915,119 @@ func (m *EventExec) Size() (n int) {
 	return n
 }
 
-func (m *EventLeaveFoundation) Size() (n int) {
+func (m *EventVetoProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
 	return n
 }
 
-func (m *EventUpdateCensorship) Size() (n int) {
+func (m *EventLeaveFoundation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Censorship.Size()
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateCensorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Censorship.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventLiftCensorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Censorship.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreatePaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentStream.Size()
 	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
+func (m *EventCancelPaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventReleasePaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventGrant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1430,6 +2184,124 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFoundationTaxCollected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFoundationTaxCollected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFoundationTaxCollected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTax = append(m.FeeTax, types.Coin{})
+			if err := m.FeeTax[len(m.FeeTax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationTax = append(m.InflationTax, types.Coin{})
+			if err := m.InflationTax[len(m.InflationTax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1493,9 +2365,637 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1505,25 +3005,23 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1546,7 +3044,7 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
+func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +3067,17 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLeaveFoundation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1589,25 +3087,23 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1630,7 +3126,7 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,15 +3149,15 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateCensorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1688,10 +3184,7 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
-			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1716,7 +3209,7 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *EventLiftCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1739,15 +3232,15 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLiftCensorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLiftCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1774,7 +3267,7 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1799,7 +3292,7 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateMsgTypeDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1822,17 +3315,17 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateMsgTypeDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateMsgTypeDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1842,11 +3335,60 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1868,7 +3410,7 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventVote) Unmarshal(dAtA []byte) error {
+func (m *EventCreatePaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,15 +3433,15 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreatePaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreatePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1926,7 +3468,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PaymentStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1951,7 +3493,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExec) Unmarshal(dAtA []byte) error {
+func (m *EventCancelPaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1974,55 +3516,17 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelPaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelPaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2032,24 +3536,11 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Logs = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2071,7 +3562,7 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
+func (m *EventReleasePaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2094,15 +3585,34 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeaveFoundation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReleasePaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReleasePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2130,61 +3640,11 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateCensorship: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2211,7 +3671,8 @@ func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,7 @@ package foundation
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 )
 
 type (
@@ -20,4 +21,11 @@ type (
 		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	}
+
+	// MintKeeper defines the mint module interface contract needed by the
+	// foundation module.
+	MintKeeper interface {
+		GetMinter(ctx sdk.Context) minttypes.Minter
+		GetParams(ctx sdk.Context) minttypes.Params
+	}
 )
@@ -69,6 +69,10 @@ func (c Censorship) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("censorship authority %s over %s", authority, url)
 	}
 
+	if c.Expiration != nil && authority == CensorshipAuthorityUnspecified {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expiration on the removal of censorship over %s", url)
+	}
+
 	return nil
 }
 
@@ -77,6 +81,45 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateDenomTaxes(p.DenomTaxes); err != nil {
+		return err
+	}
+
+	if err := validateRatio(p.InflationTax, ParamKeyInflationTax); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTaxRatio returns the foundation tax ratio of the given denom.
+func (p Params) GetTaxRatio(denom string) sdk.Dec {
+	for _, tax := range p.DenomTaxes {
+		if tax.Denom == denom {
+			return tax.Tax
+		}
+	}
+
+	return p.FoundationTax
+}
+
+func validateDenomTaxes(taxes []DenomTax) error {
+	seenDenoms := map[string]bool{}
+	for _, tax := range taxes {
+		denom := tax.Denom
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if seenDenoms[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate tax of %s", denom)
+		}
+		seenDenoms[denom] = true
+
+		if err := validateRatio(tax.Tax, ParamKeyDenomTaxes); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -91,6 +134,22 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 			return validateRatio(v, ParamKeyFoundationTax)
 		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyDenomTaxes), &p.DenomTaxes, func(i interface{}) error {
+			v, ok := i.([]DenomTax)
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyDenomTaxes)
+			}
+
+			return validateDenomTaxes(v)
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyInflationTax), &p.InflationTax, func(i interface{}) error {
+			v, ok := i.(sdk.Dec)
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyInflationTax)
+			}
+
+			return validateRatio(v, ParamKeyInflationTax)
+		}),
 	}
 }
 
@@ -103,6 +162,10 @@ func (m Member) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	if err := validateMemberWeight(m.Weight); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -112,6 +175,31 @@ func (m MemberRequest) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	if m.Weight != nil {
+		if m.Remove {
+			return sdkerrors.ErrInvalidRequest.Wrapf("weight must be empty on removal: %s", m.Address)
+		}
+		if err := validateMemberWeight(*m.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetWeight returns the weight of the member request, which defaults to 1.
+func (m MemberRequest) GetWeight() sdk.Dec {
+	if m.Weight == nil {
+		return sdk.OneDec()
+	}
+	return *m.Weight
+}
+
+func validateMemberWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("member weight must be positive")
+	}
+
 	return nil
 }
 
@@ -126,6 +214,9 @@ type DecisionPolicy interface {
 	// GetVotingPeriod returns the duration after proposal submission where
 	// votes are accepted.
 	GetVotingPeriod() time.Duration
+	// GetExecutionDelay returns the duration after proposal acceptance where
+	// the proposal cannot be executed.
+	GetExecutionDelay() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the foundation's total power and the time since
 	// the proposal was submitted.
@@ -149,9 +240,8 @@ func NewTallyResult(yes, abstain, no, veto sdk.Dec) TallyResult {
 	}
 }
 
-func (t *TallyResult) Add(option VoteOption) error {
-	weight := sdk.OneDec()
-
+// Add adds the weight of the voter to the count of the option.
+func (t *TallyResult) Add(option VoteOption, weight sdk.Dec) error {
 	switch option {
 	case VOTE_OPTION_YES:
 		t.YesCount = t.YesCount.Add(weight)
@@ -195,6 +285,9 @@ func (p Proposal) ValidateBasic() error {
 	if err := validateMsgs(p.GetMsgs()); err != nil {
 		return err
 	}
+	if p.TimelockEnd != nil && p.Status != PROPOSAL_STATUS_ACCEPTED && p.Status != PROPOSAL_STATUS_VETOED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("timelock on the proposal of status %s", p.Status)
+	}
 	return nil
 }
 
@@ -271,6 +364,9 @@ func validateDecisionPolicyWindows(windows DecisionPolicyWindows, config Config)
 	if windows.MinExecutionPeriod >= windows.VotingPeriod+config.MaxExecutionPeriod {
 		return sdkerrors.ErrInvalidRequest.Wrap("min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if windows.ExecutionDelay >= config.MaxExecutionPeriod {
+		return sdkerrors.ErrInvalidRequest.Wrap("execution_delay should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -278,6 +374,9 @@ func validateDecisionPolicyWindowsBasic(windows *DecisionPolicyWindows) error {
 	if windows == nil || windows.VotingPeriod == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("voting period cannot be zero")
 	}
+	if windows.ExecutionDelay < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("execution delay cannot be negative")
+	}
 
 	return nil
 }
@@ -316,6 +415,10 @@ func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
 	return p.Windows.VotingPeriod
 }
 
+func (p ThresholdDecisionPolicy) GetExecutionDelay() time.Duration {
+	return p.Windows.ExecutionDelay
+}
+
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
 		return sdkerrors.ErrInvalidRequest.Wrap("threshold must be a positive number")
@@ -372,6 +475,10 @@ func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
 	return p.Windows.VotingPeriod
 }
 
+func (p PercentageDecisionPolicy) GetExecutionDelay() time.Duration {
+	return p.Windows.ExecutionDelay
+}
+
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	if err := validateDecisionPolicyWindowsBasic(p.Windows); err != nil {
 		return err
@@ -419,6 +526,10 @@ func (p OutsourcingDecisionPolicy) GetVotingPeriod() time.Duration {
 	return 0
 }
 
+func (p OutsourcingDecisionPolicy) GetExecutionDelay() time.Duration {
+	return 0
+}
+
 func (p OutsourcingDecisionPolicy) ValidateBasic() error {
 	return nil
 }
@@ -470,6 +581,72 @@ func (i *FoundationInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 	return unpacker.UnpackAny(i.DecisionPolicy, &policy)
 }
 
+func (p MsgTypeDecisionPolicy) ValidateBasic() error {
+	if len(p.MsgTypeUrl) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty msg type url")
+	}
+
+	policy := p.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("nil decision policy over %s", p.MsgTypeUrl)
+	}
+	if err := validateMsgTypeDecisionPolicy(policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMsgTypeDecisionPolicy validates the decision policy to be used for a message type.
+func validateMsgTypeDecisionPolicy(policy DecisionPolicy) error {
+	if _, isOutsourcing := policy.(*OutsourcingDecisionPolicy); isOutsourcing {
+		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allowed for a message type")
+	}
+
+	return policy.ValidateBasic()
+}
+
+func (p MsgTypeDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if p.DecisionPolicy == nil {
+		return nil
+	}
+
+	policy, ok := p.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+func (p *MsgTypeDecisionPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	msg, ok := policy.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("can't proto marshal %T", msg)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	p.DecisionPolicy = any
+
+	return nil
+}
+
+// for the tests
+func (p MsgTypeDecisionPolicy) WithDecisionPolicy(policy DecisionPolicy) *MsgTypeDecisionPolicy {
+	msgTypePolicy := p
+	if err := msgTypePolicy.SetDecisionPolicy(policy); err != nil {
+		return nil
+	}
+	return &msgTypePolicy
+}
+
+func (p MsgTypeDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(p.DecisionPolicy, &policy)
+}
+
 func GetAuthorization(any *codectypes.Any, name string) (Authorization, error) {
 	cached := any.GetCachedValue()
 	if cached == nil {
@@ -504,6 +681,78 @@ func (p Pool) ValidateBasic() error {
 	return nil
 }
 
+func (s PaymentStream) ValidateBasic() error {
+	if s.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("id must be > 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", s.Recipient)
+	}
+
+	if err := validatePaymentSchedule(s.Amount, s.StartTime, s.EndTime, s.Period); err != nil {
+		return err
+	}
+
+	if err := s.Paid.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid paid amount: %s", err)
+	}
+	if !s.Paid.IsAllLTE(s.Amount) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("paid amount %s exceeds the total amount %s", s.Paid, s.Amount)
+	}
+
+	return nil
+}
+
+func validatePaymentSchedule(amount sdk.Coins, startTime, endTime time.Time, period time.Duration) error {
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
+	}
+
+	if !endTime.After(startTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+
+	if period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("negative period")
+	}
+	if period > endTime.Sub(startTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("period exceeds the duration of the stream")
+	}
+
+	return nil
+}
+
+// Released returns the amount of coins released by the stream at the given
+// time, including the coins already paid.
+func (s PaymentStream) Released(now time.Time) sdk.Coins {
+	if !now.After(s.StartTime) {
+		return sdk.NewCoins()
+	}
+	if !now.Before(s.EndTime) {
+		return s.Amount
+	}
+
+	duration := s.EndTime.Sub(s.StartTime)
+	elapsed := now.Sub(s.StartTime)
+
+	// released = amount * numerator / denominator
+	numerator, denominator := int64(elapsed), int64(duration)
+	if s.Period != 0 {
+		// the number of installments, the last one of which is paid at the end
+		installments := (duration + s.Period - 1) / s.Period
+		numerator, denominator = int64(elapsed/s.Period), int64(installments)
+	}
+
+	released := make([]sdk.Coin, len(s.Amount))
+	for i, coin := range s.Amount {
+		amount := coin.Amount.MulRaw(numerator).QuoRaw(denominator)
+		released[i] = sdk.NewCoin(coin.Denom, amount)
+	}
+
+	return sdk.NewCoins(released...)
+}
+
 // Members defines a repeated slice of Member objects.
 type Members struct {
 	Members []Member
@@ -528,6 +777,15 @@ func (ms Members) ValidateBasic() error {
 	return nil
 }
 
+// TotalWeight returns the sum of the weights of the members.
+func (ms Members) TotalWeight() sdk.Dec {
+	totalWeight := sdk.ZeroDec()
+	for _, member := range ms.Members {
+		totalWeight = totalWeight.Add(member.Weight)
+	}
+	return totalWeight
+}
+
 // MemberRequests defines a repeated slice of MemberRequest objects.
 type MemberRequests struct {
 	Members []MemberRequest
@@ -123,6 +123,8 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of a proposal when it is vetoed during its timelock.
+	PROPOSAL_STATUS_VETOED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -132,6 +134,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -141,6 +144,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_VETOED":      6,
 }
 
 func (x ProposalStatus) String() string {
@@ -190,6 +194,12 @@ func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
 // Params defines the parameters for the foundation module.
 type Params struct {
 	FoundationTax github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=foundation_tax,json=foundationTax,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"foundation_tax"`
+	// denom_taxes defines the tax ratios of the specific denoms, which override
+	// foundation_tax.
+	DenomTaxes []DenomTax `protobuf:"bytes,3,rep,name=denom_taxes,json=denomTaxes,proto3" json:"denom_taxes"`
+	// inflation_tax defines the ratio of the block provisions of x/mint, which
+	// goes to the treasury.
+	InflationTax github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_tax,json=inflationTax,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"inflation_tax"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -225,16 +235,74 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDenomTaxes() []DenomTax {
+	if m != nil {
+		return m.DenomTaxes
+	}
+	return nil
+}
+
+// DenomTax defines the foundation tax ratio of a denom.
+type DenomTax struct {
+	// denom is the denom of the coins.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// tax is the tax ratio of the denom.
+	Tax github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"tax"`
+}
+
+func (m *DenomTax) Reset()         { *m = DenomTax{} }
+func (m *DenomTax) String() string { return proto.CompactTextString(m) }
+func (*DenomTax) ProtoMessage()    {}
+func (*DenomTax) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{1}
+}
+func (m *DenomTax) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTax) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTax.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTax) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTax.Merge(m, src)
+}
+func (m *DenomTax) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTax) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTax.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTax proto.InternalMessageInfo
+
+func (m *DenomTax) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type Censorship struct {
 	MsgTypeUrl string              `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	Authority  CensorshipAuthority `protobuf:"varint,2,opt,name=authority,proto3,enum=lbm.foundation.v1.CensorshipAuthority" json:"authority,omitempty"`
+	// expiration is the time at which the censorship is lifted automatically.
+	// the censorship lasts until explicitly updated if it's not set.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *Censorship) Reset()         { *m = Censorship{} }
 func (m *Censorship) String() string { return proto.CompactTextString(m) }
 func (*Censorship) ProtoMessage()    {}
 func (*Censorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{1}
+	return fileDescriptor_1980496a233f02f4, []int{2}
 }
 func (m *Censorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +345,13 @@ func (m *Censorship) GetAuthority() CensorshipAuthority {
 	return CensorshipAuthorityUnspecified
 }
 
+func (m *Censorship) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // Member represents a foundation member with an account address and metadata.
 type Member struct {
 	// address is the member's account address.
@@ -285,13 +360,15 @@ type Member struct {
 	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// weight is the member's voting weight that should be greater than 0.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{2}
+	return fileDescriptor_1980496a233f02f4, []int{3}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,13 +428,16 @@ type MemberRequest struct {
 	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
 	// metadata is any arbitrary metadata attached to the member.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight is the member's voting weight that should be greater than 0.
+	// It defaults to 1 if empty.
+	Weight *github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight,omitempty"`
 }
 
 func (m *MemberRequest) Reset()         { *m = MemberRequest{} }
 func (m *MemberRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberRequest) ProtoMessage()    {}
 func (*MemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{3}
+	return fileDescriptor_1980496a233f02f4, []int{4}
 }
 func (m *MemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,10 +489,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum sum of yes votes that must be met or exceeded for a proposal to succeed.
 	Threshold github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"threshold"`
@@ -424,7 +504,7 @@ func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy
 func (m *ThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*ThresholdDecisionPolicy) ProtoMessage()    {}
 func (*ThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{4}
+	return fileDescriptor_1980496a233f02f4, []int{5}
 }
 func (m *ThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,10 +542,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the sum of yes votes must meet for a proposal to succeed.
 	Percentage github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=percentage,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"percentage"`
@@ -477,7 +557,7 @@ func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPoli
 func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*PercentageDecisionPolicy) ProtoMessage()    {}
 func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{5}
+	return fileDescriptor_1980496a233f02f4, []int{6}
 }
 func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,13 +610,23 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// execution_delay is the duration after the acceptance of a proposal during
+	// which the proposal cannot be executed. Within this times the proposal can
+	// be vetoed with MsgVetoProposal, and once it has passed, the proposal is
+	// executed automatically on EndBlock.
+	// If not set, execution_delay will default to 0, which means no delay.
+	//
+	// Please make sure to set an `execution_delay` that is smaller than
+	// `max_execution_period`, or else the proposals would be pruned before the
+	// delay passes.
+	ExecutionDelay time.Duration `protobuf:"bytes,3,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{6}
+	return fileDescriptor_1980496a233f02f4, []int{7}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,6 +669,13 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetExecutionDelay() time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
 // OutsourcingDecisionPolicy is a dummy decision policy which is set after
 // the proposal feature has been outsourced to x/group.
 type OutsourcingDecisionPolicy struct {
@@ -589,7 +686,7 @@ func (m *OutsourcingDecisionPolicy) Reset()         { *m = OutsourcingDecisionPo
 func (m *OutsourcingDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*OutsourcingDecisionPolicy) ProtoMessage()    {}
 func (*OutsourcingDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{7}
+	return fileDescriptor_1980496a233f02f4, []int{8}
 }
 func (m *OutsourcingDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +729,7 @@ type FoundationInfo struct {
 	// this version is incremented and will cause proposals based on older versions
 	// of the foundation to fail
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// total_weight is the number of the foundation members.
+	// total_weight is the sum of the weights of the foundation members.
 	TotalWeight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"total_weight"`
 	// decision_policy specifies the foundation's decision policy.
 	DecisionPolicy *types.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
@@ -642,7 +739,7 @@ func (m *FoundationInfo) Reset()         { *m = FoundationInfo{} }
 func (m *FoundationInfo) String() string { return proto.CompactTextString(m) }
 func (*FoundationInfo) ProtoMessage()    {}
 func (*FoundationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{8}
+	return fileDescriptor_1980496a233f02f4, []int{9}
 }
 func (m *FoundationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -671,6 +768,49 @@ func (m *FoundationInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FoundationInfo proto.InternalMessageInfo
 
+// MsgTypeDecisionPolicy defines a decision policy which applies to the
+// proposals including the messages of a specific type, instead of the
+// foundation's decision policy.
+type MsgTypeDecisionPolicy struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// decision_policy specifies the decision policy of the message type.
+	DecisionPolicy *types.Any `protobuf:"bytes,2,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *MsgTypeDecisionPolicy) Reset()         { *m = MsgTypeDecisionPolicy{} }
+func (m *MsgTypeDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeDecisionPolicy) ProtoMessage()    {}
+func (*MsgTypeDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{10}
+}
+func (m *MsgTypeDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeDecisionPolicy.Merge(m, src)
+}
+func (m *MsgTypeDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeDecisionPolicy proto.InternalMessageInfo
+
 // Proposal defines a foundation proposal. Any member of the foundation can submit a proposal
 // for a group policy to decide upon.
 // A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
@@ -704,13 +844,17 @@ type Proposal struct {
 	ExecutorResult ProposalExecutorResult `protobuf:"varint,9,opt,name=executor_result,json=executorResult,proto3,enum=lbm.foundation.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
 	// messages is a list of Msgs that will be executed if the proposal passes.
 	Messages []*types.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// timelock_end is the timestamp before which the accepted proposal cannot
+	// be executed. It is set on the acceptance of the proposal if its decision
+	// policies have an execution delay.
+	TimelockEnd *time.Time `protobuf:"bytes,11,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{9}
+	return fileDescriptor_1980496a233f02f4, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +899,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{10}
+	return fileDescriptor_1980496a233f02f4, []int{12}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +946,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{11}
+	return fileDescriptor_1980496a233f02f4, []int{13}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1019,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{12}
+	return fileDescriptor_1980496a233f02f4, []int{14}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,6 +1055,109 @@ func (m *Pool) GetTreasury() github_com_Finschia_finschia_sdk_types.DecCoins {
 	return nil
 }
 
+// PaymentStream defines a stream of payments from the treasury to a
+// recipient, which releases coins linearly or in installments between the
+// start time and the end time.
+type PaymentStream struct {
+	// id is the unique id of the payment stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address which receives the payments.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount of coins to be paid by the stream.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	// paid is the amount of coins which have been paid so far.
+	Paid github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,4,rep,name=paid,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"paid"`
+	// start_time is the time at which the stream starts.
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which the whole amount has been released.
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// period is the interval of the installments. If zero, the coins are
+	// released linearly.
+	Period time.Duration `protobuf:"bytes,7,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *PaymentStream) Reset()         { *m = PaymentStream{} }
+func (m *PaymentStream) String() string { return proto.CompactTextString(m) }
+func (*PaymentStream) ProtoMessage()    {}
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{15}
+}
+func (m *PaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStream.Merge(m, src)
+}
+func (m *PaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentStream proto.InternalMessageInfo
+
+func (m *PaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PaymentStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetPaid() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PaymentStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *PaymentStream) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 // FoundationExecProposal is x/gov proposal to trigger the x/foundation messages on behalf of x/gov.
 type FoundationExecProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -924,7 +1171,7 @@ func (m *FoundationExecProposal) Reset()         { *m = FoundationExecProposal{}
 func (m *FoundationExecProposal) String() string { return proto.CompactTextString(m) }
 func (*FoundationExecProposal) ProtoMessage()    {}
 func (*FoundationExecProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{13}
+	return fileDescriptor_1980496a233f02f4, []int{16}
 }
 func (m *FoundationExecProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,6 +1227,7 @@ func init() {
 	proto.RegisterEnum("lbm.foundation.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("lbm.foundation.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
 	proto.RegisterType((*Params)(nil), "lbm.foundation.v1.Params")
+	proto.RegisterType((*DenomTax)(nil), "lbm.foundation.v1.DenomTax")
 	proto.RegisterType((*Censorship)(nil), "lbm.foundation.v1.Censorship")
 	proto.RegisterType((*Member)(nil), "lbm.foundation.v1.Member")
 	proto.RegisterType((*MemberRequest)(nil), "lbm.foundation.v1.MemberRequest")
@@ -988,10 +1236,12 @@ func init() {
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "lbm.foundation.v1.DecisionPolicyWindows")
 	proto.RegisterType((*OutsourcingDecisionPolicy)(nil), "lbm.foundation.v1.OutsourcingDecisionPolicy")
 	proto.RegisterType((*FoundationInfo)(nil), "lbm.foundation.v1.FoundationInfo")
+	proto.RegisterType((*MsgTypeDecisionPolicy)(nil), "lbm.foundation.v1.MsgTypeDecisionPolicy")
 	proto.RegisterType((*Proposal)(nil), "lbm.foundation.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "lbm.foundation.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "lbm.foundation.v1.Vote")
 	proto.RegisterType((*Pool)(nil), "lbm.foundation.v1.Pool")
+	proto.RegisterType((*PaymentStream)(nil), "lbm.foundation.v1.PaymentStream")
 	proto.RegisterType((*FoundationExecProposal)(nil), "lbm.foundation.v1.FoundationExecProposal")
 }
 
@@ -1000,102 +1250,121 @@ func init() {
 }
 
 var fileDescriptor_1980496a233f02f4 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x4e, 0x62, 0x3f, 0x27, 0x8e, 0x3b, 0xcd, 0xbf, 0x75, 0xdc, 0xd6, 0x76, 0xad,
-	0xea, 0xaf, 0x50, 0x29, 0x36, 0x09, 0x42, 0x88, 0x5e, 0x90, 0x3f, 0x36, 0x8d, 0x4b, 0xeb, 0x75,
-	0xc7, 0xeb, 0x84, 0x22, 0xa1, 0xd5, 0xda, 0x3b, 0xb1, 0x57, 0xd8, 0x3b, 0xee, 0xce, 0xd8, 0x89,
-	0x4f, 0x48, 0x9c, 0xaa, 0x5e, 0xe8, 0x91, 0x4b, 0x25, 0x24, 0x2e, 0x88, 0x33, 0x12, 0x88, 0x2b,
-	0x12, 0xaa, 0x38, 0xa0, 0x8a, 0x0b, 0xa8, 0x87, 0x16, 0xa5, 0x67, 0x8e, 0xdc, 0xd1, 0x7e, 0xf9,
-	0x2b, 0x4e, 0x68, 0x53, 0x71, 0xf3, 0x9b, 0xf7, 0xde, 0x6f, 0x7e, 0xef, 0x73, 0xd6, 0x90, 0x6e,
-	0xd7, 0x3b, 0xd9, 0x7d, 0xda, 0x33, 0x34, 0x95, 0xeb, 0xd4, 0xc8, 0xf6, 0x37, 0xc7, 0xa4, 0x4c,
-	0xd7, 0xa4, 0x9c, 0xa2, 0x73, 0xed, 0x7a, 0x27, 0x33, 0x76, 0xda, 0xdf, 0x8c, 0xaf, 0x36, 0x69,
-	0x93, 0xda, 0xda, 0xac, 0xf5, 0xcb, 0x31, 0x8c, 0x27, 0x9a, 0x94, 0x36, 0xdb, 0x24, 0x6b, 0x4b,
-	0xf5, 0xde, 0x7e, 0x56, 0xeb, 0x99, 0x63, 0x40, 0xf1, 0xe4, 0xb4, 0x9e, 0xeb, 0x1d, 0xc2, 0xb8,
-	0xda, 0xe9, 0xba, 0x06, 0x6b, 0xd3, 0x06, 0xaa, 0x31, 0xf0, 0xb0, 0x1b, 0x94, 0x75, 0x28, 0xcb,
-	0xd6, 0x55, 0x46, 0xb2, 0xfd, 0xcd, 0x3a, 0xe1, 0xea, 0x66, 0xb6, 0x41, 0x75, 0x0f, 0x7b, 0xcd,
-	0xd1, 0x2b, 0x0e, 0x29, 0x47, 0x70, 0x54, 0x69, 0x1d, 0x16, 0x2a, 0xaa, 0xa9, 0x76, 0x18, 0xba,
-	0x07, 0x91, 0x51, 0x1c, 0x0a, 0x57, 0x0f, 0x63, 0x42, 0x4a, 0x58, 0x0f, 0xe5, 0xb7, 0x9e, 0x3c,
-	0x4f, 0xce, 0x3d, 0x7b, 0x9e, 0xbc, 0xde, 0xd4, 0x79, 0xab, 0x57, 0xcf, 0x34, 0x68, 0x27, 0xbb,
-	0xad, 0x1b, 0xac, 0xd1, 0xd2, 0xd5, 0xec, 0xbe, 0xfb, 0x63, 0x83, 0x69, 0x9f, 0x66, 0xf9, 0xa0,
-	0x4b, 0x58, 0xa6, 0x48, 0x1a, 0x78, 0x79, 0x84, 0x24, 0xab, 0x87, 0xb7, 0x02, 0x41, 0x5f, 0xd4,
-	0x9f, 0xe6, 0x00, 0x05, 0x62, 0x30, 0x6a, 0xb2, 0x96, 0xde, 0x45, 0x29, 0x58, 0xea, 0xb0, 0xa6,
-	0x62, 0xf9, 0x28, 0x3d, 0xb3, 0xed, 0x5c, 0x86, 0xa1, 0xc3, 0x9a, 0xf2, 0xa0, 0x4b, 0x6a, 0x66,
-	0x1b, 0x15, 0x21, 0xa4, 0xf6, 0x78, 0x8b, 0x9a, 0x3a, 0x1f, 0xc4, 0x7c, 0x29, 0x61, 0x3d, 0xb2,
-	0xf5, 0xff, 0xcc, 0xb1, 0x74, 0x67, 0x46, 0x98, 0x39, 0xcf, 0x1a, 0x8f, 0x1c, 0xd3, 0x9f, 0xc1,
-	0xc2, 0x1d, 0xd2, 0xa9, 0x13, 0x13, 0xc5, 0x60, 0x51, 0xd5, 0x34, 0x93, 0x30, 0xe6, 0x5e, 0xe6,
-	0x89, 0x28, 0x0e, 0xc1, 0x0e, 0xe1, 0xaa, 0xa6, 0x72, 0xd5, 0xbe, 0x28, 0x84, 0x87, 0x32, 0xfa,
-	0x00, 0x82, 0xaa, 0xa6, 0x11, 0x4d, 0x51, 0x79, 0x2c, 0x90, 0x12, 0xd6, 0xc3, 0x5b, 0xf1, 0x8c,
-	0x53, 0x89, 0x8c, 0x57, 0x89, 0x8c, 0xec, 0x95, 0x2a, 0x1f, 0xb4, 0x92, 0xf5, 0xe8, 0x45, 0x52,
-	0xb0, 0xc1, 0x89, 0x96, 0xe3, 0xe9, 0x4f, 0x60, 0xd9, 0x21, 0x80, 0xc9, 0xfd, 0x1e, 0x61, 0xfc,
-	0x14, 0x1e, 0x17, 0x60, 0xc1, 0x24, 0x1d, 0xda, 0x27, 0x36, 0x8b, 0x20, 0x76, 0xa5, 0x09, 0x7e,
-	0xfe, 0x49, 0x7e, 0xe9, 0x9f, 0x04, 0xb8, 0x28, 0xb7, 0x4c, 0xc2, 0x5a, 0xb4, 0xad, 0x15, 0x49,
-	0x43, 0x67, 0x3a, 0x35, 0x2a, 0xb4, 0xad, 0x37, 0x06, 0xa8, 0x02, 0x21, 0xee, 0xa9, 0xde, 0xa0,
-	0x9a, 0x23, 0x10, 0x94, 0x87, 0xc5, 0x03, 0xdd, 0xd0, 0xe8, 0x01, 0xb3, 0x29, 0x86, 0xb7, 0xd6,
-	0x67, 0x54, 0x64, 0x92, 0xc5, 0x9e, 0x63, 0x8f, 0x3d, 0xc7, 0x1b, 0xe8, 0xb7, 0xef, 0x36, 0x22,
-	0x93, 0x36, 0xe9, 0x9f, 0x05, 0x88, 0x55, 0x88, 0xd9, 0x20, 0x06, 0x57, 0x9b, 0x64, 0x2a, 0x0c,
-	0x0c, 0xd0, 0x1d, 0xea, 0xde, 0x20, 0x8e, 0x31, 0x94, 0xff, 0x2c, 0x90, 0x1f, 0x04, 0xf8, 0xdf,
-	0x4c, 0x37, 0xb4, 0x03, 0xcb, 0x7d, 0xca, 0x75, 0xa3, 0xa9, 0x74, 0x89, 0xa9, 0x53, 0xa7, 0x20,
-	0xe1, 0xad, 0xb5, 0x63, 0xdd, 0x54, 0x74, 0x17, 0x83, 0xd3, 0x4c, 0x5f, 0x5a, 0xcd, 0xb4, 0xe4,
-	0x78, 0x56, 0x6c, 0x47, 0x54, 0x83, 0xd5, 0x8e, 0x6e, 0x28, 0xe4, 0x90, 0x34, 0x7a, 0xf6, 0xb0,
-	0xba, 0x80, 0xbe, 0x57, 0x07, 0x44, 0x1d, 0xdd, 0x10, 0x3d, 0x7f, 0x07, 0x36, 0x7d, 0x17, 0xd6,
-	0xa4, 0x1e, 0x67, 0xb4, 0x67, 0x36, 0x74, 0xa3, 0x39, 0x55, 0x83, 0x14, 0x84, 0x35, 0xc2, 0x1a,
-	0xa6, 0xde, 0xb5, 0x3c, 0xdc, 0xc6, 0x1d, 0x3f, 0x9a, 0x99, 0x8d, 0x67, 0x02, 0x44, 0xb6, 0x87,
-	0x29, 0x2d, 0x19, 0xfb, 0xd4, 0xea, 0xfe, 0x3e, 0x31, 0x99, 0x07, 0x12, 0xc0, 0x9e, 0x88, 0x6a,
-	0xb0, 0xc4, 0x29, 0x57, 0xdb, 0xca, 0x01, 0xd1, 0x9b, 0x2d, 0xee, 0x4c, 0xe2, 0x99, 0x0a, 0x1d,
-	0xb6, 0x71, 0xf6, 0x6c, 0x18, 0x74, 0x17, 0x56, 0x34, 0x97, 0x95, 0xd2, 0xb5, 0x69, 0xd9, 0x33,
-	0x14, 0xde, 0x5a, 0x3d, 0x96, 0xa8, 0x9c, 0x31, 0xc8, 0xa3, 0x5f, 0x8e, 0x85, 0x81, 0x23, 0xda,
-	0x84, 0x7c, 0x23, 0xf0, 0xe0, 0xab, 0xe4, 0x5c, 0xfa, 0xfb, 0x00, 0x04, 0x2b, 0x26, 0xed, 0x52,
-	0xa6, 0xb6, 0x51, 0x04, 0x7c, 0xba, 0xe6, 0x46, 0xe4, 0xd3, 0xb5, 0x53, 0x57, 0xca, 0x65, 0x08,
-	0x75, 0x6d, 0x3f, 0x62, 0xb2, 0x98, 0x3f, 0xe5, 0x5f, 0x0f, 0xe1, 0xd1, 0x01, 0x12, 0x21, 0xcc,
-	0x7a, 0xf5, 0x8e, 0xce, 0x15, 0xeb, 0x05, 0x78, 0xad, 0x9d, 0x03, 0x8e, 0xa3, 0xa5, 0x42, 0x1b,
-	0x80, 0xc6, 0xd6, 0xb9, 0x97, 0xf2, 0x79, 0x9b, 0xe0, 0xb9, 0x91, 0x66, 0xd7, 0x4d, 0xfe, 0xfb,
-	0xb0, 0xc0, 0xb8, 0xca, 0x7b, 0x2c, 0xb6, 0x60, 0x6f, 0xda, 0xab, 0x33, 0xc6, 0xc1, 0x0b, 0xb6,
-	0x6a, 0x1b, 0x62, 0xd7, 0x01, 0x61, 0x40, 0xfb, 0xba, 0xa1, 0xb6, 0x15, 0xae, 0xb6, 0xdb, 0x03,
-	0xc5, 0x24, 0xac, 0xd7, 0xe6, 0xb1, 0x45, 0x9b, 0x77, 0x62, 0x06, 0x8c, 0x6c, 0x99, 0x61, 0xdb,
-	0x2a, 0x1f, 0xb0, 0xb8, 0xe3, 0xa8, 0xed, 0x3f, 0x76, 0x8e, 0x2a, 0x70, 0x6e, 0x62, 0x58, 0x14,
-	0x62, 0x68, 0xb1, 0xe0, 0x6b, 0xa4, 0x62, 0x65, 0x7c, 0x62, 0x44, 0x43, 0x43, 0x18, 0x56, 0x9c,
-	0x81, 0xa1, 0xa6, 0x47, 0x31, 0x64, 0x47, 0xfa, 0xd6, 0x29, 0x91, 0x8a, 0xae, 0x87, 0xc3, 0x0a,
-	0x47, 0xc8, 0x84, 0x8c, 0xde, 0xb6, 0x8a, 0xcc, 0x98, 0xda, 0x24, 0x2c, 0x06, 0x29, 0xff, 0x49,
-	0x3d, 0x85, 0x87, 0x56, 0x6e, 0xe7, 0xfc, 0xe5, 0x83, 0xf0, 0x78, 0xb4, 0x12, 0x84, 0x06, 0x84,
-	0x29, 0x0d, 0xda, 0x33, 0xf8, 0x1b, 0xec, 0xb7, 0xe0, 0x80, 0xb0, 0x82, 0x85, 0x81, 0xf6, 0x60,
-	0x59, 0xad, 0x33, 0xae, 0xea, 0x86, 0x0b, 0x7a, 0xf6, 0x59, 0x5a, 0x72, 0x81, 0x1c, 0xe0, 0x3b,
-	0x10, 0x34, 0xa8, 0x8b, 0xe9, 0x3f, 0x33, 0xe6, 0xa2, 0x41, 0x1d, 0x38, 0x05, 0x90, 0x41, 0x95,
-	0x03, 0x9d, 0xb7, 0x94, 0x3e, 0xe1, 0x1e, 0x70, 0xe0, 0xcc, 0xc0, 0x2b, 0x06, 0xdd, 0xd3, 0x79,
-	0x6b, 0x97, 0x70, 0xe7, 0x02, 0x37, 0xdf, 0xbf, 0x0b, 0x10, 0xd8, 0xa5, 0x9c, 0xa0, 0x24, 0x84,
-	0xbb, 0x6e, 0x69, 0x95, 0xe1, 0xb8, 0x82, 0x77, 0x54, 0xd2, 0xd0, 0x2a, 0xcc, 0xf7, 0x29, 0x27,
-	0xa6, 0x3b, 0xb3, 0x8e, 0x80, 0xde, 0x85, 0x05, 0xea, 0xec, 0x3d, 0xbf, 0xdd, 0x32, 0x57, 0x66,
-	0xb4, 0x8c, 0x85, 0x2f, 0xd9, 0x46, 0xd8, 0x35, 0x9e, 0xd8, 0x01, 0x81, 0xa9, 0x1d, 0x30, 0x35,
-	0xe5, 0xf3, 0x67, 0x9b, 0xf2, 0xf4, 0x00, 0x02, 0x15, 0x4a, 0xdb, 0xe8, 0x3e, 0x04, 0xb9, 0x49,
-	0x54, 0xd6, 0x33, 0x07, 0x31, 0xc1, 0xee, 0xc4, 0xcb, 0x19, 0xf7, 0x3b, 0xcf, 0xfa, 0x28, 0xcc,
-	0xb8, 0x1f, 0x85, 0x56, 0x92, 0x0a, 0x54, 0x37, 0xf2, 0xef, 0x59, 0x68, 0xdf, 0xbe, 0x48, 0x66,
-	0x5f, 0x3d, 0xb9, 0x96, 0x1f, 0xc3, 0xc3, 0x6b, 0xd2, 0x9f, 0x0b, 0x70, 0x61, 0xb4, 0xdb, 0xad,
-	0x49, 0x19, 0x2e, 0xc3, 0x55, 0x98, 0xe7, 0x3a, 0x6f, 0xbb, 0x6f, 0x35, 0x76, 0x84, 0xe9, 0x27,
-	0xc4, 0x77, 0xec, 0x09, 0x99, 0x98, 0x27, 0xff, 0xab, 0xcc, 0xd3, 0xf5, 0xbf, 0x05, 0x38, 0x3f,
-	0xe3, 0x03, 0x10, 0xed, 0x40, 0xaa, 0x20, 0x96, 0xab, 0x12, 0xae, 0xee, 0x94, 0x2a, 0x4a, 0xae,
-	0x26, 0xef, 0x48, 0xb8, 0x24, 0xdf, 0x53, 0x6a, 0xe5, 0x6a, 0x45, 0x2c, 0x94, 0xb6, 0x4b, 0x62,
-	0x31, 0x3a, 0x17, 0x4f, 0x3f, 0x7c, 0x9c, 0x4a, 0xcc, 0x70, 0xaf, 0x19, 0xac, 0x4b, 0x1a, 0xfa,
-	0xbe, 0x4e, 0x34, 0xb4, 0x0d, 0xc9, 0x99, 0x48, 0x37, 0xa5, 0x5d, 0x11, 0x97, 0x73, 0xe5, 0x82,
-	0x18, 0x15, 0xe2, 0x57, 0x1f, 0x3e, 0x4e, 0x5d, 0x99, 0x01, 0x74, 0x93, 0xf6, 0x89, 0x69, 0xa8,
-	0x46, 0x83, 0x9c, 0x88, 0xb3, 0x2d, 0xd5, 0xca, 0xc5, 0x9c, 0x5c, 0x92, 0xca, 0x51, 0xdf, 0x89,
-	0x38, 0xa3, 0x3c, 0xc7, 0x03, 0x0f, 0xbe, 0x4e, 0xcc, 0x5d, 0xff, 0x42, 0x00, 0x18, 0x75, 0x1c,
-	0xba, 0x04, 0x17, 0x77, 0x25, 0x59, 0x54, 0xa4, 0x8a, 0x05, 0x34, 0x19, 0x25, 0x3a, 0x0f, 0x2b,
-	0xe3, 0xca, 0x7b, 0x62, 0x35, 0x2a, 0xa0, 0x8b, 0x70, 0x7e, 0xfc, 0x30, 0x97, 0xaf, 0xca, 0xb9,
-	0x52, 0x39, 0xea, 0x43, 0x08, 0x22, 0xe3, 0x8a, 0xb2, 0x14, 0xf5, 0xa3, 0xcb, 0x10, 0x9b, 0x3c,
-	0x53, 0xf6, 0x4a, 0xf2, 0x8e, 0xb2, 0x2b, 0xca, 0x52, 0x34, 0xe0, 0x32, 0xfa, 0x55, 0x80, 0xc8,
-	0xe4, 0x03, 0x81, 0x92, 0x70, 0xa9, 0x82, 0xa5, 0x8a, 0x54, 0xcd, 0xdd, 0x56, 0xaa, 0x72, 0x4e,
-	0xae, 0x55, 0xa7, 0x98, 0x5d, 0x81, 0xb5, 0x69, 0x83, 0x6a, 0x2d, 0x7f, 0xa7, 0x24, 0xcb, 0x62,
-	0x31, 0x2a, 0x58, 0xd7, 0x4e, 0xab, 0x73, 0x85, 0x82, 0x58, 0xb1, 0xb4, 0xbe, 0x59, 0x5a, 0x2c,
-	0xde, 0x12, 0x0b, 0x96, 0xd6, 0x6f, 0x65, 0xe4, 0x98, 0x6f, 0x5e, 0xc2, 0x96, 0x32, 0x30, 0xeb,
-	0x5e, 0x2b, 0xa0, 0x22, 0xce, 0xed, 0x95, 0xa3, 0xf3, 0x6e, 0x40, 0x3f, 0x0a, 0x70, 0x61, 0xf6,
-	0x3b, 0x80, 0xd6, 0xe1, 0xda, 0xd0, 0x5f, 0xfc, 0x48, 0x2c, 0xd4, 0x64, 0x09, 0x2b, 0x58, 0xac,
-	0xd6, 0x6e, 0xcb, 0x53, 0x11, 0x5e, 0x83, 0xd4, 0x89, 0x96, 0x65, 0x49, 0x56, 0x70, 0xad, 0x1c,
-	0x15, 0x4e, 0xb5, 0xaa, 0xd6, 0x0a, 0x05, 0xb1, 0x5a, 0x8d, 0xfa, 0x4e, 0xb5, 0xda, 0xce, 0x95,
-	0x6e, 0xd7, 0xb0, 0x18, 0xf5, 0x3b, 0xe4, 0xf3, 0x1f, 0x7e, 0x73, 0x94, 0x10, 0x9e, 0x1c, 0x25,
-	0x84, 0xa7, 0x47, 0x09, 0xe1, 0xcf, 0xa3, 0x84, 0xf0, 0xe8, 0x65, 0x62, 0xee, 0xe9, 0xcb, 0xc4,
-	0xdc, 0x1f, 0x2f, 0x13, 0x73, 0x1f, 0x6f, 0xfc, 0xeb, 0xd4, 0x1f, 0x8e, 0xfd, 0xd3, 0xad, 0x2f,
-	0xd8, 0xc3, 0xf7, 0xce, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xef, 0x06, 0x11, 0x10, 0x0f,
-	0x00, 0x00,
+	// 1814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x14, 0x45, 0x3d, 0x4a, 0x14, 0x3d, 0x56, 0x6c, 0x4a, 0xb6, 0x49, 0x86, 0x08,
+	0x0a, 0xd5, 0x88, 0xc9, 0x5a, 0x6d, 0x51, 0x34, 0x3d, 0xa4, 0xfc, 0x59, 0x45, 0x74, 0x6d, 0x92,
+	0x59, 0x2e, 0xa5, 0x3a, 0x97, 0xc5, 0x92, 0x3b, 0x22, 0x07, 0xd9, 0xdd, 0xd9, 0xec, 0x0c, 0x69,
+	0xf1, 0xda, 0x43, 0x10, 0xe4, 0xd2, 0xa0, 0xa7, 0x5e, 0x02, 0x14, 0xe8, 0xa5, 0xe8, 0xb9, 0x28,
+	0xd0, 0x5e, 0x0b, 0x14, 0x41, 0x0b, 0x14, 0x41, 0x2f, 0x2d, 0x72, 0x70, 0x0a, 0xfb, 0x16, 0xa0,
+	0xc7, 0xde, 0x7a, 0x28, 0x76, 0x77, 0x96, 0x7f, 0xa2, 0x15, 0x99, 0x6e, 0x6e, 0x7c, 0xf3, 0xde,
+	0xfb, 0xe6, 0x7b, 0x6f, 0xde, 0xbc, 0x79, 0x4b, 0x28, 0x98, 0x5d, 0xab, 0x74, 0x46, 0x87, 0xb6,
+	0xa1, 0x73, 0x42, 0xed, 0xd2, 0xe8, 0xfe, 0x8c, 0x54, 0x74, 0x5c, 0xca, 0x29, 0xba, 0x66, 0x76,
+	0xad, 0xe2, 0xcc, 0xea, 0xe8, 0xfe, 0xfe, 0x6e, 0x9f, 0xf6, 0xa9, 0xaf, 0x2d, 0x79, 0xbf, 0x02,
+	0xc3, 0xfd, 0x6c, 0x9f, 0xd2, 0xbe, 0x89, 0x4b, 0xbe, 0xd4, 0x1d, 0x9e, 0x95, 0x8c, 0xa1, 0x3b,
+	0x03, 0xb4, 0x9f, 0x5b, 0xd4, 0x73, 0x62, 0x61, 0xc6, 0x75, 0xcb, 0x11, 0x06, 0x7b, 0x8b, 0x06,
+	0xba, 0x3d, 0x0e, 0xb1, 0x7b, 0x94, 0x59, 0x94, 0x95, 0xba, 0x3a, 0xc3, 0xa5, 0xd1, 0xfd, 0x2e,
+	0xe6, 0xfa, 0xfd, 0x52, 0x8f, 0x92, 0x10, 0x7b, 0x2f, 0xd0, 0x6b, 0x01, 0xa9, 0x40, 0x08, 0x54,
+	0x85, 0x0f, 0x23, 0x10, 0x6f, 0xe9, 0xae, 0x6e, 0x31, 0xf4, 0x18, 0x52, 0xd3, 0x40, 0x34, 0xae,
+	0x9f, 0x67, 0xa4, 0xbc, 0x74, 0xb0, 0x59, 0x39, 0xfc, 0xec, 0x69, 0x6e, 0xed, 0x8b, 0xa7, 0xb9,
+	0xbb, 0x7d, 0xc2, 0x07, 0xc3, 0x6e, 0xb1, 0x47, 0xad, 0xd2, 0x11, 0xb1, 0x59, 0x6f, 0x40, 0xf4,
+	0xd2, 0x99, 0xf8, 0x71, 0x8f, 0x19, 0xef, 0x97, 0xf8, 0xd8, 0xc1, 0xac, 0x58, 0xc3, 0x3d, 0x65,
+	0x7b, 0x8a, 0xa4, 0xea, 0xe7, 0xa8, 0x02, 0x49, 0x03, 0xdb, 0xd4, 0xf2, 0x50, 0x31, 0xcb, 0x44,
+	0xf3, 0xd1, 0x83, 0xe4, 0xe1, 0xad, 0xe2, 0x85, 0xdc, 0x15, 0x6b, 0x9e, 0x95, 0xaa, 0x9f, 0x57,
+	0x62, 0xde, 0xa6, 0x0a, 0x18, 0x42, 0xc6, 0x0c, 0x9d, 0xc2, 0x36, 0xb1, 0xcf, 0xcc, 0x29, 0xbb,
+	0xd8, 0xca, 0xec, 0xb6, 0x26, 0x40, 0xaa, 0x7e, 0xfe, 0x20, 0x96, 0x88, 0xa4, 0xa3, 0x85, 0x33,
+	0x48, 0x84, 0x9b, 0xa3, 0x5d, 0x58, 0xf7, 0x37, 0x0e, 0x12, 0xa0, 0x04, 0x02, 0xaa, 0x41, 0xd4,
+	0xdb, 0x36, 0xb2, 0xf2, 0xb6, 0x9e, 0x7b, 0xe1, 0xf7, 0x12, 0x40, 0x15, 0xdb, 0x8c, 0xba, 0x6c,
+	0x40, 0x1c, 0x94, 0x87, 0x2d, 0x8b, 0xf5, 0x35, 0xcf, 0x48, 0x1b, 0xba, 0xa6, 0xd8, 0x11, 0x2c,
+	0xd6, 0x57, 0xc7, 0x0e, 0xee, 0xb8, 0x26, 0xaa, 0xc1, 0xa6, 0x3e, 0xe4, 0x03, 0xea, 0x12, 0x3e,
+	0xf6, 0x37, 0x4f, 0x1d, 0x7e, 0x6b, 0x49, 0xe6, 0xa6, 0x98, 0xe5, 0xd0, 0x5a, 0x99, 0x3a, 0xa2,
+	0x1f, 0x03, 0xe0, 0x73, 0x87, 0x04, 0x25, 0x97, 0x89, 0xe6, 0xa5, 0x83, 0xe4, 0xe1, 0x7e, 0x31,
+	0x28, 0xa9, 0x62, 0x58, 0x52, 0x45, 0x35, 0xac, 0xb9, 0x4a, 0xec, 0x93, 0x2f, 0x73, 0x92, 0x32,
+	0xe3, 0x53, 0xf8, 0xab, 0x04, 0xf1, 0x47, 0xd8, 0xea, 0x62, 0x17, 0x65, 0x60, 0x43, 0x37, 0x0c,
+	0x17, 0x33, 0x26, 0xf8, 0x86, 0x22, 0xda, 0x87, 0x84, 0x85, 0xb9, 0x6e, 0xe8, 0x5c, 0x0f, 0x12,
+	0xa5, 0x4c, 0x64, 0xf4, 0x36, 0x24, 0x74, 0xc3, 0xc0, 0x86, 0xa6, 0x73, 0xff, 0xec, 0x2e, 0x27,
+	0x90, 0xf0, 0x12, 0xec, 0x93, 0xd8, 0xf0, 0xbd, 0xca, 0x1c, 0x3d, 0x80, 0xf8, 0x13, 0x4c, 0xfa,
+	0x03, 0x9e, 0x59, 0x5f, 0xf9, 0x0c, 0x04, 0x42, 0xe1, 0x0f, 0x12, 0x6c, 0x07, 0xd1, 0x28, 0xf8,
+	0x83, 0x21, 0x66, 0xfc, 0x92, 0xa0, 0x6e, 0x40, 0xdc, 0xc5, 0x16, 0x1d, 0x61, 0x3f, 0xa4, 0x84,
+	0x22, 0xa4, 0xb9, 0x60, 0xa3, 0x0b, 0xc1, 0xbe, 0x37, 0xe1, 0x1a, 0x94, 0x69, 0xe5, 0xe5, 0x78,
+	0x7e, 0xf5, 0x34, 0x97, 0x0e, 0xfc, 0xdf, 0xa4, 0x16, 0xe1, 0xd8, 0x72, 0xf8, 0x78, 0xc2, 0xfd,
+	0x4f, 0x12, 0xdc, 0x54, 0x07, 0x2e, 0x66, 0x03, 0x6a, 0x1a, 0x35, 0xdc, 0x23, 0x8c, 0x50, 0xbb,
+	0x45, 0x4d, 0xd2, 0x1b, 0xa3, 0x16, 0x6c, 0xf2, 0x50, 0xf5, 0x0a, 0xf7, 0x77, 0x0a, 0x82, 0x2a,
+	0xb0, 0xf1, 0x84, 0xd8, 0x06, 0x7d, 0xc2, 0xfc, 0xf0, 0x93, 0x87, 0x07, 0x4b, 0xef, 0xed, 0x2c,
+	0x8b, 0xd3, 0xc0, 0x5e, 0x09, 0x1d, 0xdf, 0x42, 0x7f, 0xff, 0xdd, 0xbd, 0xd4, 0xbc, 0x4d, 0xe1,
+	0xcf, 0x12, 0x64, 0x5a, 0xd8, 0xed, 0x61, 0x9b, 0xeb, 0x7d, 0xbc, 0x10, 0x86, 0x02, 0xe0, 0x4c,
+	0x74, 0xaf, 0x10, 0xc7, 0x0c, 0xca, 0x37, 0x16, 0xc8, 0x87, 0x11, 0x78, 0x6d, 0xa9, 0x1b, 0x3a,
+	0x86, 0xed, 0x11, 0xe5, 0xc4, 0xee, 0x6b, 0x0e, 0x76, 0x09, 0x0d, 0x0e, 0x24, 0x79, 0xb8, 0x77,
+	0xa1, 0xec, 0x6b, 0xe2, 0x2d, 0x08, 0xaa, 0xfe, 0x97, 0x5e, 0xd5, 0x6f, 0x05, 0x9e, 0x2d, 0xdf,
+	0x11, 0x75, 0x60, 0xd7, 0x22, 0xb6, 0x86, 0xcf, 0x71, 0x6f, 0xe8, 0x37, 0x40, 0x01, 0x18, 0xb9,
+	0x3a, 0x20, 0xb2, 0x88, 0x2d, 0x87, 0xfe, 0x02, 0xf6, 0x21, 0xec, 0x4c, 0x21, 0x0d, 0x6c, 0xea,
+	0x63, 0xd1, 0x1a, 0xae, 0x84, 0x98, 0x9a, 0xf8, 0xd6, 0x3c, 0xd7, 0xc2, 0xbb, 0xb0, 0xd7, 0x1c,
+	0x72, 0x46, 0x87, 0x6e, 0x8f, 0xd8, 0xfd, 0x85, 0x13, 0xcd, 0x7b, 0x4f, 0x00, 0xeb, 0xb9, 0xc4,
+	0xf1, 0x3b, 0x50, 0x70, 0xc5, 0x66, 0x97, 0x96, 0xe6, 0xf6, 0x0b, 0x09, 0x52, 0x47, 0x93, 0x03,
+	0xaa, 0xdb, 0x67, 0xd4, 0xbb, 0xa7, 0x23, 0xec, 0xb2, 0x10, 0x24, 0xa6, 0x84, 0x22, 0xea, 0xc0,
+	0x16, 0xa7, 0x5c, 0x37, 0x35, 0x71, 0xf3, 0x56, 0xef, 0xd4, 0x49, 0x1f, 0xe7, 0xd4, 0x87, 0x41,
+	0xef, 0xc2, 0x8e, 0x21, 0x58, 0x69, 0x8e, 0x4f, 0x4b, 0x24, 0x69, 0xf7, 0x42, 0x92, 0xca, 0xf6,
+	0xb8, 0x82, 0xfe, 0x72, 0x21, 0x0c, 0x25, 0x65, 0xcc, 0xc9, 0x6f, 0xc5, 0x3e, 0xfa, 0x55, 0x6e,
+	0xad, 0xf0, 0x0b, 0x09, 0x5e, 0x7b, 0x14, 0x34, 0xfa, 0x0b, 0xc9, 0xfa, 0xba, 0x57, 0x61, 0x09,
+	0xa9, 0xc8, 0xff, 0x85, 0xd4, 0x7f, 0x63, 0x90, 0x68, 0xb9, 0xd4, 0xa1, 0x4c, 0x37, 0x51, 0x0a,
+	0x22, 0xc4, 0x10, 0x69, 0x8e, 0x10, 0xe3, 0xd2, 0xf6, 0x7e, 0x1b, 0x36, 0x1d, 0xdf, 0x0f, 0xbb,
+	0xc1, 0x0b, 0xbf, 0xa9, 0x4c, 0x17, 0x90, 0x0c, 0x49, 0x36, 0xec, 0x5a, 0x84, 0x6b, 0xde, 0x5c,
+	0xf3, 0x52, 0xfd, 0x1f, 0x02, 0x47, 0x4f, 0x85, 0xee, 0x01, 0x9a, 0x99, 0x51, 0xc2, 0x3a, 0x58,
+	0xf7, 0x09, 0x5e, 0x9b, 0x6a, 0x4e, 0x44, 0x45, 0xfc, 0x10, 0xe2, 0x8c, 0xeb, 0x7c, 0xc8, 0x32,
+	0x71, 0xff, 0xe1, 0x7c, 0x7d, 0xc9, 0x8d, 0x0f, 0x83, 0x6d, 0xfb, 0x86, 0x8a, 0x70, 0x40, 0x0a,
+	0xa0, 0x33, 0x62, 0xeb, 0xa6, 0xc6, 0x75, 0xd3, 0x1c, 0x6b, 0x2e, 0x66, 0x43, 0x93, 0x67, 0x36,
+	0x7c, 0xde, 0xd9, 0x25, 0x30, 0xaa, 0x67, 0xa6, 0xf8, 0x56, 0x62, 0x78, 0x49, 0xfb, 0xfe, 0x33,
+	0xeb, 0xa8, 0x05, 0xd7, 0xe6, 0xfa, 0x81, 0x86, 0x6d, 0x23, 0x93, 0x78, 0x89, 0x54, 0xec, 0xcc,
+	0x36, 0x05, 0xd9, 0x36, 0x90, 0x12, 0x5e, 0x60, 0xea, 0x86, 0x14, 0x37, 0xfd, 0x48, 0xbf, 0x7d,
+	0x49, 0xa4, 0xb2, 0xf0, 0x08, 0x58, 0x85, 0xd7, 0x38, 0x94, 0xd1, 0x77, 0xbc, 0x43, 0x66, 0x4c,
+	0xef, 0x63, 0x96, 0x01, 0x7f, 0x52, 0x5b, 0x5a, 0x53, 0xca, 0xc4, 0x0a, 0x55, 0x61, 0xcb, 0x3b,
+	0x55, 0x93, 0xf6, 0xde, 0xf7, 0x43, 0x4a, 0x5e, 0x71, 0xbc, 0x48, 0x86, 0x5e, 0xb2, 0x6d, 0x88,
+	0xf2, 0xfb, 0x77, 0x04, 0x92, 0xb3, 0x29, 0x6b, 0xc2, 0xe6, 0x18, 0x33, 0xad, 0x47, 0x87, 0x36,
+	0x7f, 0x85, 0x77, 0x20, 0x31, 0xc6, 0xac, 0xea, 0x61, 0x78, 0x63, 0xa4, 0xde, 0x65, 0x5c, 0x27,
+	0xb6, 0x00, 0x5d, 0xbd, 0x4b, 0x6c, 0x09, 0xa0, 0x00, 0xf8, 0x11, 0x24, 0x6c, 0x2a, 0x30, 0xa3,
+	0x2b, 0x63, 0x6e, 0xd8, 0x34, 0x80, 0xd3, 0x00, 0xd9, 0x54, 0x7b, 0x42, 0xf8, 0x40, 0x1b, 0x61,
+	0x1e, 0x02, 0xaf, 0x3e, 0xf3, 0xee, 0xd8, 0xf4, 0x94, 0xf0, 0xc1, 0x09, 0xe6, 0xc1, 0x06, 0x22,
+	0xdf, 0xff, 0x90, 0x20, 0x76, 0x42, 0x39, 0x46, 0x39, 0x48, 0x3a, 0xa2, 0x3e, 0xb4, 0xc9, 0x9d,
+	0x87, 0x70, 0xa9, 0x6e, 0x78, 0x43, 0xf1, 0x88, 0x72, 0xec, 0x8a, 0x8b, 0x1f, 0x08, 0xe8, 0xfb,
+	0x10, 0xa7, 0xce, 0x64, 0xa6, 0x4c, 0x1d, 0xde, 0x59, 0x52, 0x77, 0x1e, 0x7e, 0xd3, 0x37, 0x52,
+	0x84, 0xf1, 0x5c, 0x23, 0x89, 0x2d, 0x34, 0x92, 0x85, 0x56, 0xb1, 0xbe, 0x5a, 0xab, 0x28, 0x8c,
+	0x21, 0xd6, 0xa2, 0xd4, 0x44, 0x1f, 0x40, 0x82, 0xbb, 0x58, 0x67, 0x43, 0x77, 0x9c, 0x91, 0xfc,
+	0x72, 0xbe, 0x5d, 0x14, 0x9f, 0x40, 0xde, 0xf7, 0x52, 0x51, 0x7c, 0x2f, 0x79, 0x49, 0xaa, 0x52,
+	0x62, 0x57, 0x7e, 0xe0, 0xa1, 0xfd, 0xf6, 0xcb, 0x5c, 0xe9, 0xea, 0xc9, 0xf5, 0xfc, 0x98, 0x32,
+	0xd9, 0xa6, 0xf0, 0xb7, 0x28, 0x6c, 0xb7, 0xf4, 0xb1, 0x85, 0x6d, 0xde, 0xf6, 0x16, 0xad, 0x0b,
+	0x8d, 0xf4, 0x36, 0x6c, 0xba, 0xb8, 0x47, 0x1c, 0x82, 0xc3, 0x0a, 0x54, 0xa6, 0x0b, 0xa8, 0x0f,
+	0x71, 0xdd, 0x12, 0x85, 0x14, 0xf5, 0x5f, 0xe3, 0x65, 0x84, 0x7d, 0xb6, 0xdf, 0x13, 0x6c, 0xdf,
+	0xbc, 0x22, 0xdb, 0x80, 0xaa, 0x80, 0x47, 0x3d, 0x88, 0x39, 0x3a, 0x31, 0x32, 0xb1, 0x6f, 0x66,
+	0x1b, 0x1f, 0x1c, 0x55, 0x01, 0x18, 0xd7, 0xdd, 0x15, 0x8e, 0x73, 0xd3, 0xf7, 0xf3, 0x1b, 0xff,
+	0xdb, 0x90, 0xc0, 0xb6, 0x11, 0x40, 0xc4, 0x5f, 0xe6, 0xe3, 0x01, 0xdb, 0x86, 0x0f, 0xf0, 0x23,
+	0x88, 0x8b, 0x99, 0x69, 0xe3, 0xea, 0x13, 0x8e, 0x70, 0x29, 0xfc, 0x4c, 0x82, 0x1b, 0xd3, 0x31,
+	0xc4, 0xeb, 0x9f, 0x93, 0x27, 0x72, 0x17, 0xd6, 0x39, 0xe1, 0x26, 0x0e, 0xbf, 0x15, 0x7d, 0x61,
+	0x71, 0xda, 0x89, 0x5c, 0x98, 0x76, 0xe6, 0xba, 0x6c, 0xf4, 0x2a, 0x5d, 0xf6, 0xee, 0x7f, 0x24,
+	0xb8, 0xbe, 0xe4, 0x2b, 0x0f, 0x1d, 0x43, 0xbe, 0x2a, 0x37, 0xda, 0x4d, 0xa5, 0x7d, 0x5c, 0x6f,
+	0x69, 0xe5, 0x8e, 0x7a, 0xdc, 0x54, 0xea, 0xea, 0x63, 0xad, 0xd3, 0x68, 0xb7, 0xe4, 0x6a, 0xfd,
+	0xa8, 0x2e, 0xd7, 0xd2, 0x6b, 0xfb, 0x85, 0x8f, 0x3f, 0xcd, 0x67, 0x97, 0xb8, 0x77, 0x6c, 0xe6,
+	0xe0, 0x1e, 0x39, 0x23, 0xd8, 0x40, 0x47, 0x90, 0x5b, 0x8a, 0xf4, 0x4e, 0xf3, 0x44, 0x56, 0x1a,
+	0xe5, 0x46, 0x55, 0x4e, 0x4b, 0xfb, 0xaf, 0x7f, 0xfc, 0x69, 0xfe, 0xce, 0x12, 0xa0, 0x77, 0xe8,
+	0x08, 0xbb, 0xb6, 0x6e, 0xf7, 0xf0, 0x0b, 0x71, 0x8e, 0x9a, 0x9d, 0x46, 0xad, 0xac, 0xd6, 0x9b,
+	0x8d, 0x74, 0xe4, 0x85, 0x38, 0xd3, 0x3c, 0xef, 0xc7, 0x3e, 0xfa, 0x75, 0x76, 0xed, 0xee, 0xcf,
+	0x25, 0x80, 0x69, 0x0b, 0x41, 0xb7, 0xe0, 0xe6, 0x49, 0x53, 0x95, 0xb5, 0x66, 0xcb, 0x03, 0x9a,
+	0x8f, 0x12, 0x5d, 0x87, 0x9d, 0x59, 0xe5, 0x63, 0xb9, 0x9d, 0x96, 0xd0, 0x4d, 0xb8, 0x3e, 0xbb,
+	0x58, 0xae, 0xb4, 0xd5, 0x72, 0xbd, 0x91, 0x8e, 0x20, 0x04, 0xa9, 0x59, 0x45, 0xa3, 0x99, 0x8e,
+	0xa2, 0xdb, 0x90, 0x99, 0x5f, 0xd3, 0x4e, 0xeb, 0xea, 0xb1, 0x76, 0x22, 0xab, 0xcd, 0x74, 0x4c,
+	0x30, 0xfa, 0x4a, 0x82, 0xd4, 0xfc, 0xd8, 0x80, 0x72, 0x70, 0xab, 0xa5, 0x34, 0x5b, 0xcd, 0x76,
+	0xf9, 0xa1, 0xd6, 0x56, 0xcb, 0x6a, 0xa7, 0xbd, 0xc0, 0xec, 0x0e, 0xec, 0x2d, 0x1a, 0xb4, 0x3b,
+	0x95, 0x47, 0x75, 0x55, 0x95, 0x6b, 0x69, 0xc9, 0xdb, 0x76, 0x51, 0x5d, 0xae, 0x56, 0xe5, 0x96,
+	0xa7, 0x8d, 0x2c, 0xd3, 0x2a, 0xf2, 0x03, 0xb9, 0xea, 0x69, 0xa3, 0x5e, 0x46, 0x2e, 0xf8, 0x56,
+	0x9a, 0x8a, 0xa7, 0x8c, 0x2d, 0xdb, 0xd7, 0x0b, 0xa8, 0xa6, 0x94, 0x4f, 0x1b, 0xe9, 0x75, 0xb4,
+	0x0f, 0x37, 0x16, 0xd5, 0x5e, 0xa8, 0x72, 0x2d, 0x1d, 0x17, 0xc1, 0xfe, 0x51, 0x82, 0x1b, 0xcb,
+	0x27, 0x07, 0x74, 0x00, 0x6f, 0x4c, 0x9c, 0xe5, 0x9f, 0xca, 0xd5, 0x8e, 0xda, 0x54, 0x34, 0x45,
+	0x6e, 0x77, 0x1e, 0xaa, 0x0b, 0xd1, 0xbf, 0x01, 0xf9, 0x17, 0x5a, 0x36, 0x9a, 0xaa, 0xa6, 0x74,
+	0x1a, 0x69, 0xe9, 0x52, 0xab, 0x76, 0xa7, 0x5a, 0x95, 0xdb, 0xed, 0x74, 0xe4, 0x52, 0xab, 0xa3,
+	0x72, 0xfd, 0x61, 0x47, 0x91, 0xd3, 0xd1, 0x80, 0x7c, 0xe5, 0x27, 0xbf, 0x79, 0x96, 0x95, 0x3e,
+	0x7b, 0x96, 0x95, 0x3e, 0x7f, 0x96, 0x95, 0xfe, 0xf5, 0x2c, 0x2b, 0x7d, 0xf2, 0x3c, 0xbb, 0xf6,
+	0xf9, 0xf3, 0xec, 0xda, 0x3f, 0x9f, 0x67, 0xd7, 0xde, 0xbb, 0xf7, 0xb5, 0xdd, 0xec, 0x7c, 0xe6,
+	0x1f, 0xbf, 0x6e, 0xdc, 0xbf, 0x98, 0xdf, 0xfd, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x8b,
+	0xee, 0x96, 0x18, 0x14, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1120,6 +1389,44 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.FoundationTax.Equal(that1.FoundationTax) {
 		return false
 	}
+	if len(this.DenomTaxes) != len(that1.DenomTaxes) {
+		return false
+	}
+	for i := range this.DenomTaxes {
+		if !this.DenomTaxes[i].Equal(&that1.DenomTaxes[i]) {
+			return false
+		}
+	}
+	if !this.InflationTax.Equal(that1.InflationTax) {
+		return false
+	}
+	return true
+}
+func (this *DenomTax) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTax)
+	if !ok {
+		that2, ok := that.(DenomTax)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Tax.Equal(that1.Tax) {
+		return false
+	}
 	return true
 }
 func (this *Censorship) Equal(that interface{}) bool {
@@ -1147,6 +1454,13 @@ func (this *Censorship) Equal(that interface{}) bool {
 	if this.Authority != that1.Authority {
 		return false
 	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
 	return true
 }
 func (this *Member) Equal(that interface{}) bool {
@@ -1177,6 +1491,9 @@ func (this *Member) Equal(that interface{}) bool {
 	if !this.AddedAt.Equal(that1.AddedAt) {
 		return false
 	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *MemberRequest) Equal(that interface{}) bool {
@@ -1207,6 +1524,13 @@ func (this *MemberRequest) Equal(that interface{}) bool {
 	if this.Metadata != that1.Metadata {
 		return false
 	}
+	if that1.Weight == nil {
+		if this.Weight != nil {
+			return false
+		}
+	} else if !this.Weight.Equal(*that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *ThresholdDecisionPolicy) Equal(that interface{}) bool {
@@ -1288,6 +1612,9 @@ func (this *DecisionPolicyWindows) Equal(that interface{}) bool {
 	if this.MinExecutionPeriod != that1.MinExecutionPeriod {
 		return false
 	}
+	if this.ExecutionDelay != that1.ExecutionDelay {
+		return false
+	}
 	return true
 }
 func (this *OutsourcingDecisionPolicy) Equal(that interface{}) bool {
@@ -1344,6 +1671,33 @@ func (this *FoundationInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgTypeDecisionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTypeDecisionPolicy)
+	if !ok {
+		that2, ok := that.(MsgTypeDecisionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
+		return false
+	}
+	return true
+}
 func (this *Proposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1403,6 +1757,13 @@ func (this *Proposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.TimelockEnd == nil {
+		if this.TimelockEnd != nil {
+			return false
+		}
+	} else if !this.TimelockEnd.Equal(*that1.TimelockEnd) {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -1503,6 +1864,58 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PaymentStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PaymentStream)
+	if !ok {
+		that2, ok := that.(PaymentStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	return true
+}
 func (this *FoundationExecProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1559,17 +1972,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.FoundationTax.Size()
+		size := m.InflationTax.Size()
 		i -= size
-		if _, err := m.FoundationTax.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InflationTax.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFoundation(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	dAtA[i] = 0x22
+	if len(m.DenomTaxes) > 0 {
+		for iNdEx := len(m.DenomTaxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTaxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.FoundationTax.Size()
+		i -= size
+		if _, err := m.FoundationTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTax) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTax) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTax) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tax.Size()
+		i -= size
+		if _, err := m.Tax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *Censorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1591,6 +2068,16 @@ func (m *Censorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFoundation(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Authority != 0 {
 		i = encodeVarintFoundation(dAtA, i, uint64(m.Authority))
 		i--
@@ -1626,12 +2113,22 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
 	}
-	i -= n1
-	i = encodeVarintFoundation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFoundation(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
@@ -1671,6 +2168,18 @@ func (m *MemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != nil {
+		{
+			size := m.Weight.Size()
+			i -= size
+			if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFoundation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1808,21 +2317,29 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintFoundation(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintFoundation(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFoundation(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFoundation(dAtA, i, uint64(n7))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1907,6 +2424,48 @@ func (m *FoundationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTypeDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFoundation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1927,6 +2486,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimelockEnd != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimelockEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimelockEnd):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintFoundation(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1946,12 +2515,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err7 != nil {
-		return 0, err7
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n7
-	i = encodeVarintFoundation(dAtA, i, uint64(n7))
+	i -= n11
+	i = encodeVarintFoundation(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -1974,12 +2543,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err9 != nil {
-		return 0, err9
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n9
-	i = encodeVarintFoundation(dAtA, i, uint64(n9))
+	i -= n13
+	i = encodeVarintFoundation(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Proposers) > 0 {
@@ -2089,12 +2658,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err10 != nil {
-		return 0, err10
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n10
-	i = encodeVarintFoundation(dAtA, i, uint64(n10))
+	i -= n14
+	i = encodeVarintFoundation(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -2161,6 +2730,93 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintFoundation(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintFoundation(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintFoundation(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FoundationExecProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2231,6 +2887,29 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.FoundationTax.Size()
 	n += 1 + l + sovFoundation(uint64(l))
+	if len(m.DenomTaxes) > 0 {
+		for _, e := range m.DenomTaxes {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	l = m.InflationTax.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
+func (m *DenomTax) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = m.Tax.Size()
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2247,6 +2926,10 @@ func (m *Censorship) Size() (n int) {
 	if m.Authority != 0 {
 		n += 1 + sovFoundation(uint64(m.Authority))
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2266,6 +2949,8 @@ func (m *Member) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt)
 	n += 1 + l + sovFoundation(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2286,6 +2971,10 @@ func (m *MemberRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFoundation(uint64(l))
 	}
+	if m.Weight != nil {
+		l = m.Weight.Size()
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2329,6 +3018,8 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovFoundation(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2363,6 +3054,23 @@ func (m *FoundationInfo) Size() (n int) {
 	return n
 }
 
+func (m *MsgTypeDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	return n
+}
+
 func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2403,6 +3111,10 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovFoundation(uint64(l))
 		}
 	}
+	if m.TimelockEnd != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimelockEnd)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2463,36 +3175,70 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
-func (m *FoundationExecProposal) Size() (n int) {
+func (m *PaymentStream) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovFoundation(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovFoundation(uint64(m.Id))
 	}
-	l = len(m.Description)
+	l = len(m.Recipient)
 	if l > 0 {
 		n += 1 + l + sovFoundation(uint64(l))
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
 			l = e.Size()
 			n += 1 + l + sovFoundation(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
-func sovFoundation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFoundation(x uint64) (n int) {
-	return sovFoundation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FoundationExecProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFoundation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFoundation(x uint64) (n int) {
+	return sovFoundation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2555,6 +3301,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTaxes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTaxes = append(m.DenomTaxes, DenomTax{})
+			if err := m.DenomTaxes[len(m.DenomTaxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -2576,7 +3390,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Censorship) Unmarshal(dAtA []byte) error {
+func (m *DenomTax) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2599,15 +3413,15 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Censorship: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomTax: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Censorship: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomTax: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,13 +3449,13 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tax", wireType)
 			}
-			m.Authority = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -2651,11 +3465,26 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Authority |= CensorshipAuthority(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -2677,7 +3506,7 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *Censorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2700,15 +3529,15 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: Censorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Censorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2736,13 +3565,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var stringLen uint64
+			m.Authority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -2752,27 +3581,14 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Authority |= CensorshipAuthority(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2799,7 +3615,10 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2824,7 +3643,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberRequest) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2847,10 +3666,10 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2886,10 +3705,10 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -2899,15 +3718,60 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2935,7 +3799,9 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2958,7 +3824,7 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,15 +3847,15 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3017,15 +3883,13 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3035,22 +3899,194 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Remove = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			if m.Windows == nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_Finschia_finschia_sdk_types.Dec
+			m.Weight = &v
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
 				m.Windows = &DecisionPolicyWindows{}
 			}
 			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
@@ -3293,6 +4329,39 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -3535,7 +4604,7 @@ func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *MsgTypeDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,34 +4627,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTypeDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTypeDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3613,13 +4663,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3629,33 +4679,170 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types.Any{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -3837,6 +5024,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEnd == nil {
+				m.TimelockEnd = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -4313,6 +5536,274 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types1.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FoundationExecProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,26 +15,30 @@ import (
 func TestTallyResult(t *testing.T) {
 	result := foundation.DefaultTallyResult()
 
-	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED)
+	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED, sdk.OneDec())
 	require.Error(t, err)
 
-	err = result.Add(foundation.VOTE_OPTION_YES)
+	err = result.Add(foundation.VOTE_OPTION_YES, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.YesCount)
 
-	err = result.Add(foundation.VOTE_OPTION_ABSTAIN)
+	err = result.Add(foundation.VOTE_OPTION_ABSTAIN, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.AbstainCount)
 
-	err = result.Add(foundation.VOTE_OPTION_NO)
+	err = result.Add(foundation.VOTE_OPTION_NO, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.NoCount)
 
-	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO)
+	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.NoWithVetoCount)
 
-	require.Equal(t, sdk.NewDec(4), result.TotalCounts())
+	err = result.Add(foundation.VOTE_OPTION_YES, sdk.NewDec(3))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), result.YesCount)
+
+	require.Equal(t, sdk.NewDec(7), result.TotalCounts())
 }
 
 func TestThresholdDecisionPolicy(t *testing.T) {
@@ -44,6 +48,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 		threshold          sdk.Dec
 		votingPeriod       time.Duration
 		minExecutionPeriod time.Duration
+		executionDelay     time.Duration
 		totalWeight        sdk.Dec
 		validBasic         bool
 		valid              bool
@@ -52,6 +57,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			threshold:          sdk.OneDec(),
 			votingPeriod:       time.Hour,
 			minExecutionPeriod: config.MaxExecutionPeriod + time.Hour - time.Nanosecond,
+			executionDelay:     config.MaxExecutionPeriod - time.Nanosecond,
 			totalWeight:        sdk.OneDec(),
 			validBasic:         true,
 			valid:              true,
@@ -73,6 +79,19 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			totalWeight:        sdk.OneDec(),
 			validBasic:         true,
 		},
+		"negative execution delay": {
+			threshold:      sdk.OneDec(),
+			votingPeriod:   time.Hour,
+			executionDelay: -time.Nanosecond,
+			totalWeight:    sdk.OneDec(),
+		},
+		"invalid execution delay": {
+			threshold:      sdk.OneDec(),
+			votingPeriod:   time.Hour,
+			executionDelay: config.MaxExecutionPeriod,
+			totalWeight:    sdk.OneDec(),
+			validBasic:     true,
+		},
 		"invalid total weight": {
 			threshold:          sdk.OneDec(),
 			votingPeriod:       time.Hour,
@@ -89,9 +108,11 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 				Windows: &foundation.DecisionPolicyWindows{
 					VotingPeriod:       tc.votingPeriod,
 					MinExecutionPeriod: tc.minExecutionPeriod,
+					ExecutionDelay:     tc.executionDelay,
 				},
 			}
 			require.Equal(t, tc.votingPeriod, policy.GetVotingPeriod())
+			require.Equal(t, tc.executionDelay, policy.GetExecutionDelay())
 
 			err := policy.ValidateBasic()
 			if !tc.validBasic {
@@ -197,6 +218,7 @@ func TestPercentageDecisionPolicy(t *testing.T) {
 		percentage         sdk.Dec
 		votingPeriod       time.Duration
 		minExecutionPeriod time.Duration
+		executionDelay     time.Duration
 		totalWeight        sdk.Dec
 		validBasic         bool
 		valid              bool
@@ -205,6 +227,7 @@ func TestPercentageDecisionPolicy(t *testing.T) {
 			percentage:         sdk.OneDec(),
 			votingPeriod:       time.Hour,
 			minExecutionPeriod: config.MaxExecutionPeriod + time.Hour - time.Nanosecond,
+			executionDelay:     config.MaxExecutionPeriod - time.Nanosecond,
 			totalWeight:        sdk.OneDec(),
 			validBasic:         true,
 			valid:              true,
@@ -226,6 +249,19 @@ func TestPercentageDecisionPolicy(t *testing.T) {
 			totalWeight:        sdk.OneDec(),
 			validBasic:         true,
 		},
+		"negative execution delay": {
+			percentage:     sdk.OneDec(),
+			votingPeriod:   time.Hour,
+			executionDelay: -time.Nanosecond,
+			totalWeight:    sdk.OneDec(),
+		},
+		"invalid execution delay": {
+			percentage:     sdk.OneDec(),
+			votingPeriod:   time.Hour,
+			executionDelay: config.MaxExecutionPeriod,
+			totalWeight:    sdk.OneDec(),
+			validBasic:     true,
+		},
 		"invalid total weight": {
 			percentage:         sdk.OneDec(),
 			votingPeriod:       time.Hour,
@@ -242,9 +278,11 @@ func TestPercentageDecisionPolicy(t *testing.T) {
 				Windows: &foundation.DecisionPolicyWindows{
 					VotingPeriod:       tc.votingPeriod,
 					MinExecutionPeriod: tc.minExecutionPeriod,
+					ExecutionDelay:     tc.executionDelay,
 				},
 			}
 			require.Equal(t, tc.votingPeriod, policy.GetVotingPeriod())
+			require.Equal(t, tc.executionDelay, policy.GetExecutionDelay())
 
 			err := policy.ValidateBasic()
 			if !tc.validBasic {
@@ -358,23 +396,35 @@ func TestMembers(t *testing.T) {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
+					Weight:  sdk.NewDec(2),
 				},
 			},
 			valid: true,
 		},
 		"invalid member": {
-			members: []foundation.Member{{}},
+			members: []foundation.Member{{Weight: sdk.OneDec()}},
+		},
+		"invalid weight": {
+			members: []foundation.Member{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
 		},
 		"duplicate members": {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
 		},
@@ -399,6 +449,9 @@ func TestMemberRequests(t *testing.T) {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
+	weight := sdk.NewDec(2)
+	zeroWeight := sdk.ZeroDec()
+
 	testCases := map[string]struct {
 		members []foundation.MemberRequest
 		valid   bool
@@ -415,9 +468,35 @@ func TestMemberRequests(t *testing.T) {
 			},
 			valid: true,
 		},
+		"valid weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Weight:  &weight,
+				},
+			},
+			valid: true,
+		},
 		"invalid member": {
 			members: []foundation.MemberRequest{{}},
 		},
+		"invalid weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Weight:  &zeroWeight,
+				},
+			},
+		},
+		"weight on removal": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Remove:  true,
+					Weight:  &weight,
+				},
+			},
+		},
 		"duplicate requests": {
 			members: []foundation.MemberRequest{
 				{
@@ -455,6 +534,8 @@ func TestProposal(t *testing.T) {
 		proposers []string
 		version   uint64
 		msgs      []sdk.Msg
+		status    foundation.ProposalStatus
+		timelock  bool
 		valid     bool
 	}{
 		"valid proposal": {
@@ -469,6 +550,31 @@ func TestProposal(t *testing.T) {
 			},
 			valid: true,
 		},
+		"timelocked proposal": {
+			id: 1,
+			proposers: []string{
+				addrs[0].String(),
+			},
+			version: 1,
+			msgs: []sdk.Msg{
+				testdata.NewTestMsg(),
+			},
+			status:   foundation.PROPOSAL_STATUS_ACCEPTED,
+			timelock: true,
+			valid:    true,
+		},
+		"timelock on a submitted proposal": {
+			id: 1,
+			proposers: []string{
+				addrs[0].String(),
+			},
+			version: 1,
+			msgs: []sdk.Msg{
+				testdata.NewTestMsg(),
+			},
+			status:   foundation.PROPOSAL_STATUS_SUBMITTED,
+			timelock: true,
+		},
 		"invalid id": {
 			proposers: []string{
 				addrs[0].String(),
@@ -542,8 +648,13 @@ func TestProposal(t *testing.T) {
 				Id:                tc.id,
 				Proposers:         tc.proposers,
 				FoundationVersion: tc.version,
+				Status:            tc.status,
 			}.WithMsgs(tc.msgs)
 			require.NotNil(t, proposal)
+			if tc.timelock {
+				timelockEnd := time.Now()
+				proposal.TimelockEnd = &timelockEnd
+			}
 
 			err := proposal.ValidateBasic()
 			if !tc.valid {
@@ -636,3 +747,292 @@ func TestOutsourcingDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentStream(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	testCases := map[string]struct {
+		malleate func(stream *foundation.PaymentStream)
+		valid    bool
+	}{
+		"valid stream": {
+			valid: true,
+		},
+		"empty id": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.Id = 0
+			},
+		},
+		"invalid recipient": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.Recipient = ""
+			},
+		},
+		"zero amount": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.Amount = sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}
+			},
+		},
+		"end time not after start time": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.EndTime = stream.StartTime
+			},
+		},
+		"negative period": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.Period = -time.Hour
+			},
+		},
+		"period longer than the stream": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.Period = stream.EndTime.Sub(stream.StartTime) + 1
+			},
+		},
+		"paid exceeds amount": {
+			malleate: func(stream *foundation.PaymentStream) {
+				stream.Paid = coins(101)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stream := foundation.PaymentStream{
+				Id:        1,
+				Recipient: addr.String(),
+				Amount:    coins(100),
+				StartTime: startTime,
+				EndTime:   startTime.Add(10 * time.Hour),
+				Period:    time.Hour,
+			}
+			if tc.malleate != nil {
+				tc.malleate(&stream)
+			}
+
+			err := stream.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPaymentStreamReleased(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	testCases := map[string]struct {
+		period   time.Duration
+		elapsed  time.Duration
+		released sdk.Coins
+	}{
+		"linear before start": {
+			elapsed:  -time.Hour,
+			released: sdk.NewCoins(),
+		},
+		"linear at start": {
+			released: sdk.NewCoins(),
+		},
+		"linear in the middle": {
+			elapsed:  150 * time.Minute,
+			released: coins(25),
+		},
+		"linear truncated": {
+			elapsed:  time.Minute,
+			released: sdk.NewCoins(),
+		},
+		"linear at end": {
+			elapsed:  10 * time.Hour,
+			released: coins(100),
+		},
+		"linear after end": {
+			elapsed:  11 * time.Hour,
+			released: coins(100),
+		},
+		"installments before the first": {
+			period:   4 * time.Hour,
+			elapsed:  4*time.Hour - 1,
+			released: sdk.NewCoins(),
+		},
+		"installments at the first": {
+			period:   4 * time.Hour,
+			elapsed:  4 * time.Hour,
+			released: coins(33),
+		},
+		"installments at the second": {
+			period:   4 * time.Hour,
+			elapsed:  8 * time.Hour,
+			released: coins(66),
+		},
+		"installments at the last": {
+			period:   4 * time.Hour,
+			elapsed:  10 * time.Hour,
+			released: coins(100),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stream := foundation.PaymentStream{
+				Amount:    coins(100),
+				StartTime: startTime,
+				EndTime:   startTime.Add(10 * time.Hour),
+				Period:    tc.period,
+			}
+
+			released := stream.Released(startTime.Add(tc.elapsed))
+			require.Equal(t, tc.released, released)
+		})
+	}
+}
+
+func TestCensorship(t *testing.T) {
+	expiration := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		msgTypeURL string
+		authority  foundation.CensorshipAuthority
+		expiration *time.Time
+		valid      bool
+	}{
+		"valid censorship": {
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			authority:  foundation.CensorshipAuthorityFoundation,
+			valid:      true,
+		},
+		"valid time-limited censorship": {
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			authority:  foundation.CensorshipAuthorityGovernance,
+			expiration: &expiration,
+			valid:      true,
+		},
+		"empty msg type url": {
+			authority: foundation.CensorshipAuthorityFoundation,
+		},
+		"invalid authority": {
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			authority:  -1,
+		},
+		"expiration on removal": {
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			authority:  foundation.CensorshipAuthorityUnspecified,
+			expiration: &expiration,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			censorship := foundation.Censorship{
+				MsgTypeUrl: tc.msgTypeURL,
+				Authority:  tc.authority,
+				Expiration: tc.expiration,
+			}
+
+			err := censorship.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParams(t *testing.T) {
+	testCases := map[string]struct {
+		params foundation.Params
+		valid  bool
+	}{
+		"default params": {
+			params: foundation.DefaultParams(),
+			valid:  true,
+		},
+		"valid params": {
+			params: foundation.Params{
+				FoundationTax: sdk.MustNewDecFromStr("0.1"),
+				DenomTaxes: []foundation.DenomTax{
+					{
+						Denom: "stake",
+						Tax:   sdk.MustNewDecFromStr("0.2"),
+					},
+				},
+				InflationTax: sdk.MustNewDecFromStr("0.3"),
+			},
+			valid: true,
+		},
+		"invalid foundation tax": {
+			params: foundation.Params{
+				FoundationTax: sdk.NewDec(2),
+				InflationTax:  sdk.ZeroDec(),
+			},
+		},
+		"invalid denom": {
+			params: foundation.Params{
+				FoundationTax: sdk.ZeroDec(),
+				DenomTaxes: []foundation.DenomTax{
+					{
+						Tax: sdk.ZeroDec(),
+					},
+				},
+				InflationTax: sdk.ZeroDec(),
+			},
+		},
+		"duplicate denom": {
+			params: foundation.Params{
+				FoundationTax: sdk.ZeroDec(),
+				DenomTaxes: []foundation.DenomTax{
+					{
+						Denom: "stake",
+						Tax:   sdk.ZeroDec(),
+					},
+					{
+						Denom: "stake",
+						Tax:   sdk.OneDec(),
+					},
+				},
+				InflationTax: sdk.ZeroDec(),
+			},
+		},
+		"invalid denom tax": {
+			params: foundation.Params{
+				FoundationTax: sdk.ZeroDec(),
+				DenomTaxes: []foundation.DenomTax{
+					{
+						Denom: "stake",
+						Tax:   sdk.NewDec(-1),
+					},
+				},
+				InflationTax: sdk.ZeroDec(),
+			},
+		},
+		"invalid inflation tax": {
+			params: foundation.Params{
+				FoundationTax: sdk.ZeroDec(),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, tax := range tc.params.DenomTaxes {
+				require.Equal(t, tax.Tax, tc.params.GetTaxRatio(tax.Denom))
+			}
+			require.Equal(t, tc.params.FoundationTax, tc.params.GetTaxRatio("nonexistent"))
+		})
+	}
+}
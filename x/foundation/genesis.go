@@ -37,6 +37,7 @@ func DefaultAuthority() sdk.AccAddress {
 func DefaultParams() Params {
 	return Params{
 		FoundationTax: sdk.ZeroDec(),
+		InflationTax:  sdk.ZeroDec(),
 	}
 }
 
@@ -57,6 +58,12 @@ func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 		}
 	}
 
+	for _, p := range data.MsgTypeDecisionPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -104,13 +111,13 @@ func ValidateGenesis(data GenesisState) error {
 	// Is x/foundation outsourcing the proposal feature
 	isOutsourcing := info.TotalWeight.IsZero()
 
-	if realWeight := sdk.NewDec(int64(len(data.Members))); !info.TotalWeight.Equal(realWeight) {
-		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
-	}
-	members := Members{Members: data.Members}
+	members := Members{Members: MigrateMemberWeights(data.Members)}
 	if err := members.ValidateBasic(); err != nil {
 		return err
 	}
+	if realWeight := members.TotalWeight(); !info.TotalWeight.Equal(realWeight) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
+	}
 
 	if isOutsourcing && len(data.Proposals) != 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allows proposals")
@@ -170,6 +177,9 @@ func ValidateGenesis(data GenesisState) error {
 		if auth == nil {
 			return sdkerrors.ErrInvalidType.Wrap("invalid authorization")
 		}
+		if err := auth.ValidateBasic(); err != nil {
+			return err
+		}
 
 		url := auth.MsgTypeURL()
 		if !seenURLs[url] {
@@ -185,9 +195,55 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if isOutsourcing && len(data.MsgTypeDecisionPolicies) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allows decision policies of message types")
+	}
+	seenPolicyURLs := map[string]bool{}
+	for _, policy := range data.MsgTypeDecisionPolicies {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+
+		url := policy.MsgTypeUrl
+		if seenPolicyURLs[url] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate decision policy over %s", url)
+		}
+		seenPolicyURLs[url] = true
+	}
+
+	streamIDs := map[uint64]bool{}
+	for _, stream := range data.PaymentStreams {
+		id := stream.Id
+		if id > data.PreviousPaymentStreamId {
+			return sdkerrors.ErrInvalidRequest.Wrapf("payment stream %d has not yet been created", id)
+		}
+		if streamIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated payment stream id of %d", id)
+		}
+		streamIDs[id] = true
+
+		if err := stream.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// MigrateMemberWeights returns the members with the weight of 1 set on the members without any weight,
+// which have been exported before the weights were introduced.
+func MigrateMemberWeights(members []Member) []Member {
+	migrated := make([]Member, len(members))
+	for i, member := range members {
+		if member.Weight.IsNil() {
+			member.Weight = sdk.OneDec()
+		}
+		migrated[i] = member
+	}
+
+	return migrated
+}
+
 func (g GrantAuthorization) GetAuthorization() Authorization {
 	if g.Authorization == nil {
 		return nil
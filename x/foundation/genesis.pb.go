@@ -44,6 +44,12 @@ type GenesisState struct {
 	// pool
 	Pool        Pool         `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool"`
 	Censorships []Censorship `protobuf:"bytes,10,rep,name=censorships,proto3" json:"censorships"`
+	// msg_type_decision_policies is the list of the decision policies of the message types.
+	MsgTypeDecisionPolicies []MsgTypeDecisionPolicy `protobuf:"bytes,11,rep,name=msg_type_decision_policies,json=msgTypeDecisionPolicies,proto3" json:"msg_type_decision_policies"`
+	// it is used to get the next payment stream ID.
+	PreviousPaymentStreamId uint64 `protobuf:"varint,12,opt,name=previous_payment_stream_id,json=previousPaymentStreamId,proto3" json:"previous_payment_stream_id,omitempty"`
+	// payment_streams is the list of the payment streams.
+	PaymentStreams []PaymentStream `protobuf:"bytes,13,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -126,40 +132,46 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/genesis.proto", fileDescriptor_c5e13dd78b24d473) }
 
 var fileDescriptor_c5e13dd78b24d473 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0x87, 0x6d, 0xea, 0xe6, 0xcf, 0x15, 0x10, 0x9c, 0x22, 0x71, 0x2d, 0xc2, 0x09, 0x91, 0x90,
-	0xba, 0xc4, 0x26, 0x74, 0x40, 0x94, 0xa1, 0x6a, 0x10, 0x8d, 0x0a, 0x42, 0x8a, 0x52, 0x89, 0x81,
-	0xa5, 0xb2, 0x93, 0x8b, 0x73, 0x22, 0xbe, 0xd7, 0xf2, 0x9d, 0x23, 0x0a, 0x5f, 0x80, 0x91, 0x8f,
-	0xd0, 0x91, 0x15, 0x89, 0x0f, 0x51, 0x31, 0x55, 0x4c, 0x4c, 0x08, 0x25, 0x0b, 0x1f, 0x03, 0xe5,
-	0x7c, 0x6e, 0x1c, 0x62, 0x06, 0x36, 0x5f, 0xde, 0xe7, 0x79, 0xdf, 0xdf, 0x9d, 0xde, 0xa0, 0xfa,
-	0xc4, 0x0f, 0xdd, 0x11, 0x24, 0x7c, 0xe8, 0x49, 0x06, 0xdc, 0x9d, 0xb6, 0xdd, 0x80, 0x72, 0x2a,
-	0x98, 0x70, 0xa2, 0x18, 0x24, 0xe0, 0xdb, 0x13, 0x3f, 0x74, 0x96, 0x80, 0x33, 0x6d, 0xef, 0xd4,
-	0x02, 0x08, 0x40, 0x55, 0xdd, 0xc5, 0x57, 0x0a, 0xee, 0x34, 0xd7, 0x3b, 0xe5, 0xb4, 0x94, 0xd9,
-	0x1e, 0x80, 0x08, 0x41, 0x9c, 0xa6, 0x72, 0x7a, 0xc8, 0x4a, 0x01, 0x40, 0x30, 0xa1, 0xae, 0x3a,
-	0xf9, 0xc9, 0xc8, 0xf5, 0xf8, 0x59, 0x5a, 0x6a, 0x7e, 0xb7, 0xd0, 0xf5, 0x6e, 0x1a, 0xea, 0x44,
-	0x7a, 0x92, 0xe2, 0xc7, 0xa8, 0x14, 0x79, 0xb1, 0x17, 0x0a, 0x62, 0x36, 0xcc, 0xdd, 0xad, 0x47,
-	0xdb, 0xce, 0x5a, 0x48, 0xa7, 0xa7, 0x80, 0x8e, 0x75, 0xf1, 0xb3, 0x6e, 0xf4, 0x35, 0x8e, 0xbb,
-	0x08, 0x2d, 0x29, 0x72, 0x4d, 0xc9, 0xf7, 0x0b, 0xe4, 0xa3, 0xab, 0xd3, 0x31, 0x1f, 0x81, 0x6e,
-	0x92, 0x53, 0xf1, 0x13, 0x54, 0x0e, 0x69, 0xe8, 0xd3, 0x58, 0x90, 0x8d, 0xc6, 0xc6, 0x3f, 0x22,
-	0xbc, 0x52, 0x84, 0xb6, 0x33, 0x1e, 0x3f, 0x44, 0xb5, 0x28, 0xa6, 0x53, 0x06, 0x89, 0x7a, 0x87,
-	0x08, 0x84, 0x37, 0x39, 0x65, 0x43, 0x62, 0x35, 0xcc, 0x5d, 0xab, 0x8f, 0xb3, 0x5a, 0x4f, 0x97,
-	0x8e, 0x87, 0xf8, 0x00, 0x55, 0x33, 0x50, 0x90, 0x4d, 0x35, 0xee, 0x6e, 0xd1, 0x8d, 0x35, 0xa3,
-	0x07, 0x2e, 0x1d, 0xbc, 0x87, 0x36, 0xa7, 0x20, 0xa9, 0x20, 0x25, 0x25, 0xdf, 0x29, 0x90, 0x5f,
-	0x83, 0xa4, 0x5a, 0x4c, 0x59, 0x7c, 0x82, 0x6e, 0x7a, 0x89, 0x1c, 0x43, 0xcc, 0xde, 0x2b, 0x4a,
-	0x90, 0xb2, 0xb2, 0x1f, 0x14, 0xd8, 0xdd, 0xd8, 0xe3, 0xf2, 0x30, 0x4f, 0xeb, 0x5e, 0x7f, 0xb5,
-	0xc0, 0x6d, 0x64, 0x45, 0x00, 0x13, 0x52, 0x51, 0x4f, 0x5f, 0x14, 0xa4, 0x07, 0x90, 0xdd, 0x40,
-	0xa1, 0xf8, 0x39, 0xda, 0x1a, 0x50, 0x2e, 0x20, 0x16, 0x63, 0x16, 0x09, 0x82, 0x54, 0x88, 0x7b,
-	0x05, 0xe6, 0xb3, 0x2b, 0x4a, 0xfb, 0x79, 0x6f, 0xbf, 0xf2, 0xf1, 0xbc, 0x6e, 0xfc, 0x3e, 0xaf,
-	0x1b, 0x2f, 0xac, 0x4a, 0xf5, 0x16, 0x6a, 0x7e, 0x31, 0x11, 0x5e, 0x8f, 0x8d, 0x09, 0x2a, 0x07,
-	0x8b, 0x5f, 0x29, 0x55, 0xbb, 0x55, 0xed, 0x67, 0x47, 0xfc, 0x01, 0xdd, 0x58, 0xb9, 0x8c, 0x5e,
-	0x9f, 0x9a, 0x93, 0x2e, 0xae, 0x93, 0x2d, 0xae, 0x73, 0xc8, 0xcf, 0x3a, 0x07, 0xdf, 0xbe, 0xb6,
-	0x9e, 0x06, 0x4c, 0x8e, 0x13, 0xdf, 0x19, 0x40, 0xe8, 0x1e, 0x31, 0x2e, 0x06, 0x63, 0xe6, 0xb9,
-	0x23, 0xfd, 0xd1, 0x12, 0xc3, 0xb7, 0xee, 0xbb, 0xfc, 0x3f, 0x64, 0x25, 0x47, 0x7f, 0x75, 0xd6,
-	0xbe, 0xb5, 0x48, 0xdf, 0x79, 0xf9, 0x79, 0x66, 0x9b, 0x17, 0x33, 0xdb, 0xbc, 0x9c, 0xd9, 0xe6,
-	0xaf, 0x99, 0x6d, 0x7e, 0x9a, 0xdb, 0xc6, 0xe5, 0xdc, 0x36, 0x7e, 0xcc, 0x6d, 0xe3, 0x4d, 0xeb,
-	0xbf, 0xe6, 0xf9, 0x25, 0x15, 0x78, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x37, 0xe3,
-	0x2e, 0x02, 0x04, 0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xed, 0x5f, 0xdd, 0xb4, 0xdd, 0xb6, 0x3f, 0x60, 0x55, 0xa9, 0xdb, 0x20, 0x9c, 0x50,
+	0x09, 0xa9, 0x97, 0xda, 0x94, 0x1e, 0x10, 0xed, 0xa1, 0x6a, 0x81, 0x56, 0x05, 0x21, 0xa2, 0x14,
+	0x71, 0xe0, 0x62, 0xf9, 0xcf, 0xc6, 0x59, 0xd5, 0xf6, 0x58, 0xde, 0x4d, 0x84, 0xe1, 0x05, 0x38,
+	0xf2, 0x08, 0x3d, 0x72, 0x45, 0xe2, 0x21, 0x2a, 0x24, 0xa4, 0x1e, 0x39, 0x21, 0x94, 0x5e, 0x78,
+	0x0c, 0x94, 0xf5, 0xba, 0x71, 0xa8, 0x39, 0x70, 0xf3, 0xec, 0x7c, 0x3f, 0x33, 0xdf, 0xdd, 0xc9,
+	0x04, 0xb5, 0x22, 0x2f, 0xb6, 0x7b, 0x30, 0x48, 0x02, 0x57, 0x30, 0x48, 0xec, 0xe1, 0x96, 0x1d,
+	0xd2, 0x84, 0x72, 0xc6, 0xad, 0x34, 0x03, 0x01, 0xf8, 0x56, 0xe4, 0xc5, 0xd6, 0x44, 0x60, 0x0d,
+	0xb7, 0x9a, 0x2b, 0x21, 0x84, 0x20, 0xb3, 0xf6, 0xf8, 0xab, 0x10, 0x36, 0xd7, 0xaf, 0x57, 0xaa,
+	0x60, 0x85, 0x66, 0xcd, 0x07, 0x1e, 0x03, 0x77, 0x0a, 0xb8, 0x08, 0xca, 0x54, 0x08, 0x10, 0x46,
+	0xd4, 0x96, 0x91, 0x37, 0xe8, 0xd9, 0x6e, 0x92, 0x17, 0xa9, 0xf5, 0x6f, 0x0d, 0xb4, 0x74, 0x54,
+	0x98, 0x3a, 0x11, 0xae, 0xa0, 0xf8, 0x21, 0x6a, 0xa4, 0x6e, 0xe6, 0xc6, 0x9c, 0xe8, 0x6d, 0x7d,
+	0x63, 0xf1, 0xc1, 0x9a, 0x75, 0xcd, 0xa4, 0xd5, 0x91, 0x82, 0x03, 0xe3, 0xfc, 0x47, 0x4b, 0xeb,
+	0x2a, 0x39, 0x3e, 0x42, 0x68, 0xa2, 0x22, 0xff, 0x49, 0xf8, 0x6e, 0x0d, 0x7c, 0x78, 0x15, 0x1d,
+	0x27, 0x3d, 0x50, 0x45, 0x2a, 0x28, 0x7e, 0x84, 0xe6, 0x62, 0x1a, 0x7b, 0x34, 0xe3, 0x64, 0xa6,
+	0x3d, 0xf3, 0x17, 0x0b, 0x2f, 0xa4, 0x42, 0xd1, 0xa5, 0x1e, 0xdf, 0x47, 0x2b, 0x69, 0x46, 0x87,
+	0x0c, 0x06, 0xf2, 0x1d, 0x52, 0xe0, 0x6e, 0xe4, 0xb0, 0x80, 0x18, 0x6d, 0x7d, 0xc3, 0xe8, 0xe2,
+	0x32, 0xd7, 0x51, 0xa9, 0xe3, 0x00, 0xef, 0xa1, 0x85, 0x52, 0xc8, 0xc9, 0xac, 0x6c, 0x77, 0xbb,
+	0xee, 0xc6, 0x4a, 0xa3, 0x1a, 0x4e, 0x18, 0xbc, 0x8d, 0x66, 0x87, 0x20, 0x28, 0x27, 0x0d, 0x09,
+	0xaf, 0xd6, 0xc0, 0xaf, 0x41, 0x50, 0x05, 0x16, 0x5a, 0x7c, 0x82, 0xfe, 0x77, 0x07, 0xa2, 0x0f,
+	0x19, 0x7b, 0x27, 0x55, 0x9c, 0xcc, 0x49, 0xfa, 0x5e, 0x0d, 0x7d, 0x94, 0xb9, 0x89, 0xd8, 0xaf,
+	0xaa, 0x55, 0xad, 0x3f, 0x4a, 0xe0, 0x2d, 0x64, 0xa4, 0x00, 0x11, 0x99, 0x97, 0x4f, 0x5f, 0x67,
+	0xa4, 0x03, 0x50, 0xde, 0x40, 0x4a, 0xf1, 0x53, 0xb4, 0xe8, 0xd3, 0x84, 0x43, 0xc6, 0xfb, 0x2c,
+	0xe5, 0x04, 0x49, 0x13, 0x77, 0x6a, 0xc8, 0xc7, 0x57, 0x2a, 0xc5, 0x57, 0x39, 0x7c, 0x8a, 0x9a,
+	0x31, 0x0f, 0x1d, 0x91, 0xa7, 0xd4, 0x09, 0xa8, 0xcf, 0x38, 0x83, 0xc4, 0x49, 0x21, 0x62, 0x3e,
+	0xa3, 0x9c, 0x2c, 0xca, 0xaa, 0x1b, 0x75, 0x43, 0xe4, 0xe1, 0xab, 0x3c, 0xa5, 0x4f, 0x14, 0xd2,
+	0x19, 0x13, 0xb9, 0x6a, 0xb0, 0x1a, 0xd7, 0x24, 0x19, 0xe5, 0x78, 0x17, 0x35, 0x27, 0x33, 0x76,
+	0xf3, 0x98, 0x26, 0xc2, 0xe1, 0x22, 0xa3, 0x6e, 0x3c, 0x9e, 0xf4, 0x92, 0x9c, 0xf4, 0xea, 0xd5,
+	0xa4, 0x0b, 0xc1, 0x89, 0xcc, 0x1f, 0x07, 0xf8, 0x25, 0xba, 0x31, 0xcd, 0x70, 0xb2, 0x2c, 0xed,
+	0xb5, 0x6b, 0x7f, 0xe6, 0x15, 0xb8, 0x7c, 0xf4, 0xb4, 0x7a, 0xc8, 0x77, 0xe6, 0x3f, 0x9c, 0xb5,
+	0xb4, 0x5f, 0x67, 0x2d, 0xed, 0x99, 0x31, 0xbf, 0x70, 0x13, 0xad, 0x7f, 0xd6, 0x11, 0xbe, 0x3e,
+	0x31, 0x4c, 0xd0, 0x5c, 0x38, 0x3e, 0xa5, 0x54, 0xae, 0xd5, 0x42, 0xb7, 0x0c, 0xf1, 0x7b, 0xb4,
+	0x3c, 0x35, 0x47, 0xb5, 0x39, 0x2b, 0x56, 0xb1, 0xb3, 0x56, 0xb9, 0xb3, 0xd6, 0x7e, 0x92, 0x1f,
+	0xec, 0x7d, 0xfd, 0xb2, 0xb9, 0x1b, 0x32, 0xd1, 0x1f, 0x78, 0x96, 0x0f, 0xb1, 0x7d, 0xc8, 0x12,
+	0xee, 0xf7, 0x99, 0x6b, 0xf7, 0xd4, 0xc7, 0x26, 0x0f, 0x4e, 0xed, 0xb7, 0xd5, 0x3f, 0x87, 0x29,
+	0x1f, 0xdd, 0xe9, 0x5e, 0x3b, 0xc6, 0xd8, 0xfd, 0xc1, 0xf3, 0x4f, 0x23, 0x53, 0x3f, 0x1f, 0x99,
+	0xfa, 0xc5, 0xc8, 0xd4, 0x7f, 0x8e, 0x4c, 0xfd, 0xe3, 0xa5, 0xa9, 0x5d, 0x5c, 0x9a, 0xda, 0xf7,
+	0x4b, 0x53, 0x7b, 0xb3, 0xf9, 0x4f, 0xfd, 0xbc, 0x86, 0x34, 0xbc, 0xfd, 0x3b, 0x00, 0x00, 0xff,
+	0xff, 0xa9, 0x02, 0x73, 0x07, 0xfd, 0x04, 0x00, 0x00,
 }
 
 func (this *GrantAuthorization) Equal(that interface{}) bool {
@@ -209,6 +221,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.PreviousPaymentStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PreviousPaymentStreamId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.MsgTypeDecisionPolicies) > 0 {
+		for iNdEx := len(m.MsgTypeDecisionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeDecisionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.Censorships) > 0 {
 		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -415,6 +460,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MsgTypeDecisionPolicies) > 0 {
+		for _, e := range m.MsgTypeDecisionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PreviousPaymentStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.PreviousPaymentStreamId))
+	}
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -758,6 +818,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeDecisionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeDecisionPolicies = append(m.MsgTypeDecisionPolicies, MsgTypeDecisionPolicy{})
+			if err := m.MsgTypeDecisionPolicies[len(m.MsgTypeDecisionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPaymentStreamId", wireType)
+			}
+			m.PreviousPaymentStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPaymentStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
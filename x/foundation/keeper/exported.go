@@ -13,6 +13,8 @@ import (
 type Keeper interface {
 	GetAuthority() string
 	Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error
+	GetAuthorization(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) (foundation.Authorization, error)
+	FundTreasury(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) error
 
 	InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error
 	ExportGenesis(ctx sdk.Context) *foundation.GenesisState
@@ -28,6 +30,7 @@ func NewKeeper(
 	router *baseapp.MsgServiceRouter,
 	authKeeper foundation.AuthKeeper,
 	bankKeeper foundation.BankKeeper,
+	mintKeeper foundation.MintKeeper,
 	feeCollectorName string,
 	config foundation.Config,
 	authority string,
@@ -40,6 +43,7 @@ func NewKeeper(
 			router,
 			authKeeper,
 			bankKeeper,
+			mintKeeper,
 			feeCollectorName,
 			config,
 			authority,
@@ -57,6 +61,16 @@ func (k keeper) Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) err
 	return k.impl.Accept(ctx, grantee, msg)
 }
 
+// GetAuthorization returns the authorization of the grantee on the message type.
+func (k keeper) GetAuthorization(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) (foundation.Authorization, error) {
+	return k.impl.GetAuthorization(ctx, grantee, msgTypeURL)
+}
+
+// FundTreasury sends coins from the account to the treasury.
+func (k keeper) FundTreasury(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) error {
+	return k.impl.FundTreasury(ctx, from, amt)
+}
+
 func (k keeper) InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error {
 	return k.impl.InitGenesis(ctx, gs)
 }
@@ -9,16 +9,20 @@ import (
 )
 
 // BeginBlocker withdraws rewards from fee-collector before the distribution
-// module's withdraw.
+// module's withdraw, and pays the coins released by the payment streams.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	defer telemetry.ModuleMeasureSince(foundation.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	if err := k.CollectFoundationTax(ctx); err != nil {
 		panic(err)
 	}
+
+	k.ReleasePaymentStreams(ctx)
 }
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	k.UpdateTallyOfVPEndProposals(ctx)
+	k.ExecTimelockEndProposals(ctx)
 	k.PruneExpiredProposals(ctx)
+	k.LiftExpiredCensorships(ctx)
 }
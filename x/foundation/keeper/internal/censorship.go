@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -30,10 +32,14 @@ func (k Keeper) UpdateCensorship(ctx sdk.Context, censorship foundation.Censorsh
 
 	newAuthority := censorship.Authority
 	oldAuthority := oldCensorship.Authority
-	if newAuthority >= oldAuthority {
+	if newAuthority > oldAuthority || (newAuthority == oldAuthority && sameExpiration(censorship, *oldCensorship)) {
 		return sdkerrors.ErrInvalidRequest.Wrapf("bad transition; %s -> %s over %s", oldAuthority, newAuthority, url)
 	}
 
+	if expiration := censorship.Expiration; expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expiration %s is not after the block time", expiration)
+	}
+
 	// clean up relevant authorizations
 	if newAuthority == foundation.CensorshipAuthorityUnspecified {
 		k.pruneAuthorizations(ctx, url)
@@ -44,9 +50,23 @@ func (k Keeper) UpdateCensorship(ctx sdk.Context, censorship foundation.Censorsh
 	return nil
 }
 
+func sameExpiration(x, y foundation.Censorship) bool {
+	if x.Expiration == nil || y.Expiration == nil {
+		return x.Expiration == y.Expiration
+	}
+
+	return x.Expiration.Equal(*y.Expiration)
+}
+
 func (k Keeper) SetCensorship(ctx sdk.Context, censorship foundation.Censorship) {
 	store := ctx.KVStore(k.storeKey)
-	key := censorshipKey(censorship.MsgTypeUrl)
+	url := censorship.MsgTypeUrl
+	key := censorshipKey(url)
+
+	// remove the old one from the expiration queue
+	if oldCensorship, err := k.GetCensorship(ctx, url); err == nil && oldCensorship.Expiration != nil {
+		store.Delete(censorshipByExpirationKey(*oldCensorship.Expiration, url))
+	}
 
 	if censorship.Authority == foundation.CensorshipAuthorityUnspecified {
 		store.Delete(key)
@@ -55,6 +75,54 @@ func (k Keeper) SetCensorship(ctx sdk.Context, censorship foundation.Censorship)
 
 	bz := k.cdc.MustMarshal(&censorship)
 	store.Set(key, bz)
+
+	if censorship.Expiration != nil {
+		store.Set(censorshipByExpirationKey(*censorship.Expiration, url), []byte{})
+	}
+}
+
+// LiftExpiredCensorships lifts the censorships which have expired.
+// Unlike the removal by UpdateCensorship, the relevant authorizations remain.
+func (k Keeper) LiftExpiredCensorships(ctx sdk.Context) {
+	var censorships []foundation.Censorship
+	k.iterateCensorshipsByExpiration(ctx, ctx.BlockTime(), func(censorship foundation.Censorship) (stop bool) {
+		censorships = append(censorships, censorship)
+		return false
+	})
+
+	for _, censorship := range censorships {
+		k.SetCensorship(ctx, foundation.Censorship{
+			MsgTypeUrl: censorship.MsgTypeUrl,
+			Authority:  foundation.CensorshipAuthorityUnspecified,
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&foundation.EventLiftCensorship{
+			Censorship: censorship,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// iterateCensorshipsByExpiration iterates over the censorships which expire
+// until the given time.
+func (k Keeper) iterateCensorshipsByExpiration(ctx sdk.Context, endTime time.Time, fn func(censorship foundation.Censorship) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(censorshipByExpirationKeyPrefix, sdk.PrefixEndBytes(append(censorshipByExpirationKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, url := splitCensorshipByExpirationKey(iter.Key())
+
+		censorship, err := k.GetCensorship(ctx, url)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*censorship) {
+			break
+		}
+	}
 }
 
 func (k Keeper) iterateCensorships(ctx sdk.Context, fn func(censorship foundation.Censorship) (stop bool)) {
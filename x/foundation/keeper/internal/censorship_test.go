@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
@@ -54,6 +56,124 @@ func (s *KeeperTestSuite) TestUpdateCensorship() {
 	s.Require().Error(err)
 }
 
+func (s *KeeperTestSuite) TestUpdateCensorshipExpiration() {
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	otherExpiration := expiration.Add(time.Hour)
+
+	testCases := map[string]struct {
+		authority  foundation.CensorshipAuthority
+		expiration *time.Time
+		malleate   func(ctx sdk.Context)
+		valid      bool
+	}{
+		"set expiration": {
+			authority:  foundation.CensorshipAuthorityFoundation,
+			expiration: &expiration,
+			valid:      true,
+		},
+		"update expiration": {
+			authority:  foundation.CensorshipAuthorityFoundation,
+			expiration: &otherExpiration,
+			malleate: func(ctx sdk.Context) {
+				s.impl.SetCensorship(ctx, foundation.Censorship{
+					MsgTypeUrl: msgTypeURL,
+					Authority:  foundation.CensorshipAuthorityFoundation,
+					Expiration: &expiration,
+				})
+			},
+			valid: true,
+		},
+		"unset expiration": {
+			authority: foundation.CensorshipAuthorityFoundation,
+			malleate: func(ctx sdk.Context) {
+				s.impl.SetCensorship(ctx, foundation.Censorship{
+					MsgTypeUrl: msgTypeURL,
+					Authority:  foundation.CensorshipAuthorityFoundation,
+					Expiration: &expiration,
+				})
+			},
+			valid: true,
+		},
+		"lower authority with expiration": {
+			authority:  foundation.CensorshipAuthorityGovernance,
+			expiration: &expiration,
+			valid:      true,
+		},
+		"no change": {
+			authority: foundation.CensorshipAuthorityFoundation,
+		},
+		"same expiration": {
+			authority:  foundation.CensorshipAuthorityFoundation,
+			expiration: &expiration,
+			malleate: func(ctx sdk.Context) {
+				s.impl.SetCensorship(ctx, foundation.Censorship{
+					MsgTypeUrl: msgTypeURL,
+					Authority:  foundation.CensorshipAuthorityFoundation,
+					Expiration: &expiration,
+				})
+			},
+		},
+		"expiration in the past": {
+			authority:  foundation.CensorshipAuthorityFoundation,
+			expiration: &time.Time{},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			censorship := foundation.Censorship{
+				MsgTypeUrl: msgTypeURL,
+				Authority:  tc.authority,
+				Expiration: tc.expiration,
+			}
+			err := s.impl.UpdateCensorship(ctx, censorship)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			updated, err := s.impl.GetCensorship(ctx, msgTypeURL)
+			s.Require().NoError(err)
+			s.Require().Equal(censorship, *updated)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiftExpiredCensorships() {
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+
+	ctx, _ := s.ctx.CacheContext()
+	err := s.impl.UpdateCensorship(ctx, foundation.Censorship{
+		MsgTypeUrl: msgTypeURL,
+		Authority:  foundation.CensorshipAuthorityFoundation,
+		Expiration: &expiration,
+	})
+	s.Require().NoError(err)
+
+	// not expired yet
+	ctx = ctx.WithBlockTime(expiration.Add(-time.Nanosecond))
+	s.impl.LiftExpiredCensorships(ctx)
+	s.Require().True(s.impl.IsCensoredMessage(ctx, msgTypeURL))
+
+	// expired
+	ctx = ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	s.impl.LiftExpiredCensorships(ctx)
+	s.Require().False(s.impl.IsCensoredMessage(ctx, msgTypeURL))
+	s.Require().Len(ctx.EventManager().Events(), 1)
+
+	// the authorizations remain
+	_, err = s.impl.GetAuthorization(ctx, s.stranger, msgTypeURL)
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestGrant() {
 	testCases := map[string]struct {
 		malleate func(ctx sdk.Context)
@@ -189,3 +309,45 @@ func (s *KeeperTestSuite) TestAccept() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestAcceptReceiveLimit() {
+	ctx, _ := s.ctx.CacheContext()
+
+	grantee := s.members[0]
+	limit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	err := s.impl.Grant(ctx, grantee, &foundation.ReceiveFromTreasuryAuthorization{
+		ReceiveLimit: limit,
+	})
+	s.Require().NoError(err)
+
+	msgWithAmount := func(amount int64) sdk.Msg {
+		return &foundation.MsgWithdrawFromTreasury{
+			Authority: s.authority.String(),
+			To:        grantee.String(),
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+		}
+	}
+	msgTypeURL := foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL()
+
+	// spend down the limit
+	err = s.impl.Accept(ctx, grantee, msgWithAmount(3))
+	s.Require().NoError(err)
+
+	authorization, err := s.impl.GetAuthorization(ctx, grantee, msgTypeURL)
+	s.Require().NoError(err)
+	expected := &foundation.ReceiveFromTreasuryAuthorization{
+		ReceiveLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7)),
+	}
+	s.Require().Equal(expected, authorization)
+
+	// exceed the limit
+	err = s.impl.Accept(ctx, grantee, msgWithAmount(8))
+	s.Require().Error(err)
+
+	// exhaust the limit
+	err = s.impl.Accept(ctx, grantee, msgWithAmount(7))
+	s.Require().NoError(err)
+
+	_, err = s.impl.GetAuthorization(ctx, grantee, msgTypeURL)
+	s.Require().Error(err)
+}
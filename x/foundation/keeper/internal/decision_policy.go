@@ -0,0 +1,140 @@
+package internal
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (k Keeper) GetMsgTypeDecisionPolicy(ctx sdk.Context, msgTypeURL string) (foundation.DecisionPolicy, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := msgTypeDecisionPolicyKey(msgTypeURL)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("decision policy not found over %s", msgTypeURL)
+	}
+
+	var policy foundation.MsgTypeDecisionPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+
+	return policy.GetDecisionPolicy(), nil
+}
+
+// UpdateMsgTypeDecisionPolicy sets the decision policy of the message type.
+// A nil policy removes the existing one.
+func (k Keeper) UpdateMsgTypeDecisionPolicy(ctx sdk.Context, msgTypeURL string, policy foundation.DecisionPolicy) error {
+	info := k.GetFoundationInfo(ctx)
+
+	if policy == nil {
+		if _, err := k.GetMsgTypeDecisionPolicy(ctx, msgTypeURL); err != nil {
+			return err
+		}
+		k.deleteMsgTypeDecisionPolicy(ctx, msgTypeURL)
+	} else {
+		if err := policy.Validate(info, k.config); err != nil {
+			return err
+		}
+
+		msgTypePolicy := foundation.MsgTypeDecisionPolicy{
+			MsgTypeUrl: msgTypeURL,
+		}
+		if err := msgTypePolicy.SetDecisionPolicy(policy); err != nil {
+			return err
+		}
+		k.SetMsgTypeDecisionPolicy(ctx, msgTypePolicy)
+	}
+
+	info.Version++
+	k.SetFoundationInfo(ctx, info)
+
+	// invalidate active proposals
+	k.abortOldProposals(ctx)
+
+	return nil
+}
+
+func (k Keeper) SetMsgTypeDecisionPolicy(ctx sdk.Context, policy foundation.MsgTypeDecisionPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	key := msgTypeDecisionPolicyKey(policy.MsgTypeUrl)
+
+	bz := k.cdc.MustMarshal(&policy)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteMsgTypeDecisionPolicy(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	key := msgTypeDecisionPolicyKey(msgTypeURL)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateMsgTypeDecisionPolicies(ctx sdk.Context, fn func(policy foundation.MsgTypeDecisionPolicy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, msgTypeDecisionPolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy foundation.MsgTypeDecisionPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		if stop := fn(policy); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetMsgTypeDecisionPolicies(ctx sdk.Context) []foundation.MsgTypeDecisionPolicy {
+	var policies []foundation.MsgTypeDecisionPolicy
+	k.iterateMsgTypeDecisionPolicies(ctx, func(policy foundation.MsgTypeDecisionPolicy) (stop bool) {
+		policies = append(policies, policy)
+		return false
+	})
+
+	return policies
+}
+
+// validateDecisionPolicies validates all the decision policies against the
+// foundation info.
+func (k Keeper) validateDecisionPolicies(ctx sdk.Context, info foundation.FoundationInfo) error {
+	if err := info.GetDecisionPolicy().Validate(info, k.config); err != nil {
+		return err
+	}
+
+	var errIter error
+	k.iterateMsgTypeDecisionPolicies(ctx, func(policy foundation.MsgTypeDecisionPolicy) (stop bool) {
+		if err := policy.GetDecisionPolicy().Validate(info, k.config); err != nil {
+			errIter = err
+			return true
+		}
+		return false
+	})
+
+	return errIter
+}
+
+// decisionPoliciesOf returns the decision policies which apply to a proposal
+// of the messages. The foundation's decision policy applies to the messages
+// without any decision policy of their types.
+func (k Keeper) decisionPoliciesOf(ctx sdk.Context, info foundation.FoundationInfo, msgs []sdk.Msg) []foundation.DecisionPolicy {
+	var policies []foundation.DecisionPolicy
+	seenURLs := map[string]bool{}
+	for _, msg := range msgs {
+		url := sdk.MsgTypeURL(msg)
+		if seenURLs[url] {
+			continue
+		}
+		seenURLs[url] = true
+
+		policy, err := k.GetMsgTypeDecisionPolicy(ctx, url)
+		if err != nil {
+			policy = info.GetDecisionPolicy()
+		}
+		policies = append(policies, policy)
+	}
+
+	// a proposal without any messages
+	if len(policies) == 0 {
+		policies = append(policies, info.GetDecisionPolicy())
+	}
+
+	return policies
+}
@@ -0,0 +1,160 @@
+package internal_test
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (s *KeeperTestSuite) TestUpdateMsgTypeDecisionPolicy() {
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+	policy := &foundation.PercentageDecisionPolicy{
+		Percentage: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	}
+
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		policy   foundation.DecisionPolicy
+		valid    bool
+	}{
+		"set a policy": {
+			policy: policy,
+			valid:  true,
+		},
+		"remove a policy": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, msgTypeURL, policy)
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"remove a non-existing policy": {},
+		"invalid windows": {
+			policy: &foundation.PercentageDecisionPolicy{
+				Percentage: sdk.OneDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod:       time.Hour,
+					MinExecutionPeriod: time.Hour + foundation.DefaultConfig().MaxExecutionPeriod,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+			version := s.impl.GetFoundationInfo(ctx).Version
+
+			err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, msgTypeURL, tc.policy)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(version+1, s.impl.GetFoundationInfo(ctx).Version)
+
+			actual, err := s.impl.GetMsgTypeDecisionPolicy(ctx, msgTypeURL)
+			if tc.policy == nil {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.policy, actual)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgTypeDecisionPolicyTally() {
+	// all the members must vote yes on the messages of this type
+	strictMsg := testdata.NewTestMsg(s.authority)
+	strictPolicy := &foundation.PercentageDecisionPolicy{
+		Percentage: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: 14 * 24 * time.Hour,
+		},
+	}
+
+	// the foundation's decision policy applies to this message
+	lenientMsg := &foundation.MsgWithdrawFromTreasury{
+		Authority: s.authority.String(),
+		To:        s.stranger.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.Add(sdk.OneInt()))),
+	}
+
+	testCases := map[string]struct {
+		msgs     []sdk.Msg
+		voters   []sdk.AccAddress
+		accepted bool
+	}{
+		"foundation policy": {
+			msgs:     []sdk.Msg{lenientMsg},
+			voters:   s.members[:1],
+			accepted: true,
+		},
+		"msg type policy": {
+			msgs:   []sdk.Msg{strictMsg},
+			voters: s.members[:1],
+		},
+		"msg type policy satisfied": {
+			msgs:     []sdk.Msg{strictMsg},
+			voters:   s.members,
+			accepted: true,
+		},
+		"strictest policy applies": {
+			msgs:   []sdk.Msg{lenientMsg, strictMsg},
+			voters: s.members[:1],
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, sdk.MsgTypeURL(strictMsg), strictPolicy)
+			s.Require().NoError(err)
+
+			id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", tc.msgs)
+			s.Require().NoError(err)
+
+			for _, voter := range tc.voters {
+				err := s.impl.Vote(ctx, foundation.Vote{
+					ProposalId: *id,
+					Voter:      voter.String(),
+					Option:     foundation.VOTE_OPTION_YES,
+				})
+				s.Require().NoError(err)
+			}
+
+			// the execution of the messages fails, so the proposal remains
+			err = s.impl.Exec(ctx, *id)
+			s.Require().NoError(err)
+
+			proposal, err := s.impl.GetProposal(ctx, *id)
+			s.Require().NoError(err)
+
+			expected := foundation.PROPOSAL_STATUS_SUBMITTED
+			if tc.accepted {
+				expected = foundation.PROPOSAL_STATUS_ACCEPTED
+			}
+			s.Require().Equal(expected, proposal.Status)
+
+			// the longest voting period applies
+			votingPeriod := workingPolicy().GetVotingPeriod()
+			for _, msg := range tc.msgs {
+				if msg == strictMsg {
+					votingPeriod = strictPolicy.GetVotingPeriod()
+				}
+			}
+			s.Require().Equal(ctx.BlockTime().Add(votingPeriod), proposal.VotingPeriodEnd)
+		})
+	}
+}
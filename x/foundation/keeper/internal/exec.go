@@ -43,7 +43,9 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 
 	// Execute proposal payload.
 	var logs string
-	if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED &&
+	if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED && isTimelocked(ctx, *proposal) {
+		logs = fmt.Sprintf("proposal %d is timelocked until %s", proposalID, *proposal.TimelockEnd)
+	} else if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED &&
 		proposal.ExecutorResult != foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", foundation.ModuleName))
 		// Caching context so that we don't update the store in case of failure.
@@ -82,6 +84,32 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// isTimelocked returns whether the proposal cannot be executed yet because of
+// its timelock.
+func isTimelocked(ctx sdk.Context, proposal foundation.Proposal) bool {
+	return proposal.TimelockEnd != nil && ctx.BlockTime().Before(*proposal.TimelockEnd)
+}
+
+// ExecTimelockEndProposals executes all the proposals whose timelock has just
+// ended.
+func (k Keeper) ExecTimelockEndProposals(ctx sdk.Context) {
+	var proposals []foundation.Proposal
+	k.iterateProposalsByTimelockEnd(ctx, ctx.BlockTime(), func(proposal foundation.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", foundation.ModuleName))
+	for _, proposal := range proposals {
+		// the proposal would not be executed automatically again
+		k.removeProposalFromTimelockQueue(ctx, proposal)
+
+		if err := k.Exec(ctx, proposal.Id); err != nil {
+			logger.Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
+		}
+	}
+}
+
 // doExecuteMsgs routes the messages to the registered handlers.
 func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal foundation.Proposal) ([]sdk.Result, error) {
 	msgs := proposal.GetMsgs()
@@ -1,5 +1,13 @@
 package internal_test
 
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+)
+
 func (s *KeeperTestSuite) TestExec() {
 	testCases := map[string]struct {
 		proposalID uint64
@@ -42,3 +50,135 @@ func (s *KeeperTestSuite) TestExec() {
 		})
 	}
 }
+
+// acceptTimelockedProposal submits a proposal withdrawing coins from the
+// treasury, which is delayed by the decision policy of the message, and
+// accepts it.
+func (s *KeeperTestSuite) acceptTimelockedProposal(ctx sdk.Context, delay time.Duration) uint64 {
+	msg := &foundation.MsgWithdrawFromTreasury{
+		Authority: s.authority.String(),
+		To:        s.stranger.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+	}
+	err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, sdk.MsgTypeURL(msg), &foundation.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod:   7 * 24 * time.Hour,
+			ExecutionDelay: delay,
+		},
+	})
+	s.Require().NoError(err)
+
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{msg})
+	s.Require().NoError(err)
+
+	err = s.impl.Vote(ctx, foundation.Vote{
+		ProposalId: *id,
+		Voter:      s.members[0].String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	// accepted, but not executed
+	err = s.impl.Exec(ctx, *id)
+	s.Require().NoError(err)
+
+	proposal, err := s.impl.GetProposal(ctx, *id)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_ACCEPTED, proposal.Status)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+	s.Require().NotNil(proposal.TimelockEnd)
+	s.Require().Equal(ctx.BlockTime().Add(delay), *proposal.TimelockEnd)
+
+	return *id
+}
+
+func (s *KeeperTestSuite) TestExecTimelockedProposal() {
+	delay := time.Hour
+
+	testCases := map[string]struct {
+		elapsed  time.Duration
+		executed bool
+	}{
+		"within the timelock": {
+			elapsed: delay - time.Nanosecond,
+		},
+		"timelock ended": {
+			elapsed:  delay,
+			executed: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			id := s.acceptTimelockedProposal(ctx, delay)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed))
+			err := s.impl.Exec(ctx, id)
+			s.Require().NoError(err)
+
+			_, err = s.impl.GetProposal(ctx, id)
+			if tc.executed {
+				// pruned after the execution
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExecTimelockEndProposals() {
+	delay := time.Hour
+
+	testCases := map[string]struct {
+		elapsed  time.Duration
+		veto     bool
+		executed bool
+	}{
+		"within the timelock": {
+			elapsed: delay - time.Nanosecond,
+		},
+		"timelock ended": {
+			elapsed:  delay,
+			executed: true,
+		},
+		"vetoed": {
+			elapsed: delay,
+			veto:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			id := s.acceptTimelockedProposal(ctx, delay)
+
+			if tc.veto {
+				err := s.impl.VetoProposal(ctx, id)
+				s.Require().NoError(err)
+			}
+
+			before := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed))
+			internal.EndBlocker(ctx, s.impl)
+
+			after := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+			if !tc.executed {
+				s.Require().True(before.Equal(after))
+
+				proposal, err := s.impl.GetProposal(ctx, id)
+				s.Require().NoError(err)
+				s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+				return
+			}
+			s.Require().True(before.Add(sdk.OneInt()).Equal(after))
+
+			// pruned after the execution
+			_, err := s.impl.GetProposal(ctx, id)
+			s.Require().Error(err)
+		})
+	}
+}
@@ -14,7 +14,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 	k.setPreviousProposalID(ctx, data.PreviousProposalId)
 
-	for _, member := range data.Members {
+	for _, member := range foundation.MigrateMemberWeights(data.Members) {
 		if err := validateMetadata(member.Metadata, k.config); err != nil {
 			return err
 		}
@@ -29,6 +29,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 		k.setProposal(ctx, proposal)
 		k.addProposalToVPEndQueue(ctx, proposal)
+		if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED &&
+			proposal.ExecutorResult == foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN &&
+			proposal.TimelockEnd != nil {
+			k.addProposalToTimelockQueue(ctx, proposal)
+		}
 	}
 
 	for _, vote := range data.Votes {
@@ -50,6 +55,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 	k.SetPool(ctx, data.Pool)
 
+	for _, policy := range data.MsgTypeDecisionPolicies {
+		k.SetMsgTypeDecisionPolicy(ctx, policy)
+	}
+
+	k.setPreviousPaymentStreamID(ctx, data.PreviousPaymentStreamId)
+	for _, stream := range data.PaymentStreams {
+		k.setPaymentStream(ctx, stream)
+		k.addPaymentStreamToReleaseQueue(ctx, ctx.BlockTime(), stream.Id)
+	}
+
 	// init module accounts just in case
 	if acc := k.authKeeper.GetModuleAccount(ctx, foundation.ModuleName); acc == nil {
 		panic(fmt.Sprintf("failed to create module account=%s", foundation.ModuleName))
@@ -70,15 +85,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *foundation.GenesisState {
 	}
 
 	return &foundation.GenesisState{
-		Params:             k.GetParams(ctx),
-		Foundation:         info,
-		Members:            k.GetMembers(ctx),
-		PreviousProposalId: k.getPreviousProposalID(ctx),
-		Proposals:          proposals,
-		Votes:              votes,
-		Censorships:        k.GetCensorships(ctx),
-		Authorizations:     k.GetGrants(ctx),
-		Pool:               k.GetPool(ctx),
+		Params:                  k.GetParams(ctx),
+		Foundation:              info,
+		Members:                 k.GetMembers(ctx),
+		PreviousProposalId:      k.getPreviousProposalID(ctx),
+		Proposals:               proposals,
+		Votes:                   votes,
+		Censorships:             k.GetCensorships(ctx),
+		Authorizations:          k.GetGrants(ctx),
+		Pool:                    k.GetPool(ctx),
+		MsgTypeDecisionPolicies: k.GetMsgTypeDecisionPolicies(ctx),
+		PreviousPaymentStreamId: k.getPreviousPaymentStreamID(ctx),
+		PaymentStreams:          k.GetPaymentStreams(ctx),
 	}
 }
 
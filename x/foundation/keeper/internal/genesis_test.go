@@ -44,6 +44,7 @@ func TestImportExportGenesis(t *testing.T) {
 
 	member := createAddress()
 	stranger := createAddress()
+	timelockEnd := ctx.BlockTime().Add(time.Hour)
 
 	testCases := map[string]struct {
 		init   *foundation.GenesisState
@@ -84,6 +85,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
@@ -126,6 +128,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -151,6 +154,59 @@ func TestImportExportGenesis(t *testing.T) {
 				},
 			},
 		},
+		"timelocked proposals": {
+			init: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				PreviousProposalId: 1,
+				Proposals: []foundation.Proposal{
+					*foundation.Proposal{
+						Id:                1,
+						Proposers:         []string{member.String()},
+						FoundationVersion: 1,
+						Status:            foundation.PROPOSAL_STATUS_ACCEPTED,
+						FinalTallyResult:  foundation.NewTallyResult(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+						ExecutorResult:    foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+						TimelockEnd:       &timelockEnd,
+					}.WithMsgs([]sdk.Msg{testdata.NewTestMsg(authority)}),
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				PreviousProposalId: 1,
+				Proposals: []foundation.Proposal{
+					*foundation.Proposal{
+						Id:                1,
+						Proposers:         []string{member.String()},
+						FoundationVersion: 1,
+						Status:            foundation.PROPOSAL_STATUS_ACCEPTED,
+						FinalTallyResult:  foundation.NewTallyResult(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+						ExecutorResult:    foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+						TimelockEnd:       &timelockEnd,
+					}.WithMsgs([]sdk.Msg{testdata.NewTestMsg(authority)}),
+				},
+			},
+		},
 		"authorizations": {
 			init: &foundation.GenesisState{
 				Params:     foundation.DefaultParams(),
@@ -201,6 +257,80 @@ func TestImportExportGenesis(t *testing.T) {
 				},
 			},
 		},
+		"msg type decision policies": {
+			init: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				MsgTypeDecisionPolicies: []foundation.MsgTypeDecisionPolicy{
+					*foundation.MsgTypeDecisionPolicy{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+					}.WithDecisionPolicy(workingPolicy()),
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				MsgTypeDecisionPolicies: []foundation.MsgTypeDecisionPolicy{
+					*foundation.MsgTypeDecisionPolicy{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+					}.WithDecisionPolicy(workingPolicy()),
+				},
+			},
+		},
+		"payment streams": {
+			init: &foundation.GenesisState{
+				Params:                  foundation.DefaultParams(),
+				Foundation:              foundation.DefaultFoundation(),
+				PreviousPaymentStreamId: 2,
+				PaymentStreams: []foundation.PaymentStream{
+					{
+						Id:        2,
+						Recipient: member.String(),
+						Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+						Paid:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3))),
+						StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Period:    24 * time.Hour,
+					},
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params:                  foundation.DefaultParams(),
+				Foundation:              foundation.DefaultFoundation(),
+				PreviousPaymentStreamId: 2,
+				PaymentStreams: []foundation.PaymentStream{
+					{
+						Id:        2,
+						Recipient: member.String(),
+						Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+						Paid:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3))),
+						StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Period:    24 * time.Hour,
+					},
+				},
+			},
+		},
 		"member of long metadata": {
 			init: &foundation.GenesisState{
 				Params: foundation.DefaultParams(),
@@ -320,6 +450,7 @@ func TestShouldPanicWhenFailToGenerateFoundationModuleAccountInInitGenesis(t *te
 					app.MsgServiceRouter(),
 					tc.mockAccKeeper,
 					app.BankKeeper,
+					app.MintKeeper,
 					authtypes.FeeCollectorName,
 					foundation.DefaultConfig(),
 					foundation.DefaultAuthority().String(),
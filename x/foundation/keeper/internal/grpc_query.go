@@ -216,6 +216,50 @@ func (s queryServer) Censorships(c context.Context, req *foundation.QueryCensors
 	return &foundation.QueryCensorshipsResponse{Censorships: censorships, Pagination: pageRes}, nil
 }
 
+func (s queryServer) MsgTypeDecisionPolicies(c context.Context, req *foundation.QueryMsgTypeDecisionPoliciesRequest) (*foundation.QueryMsgTypeDecisionPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var policies []foundation.MsgTypeDecisionPolicy
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	policyStore := prefix.NewStore(store, msgTypeDecisionPolicyKeyPrefix)
+	pageRes, err := query.Paginate(policyStore, req.Pagination, func(key, value []byte) error {
+		var policy foundation.MsgTypeDecisionPolicy
+		s.keeper.cdc.MustUnmarshal(value, &policy)
+		policies = append(policies, policy)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryMsgTypeDecisionPoliciesResponse{DecisionPolicies: policies, Pagination: pageRes}, nil
+}
+
+func (s queryServer) PaymentStreams(c context.Context, req *foundation.QueryPaymentStreamsRequest) (*foundation.QueryPaymentStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var streams []foundation.PaymentStream
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	streamStore := prefix.NewStore(store, paymentStreamKeyPrefix)
+	pageRes, err := query.Paginate(streamStore, req.Pagination, func(key, value []byte) error {
+		var stream foundation.PaymentStream
+		s.keeper.cdc.MustUnmarshal(value, &stream)
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryPaymentStreamsResponse{PaymentStreams: streams, Pagination: pageRes}, nil
+}
+
 func (s queryServer) Grants(c context.Context, req *foundation.QueryGrantsRequest) (*foundation.QueryGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
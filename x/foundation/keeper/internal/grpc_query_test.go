@@ -41,6 +41,7 @@ func (s *FoundationTestSuite) SetupTest() {
 		s.app.MsgServiceRouter(),
 		s.app.AccountKeeper,
 		s.app.BankKeeper,
+		s.app.MintKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -64,6 +65,7 @@ func (s *FoundationTestSuite) TestQueryParams() {
 			func() {
 				params := foundation.Params{
 					FoundationTax: sdk.OneDec(),
+					InflationTax:  sdk.ZeroDec(),
 				}
 				s.impl.SetParams(s.ctx, params)
 
@@ -23,6 +23,7 @@ type Keeper struct {
 	// keepers
 	authKeeper foundation.AuthKeeper
 	bankKeeper foundation.BankKeeper
+	mintKeeper foundation.MintKeeper
 
 	feeCollectorName string
 
@@ -46,6 +47,7 @@ func NewKeeper(
 	router *baseapp.MsgServiceRouter,
 	authKeeper foundation.AuthKeeper,
 	bankKeeper foundation.BankKeeper,
+	mintKeeper foundation.MintKeeper,
 	feeCollectorName string,
 	config foundation.Config,
 	authority string,
@@ -71,6 +73,7 @@ func NewKeeper(
 		router:           router,
 		authKeeper:       authKeeper,
 		bankKeeper:       bankKeeper,
+		mintKeeper:       mintKeeper,
 		feeCollectorName: feeCollectorName,
 		config:           config,
 		authority:        authority,
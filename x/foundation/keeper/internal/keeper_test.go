@@ -17,6 +17,7 @@ import (
 	keeper "github.com/Finschia/finschia-sdk/x/foundation/keeper"
 	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
+	mintkeeper "github.com/Finschia/finschia-sdk/x/mint/keeper"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 )
 
@@ -28,6 +29,7 @@ type KeeperTestSuite struct {
 	ctx sdk.Context
 
 	bankKeeper foundation.BankKeeper
+	mintKeeper mintkeeper.Keeper
 	keeper     keeper.Keeper
 	impl       internal.Keeper
 
@@ -91,6 +93,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.bankKeeper = app.BankKeeper
+	s.mintKeeper = app.MintKeeper
 	s.keeper = app.FoundationKeeper
 	s.impl = internal.NewKeeper(
 		app.AppCodec(),
@@ -98,6 +101,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.MintKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -111,6 +115,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	s.impl.SetParams(s.ctx, foundation.Params{
 		FoundationTax: sdk.OneDec(),
+		InflationTax:  sdk.ZeroDec(),
 	})
 
 	s.impl.SetCensorship(s.ctx, foundation.Censorship{
@@ -126,6 +131,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	for i := range s.members {
 		member := foundation.Member{
 			Address: s.members[i].String(),
+			Weight:  sdk.OneDec(),
 		}
 		s.impl.SetMember(s.ctx, member)
 	}
@@ -281,7 +287,7 @@ func TestNewKeeper(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			newKeeper := func() keeper.Keeper {
 				app := simapp.Setup(false)
-				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
+				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, app.MintKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
 			}
 
 			if tc.panics {
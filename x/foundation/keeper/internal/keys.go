@@ -20,11 +20,20 @@ var (
 	proposalByVPEndKeyPrefix = []byte{0x13}
 	voteKeyPrefix            = []byte{0x14}
 
-	censorshipKeyPrefix = []byte{0x20}
-	grantKeyPrefix      = []byte{0x21}
+	msgTypeDecisionPolicyKeyPrefix = []byte{0x15}
+
+	proposalByTimelockEndKeyPrefix = []byte{0x16}
+
+	censorshipKeyPrefix             = []byte{0x20}
+	grantKeyPrefix                  = []byte{0x21}
+	censorshipByExpirationKeyPrefix = []byte{0x22}
 
 	poolKey = []byte{0x30}
 
+	previousPaymentStreamIDKey          = []byte{0x31}
+	paymentStreamKeyPrefix              = []byte{0x32}
+	paymentStreamByReleaseTimeKeyPrefix = []byte{0x33}
+
 	// deprecatedGovMintKey Deprecated. Don't use it again.
 	deprecatedGovMintKey = []byte{0x40}
 	_                    = deprecatedGovMintKey
@@ -68,6 +77,51 @@ func proposalKey(id uint64) []byte {
 	return key
 }
 
+// paymentStreamKey key for a specific payment stream from the store
+func paymentStreamKey(id uint64) []byte {
+	prefix := paymentStreamKeyPrefix
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+len(idBz))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], idBz)
+
+	return key
+}
+
+func paymentStreamByReleaseTimeKey(releaseTime time.Time, id uint64) []byte {
+	prefix := paymentStreamByReleaseTimeKeyPrefix
+	releaseTimeBz := sdk.FormatTimeBytes(releaseTime)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+lenTime+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], releaseTimeBz)
+
+	begin += len(releaseTimeBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+func splitPaymentStreamByReleaseTimeKey(key []byte) (releaseTime time.Time, id uint64) {
+	prefix := paymentStreamByReleaseTimeKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	releaseTime, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
 func voteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	prefix := voteKeyPrefix
 	idBz := Uint64ToBytes(proposalID)
@@ -118,6 +172,39 @@ func splitProposalByVPEndKey(key []byte) (vpEnd time.Time, id uint64) {
 	return
 }
 
+func proposalByTimelockEndKey(timelockEnd time.Time, id uint64) []byte {
+	prefix := proposalByTimelockEndKeyPrefix
+	timelockEndBz := sdk.FormatTimeBytes(timelockEnd)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+lenTime+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], timelockEndBz)
+
+	begin += len(timelockEndBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+func splitProposalByTimelockEndKey(key []byte) (timelockEnd time.Time, id uint64) {
+	prefix := proposalByTimelockEndKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	timelockEnd, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
 // memberKey key for a specific member from the store
 func censorshipKey(url string) []byte {
 	prefix := censorshipKeyPrefix
@@ -129,6 +216,48 @@ func censorshipKey(url string) []byte {
 	return key
 }
 
+func censorshipByExpirationKey(expiration time.Time, url string) []byte {
+	prefix := censorshipByExpirationKeyPrefix
+	expirationBz := sdk.FormatTimeBytes(expiration)
+	key := make([]byte, len(prefix)+lenTime+len(url))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], expirationBz)
+
+	begin += len(expirationBz)
+	copy(key[begin:], url)
+
+	return key
+}
+
+func splitCensorshipByExpirationKey(key []byte) (expiration time.Time, url string) {
+	prefix := censorshipByExpirationKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	expiration, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	url = string(key[begin:])
+
+	return
+}
+
+func msgTypeDecisionPolicyKey(url string) []byte {
+	prefix := msgTypeDecisionPolicyKeyPrefix
+	key := make([]byte, len(prefix)+len(url))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], url)
+
+	return key
+}
+
 func grantKey(grantee sdk.AccAddress, url string) []byte {
 	prefix := grantKeyPrefixByGrantee(grantee)
 	key := make([]byte, len(prefix)+len(url))
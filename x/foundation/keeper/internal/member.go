@@ -59,6 +59,7 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 			Address:  request.Address,
 			Metadata: request.Metadata,
 			AddedAt:  ctx.BlockTime(),
+			Weight:   request.GetWeight(),
 		}
 		if err := new.ValidateBasic(); err != nil {
 			panic(err)
@@ -73,14 +74,14 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 			return err
 		}
 		if err == nil { // overwrite
-			weightUpdate = weightUpdate.Sub(sdk.OneDec())
+			weightUpdate = weightUpdate.Sub(old.Weight)
 			new.AddedAt = old.AddedAt
 		}
 
 		if request.Remove {
 			k.deleteMember(ctx, addr)
 		} else {
-			weightUpdate = weightUpdate.Add(sdk.OneDec())
+			weightUpdate = weightUpdate.Add(new.Weight)
 			k.SetMember(ctx, new)
 		}
 	}
@@ -90,7 +91,7 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 	info.Version++
 	k.SetFoundationInfo(ctx, info)
 
-	if err := info.GetDecisionPolicy().Validate(info, k.config); err != nil {
+	if err := k.validateDecisionPolicies(ctx, info); err != nil {
 		return err
 	}
 
@@ -179,6 +180,22 @@ func (k Keeper) validateCensorshipAuthority(ctx sdk.Context, msgTypeURL, authori
 	return nil
 }
 
+// validateVetoAuthority checks that the authority is either x/gov or the
+// censorship authority over any message of the proposal.
+func (k Keeper) validateVetoAuthority(ctx sdk.Context, proposal foundation.Proposal, authority string) error {
+	if authority == authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		return nil
+	}
+
+	for _, msg := range proposal.GetMsgs() {
+		if err := k.validateCensorshipAuthority(ctx, sdk.MsgTypeURL(msg), authority); err == nil {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("%s cannot veto proposal %d", authority, proposal.Id)
+}
+
 func (k Keeper) validateMembers(ctx sdk.Context, members []string) error {
 	for _, member := range members {
 		addr := sdk.MustAccAddressFromBech32(member)
@@ -48,9 +48,13 @@ func (s *KeeperTestSuite) TestUpdateDecisionPolicy() {
 }
 
 func (s *KeeperTestSuite) TestUpdateMembers() {
+	numMembers := int64(len(s.members))
+	weight := sdk.NewDec(3)
+
 	testCases := map[string]struct {
-		updates []foundation.MemberRequest
-		valid   bool
+		updates     []foundation.MemberRequest
+		valid       bool
+		totalWeight sdk.Dec
 	}{
 		"add a new member": {
 			updates: []foundation.MemberRequest{
@@ -58,7 +62,28 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 					Address: s.stranger.String(),
 				},
 			},
-			valid: true,
+			valid:       true,
+			totalWeight: sdk.NewDec(numMembers + 1),
+		},
+		"add a new member with weight": {
+			updates: []foundation.MemberRequest{
+				{
+					Address: s.stranger.String(),
+					Weight:  &weight,
+				},
+			},
+			valid:       true,
+			totalWeight: sdk.NewDec(numMembers + 3),
+		},
+		"update the weight of a member": {
+			updates: []foundation.MemberRequest{
+				{
+					Address: s.members[0].String(),
+					Weight:  &weight,
+				},
+			},
+			valid:       true,
+			totalWeight: sdk.NewDec(numMembers + 2),
 		},
 		"remove a member": {
 			updates: []foundation.MemberRequest{
@@ -67,7 +92,8 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 					Remove:  true,
 				},
 			},
-			valid: true,
+			valid:       true,
+			totalWeight: sdk.NewDec(numMembers - 1),
 		},
 		"remove a non-member": {
 			updates: []foundation.MemberRequest{
@@ -92,11 +118,14 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 			ctx, _ := s.ctx.CacheContext()
 
 			err := s.impl.UpdateMembers(ctx, tc.updates)
-			if tc.valid {
-				s.Require().NoError(err)
-			} else {
+			if !tc.valid {
 				s.Require().Error(err)
+				return
 			}
+			s.Require().NoError(err)
+
+			info := s.impl.GetFoundationInfo(ctx)
+			s.Require().Equal(tc.totalWeight, info.TotalWeight)
 		})
 	}
 }
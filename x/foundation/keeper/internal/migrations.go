@@ -5,6 +5,9 @@ import (
 	"github.com/Finschia/finschia-sdk/types/module"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	v2 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v2"
+	v3 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v3"
+	v4 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v4"
+	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,7 +23,13 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Register(register func(moduleName string, fromVersion uint64, handler module.MigrationHandler) error) error {
 	for fromVersion, handler := range map[uint64]module.MigrationHandler{
 		1: func(ctx sdk.Context) error {
-			return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace)
+			return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, v2Subspace{m.keeper.paramSpace})
+		},
+		2: func(ctx sdk.Context) error {
+			return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+		},
+		3: func(ctx sdk.Context) error {
+			return v4.MigrateStore(ctx, m.keeper.paramSpace)
 		},
 	} {
 		if err := register(foundation.ModuleName, fromVersion, handler); err != nil {
@@ -30,3 +39,14 @@ func (m Migrator) Register(register func(moduleName string, fromVersion uint64,
 
 	return nil
 }
+
+// v2Subspace sets the params which existed at v2 only. The params introduced
+// later are not in the store yet, and are set by the corresponding migrations.
+type v2Subspace struct {
+	paramtypes.Subspace
+}
+
+func (s v2Subspace) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	params := ps.(*foundation.Params)
+	s.Set(ctx, []byte(foundation.ParamKeyFoundationTax), params.FoundationTax)
+}
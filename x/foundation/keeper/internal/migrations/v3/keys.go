@@ -0,0 +1,6 @@
+package v3
+
+var (
+	FoundationInfoKey = []byte{0x01}
+	MemberKeyPrefix   = []byte{0x10}
+)
@@ -0,0 +1,75 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// migrate member weights
+	totalWeight, err := migrateMemberWeights(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	return validateTotalWeight(store, cdc, totalWeight)
+}
+
+// migrateMemberWeights sets the weight of 1 on the existing members, and returns the total weight.
+func migrateMemberWeights(store storetypes.KVStore, cdc codec.BinaryCodec) (sdk.Dec, error) {
+	iterator := sdk.KVStorePrefixIterator(store, MemberKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	var members []foundation.Member
+	totalWeight := sdk.ZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		var member foundation.Member
+		if err := cdc.Unmarshal(iterator.Value(), &member); err != nil {
+			return sdk.Dec{}, err
+		}
+
+		if member.Weight.IsNil() || member.Weight.IsZero() {
+			member.Weight = sdk.OneDec()
+		}
+		totalWeight = totalWeight.Add(member.Weight)
+		keys = append(keys, iterator.Key())
+		members = append(members, member)
+	}
+
+	for i := range members {
+		bz, err := cdc.Marshal(&members[i])
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		store.Set(keys[i], bz)
+	}
+
+	return totalWeight, nil
+}
+
+// validateTotalWeight checks the total weight of the foundation, which has been the number of the members.
+func validateTotalWeight(store storetypes.KVStore, cdc codec.BinaryCodec, totalWeight sdk.Dec) error {
+	bz := store.Get(FoundationInfoKey)
+	if bz == nil {
+		return fmt.Errorf("foundation info not found")
+	}
+
+	var info foundation.FoundationInfo
+	if err := cdc.Unmarshal(bz, &info); err != nil {
+		return err
+	}
+
+	if !info.TotalWeight.Equal(totalWeight) {
+		return fmt.Errorf("total weight not match, %s != %s", info.TotalWeight, totalWeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	encCfg := simappparams.MakeTestEncodingConfig()
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	setMembers := func(ctx sdk.Context) {
+		// set members without weight
+		store := ctx.KVStore(foundationKey)
+		for _, addr := range addrs {
+			bz := encCfg.Marshaler.MustMarshal(&foundation.Member{
+				Address: addr.String(),
+			})
+			store.Set(append(v3.MemberKeyPrefix, addr...), bz)
+		}
+	}
+	setInfo := func(ctx sdk.Context, totalWeight sdk.Dec) {
+		bz := encCfg.Marshaler.MustMarshal(&foundation.FoundationInfo{
+			Version:     1,
+			TotalWeight: totalWeight,
+		})
+		store := ctx.KVStore(foundationKey)
+		store.Set(v3.FoundationInfoKey, bz)
+	}
+
+	for name, tc := range map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"valid": {
+			malleate: func(ctx sdk.Context) {
+				setMembers(ctx)
+				setInfo(ctx, sdk.NewDec(int64(len(addrs))))
+			},
+			valid: true,
+		},
+		"no foundation info found": {
+			malleate: setMembers,
+		},
+		"total weight mismatch": {
+			malleate: func(ctx sdk.Context) {
+				setMembers(ctx)
+				setInfo(ctx, sdk.NewDec(int64(len(addrs)+1)))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// migrate
+			err := v3.MigrateStore(ctx, foundationKey, encCfg.Marshaler)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			store := ctx.KVStore(foundationKey)
+			for _, addr := range addrs {
+				bz := store.Get(append(v3.MemberKeyPrefix, addr...))
+				require.NotNil(t, bz)
+
+				var member foundation.Member
+				encCfg.Marshaler.MustUnmarshal(bz, &member)
+				require.Equal(t, sdk.OneDec(), member.Weight)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package v4
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+func MigrateStore(ctx sdk.Context, subspace Subspace) error {
+	// set the new params, which do not change the tax
+	subspace.Set(ctx, []byte(foundation.ParamKeyDenomTaxes), []foundation.DenomTax{})
+	subspace.Set(ctx, []byte(foundation.ParamKeyInflationTax), sdk.ZeroDec())
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v4"
+)
+
+type mockSubspace struct {
+	params map[string]interface{}
+}
+
+func (ms *mockSubspace) Set(ctx sdk.Context, key []byte, value interface{}) {
+	if ms.params == nil {
+		ms.params = map[string]interface{}{}
+	}
+	ms.params[string(key)] = value
+}
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	subspace := &mockSubspace{}
+	err := v4.MigrateStore(ctx, subspace)
+	require.NoError(t, err)
+
+	require.Equal(t, []foundation.DenomTax{}, subspace.params[foundation.ParamKeyDenomTaxes])
+	require.Equal(t, sdk.ZeroDec(), subspace.params[foundation.ParamKeyInflationTax])
+}
@@ -0,0 +1,55 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+	v2 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v2"
+)
+
+func TestMigrateFromV1(t *testing.T) {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	impl := internal.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(foundation.ModuleName),
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.MintKeeper,
+		authtypes.FeeCollectorName,
+		foundation.DefaultConfig(),
+		foundation.DefaultAuthority().String(),
+		app.GetSubspace(foundation.ModuleName),
+	)
+
+	// v1 keeps the params of its time in the store
+	tax := sdk.NewDecWithPrec(2, 1)
+	store := ctx.KVStore(app.GetKey(foundation.ModuleName))
+	store.Set(v2.ParamsKey, app.AppCodec().MustMarshal(&foundation.Params{FoundationTax: tax}))
+
+	handlers := map[uint64]module.MigrationHandler{}
+	err := internal.NewMigrator(impl).Register(func(_ string, fromVersion uint64, handler module.MigrationHandler) error {
+		handlers[fromVersion] = handler
+		return nil
+	})
+	require.NoError(t, err)
+
+	for version := uint64(1); version <= uint64(len(handlers)); version++ {
+		require.NoError(t, handlers[version](ctx), version)
+	}
+
+	params := impl.GetParams(ctx)
+	require.Equal(t, tax, params.FoundationTax)
+	require.Empty(t, params.DenomTaxes)
+	require.True(t, sdk.ZeroDec().Equal(params.InflationTax))
+}
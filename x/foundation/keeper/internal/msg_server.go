@@ -231,6 +231,32 @@ func (s msgServer) Exec(c context.Context, req *foundation.MsgExec) (*foundation
 	return &foundation.MsgExecResponse{}, nil
 }
 
+func (s msgServer) VetoProposal(c context.Context, req *foundation.MsgVetoProposal) (*foundation.MsgVetoProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, err := s.keeper.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.validateVetoAuthority(ctx, *proposal, req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.VetoProposal(ctx, req.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventVetoProposal{
+		ProposalId: req.ProposalId,
+		Authority:  req.Authority,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgVetoProposalResponse{}, nil
+}
+
 func (s msgServer) LeaveFoundation(c context.Context, req *foundation.MsgLeaveFoundation) (*foundation.MsgLeaveFoundationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -276,6 +302,63 @@ func (s msgServer) UpdateCensorship(c context.Context, req *foundation.MsgUpdate
 	return &foundation.MsgUpdateCensorshipResponse{}, nil
 }
 
+func (s msgServer) UpdateMsgTypeDecisionPolicy(c context.Context, req *foundation.MsgUpdateMsgTypeDecisionPolicy) (*foundation.MsgUpdateMsgTypeDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	policy := req.GetDecisionPolicy()
+	if err := s.keeper.UpdateMsgTypeDecisionPolicy(ctx, req.MsgTypeUrl, policy); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventUpdateMsgTypeDecisionPolicy{
+		MsgTypeUrl:     req.MsgTypeUrl,
+		DecisionPolicy: req.DecisionPolicy,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgUpdateMsgTypeDecisionPolicyResponse{}, nil
+}
+
+func (s msgServer) CreatePaymentStream(c context.Context, req *foundation.MsgCreatePaymentStream) (*foundation.MsgCreatePaymentStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := s.keeper.CreatePaymentStream(ctx, foundation.PaymentStream{
+		Recipient: req.Recipient,
+		Amount:    req.Amount,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Period:    req.Period,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.MsgCreatePaymentStreamResponse{Id: *id}, nil
+}
+
+func (s msgServer) CancelPaymentStream(c context.Context, req *foundation.MsgCancelPaymentStream) (*foundation.MsgCancelPaymentStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.CancelPaymentStream(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &foundation.MsgCancelPaymentStreamResponse{}, nil
+}
+
 func (s msgServer) Grant(c context.Context, req *foundation.MsgGrant) (*foundation.MsgGrantResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
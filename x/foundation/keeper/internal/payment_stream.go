@@ -0,0 +1,233 @@
+package internal
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (k Keeper) getPreviousPaymentStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(previousPaymentStreamIDKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return Uint64FromBytes(bz)
+}
+
+func (k Keeper) setPreviousPaymentStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(previousPaymentStreamIDKey, Uint64ToBytes(id))
+}
+
+// CreatePaymentStream creates a stream of payments from the treasury.
+// The recipient must be authorized to receive from the treasury, and each
+// payment is accepted by the authorization when it is paid.
+func (k Keeper) CreatePaymentStream(ctx sdk.Context, stream foundation.PaymentStream) (*uint64, error) {
+	if !stream.EndTime.After(ctx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("end time must be after the block time")
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+	if msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)); k.IsCensoredMessage(ctx, msgTypeURL) {
+		if _, err := k.GetAuthorization(ctx, recipient, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	id := k.getPreviousPaymentStreamID(ctx) + 1
+	k.setPreviousPaymentStreamID(ctx, id)
+
+	stream.Id = id
+	stream.Paid = nil
+	k.setPaymentStream(ctx, stream)
+	k.addPaymentStreamToReleaseQueue(ctx, ctx.BlockTime(), id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCreatePaymentStream{
+		PaymentStream: stream,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &id, nil
+}
+
+// CancelPaymentStream removes the payment stream, so the coins which have not
+// been paid yet remain in the treasury. Its entry in the release queue is
+// removed by ReleasePaymentStreams when it comes due.
+func (k Keeper) CancelPaymentStream(ctx sdk.Context, id uint64) error {
+	if _, err := k.GetPaymentStream(ctx, id); err != nil {
+		return err
+	}
+	k.deletePaymentStream(ctx, id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCancelPaymentStream{
+		Id: id,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// ReleasePaymentStreams pays the coins released by the payment streams whose
+// release time has come. If the treasury lacks the coins, or the authorization
+// of the recipient does not accept the payment, the payment is deferred to the
+// next block.
+func (k Keeper) ReleasePaymentStreams(ctx sdk.Context) {
+	type queueEntry struct {
+		releaseTime time.Time
+		id          uint64
+	}
+	var entries []queueEntry
+	now := ctx.BlockTime()
+	k.iteratePaymentStreamsByReleaseTime(ctx, now, func(releaseTime time.Time, id uint64) (stop bool) {
+		entries = append(entries, queueEntry{releaseTime: releaseTime, id: id})
+		return false
+	})
+
+	for _, entry := range entries {
+		stream, err := k.GetPaymentStream(ctx, entry.id)
+		if err != nil {
+			// the stream has been canceled
+			k.removePaymentStreamFromReleaseQueue(ctx, entry.releaseTime, entry.id)
+			continue
+		}
+
+		due := stream.Released(now).Sub(stream.Paid)
+		if due.IsZero() {
+			k.removePaymentStreamFromReleaseQueue(ctx, entry.releaseTime, entry.id)
+			k.addPaymentStreamToReleaseQueue(ctx, nextReleaseTime(*stream, now), entry.id)
+			continue
+		}
+
+		// Caching context so that we don't update the store in case of failure.
+		recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+		cacheCtx, flush := ctx.CacheContext()
+		if err := k.Accept(cacheCtx, recipient, &foundation.MsgWithdrawFromTreasury{
+			Authority: k.GetAuthority(),
+			To:        stream.Recipient,
+			Amount:    due,
+		}); err != nil {
+			k.Logger(ctx).Debug("payment stream deferred", "cause", err, "id", stream.Id)
+			continue
+		}
+		if err := k.WithdrawFromTreasury(cacheCtx, recipient, due); err != nil {
+			k.Logger(ctx).Debug("payment stream deferred", "cause", err, "id", stream.Id)
+			continue
+		}
+		flush()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		k.removePaymentStreamFromReleaseQueue(ctx, entry.releaseTime, entry.id)
+		stream.Paid = stream.Paid.Add(due...)
+		if stream.Paid.IsAllGTE(stream.Amount) {
+			k.deletePaymentStream(ctx, stream.Id)
+		} else {
+			k.setPaymentStream(ctx, *stream)
+			k.addPaymentStreamToReleaseQueue(ctx, nextReleaseTime(*stream, now), stream.Id)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&foundation.EventReleasePaymentStream{
+			Id:        stream.Id,
+			Recipient: stream.Recipient,
+			Amount:    due,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// nextReleaseTime returns the earliest time after now at which the stream may
+// release more coins. The linear streams release coins at every block, so the
+// time is now for them.
+func nextReleaseTime(stream foundation.PaymentStream, now time.Time) time.Time {
+	if now.Before(stream.StartTime) {
+		now = stream.StartTime
+	}
+	if stream.Period == 0 {
+		return now
+	}
+
+	installments := now.Sub(stream.StartTime)/stream.Period + 1
+	next := stream.StartTime.Add(installments * stream.Period)
+	if next.After(stream.EndTime) {
+		return stream.EndTime
+	}
+
+	return next
+}
+
+func (k Keeper) GetPaymentStream(ctx sdk.Context, id uint64) (*foundation.PaymentStream, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(paymentStreamKey(id))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No payment stream for id: %d", id)
+	}
+
+	var stream foundation.PaymentStream
+	k.cdc.MustUnmarshal(bz, &stream)
+
+	return &stream, nil
+}
+
+func (k Keeper) setPaymentStream(ctx sdk.Context, stream foundation.PaymentStream) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(paymentStreamKey(stream.Id), bz)
+}
+
+func (k Keeper) deletePaymentStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(paymentStreamKey(id))
+}
+
+func (k Keeper) iteratePaymentStreams(ctx sdk.Context, fn func(stream foundation.PaymentStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, paymentStreamKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var stream foundation.PaymentStream
+		k.cdc.MustUnmarshal(iter.Value(), &stream)
+
+		if stop := fn(stream); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) addPaymentStreamToReleaseQueue(ctx sdk.Context, releaseTime time.Time, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(paymentStreamByReleaseTimeKey(releaseTime, id), []byte{})
+}
+
+func (k Keeper) removePaymentStreamFromReleaseQueue(ctx sdk.Context, releaseTime time.Time, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(paymentStreamByReleaseTimeKey(releaseTime, id))
+}
+
+func (k Keeper) iteratePaymentStreamsByReleaseTime(ctx sdk.Context, endTime time.Time, fn func(releaseTime time.Time, id uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(paymentStreamByReleaseTimeKeyPrefix, sdk.PrefixEndBytes(append(paymentStreamByReleaseTimeKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		releaseTime, id := splitPaymentStreamByReleaseTimeKey(iter.Key())
+		if fn(releaseTime, id) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPaymentStreams(ctx sdk.Context) []foundation.PaymentStream {
+	var streams []foundation.PaymentStream
+	k.iteratePaymentStreams(ctx, func(stream foundation.PaymentStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	return streams
+}
@@ -0,0 +1,255 @@
+package internal_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (s *KeeperTestSuite) TestCreatePaymentStream() {
+	testCases := map[string]struct {
+		recipient sdk.AccAddress
+		endTime   time.Duration
+		valid     bool
+	}{
+		"valid request": {
+			recipient: s.stranger,
+			endTime:   time.Hour,
+			valid:     true,
+		},
+		"recipient not authorized": {
+			recipient: s.members[0],
+			endTime:   time.Hour,
+		},
+		"already ended": {
+			recipient: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			stream := foundation.PaymentStream{
+				Recipient: tc.recipient.String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)),
+				StartTime: ctx.BlockTime(),
+				EndTime:   ctx.BlockTime().Add(tc.endTime),
+			}
+			id, err := s.impl.CreatePaymentStream(ctx, stream)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			created, err := s.impl.GetPaymentStream(ctx, *id)
+			s.Require().NoError(err)
+			stream.Id = *id
+			s.Require().Equal(stream, *created)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelPaymentStream() {
+	testCases := map[string]struct {
+		create bool
+		valid  bool
+	}{
+		"valid request": {
+			create: true,
+			valid:  true,
+		},
+		"no such a stream": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			id := uint64(1)
+			if tc.create {
+				created, err := s.impl.CreatePaymentStream(ctx, foundation.PaymentStream{
+					Recipient: s.stranger.String(),
+					Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)),
+					StartTime: ctx.BlockTime(),
+					EndTime:   ctx.BlockTime().Add(time.Hour),
+				})
+				s.Require().NoError(err)
+				id = *created
+			}
+
+			err := s.impl.CancelPaymentStream(ctx, id)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.impl.GetPaymentStream(ctx, id)
+			s.Require().Error(err)
+
+			// the canceled stream pays nothing
+			before := s.bankKeeper.GetAllBalances(ctx, s.stranger)
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+			s.impl.ReleasePaymentStreams(ctx)
+			s.Require().Equal(before, s.bankKeeper.GetAllBalances(ctx, s.stranger))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReleasePaymentStreams() {
+	testCases := map[string]struct {
+		amount   sdk.Int
+		period   time.Duration
+		elapsed  time.Duration
+		paid     sdk.Int
+		finished bool
+	}{
+		"linear, not started": {
+			amount: s.balance,
+			paid:   sdk.ZeroInt(),
+		},
+		"linear, in the middle": {
+			amount:  s.balance,
+			elapsed: 5 * time.Hour,
+			paid:    s.balance.QuoRaw(2),
+		},
+		"linear, finished": {
+			amount:   s.balance,
+			elapsed:  10 * time.Hour,
+			paid:     s.balance,
+			finished: true,
+		},
+		"installments, before the first": {
+			amount:  s.balance,
+			period:  5 * time.Hour,
+			elapsed: 5*time.Hour - 1,
+			paid:    sdk.ZeroInt(),
+		},
+		"installments, at the first": {
+			amount:  s.balance,
+			period:  5 * time.Hour,
+			elapsed: 5 * time.Hour,
+			paid:    s.balance.QuoRaw(2),
+		},
+		"insufficient treasury": {
+			amount:  s.balance.MulRaw(2),
+			elapsed: 10 * time.Hour,
+			paid:    sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			id, err := s.impl.CreatePaymentStream(ctx, foundation.PaymentStream{
+				Recipient: s.stranger.String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.amount)),
+				StartTime: ctx.BlockTime(),
+				EndTime:   ctx.BlockTime().Add(10 * time.Hour),
+				Period:    tc.period,
+			})
+			s.Require().NoError(err)
+
+			before := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed))
+			s.impl.ReleasePaymentStreams(ctx)
+
+			after := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+			s.Require().True(tc.paid.Equal(after.Sub(before)), after.Sub(before))
+
+			stream, err := s.impl.GetPaymentStream(ctx, *id)
+			if tc.finished {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tc.paid.Equal(stream.Paid.AmountOf(sdk.DefaultBondDenom)), stream.Paid)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReleasePaymentStreamsOverBlocks() {
+	ctx, _ := s.ctx.CacheContext()
+	start := ctx.BlockTime()
+
+	id, err := s.impl.CreatePaymentStream(ctx, foundation.PaymentStream{
+		Recipient: s.stranger.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)),
+		StartTime: start,
+		EndTime:   start.Add(10 * time.Hour),
+		Period:    5 * time.Hour,
+	})
+	s.Require().NoError(err)
+
+	before := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+	for _, step := range []struct {
+		elapsed time.Duration
+		paid    sdk.Int
+	}{
+		{elapsed: time.Hour, paid: sdk.ZeroInt()},
+		{elapsed: 5*time.Hour - 1, paid: sdk.ZeroInt()},
+		{elapsed: 5 * time.Hour, paid: s.balance.QuoRaw(2)},
+		{elapsed: 6 * time.Hour, paid: s.balance.QuoRaw(2)},
+		{elapsed: 10 * time.Hour, paid: s.balance},
+	} {
+		ctx = ctx.WithBlockTime(start.Add(step.elapsed))
+		s.impl.ReleasePaymentStreams(ctx)
+
+		after := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+		s.Require().True(step.paid.Equal(after.Sub(before)), after.Sub(before))
+	}
+
+	_, err = s.impl.GetPaymentStream(ctx, *id)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestReleasePaymentStreamsPeriodicLimit() {
+	ctx, _ := s.ctx.CacheContext()
+	start := ctx.BlockTime()
+
+	// the limit covers a single installment in a period
+	limit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.Sub(s.balance.QuoRaw(2))))
+	err := s.impl.Revoke(ctx, s.stranger, sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)))
+	s.Require().NoError(err)
+	err = s.impl.Grant(ctx, s.stranger, &foundation.ReceiveFromTreasuryAuthorization{
+		Period:             12 * time.Hour,
+		PeriodReceiveLimit: limit,
+		PeriodCanReceive:   limit,
+		PeriodReset:        start.Add(12 * time.Hour),
+	})
+	s.Require().NoError(err)
+
+	id, err := s.impl.CreatePaymentStream(ctx, foundation.PaymentStream{
+		Recipient: s.stranger.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)),
+		StartTime: start,
+		EndTime:   start.Add(10 * time.Hour),
+		Period:    5 * time.Hour,
+	})
+	s.Require().NoError(err)
+
+	before := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+	for _, step := range []struct {
+		elapsed time.Duration
+		paid    sdk.Int
+	}{
+		{elapsed: 5 * time.Hour, paid: s.balance.QuoRaw(2)},
+		// deferred until the period resets
+		{elapsed: 10 * time.Hour, paid: s.balance.QuoRaw(2)},
+		{elapsed: 15 * time.Hour, paid: s.balance},
+	} {
+		ctx = ctx.WithBlockTime(start.Add(step.elapsed))
+		s.impl.ReleasePaymentStreams(ctx)
+
+		after := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+		s.Require().True(step.paid.Equal(after.Sub(before)), after.Sub(before))
+	}
+
+	_, err = s.impl.GetPaymentStream(ctx, *id)
+	s.Require().Error(err)
+}
@@ -41,9 +41,16 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata str
 	}
 
 	// Prevent proposal that can not succeed.
-	policy := foundationInfo.GetDecisionPolicy()
-	if err := policy.Validate(foundationInfo, k.config); err != nil {
-		return nil, err
+	var votingPeriod time.Duration
+	for _, policy := range k.decisionPoliciesOf(ctx, foundationInfo, msgs) {
+		if err := policy.Validate(foundationInfo, k.config); err != nil {
+			return nil, err
+		}
+
+		// the longest voting period applies
+		if period := policy.GetVotingPeriod(); period > votingPeriod {
+			votingPeriod = period
+		}
 	}
 
 	id := k.newProposalID(ctx)
@@ -55,7 +62,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata str
 		FoundationVersion: foundationInfo.Version,
 		Status:            foundation.PROPOSAL_STATUS_SUBMITTED,
 		ExecutorResult:    foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
-		VotingPeriodEnd:   ctx.BlockTime().Add(policy.GetVotingPeriod()),
+		VotingPeriodEnd:   ctx.BlockTime().Add(votingPeriod),
 		FinalTallyResult:  foundation.DefaultTallyResult(),
 	}
 	if err := proposal.SetMsgs(msgs); err != nil {
@@ -85,10 +92,56 @@ func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+func (k Keeper) VetoProposal(ctx sdk.Context, proposalID uint64) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the proposal is under its timelock.
+	if proposal.Status != foundation.PROPOSAL_STATUS_ACCEPTED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot veto a proposal with the status of %s", proposal.Status)
+	}
+	if proposal.TimelockEnd == nil || !ctx.BlockTime().Before(*proposal.TimelockEnd) {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot veto a proposal out of its timelock")
+	}
+
+	k.removeProposalFromTimelockQueue(ctx, *proposal)
+
+	proposal.Status = foundation.PROPOSAL_STATUS_VETOED
+	k.setProposal(ctx, *proposal)
+
+	return nil
+}
+
+// lockProposal sets the timelock on the accepted proposal, according to the
+// longest execution delay of the decision policies.
+func (k Keeper) lockProposal(ctx sdk.Context, proposal *foundation.Proposal, policies []foundation.DecisionPolicy) {
+	// the timelock has been already set
+	if proposal.TimelockEnd != nil {
+		return
+	}
+
+	var delay time.Duration
+	for _, policy := range policies {
+		if d := policy.GetExecutionDelay(); d > delay {
+			delay = d
+		}
+	}
+	if delay == 0 {
+		return
+	}
+
+	timelockEnd := ctx.BlockTime().Add(delay)
+	proposal.TimelockEnd = &timelockEnd
+	k.addProposalToTimelockQueue(ctx, *proposal)
+}
+
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx sdk.Context, proposal foundation.Proposal) {
 	k.pruneVotes(ctx, proposal.Id)
 	k.removeProposalFromVPEndQueue(ctx, proposal)
+	k.removeProposalFromTimelockQueue(ctx, proposal)
 	k.deleteProposal(ctx, proposal.Id)
 }
 
@@ -172,6 +225,25 @@ func (k Keeper) iterateProposalsByVPEnd(ctx sdk.Context, endTime time.Time, fn f
 	}
 }
 
+func (k Keeper) iterateProposalsByTimelockEnd(ctx sdk.Context, endTime time.Time, fn func(proposal foundation.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(proposalByTimelockEndKeyPrefix, sdk.PrefixEndBytes(append(proposalByTimelockEndKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalByTimelockEndKey(iter.Key())
+
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*proposal) {
+			break
+		}
+	}
+}
+
 func (k Keeper) UpdateTallyOfVPEndProposals(ctx sdk.Context) {
 	var proposals []foundation.Proposal
 	k.iterateProposalsByVPEnd(ctx, ctx.BlockTime(), func(proposal foundation.Proposal) (stop bool) {
@@ -180,7 +252,7 @@ func (k Keeper) UpdateTallyOfVPEndProposals(ctx sdk.Context) {
 	})
 
 	for _, proposal := range proposals {
-		if proposal.Status == foundation.PROPOSAL_STATUS_ABORTED || proposal.Status == foundation.PROPOSAL_STATUS_WITHDRAWN {
+		if proposal.Status == foundation.PROPOSAL_STATUS_ABORTED || proposal.Status == foundation.PROPOSAL_STATUS_WITHDRAWN || proposal.Status == foundation.PROPOSAL_STATUS_VETOED {
 			k.pruneProposal(ctx, proposal)
 			continue
 		}
@@ -232,6 +304,22 @@ func (k Keeper) removeProposalFromVPEndQueue(ctx sdk.Context, proposal foundatio
 	store.Delete(key)
 }
 
+func (k Keeper) addProposalToTimelockQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByTimelockEndKey(*proposal.TimelockEnd, proposal.Id)
+	store.Set(key, []byte{})
+}
+
+func (k Keeper) removeProposalFromTimelockQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	if proposal.TimelockEnd == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByTimelockEndKey(*proposal.TimelockEnd, proposal.Id)
+	store.Delete(key)
+}
+
 func validateActorForProposal(address string, proposal foundation.Proposal) error {
 	for _, proposer := range proposal.Proposers {
 		if address == proposer {
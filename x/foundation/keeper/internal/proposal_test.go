@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -83,6 +84,60 @@ func (s *KeeperTestSuite) TestWithdrawProposal() {
 	}
 }
 
+func (s *KeeperTestSuite) TestVetoProposal() {
+	delay := time.Hour
+
+	testCases := map[string]struct {
+		elapsed time.Duration
+		valid   bool
+	}{
+		"within the timelock": {
+			elapsed: delay - time.Nanosecond,
+			valid:   true,
+		},
+		"timelock ended": {
+			elapsed: delay,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			id := s.acceptTimelockedProposal(ctx, delay)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed))
+			err := s.impl.VetoProposal(ctx, id)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			proposal, err := s.impl.GetProposal(ctx, id)
+			s.Require().NoError(err)
+			s.Require().Equal(foundation.PROPOSAL_STATUS_VETOED, proposal.Status)
+
+			// vetoed proposal cannot be executed
+			err = s.impl.Exec(ctx, id)
+			s.Require().Error(err)
+		})
+	}
+
+	// proposals without timelock
+	for name, id := range map[string]uint64{
+		"active proposal":    s.activeProposal,
+		"withdrawn proposal": s.withdrawnProposal,
+		"no such a proposal": s.nextProposal,
+	} {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.VetoProposal(ctx, id)
+			s.Require().Error(err)
+		})
+	}
+}
+
 func TestAbortProposal(t *testing.T) {
 	checkTx := false
 	app := simapp.Setup(checkTx)
@@ -95,6 +150,7 @@ func TestAbortProposal(t *testing.T) {
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.MintKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -113,6 +169,7 @@ func TestAbortProposal(t *testing.T) {
 	}
 	impl.SetMember(ctx, foundation.Member{
 		Address: members[0].String(),
+		Weight:  sdk.OneDec(),
 	})
 
 	info := foundation.DefaultFoundation()
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -16,9 +18,9 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 	}
 
 	info := k.GetFoundationInfo(ctx)
-	policy := info.GetDecisionPolicy()
+	policies := k.decisionPoliciesOf(ctx, info, p.GetMsgs())
 	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
-	result, err := policy.Allow(tallyResult, info.TotalWeight, sinceSubmission)
+	result, err := allowByPolicies(policies, tallyResult, info.TotalWeight, sinceSubmission)
 	if err != nil {
 		return err
 	}
@@ -30,6 +32,7 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = foundation.PROPOSAL_STATUS_ACCEPTED
+			k.lockProposal(ctx, p, policies)
 		} else {
 			p.Status = foundation.PROPOSAL_STATUS_REJECTED
 		}
@@ -38,6 +41,27 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 	return nil
 }
 
+// allowByPolicies applies the strictest of the decision policies, that is,
+// the proposal passes only if all the policies allow it, and fails if any of
+// them rejects it.
+func allowByPolicies(policies []foundation.DecisionPolicy, tallyResult foundation.TallyResult, totalWeight sdk.Dec, sinceSubmission time.Duration) (*foundation.DecisionPolicyResult, error) {
+	combined := foundation.DecisionPolicyResult{Allow: true, Final: true}
+	for _, policy := range policies {
+		result, err := policy.Allow(tallyResult, totalWeight, sinceSubmission)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Final && !result.Allow {
+			return result, nil
+		}
+		combined.Allow = combined.Allow && result.Allow
+		combined.Final = combined.Final && result.Final
+	}
+
+	return &combined, nil
+}
+
 // tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
 func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyResult, error) {
@@ -55,7 +79,7 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 	k.iterateVotes(ctx, p.Id, func(vote foundation.Vote) (stop bool) {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
-		_, err := k.GetMember(ctx, voter)
+		member, err := k.GetMember(ctx, voter)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
 			// If the member left the foundation after voting, then we simply skip the
@@ -67,7 +91,7 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 			return true
 		}
 
-		if err := tallyResult.Add(vote.Option); err != nil {
+		if err := tallyResult.Add(vote.Option, member.Weight); err != nil {
 			panic(err)
 		}
 
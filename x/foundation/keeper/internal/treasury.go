@@ -8,15 +8,17 @@ import (
 
 func (k Keeper) CollectFoundationTax(ctx sdk.Context) error {
 	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
-	feesCollectedInt := k.bankKeeper.GetAllBalances(ctx, feeCollector)
-	if feesCollectedInt.Empty() {
+	feesCollected := k.bankKeeper.GetAllBalances(ctx, feeCollector)
+	if feesCollected.Empty() {
 		return nil
 	}
-	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
+
+	params := k.GetParams(ctx)
 
 	// calculate the tax
-	taxRatio := k.GetFoundationTax(ctx)
-	tax, _ := feesCollected.MulDecTruncate(taxRatio).TruncateDecimal()
+	inflationTax, provided := k.calculateInflationTax(ctx, params.InflationTax, feesCollected)
+	feeTax := calculateFeeTax(params, feesCollected.Sub(provided))
+	tax := feeTax.Add(inflationTax...)
 	if tax.Empty() {
 		return nil
 	}
@@ -26,9 +28,44 @@ func (k Keeper) CollectFoundationTax(ctx sdk.Context) error {
 		return err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventFoundationTaxCollected{
+		FeeTax:       feeTax,
+		InflationTax: inflationTax,
+	}); err != nil {
+		panic(err)
+	}
+
 	return nil
 }
 
+// calculateFeeTax returns the tax on the fees, applying the ratio of each
+// denom.
+func calculateFeeTax(params foundation.Params, fees sdk.Coins) sdk.Coins {
+	tax := make([]sdk.Coin, 0, len(fees))
+	for _, fee := range fees {
+		amount := sdk.NewDecFromInt(fee.Amount).MulTruncate(params.GetTaxRatio(fee.Denom)).TruncateInt()
+		tax = append(tax, sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return sdk.NewCoins(tax...)
+}
+
+// calculateInflationTax returns the tax on the block provisions of x/mint,
+// which have been sent to the fee collector prior to the foundation's
+// BeginBlocker, and the provisions themselves. The provisions are excluded
+// from the fee tax if the inflation tax applies, so they are taxed only once.
+func (k Keeper) calculateInflationTax(ctx sdk.Context, ratio sdk.Dec, feesCollected sdk.Coins) (tax, provided sdk.Coins) {
+	if ratio.IsZero() {
+		return sdk.NewCoins(), sdk.NewCoins()
+	}
+
+	provision := k.mintKeeper.GetMinter(ctx).BlockProvision(k.mintKeeper.GetParams(ctx))
+	amount := sdk.MinInt(provision.Amount, feesCollected.AmountOf(provision.Denom))
+	taxAmount := sdk.NewDecFromInt(amount).MulTruncate(ratio).TruncateInt()
+
+	return sdk.NewCoins(sdk.NewCoin(provision.Denom, taxAmount)), sdk.NewCoins(sdk.NewCoin(provision.Denom, amount))
+}
+
 func (k Keeper) GetTreasury(ctx sdk.Context) sdk.DecCoins {
 	return k.GetPool(ctx).Treasury
 }
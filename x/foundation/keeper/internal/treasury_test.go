@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -52,6 +54,7 @@ func (s *KeeperTestSuite) TestCollectFoundationTax() {
 			// set tax ratio
 			s.impl.SetParams(ctx, foundation.Params{
 				FoundationTax: tc.taxRatio,
+				InflationTax:  sdk.ZeroDec(),
 			})
 
 			before := s.impl.GetTreasury(ctx)
@@ -77,6 +80,105 @@ func (s *KeeperTestSuite) TestCollectFoundationTax() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCollectFoundationTaxWithParams() {
+	ctx, _ := s.ctx.CacheContext()
+
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := s.bankKeeper.GetAllBalances(ctx, collector)
+	s.Require().Equal(1, len(fees))
+	fee := fees[0]
+
+	// x/mint provides 1000 coins on this block
+	mintParams := s.mintKeeper.GetParams(ctx)
+	s.Require().Equal(fee.Denom, mintParams.MintDenom)
+	minter := s.mintKeeper.GetMinter(ctx)
+	minter.AnnualProvisions = sdk.NewDec(int64(mintParams.BlocksPerYear) * 1000)
+	s.mintKeeper.SetMinter(ctx, minter)
+
+	for name, tc := range map[string]struct {
+		params       foundation.Params
+		feeTax       sdk.Int
+		inflationTax sdk.Int
+	}{
+		"denom tax": {
+			params: foundation.Params{
+				FoundationTax: sdk.OneDec(),
+				DenomTaxes: []foundation.DenomTax{
+					{
+						Denom: fee.Denom,
+						Tax:   sdk.MustNewDecFromStr("0.5"),
+					},
+				},
+				InflationTax: sdk.ZeroDec(),
+			},
+			feeTax:       fee.Amount.QuoRaw(2),
+			inflationTax: sdk.ZeroInt(),
+		},
+		"denom tax of another denom": {
+			params: foundation.Params{
+				FoundationTax: sdk.MustNewDecFromStr("0.5"),
+				DenomTaxes: []foundation.DenomTax{
+					{
+						Denom: "nonexistent",
+						Tax:   sdk.OneDec(),
+					},
+				},
+				InflationTax: sdk.ZeroDec(),
+			},
+			feeTax:       fee.Amount.QuoRaw(2),
+			inflationTax: sdk.ZeroInt(),
+		},
+		"inflation tax": {
+			params: foundation.Params{
+				FoundationTax: sdk.ZeroDec(),
+				InflationTax:  sdk.MustNewDecFromStr("0.1"),
+			},
+			feeTax:       sdk.ZeroInt(),
+			inflationTax: sdk.NewInt(100),
+		},
+		"both": {
+			params: foundation.Params{
+				FoundationTax: sdk.OneDec(),
+				InflationTax:  sdk.MustNewDecFromStr("0.1"),
+			},
+			// the provisions are not taxed as the fees
+			feeTax:       fee.Amount.SubRaw(1000),
+			inflationTax: sdk.NewInt(100),
+		},
+	} {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			s.impl.SetParams(ctx, tc.params)
+
+			err := s.impl.CollectFoundationTax(ctx)
+			s.Require().NoError(err)
+
+			expectedAfter := s.balance.Add(tc.feeTax).Add(tc.inflationTax)
+			after := s.impl.GetTreasury(ctx)
+			s.Require().Equal(1, len(after))
+			s.Require().Equal(sdk.NewDecFromInt(expectedAfter), after[0].Amount)
+
+			// check the event
+			var collected *foundation.EventFoundationTaxCollected
+			for _, event := range ctx.EventManager().ABCIEvents() {
+				if event.Type != proto.MessageName(&foundation.EventFoundationTaxCollected{}) {
+					continue
+				}
+				msg, err := sdk.ParseTypedEvent(event)
+				s.Require().NoError(err)
+				if e, ok := msg.(*foundation.EventFoundationTaxCollected); ok {
+					collected = e
+				}
+			}
+			s.Require().NotNil(collected)
+			s.Require().True(collected.FeeTax.AmountOf(fee.Denom).Equal(tc.feeTax))
+			s.Require().True(collected.InflationTax.AmountOf(fee.Denom).Equal(tc.inflationTax))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestFundTreasury() {
 	testCases := map[string]struct {
 		amount sdk.Int
@@ -16,4 +16,6 @@ const (
 // params
 const (
 	ParamKeyFoundationTax = "FoundationTax"
+	ParamKeyDenomTaxes    = "DenomTaxes"
+	ParamKeyInflationTax  = "InflationTax"
 )
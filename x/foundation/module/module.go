@@ -20,7 +20,7 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 2
+	consensusVersion uint64 = 4
 )
 
 var (
@@ -405,6 +405,42 @@ func (m MsgExec) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgVetoProposal)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgVetoProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if m.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty proposal id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgVetoProposal) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgVetoProposal) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgVetoProposal) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgVetoProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgLeaveFoundation)(nil)
 
 // ValidateBasic implements Msg.
@@ -473,6 +509,168 @@ func (m MsgUpdateCensorship) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgUpdateMsgTypeDecisionPolicy)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateMsgTypeDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if len(m.MsgTypeUrl) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty msg type url")
+	}
+
+	// empty decision policy means removal
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+
+	policy := m.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.ErrInvalidType.Wrap("invalid decision policy")
+	}
+	if err := validateMsgTypeDecisionPolicy(policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateMsgTypeDecisionPolicy) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateMsgTypeDecisionPolicy) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateMsgTypeDecisionPolicy) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateMsgTypeDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateMsgTypeDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+
+	policy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	msg, ok := policy.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("can't proto marshal %T", msg)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+
+	return nil
+}
+
+func (m MsgUpdateMsgTypeDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	// empty on removal
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
+
+var _ sdk.Msg = (*MsgCreatePaymentStream)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCreatePaymentStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", m.Recipient)
+	}
+
+	if err := validatePaymentSchedule(m.Amount, m.StartTime, m.EndTime, m.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCreatePaymentStream) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreatePaymentStream) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreatePaymentStream) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreatePaymentStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgCancelPaymentStream)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCancelPaymentStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if m.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty payment stream id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCancelPaymentStream) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCancelPaymentStream) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCancelPaymentStream) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCancelPaymentStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgGrant)(nil)
 
 // ValidateBasic implements Msg.
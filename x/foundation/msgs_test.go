@@ -304,6 +304,209 @@ func TestMsgUpdateDecisionPolicy(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateMsgTypeDecisionPolicy(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+
+	testCases := map[string]struct {
+		authority  sdk.AccAddress
+		msgTypeURL string
+		policy     foundation.DecisionPolicy
+		valid      bool
+	}{
+		"valid policy": {
+			authority:  addrs[0],
+			msgTypeURL: msgTypeURL,
+			policy: &foundation.PercentageDecisionPolicy{
+				Percentage: sdk.OneDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			valid: true,
+		},
+		"valid removal": {
+			authority:  addrs[0],
+			msgTypeURL: msgTypeURL,
+			valid:      true,
+		},
+		"empty authority": {
+			msgTypeURL: msgTypeURL,
+		},
+		"empty msg type url": {
+			authority: addrs[0],
+		},
+		"invalid policy": {
+			authority:  addrs[0],
+			msgTypeURL: msgTypeURL,
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.ZeroDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+		},
+		"outsourcing policy": {
+			authority:  addrs[0],
+			msgTypeURL: msgTypeURL,
+			policy:     foundation.DefaultDecisionPolicy(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgUpdateMsgTypeDecisionPolicy{
+				Authority:  tc.authority.String(),
+				MsgTypeUrl: tc.msgTypeURL,
+			}
+			if tc.policy != nil {
+				err := msg.SetDecisionPolicy(tc.policy)
+				require.NoError(t, err)
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgCreatePaymentStream(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		recipient sdk.AccAddress
+		amount    sdk.Int
+		endTime   time.Time
+		period    time.Duration
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			endTime:   startTime.Add(time.Hour),
+			valid:     true,
+		},
+		"valid msg with period": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			endTime:   startTime.Add(time.Hour),
+			period:    time.Minute,
+			valid:     true,
+		},
+		"empty authority": {
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			endTime:   startTime.Add(time.Hour),
+		},
+		"empty recipient": {
+			authority: addrs[0],
+			amount:    sdk.OneInt(),
+			endTime:   startTime.Add(time.Hour),
+		},
+		"zero amount": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.ZeroInt(),
+			endTime:   startTime.Add(time.Hour),
+		},
+		"invalid end time": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			endTime:   startTime,
+		},
+		"invalid period": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			endTime:   startTime.Add(time.Hour),
+			period:    -time.Minute,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgCreatePaymentStream{
+				Authority: tc.authority.String(),
+				Recipient: tc.recipient.String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.amount)),
+				StartTime: startTime,
+				EndTime:   tc.endTime,
+				Period:    tc.period,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgCancelPaymentStream(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		id        uint64
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			id:        1,
+			valid:     true,
+		},
+		"empty authority": {
+			id: 1,
+		},
+		"empty id": {
+			authority: addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgCancelPaymentStream{
+				Authority: tc.authority.String(),
+				Id:        tc.id,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgSubmitProposal(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
@@ -523,6 +726,49 @@ func TestMsgExec(t *testing.T) {
 	}
 }
 
+func TestMsgVetoProposal(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		id        uint64
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			id:        1,
+			valid:     true,
+		},
+		"empty authority": {
+			id: 1,
+		},
+		"empty proposal id": {
+			authority: addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgVetoProposal{
+				Authority:  tc.authority.String(),
+				ProposalId: tc.id,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgLeaveFoundation(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
@@ -588,6 +834,13 @@ func TestMsgGrant(t *testing.T) {
 			authority: addrs[0],
 			grantee:   addrs[1],
 		},
+		"invalid authorization": {
+			authority: addrs[0],
+			grantee:   addrs[1],
+			authorization: &foundation.ReceiveFromTreasuryAuthorization{
+				Period: -time.Hour,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -699,6 +952,13 @@ func TestAminoJSON(t *testing.T) {
 			},
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgExec\",\"value\":{\"proposal_id\":\"1\",\"signer\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgVetoProposal": {
+			&foundation.MsgVetoProposal{
+				Authority:  addrs[0].String(),
+				ProposalId: 1,
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgVetoProposal\",\"value\":{\"authority\":\"%s\",\"proposal_id\":\"1\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
 		"MsgLeaveFoundation": {
 			&foundation.MsgLeaveFoundation{Address: addrs[0].String()},
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgLeaveFoundation\",\"value\":{\"address\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
@@ -736,7 +996,7 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 				Authority: addrs[0].String(),
 				Params:    foundation.Params{FoundationTax: sdk.ZeroDec()},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateParams\",\"value\":{\"authority\":\"%s\",\"params\":{\"foundation_tax\":\"0.000000000000000000\"}}}],\"metadata\":\"MsgUpdateParams\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateParams\",\"value\":{\"authority\":\"%s\",\"params\":{\"denom_taxes\":null,\"foundation_tax\":\"0.000000000000000000\",\"inflation_tax\":\"0.000000000000000000\"}}}],\"metadata\":\"MsgUpdateParams\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
 		},
 		"MsgWithdrawFromTreasury": {
 			&foundation.MsgWithdrawFromTreasury{
@@ -765,6 +1025,31 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 			},
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateCensorship\",\"value\":{\"authority\":\"%s\",\"censorship\":{\"authority\":1,\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}}],\"metadata\":\"MsgUpdateCensorship\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
 		},
+		"MsgUpdateMsgTypeDecisionPolicy": {
+			&foundation.MsgUpdateMsgTypeDecisionPolicy{
+				Authority:  addrs[0].String(),
+				MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMsgTypeDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}],\"metadata\":\"MsgUpdateMsgTypeDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
+		},
+		"MsgCreatePaymentStream": {
+			&foundation.MsgCreatePaymentStream{
+				Authority: addrs[0].String(),
+				Recipient: addrs[1].String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))),
+				StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Period:    24 * time.Hour,
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgCreatePaymentStream\",\"value\":{\"amount\":[{\"amount\":\"1000000\",\"denom\":\"stake\"}],\"authority\":\"%s\",\"end_time\":\"2025-01-01T00:00:00Z\",\"period\":\"86400000000000\",\"recipient\":\"%s\",\"start_time\":\"2024-01-01T00:00:00Z\"}}],\"metadata\":\"MsgCreatePaymentStream\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+		},
+		"MsgCancelPaymentStream": {
+			&foundation.MsgCancelPaymentStream{
+				Authority: addrs[0].String(),
+				Id:        1,
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgCancelPaymentStream\",\"value\":{\"authority\":\"%s\",\"id\":\"1\"}}],\"metadata\":\"MsgCancelPaymentStream\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
+		},
 		"MsgRevoke": {
 			&foundation.MsgRevoke{
 				Authority:  addrs[0].String(),
@@ -806,7 +1091,7 @@ func TestMsgUpdateDecisionPolicyAminoJson(t *testing.T) {
 					VotingPeriod: time.Hour,
 				},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/ThresholdDecisionPolicy\",\"value\":{\"threshold\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"ThresholdDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/ThresholdDecisionPolicy\",\"value\":{\"threshold\":\"1.000000000000000000\",\"windows\":{\"execution_delay\":\"0\",\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"ThresholdDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
 		},
 		"PercentageDecisionPolicy": {
 			&foundation.PercentageDecisionPolicy{
@@ -815,7 +1100,7 @@ func TestMsgUpdateDecisionPolicyAminoJson(t *testing.T) {
 					VotingPeriod: time.Hour,
 				},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/PercentageDecisionPolicy\",\"value\":{\"percentage\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"PercentageDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/PercentageDecisionPolicy\",\"value\":{\"percentage\":\"1.000000000000000000\",\"windows\":{\"execution_delay\":\"0\",\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"PercentageDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
 		},
 	}
 
@@ -856,7 +1141,7 @@ func TestMsgGrantAminoJson(t *testing.T) {
 	}{
 		"ReceiveFromTreasuryAuthorization": {
 			&foundation.ReceiveFromTreasuryAuthorization{},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{\"period\":\"0\",\"period_can_receive\":[],\"period_receive_limit\":[],\"period_reset\":\"0001-01-01T00:00:00Z\",\"receive_limit\":[]}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
 		},
 	}
 
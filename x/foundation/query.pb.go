@@ -1072,6 +1072,208 @@ func (m *QueryCensorshipsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMsgTypeDecisionPoliciesRequest is the request type for the Query/MsgTypeDecisionPolicies RPC method.
+type QueryMsgTypeDecisionPoliciesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgTypeDecisionPoliciesRequest) Reset()         { *m = QueryMsgTypeDecisionPoliciesRequest{} }
+func (m *QueryMsgTypeDecisionPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeDecisionPoliciesRequest) ProtoMessage()    {}
+func (*QueryMsgTypeDecisionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{22}
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest.Merge(m, src)
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryMsgTypeDecisionPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgTypeDecisionPoliciesResponse is the response type for the Query/MsgTypeDecisionPolicies RPC method.
+type QueryMsgTypeDecisionPoliciesResponse struct {
+	// decision_policies is the list of the decision policies of the message types.
+	DecisionPolicies []MsgTypeDecisionPolicy `protobuf:"bytes,1,rep,name=decision_policies,json=decisionPolicies,proto3" json:"decision_policies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) Reset()         { *m = QueryMsgTypeDecisionPoliciesResponse{} }
+func (m *QueryMsgTypeDecisionPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeDecisionPoliciesResponse) ProtoMessage()    {}
+func (*QueryMsgTypeDecisionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{23}
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse.Merge(m, src)
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) GetDecisionPolicies() []MsgTypeDecisionPolicy {
+	if m != nil {
+		return m.DecisionPolicies
+	}
+	return nil
+}
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentStreamsRequest is the request type for the Query/PaymentStreams RPC method.
+type QueryPaymentStreamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentStreamsRequest) Reset()         { *m = QueryPaymentStreamsRequest{} }
+func (m *QueryPaymentStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{24}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentStreamsResponse is the response type for the Query/PaymentStreams RPC method.
+type QueryPaymentStreamsResponse struct {
+	// payment_streams is the list of the payment streams.
+	PaymentStreams []PaymentStream `protobuf:"bytes,1,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentStreamsResponse) Reset()         { *m = QueryPaymentStreamsResponse{} }
+func (m *QueryPaymentStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{25}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsResponse) GetPaymentStreams() []PaymentStream {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *QueryPaymentStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGrantsRequest is the request type for the Query/Grants RPC method.
 type QueryGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
@@ -1085,7 +1287,7 @@ func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
 func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsRequest) ProtoMessage()    {}
 func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{22}
+	return fileDescriptor_15a18e20c0e403af, []int{26}
 }
 func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,7 +1349,7 @@ func (m *QueryGrantsResponse) Reset()         { *m = QueryGrantsResponse{} }
 func (m *QueryGrantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsResponse) ProtoMessage()    {}
 func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{23}
+	return fileDescriptor_15a18e20c0e403af, []int{27}
 }
 func (m *QueryGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1213,6 +1415,10 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "lbm.foundation.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryCensorshipsRequest)(nil), "lbm.foundation.v1.QueryCensorshipsRequest")
 	proto.RegisterType((*QueryCensorshipsResponse)(nil), "lbm.foundation.v1.QueryCensorshipsResponse")
+	proto.RegisterType((*QueryMsgTypeDecisionPoliciesRequest)(nil), "lbm.foundation.v1.QueryMsgTypeDecisionPoliciesRequest")
+	proto.RegisterType((*QueryMsgTypeDecisionPoliciesResponse)(nil), "lbm.foundation.v1.QueryMsgTypeDecisionPoliciesResponse")
+	proto.RegisterType((*QueryPaymentStreamsRequest)(nil), "lbm.foundation.v1.QueryPaymentStreamsRequest")
+	proto.RegisterType((*QueryPaymentStreamsResponse)(nil), "lbm.foundation.v1.QueryPaymentStreamsResponse")
 	proto.RegisterType((*QueryGrantsRequest)(nil), "lbm.foundation.v1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "lbm.foundation.v1.QueryGrantsResponse")
 }
@@ -1220,85 +1426,95 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/query.proto", fileDescriptor_15a18e20c0e403af) }
 
 var fileDescriptor_15a18e20c0e403af = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb4, 0x89, 0x93, 0x8c, 0xa1, 0xa2, 0x43, 0x68, 0x13, 0x27, 0x71, 0xd2, 0x6d, 0x7e,
-	0x91, 0x92, 0x1d, 0xec, 0x22, 0xa5, 0xa4, 0x87, 0xb6, 0x29, 0x24, 0xe4, 0x00, 0x0a, 0x56, 0xe9,
-	0xa1, 0x12, 0xb2, 0xd6, 0xf6, 0xc6, 0x59, 0xe1, 0xdd, 0x71, 0x77, 0x76, 0x23, 0xdc, 0xe0, 0x4b,
-	0x0f, 0x95, 0xb8, 0xa0, 0x42, 0x2e, 0x08, 0x2e, 0x88, 0x63, 0xcf, 0xfc, 0x11, 0x15, 0xa7, 0x4a,
-	0xbd, 0x70, 0xe1, 0x87, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0x6f, 0xec, 0x5d, 0x7b, 0xd7, 0x76, 0x8b,
-	0x6f, 0xbb, 0x33, 0xef, 0xbd, 0xef, 0x7b, 0xef, 0xcd, 0xcc, 0xfb, 0xf0, 0x7c, 0xad, 0x64, 0xd3,
-	0x03, 0xe6, 0x3b, 0x15, 0xc3, 0xb3, 0x98, 0x43, 0x8f, 0x72, 0xf4, 0xa1, 0x6f, 0xba, 0x0d, 0xbd,
-	0xee, 0x32, 0x8f, 0x91, 0x8b, 0xb5, 0x92, 0xad, 0xb7, 0xb7, 0xf5, 0xa3, 0x5c, 0x66, 0xbd, 0xcc,
-	0xb8, 0xcd, 0x38, 0x2d, 0x19, 0xdc, 0x94, 0xb6, 0xf4, 0x28, 0x57, 0x32, 0x3d, 0x23, 0x47, 0xeb,
-	0x46, 0xd5, 0x72, 0xa4, 0xa1, 0x70, 0xcf, 0xcc, 0x55, 0x19, 0xab, 0xd6, 0x4c, 0x6a, 0xd4, 0x2d,
-	0x6a, 0x38, 0x0e, 0xf3, 0xc4, 0x26, 0x87, 0x5d, 0xad, 0x1b, 0x3b, 0x04, 0x25, 0x6d, 0xb2, 0x61,
-	0x34, 0x85, 0x53, 0x66, 0x96, 0xda, 0x9f, 0x01, 0x04, 0xf1, 0x57, 0xf2, 0x0f, 0xa8, 0xe1, 0x34,
-	0xd4, 0x96, 0x74, 0x2d, 0x8a, 0x3f, 0x2a, 0x7f, 0x60, 0x6b, 0xaa, 0xca, 0xaa, 0x4c, 0xae, 0x07,
-	0x5f, 0x72, 0x55, 0x9b, 0xc2, 0xe4, 0xf3, 0x20, 0x9f, 0x7d, 0xc3, 0x35, 0x6c, 0x5e, 0x30, 0x1f,
-	0xfa, 0x26, 0xf7, 0xb4, 0xcf, 0xf0, 0xdb, 0x91, 0x55, 0x5e, 0x67, 0x0e, 0x37, 0xc9, 0x26, 0x4e,
-	0xd5, 0xc5, 0xca, 0x34, 0x5a, 0x44, 0x6b, 0xe9, 0xfc, 0x8c, 0xde, 0x55, 0x2a, 0x5d, 0xba, 0x6c,
-	0x8f, 0x3e, 0xff, 0x6b, 0x61, 0xa4, 0x00, 0xe6, 0xda, 0x25, 0x3c, 0x25, 0xe2, 0xdd, 0x73, 0x4d,
-	0x83, 0xfb, 0x6e, 0x43, 0xe1, 0x3c, 0x41, 0xf8, 0x9d, 0x8e, 0x0d, 0x80, 0xb2, 0x71, 0xca, 0xb0,
-	0x99, 0xef, 0x78, 0xd3, 0x68, 0xf1, 0xfc, 0x5a, 0x3a, 0x3f, 0xa7, 0x43, 0x32, 0x41, 0x51, 0x74,
-	0x28, 0x8a, 0xfe, 0x91, 0x59, 0xbe, 0xcb, 0x2c, 0x67, 0x7b, 0x33, 0x40, 0x7b, 0xf6, 0xf7, 0x02,
-	0xad, 0x5a, 0xde, 0xa1, 0x5f, 0xd2, 0xcb, 0xcc, 0xa6, 0x3b, 0x96, 0xc3, 0xcb, 0x87, 0x96, 0x41,
-	0x0f, 0xe0, 0x63, 0x83, 0x57, 0xbe, 0xa2, 0x5e, 0xa3, 0x6e, 0x72, 0xe5, 0xc7, 0x0b, 0x00, 0xa2,
-	0xcd, 0xe1, 0x8c, 0xe0, 0xb1, 0xd3, 0xca, 0x65, 0xcf, 0x39, 0x60, 0x8a, 0xe6, 0x03, 0x3c, 0x1b,
-	0xbb, 0x0b, 0x5c, 0x6f, 0xe2, 0x51, 0xcb, 0x39, 0x60, 0x50, 0x94, 0x2b, 0x31, 0x45, 0x89, 0x3a,
-	0x42, 0x71, 0x84, 0x93, 0xa6, 0x43, 0x03, 0x3e, 0x35, 0xed, 0x92, 0xe9, 0x02, 0x22, 0x99, 0xc6,
-	0xe3, 0x46, 0xa5, 0xe2, 0x9a, 0x5c, 0x96, 0x7a, 0xb2, 0xa0, 0x7e, 0xb5, 0x4f, 0xa0, 0x35, 0xca,
-	0x1e, 0x38, 0xe4, 0x70, 0xca, 0x16, 0x2b, 0x3d, 0x5a, 0x03, 0x2e, 0x60, 0xa8, 0x7d, 0x19, 0x89,
-	0xa4, 0x7a, 0x4f, 0x76, 0x30, 0x6e, 0x9f, 0x69, 0x88, 0xb6, 0x12, 0xa9, 0xbe, 0xbc, 0x2c, 0xaa,
-	0x07, 0xfb, 0x46, 0xd5, 0x04, 0xdf, 0x42, 0xc8, 0x53, 0xfb, 0x09, 0x41, 0xd3, 0x5b, 0xf1, 0x81,
-	0xea, 0x87, 0x78, 0x5c, 0x32, 0xe0, 0xd0, 0xdb, 0x64, 0xae, 0x50, 0x29, 0x65, 0x4f, 0x76, 0x23,
-	0xdc, 0xce, 0x09, 0x6e, 0xab, 0x7d, 0xb9, 0x49, 0xdc, 0x08, 0xb9, 0x4d, 0xe0, 0xb6, 0xef, 0xb2,
-	0x3a, 0xe3, 0x46, 0x4d, 0x25, 0xbf, 0x80, 0xd3, 0x75, 0x58, 0x2a, 0x5a, 0x15, 0x91, 0xfd, 0x68,
-	0x01, 0xab, 0xa5, 0xbd, 0x8a, 0xb6, 0x0f, 0x07, 0xb6, 0xed, 0xd8, 0xba, 0x1b, 0x13, 0xca, 0x0c,
-	0x8a, 0x36, 0x1b, 0x77, 0x3b, 0x94, 0x5b, 0xcb, 0x58, 0x2b, 0x76, 0x44, 0x1c, 0x7a, 0x23, 0x7e,
-	0x45, 0xf8, 0x52, 0x27, 0x02, 0x90, 0xbe, 0x85, 0x27, 0x15, 0x0f, 0xd5, 0x8c, 0x5e, 0xac, 0xa1,
-	0x1d, 0x6d, 0x9f, 0xe1, 0x35, 0x64, 0x0f, 0xbf, 0x25, 0x38, 0xde, 0x67, 0x9e, 0x39, 0x68, 0x33,
-	0xc8, 0x14, 0x1e, 0x3b, 0x62, 0x9e, 0xe9, 0x0a, 0xe0, 0xc9, 0x82, 0xfc, 0xd1, 0x6e, 0xe3, 0x8b,
-	0xa1, 0x50, 0x90, 0xe9, 0x35, 0x3c, 0x1a, 0xec, 0x42, 0x19, 0x2f, 0xc7, 0x24, 0x29, 0xcc, 0x85,
-	0x91, 0xf6, 0x4d, 0x28, 0x02, 0x1f, 0x98, 0xcd, 0x4e, 0x4c, 0x2d, 0x5e, 0xa7, 0x5f, 0x3f, 0x20,
-	0x78, 0x12, 0x00, 0x1e, 0x32, 0xb8, 0x2e, 0x93, 0x55, 0x7d, 0x4a, 0x4a, 0x01, 0x7a, 0x24, 0x6d,
-	0x87, 0xd7, 0x9f, 0x2d, 0x7c, 0x59, 0x3e, 0xd4, 0x46, 0xad, 0x16, 0xbc, 0xd2, 0x7e, 0xcd, 0x1b,
-	0xf8, 0xce, 0xdc, 0xc7, 0xd3, 0xdd, 0xbe, 0x90, 0xd5, 0x16, 0x1e, 0xf3, 0x82, 0x65, 0x68, 0x4c,
-	0x36, 0x26, 0xab, 0x90, 0x9b, 0x4a, 0x4e, 0xb8, 0x68, 0x06, 0x70, 0xba, 0x6b, 0x3a, 0x9c, 0xb9,
-	0xfc, 0xd0, 0xaa, 0x0f, 0xfd, 0xee, 0x3c, 0x43, 0xc0, 0x3d, 0x82, 0x01, 0xdc, 0x3f, 0xc6, 0xe9,
-	0x72, 0x7b, 0x19, 0xfa, 0x32, 0x1f, 0x93, 0x41, 0xdb, 0x19, 0x12, 0x08, 0xfb, 0x0d, 0xaf, 0x47,
-	0x3f, 0xaa, 0x83, 0xb3, 0xeb, 0x1a, 0x8e, 0xc7, 0x43, 0xb3, 0xa4, 0x1a, 0x2c, 0x98, 0xa6, 0x9a,
-	0x25, 0xf0, 0x4b, 0x16, 0xf1, 0x1b, 0x36, 0xaf, 0x16, 0x83, 0x99, 0x58, 0xf4, 0xdd, 0x1a, 0x5c,
-	0x23, 0x6c, 0xf3, 0xea, 0xbd, 0x46, 0xdd, 0xfc, 0xc2, 0xad, 0x75, 0xd4, 0xf1, 0xfc, 0x6b, 0xd7,
-	0xf1, 0x4f, 0x04, 0xc3, 0x46, 0x51, 0x83, 0x12, 0x36, 0xf1, 0x05, 0xc3, 0xf7, 0x0e, 0x99, 0x6b,
-	0x3d, 0x92, 0x32, 0x09, 0xaa, 0x38, 0xa5, 0x4b, 0x8d, 0xa3, 0x2b, 0x8d, 0xa3, 0xdf, 0x71, 0x1a,
-	0xdb, 0xb7, 0x7e, 0xff, 0x6d, 0xe3, 0x66, 0xdf, 0x11, 0xff, 0x75, 0x58, 0x51, 0xdd, 0x09, 0x47,
-	0x2f, 0x74, 0x80, 0x0d, 0xad, 0xf4, 0xf9, 0x97, 0x6f, 0xe2, 0x31, 0x91, 0x1f, 0x79, 0x84, 0x53,
-	0x52, 0x02, 0x91, 0xe5, 0x98, 0x93, 0xd0, 0xad, 0xb5, 0x32, 0x2b, 0xfd, 0xcc, 0x24, 0x9c, 0x76,
-	0xe5, 0xf1, 0xcb, 0x7f, 0x4f, 0xce, 0xcd, 0x92, 0x19, 0xda, 0x2d, 0x21, 0xa5, 0xcc, 0x22, 0x8f,
-	0x11, 0x9e, 0x50, 0x4a, 0x8a, 0xac, 0x26, 0xc5, 0xed, 0x10, 0x61, 0x99, 0xb5, 0xfe, 0x86, 0x40,
-	0xe1, 0xaa, 0xa0, 0x30, 0x4f, 0x66, 0x63, 0x28, 0x78, 0x0a, 0xf7, 0x67, 0x84, 0x2f, 0x44, 0xf5,
-	0x0e, 0xd9, 0x48, 0x42, 0x88, 0x95, 0x5b, 0x19, 0x7d, 0x50, 0x73, 0xa0, 0xb5, 0x2e, 0x68, 0x2d,
-	0x11, 0x8d, 0xf6, 0x12, 0xd7, 0xc5, 0x40, 0x6e, 0x91, 0xa7, 0x08, 0xa7, 0xa4, 0xb6, 0x48, 0xee,
-	0x4f, 0x44, 0x8a, 0x25, 0xf7, 0x27, 0xaa, 0xc0, 0xb4, 0x4d, 0xc1, 0x22, 0x47, 0x68, 0x6f, 0x16,
-	0x20, 0x65, 0xe8, 0x31, 0x08, 0xba, 0x26, 0xf9, 0x16, 0xe1, 0x71, 0xd0, 0x48, 0xa4, 0x0f, 0x58,
-	0xeb, 0xd0, 0xac, 0xf6, 0xb5, 0x03, 0x56, 0x1b, 0x82, 0xd5, 0x2a, 0x59, 0x1e, 0x88, 0x15, 0xf9,
-	0x1e, 0xe1, 0x09, 0x35, 0xed, 0x93, 0x4f, 0x50, 0x87, 0x6a, 0x4a, 0x3e, 0x41, 0x9d, 0x2a, 0x49,
-	0xcb, 0x0b, 0x3a, 0xef, 0x91, 0xf5, 0xb8, 0x43, 0xac, 0x54, 0x05, 0x3d, 0x0e, 0xcd, 0x93, 0x26,
-	0x79, 0x82, 0xf0, 0x64, 0x4b, 0xba, 0x90, 0xbe, 0x58, 0xad, 0x1a, 0xbd, 0x3b, 0x80, 0x25, 0xd0,
-	0x5a, 0x12, 0xb4, 0xb2, 0x64, 0xae, 0x17, 0x2d, 0x72, 0x82, 0xf0, 0x68, 0x30, 0x62, 0xc9, 0xd5,
-	0xa4, 0xc8, 0x21, 0xf5, 0x92, 0x59, 0xea, 0x6d, 0x04, 0xc8, 0xb7, 0x05, 0xf2, 0x16, 0xb9, 0x31,
-	0x78, 0x41, 0xa8, 0x18, 0xed, 0xf4, 0x58, 0xa8, 0x9d, 0x26, 0xf9, 0x0e, 0xe1, 0x31, 0xa1, 0x14,
-	0x48, 0x4f, 0xc4, 0x56, 0x59, 0x96, 0xfb, 0x58, 0x01, 0xb1, 0x1b, 0x82, 0x58, 0x9e, 0xbc, 0xff,
-	0xaa, 0xc4, 0xc8, 0x2f, 0x08, 0xa7, 0x43, 0x33, 0x9b, 0xac, 0x27, 0xbe, 0x2f, 0x5d, 0x5a, 0x22,
-	0x73, 0x6d, 0x20, 0xdb, 0xff, 0x41, 0x51, 0x28, 0x87, 0xa0, 0x66, 0xe9, 0xd0, 0x44, 0x4f, 0xa6,
-	0xd8, 0x2d, 0x2d, 0x92, 0x29, 0xc6, 0x48, 0x04, 0x6d, 0x45, 0x50, 0x5c, 0x24, 0xd9, 0x18, 0x8a,
-	0x61, 0x0d, 0x70, 0x82, 0x70, 0x4a, 0x8e, 0xc6, 0xe4, 0x67, 0x29, 0x32, 0xd5, 0x93, 0x9f, 0xa5,
-	0xe8, 0x84, 0xd5, 0xb6, 0x04, 0x83, 0x0f, 0x48, 0x3e, 0x86, 0x81, 0xd0, 0x01, 0x9c, 0x1e, 0x83,
-	0x1e, 0x68, 0xd2, 0xe3, 0xb0, 0x1c, 0x68, 0x6e, 0xef, 0x3e, 0x3f, 0xcd, 0xa2, 0x17, 0xa7, 0x59,
-	0xf4, 0xcf, 0x69, 0x16, 0x3d, 0x3d, 0xcb, 0x8e, 0xbc, 0x38, 0xcb, 0x8e, 0xfc, 0x71, 0x96, 0x1d,
-	0x79, 0xb0, 0xf1, 0x4a, 0x53, 0xb8, 0x94, 0x12, 0x63, 0xfc, 0xfa, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2b, 0x30, 0x28, 0x91, 0x7a, 0x11, 0x00, 0x00,
+	// 1400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x6d, 0xe2, 0x36, 0x13, 0x54, 0xda, 0x21, 0xb4, 0xa9, 0x93, 0xba, 0xe9, 0x36,
+	0x6d, 0x42, 0x4a, 0x76, 0x88, 0x0b, 0xa4, 0xa4, 0x87, 0xb6, 0x69, 0x49, 0xe9, 0x01, 0x30, 0xa6,
+	0xf4, 0x50, 0x84, 0xac, 0xb5, 0x3d, 0x71, 0x56, 0x78, 0x77, 0xb6, 0x3b, 0xeb, 0x08, 0x37, 0xf8,
+	0xd2, 0x43, 0x25, 0x2e, 0xa8, 0xd0, 0x0b, 0x82, 0x0b, 0xe2, 0xd8, 0x33, 0x88, 0x33, 0xb7, 0x0a,
+	0x71, 0xa8, 0xc4, 0x85, 0x0b, 0x3f, 0xd4, 0xf2, 0x87, 0xa0, 0x9d, 0x79, 0x63, 0xef, 0xda, 0xbb,
+	0xb6, 0x53, 0x7c, 0xf3, 0xce, 0xbc, 0x37, 0xef, 0xfb, 0xbe, 0x6f, 0x77, 0xf4, 0x31, 0x3e, 0x51,
+	0x2f, 0x3b, 0x74, 0x8b, 0x37, 0xdc, 0xaa, 0x15, 0xd8, 0xdc, 0xa5, 0x3b, 0xab, 0xf4, 0x4e, 0x83,
+	0xf9, 0x4d, 0xd3, 0xf3, 0x79, 0xc0, 0xc9, 0x91, 0x7a, 0xd9, 0x31, 0x3b, 0xdb, 0xe6, 0xce, 0x6a,
+	0x76, 0xb9, 0xc2, 0x85, 0xc3, 0x05, 0x2d, 0x5b, 0x82, 0xa9, 0x58, 0xba, 0xb3, 0x5a, 0x66, 0x81,
+	0xb5, 0x4a, 0x3d, 0xab, 0x66, 0xbb, 0x2a, 0x50, 0xa6, 0x67, 0xe7, 0x6a, 0x9c, 0xd7, 0xea, 0x8c,
+	0x5a, 0x9e, 0x4d, 0x2d, 0xd7, 0xe5, 0x81, 0xdc, 0x14, 0xb0, 0x6b, 0xf4, 0xd6, 0x8e, 0x94, 0x52,
+	0x31, 0xb9, 0x68, 0x35, 0x5d, 0xa7, 0xc2, 0x6d, 0xbd, 0x7f, 0x1c, 0x2a, 0xc8, 0xa7, 0x72, 0x63,
+	0x8b, 0x5a, 0x6e, 0x53, 0x6f, 0xa9, 0xd4, 0x92, 0x7c, 0xa2, 0xea, 0x01, 0xb6, 0xa6, 0x6b, 0xbc,
+	0xc6, 0xd5, 0x7a, 0xf8, 0x4b, 0xad, 0x1a, 0xd3, 0x98, 0x7c, 0x10, 0xf6, 0x53, 0xb0, 0x7c, 0xcb,
+	0x11, 0x45, 0x76, 0xa7, 0xc1, 0x44, 0x60, 0xbc, 0x87, 0x5f, 0x8a, 0xad, 0x0a, 0x8f, 0xbb, 0x82,
+	0x91, 0x35, 0x9c, 0xf1, 0xe4, 0xca, 0x0c, 0x9a, 0x47, 0x4b, 0x53, 0xf9, 0xe3, 0x66, 0x8f, 0x55,
+	0xa6, 0x4a, 0xd9, 0x18, 0x7f, 0xfc, 0xd7, 0xc9, 0xb1, 0x22, 0x84, 0x1b, 0x47, 0xf1, 0xb4, 0x3c,
+	0xef, 0xa6, 0xcf, 0x2c, 0xd1, 0xf0, 0x9b, 0xba, 0xce, 0x7d, 0x84, 0x5f, 0xee, 0xda, 0x80, 0x52,
+	0x0e, 0xce, 0x58, 0x0e, 0x6f, 0xb8, 0xc1, 0x0c, 0x9a, 0xdf, 0xbf, 0x34, 0x95, 0x9f, 0x33, 0xa1,
+	0x99, 0xd0, 0x14, 0x13, 0x4c, 0x31, 0xaf, 0xb1, 0xca, 0x55, 0x6e, 0xbb, 0x1b, 0x6b, 0x61, 0xb5,
+	0x47, 0x7f, 0x9f, 0xa4, 0x35, 0x3b, 0xd8, 0x6e, 0x94, 0xcd, 0x0a, 0x77, 0xe8, 0xa6, 0xed, 0x8a,
+	0xca, 0xb6, 0x6d, 0xd1, 0x2d, 0xf8, 0xb1, 0x22, 0xaa, 0x9f, 0xd2, 0xa0, 0xe9, 0x31, 0xa1, 0xf3,
+	0x44, 0x11, 0x8a, 0x18, 0x73, 0x38, 0x2b, 0x75, 0x6c, 0xb6, 0x7b, 0xb9, 0xe1, 0x6e, 0x71, 0x2d,
+	0xf3, 0x36, 0x9e, 0x4d, 0xdc, 0x05, 0xad, 0x17, 0xf1, 0xb8, 0xed, 0x6e, 0x71, 0x30, 0xe5, 0x54,
+	0x82, 0x29, 0xf1, 0x44, 0x30, 0x47, 0x26, 0x19, 0x26, 0x0c, 0xe0, 0x5d, 0xe6, 0x94, 0x99, 0x0f,
+	0x15, 0xc9, 0x0c, 0x3e, 0x60, 0x55, 0xab, 0x3e, 0x13, 0xca, 0xea, 0xc9, 0xa2, 0x7e, 0x34, 0xde,
+	0x81, 0xd1, 0xe8, 0x78, 0xd0, 0xb0, 0x8a, 0x33, 0x8e, 0x5c, 0xe9, 0x33, 0x1a, 0x48, 0x81, 0x40,
+	0xe3, 0x93, 0xd8, 0x49, 0x7a, 0xf6, 0x64, 0x13, 0xe3, 0xce, 0x3b, 0x0d, 0xa7, 0x9d, 0x8d, 0xb9,
+	0xaf, 0x3e, 0x16, 0x3d, 0x83, 0x82, 0x55, 0x63, 0x90, 0x5b, 0x8c, 0x64, 0x1a, 0xdf, 0x22, 0x18,
+	0x7a, 0xfb, 0x7c, 0x90, 0xfa, 0x16, 0x3e, 0xa0, 0x14, 0x08, 0x98, 0x6d, 0xba, 0x56, 0x70, 0x4a,
+	0xc7, 0x93, 0xeb, 0x31, 0x6d, 0xfb, 0xa4, 0xb6, 0xc5, 0x81, 0xda, 0x54, 0xdd, 0x98, 0xb8, 0x35,
+	0xd0, 0x56, 0xf0, 0xb9, 0xc7, 0x85, 0x55, 0xd7, 0xcd, 0x9f, 0xc4, 0x53, 0x1e, 0x2c, 0x95, 0xec,
+	0xaa, 0xec, 0x7e, 0xbc, 0x88, 0xf5, 0xd2, 0x8d, 0xaa, 0x51, 0x80, 0x17, 0xb6, 0x93, 0xd8, 0xfe,
+	0x36, 0x0e, 0xea, 0x30, 0x30, 0x6d, 0x36, 0xe9, 0xeb, 0xd0, 0x69, 0xed, 0x60, 0xa3, 0xd4, 0x75,
+	0xe2, 0xc8, 0x07, 0xf1, 0x03, 0xc2, 0x47, 0xbb, 0x2b, 0x80, 0xe8, 0x4b, 0x78, 0x52, 0xeb, 0xd0,
+	0xc3, 0xe8, 0xa7, 0x1a, 0xc6, 0xd1, 0xc9, 0x19, 0xdd, 0x40, 0x6e, 0xe0, 0xc3, 0x52, 0xe3, 0x2d,
+	0x1e, 0xb0, 0x61, 0x87, 0x41, 0xa6, 0xf1, 0xc4, 0x0e, 0x0f, 0x98, 0x2f, 0x0b, 0x4f, 0x16, 0xd5,
+	0x83, 0x71, 0x19, 0x1f, 0x89, 0x1c, 0x05, 0x9d, 0x9e, 0xc3, 0xe3, 0xe1, 0x2e, 0xd8, 0x78, 0x2c,
+	0xa1, 0x49, 0x19, 0x2e, 0x83, 0x8c, 0xcf, 0x23, 0x27, 0x88, 0xa1, 0xd5, 0x6c, 0x26, 0x78, 0xf1,
+	0x3c, 0xf3, 0xfa, 0x1a, 0xc1, 0x95, 0x00, 0xe5, 0xa1, 0x83, 0xf3, 0xaa, 0x59, 0x3d, 0xa7, 0xb4,
+	0x16, 0x60, 0x46, 0x2a, 0x76, 0x74, 0xf3, 0x59, 0xc7, 0xc7, 0xd4, 0x45, 0x6d, 0xd5, 0xeb, 0xe1,
+	0x2d, 0xdd, 0xa8, 0x07, 0x43, 0x7f, 0x33, 0xb7, 0xf0, 0x4c, 0x6f, 0x2e, 0x74, 0xb5, 0x8e, 0x27,
+	0x82, 0x70, 0x19, 0x06, 0x93, 0x4b, 0xe8, 0x2a, 0x92, 0xa6, 0x9b, 0x93, 0x29, 0x86, 0x05, 0x9a,
+	0xae, 0x32, 0x57, 0x70, 0x5f, 0x6c, 0xdb, 0xde, 0xc8, 0xbf, 0x9d, 0x47, 0x08, 0xb4, 0xc7, 0x6a,
+	0x80, 0xf6, 0xb7, 0xf1, 0x54, 0xa5, 0xb3, 0x0c, 0x73, 0x39, 0x91, 0xd0, 0x41, 0x27, 0x19, 0x1a,
+	0x88, 0xe6, 0x8d, 0x6e, 0x46, 0x0e, 0x3e, 0xad, 0x2e, 0x5c, 0x51, 0xbb, 0xd9, 0xf4, 0xd8, 0x35,
+	0x56, 0xb1, 0x85, 0xcd, 0xdd, 0x02, 0xaf, 0xdb, 0x15, 0x9b, 0x8d, 0xdc, 0x9b, 0xdf, 0x10, 0x5e,
+	0xe8, 0x5f, 0x0f, 0x7c, 0xfa, 0x18, 0x1f, 0xa9, 0xc2, 0x5e, 0xc9, 0x83, 0x4d, 0x70, 0x6b, 0x29,
+	0xe9, 0xea, 0x4f, 0x38, 0xae, 0x09, 0xc6, 0x1d, 0xae, 0x76, 0x15, 0x19, 0x9d, 0x7b, 0x55, 0x40,
+	0x80, 0x82, 0xd5, 0x74, 0x98, 0x1b, 0x7c, 0x18, 0xf8, 0xac, 0x43, 0x44, 0x23, 0x33, 0xed, 0x67,
+	0x04, 0x2c, 0xd1, 0x5d, 0x06, 0xbc, 0x7a, 0x1f, 0xbf, 0xe8, 0xa9, 0x9d, 0x92, 0x50, 0x5b, 0xe0,
+	0xd4, 0x7c, 0x22, 0x6b, 0x45, 0xce, 0x00, 0x87, 0x0e, 0x79, 0xb1, 0x83, 0x47, 0xe7, 0xcf, 0x37,
+	0xfa, 0x5a, 0xba, 0xee, 0x5b, 0x6e, 0x20, 0x22, 0xa4, 0x52, 0x0b, 0x17, 0x18, 0xd3, 0xa4, 0x02,
+	0x8f, 0x64, 0x1e, 0xbf, 0xe0, 0x88, 0x5a, 0x29, 0x24, 0xae, 0x52, 0xc3, 0xaf, 0xc3, 0x25, 0x8d,
+	0x1d, 0x35, 0xde, 0x8f, 0xfc, 0x7a, 0x97, 0xa9, 0xfb, 0x9f, 0xdb, 0xd4, 0x3f, 0x11, 0xa0, 0x8c,
+	0x96, 0x06, 0x66, 0xb6, 0xf0, 0x21, 0xab, 0x11, 0x6c, 0x73, 0xdf, 0xbe, 0xab, 0x20, 0x1c, 0xbc,
+	0x9c, 0x36, 0x15, 0x41, 0x9b, 0x9a, 0xa0, 0xcd, 0x2b, 0x6e, 0x73, 0xe3, 0xd2, 0xaf, 0x3f, 0xae,
+	0x5c, 0x1c, 0x08, 0x90, 0x9f, 0x45, 0x79, 0xfd, 0x4a, 0xf4, 0xf4, 0x62, 0x57, 0xb1, 0x91, 0x59,
+	0x9f, 0xff, 0xe9, 0x30, 0x9e, 0x90, 0xfd, 0x91, 0xbb, 0x38, 0xa3, 0x00, 0x9b, 0x9c, 0x49, 0x78,
+	0x1f, 0x7a, 0x49, 0x3e, 0x7b, 0x76, 0x50, 0x98, 0x2a, 0x67, 0x9c, 0xba, 0xf7, 0xfb, 0xbf, 0x0f,
+	0xf7, 0xcd, 0x92, 0xe3, 0xb4, 0xf7, 0x0f, 0x8a, 0x82, 0x78, 0x72, 0x0f, 0xe1, 0x83, 0x9a, 0xd3,
+	0xc9, 0x62, 0xda, 0xb9, 0x5d, 0x88, 0x9f, 0x5d, 0x1a, 0x1c, 0x08, 0x12, 0x4e, 0x4b, 0x09, 0x27,
+	0xc8, 0x6c, 0x82, 0x84, 0x40, 0xd7, 0xfd, 0x0e, 0xe1, 0x43, 0x71, 0x9a, 0x26, 0x2b, 0x69, 0x15,
+	0x12, 0x61, 0x3e, 0x6b, 0x0e, 0x1b, 0x0e, 0xb2, 0x96, 0xa5, 0xac, 0x05, 0x62, 0xd0, 0x7e, 0x7f,
+	0xdd, 0x4a, 0x21, 0xcc, 0x93, 0x07, 0x08, 0x67, 0x14, 0xb9, 0xa6, 0xcf, 0x27, 0x06, 0xfa, 0xe9,
+	0xf3, 0x89, 0xf3, 0xbd, 0xb1, 0x26, 0x55, 0xac, 0x12, 0xda, 0x5f, 0x05, 0x80, 0x32, 0xdd, 0x85,
+	0xbf, 0x0b, 0x2d, 0xf2, 0x05, 0xc2, 0x07, 0x80, 0xc0, 0xc9, 0x80, 0x62, 0xed, 0x97, 0x66, 0x71,
+	0x60, 0x1c, 0xa8, 0x5a, 0x91, 0xaa, 0x16, 0xc9, 0x99, 0xa1, 0x54, 0x91, 0xaf, 0x10, 0x3e, 0xa8,
+	0x59, 0x32, 0xfd, 0x0d, 0xea, 0x62, 0xf2, 0xf4, 0x37, 0xa8, 0x9b, 0xc1, 0x8d, 0xbc, 0x94, 0xf3,
+	0x2a, 0x59, 0x4e, 0x7a, 0x89, 0x35, 0xb3, 0xd2, 0xdd, 0x08, 0xad, 0xb4, 0xc8, 0x7d, 0x84, 0x27,
+	0xdb, 0x60, 0x4c, 0x06, 0xd6, 0x6a, 0x7b, 0xf4, 0xca, 0x10, 0x91, 0x20, 0x6b, 0x41, 0xca, 0xca,
+	0x91, 0xb9, 0x7e, 0xb2, 0xc8, 0x43, 0x84, 0xc7, 0x43, 0x80, 0x23, 0xa7, 0xd3, 0x4e, 0x8e, 0xb0,
+	0x71, 0x76, 0xa1, 0x7f, 0x10, 0x54, 0xbe, 0x2c, 0x2b, 0xaf, 0x93, 0x0b, 0xc3, 0x1b, 0x42, 0x25,
+	0x38, 0xd2, 0x5d, 0xc9, 0xd2, 0x2d, 0xf2, 0x25, 0xc2, 0x13, 0x92, 0x43, 0x49, 0xdf, 0x8a, 0x6d,
+	0x5b, 0xce, 0x0c, 0x88, 0x02, 0x61, 0x17, 0xa4, 0xb0, 0x3c, 0x79, 0x6d, 0xaf, 0xc2, 0xc8, 0xf7,
+	0x08, 0x4f, 0x45, 0x88, 0x90, 0x2c, 0xa7, 0xde, 0x2f, 0x3d, 0xa4, 0x9a, 0x3d, 0x37, 0x54, 0xec,
+	0xff, 0x90, 0x28, 0xb9, 0x34, 0xf4, 0x6c, 0x2a, 0xc2, 0x8b, 0xe9, 0x12, 0x7b, 0xc1, 0x35, 0x5d,
+	0x62, 0x02, 0x80, 0x1a, 0x67, 0xa5, 0xc4, 0x79, 0x92, 0x4b, 0x90, 0x18, 0x25, 0xcc, 0x5f, 0x10,
+	0x3e, 0x96, 0x02, 0x69, 0xe4, 0xcd, 0xd4, 0x6f, 0xbd, 0x2f, 0x45, 0x66, 0xd7, 0xf6, 0x9c, 0x07,
+	0xa2, 0xdf, 0x90, 0xa2, 0x29, 0x59, 0x49, 0x10, 0xdd, 0xe6, 0x85, 0x1e, 0x5e, 0x94, 0x17, 0x7f,
+	0x9c, 0x99, 0xd2, 0x2f, 0xfe, 0x44, 0x84, 0x4b, 0xbf, 0xf8, 0x93, 0x51, 0xac, 0xef, 0xc5, 0xdf,
+	0xc5, 0x68, 0xe1, 0xc7, 0x9b, 0x51, 0xf0, 0x91, 0x7e, 0xf1, 0xc7, 0xb8, 0x29, 0xfd, 0xe2, 0x8f,
+	0x33, 0x8c, 0xb1, 0x2e, 0x55, 0xbc, 0x4e, 0xf2, 0x09, 0x2a, 0x24, 0x69, 0x09, 0xba, 0x0b, 0xc4,
+	0xd5, 0xa2, 0xbb, 0x51, 0xe0, 0x6a, 0x6d, 0x5c, 0x7f, 0xfc, 0x34, 0x87, 0x9e, 0x3c, 0xcd, 0xa1,
+	0x7f, 0x9e, 0xe6, 0xd0, 0x83, 0x67, 0xb9, 0xb1, 0x27, 0xcf, 0x72, 0x63, 0x7f, 0x3c, 0xcb, 0x8d,
+	0xdd, 0x5e, 0xd9, 0x13, 0xe7, 0x94, 0x33, 0x12, 0x94, 0xce, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
+	0x6d, 0x9a, 0xab, 0x59, 0x3a, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1335,6 +1551,10 @@ type QueryClient interface {
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 	// Censorships queries the censorship informations.
 	Censorships(ctx context.Context, in *QueryCensorshipsRequest, opts ...grpc.CallOption) (*QueryCensorshipsResponse, error)
+	// MsgTypeDecisionPolicies queries the decision policies of the message types.
+	MsgTypeDecisionPolicies(ctx context.Context, in *QueryMsgTypeDecisionPoliciesRequest, opts ...grpc.CallOption) (*QueryMsgTypeDecisionPoliciesResponse, error)
+	// PaymentStreams queries the payment streams from the treasury.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
 	// Returns list of authorizations, granted to the grantee.
 	Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error)
 }
@@ -1446,6 +1666,24 @@ func (c *queryClient) Censorships(ctx context.Context, in *QueryCensorshipsReque
 	return out, nil
 }
 
+func (c *queryClient) MsgTypeDecisionPolicies(ctx context.Context, in *QueryMsgTypeDecisionPoliciesRequest, opts ...grpc.CallOption) (*QueryMsgTypeDecisionPoliciesResponse, error) {
+	out := new(QueryMsgTypeDecisionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/MsgTypeDecisionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/PaymentStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error) {
 	out := new(QueryGrantsResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/Grants", in, out, opts...)
@@ -1479,6 +1717,10 @@ type QueryServer interface {
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 	// Censorships queries the censorship informations.
 	Censorships(context.Context, *QueryCensorshipsRequest) (*QueryCensorshipsResponse, error)
+	// MsgTypeDecisionPolicies queries the decision policies of the message types.
+	MsgTypeDecisionPolicies(context.Context, *QueryMsgTypeDecisionPoliciesRequest) (*QueryMsgTypeDecisionPoliciesResponse, error)
+	// PaymentStreams queries the payment streams from the treasury.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
 	// Returns list of authorizations, granted to the grantee.
 	Grants(context.Context, *QueryGrantsRequest) (*QueryGrantsResponse, error)
 }
@@ -1520,6 +1762,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Censorships(ctx context.Context, req *QueryCensorshipsRequest) (*QueryCensorshipsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Censorships not implemented")
 }
+func (*UnimplementedQueryServer) MsgTypeDecisionPolicies(ctx context.Context, req *QueryMsgTypeDecisionPoliciesRequest) (*QueryMsgTypeDecisionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTypeDecisionPolicies not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStreams(ctx context.Context, req *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
 func (*UnimplementedQueryServer) Grants(ctx context.Context, req *QueryGrantsRequest) (*QueryGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Grants not implemented")
 }
@@ -1726,6 +1974,42 @@ func _Query_Censorships_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgTypeDecisionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTypeDecisionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTypeDecisionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/MsgTypeDecisionPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTypeDecisionPolicies(ctx, req.(*QueryMsgTypeDecisionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/PaymentStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Grants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGrantsRequest)
 	if err := dec(in); err != nil {
@@ -1792,6 +2076,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Censorships",
 			Handler:    _Query_Censorships_Handler,
 		},
+		{
+			MethodName: "MsgTypeDecisionPolicies",
+			Handler:    _Query_MsgTypeDecisionPolicies_Handler,
+		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
 		{
 			MethodName: "Grants",
 			Handler:    _Query_Grants_Handler,
@@ -2573,7 +2865,7 @@ func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMsgTypeDecisionPoliciesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2583,12 +2875,12 @@ func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2603,26 +2895,12 @@ func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMsgTypeDecisionPoliciesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2632,12 +2910,12 @@ func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2654,10 +2932,10 @@ func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authorizations) > 0 {
-		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DecisionPolicies) > 0 {
+		for iNdEx := len(m.DecisionPolicies) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DecisionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2671,44 +2949,226 @@ func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPaymentStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryPaymentStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTreasuryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryTreasuryResponse) Size() (n int) {
@@ -2980,6 +3440,70 @@ func (m *QueryCensorshipsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMsgTypeDecisionPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DecisionPolicies) > 0 {
+		for _, e := range m.DecisionPolicies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGrantsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4931,6 +5455,418 @@ func (m *QueryCensorshipsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMsgTypeDecisionPoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecisionPolicies = append(m.DecisionPolicies, MsgTypeDecisionPolicy{})
+			if err := m.DecisionPolicies[len(m.DecisionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
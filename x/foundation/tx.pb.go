@@ -12,19 +12,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -785,6 +790,85 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgVetoProposal is the Msg/VetoProposal request type.
+type MsgVetoProposal struct {
+	// authority is the address of x/gov, or of the censorship authority over
+	// any message of the proposal.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{18}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{19}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 // MsgLeaveFoundation is the Msg/LeaveFoundation request type.
 type MsgLeaveFoundation struct {
 	// address is the account address of the foundation member.
@@ -795,7 +879,7 @@ func (m *MsgLeaveFoundation) Reset()         { *m = MsgLeaveFoundation{} }
 func (m *MsgLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundation) ProtoMessage()    {}
 func (*MsgLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{18}
+	return fileDescriptor_5ec2105611cae3ff, []int{20}
 }
 func (m *MsgLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +916,7 @@ func (m *MsgLeaveFoundationResponse) Reset()         { *m = MsgLeaveFoundationRe
 func (m *MsgLeaveFoundationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundationResponse) ProtoMessage()    {}
 func (*MsgLeaveFoundationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{19}
+	return fileDescriptor_5ec2105611cae3ff, []int{21}
 }
 func (m *MsgLeaveFoundationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +957,7 @@ func (m *MsgUpdateCensorship) Reset()         { *m = MsgUpdateCensorship{} }
 func (m *MsgUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorship) ProtoMessage()    {}
 func (*MsgUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{20}
+	return fileDescriptor_5ec2105611cae3ff, []int{22}
 }
 func (m *MsgUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +994,7 @@ func (m *MsgUpdateCensorshipResponse) Reset()         { *m = MsgUpdateCensorship
 func (m *MsgUpdateCensorshipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorshipResponse) ProtoMessage()    {}
 func (*MsgUpdateCensorshipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{21}
+	return fileDescriptor_5ec2105611cae3ff, []int{23}
 }
 func (m *MsgUpdateCensorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,6 +1023,257 @@ func (m *MsgUpdateCensorshipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCensorshipResponse proto.InternalMessageInfo
 
+// MsgUpdateMsgTypeDecisionPolicy is the Msg/UpdateMsgTypeDecisionPolicy request type.
+type MsgUpdateMsgTypeDecisionPolicy struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// decision_policy is the new decision policy of the message type.
+	// an empty value removes the decision policy of the message type,
+	// so the foundation's decision policy applies to it.
+	DecisionPolicy *types1.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicy) Reset()         { *m = MsgUpdateMsgTypeDecisionPolicy{} }
+func (m *MsgUpdateMsgTypeDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgTypeDecisionPolicy) ProtoMessage()    {}
+func (*MsgUpdateMsgTypeDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{24}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy.Merge(m, src)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy proto.InternalMessageInfo
+
+// MsgUpdateMsgTypeDecisionPolicyResponse is the Msg/UpdateMsgTypeDecisionPolicy response type.
+type MsgUpdateMsgTypeDecisionPolicyResponse struct {
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Reset() {
+	*m = MsgUpdateMsgTypeDecisionPolicyResponse{}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgTypeDecisionPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateMsgTypeDecisionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{25}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse proto.InternalMessageInfo
+
+// MsgCreatePaymentStream is the Msg/CreatePaymentStream request type.
+type MsgCreatePaymentStream struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient is the address which receives the payments.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount of coins to be paid by the stream.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	// start_time is the time at which the stream starts.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which the whole amount has been released.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// period is the interval of the installments. If zero, the coins are
+	// released linearly.
+	Period time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *MsgCreatePaymentStream) Reset()         { *m = MsgCreatePaymentStream{} }
+func (m *MsgCreatePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentStream) ProtoMessage()    {}
+func (*MsgCreatePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{26}
+}
+func (m *MsgCreatePaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentStream.Merge(m, src)
+}
+func (m *MsgCreatePaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePaymentStream proto.InternalMessageInfo
+
+// MsgCreatePaymentStreamResponse is the Msg/CreatePaymentStream response type.
+type MsgCreatePaymentStreamResponse struct {
+	// id is the unique id of the payment stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreatePaymentStreamResponse) Reset()         { *m = MsgCreatePaymentStreamResponse{} }
+func (m *MsgCreatePaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentStreamResponse) ProtoMessage()    {}
+func (*MsgCreatePaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{27}
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentStreamResponse.Merge(m, src)
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePaymentStreamResponse proto.InternalMessageInfo
+
+// MsgCancelPaymentStream is the Msg/CancelPaymentStream request type.
+type MsgCancelPaymentStream struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the unique id of the payment stream.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelPaymentStream) Reset()         { *m = MsgCancelPaymentStream{} }
+func (m *MsgCancelPaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentStream) ProtoMessage()    {}
+func (*MsgCancelPaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{28}
+}
+func (m *MsgCancelPaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentStream.Merge(m, src)
+}
+func (m *MsgCancelPaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPaymentStream proto.InternalMessageInfo
+
+// MsgCancelPaymentStreamResponse is the Msg/CancelPaymentStream response type.
+type MsgCancelPaymentStreamResponse struct {
+}
+
+func (m *MsgCancelPaymentStreamResponse) Reset()         { *m = MsgCancelPaymentStreamResponse{} }
+func (m *MsgCancelPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentStreamResponse) ProtoMessage()    {}
+func (*MsgCancelPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{29}
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPaymentStreamResponse proto.InternalMessageInfo
+
 // MsgGrant is the Msg/Grant request type.
 // on behalf of the foundation.
 type MsgGrant struct {
@@ -952,7 +1287,7 @@ func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
 func (m *MsgGrant) String() string { return proto.CompactTextString(m) }
 func (*MsgGrant) ProtoMessage()    {}
 func (*MsgGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{22}
+	return fileDescriptor_5ec2105611cae3ff, []int{30}
 }
 func (m *MsgGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1324,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{23}
+	return fileDescriptor_5ec2105611cae3ff, []int{31}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1365,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{24}
+	return fileDescriptor_5ec2105611cae3ff, []int{32}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1402,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{25}
+	return fileDescriptor_5ec2105611cae3ff, []int{33}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,10 +1451,18 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "lbm.foundation.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "lbm.foundation.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "lbm.foundation.v1.MsgExecResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "lbm.foundation.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "lbm.foundation.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgLeaveFoundation)(nil), "lbm.foundation.v1.MsgLeaveFoundation")
 	proto.RegisterType((*MsgLeaveFoundationResponse)(nil), "lbm.foundation.v1.MsgLeaveFoundationResponse")
 	proto.RegisterType((*MsgUpdateCensorship)(nil), "lbm.foundation.v1.MsgUpdateCensorship")
 	proto.RegisterType((*MsgUpdateCensorshipResponse)(nil), "lbm.foundation.v1.MsgUpdateCensorshipResponse")
+	proto.RegisterType((*MsgUpdateMsgTypeDecisionPolicy)(nil), "lbm.foundation.v1.MsgUpdateMsgTypeDecisionPolicy")
+	proto.RegisterType((*MsgUpdateMsgTypeDecisionPolicyResponse)(nil), "lbm.foundation.v1.MsgUpdateMsgTypeDecisionPolicyResponse")
+	proto.RegisterType((*MsgCreatePaymentStream)(nil), "lbm.foundation.v1.MsgCreatePaymentStream")
+	proto.RegisterType((*MsgCreatePaymentStreamResponse)(nil), "lbm.foundation.v1.MsgCreatePaymentStreamResponse")
+	proto.RegisterType((*MsgCancelPaymentStream)(nil), "lbm.foundation.v1.MsgCancelPaymentStream")
+	proto.RegisterType((*MsgCancelPaymentStreamResponse)(nil), "lbm.foundation.v1.MsgCancelPaymentStreamResponse")
 	proto.RegisterType((*MsgGrant)(nil), "lbm.foundation.v1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "lbm.foundation.v1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "lbm.foundation.v1.MsgRevoke")
@@ -1129,79 +1472,97 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/tx.proto", fileDescriptor_5ec2105611cae3ff) }
 
 var fileDescriptor_5ec2105611cae3ff = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x6d, 0x45, 0xb1, 0x27, 0xb6, 0x6c, 0x33, 0xc6, 0x1b, 0x99, 0x49, 0x64, 0xbd, 0x4c,
-	0x1a, 0x18, 0x4e, 0x4c, 0xd6, 0x6e, 0x8b, 0x1e, 0x5a, 0xa0, 0x88, 0x1d, 0xab, 0x30, 0x10, 0x35,
-	0x2e, 0x63, 0xf7, 0xeb, 0x50, 0x61, 0x25, 0xae, 0x29, 0x36, 0x22, 0x97, 0xe5, 0x92, 0xaa, 0xd5,
-	0x1e, 0x7a, 0x2d, 0x7a, 0x28, 0xfa, 0x33, 0x8a, 0xa2, 0x87, 0x1e, 0x72, 0x6f, 0x8f, 0x46, 0x4e,
-	0x39, 0xf6, 0xd4, 0x0f, 0xfb, 0x8f, 0x14, 0x5c, 0x2e, 0xd7, 0xa4, 0x44, 0x7d, 0xb4, 0x40, 0x6f,
-	0xda, 0x9d, 0x67, 0x9e, 0x79, 0x66, 0x76, 0x38, 0x23, 0x50, 0xba, 0x2d, 0x47, 0x3f, 0x21, 0xa1,
-	0x6b, 0xa2, 0xc0, 0x26, 0xae, 0xde, 0xdb, 0xd6, 0x83, 0x53, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x95,
-	0x6e, 0xcb, 0xd1, 0x2e, 0x6d, 0x5a, 0x6f, 0x5b, 0x59, 0xb5, 0x88, 0x45, 0x98, 0x55, 0x8f, 0x7e,
-	0xc5, 0x40, 0x45, 0x1d, 0x26, 0x49, 0xb9, 0xc5, 0x98, 0x6a, 0x9b, 0x50, 0x87, 0x50, 0xbd, 0x85,
-	0x28, 0xd6, 0x7b, 0xdb, 0x2d, 0x1c, 0xa0, 0x6d, 0xbd, 0x4d, 0xec, 0xc4, 0xbe, 0x66, 0x11, 0x62,
-	0x75, 0xb1, 0xce, 0x4e, 0xad, 0xf0, 0x44, 0x47, 0x6e, 0x3f, 0x31, 0xc5, 0xae, 0xcd, 0x38, 0x6e,
-	0x7c, 0x88, 0x4d, 0xea, 0x77, 0x12, 0x2c, 0x35, 0xa8, 0x55, 0x0f, 0x5d, 0xf3, 0xc8, 0xc7, 0x88,
-	0x86, 0x7e, 0x5f, 0x96, 0xa1, 0x78, 0xe2, 0x13, 0xa7, 0x22, 0xd5, 0xa4, 0x8d, 0x79, 0x83, 0xfd,
-	0x96, 0x2d, 0x28, 0x21, 0x87, 0x84, 0x6e, 0x50, 0x99, 0xa9, 0xcd, 0x6e, 0x5c, 0xdb, 0x59, 0xd3,
-	0x38, 0x4d, 0x24, 0x47, 0xe3, 0x72, 0xb4, 0x3d, 0x62, 0xbb, 0xbb, 0xaf, 0x9f, 0xfd, 0xbe, 0x5e,
-	0xf8, 0xf1, 0x8f, 0xf5, 0x07, 0x96, 0x1d, 0x74, 0xc2, 0x96, 0xd6, 0x26, 0x8e, 0x5e, 0xb7, 0x5d,
-	0xda, 0xee, 0xd8, 0x48, 0x3f, 0xe1, 0x3f, 0xb6, 0xa8, 0xf9, 0x4c, 0x0f, 0xfa, 0x1e, 0xa6, 0xcc,
-	0x89, 0x1a, 0x9c, 0x5e, 0x5d, 0x83, 0x1b, 0x03, 0x7a, 0x0c, 0x4c, 0x3d, 0xe2, 0x52, 0xac, 0xfe,
-	0x2c, 0x31, 0xdb, 0x87, 0x76, 0xd0, 0x31, 0x7d, 0xf4, 0x45, 0xdd, 0x27, 0x8e, 0xd0, 0x7c, 0x0b,
-	0xe6, 0x51, 0x18, 0x74, 0x88, 0x6f, 0x07, 0x7d, 0x2e, 0xfc, 0xf2, 0x42, 0x2e, 0xc3, 0x4c, 0x40,
-	0x2a, 0x33, 0xec, 0x7a, 0x26, 0x20, 0xa9, 0x6c, 0x66, 0xff, 0xdb, 0x6c, 0x3a, 0xac, 0xba, 0xc7,
-	0x9e, 0x89, 0x02, 0x7c, 0x88, 0x7c, 0xe4, 0xd0, 0x09, 0x4a, 0xdf, 0x84, 0x92, 0xc7, 0x70, 0x4c,
-	0x6d, 0xa4, 0x6c, 0xa8, 0x87, 0xb4, 0x98, 0x68, 0xb7, 0x18, 0x29, 0x33, 0x38, 0x9c, 0xd7, 0x2d,
-	0x1d, 0x49, 0xd4, 0xed, 0xff, 0xb0, 0x3e, 0xa2, 0x6c, 0x02, 0xf2, 0x35, 0x2c, 0x0b, 0xef, 0x06,
-	0x76, 0x5a, 0xd8, 0x9f, 0x24, 0xb4, 0x01, 0x65, 0x87, 0x01, 0x9b, 0x21, 0xf3, 0xa2, 0xbc, 0x31,
-	0x6a, 0x39, 0x82, 0x63, 0x46, 0x03, 0x7f, 0x1e, 0x62, 0x1a, 0x70, 0xdd, 0x8b, 0xb1, 0x77, 0x1c,
-	0x92, 0xaa, 0x0a, 0x54, 0x06, 0x05, 0x08, 0x71, 0xdf, 0x4a, 0xa9, 0xdc, 0x1e, 0xe1, 0xb6, 0x4d,
-	0x6d, 0xe2, 0x1e, 0x92, 0xae, 0xdd, 0x9e, 0xf4, 0xee, 0xef, 0xc3, 0x92, 0xc9, 0xf1, 0x4d, 0x8f,
-	0x39, 0xf0, 0xb2, 0xae, 0x6a, 0xf1, 0xd7, 0xa2, 0x25, 0x5f, 0x8b, 0xf6, 0xd0, 0xed, 0xef, 0xca,
-	0x2f, 0x9e, 0x6f, 0x95, 0xb3, 0x01, 0x8c, 0xb2, 0x99, 0x39, 0xf3, 0x62, 0xe6, 0x69, 0x11, 0x7a,
-	0x7f, 0x92, 0x60, 0xa5, 0x41, 0xad, 0xa7, 0x61, 0xcb, 0xb1, 0x83, 0x43, 0x9f, 0x78, 0x84, 0xa2,
-	0x6e, 0xa4, 0xd4, 0x63, 0xbf, 0xb1, 0x4f, 0x2b, 0x52, 0x6d, 0x36, 0x52, 0x2a, 0x2e, 0x64, 0x05,
-	0xe6, 0x1c, 0x1c, 0x20, 0x13, 0x05, 0x88, 0xf7, 0xa9, 0x38, 0xcb, 0xaf, 0x46, 0x36, 0x4a, 0x91,
-	0x85, 0x29, 0xef, 0xd7, 0x5c, 0xf9, 0x86, 0x40, 0xc9, 0xf7, 0xa1, 0x88, 0x4f, 0x71, 0xbb, 0x52,
-	0xac, 0x49, 0x1b, 0xe5, 0x9d, 0x1b, 0x39, 0x4f, 0xb2, 0x7f, 0x8a, 0xdb, 0x06, 0x03, 0xa9, 0x6f,
-	0xc3, 0xda, 0x90, 0xda, 0x24, 0x17, 0x79, 0x1d, 0xae, 0x79, 0xfc, 0xae, 0x69, 0x9b, 0xac, 0xc2,
-	0x45, 0x03, 0x92, 0xab, 0x03, 0x53, 0x3d, 0x84, 0xeb, 0xa9, 0xe6, 0x12, 0xd9, 0x4e, 0xf2, 0x93,
-	0x2b, 0x70, 0x15, 0x99, 0xa6, 0x8f, 0x29, 0xe5, 0xf9, 0x26, 0x47, 0xf5, 0x36, 0xdc, 0xcc, 0x61,
-	0x14, 0xd5, 0xfd, 0x55, 0x82, 0xab, 0x0d, 0x6a, 0x7d, 0x40, 0x82, 0xc9, 0xea, 0xe4, 0x55, 0xb8,
-	0xd2, 0x23, 0x01, 0xf6, 0x79, 0x8c, 0xf8, 0x20, 0xbf, 0x01, 0x25, 0xe2, 0x45, 0x95, 0xa8, 0xcc,
-	0xb2, 0x02, 0xdd, 0xce, 0x29, 0x50, 0xc4, 0xff, 0x84, 0x81, 0x0c, 0x0e, 0xce, 0xbc, 0x51, 0x71,
-	0xe0, 0x8d, 0x92, 0x8a, 0x5f, 0x99, 0xa6, 0xe2, 0x2b, 0x6c, 0x2a, 0x44, 0x11, 0x44, 0x56, 0xbb,
-	0x2c, 0xa9, 0x08, 0x33, 0x39, 0xa9, 0xff, 0x41, 0x89, 0xda, 0x96, 0x2b, 0xb2, 0xe2, 0x27, 0x4e,
-	0xcb, 0xe2, 0x24, 0xb4, 0x1a, 0xc8, 0x0d, 0x6a, 0x3d, 0xc6, 0xa8, 0x87, 0xeb, 0x42, 0x4d, 0xba,
-	0xf6, 0x52, 0xb6, 0xf6, 0xb7, 0x40, 0x19, 0xc6, 0x0b, 0xb6, 0x53, 0xf6, 0xd6, 0x71, 0xef, 0xef,
-	0x61, 0x97, 0x12, 0x9f, 0x76, 0x6c, 0x6f, 0xc2, 0x37, 0xb8, 0x07, 0xd0, 0x16, 0x58, 0xfe, 0xf9,
-	0xe5, 0x15, 0xfc, 0x92, 0x90, 0x4f, 0x88, 0x94, 0x1b, 0xef, 0x89, 0xc1, 0xc8, 0x42, 0xd8, 0x2f,
-	0x12, 0xcc, 0x35, 0xa8, 0xf5, 0xae, 0x8f, 0xdc, 0x60, 0x82, 0x9c, 0x0a, 0x5c, 0xb5, 0x22, 0x18,
-	0xc6, 0x49, 0xdf, 0xf1, 0xa3, 0xfc, 0x15, 0x2c, 0x72, 0xd8, 0x97, 0x48, 0x34, 0xc7, 0xa8, 0x51,
-	0xf1, 0xce, 0x8b, 0xe7, 0x5b, 0x6f, 0x4d, 0x5c, 0x09, 0xa7, 0xe9, 0x35, 0xfe, 0x30, 0x4d, 0x6e,
-	0x64, 0x63, 0xa9, 0x32, 0x1b, 0xc0, 0x2c, 0x01, 0x91, 0x15, 0x86, 0xf9, 0x06, 0xb5, 0x0c, 0xdc,
-	0x23, 0xcf, 0xf0, 0xbf, 0xce, 0xaa, 0x06, 0x0b, 0x0e, 0xb5, 0x9a, 0xd1, 0x72, 0x6a, 0x86, 0x7e,
-	0x97, 0x25, 0x35, 0x6f, 0x80, 0x43, 0xad, 0xa3, 0xbe, 0x87, 0x8f, 0xfd, 0xae, 0x7a, 0x9d, 0x4d,
-	0xab, 0x38, 0x4c, 0x12, 0x7b, 0x73, 0x13, 0x8a, 0xac, 0x19, 0x57, 0x61, 0x79, 0xff, 0xa3, 0xfd,
-	0xbd, 0xe6, 0xf1, 0x7b, 0x4f, 0x0f, 0xf7, 0xf7, 0x0e, 0xea, 0x07, 0xfb, 0x8f, 0x96, 0x0b, 0xf2,
-	0x02, 0xcc, 0xb1, 0xdb, 0x23, 0xe3, 0xe3, 0x65, 0x69, 0xe7, 0x9b, 0x79, 0x98, 0x6d, 0x50, 0x4b,
-	0xfe, 0x14, 0x16, 0x32, 0xff, 0x23, 0xd4, 0xbc, 0x55, 0x90, 0xdd, 0xed, 0xca, 0xe6, 0x64, 0x8c,
-	0x98, 0x45, 0x3d, 0x58, 0xcd, 0xdd, 0xfd, 0x23, 0x38, 0xf2, 0xb0, 0xca, 0xce, 0xf4, 0x58, 0x11,
-	0x17, 0xc1, 0x62, 0x76, 0x33, 0xde, 0xc9, 0x27, 0xc9, 0x80, 0x94, 0xfb, 0x53, 0x80, 0xd2, 0xa9,
-	0xe5, 0xae, 0xb7, 0xcd, 0x71, 0x24, 0x59, 0xec, 0xa8, 0xd4, 0xc6, 0xad, 0x2a, 0xd9, 0x84, 0xf2,
-	0xc0, 0x9a, 0xba, 0x9b, 0xcf, 0x92, 0x45, 0x29, 0x0f, 0xa6, 0x41, 0x89, 0x28, 0x9f, 0xc1, 0xf2,
-	0xd0, 0x82, 0xb8, 0x37, 0xfe, 0x21, 0x44, 0x24, 0x6d, 0x3a, 0x9c, 0x88, 0x55, 0x87, 0x22, 0x5b,
-	0x0d, 0x4a, 0xbe, 0x5f, 0x64, 0x53, 0xd4, 0xd1, 0xb6, 0x34, 0x0f, 0xfb, 0x00, 0x46, 0xf0, 0x44,
-	0xb6, 0x51, 0x3c, 0xe9, 0x09, 0x2c, 0x5b, 0xb0, 0x34, 0x38, 0x7e, 0x5f, 0xc9, 0x77, 0x1b, 0x80,
-	0x29, 0x5b, 0x53, 0xc1, 0xd2, 0x45, 0x1e, 0x9a, 0xcc, 0xf7, 0xc6, 0xb5, 0xc4, 0x25, 0x6e, 0x54,
-	0x91, 0x47, 0xcd, 0x5b, 0xf9, 0x00, 0xae, 0xc4, 0xb3, 0xf6, 0x66, 0xbe, 0x23, 0x33, 0x2a, 0x77,
-	0xc6, 0x18, 0x05, 0xd5, 0x63, 0x28, 0x25, 0x13, 0x2e, 0x1f, 0x1e, 0x5b, 0x95, 0xbb, 0xe3, 0xac,
-	0x09, 0xdb, 0xee, 0x93, 0xb3, 0xbf, 0xaa, 0x85, 0x1f, 0xce, 0xab, 0x85, 0xb3, 0xf3, 0xaa, 0xf4,
-	0xf2, 0xbc, 0x2a, 0xfd, 0x79, 0x5e, 0x95, 0xbe, 0xbf, 0xa8, 0x16, 0x5e, 0x5e, 0x54, 0x0b, 0xbf,
-	0x5d, 0x54, 0x0b, 0x9f, 0x6c, 0xfd, 0xa3, 0xa1, 0xdd, 0x2a, 0xb1, 0xa9, 0xff, 0xda, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x0a, 0x94, 0xad, 0x73, 0xe7, 0x0d, 0x00, 0x00,
+	// 1427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0x8f, 0x93, 0x34, 0x6d, 0xcf, 0xba, 0xac, 0xf3, 0xaa, 0x2d, 0xf5, 0xb6, 0x34, 0x78, 0x63,
+	0x2a, 0xdd, 0x9a, 0xac, 0x05, 0x84, 0xd0, 0x90, 0xa6, 0xb5, 0x6b, 0x50, 0xa5, 0x85, 0x15, 0xaf,
+	0xe3, 0xdf, 0x03, 0x91, 0x13, 0xdf, 0xba, 0x66, 0xb1, 0xaf, 0xf1, 0xbd, 0x09, 0x0d, 0x3c, 0xc0,
+	0x33, 0x48, 0x68, 0x8f, 0x7c, 0x04, 0x84, 0x78, 0x40, 0x62, 0xef, 0xf0, 0x38, 0xed, 0x69, 0x8f,
+	0x3c, 0xb1, 0xd1, 0x7e, 0x11, 0xe4, 0xeb, 0xeb, 0x5b, 0xc7, 0xb1, 0x93, 0x14, 0x04, 0x6f, 0xbe,
+	0x3e, 0xbf, 0xf3, 0x3b, 0x7f, 0xef, 0xb9, 0x07, 0x94, 0x4e, 0xcb, 0xae, 0xed, 0xe1, 0xae, 0x63,
+	0xe8, 0xd4, 0xc2, 0x4e, 0xad, 0xb7, 0x56, 0xa3, 0x07, 0x55, 0xd7, 0xc3, 0x14, 0xcb, 0x67, 0x3b,
+	0x2d, 0xbb, 0x7a, 0x2c, 0xab, 0xf6, 0xd6, 0x94, 0x05, 0x13, 0x9b, 0x98, 0x49, 0x6b, 0xfe, 0x57,
+	0x00, 0x54, 0xd4, 0x61, 0x92, 0x88, 0x5a, 0x80, 0x29, 0xb7, 0x31, 0xb1, 0x31, 0xa9, 0xb5, 0x74,
+	0x82, 0x6a, 0xbd, 0xb5, 0x16, 0xa2, 0xfa, 0x5a, 0xad, 0x8d, 0xad, 0x50, 0xbe, 0x68, 0x62, 0x6c,
+	0x76, 0x50, 0x8d, 0x9d, 0x5a, 0xdd, 0xbd, 0x9a, 0xee, 0xf4, 0x43, 0xd5, 0xb8, 0xc8, 0xe8, 0x7a,
+	0x51, 0xea, 0xa5, 0xb8, 0x9c, 0x5a, 0x36, 0x22, 0x54, 0xb7, 0xdd, 0x90, 0x3b, 0xb0, 0xdd, 0x0c,
+	0x1c, 0x0f, 0x0e, 0x81, 0x48, 0xfd, 0x5e, 0x82, 0x33, 0x0d, 0x62, 0xd6, 0xbb, 0x8e, 0xb1, 0xeb,
+	0x21, 0x9d, 0x74, 0xbd, 0xbe, 0x2c, 0x43, 0x7e, 0xcf, 0xc3, 0x76, 0x49, 0xaa, 0x48, 0xcb, 0xb3,
+	0x1a, 0xfb, 0x96, 0x4d, 0x28, 0xe8, 0x36, 0xee, 0x3a, 0xb4, 0x94, 0xad, 0xe4, 0x96, 0x4f, 0xad,
+	0x2f, 0x56, 0x39, 0x8d, 0x1f, 0x4f, 0x95, 0xc7, 0x53, 0xdd, 0xc4, 0x96, 0xb3, 0xf1, 0xc6, 0xd3,
+	0x3f, 0x97, 0x32, 0x3f, 0xbd, 0x58, 0xba, 0x61, 0x5a, 0x74, 0xbf, 0xdb, 0xaa, 0xb6, 0xb1, 0x5d,
+	0xab, 0x5b, 0x0e, 0x69, 0xef, 0x5b, 0x7a, 0x6d, 0x8f, 0x7f, 0xac, 0x12, 0xe3, 0x51, 0x8d, 0xf6,
+	0x5d, 0x44, 0x98, 0x12, 0xd1, 0x38, 0xbd, 0xba, 0x08, 0x17, 0x62, 0xfe, 0x68, 0x88, 0xb8, 0xd8,
+	0x21, 0x48, 0xfd, 0x45, 0x62, 0xb2, 0x0f, 0x2d, 0xba, 0x6f, 0x78, 0xfa, 0x17, 0x75, 0x0f, 0xdb,
+	0xc2, 0xe7, 0x4b, 0x30, 0xab, 0x77, 0xe9, 0x3e, 0xf6, 0x2c, 0xda, 0xe7, 0x8e, 0x1f, 0xff, 0x90,
+	0x8b, 0x90, 0xa5, 0xb8, 0x94, 0x65, 0xbf, 0xb3, 0x14, 0x47, 0xa2, 0xc9, 0xfd, 0xb7, 0xd1, 0xec,
+	0xb3, 0xec, 0x3e, 0x74, 0x0d, 0x9d, 0xa2, 0x1d, 0xdd, 0xd3, 0x6d, 0x32, 0xc6, 0xd3, 0xb7, 0xa0,
+	0xe0, 0x32, 0x1c, 0xf3, 0xd6, 0xf7, 0x6c, 0xa8, 0x09, 0xab, 0x01, 0xd1, 0x46, 0xde, 0xf7, 0x4c,
+	0xe3, 0x70, 0x9e, 0xb7, 0xa8, 0x25, 0x91, 0xb7, 0x57, 0x60, 0x29, 0x25, 0x6d, 0x02, 0xf2, 0x35,
+	0xcc, 0x0b, 0xed, 0x06, 0xb2, 0x5b, 0xc8, 0x1b, 0xe7, 0x68, 0x03, 0x8a, 0x36, 0x03, 0x36, 0xbb,
+	0x4c, 0x8b, 0xf0, 0xc6, 0xa8, 0x24, 0x38, 0x1c, 0x30, 0x6a, 0xe8, 0xf3, 0x2e, 0x22, 0x94, 0xfb,
+	0x7d, 0x3a, 0xd0, 0x0e, 0x4c, 0x12, 0x55, 0x81, 0x52, 0xdc, 0x01, 0xe1, 0xdc, 0xb7, 0x52, 0x24,
+	0xb6, 0xbb, 0xa8, 0x6d, 0x11, 0x0b, 0x3b, 0x3b, 0xb8, 0x63, 0xb5, 0xc7, 0xd5, 0xfd, 0x7d, 0x38,
+	0x63, 0x70, 0x7c, 0xd3, 0x65, 0x0a, 0x3c, 0xad, 0x0b, 0xd5, 0xe0, 0xce, 0x54, 0xc3, 0x3b, 0x53,
+	0xbd, 0xe3, 0xf4, 0x37, 0xe4, 0x67, 0x4f, 0x56, 0x8b, 0x83, 0x06, 0xb4, 0xa2, 0x31, 0x70, 0xe6,
+	0xc9, 0x4c, 0xf2, 0x45, 0xf8, 0xfb, 0xb3, 0x04, 0x67, 0x1b, 0xc4, 0x7c, 0xd0, 0x6d, 0xd9, 0x16,
+	0xdd, 0xf1, 0xb0, 0x8b, 0x89, 0xde, 0xf1, 0x3d, 0x75, 0xd9, 0x37, 0xf2, 0x48, 0x49, 0xaa, 0xe4,
+	0x7c, 0x4f, 0xc5, 0x0f, 0x59, 0x81, 0x19, 0x1b, 0x51, 0xdd, 0xd0, 0xa9, 0xce, 0xfb, 0x54, 0x9c,
+	0xe5, 0x9b, 0xbe, 0x8c, 0x10, 0xdd, 0x44, 0x84, 0xf7, 0x6b, 0xa2, 0xfb, 0x9a, 0x40, 0xc9, 0xd7,
+	0x21, 0x8f, 0x0e, 0x50, 0xbb, 0x94, 0xaf, 0x48, 0xcb, 0xc5, 0xf5, 0x0b, 0x09, 0x25, 0xd9, 0x3a,
+	0x40, 0x6d, 0x8d, 0x81, 0xd4, 0x77, 0x60, 0x71, 0xc8, 0xdb, 0x30, 0x16, 0x79, 0x09, 0x4e, 0xb9,
+	0xfc, 0x5f, 0xd3, 0x32, 0x58, 0x86, 0xf3, 0x1a, 0x84, 0xbf, 0xb6, 0x0d, 0x75, 0x07, 0xce, 0x45,
+	0x9a, 0x4b, 0x44, 0x3b, 0x4e, 0x4f, 0x2e, 0xc1, 0xb4, 0x6e, 0x18, 0x1e, 0x22, 0x84, 0xc7, 0x1b,
+	0x1e, 0xd5, 0xcb, 0x70, 0x31, 0x81, 0x51, 0x64, 0xf7, 0x77, 0x09, 0xa6, 0x1b, 0xc4, 0xfc, 0x00,
+	0xd3, 0xf1, 0xde, 0xc9, 0x0b, 0x30, 0xd5, 0xc3, 0x14, 0x79, 0xdc, 0x46, 0x70, 0x90, 0xdf, 0x84,
+	0x02, 0x76, 0xfd, 0x4c, 0x94, 0x72, 0x2c, 0x41, 0x97, 0x13, 0x12, 0xe4, 0xf3, 0xdf, 0x67, 0x20,
+	0x8d, 0x83, 0x07, 0x6a, 0x94, 0x8f, 0xd5, 0x28, 0xcc, 0xf8, 0xd4, 0x24, 0x19, 0x3f, 0xcb, 0xa6,
+	0x82, 0x6f, 0x41, 0x44, 0xb5, 0xc1, 0x82, 0xf2, 0x31, 0xe3, 0x83, 0x3a, 0x0f, 0x05, 0x62, 0x99,
+	0x8e, 0x88, 0x8a, 0x9f, 0x38, 0x2d, 0xb3, 0x13, 0xd2, 0xee, 0x04, 0x96, 0x10, 0xc5, 0xd1, 0x3e,
+	0x1c, 0x71, 0x63, 0x62, 0xc6, 0xb3, 0x43, 0xf5, 0x0e, 0xe6, 0x4c, 0x94, 0x51, 0x18, 0xab, 0x82,
+	0xdc, 0x20, 0xe6, 0x3d, 0xa4, 0xf7, 0x50, 0x5d, 0x84, 0x1e, 0x2d, 0xb4, 0x34, 0x58, 0xe8, 0x4b,
+	0xa0, 0x0c, 0xe3, 0x05, 0xdb, 0x01, 0x6b, 0xac, 0xe0, 0xa2, 0x6d, 0x22, 0x87, 0x60, 0x8f, 0xec,
+	0x5b, 0xee, 0x18, 0xf7, 0x37, 0x01, 0xda, 0x02, 0xcb, 0xef, 0x7a, 0x52, 0x75, 0x8f, 0x09, 0xf9,
+	0x38, 0x8a, 0xa8, 0xf1, 0x06, 0x8c, 0x5b, 0x16, 0x8e, 0xfd, 0x2a, 0x41, 0xf9, 0x78, 0x56, 0x11,
+	0x73, 0xb7, 0xef, 0x9e, 0x6c, 0x2a, 0x55, 0x60, 0xce, 0x26, 0x66, 0xd3, 0x7f, 0x2f, 0x9a, 0x5d,
+	0xaf, 0xc3, 0xab, 0x08, 0x76, 0x40, 0xf5, 0xd0, 0xeb, 0x24, 0xcd, 0xad, 0xdc, 0xbf, 0x9c, 0x5b,
+	0xcb, 0x70, 0x6d, 0xb4, 0xd3, 0x22, 0xbe, 0x6f, 0x72, 0x70, 0xbe, 0x41, 0xcc, 0x4d, 0x0f, 0xb1,
+	0xa7, 0xa4, 0x6f, 0x23, 0x87, 0x3e, 0xa0, 0x1e, 0xd2, 0xed, 0x31, 0x71, 0x5d, 0x82, 0x59, 0x0f,
+	0xb5, 0x2d, 0xd7, 0x42, 0x6c, 0x4d, 0x60, 0x52, 0xf1, 0xe3, 0x7f, 0x7b, 0x73, 0xfd, 0x1e, 0x20,
+	0x54, 0xf7, 0x68, 0xd3, 0x5f, 0x83, 0xd8, 0x45, 0x3d, 0xb5, 0xae, 0x0c, 0xe5, 0x6d, 0x37, 0xdc,
+	0x91, 0x36, 0x66, 0x7c, 0x6b, 0x8f, 0x5f, 0x2c, 0x49, 0xda, 0x2c, 0xd3, 0xf3, 0x25, 0xf2, 0x6d,
+	0x98, 0x41, 0x8e, 0x11, 0x50, 0x4c, 0x9d, 0x80, 0x62, 0x1a, 0x39, 0x06, 0x23, 0xb8, 0x05, 0x05,
+	0x17, 0x79, 0x16, 0x36, 0x4a, 0x05, 0xfe, 0x90, 0xc7, 0xd5, 0xef, 0xf2, 0x2d, 0x2e, 0xd0, 0xfe,
+	0xc1, 0xd7, 0xe6, 0x2a, 0xea, 0x4d, 0xd6, 0x61, 0x09, 0x15, 0x10, 0x73, 0xb9, 0x08, 0x59, 0x31,
+	0x1b, 0xb2, 0x96, 0xa1, 0xd6, 0x83, 0x9a, 0xe9, 0x4e, 0x1b, 0x75, 0x4e, 0x52, 0xb3, 0x80, 0x27,
+	0x2b, 0x78, 0x2a, 0x81, 0xe5, 0x61, 0x1e, 0xd1, 0x1e, 0xbf, 0x49, 0x30, 0xd3, 0x20, 0xe6, 0xbb,
+	0x9e, 0xee, 0xd0, 0x31, 0xe4, 0x25, 0x98, 0x36, 0x7d, 0x18, 0x42, 0xe1, 0x8c, 0xe7, 0x47, 0xf9,
+	0x2b, 0x38, 0xcd, 0x61, 0x5f, 0xea, 0x62, 0x10, 0xa7, 0xb5, 0xf7, 0xed, 0x67, 0x4f, 0x56, 0x6f,
+	0x8d, 0x6d, 0x85, 0x83, 0xe8, 0xce, 0x7d, 0x27, 0x4a, 0xae, 0x0d, 0xda, 0x52, 0x65, 0xb6, 0xec,
+	0xb0, 0x00, 0x44, 0x54, 0x08, 0x66, 0x1b, 0xc4, 0xd4, 0x50, 0x0f, 0x3f, 0x42, 0xff, 0x38, 0xaa,
+	0xf8, 0xc5, 0xce, 0xc5, 0x2f, 0xb6, 0x7a, 0x8e, 0x6d, 0x06, 0x81, 0x99, 0xd0, 0xf6, 0xca, 0x0a,
+	0xe4, 0xd9, 0xe0, 0x5f, 0x80, 0xf9, 0xad, 0x8f, 0xb6, 0x36, 0x9b, 0x0f, 0xdf, 0x7b, 0xb0, 0xb3,
+	0xb5, 0xb9, 0x5d, 0xdf, 0xde, 0xba, 0x3b, 0x9f, 0x91, 0xe7, 0x60, 0x86, 0xfd, 0xdd, 0xd5, 0x3e,
+	0x9e, 0x97, 0xd6, 0x5f, 0xce, 0x41, 0xae, 0x41, 0x4c, 0xf9, 0x53, 0x98, 0x1b, 0xd8, 0xd9, 0xd5,
+	0xa4, 0xb5, 0x6b, 0x70, 0x8f, 0x56, 0x56, 0xc6, 0x63, 0x44, 0x7f, 0xf5, 0x60, 0x21, 0x71, 0xcf,
+	0x4e, 0xe1, 0x48, 0xc2, 0x2a, 0xeb, 0x93, 0x63, 0x85, 0x5d, 0x1d, 0x4e, 0x0f, 0x6e, 0xa1, 0x57,
+	0x92, 0x49, 0x06, 0x40, 0xca, 0xf5, 0x09, 0x40, 0xd1, 0xd0, 0x12, 0x57, 0xc9, 0x95, 0x51, 0x24,
+	0x83, 0xd8, 0xb4, 0xd0, 0x46, 0xad, 0x85, 0xb2, 0x01, 0xc5, 0xd8, 0x4a, 0x78, 0x35, 0x99, 0x65,
+	0x10, 0xa5, 0xdc, 0x98, 0x04, 0x25, 0xac, 0x7c, 0x06, 0xf3, 0x43, 0xcb, 0xd8, 0xb5, 0xd1, 0x85,
+	0x10, 0x96, 0xaa, 0x93, 0xe1, 0x84, 0xad, 0x3a, 0xe4, 0xd9, 0x1a, 0xa6, 0x24, 0xeb, 0xf9, 0x32,
+	0x45, 0x4d, 0x97, 0x45, 0x79, 0xd8, 0x05, 0x48, 0xe1, 0xf1, 0x65, 0x69, 0x3c, 0xd1, 0x6d, 0xc7,
+	0xbf, 0x14, 0x03, 0xab, 0x4e, 0x9a, 0xed, 0x08, 0x26, 0xed, 0x52, 0x24, 0x2d, 0x38, 0xb2, 0x09,
+	0x67, 0xe2, 0xdb, 0xcd, 0xab, 0xc9, 0xea, 0x31, 0x98, 0xb2, 0x3a, 0x11, 0x2c, 0x5a, 0xc4, 0xa1,
+	0xc5, 0xe7, 0xda, 0xa8, 0x96, 0x3b, 0xc6, 0xa5, 0x15, 0x31, 0x6d, 0x9d, 0x91, 0xbf, 0x93, 0xe0,
+	0xe2, 0xa8, 0x5d, 0x66, 0x6d, 0xe4, 0xdd, 0x4a, 0x52, 0x51, 0xde, 0x3e, 0xb1, 0x8a, 0xf0, 0x86,
+	0xc0, 0xb9, 0xa4, 0xc5, 0xe3, 0xb5, 0x64, 0xc6, 0x04, 0xa8, 0xb2, 0x36, 0x31, 0x74, 0xc0, 0x68,
+	0xc2, 0xcb, 0x99, 0x66, 0x74, 0x18, 0x9a, 0x6a, 0x34, 0xfd, 0x1d, 0x95, 0xb7, 0x61, 0x2a, 0x78,
+	0x43, 0x2f, 0x26, 0xeb, 0x32, 0xa1, 0x72, 0x65, 0x84, 0x50, 0x50, 0xdd, 0x83, 0x42, 0xf8, 0x72,
+	0x25, 0xc3, 0x03, 0xa9, 0x72, 0x75, 0x94, 0x34, 0x64, 0xdb, 0xb8, 0xff, 0xf4, 0xaf, 0x72, 0xe6,
+	0xc7, 0xc3, 0x72, 0xe6, 0xe9, 0x61, 0x59, 0x7a, 0x7e, 0x58, 0x96, 0x5e, 0x1e, 0x96, 0xa5, 0xc7,
+	0x47, 0xe5, 0xcc, 0xf3, 0xa3, 0x72, 0xe6, 0x8f, 0xa3, 0x72, 0xe6, 0x93, 0xd5, 0x13, 0x3d, 0xc6,
+	0xad, 0x02, 0x7b, 0xcd, 0x5f, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x60, 0x97, 0x41, 0x6c,
+	0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1232,10 +1593,18 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// VetoProposal vetoes an accepted proposal during its timelock.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 	// LeaveFoundation allows a member to leave the foundation.
 	LeaveFoundation(ctx context.Context, in *MsgLeaveFoundation, opts ...grpc.CallOption) (*MsgLeaveFoundationResponse, error)
 	// UpdateCensorship updates censorship information.
 	UpdateCensorship(ctx context.Context, in *MsgUpdateCensorship, opts ...grpc.CallOption) (*MsgUpdateCensorshipResponse, error)
+	// UpdateMsgTypeDecisionPolicy sets or removes the decision policy of a message type.
+	UpdateMsgTypeDecisionPolicy(ctx context.Context, in *MsgUpdateMsgTypeDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateMsgTypeDecisionPolicyResponse, error)
+	// CreatePaymentStream creates a stream of payments from the treasury.
+	CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error)
+	// CancelPaymentStream cancels a payment stream.
+	CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error)
 	// Grant grants the provided authorization to the grantee with authority of
 	// the foundation. If there is already a grant for the given
 	// (grantee, Authorization) tuple, then the grant will be overwritten.
@@ -1325,6 +1694,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveFoundation(ctx context.Context, in *MsgLeaveFoundation, opts ...grpc.CallOption) (*MsgLeaveFoundationResponse, error) {
 	out := new(MsgLeaveFoundationResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/LeaveFoundation", in, out, opts...)
@@ -1343,6 +1721,33 @@ func (c *msgClient) UpdateCensorship(ctx context.Context, in *MsgUpdateCensorshi
 	return out, nil
 }
 
+func (c *msgClient) UpdateMsgTypeDecisionPolicy(ctx context.Context, in *MsgUpdateMsgTypeDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateMsgTypeDecisionPolicyResponse, error) {
+	out := new(MsgUpdateMsgTypeDecisionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UpdateMsgTypeDecisionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error) {
+	out := new(MsgCreatePaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/CreatePaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error) {
+	out := new(MsgCancelPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/CancelPaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Grant(ctx context.Context, in *MsgGrant, opts ...grpc.CallOption) (*MsgGrantResponse, error) {
 	out := new(MsgGrantResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/Grant", in, out, opts...)
@@ -1379,10 +1784,18 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// VetoProposal vetoes an accepted proposal during its timelock.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 	// LeaveFoundation allows a member to leave the foundation.
 	LeaveFoundation(context.Context, *MsgLeaveFoundation) (*MsgLeaveFoundationResponse, error)
 	// UpdateCensorship updates censorship information.
 	UpdateCensorship(context.Context, *MsgUpdateCensorship) (*MsgUpdateCensorshipResponse, error)
+	// UpdateMsgTypeDecisionPolicy sets or removes the decision policy of a message type.
+	UpdateMsgTypeDecisionPolicy(context.Context, *MsgUpdateMsgTypeDecisionPolicy) (*MsgUpdateMsgTypeDecisionPolicyResponse, error)
+	// CreatePaymentStream creates a stream of payments from the treasury.
+	CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error)
+	// CancelPaymentStream cancels a payment stream.
+	CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error)
 	// Grant grants the provided authorization to the grantee with authority of
 	// the foundation. If there is already a grant for the given
 	// (grantee, Authorization) tuple, then the grant will be overwritten.
@@ -1420,12 +1833,24 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 func (*UnimplementedMsgServer) LeaveFoundation(ctx context.Context, req *MsgLeaveFoundation) (*MsgLeaveFoundationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveFoundation not implemented")
 }
 func (*UnimplementedMsgServer) UpdateCensorship(ctx context.Context, req *MsgUpdateCensorship) (*MsgUpdateCensorshipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCensorship not implemented")
 }
+func (*UnimplementedMsgServer) UpdateMsgTypeDecisionPolicy(ctx context.Context, req *MsgUpdateMsgTypeDecisionPolicy) (*MsgUpdateMsgTypeDecisionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMsgTypeDecisionPolicy not implemented")
+}
+func (*UnimplementedMsgServer) CreatePaymentStream(ctx context.Context, req *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelPaymentStream(ctx context.Context, req *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentStream not implemented")
+}
 func (*UnimplementedMsgServer) Grant(ctx context.Context, req *MsgGrant) (*MsgGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Grant not implemented")
 }
@@ -1581,6 +2006,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveFoundation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveFoundation)
 	if err := dec(in); err != nil {
@@ -1617,59 +2060,113 @@ func _Msg_UpdateCensorship_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrant)
+func _Msg_UpdateMsgTypeDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMsgTypeDecisionPolicy)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Grant(ctx, in)
+		return srv.(MsgServer).UpdateMsgTypeDecisionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.foundation.v1.Msg/Grant",
+		FullMethod: "/lbm.foundation.v1.Msg/UpdateMsgTypeDecisionPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Grant(ctx, req.(*MsgGrant))
+		return srv.(MsgServer).UpdateMsgTypeDecisionPolicy(ctx, req.(*MsgUpdateMsgTypeDecisionPolicy))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevoke)
+func _Msg_CreatePaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentStream)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Revoke(ctx, in)
+		return srv.(MsgServer).CreatePaymentStream(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.foundation.v1.Msg/Revoke",
+		FullMethod: "/lbm.foundation.v1.Msg/CreatePaymentStream",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Revoke(ctx, req.(*MsgRevoke))
+		return srv.(MsgServer).CreatePaymentStream(ctx, req.(*MsgCreatePaymentStream))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lbm.foundation.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FundTreasury",
-			Handler:    _Msg_FundTreasury_Handler,
-		},
-		{
-			MethodName: "WithdrawFromTreasury",
-			Handler:    _Msg_WithdrawFromTreasury_Handler,
-		},
-		{
-			MethodName: "UpdateMembers",
-			Handler:    _Msg_UpdateMembers_Handler,
-		},
-		{
+func _Msg_CancelPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/CancelPaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentStream(ctx, req.(*MsgCancelPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Grant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/Grant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Grant(ctx, req.(*MsgGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevoke)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/Revoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Revoke(ctx, req.(*MsgRevoke))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.foundation.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FundTreasury",
+			Handler:    _Msg_FundTreasury_Handler,
+		},
+		{
+			MethodName: "WithdrawFromTreasury",
+			Handler:    _Msg_WithdrawFromTreasury_Handler,
+		},
+		{
+			MethodName: "UpdateMembers",
+			Handler:    _Msg_UpdateMembers_Handler,
+		},
+		{
 			MethodName: "UpdateDecisionPolicy",
 			Handler:    _Msg_UpdateDecisionPolicy_Handler,
 		},
@@ -1689,6 +2186,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 		{
 			MethodName: "LeaveFoundation",
 			Handler:    _Msg_LeaveFoundation_Handler,
@@ -1697,6 +2198,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCensorship",
 			Handler:    _Msg_UpdateCensorship_Handler,
 		},
+		{
+			MethodName: "UpdateMsgTypeDecisionPolicy",
+			Handler:    _Msg_UpdateMsgTypeDecisionPolicy_Handler,
+		},
+		{
+			MethodName: "CreatePaymentStream",
+			Handler:    _Msg_CreatePaymentStream_Handler,
+		},
+		{
+			MethodName: "CancelPaymentStream",
+			Handler:    _Msg_CancelPaymentStream_Handler,
+		},
 		{
 			MethodName: "Grant",
 			Handler:    _Msg_Grant_Handler,
@@ -2323,6 +2836,64 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLeaveFoundation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2439,7 +3010,7 @@ func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateMsgTypeDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2449,19 +3020,19 @@ func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateMsgTypeDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateMsgTypeDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Authorization != nil {
+	if m.DecisionPolicy != nil {
 		{
-			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2471,10 +3042,10 @@ func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2488,7 +3059,7 @@ func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2498,12 +3069,12 @@ func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2511,7 +3082,7 @@ func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevoke) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePaymentStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2521,27 +3092,58 @@ func (m *MsgRevoke) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevoke) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePaymentStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2555,7 +3157,7 @@ func (m *MsgRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePaymentStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2565,66 +3167,268 @@ func (m *MsgRevokeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelPaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundTreasury) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelPaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundTreasuryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawFromTreasury) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevoke) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevoke) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.To)
@@ -2852,6 +3656,31 @@ func (m *MsgExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgLeaveFoundation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2898,7 +3727,7 @@ func (m *MsgUpdateCensorshipResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgGrant) Size() (n int) {
+func (m *MsgUpdateMsgTypeDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2908,18 +3737,18 @@ func (m *MsgGrant) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Grantee)
+	l = len(m.MsgTypeUrl)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Authorization != nil {
-		l = m.Authorization.Size()
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgGrantResponse) Size() (n int) {
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2928,7 +3757,7 @@ func (m *MsgGrantResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgRevoke) Size() (n int) {
+func (m *MsgCreatePaymentStream) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2938,41 +3767,137 @@ func (m *MsgRevoke) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Grantee)
+	l = len(m.Recipient)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgRevokeResponse) Size() (n int) {
+func (m *MsgCreatePaymentStreamResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCancelPaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCancelPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgFundTreasury) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevoke) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -3249,14 +4174,950 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3278,7 +5139,7 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,15 +5162,34 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3337,13 +5217,32 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3353,75 +5252,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3443,7 +5310,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3466,10 +5333,10 @@ func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3493,7 +5360,7 @@ func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3516,17 +5383,17 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3536,29 +5403,16 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3568,25 +5422,23 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3609,7 +5461,7 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3632,10 +5484,10 @@ func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3659,7 +5511,7 @@ func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3682,10 +5534,10 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3721,41 +5573,24 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3777,7 +5612,7 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3800,10 +5635,10 @@ func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3827,7 +5662,7 @@ func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3850,47 +5685,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveFoundation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3918,61 +5721,8 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3994,7 +5744,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4017,31 +5767,12 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveFoundationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveFoundationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4063,7 +5794,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4086,17 +5817,17 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCensorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4106,16 +5837,29 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4125,23 +5869,24 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4164,7 +5909,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,10 +5932,10 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4214,7 +5959,7 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMsgTypeDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4237,34 +5982,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4292,30 +6018,11 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4343,13 +6050,13 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
 			}
-			m.Exec = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4359,11 +6066,28 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4385,7 +6109,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4408,10 +6132,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4435,7 +6159,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4458,17 +6182,17 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4478,14 +6202,27 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4513,113 +6250,113 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveFoundation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4629,23 +6366,24 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4668,7 +6406,7 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4691,12 +6429,31 @@ func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveFoundationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveFoundationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4718,7 +6475,7 @@ func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4741,10 +6498,10 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCensorship: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4780,10 +6537,10 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4793,25 +6550,11 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4833,7 +6576,7 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4856,10 +6599,10 @@ func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryTotalSwappableAmount(),
 		CmdQuerySwap(),
 		CmdQuerySwaps(),
+		CmdQuerySimulateSwap(),
 	)
 	return cmd
 }
@@ -149,3 +150,39 @@ func CmdQuerySwaps() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQuerySimulateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-swap [from_coin_amount] [to_denom]",
+		Short: "shows the output of a swap at the current block and the swappable amount left after it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			hopDenoms, err := cmd.Flags().GetStringSlice(FlagHopDenoms)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateSwapRequest{
+				FromCoinAmount: args[0],
+				ToDenom:        args[1],
+				HopDenoms:      hopDenoms,
+			}
+			res, err := queryClient.SimulateSwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagHopDenoms, nil, "intermediate denoms to swap through in order, ex) kei,cony")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
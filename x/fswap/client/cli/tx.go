@@ -3,6 +3,8 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,10 +18,15 @@ import (
 )
 
 const (
-	FlagFromDenom           = "from-denom"
-	FlagToDenom             = "to-denom"
-	FlagAmountCapForToDenom = "to-coin-amount-cap"
-	FlagSwapRate            = "swap-rate"
+	FlagFromDenom             = "from-denom"
+	FlagToDenom               = "to-denom"
+	FlagAmountCapForToDenom   = "to-coin-amount-cap"
+	FlagSwapRate              = "swap-rate"
+	FlagRateSchedule          = "rate-schedule"
+	FlagAmountCapForFromDenom = "from-coin-amount-cap"
+	FlagHopDenoms             = "hop-denoms"
+	FlagEndTime               = "end-time"
+	FlagMinToCoinAmount       = "min-to-coin-amount"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -63,10 +70,22 @@ func CmdTxMsgSwap() *cobra.Command {
 
 			toDenom := args[2]
 
+			hopDenoms, err := cmd.Flags().GetStringSlice(FlagHopDenoms)
+			if err != nil {
+				return err
+			}
+
+			minToCoinAmount, err := parseMinToCoinAmount(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgSwap{
-				FromAddress:    from,
-				FromCoinAmount: amount,
-				ToDenom:        toDenom,
+				FromAddress:     from,
+				FromCoinAmount:  amount,
+				ToDenom:         toDenom,
+				HopDenoms:       hopDenoms,
+				MinToCoinAmount: minToCoinAmount,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -75,6 +94,9 @@ func CmdTxMsgSwap() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagHopDenoms, nil, "intermediate denoms to swap through in order, ex) kei,cony")
+	cmd.Flags().String(FlagMinToCoinAmount, "", "minimum amount of to-coin to receive, the swap fails if the output is below it")
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -97,10 +119,16 @@ func CmdTxMsgSwapAll() *cobra.Command {
 
 			fromDenom := args[1]
 			toDenom := args[2]
+			minToCoinAmount, err := parseMinToCoinAmount(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgSwapAll{
-				FromAddress: clientCtx.GetFromAddress().String(),
-				FromDenom:   fromDenom,
-				ToDenom:     toDenom,
+				FromAddress:     clientCtx.GetFromAddress().String(),
+				FromDenom:       fromDenom,
+				ToDenom:         toDenom,
+				MinToCoinAmount: minToCoinAmount,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -110,6 +138,8 @@ func CmdTxMsgSwapAll() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMinToCoinAmount, "", "minimum amount of to-coin to receive, the swap fails if the output is below it")
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -188,11 +218,46 @@ Example of the content of metadata-json:
 			if err != nil {
 				return err
 			}
+
+			rateScheduleStrs, err := cmd.Flags().GetStringSlice(FlagRateSchedule)
+			if err != nil {
+				return err
+			}
+			rateSchedule, err := parseRateSchedule(rateScheduleStrs)
+			if err != nil {
+				return err
+			}
+
 			swap := types.Swap{
 				FromDenom:           fromDenom,
 				ToDenom:             toDenom,
 				AmountCapForToDenom: amountCap,
 				SwapRate:            swapRateDec,
+				RateSchedule:        rateSchedule,
+			}
+
+			reverseCapStr, err := cmd.Flags().GetString(FlagAmountCapForFromDenom)
+			if err != nil {
+				return err
+			}
+			if len(reverseCapStr) != 0 {
+				reverseCap, ok := sdk.NewIntFromString(reverseCapStr)
+				if !ok {
+					return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse %s %s", FlagAmountCapForFromDenom, reverseCapStr)
+				}
+				swap.AmountCapForFromDenom = &reverseCap
+			}
+
+			endTimeStr, err := cmd.Flags().GetString(FlagEndTime)
+			if err != nil {
+				return err
+			}
+			if len(endTimeStr) != 0 {
+				endTime, err := time.Parse(time.RFC3339, endTimeStr)
+				if err != nil {
+					return err
+				}
+				swap.EndTime = &endTime
 			}
 
 			authority := args[0]
@@ -215,6 +280,9 @@ Example of the content of metadata-json:
 	cmd.Flags().String(FlagToDenom, "", "set toDenom string, ex) peb")
 	cmd.Flags().String(FlagAmountCapForToDenom, "0", "set integer value for limit cap for the amount to swap to to-denom, ex 1000000000")
 	cmd.Flags().String(FlagSwapRate, "0", "set swap rate for swap from fromDenom to toDenom, ex(rate for cony to peb)  148079656000000")
+	cmd.Flags().StringSlice(FlagRateSchedule, nil, "set swap rates applied from the given block times, ex) 2024-07-01T00:00:00Z=148079656000000")
+	cmd.Flags().String(FlagAmountCapForFromDenom, "", "set integer value for limit cap for the amount to swap back to from-denom, the reverse direction is disabled if empty")
+	cmd.Flags().String(FlagEndTime, "", "set block time from which the swap is closed, ex) 2024-12-31T00:00:00Z")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -231,6 +299,46 @@ func validateGenerateOnly(cmd *cobra.Command) error {
 	return nil
 }
 
+func parseMinToCoinAmount(cmd *cobra.Command) (*sdk.Int, error) {
+	minStr, err := cmd.Flags().GetString(FlagMinToCoinAmount)
+	if err != nil {
+		return nil, err
+	}
+	if len(minStr) == 0 {
+		return nil, nil
+	}
+
+	minAmount, ok := sdk.NewIntFromString(minStr)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to parse %s %s", FlagMinToCoinAmount, minStr)
+	}
+	return &minAmount, nil
+}
+
+func parseRateSchedule(steps []string) ([]types.SwapRateStep, error) {
+	schedule := make([]types.SwapRateStep, 0, len(steps))
+	for _, step := range steps {
+		startTimeStr, rateStr, found := strings.Cut(step, "=")
+		if !found {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid rate step %s, expected <start_time>=<swap_rate>", step)
+		}
+
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return nil, err
+		}
+
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, err
+		}
+
+		schedule = append(schedule, types.SwapRateStep{StartTime: startTime, SwapRate: rate})
+	}
+
+	return schedule, nil
+}
+
 func parseToDenomMetadata(jsonDenomMetadata string) (bank.Metadata, error) {
 	type toDenomMeta struct {
 		Metadata bank.Metadata `json:"metadata"`
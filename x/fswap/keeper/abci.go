@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// EndBlocker closes the swaps which have passed their end time.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if err := k.closeExpiredSwaps(ctx); err != nil {
+		panic(err)
+	}
+}
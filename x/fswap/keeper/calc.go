@@ -5,3 +5,8 @@ import "github.com/Finschia/finschia-sdk/types"
 func CalcSwap(swapRate types.Dec, fromCoinAmount types.Int) types.Int {
 	return swapRate.MulTruncate(types.NewDecFromBigInt(fromCoinAmount.BigInt())).TruncateInt()
 }
+
+// CalcReverseSwap returns the amount of from-denom swapped back from the amount of to-denom.
+func CalcReverseSwap(swapRate types.Dec, toCoinAmount types.Int) types.Int {
+	return types.NewDecFromBigInt(toCoinAmount.BigInt()).QuoTruncate(swapRate).TruncateInt()
+}
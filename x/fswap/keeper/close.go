@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+// closeExpiredSwaps marks the swaps which have passed their end time as closed,
+// so their Swapped totals are frozen from then on.
+func (k Keeper) closeExpiredSwaps(ctx sdk.Context) error {
+	type queueEntry struct {
+		endTime   time.Time
+		fromDenom string
+		toDenom   string
+	}
+	var entries []queueEntry
+	k.iterateSwapsByEndTime(ctx, ctx.BlockTime(), func(endTime time.Time, fromDenom, toDenom string) (stop bool) {
+		entries = append(entries, queueEntry{endTime: endTime, fromDenom: fromDenom, toDenom: toDenom})
+		return false
+	})
+
+	for _, entry := range entries {
+		k.removeSwapFromEndTimeQueue(ctx, entry.endTime, entry.fromDenom, entry.toDenom)
+		if k.isClosedSwap(ctx, entry.fromDenom, entry.toDenom) {
+			continue
+		}
+
+		swap, err := k.getSwap(ctx, entry.fromDenom, entry.toDenom)
+		if err != nil {
+			return err
+		}
+		if err := k.closeSwap(ctx, swap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) addSwapToEndTimeQueue(ctx sdk.Context, swap types.Swap) {
+	if swap.EndTime == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(swapByEndTimeKey(*swap.EndTime, swap.FromDenom, swap.ToDenom), []byte{})
+}
+
+func (k Keeper) removeSwapFromEndTimeQueue(ctx sdk.Context, endTime time.Time, fromDenom, toDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(swapByEndTimeKey(endTime, fromDenom, toDenom))
+}
+
+// iterateSwapsByEndTime iterates the swaps whose end time is not after the given time, in chronological order.
+func (k Keeper) iterateSwapsByEndTime(ctx sdk.Context, endTime time.Time, cb func(endTime time.Time, fromDenom, toDenom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(swapByEndTimeKeyPrefix, sdk.PrefixEndBytes(append(swapByEndTimeKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(splitSwapByEndTimeKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) closeSwap(ctx sdk.Context, swap types.Swap) error {
+	swapped, err := k.getSwapped(ctx, swap.FromDenom, swap.ToDenom)
+	if err != nil {
+		return err
+	}
+
+	reverseSwapped, err := k.getReverseSwapped(ctx, swap.FromDenom, swap.ToDenom)
+	if err != nil {
+		return err
+	}
+
+	k.setClosedSwap(ctx, types.ClosedSwap{
+		FromDenom: swap.FromDenom,
+		ToDenom:   swap.ToDenom,
+		ClosedAt:  ctx.BlockTime(),
+	})
+
+	leftover := sdk.NewCoin(swap.ToDenom, swap.AmountCapForToDenom.Sub(swapped.ToCoinAmount.Amount))
+	return ctx.EventManager().EmitTypedEvent(&types.EventSwapClosed{
+		Swap:                 swap,
+		Swapped:              swapped,
+		ReverseSwapped:       reverseSwapped,
+		LeftoverToCoinAmount: leftover,
+	})
+}
+
+func (k Keeper) isClosedSwap(ctx sdk.Context, fromDenom, toDenom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(closedSwapKey(fromDenom, toDenom))
+}
+
+func (k Keeper) setClosedSwap(ctx sdk.Context, closedSwap types.ClosedSwap) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(closedSwapKey(closedSwap.FromDenom, closedSwap.ToDenom), sdk.FormatTimeBytes(closedSwap.ClosedAt))
+}
+
+func (k Keeper) getAllClosedSwaps(ctx sdk.Context) []types.ClosedSwap {
+	closedSwaps := []types.ClosedSwap{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, closedSwapKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		closedAt, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		fromDenom, toDenom := splitDenoms(iterator.Key())
+		closedSwaps = append(closedSwaps, types.ClosedSwap{
+			FromDenom: fromDenom,
+			ToDenom:   toDenom,
+			ClosedAt:  closedAt,
+		})
+	}
+	return closedSwaps
+}
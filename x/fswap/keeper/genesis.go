@@ -36,6 +36,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) error
 		}
 	}
 
+	for _, swapped := range genState.GetReverseSwappeds() {
+		if err := k.setReverseSwapped(ctx, swapped); err != nil {
+			return err
+		}
+	}
+
+	for _, closedSwap := range genState.GetClosedSwaps() {
+		k.setClosedSwap(ctx, closedSwap)
+	}
+
 	return nil
 }
 
@@ -50,8 +60,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 	return &types.GenesisState{
-		Swaps:     k.getAllSwaps(ctx),
-		SwapStats: stats,
-		Swappeds:  k.getAllSwapped(ctx),
+		Swaps:           k.getAllSwaps(ctx),
+		SwapStats:       stats,
+		Swappeds:        k.getAllSwapped(ctx),
+		ReverseSwappeds: k.getAllReverseSwapped(ctx),
+		ClosedSwaps:     k.getAllClosedSwaps(ctx),
 	}
 }
@@ -100,5 +100,7 @@ func stubGenesisState() *types.GenesisState {
 				},
 			},
 		},
+		ReverseSwappeds: []types.Swapped{},
+		ClosedSwaps:     []types.ClosedSwap{},
 	}
 }
@@ -36,7 +36,15 @@ func (s QueryServer) Swapped(ctx context.Context, req *types.QuerySwappedRequest
 
 	swapped, err := s.Keeper.getSwapped(c, req.GetFromDenom(), req.GetToDenom())
 	if err != nil {
-		return nil, err
+		// the amounts swapped back through the reverse direction of the opposite swap
+		reverse, rerr := s.Keeper.getSwap(c, req.GetToDenom(), req.GetFromDenom())
+		if rerr != nil || !reverse.IsReversible() {
+			return nil, err
+		}
+
+		if swapped, err = s.Keeper.getReverseSwapped(c, req.GetToDenom(), req.GetFromDenom()); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.QuerySwappedResponse{
@@ -98,3 +106,28 @@ func (s QueryServer) Swaps(ctx context.Context, req *types.QuerySwapsRequest) (*
 		Pagination: pageResponse,
 	}, nil
 }
+
+func (s QueryServer) SimulateSwap(ctx context.Context, req *types.QuerySimulateSwapRequest) (*types.QuerySimulateSwapResponse, error) {
+	fromCoinAmount, err := sdk.ParseCoinNormalized(req.GetFromCoinAmount())
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if !fromCoinAmount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(fromCoinAmount.String())
+	}
+
+	if err := types.ValidateSwapRoute(fromCoinAmount.Denom, req.GetHopDenoms(), req.GetToDenom()); err != nil {
+		return nil, err
+	}
+
+	c := sdk.UnwrapSDKContext(ctx)
+	toCoinAmount, swappableAmount, err := s.Keeper.simulateSwap(c, fromCoinAmount, req.GetHopDenoms(), req.GetToDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateSwapResponse{
+		ToCoinAmount:    toCoinAmount,
+		SwappableAmount: swappableAmount,
+	}, nil
+}
@@ -57,27 +57,169 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 func (k Keeper) Swap(ctx sdk.Context, addr sdk.AccAddress, fromCoinAmount sdk.Coin, toDenom string) error {
+	_, err := k.swap(ctx, addr, fromCoinAmount, toDenom)
+	return err
+}
+
+// SwapThrough swaps the coin to toDenom through the intermediate denoms in order, and returns the final coin.
+func (k Keeper) SwapThrough(ctx sdk.Context, addr sdk.AccAddress, fromCoinAmount sdk.Coin, hopDenoms []string, toDenom string) (sdk.Coin, error) {
+	coin := fromCoinAmount
+	for _, denom := range routeDenoms(hopDenoms, toDenom) {
+		newCoin, err := k.swap(ctx, addr, coin, denom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		coin = newCoin
+	}
+	return coin, nil
+}
+
+// simulateSwap returns the final coin of the swap through the intermediate denoms,
+// and the swappable amount left on the last hop, without executing it.
+func (k Keeper) simulateSwap(ctx sdk.Context, fromCoinAmount sdk.Coin, hopDenoms []string, toDenom string) (sdk.Coin, sdk.Coin, error) {
+	var quote swapQuote
+	coin := fromCoinAmount
+	for _, denom := range routeDenoms(hopDenoms, toDenom) {
+		var err error
+		if quote, err = k.quoteSwap(ctx, coin, denom); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		coin = quote.newCoinAmount
+	}
+	return coin, quote.swappableAmount(), nil
+}
+
+func routeDenoms(hopDenoms []string, toDenom string) []string {
+	denoms := make([]string, 0, len(hopDenoms)+1)
+	denoms = append(denoms, hopDenoms...)
+	return append(denoms, toDenom)
+}
+
+// swapQuote is the outcome of a swap computed against the current state.
+type swapQuote struct {
+	swap          types.Swap
+	reverse       bool
+	newCoinAmount sdk.Coin
+	// swapped is the updated accounting of the direction taken.
+	swapped types.Swapped
+	// forwardSwapped is the accounting of the forward direction, which bounds a reverse swap.
+	forwardSwapped types.Swapped
+}
+
+func (q swapQuote) swappableAmount() sdk.Coin {
+	if q.reverse {
+		return sdk.NewCoin(q.newCoinAmount.Denom, reverseSwappableAmount(q.swap, q.swapped, q.forwardSwapped))
+	}
+	return sdk.NewCoin(q.newCoinAmount.Denom, q.swap.AmountCapForToDenom.Sub(q.swapped.ToCoinAmount.Amount))
+}
+
+// quoteSwap computes the swap through the registered swap of the pair, or the reverse direction of the opposite swap.
+func (k Keeper) quoteSwap(ctx sdk.Context, fromCoinAmount sdk.Coin, toDenom string) (swapQuote, error) {
 	swap, err := k.getSwap(ctx, fromCoinAmount.Denom, toDenom)
-	if err != nil {
-		return err
+	if err == nil {
+		if swap.IsClosedAt(ctx.BlockTime()) {
+			return swapQuote{}, types.ErrSwapClosed.Wrapf("%s to %s", swap.FromDenom, swap.ToDenom)
+		}
+		return k.quoteForward(ctx, swap, fromCoinAmount)
+	}
+
+	if reverse, rerr := k.getSwap(ctx, toDenom, fromCoinAmount.Denom); rerr == nil && reverse.IsReversible() {
+		if reverse.IsClosedAt(ctx.BlockTime()) {
+			return swapQuote{}, types.ErrSwapClosed.Wrapf("%s to %s", reverse.FromDenom, reverse.ToDenom)
+		}
+		return k.quoteReverse(ctx, reverse, fromCoinAmount)
 	}
 
-	newCoinAmountInt := CalcSwap(swap.SwapRate, fromCoinAmount.Amount)
-	newCoinAmount := sdk.NewCoin(toDenom, newCoinAmountInt)
+	return swapQuote{}, err
+}
+
+func (k Keeper) quoteForward(ctx sdk.Context, swap types.Swap, fromCoinAmount sdk.Coin) (swapQuote, error) {
+	newCoinAmountInt := CalcSwap(swap.RateAt(ctx.BlockTime()), fromCoinAmount.Amount)
+	newCoinAmount := sdk.NewCoin(swap.GetToDenom(), newCoinAmountInt)
 	swapped, err := k.getSwapped(ctx, swap.GetFromDenom(), swap.GetToDenom())
 	if err != nil {
-		return err
+		return swapQuote{}, err
+	}
+
+	updateSwapped := types.Swapped{
+		FromCoinAmount: fromCoinAmount.Add(swapped.FromCoinAmount),
+		ToCoinAmount:   newCoinAmount.Add(swapped.ToCoinAmount),
+	}
+	if err := k.checkSwapCap(swap, updateSwapped); err != nil {
+		return swapQuote{}, err
 	}
 
-	updateSwapped, err := k.updateSwapped(ctx, swapped, fromCoinAmount, newCoinAmount)
+	return swapQuote{swap: swap, newCoinAmount: newCoinAmount, swapped: updateSwapped}, nil
+}
+
+// quoteReverse prices the reverse swap at the rate in effect. The reverse swap is recorded apart
+// from the forward swap, and the total of it cannot go beyond the total of the forward swap.
+func (k Keeper) quoteReverse(ctx sdk.Context, swap types.Swap, fromCoinAmount sdk.Coin) (swapQuote, error) {
+	newCoinAmountInt := CalcReverseSwap(swap.RateAt(ctx.BlockTime()), fromCoinAmount.Amount)
+	newCoinAmount := sdk.NewCoin(swap.GetFromDenom(), newCoinAmountInt)
+	swapped, err := k.getReverseSwapped(ctx, swap.GetFromDenom(), swap.GetToDenom())
 	if err != nil {
-		return err
+		return swapQuote{}, err
 	}
 
-	if err := k.checkSwapCap(swap, updateSwapped); err != nil {
-		return err
+	updateSwapped := types.Swapped{
+		FromCoinAmount: fromCoinAmount.Add(swapped.FromCoinAmount),
+		ToCoinAmount:   newCoinAmount.Add(swapped.ToCoinAmount),
+	}
+	if swap.AmountCapForFromDenom.LT(updateSwapped.ToCoinAmount.Amount) {
+		return swapQuote{}, types.ErrExceedSwappableToCoinAmount
 	}
 
+	forwardSwapped, err := k.getSwapped(ctx, swap.GetFromDenom(), swap.GetToDenom())
+	if err != nil {
+		return swapQuote{}, err
+	}
+	if forwardSwapped.FromCoinAmount.IsLT(updateSwapped.ToCoinAmount) || forwardSwapped.ToCoinAmount.IsLT(updateSwapped.FromCoinAmount) {
+		return swapQuote{}, types.ErrExceedSwappableToCoinAmount.Wrapf("%s exceeds the forward swapped amount %s", updateSwapped.ToCoinAmount, forwardSwapped.FromCoinAmount)
+	}
+
+	return swapQuote{swap: swap, reverse: true, newCoinAmount: newCoinAmount, swapped: updateSwapped, forwardSwapped: forwardSwapped}, nil
+}
+
+// reverseSwappableAmount returns the amount of from-denom which can be swapped back, limited by
+// both of the reverse cap and the forward swapped amount.
+func reverseSwappableAmount(swap types.Swap, reverseSwapped, forwardSwapped types.Swapped) sdk.Int {
+	remainingAmount := swap.AmountCapForFromDenom.Sub(reverseSwapped.ToCoinAmount.Amount)
+	unwindableAmount := forwardSwapped.FromCoinAmount.Amount.Sub(reverseSwapped.ToCoinAmount.Amount)
+	return sdk.MinInt(remainingAmount, unwindableAmount)
+}
+
+func (k Keeper) swap(ctx sdk.Context, addr sdk.AccAddress, fromCoinAmount sdk.Coin, toDenom string) (sdk.Coin, error) {
+	quote, err := k.quoteSwap(ctx, fromCoinAmount, toDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if quote.reverse {
+		err = k.setReverseSwapped(ctx, quote.swapped)
+	} else {
+		err = k.setSwapped(ctx, quote.swapped)
+	}
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.exchange(ctx, addr, fromCoinAmount, quote.newCoinAmount); err != nil {
+		return sdk.Coin{}, err
+	}
+	return quote.newCoinAmount, nil
+}
+
+// checkMinToCoinAmount returns an error if the output of the swap is below the minimum, if any.
+func checkMinToCoinAmount(toCoinAmount sdk.Coin, minToCoinAmount *sdk.Int) error {
+	if minToCoinAmount != nil && toCoinAmount.Amount.LT(*minToCoinAmount) {
+		return types.ErrBelowMinToCoinAmount.Wrapf("%s is less than the minimum %s", toCoinAmount, minToCoinAmount)
+	}
+	return nil
+}
+
+// exchange burns the from-coin of the address and mints the new coin to it.
+func (k Keeper) exchange(ctx sdk.Context, addr sdk.AccAddress, fromCoinAmount, newCoinAmount sdk.Coin) error {
 	if err := k.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(fromCoinAmount)); err != nil {
 		return err
 	}
@@ -114,6 +256,18 @@ func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap, toDenomMetadata bank.M
 		return sdkerrors.ErrInvalidRequest.Wrap("update existing swap not allowed")
 	}
 
+	if k.isClosedSwap(ctx, swap.FromDenom, swap.ToDenom) {
+		return types.ErrSwapClosed.Wrap("closed swap cannot be updated")
+	}
+
+	if swap.IsClosedAt(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after the current block time")
+	}
+
+	if opposite, err := k.getSwap(ctx, swap.ToDenom, swap.FromDenom); err == nil && (swap.IsReversible() || opposite.IsReversible()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("reverse direction conflicts with the opposite swap")
+	}
+
 	if isNewSwap {
 		if err := k.increaseSwapCount(ctx); err != nil {
 			return err
@@ -225,12 +379,75 @@ func (k Keeper) setSwapped(ctx sdk.Context, swapped types.Swapped) error {
 	return nil
 }
 
+func (k Keeper) getAllReverseSwapped(ctx sdk.Context) []types.Swapped {
+	swappedSlice := []types.Swapped{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, reverseSwappedKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		swapped := types.Swapped{}
+		k.cdc.MustUnmarshal(iterator.Value(), &swapped)
+		swappedSlice = append(swappedSlice, swapped)
+	}
+	return swappedSlice
+}
+
+// getReverseSwapped returns the amounts swapped back through the reverse direction of the swap.
+// The from-coin amount is of to-denom and the to-coin amount is of from-denom.
+func (k Keeper) getReverseSwapped(ctx sdk.Context, fromDenom, toDenom string) (types.Swapped, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(reverseSwappedKey(fromDenom, toDenom))
+	if bz == nil {
+		return types.Swapped{
+			FromCoinAmount: sdk.NewCoin(toDenom, sdk.ZeroInt()),
+			ToCoinAmount:   sdk.NewCoin(fromDenom, sdk.ZeroInt()),
+		}, nil
+	}
+
+	swapped := types.Swapped{}
+	if err := k.cdc.Unmarshal(bz, &swapped); err != nil {
+		return types.Swapped{}, err
+	}
+	return swapped, nil
+}
+
+func (k Keeper) setReverseSwapped(ctx sdk.Context, swapped types.Swapped) error {
+	key := reverseSwappedKey(swapped.ToCoinAmount.Denom, swapped.FromCoinAmount.Denom)
+	bz, err := k.cdc.Marshal(&swapped)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	return nil
+}
+
 func (k Keeper) getSwappableNewCoinAmount(ctx sdk.Context, fromDenom, toDenom string) (sdk.Coin, error) {
 	swap, err := k.getSwap(ctx, fromDenom, toDenom)
 	if err != nil {
+		if reverse, rerr := k.getSwap(ctx, toDenom, fromDenom); rerr == nil && reverse.IsReversible() {
+			if reverse.IsClosedAt(ctx.BlockTime()) {
+				return sdk.NewCoin(toDenom, sdk.ZeroInt()), nil
+			}
+			swapped, err := k.getReverseSwapped(ctx, toDenom, fromDenom)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+			forwardSwapped, err := k.getSwapped(ctx, toDenom, fromDenom)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+			return sdk.NewCoin(toDenom, reverseSwappableAmount(reverse, swapped, forwardSwapped)), nil
+		}
 		return sdk.Coin{}, err
 	}
 
+	if swap.IsClosedAt(ctx.BlockTime()) {
+		return sdk.NewCoin(toDenom, sdk.ZeroInt()), nil
+	}
+
 	swapped, err := k.getSwapped(ctx, fromDenom, toDenom)
 	if err != nil {
 		return sdk.Coin{}, err
@@ -242,23 +459,6 @@ func (k Keeper) getSwappableNewCoinAmount(ctx sdk.Context, fromDenom, toDenom st
 	return sdk.NewCoin(toDenom, remainingAmount), nil
 }
 
-func (k Keeper) updateSwapped(ctx sdk.Context, curSwapped types.Swapped, fromAmount, toAmount sdk.Coin) (types.Swapped, error) {
-	updatedSwapped := types.Swapped{
-		FromCoinAmount: fromAmount.Add(curSwapped.FromCoinAmount),
-		ToCoinAmount:   toAmount.Add(curSwapped.ToCoinAmount),
-	}
-
-	key := swappedKey(fromAmount.Denom, toAmount.Denom)
-	bz, err := k.cdc.Marshal(&updatedSwapped)
-	if err != nil {
-		return types.Swapped{}, err
-	}
-
-	store := ctx.KVStore(k.storeKey)
-	store.Set(key, bz)
-	return updatedSwapped, nil
-}
-
 func (k Keeper) checkSwapCap(swap types.Swap, swapped types.Swapped) error {
 	swapCap := swap.AmountCapForToDenom
 	if swapCap.LT(swapped.ToCoinAmount.Amount) {
@@ -325,6 +525,9 @@ func denomMetadataEqual(metadata, otherMetadata bank.Metadata) bool {
 	if metadata.Symbol != otherMetadata.Symbol {
 		return false
 	}
+	if metadata.URI != otherMetadata.URI {
+		return false
+	}
 	return true
 }
 
@@ -353,6 +556,12 @@ func (k Keeper) setSwap(ctx sdk.Context, swap types.Swap) error {
 		return err
 	}
 
+	// replace the old one in the end time queue
+	if old, err := k.getSwap(ctx, swap.FromDenom, swap.ToDenom); err == nil && old.EndTime != nil {
+		k.removeSwapFromEndTimeQueue(ctx, *old.EndTime, old.FromDenom, old.ToDenom)
+	}
+	k.addSwapToEndTimeQueue(ctx, swap)
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 	return nil
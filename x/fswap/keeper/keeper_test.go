@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -310,7 +311,7 @@ func (s *KeeperTestSuite) TestSetSwap() {
 					Attributes: []abci.EventAttribute{
 						{
 							Key:   []byte("swap"),
-							Value: []uint8{0x7b, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x31, 0x22, 0x2c, 0x22, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x22, 0x31, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x22, 0x7d},
+							Value: []uint8{0x7b, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x31, 0x22, 0x2c, 0x22, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x22, 0x31, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x22, 0x2c, 0x22, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x6e, 0x75, 0x6c, 0x6c, 0x2c, 0x22, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x6e, 0x75, 0x6c, 0x6c, 0x7d},
 							Index: false,
 						},
 					},
@@ -320,7 +321,7 @@ func (s *KeeperTestSuite) TestSetSwap() {
 					Attributes: []abci.EventAttribute{
 						{
 							Key:   []byte("metadata"),
-							Value: []uint8{0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x2d, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x2c, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x3a, 0x5b, 0x5d, 0x7d, 0x5d, 0x2c, 0x22, 0x62, 0x61, 0x73, 0x65, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x44, 0x55, 0x4d, 0x4d, 0x59, 0x22, 0x2c, 0x22, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x3a, 0x22, 0x44, 0x55, 0x4d, 0x22, 0x7d},
+							Value: []uint8{0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x2d, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x2c, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x3a, 0x5b, 0x5d, 0x7d, 0x5d, 0x2c, 0x22, 0x62, 0x61, 0x73, 0x65, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x22, 0x44, 0x55, 0x4d, 0x4d, 0x59, 0x22, 0x2c, 0x22, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x3a, 0x22, 0x44, 0x55, 0x4d, 0x22, 0x2c, 0x22, 0x75, 0x72, 0x69, 0x22, 0x3a, 0x22, 0x22, 0x7d},
 							Index: false,
 						},
 					},
@@ -346,7 +347,7 @@ func (s *KeeperTestSuite) TestSetSwap() {
 					Attributes: []abci.EventAttribute{
 						{
 							Key:   []byte("swap"),
-							Value: []uint8{0x7b, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x31, 0x22, 0x2c, 0x22, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x22, 0x31, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x22, 0x7d},
+							Value: []uint8{0x7b, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x74, 0x6f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x2c, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x22, 0x31, 0x22, 0x2c, 0x22, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x22, 0x31, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x22, 0x2c, 0x22, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x3a, 0x6e, 0x75, 0x6c, 0x6c, 0x2c, 0x22, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x6e, 0x75, 0x6c, 0x6c, 0x7d},
 							Index: false,
 						},
 					},
@@ -485,3 +486,367 @@ func (s *KeeperTestSuite) TestSwapValidateBasic() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSwapRateSchedule() {
+	stepTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(3)
+	swap.RateSchedule = []types.SwapRateStep{
+		{StartTime: stepTime, SwapRate: sdk.NewDec(2)},
+		{StartTime: stepTime.Add(time.Hour), SwapRate: sdk.NewDec(1)},
+	}
+
+	testCases := map[string]struct {
+		blockTime      time.Time
+		expectedAmount sdk.Int
+	}{
+		"before the schedule": {
+			stepTime.Add(-time.Second),
+			sdk.NewInt(300),
+		},
+		"first step": {
+			stepTime,
+			sdk.NewInt(200),
+		},
+		"last step": {
+			stepTime.Add(2 * time.Hour),
+			sdk.NewInt(100),
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(tc.blockTime)
+			err := s.keeper.SetSwap(ctx, swap, s.toDenomMetadata)
+			s.Require().NoError(err)
+
+			err = s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.GetFromDenom(), sdk.NewInt(100)), swap.GetToDenom())
+			s.Require().NoError(err)
+
+			actualAmount := s.keeper.GetBalance(ctx, s.accWithFromCoin, swap.GetToDenom()).Amount
+			s.Require().Equal(tc.expectedAmount, actualAmount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReverseSwap() {
+	reverseCap := sdk.NewInt(10)
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(3)
+	swap.AmountCapForFromDenom = &reverseCap
+
+	// the rate has stepped down to 1, which prices both of the directions
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduled := swap
+	scheduled.SwapRate = sdk.NewDec(1)
+	scheduled.RateSchedule = []types.SwapRateStep{
+		{StartTime: blockTime.Add(-time.Hour), SwapRate: sdk.NewDec(3)},
+		{StartTime: blockTime.Add(-time.Minute), SwapRate: sdk.NewDec(1)},
+	}
+
+	testCases := map[string]struct {
+		swap             types.Swap
+		forwardAmount    sdk.Int
+		amountToSwap     sdk.Int
+		expectedAmount   sdk.Int
+		shouldThrowError bool
+		expectedError    error
+	}{
+		"swap back": {
+			swap,
+			sdk.NewInt(12),
+			sdk.NewInt(20),
+			sdk.NewInt(6),
+			false,
+			nil,
+		},
+		"swap back up to the cap": {
+			swap,
+			sdk.NewInt(12),
+			sdk.NewInt(32),
+			sdk.NewInt(10),
+			false,
+			nil,
+		},
+		"swap back at the current rate of the schedule": {
+			scheduled,
+			sdk.NewInt(12),
+			sdk.NewInt(9),
+			sdk.NewInt(9),
+			false,
+			nil,
+		},
+		"invalid: amount exceed": {
+			swap,
+			sdk.NewInt(12),
+			sdk.NewInt(33),
+			sdk.ZeroInt(),
+			true,
+			types.ErrExceedSwappableToCoinAmount,
+		},
+		"invalid: beyond the forward swap": {
+			swap,
+			sdk.NewInt(2),
+			sdk.NewInt(9),
+			sdk.ZeroInt(),
+			true,
+			types.ErrExceedSwappableToCoinAmount,
+		},
+		"invalid: reverse disabled": {
+			s.swap,
+			sdk.NewInt(2),
+			sdk.NewInt(20),
+			sdk.ZeroInt(),
+			true,
+			sdkerrors.ErrNotFound,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			err := s.keeper.SetSwap(ctx, tc.swap, s.toDenomMetadata)
+			s.Require().NoError(err)
+
+			err = s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(tc.swap.GetFromDenom(), tc.forwardAmount), tc.swap.GetToDenom())
+			s.Require().NoError(err)
+			forwardSwapped, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: tc.swap.GetFromDenom(), ToDenom: tc.swap.GetToDenom()})
+			s.Require().NoError(err)
+
+			err = s.keeper.Swap(ctx, s.accWithToCoin, sdk.NewCoin(tc.swap.GetToDenom(), tc.amountToSwap), tc.swap.GetFromDenom())
+			if tc.shouldThrowError {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			actualAmount := s.keeper.GetBalance(ctx, s.accWithToCoin, tc.swap.GetFromDenom()).Amount
+			s.Require().Equal(tc.expectedAmount, actualAmount)
+
+			// the forward accounting is untouched
+			swapped, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: tc.swap.GetFromDenom(), ToDenom: tc.swap.GetToDenom()})
+			s.Require().NoError(err)
+			s.Require().Equal(forwardSwapped.FromCoinAmount, swapped.FromCoinAmount)
+			s.Require().Equal(forwardSwapped.ToCoinAmount, swapped.ToCoinAmount)
+
+			reverseSwapped, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: tc.swap.GetToDenom(), ToDenom: tc.swap.GetFromDenom()})
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(tc.swap.GetToDenom(), tc.amountToSwap), reverseSwapped.FromCoinAmount)
+			s.Require().Equal(sdk.NewCoin(tc.swap.GetFromDenom(), tc.expectedAmount), reverseSwapped.ToCoinAmount)
+
+			swappable, err := s.queryServer.TotalSwappableToCoinAmount(sdk.WrapSDKContext(ctx), &types.QueryTotalSwappableToCoinAmountRequest{FromDenom: tc.swap.GetToDenom(), ToDenom: tc.swap.GetFromDenom()})
+			s.Require().NoError(err)
+			expectedSwappable := sdk.MinInt(reverseCap, swapped.FromCoinAmount.Amount).Sub(tc.expectedAmount)
+			s.Require().True(expectedSwappable.Equal(swappable.SwappableAmount.Amount), swappable.SwappableAmount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReverseSwapBoundedByForwardTotal() {
+	reverseCap := sdk.NewInt(100)
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(3)
+	swap.AmountCapForFromDenom = &reverseCap
+
+	ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+	s.Require().NoError(s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(4)), swap.ToDenom))
+
+	// each of the reverse swaps is within the forward swap, but not the total of them
+	s.Require().NoError(s.keeper.Swap(ctx, s.accWithToCoin, sdk.NewCoin(swap.ToDenom, sdk.NewInt(9)), swap.FromDenom))
+	err := s.keeper.Swap(ctx, s.accWithToCoin, sdk.NewCoin(swap.ToDenom, sdk.NewInt(9)), swap.FromDenom)
+	s.Require().ErrorIs(err, types.ErrExceedSwappableToCoinAmount)
+	s.Require().NoError(s.keeper.Swap(ctx, s.accWithToCoin, sdk.NewCoin(swap.ToDenom, sdk.NewInt(3)), swap.FromDenom))
+}
+
+func (s *KeeperTestSuite) TestSetSwapReverseConflict() {
+	reverseCap := sdk.NewInt(10)
+	reversible := s.swap
+	reversible.AmountCapForFromDenom = &reverseCap
+	opposite := types.Swap{
+		FromDenom:           s.swap.ToDenom,
+		ToDenom:             s.swap.FromDenom,
+		AmountCapForToDenom: sdk.NewInt(100),
+		SwapRate:            sdk.OneDec(),
+	}
+	fromDenomMetadata := bank.Metadata{
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: s.swap.FromDenom, Exponent: 0},
+		},
+		Base:    s.swap.FromDenom,
+		Display: s.swap.FromDenom,
+		Name:    "FROM",
+		Symbol:  "FROM",
+	}
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.BankKeeper.SetDenomMetaData(ctx, fromDenomMetadata)
+	config := types.Config{MaxSwaps: 0, UpdateAllowed: false}
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), config, types.DefaultAuthority().String(), app.AccountKeeper, app.BankKeeper)
+	s.Require().NoError(k.SetSwap(ctx, reversible, s.toDenomMetadata))
+
+	err := k.SetSwap(ctx, opposite, fromDenomMetadata)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *KeeperTestSuite) TestSwapEndTime() {
+	endTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	swap := s.swap
+	swap.EndTime = &endTime
+
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(endTime.Add(-time.Hour))
+	s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+
+	fromCoin := sdk.NewCoin(swap.GetFromDenom(), sdk.NewInt(100))
+	s.Require().NoError(s.keeper.Swap(ctx, s.accWithFromCoin, fromCoin, swap.GetToDenom()))
+
+	// the swap stays open before the end time
+	keeper.EndBlocker(ctx, s.keeper)
+	genesis := s.keeper.ExportGenesis(ctx)
+	s.Require().Empty(genesis.ClosedSwaps)
+
+	ctx = ctx.WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	err := s.keeper.Swap(ctx, s.accWithFromCoin, fromCoin, swap.GetToDenom())
+	s.Require().ErrorIs(err, types.ErrSwapClosed)
+
+	keeper.EndBlocker(ctx, s.keeper)
+	genesis = s.keeper.ExportGenesis(ctx)
+	s.Require().Equal([]types.ClosedSwap{{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom, ClosedAt: endTime}}, genesis.ClosedSwaps)
+
+	swappedAmount := keeper.CalcSwap(swap.SwapRate, fromCoin.Amount)
+	expectedEvent, err := sdk.TypedEventToEvent(&types.EventSwapClosed{
+		Swap: swap,
+		Swapped: types.Swapped{
+			FromCoinAmount: fromCoin,
+			ToCoinAmount:   sdk.NewCoin(swap.ToDenom, swappedAmount),
+		},
+		ReverseSwapped: types.Swapped{
+			FromCoinAmount: sdk.NewCoin(swap.ToDenom, sdk.ZeroInt()),
+			ToCoinAmount:   sdk.NewCoin(swap.FromDenom, sdk.ZeroInt()),
+		},
+		LeftoverToCoinAmount: sdk.NewCoin(swap.ToDenom, swap.AmountCapForToDenom.Sub(swappedAmount)),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Events{expectedEvent}, ctx.EventManager().Events())
+
+	// closed only once
+	ctx = ctx.WithBlockTime(endTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	keeper.EndBlocker(ctx, s.keeper)
+	s.Require().Empty(ctx.EventManager().Events())
+
+	// the stats are frozen but still queryable
+	swapped, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(fromCoin, swapped.FromCoinAmount)
+
+	swappable, err := s.queryServer.TotalSwappableToCoinAmount(sdk.WrapSDKContext(ctx), &types.QueryTotalSwappableToCoinAmountRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+	s.Require().NoError(err)
+	s.Require().True(swappable.SwappableAmount.IsZero())
+
+	_, err = s.msgServer.SwapAll(sdk.WrapSDKContext(ctx), &types.MsgSwapAll{FromAddress: s.accWithFromCoin.String(), FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+	s.Require().ErrorIs(err, types.ErrSwapClosed)
+}
+
+func (s *KeeperTestSuite) TestSwapEndTimeUpdate() {
+	endTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	swap := s.swap
+	swap.EndTime = &endTime
+
+	fromDenomMetadata, found := s.keeper.GetDenomMetaData(s.ctx, swap.FromDenom)
+	s.Require().True(found)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(endTime.Add(-time.Hour))
+	app.BankKeeper.SetDenomMetaData(ctx, fromDenomMetadata)
+	config := types.Config{MaxSwaps: 0, UpdateAllowed: true}
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), config, types.DefaultAuthority().String(), app.AccountKeeper, app.BankKeeper)
+	s.Require().NoError(k.SetSwap(ctx, swap, s.toDenomMetadata))
+
+	// postpone the end time
+	postponed := endTime.Add(time.Hour)
+	swap.EndTime = &postponed
+	s.Require().NoError(k.SetSwap(ctx, swap, s.toDenomMetadata))
+
+	ctx = ctx.WithBlockTime(endTime)
+	keeper.EndBlocker(ctx, k)
+	s.Require().Empty(k.ExportGenesis(ctx).ClosedSwaps)
+
+	ctx = ctx.WithBlockTime(postponed)
+	keeper.EndBlocker(ctx, k)
+	s.Require().Equal([]types.ClosedSwap{{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom, ClosedAt: postponed}}, k.ExportGenesis(ctx).ClosedSwaps)
+}
+
+func (s *KeeperTestSuite) TestSimulateSwap() {
+	reverseCap := sdk.NewInt(10)
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(3)
+	swap.AmountCapForFromDenom = &reverseCap
+
+	testCases := map[string]struct {
+		request                 *types.QuerySimulateSwapRequest
+		expectedToCoinAmount    sdk.Coin
+		expectedSwappableAmount sdk.Coin
+		expectedError           error
+	}{
+		"forward": {
+			&types.QuerySimulateSwapRequest{FromCoinAmount: "100" + swap.FromDenom, ToDenom: swap.ToDenom},
+			sdk.NewCoin(swap.ToDenom, sdk.NewInt(300)),
+			sdk.NewCoin(swap.ToDenom, swap.AmountCapForToDenom.SubRaw(336)),
+			nil,
+		},
+		"reverse": {
+			&types.QuerySimulateSwapRequest{FromCoinAmount: "20" + swap.ToDenom, ToDenom: swap.FromDenom},
+			sdk.NewCoin(swap.FromDenom, sdk.NewInt(6)),
+			sdk.NewCoin(swap.FromDenom, sdk.NewInt(4)),
+			nil,
+		},
+		"invalid: reverse cap exceeded": {
+			&types.QuerySimulateSwapRequest{FromCoinAmount: "33" + swap.ToDenom, ToDenom: swap.FromDenom},
+			sdk.Coin{},
+			sdk.Coin{},
+			types.ErrExceedSwappableToCoinAmount,
+		},
+		"invalid: from coin": {
+			&types.QuerySimulateSwapRequest{FromCoinAmount: "0" + swap.FromDenom, ToDenom: swap.ToDenom},
+			sdk.Coin{},
+			sdk.Coin{},
+			sdkerrors.ErrInvalidCoins,
+		},
+		"invalid: route": {
+			&types.QuerySimulateSwapRequest{FromCoinAmount: "100" + swap.FromDenom, ToDenom: swap.ToDenom, HopDenoms: []string{swap.ToDenom}},
+			sdk.Coin{},
+			sdk.Coin{},
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+			// the forward swap to be unwound by the reverse swap
+			s.Require().NoError(s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(12)), swap.ToDenom))
+
+			res, err := s.queryServer.SimulateSwap(sdk.WrapSDKContext(ctx), tc.request)
+			s.Require().ErrorIs(err, tc.expectedError)
+			if tc.expectedError != nil {
+				return
+			}
+			s.Require().Equal(tc.expectedToCoinAmount, res.ToCoinAmount)
+			s.Require().Equal(tc.expectedSwappableAmount, res.SwappableAmount)
+
+			// the simulation matches the actual swap
+			fromCoinAmount, err := sdk.ParseCoinNormalized(tc.request.FromCoinAmount)
+			s.Require().NoError(err)
+			addr := s.accWithFromCoin
+			if fromCoinAmount.Denom == swap.ToDenom {
+				addr = s.accWithToCoin
+			}
+			before := s.keeper.GetBalance(ctx, addr, tc.request.ToDenom)
+			s.Require().NoError(s.keeper.Swap(ctx, addr, fromCoinAmount, tc.request.ToDenom))
+			after := s.keeper.GetBalance(ctx, addr, tc.request.ToDenom)
+			s.Require().Equal(tc.expectedToCoinAmount, after.Sub(before))
+		})
+	}
+}
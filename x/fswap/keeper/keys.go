@@ -1,11 +1,23 @@
 package keeper
 
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
 var (
-	swapPrefix       = []byte{0x01}
-	swapStatsKey     = []byte{0x02}
-	swappedKeyPrefix = []byte{0x03}
+	swapPrefix              = []byte{0x01}
+	swapStatsKey            = []byte{0x02}
+	swappedKeyPrefix        = []byte{0x03}
+	reverseSwappedKeyPrefix = []byte{0x04}
+	closedSwapKeyPrefix     = []byte{0x05}
+	swapByEndTimeKeyPrefix  = []byte{0x06}
 )
 
+// must be constant
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
 // swapKey key(prefix + (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom)
 func swapKey(fromDenom, toDenom string) []byte {
 	denoms := combineDenoms(fromDenom, toDenom)
@@ -18,12 +30,63 @@ func swappedKey(fromDenom, toDenom string) []byte {
 	return append(swappedKeyPrefix, denoms...)
 }
 
+// reverseSwappedKey key(prefix + (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom) of the swap
+func reverseSwappedKey(fromDenom, toDenom string) []byte {
+	denoms := combineDenoms(fromDenom, toDenom)
+	return append(reverseSwappedKeyPrefix, denoms...)
+}
+
+// closedSwapKey key(prefix + (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom)
+func closedSwapKey(fromDenom, toDenom string) []byte {
+	denoms := combineDenoms(fromDenom, toDenom)
+	return append(closedSwapKeyPrefix, denoms...)
+}
+
+// swapByEndTimeKey key(prefix + endTime + (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom)
+func swapByEndTimeKey(endTime time.Time, fromDenom, toDenom string) []byte {
+	prefix := swapByEndTimeKeyPrefix
+	endTimeBz := sdk.FormatTimeBytes(endTime)
+	denoms := combineDenoms(fromDenom, toDenom)
+	key := make([]byte, len(prefix)+lenTime+len(denoms))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], endTimeBz)
+
+	begin += len(endTimeBz)
+	copy(key[begin:], denoms)
+
+	return key
+}
+
+func splitSwapByEndTimeKey(key []byte) (endTime time.Time, fromDenom, toDenom string) {
+	begin := len(swapByEndTimeKeyPrefix)
+	end := begin + lenTime
+	endTime, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	fromDenom, toDenom = splitDenoms(key[end:])
+	return
+}
+
 func combineDenoms(fromDenom, toDenom string) []byte {
 	lengthPrefixedFromDenom := lengthPrefix([]byte(fromDenom))
 	lengthPrefixedToDenom := lengthPrefix([]byte(toDenom))
 	return append(lengthPrefixedFromDenom, lengthPrefixedToDenom...)
 }
 
+// splitDenoms splits (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom into the denoms.
+func splitDenoms(bz []byte) (fromDenom, toDenom string) {
+	fromDenomLen := int(bz[0])
+	fromDenom = string(bz[1 : 1+fromDenomLen])
+	toDenom = string(bz[2+fromDenomLen:])
+	return
+}
+
 // lengthPrefix prefixes the address bytes with its length, this is used
 // for example for variable-length components in store keys.
 func lengthPrefix(bz []byte) []byte {
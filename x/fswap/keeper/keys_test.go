@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -51,3 +52,13 @@ func TestSwappedKey(t *testing.T) {
 		})
 	}
 }
+
+func TestSwapByEndTimeKey(t *testing.T) {
+	endTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := swapByEndTimeKey(endTime, "cony", "peb")
+
+	actualEndTime, fromDenom, toDenom := splitSwapByEndTimeKey(key)
+	require.Equal(t, endTime, actualEndTime)
+	require.Equal(t, "cony", fromDenom)
+	require.Equal(t, "peb", toDenom)
+}
@@ -30,7 +30,12 @@ func (s MsgServer) Swap(ctx context.Context, req *types.MsgSwap) (*types.MsgSwap
 		return &types.MsgSwapResponse{}, err
 	}
 
-	if err := s.keeper.Swap(c, from, req.GetFromCoinAmount(), req.GetToDenom()); err != nil {
+	toCoinAmount, err := s.keeper.SwapThrough(c, from, req.GetFromCoinAmount(), req.GetHopDenoms(), req.GetToDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkMinToCoinAmount(toCoinAmount, req.MinToCoinAmount); err != nil {
 		return nil, err
 	}
 
@@ -54,7 +59,12 @@ func (s MsgServer) SwapAll(ctx context.Context, req *types.MsgSwapAll) (*types.M
 		return nil, err
 	}
 
-	if err := s.keeper.Swap(c, from, balance, req.GetToDenom()); err != nil {
+	toCoinAmount, err := s.keeper.swap(c, from, balance, req.GetToDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkMinToCoinAmount(toCoinAmount, req.MinToCoinAmount); err != nil {
 		return nil, err
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	fkeeper "github.com/Finschia/finschia-sdk/x/fswap/keeper"
 	"github.com/Finschia/finschia-sdk/x/fswap/types"
+	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 )
 
 func (s *KeeperTestSuite) TestMsgSwap() {
@@ -70,6 +71,28 @@ func (s *KeeperTestSuite) TestMsgSwap() {
 			true,
 			sdkerrors.ErrInsufficientFunds,
 		},
+		"swap with min to-coin amount": {
+			&types.MsgSwap{
+				FromAddress:     s.accWithFromCoin.String(),
+				FromCoinAmount:  sdk.NewCoin(s.swap.GetFromDenom(), sdk.NewInt(2)),
+				ToDenom:         s.swap.GetToDenom(),
+				MinToCoinAmount: &swap2ExpectedAmount,
+			},
+			swap2ExpectedAmount,
+			false,
+			nil,
+		},
+		"output below min to-coin amount": {
+			&types.MsgSwap{
+				FromAddress:     s.accWithFromCoin.String(),
+				FromCoinAmount:  sdk.NewCoin(s.swap.GetFromDenom(), sdk.NewInt(1)),
+				ToDenom:         s.swap.GetToDenom(),
+				MinToCoinAmount: &swap2ExpectedAmount,
+			},
+			sdk.ZeroInt(),
+			true,
+			types.ErrBelowMinToCoinAmount,
+		},
 	}
 	for name, tc := range testCases {
 		s.Run(name, func() {
@@ -96,6 +119,7 @@ func (s *KeeperTestSuite) TestMsgSwap() {
 func (s *KeeperTestSuite) TestMsgSwapAll() {
 	swapAllExpectedBalance, ok := sdk.NewIntFromString("18281438845984584000000")
 	s.Require().True(ok)
+	swapAllMinAmount := swapAllExpectedBalance.AddRaw(1)
 	testCases := map[string]struct {
 		request          *types.MsgSwapAll
 		expectedAmount   sdk.Int
@@ -122,6 +146,17 @@ func (s *KeeperTestSuite) TestMsgSwapAll() {
 			true,
 			sdkerrors.ErrInsufficientFunds,
 		},
+		"output below min to-coin amount": {
+			&types.MsgSwapAll{
+				FromAddress:     s.accWithFromCoin.String(),
+				FromDenom:       s.swap.GetFromDenom(),
+				ToDenom:         s.swap.GetToDenom(),
+				MinToCoinAmount: &swapAllMinAmount,
+			},
+			sdk.ZeroInt(),
+			true,
+			types.ErrBelowMinToCoinAmount,
+		},
 	}
 	for name, tc := range testCases {
 		s.Run(name, func() {
@@ -258,3 +293,66 @@ func TestMsgSetSwap(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapThroughHops(t *testing.T) {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	config := types.Config{MaxSwaps: 0, UpdateAllowed: false}
+	fswapKeeper := fkeeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), config, types.DefaultAuthority().String(), app.AccountKeeper, app.BankKeeper)
+	msgServer := fkeeper.NewMsgServer(fswapKeeper)
+
+	metadata := func(denom string) bank.Metadata {
+		return bank.Metadata{
+			Description: denom,
+			DenomUnits:  []*bank.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:        denom,
+			Display:     denom,
+			Name:        denom,
+			Symbol:      denom,
+		}
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, metadata("aaa"))
+
+	reverseCap := sdk.NewInt(1000)
+	swaps := []types.Swap{
+		{FromDenom: "aaa", ToDenom: "bbb", AmountCapForToDenom: sdk.NewInt(1000), SwapRate: sdk.NewDec(2)},
+		{FromDenom: "ccc", ToDenom: "bbb", AmountCapForToDenom: sdk.NewInt(1000), SwapRate: sdk.NewDec(4), AmountCapForFromDenom: &reverseCap},
+	}
+	require.NoError(t, fswapKeeper.SetSwap(ctx, swaps[0], metadata("bbb")))
+	app.BankKeeper.SetDenomMetaData(ctx, metadata("ccc"))
+	require.NoError(t, fswapKeeper.SetSwap(ctx, swaps[1], metadata("bbb")))
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	holder, other := addrs[0], addrs[1]
+	coins := sdk.NewCoins(sdk.NewCoin("aaa", sdk.NewInt(100)), sdk.NewCoin("ccc", sdk.NewInt(50)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, holder, coins[:1]))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, other, coins[1:]))
+
+	// the reverse swap can only unwind the forward swap
+	_, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &types.MsgSwap{
+		FromAddress:    other.String(),
+		FromCoinAmount: sdk.NewCoin("ccc", sdk.NewInt(50)),
+		ToDenom:        "bbb",
+	})
+	require.NoError(t, err)
+
+	// aaa -> bbb (forward) -> ccc (reverse)
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &types.MsgSwap{
+		FromAddress:    holder.String(),
+		FromCoinAmount: sdk.NewCoin("aaa", sdk.NewInt(100)),
+		ToDenom:        "ccc",
+		HopDenoms:      []string{"bbb"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("ccc", sdk.NewInt(50))), app.BankKeeper.GetAllBalances(ctx, holder))
+
+	// no route between ccc and aaa without the hop
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &types.MsgSwap{
+		FromAddress:    holder.String(),
+		FromCoinAmount: sdk.NewCoin("ccc", sdk.NewInt(50)),
+		ToDenom:        "aaa",
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
@@ -12,4 +12,6 @@ var (
 	ErrCanNotHaveMoreSwap          = sdkerrors.Register(ModuleName, 3, "no more swap allowed")
 	ErrSwappedNotFound             = sdkerrors.Register(ModuleName, 4, "swapped does not exist")
 	ErrExceedSwappableToCoinAmount = sdkerrors.Register(ModuleName, 5, "exceed swappable to-coin amount")
+	ErrSwapClosed                  = sdkerrors.Register(ModuleName, 6, "swap closed")
+	ErrBelowMinToCoinAmount        = sdkerrors.Register(ModuleName, 7, "to-coin amount below minimum")
 )
@@ -176,42 +176,119 @@ func (m *EventAddDenomMetadata) GetMetadata() types1.Metadata {
 	return types1.Metadata{}
 }
 
+// EventSwapClosed is emitted when a swap has passed its end time.
+type EventSwapClosed struct {
+	Swap Swap `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap"`
+	// swapped defines the final amounts swapped through the swap.
+	Swapped Swapped `protobuf:"bytes,2,opt,name=swapped,proto3" json:"swapped"`
+	// reverse_swapped defines the final amounts swapped back through the reverse direction.
+	ReverseSwapped Swapped `protobuf:"bytes,3,opt,name=reverse_swapped,json=reverseSwapped,proto3" json:"reverse_swapped"`
+	// leftover_to_coin_amount defines the part of amount_cap_for_to_denom which has not been swapped.
+	LeftoverToCoinAmount types.Coin `protobuf:"bytes,4,opt,name=leftover_to_coin_amount,json=leftoverToCoinAmount,proto3" json:"leftover_to_coin_amount"`
+}
+
+func (m *EventSwapClosed) Reset()         { *m = EventSwapClosed{} }
+func (m *EventSwapClosed) String() string { return proto.CompactTextString(m) }
+func (*EventSwapClosed) ProtoMessage()    {}
+func (*EventSwapClosed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92d5edbd64a725af, []int{3}
+}
+func (m *EventSwapClosed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwapClosed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwapClosed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwapClosed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwapClosed.Merge(m, src)
+}
+func (m *EventSwapClosed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwapClosed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwapClosed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwapClosed proto.InternalMessageInfo
+
+func (m *EventSwapClosed) GetSwap() Swap {
+	if m != nil {
+		return m.Swap
+	}
+	return Swap{}
+}
+
+func (m *EventSwapClosed) GetSwapped() Swapped {
+	if m != nil {
+		return m.Swapped
+	}
+	return Swapped{}
+}
+
+func (m *EventSwapClosed) GetReverseSwapped() Swapped {
+	if m != nil {
+		return m.ReverseSwapped
+	}
+	return Swapped{}
+}
+
+func (m *EventSwapClosed) GetLeftoverToCoinAmount() types.Coin {
+	if m != nil {
+		return m.LeftoverToCoinAmount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventSwapCoins)(nil), "lbm.fswap.v1.EventSwapCoins")
 	proto.RegisterType((*EventSetSwap)(nil), "lbm.fswap.v1.EventSetSwap")
 	proto.RegisterType((*EventAddDenomMetadata)(nil), "lbm.fswap.v1.EventAddDenomMetadata")
+	proto.RegisterType((*EventSwapClosed)(nil), "lbm.fswap.v1.EventSwapClosed")
 }
 
 func init() { proto.RegisterFile("lbm/fswap/v1/event.proto", fileDescriptor_92d5edbd64a725af) }
 
 var fileDescriptor_92d5edbd64a725af = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcd, 0x8e, 0xda, 0x30,
-	0x14, 0x85, 0x13, 0x8a, 0xfa, 0x63, 0x10, 0xaa, 0xa2, 0x22, 0xa5, 0x48, 0x04, 0x94, 0x15, 0x52,
-	0x5b, 0x5b, 0x81, 0x5d, 0xd5, 0x0d, 0xe9, 0x8f, 0xba, 0xe9, 0x26, 0xdd, 0x54, 0xdd, 0x20, 0x27,
-	0x31, 0x10, 0x81, 0xed, 0x0c, 0x36, 0x01, 0xde, 0x62, 0xd6, 0xf3, 0x08, 0xf3, 0x24, 0x2c, 0x59,
-	0xce, 0x8a, 0x19, 0xc1, 0x1b, 0xcc, 0x13, 0x8c, 0xec, 0x04, 0x18, 0x34, 0x8b, 0x59, 0xcd, 0xee,
-	0x5a, 0xe7, 0xde, 0xf3, 0x5d, 0x1f, 0x1b, 0xd8, 0xd3, 0x90, 0xa2, 0xa1, 0x58, 0xe0, 0x14, 0x65,
-	0x1e, 0x22, 0x19, 0x61, 0x12, 0xa6, 0x33, 0x2e, 0xb9, 0x55, 0x9d, 0x86, 0x14, 0x6a, 0x05, 0x66,
-	0x5e, 0xe3, 0xc3, 0x88, 0x8f, 0xb8, 0x16, 0x90, 0xaa, 0xf2, 0x9e, 0x86, 0x13, 0x71, 0x41, 0xb9,
-	0x40, 0x21, 0x16, 0x04, 0x65, 0x5e, 0x48, 0x24, 0xf6, 0x50, 0xc4, 0x13, 0xf6, 0x44, 0x67, 0x93,
-	0xa3, 0xae, 0x0e, 0x85, 0x7e, 0x4e, 0xcf, 0x61, 0x5a, 0x71, 0xaf, 0x4a, 0xa0, 0xf6, 0x53, 0x6d,
-	0xf3, 0x77, 0x81, 0xd3, 0xef, 0x3c, 0x61, 0xc2, 0xb2, 0xc1, 0x1b, 0x1c, 0xc7, 0x33, 0x22, 0x84,
-	0x6d, 0xb6, 0xcd, 0xce, 0xbb, 0xe0, 0x70, 0xb4, 0x96, 0xe0, 0xfd, 0x70, 0xc6, 0xe9, 0x40, 0x91,
-	0x07, 0x98, 0xf2, 0x39, 0x93, 0x76, 0xa9, 0x6d, 0x76, 0x2a, 0xdd, 0x8f, 0x30, 0xdf, 0x00, 0xaa,
-	0x0d, 0x61, 0xb1, 0x01, 0x54, 0x7e, 0x7e, 0x6f, 0xbd, 0x6d, 0x19, 0xd7, 0xb7, 0xad, 0x4f, 0xa3,
-	0x44, 0x8e, 0xe7, 0x21, 0x8c, 0x38, 0x45, 0xbf, 0x12, 0x26, 0xa2, 0x71, 0x82, 0xd1, 0xb0, 0x28,
-	0xbe, 0x88, 0x78, 0x82, 0xe4, 0x2a, 0x25, 0x42, 0x0f, 0x05, 0x35, 0xc5, 0x51, 0x55, 0x5f, 0x53,
-	0x2c, 0x09, 0x6a, 0x92, 0x9f, 0x71, 0x5f, 0xbd, 0x08, 0xb7, 0x2a, 0xf9, 0x89, 0xea, 0x7e, 0x03,
-	0xd5, 0x3c, 0x1b, 0xa2, 0xe3, 0xb1, 0x3e, 0x83, 0xb2, 0x8a, 0x4e, 0xc7, 0x52, 0xe9, 0x5a, 0xf0,
-	0xf1, 0xcb, 0x41, 0xd5, 0xe1, 0x97, 0x15, 0x34, 0xd0, 0x5d, 0xee, 0x05, 0xa8, 0xeb, 0xe9, 0x7e,
-	0x1c, 0xff, 0x20, 0x8c, 0xd3, 0x3f, 0x44, 0xe2, 0x18, 0x4b, 0x6c, 0xfd, 0x03, 0x6f, 0x69, 0x51,
-	0x17, 0x56, 0xcd, 0xd3, 0x35, 0xd8, 0xe4, 0x78, 0x8d, 0xc3, 0x80, 0xdf, 0x54, 0xae, 0xf7, 0xdb,
-	0x56, 0x7d, 0x85, 0xe9, 0xf4, 0xab, 0x1b, 0x2b, 0xb7, 0xc1, 0xc1, 0xc2, 0x0d, 0x8e, 0x6e, 0xfe,
-	0xef, 0xf5, 0xce, 0x31, 0x37, 0x3b, 0xc7, 0xbc, 0xdb, 0x39, 0xe6, 0xe5, 0xde, 0x31, 0x36, 0x7b,
-	0xc7, 0xb8, 0xd9, 0x3b, 0xc6, 0x7f, 0xf8, 0x6c, 0x0a, 0xcb, 0xe2, 0x83, 0xe8, 0x34, 0xc2, 0xd7,
-	0xfa, 0x7b, 0xf4, 0x1e, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xbc, 0xd3, 0x3f, 0xb8, 0x02, 0x00,
-	0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0x6e, 0xba, 0x8a, 0x81, 0x57, 0x75, 0xc8, 0x5a, 0x45, 0x99, 0xb4, 0x74, 0xca, 0x69, 0x12,
+	0x60, 0xab, 0x9b, 0xb8, 0x20, 0x2e, 0x2b, 0x03, 0x71, 0xe1, 0x52, 0x10, 0x42, 0x5c, 0x22, 0xa7,
+	0xfe, 0xd3, 0x45, 0x8d, 0xe3, 0x10, 0x7b, 0xd9, 0xf6, 0x16, 0x1c, 0x38, 0xf1, 0x08, 0x3c, 0xc9,
+	0x8e, 0x3b, 0x72, 0x1a, 0xa8, 0x7d, 0x03, 0x9e, 0x00, 0xd9, 0x71, 0xda, 0x8d, 0x1d, 0x2a, 0x0e,
+	0x3b, 0xf5, 0x4f, 0xbf, 0xff, 0xfb, 0xbe, 0xdf, 0xff, 0x67, 0xa3, 0x5e, 0x1a, 0x09, 0x1a, 0xab,
+	0x53, 0x96, 0xd3, 0x72, 0x40, 0xa1, 0x84, 0x4c, 0x93, 0xbc, 0x90, 0x5a, 0xe2, 0x76, 0x1a, 0x09,
+	0x62, 0x11, 0x52, 0x0e, 0xb6, 0xb7, 0x26, 0x72, 0x22, 0x2d, 0x40, 0x4d, 0x55, 0xf5, 0x6c, 0xfb,
+	0x63, 0xa9, 0x84, 0x54, 0x34, 0x62, 0x0a, 0x68, 0x39, 0x88, 0x40, 0xb3, 0x01, 0x1d, 0xcb, 0x24,
+	0xbb, 0x85, 0x67, 0xd3, 0x05, 0x6e, 0x3e, 0x1c, 0x7e, 0xd3, 0xbd, 0x32, 0xb3, 0x48, 0xf0, 0xbd,
+	0x89, 0x3a, 0xaf, 0xcd, 0x34, 0xef, 0x4f, 0x59, 0xfe, 0x4a, 0x26, 0x99, 0xc2, 0x3d, 0xb4, 0xce,
+	0x38, 0x2f, 0x40, 0xa9, 0x9e, 0xb7, 0xeb, 0xed, 0x3d, 0x18, 0xd5, 0x9f, 0xf8, 0x0c, 0x3d, 0x8c,
+	0x0b, 0x29, 0x42, 0xe3, 0x1c, 0x32, 0x21, 0x4f, 0x32, 0xdd, 0x6b, 0xee, 0x7a, 0x7b, 0x1b, 0xfb,
+	0x8f, 0x49, 0x35, 0x01, 0x31, 0x13, 0x12, 0x37, 0x01, 0x31, 0x7a, 0xc3, 0x83, 0x8b, 0xab, 0x7e,
+	0xe3, 0xc7, 0xaf, 0xfe, 0x93, 0x49, 0xa2, 0x8f, 0x4f, 0x22, 0x32, 0x96, 0x82, 0xbe, 0x49, 0x32,
+	0x35, 0x3e, 0x4e, 0x18, 0x8d, 0x5d, 0xf1, 0x4c, 0xf1, 0x29, 0xd5, 0xe7, 0x39, 0x28, 0x4b, 0x1a,
+	0x75, 0x8c, 0x8f, 0xa9, 0x0e, 0xad, 0x0b, 0xd6, 0xa8, 0xa3, 0xe5, 0x0d, 0xdf, 0xb5, 0x3b, 0xf1,
+	0x6d, 0x6b, 0xb9, 0x74, 0x0d, 0x5e, 0xa2, 0x76, 0xb5, 0x1b, 0xb0, 0xeb, 0xc1, 0x4f, 0x51, 0xcb,
+	0xac, 0xce, 0xae, 0x65, 0x63, 0x1f, 0x93, 0xeb, 0xc9, 0x11, 0xd3, 0x31, 0x6c, 0x19, 0xd3, 0x91,
+	0xed, 0x0a, 0xbe, 0xa0, 0xae, 0x65, 0x1f, 0x72, 0x7e, 0x04, 0x99, 0x14, 0xef, 0x40, 0x33, 0xce,
+	0x34, 0xc3, 0x9f, 0xd0, 0x7d, 0xe1, 0x6a, 0x27, 0xb5, 0xb3, 0x3c, 0x46, 0x36, 0x5d, 0x1c, 0xa3,
+	0x26, 0x0c, 0x77, 0x8c, 0xea, 0x9f, 0xab, 0x7e, 0xf7, 0x9c, 0x89, 0xf4, 0x45, 0xc0, 0x8d, 0x5a,
+	0x58, 0x4b, 0x04, 0xa3, 0x85, 0x5a, 0xf0, 0xad, 0x89, 0x36, 0x97, 0x69, 0xa6, 0x52, 0x01, 0xff,
+	0xbf, 0xa1, 0xf1, 0x73, 0xb4, 0x6e, 0x7e, 0x73, 0xe0, 0x2e, 0xd9, 0xee, 0x6d, 0x42, 0x0e, 0xdc,
+	0x71, 0xea, 0x5e, 0x7c, 0x84, 0x36, 0x0b, 0x28, 0xa1, 0x50, 0x10, 0xd6, 0xf4, 0xb5, 0xd5, 0xf4,
+	0x8e, 0xe3, 0xb8, 0x7f, 0xf1, 0x47, 0xf4, 0x28, 0x85, 0x58, 0xcb, 0x12, 0x8a, 0xf0, 0x9f, 0xb8,
+	0x5b, 0xab, 0xe2, 0xae, 0x14, 0xb7, 0x6a, 0xfe, 0x87, 0x6b, 0x39, 0x0e, 0xdf, 0x5e, 0xcc, 0x7c,
+	0xef, 0x72, 0xe6, 0x7b, 0xbf, 0x67, 0xbe, 0xf7, 0x75, 0xee, 0x37, 0x2e, 0xe7, 0x7e, 0xe3, 0xe7,
+	0xdc, 0x6f, 0x7c, 0x26, 0x2b, 0x2f, 0xc7, 0x99, 0x7b, 0x37, 0xf6, 0x92, 0x44, 0xf7, 0xec, 0xab,
+	0x39, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x67, 0x5f, 0xe1, 0x6a, 0xcf, 0x03, 0x00, 0x00,
 }
 
 func (m *EventSwapCoins) Marshal() (dAtA []byte, err error) {
@@ -330,6 +407,69 @@ func (m *EventAddDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSwapClosed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapClosed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LeftoverToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ReverseSwapped.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Swapped.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -380,6 +520,23 @@ func (m *EventAddDenomMetadata) Size() (n int) {
 	return n
 }
 
+func (m *EventSwapClosed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Swapped.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ReverseSwapped.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LeftoverToCoinAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -700,6 +857,188 @@ func (m *EventAddDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSwapClosed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwapClosed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwapClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swapped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseSwapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReverseSwapped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftoverToCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeftoverToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
@@ -27,9 +29,48 @@ func (s *Swap) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("swap rate cannot be zero")
 	}
 
+	for i, step := range s.RateSchedule {
+		if step.SwapRate.IsNil() || !step.SwapRate.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("swap rate of the rate schedule step %d must be positive", i)
+		}
+		if i > 0 && !step.StartTime.After(s.RateSchedule[i-1].StartTime) {
+			return sdkerrors.ErrInvalidRequest.Wrap("rate schedule must be sorted by start time without duplicates")
+		}
+	}
+
+	if s.AmountCapForFromDenom != nil && s.AmountCapForFromDenom.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount cap for from denom cannot be negative")
+	}
+
+	if s.EndTime != nil && len(s.RateSchedule) != 0 && !s.EndTime.After(s.RateSchedule[len(s.RateSchedule)-1].StartTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after the last step of the rate schedule")
+	}
+
 	return nil
 }
 
+// RateAt returns the swap rate applied at the given block time.
+func (s Swap) RateAt(blockTime time.Time) sdk.Dec {
+	rate := s.SwapRate
+	for _, step := range s.RateSchedule {
+		if blockTime.Before(step.StartTime) {
+			break
+		}
+		rate = step.SwapRate
+	}
+	return rate
+}
+
+// IsReversible returns whether to-denom can be swapped back to from-denom.
+func (s Swap) IsReversible() bool {
+	return s.AmountCapForFromDenom != nil && s.AmountCapForFromDenom.IsPositive()
+}
+
+// IsClosedAt returns whether the swap has passed its end time at the given block time.
+func (s Swap) IsClosedAt(blockTime time.Time) bool {
+	return s.EndTime != nil && !blockTime.Before(*s.EndTime)
+}
+
 // ValidateBasic validates the set of SwapStats
 func (s *SwapStats) ValidateBasic() error {
 	if s.SwapCount < 0 {
@@ -9,15 +9,19 @@ import (
 	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,15 @@ type Swap struct {
 	ToDenom             string                                     `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
 	AmountCapForToDenom github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount_cap_for_to_denom,json=amountCapForToDenom,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount_cap_for_to_denom"`
 	SwapRate            github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+	// rate_schedule defines the rates replacing swap_rate from the given block times.
+	// The steps must be sorted by start_time in ascending order.
+	RateSchedule []SwapRateStep `protobuf:"bytes,5,rep,name=rate_schedule,json=rateSchedule,proto3" json:"rate_schedule,omitempty"`
+	// amount_cap_for_from_denom defines the cap of from-denom which can be swapped back from to-denom.
+	// Empty or zero means the reverse direction is disabled.
+	AmountCapForFromDenom *github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,6,opt,name=amount_cap_for_from_denom,json=amountCapForFromDenom,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount_cap_for_from_denom,omitempty"`
+	// end_time defines the block time from which the swap is closed.
+	// Empty means the swap stays open.
+	EndTime *time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
 func (m *Swap) Reset()         { *m = Swap{} }
@@ -79,6 +92,66 @@ func (m *Swap) GetToDenom() string {
 	return ""
 }
 
+func (m *Swap) GetRateSchedule() []SwapRateStep {
+	if m != nil {
+		return m.RateSchedule
+	}
+	return nil
+}
+
+func (m *Swap) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type SwapRateStep struct {
+	// start_time defines the block time from which the rate applies.
+	StartTime time.Time                                  `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	SwapRate  github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+}
+
+func (m *SwapRateStep) Reset()         { *m = SwapRateStep{} }
+func (m *SwapRateStep) String() string { return proto.CompactTextString(m) }
+func (*SwapRateStep) ProtoMessage()    {}
+func (*SwapRateStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{1}
+}
+func (m *SwapRateStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRateStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRateStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRateStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRateStep.Merge(m, src)
+}
+func (m *SwapRateStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRateStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRateStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRateStep proto.InternalMessageInfo
+
+func (m *SwapRateStep) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
 type SwapStats struct {
 	SwapCount int32 `protobuf:"varint,1,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
 }
@@ -87,7 +160,7 @@ func (m *SwapStats) Reset()         { *m = SwapStats{} }
 func (m *SwapStats) String() string { return proto.CompactTextString(m) }
 func (*SwapStats) ProtoMessage()    {}
 func (*SwapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_42ca60eaf37a2b67, []int{1}
+	return fileDescriptor_42ca60eaf37a2b67, []int{2}
 }
 func (m *SwapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,7 +205,7 @@ func (m *Swapped) Reset()         { *m = Swapped{} }
 func (m *Swapped) String() string { return proto.CompactTextString(m) }
 func (*Swapped) ProtoMessage()    {}
 func (*Swapped) Descriptor() ([]byte, []int) {
-	return fileDescriptor_42ca60eaf37a2b67, []int{2}
+	return fileDescriptor_42ca60eaf37a2b67, []int{3}
 }
 func (m *Swapped) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,41 +248,118 @@ func (m *Swapped) GetToCoinAmount() types.Coin {
 	return types.Coin{}
 }
 
+// ClosedSwap defines a swap which has passed its end time.
+type ClosedSwap struct {
+	FromDenom string `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// closed_at defines the block time at which the swap has been closed.
+	ClosedAt time.Time `protobuf:"bytes,3,opt,name=closed_at,json=closedAt,proto3,stdtime" json:"closed_at"`
+}
+
+func (m *ClosedSwap) Reset()         { *m = ClosedSwap{} }
+func (m *ClosedSwap) String() string { return proto.CompactTextString(m) }
+func (*ClosedSwap) ProtoMessage()    {}
+func (*ClosedSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{4}
+}
+func (m *ClosedSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClosedSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClosedSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClosedSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedSwap.Merge(m, src)
+}
+func (m *ClosedSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClosedSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedSwap proto.InternalMessageInfo
+
+func (m *ClosedSwap) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
+	}
+	return ""
+}
+
+func (m *ClosedSwap) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
+}
+
+func (m *ClosedSwap) GetClosedAt() time.Time {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Swap)(nil), "lbm.fswap.v1.Swap")
+	proto.RegisterType((*SwapRateStep)(nil), "lbm.fswap.v1.SwapRateStep")
 	proto.RegisterType((*SwapStats)(nil), "lbm.fswap.v1.SwapStats")
 	proto.RegisterType((*Swapped)(nil), "lbm.fswap.v1.Swapped")
+	proto.RegisterType((*ClosedSwap)(nil), "lbm.fswap.v1.ClosedSwap")
 }
 
 func init() { proto.RegisterFile("lbm/fswap/v1/fswap.proto", fileDescriptor_42ca60eaf37a2b67) }
 
 var fileDescriptor_42ca60eaf37a2b67 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0xc6, 0x5b, 0x44, 0xa1, 0x23, 0x21, 0xa6, 0x9a, 0x08, 0x24, 0x16, 0xc3, 0xca, 0x60, 0x9c,
-	0x49, 0xe1, 0x09, 0x04, 0x42, 0x74, 0x65, 0x52, 0x5c, 0xb9, 0x69, 0xa6, 0x65, 0x0a, 0x8d, 0xb4,
-	0xa7, 0xe9, 0x0c, 0x7f, 0x7c, 0x0b, 0x9f, 0xc3, 0x27, 0x61, 0xc9, 0xd2, 0xb8, 0x40, 0x03, 0x4f,
-	0x61, 0xdc, 0x98, 0x33, 0xd3, 0x68, 0x5c, 0x79, 0x73, 0x93, 0xbb, 0x3b, 0x39, 0xdf, 0x99, 0xef,
-	0x77, 0xe6, 0xcb, 0x21, 0x9d, 0x4d, 0x94, 0xb1, 0x44, 0xee, 0x79, 0xc1, 0x76, 0xbe, 0x29, 0x68,
-	0x51, 0x82, 0x02, 0xb7, 0xb5, 0x89, 0x32, 0x6a, 0x1a, 0x3b, 0xbf, 0xf7, 0x64, 0x05, 0x2b, 0xd0,
-	0x02, 0xc3, 0xca, 0xcc, 0xf4, 0xbc, 0x18, 0x64, 0x06, 0x92, 0x45, 0x5c, 0x0a, 0xb6, 0xf3, 0x23,
-	0xa1, 0xb8, 0xcf, 0x62, 0x48, 0x73, 0xa3, 0x0f, 0x7e, 0xd9, 0xa4, 0xbe, 0xd8, 0xf3, 0xc2, 0x7d,
-	0x46, 0x48, 0x52, 0x42, 0x16, 0x2e, 0x45, 0x0e, 0x59, 0xc7, 0x7e, 0x6e, 0xbf, 0x70, 0x02, 0x07,
-	0x3b, 0x33, 0x6c, 0xb8, 0x5d, 0xd2, 0x54, 0x50, 0x89, 0x35, 0x2d, 0x36, 0x14, 0x18, 0x69, 0x4d,
-	0x9e, 0xf2, 0x0c, 0xb6, 0xb9, 0x0a, 0x63, 0x5e, 0x84, 0x09, 0x94, 0xe1, 0x9f, 0xc9, 0x7b, 0x38,
-	0x39, 0x19, 0x1d, 0xcf, 0x7d, 0xeb, 0xdb, 0xb9, 0x3f, 0x5c, 0xa5, 0x6a, 0xbd, 0x8d, 0x68, 0x0c,
-	0x19, 0x9b, 0xa7, 0xb9, 0x8c, 0xd7, 0x29, 0x67, 0x49, 0x55, 0xbc, 0x92, 0xcb, 0x8f, 0x4c, 0x7d,
-	0x2a, 0x84, 0xa4, 0x6f, 0x73, 0x15, 0x3c, 0x36, 0x96, 0x53, 0x5e, 0xcc, 0xa1, 0x7c, 0x5f, 0x91,
-	0xde, 0x11, 0x07, 0x7f, 0x1b, 0x96, 0x5c, 0x89, 0x4e, 0xfd, 0x56, 0xde, 0x33, 0x11, 0x07, 0x4d,
-	0x34, 0x09, 0xb8, 0x12, 0x83, 0x21, 0x71, 0xf0, 0xf3, 0x0b, 0xc5, 0x95, 0xc4, 0x04, 0xb4, 0x7b,
-	0x8c, 0x60, 0x9d, 0xc0, 0xfd, 0x40, 0xf3, 0xa6, 0xd8, 0x18, 0xfc, 0xb4, 0x49, 0x03, 0x87, 0x0b,
-	0xb1, 0x74, 0x0f, 0xe4, 0x91, 0x0e, 0x0b, 0x83, 0x0c, 0xcd, 0xa6, 0xfa, 0xc1, 0xc3, 0x51, 0x97,
-	0x9a, 0xc0, 0x29, 0x06, 0x4e, 0xab, 0xc0, 0xe9, 0x14, 0xd2, 0x7c, 0x32, 0xc6, 0x55, 0xbf, 0x7c,
-	0xef, 0xbf, 0xbc, 0xe1, 0xaa, 0xf8, 0x28, 0x68, 0x23, 0x07, 0xab, 0xd7, 0x9a, 0xe2, 0x2a, 0xd2,
-	0x56, 0xf0, 0x0f, 0xb7, 0x76, 0x27, 0xdc, 0x96, 0x82, 0xbf, 0xd4, 0xc9, 0x9b, 0xe3, 0xc5, 0xb3,
-	0x4f, 0x17, 0xcf, 0xfe, 0x71, 0xf1, 0xec, 0xcf, 0x57, 0xcf, 0x3a, 0x5d, 0x3d, 0xeb, 0xeb, 0xd5,
-	0xb3, 0x3e, 0xd0, 0xff, 0x9a, 0x1e, 0xaa, 0xe3, 0xd5, 0xe6, 0xd1, 0x03, 0x7d, 0x76, 0xe3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0x35, 0xf1, 0x71, 0xd6, 0x02, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xf6, 0x2f, 0xf1, 0x12, 0x2a, 0x64, 0x40, 0x4d, 0x23, 0x61, 0x57, 0xe1, 0x52, 0x15,
+	0x58, 0x2b, 0xed, 0x13, 0x34, 0xa9, 0x2a, 0x38, 0x21, 0x9c, 0x72, 0xe1, 0x62, 0xd6, 0xf6, 0x26,
+	0xb1, 0x88, 0xbd, 0x96, 0x77, 0xd2, 0x9f, 0x47, 0xe0, 0x44, 0x1f, 0x82, 0x13, 0x8f, 0xc1, 0xa9,
+	0xc7, 0x1e, 0x11, 0x87, 0x14, 0x35, 0xb7, 0xbc, 0x01, 0x37, 0x34, 0x6b, 0xa7, 0x4d, 0x90, 0x50,
+	0x0b, 0x15, 0xb7, 0xd1, 0xfc, 0x7c, 0xdf, 0xcc, 0xb7, 0x33, 0x4b, 0x6b, 0x03, 0x3f, 0x76, 0xba,
+	0xea, 0x88, 0xa7, 0xce, 0x61, 0x33, 0x37, 0x58, 0x9a, 0x49, 0x90, 0x66, 0x75, 0xe0, 0xc7, 0x2c,
+	0x77, 0x1c, 0x36, 0xeb, 0x76, 0x4f, 0xca, 0xde, 0x40, 0x38, 0x3a, 0xe6, 0x0f, 0xbb, 0x0e, 0x44,
+	0xb1, 0x50, 0xc0, 0xe3, 0x22, 0xbd, 0xfe, 0xa8, 0x27, 0x7b, 0x52, 0x9b, 0x0e, 0x5a, 0x85, 0xd7,
+	0x0a, 0xa4, 0x8a, 0xa5, 0x72, 0x7c, 0xae, 0x84, 0x73, 0xd8, 0xf4, 0x05, 0xf0, 0xa6, 0x13, 0xc8,
+	0x28, 0xc9, 0xe3, 0x8d, 0xaf, 0x4b, 0x74, 0xa9, 0x73, 0xc4, 0x53, 0xf3, 0x09, 0xa5, 0xdd, 0x4c,
+	0xc6, 0x5e, 0x28, 0x12, 0x19, 0xd7, 0xc8, 0x06, 0xd9, 0x34, 0x5c, 0x03, 0x3d, 0x7b, 0xe8, 0x30,
+	0xd7, 0x69, 0x05, 0x64, 0x11, 0x5c, 0xd0, 0xc1, 0x32, 0xc8, 0x3c, 0xd4, 0xa7, 0x6b, 0x3c, 0x96,
+	0xc3, 0x04, 0xbc, 0x80, 0xa7, 0x5e, 0x57, 0x66, 0xde, 0x55, 0xe6, 0x22, 0x66, 0xb6, 0xb6, 0xcf,
+	0x46, 0x76, 0xe9, 0xfb, 0xc8, 0xde, 0xea, 0x45, 0xd0, 0x1f, 0xfa, 0x2c, 0x90, 0xb1, 0xb3, 0x1f,
+	0x25, 0x2a, 0xe8, 0x47, 0xdc, 0xe9, 0x16, 0xc6, 0x0b, 0x15, 0x7e, 0x70, 0xe0, 0x24, 0x15, 0x8a,
+	0xbd, 0x4a, 0xc0, 0x7d, 0x98, 0x43, 0xb6, 0x79, 0xba, 0x2f, 0xb3, 0x83, 0x82, 0xe9, 0x35, 0x35,
+	0x50, 0x0e, 0x2f, 0xe3, 0x20, 0x6a, 0x4b, 0xff, 0x84, 0xbd, 0x27, 0x02, 0xb7, 0x82, 0x20, 0x2e,
+	0x07, 0x61, 0xbe, 0xa7, 0xf7, 0x11, 0xcb, 0x53, 0x41, 0x5f, 0x84, 0xc3, 0x81, 0xa8, 0x2d, 0x6f,
+	0x2c, 0x6e, 0xde, 0xdb, 0xae, 0xb3, 0x59, 0xe9, 0x59, 0xa7, 0x48, 0xef, 0x80, 0x48, 0x5b, 0x36,
+	0x12, 0x4e, 0x46, 0xf6, 0xda, 0x5c, 0xe1, 0x73, 0x19, 0x47, 0x20, 0xe2, 0x14, 0x4e, 0xdc, 0x2a,
+	0x06, 0x3a, 0x85, 0xdf, 0xfc, 0x44, 0xe8, 0xfa, 0x6f, 0xea, 0xcc, 0xc8, 0xbc, 0xa2, 0x67, 0x78,
+	0xfb, 0x77, 0xda, 0x4c, 0x46, 0xf6, 0xd3, 0x3f, 0x42, 0xce, 0x34, 0xf2, 0x78, 0x56, 0xc2, 0xfd,
+	0xab, 0x97, 0x7c, 0x43, 0x2b, 0x22, 0x09, 0x3d, 0x5c, 0x9f, 0x5a, 0x79, 0x83, 0xe8, 0x71, 0xf3,
+	0xdd, 0x62, 0xd3, 0xdd, 0x62, 0x07, 0xd3, 0xdd, 0x6a, 0xd5, 0x27, 0x23, 0xdb, 0x9c, 0xe6, 0x5f,
+	0x83, 0x9f, 0x5e, 0xd8, 0xc4, 0x2d, 0x8b, 0x24, 0xc4, 0xcc, 0xc6, 0x67, 0x42, 0xab, 0xb3, 0x22,
+	0x99, 0x6d, 0x4a, 0x15, 0xf0, 0x0c, 0x72, 0x16, 0x72, 0x23, 0x4b, 0x05, 0x45, 0xd5, 0x98, 0x86,
+	0xae, 0xc3, 0xc8, 0xfc, 0x6b, 0x2f, 0xdc, 0xfd, 0xb5, 0x1b, 0x5b, 0xd4, 0xc0, 0x2e, 0x3b, 0xc0,
+	0x41, 0xe1, 0xbe, 0x6b, 0xf4, 0x00, 0x35, 0xd2, 0x2d, 0x2e, 0xbb, 0x9a, 0xaf, 0x8d, 0x8e, 0xc6,
+	0x4f, 0x42, 0xcb, 0x98, 0x9c, 0x8a, 0xd0, 0x3c, 0xa6, 0x0f, 0xb4, 0xc0, 0x78, 0x36, 0x5e, 0x2e,
+	0x6a, 0x31, 0xd3, 0x3a, 0xcb, 0xcf, 0x8b, 0xe1, 0x79, 0xb1, 0xe2, 0xbc, 0x58, 0x5b, 0x46, 0x49,
+	0x6b, 0x07, 0x5b, 0xfd, 0x72, 0x61, 0x3f, 0xbb, 0x65, 0xab, 0x58, 0xe4, 0xae, 0x22, 0x0f, 0x5a,
+	0xbb, 0x9a, 0xc5, 0x04, 0xba, 0x0a, 0x72, 0x8e, 0x77, 0xe1, 0xbf, 0xf0, 0x56, 0x41, 0x5e, 0xb3,
+	0x36, 0x3e, 0x12, 0x4a, 0xdb, 0x03, 0xa9, 0x44, 0x78, 0xc7, 0x9f, 0x61, 0x97, 0x1a, 0x81, 0xc6,
+	0xf1, 0x38, 0xe8, 0xbf, 0xe0, 0xb6, 0x5b, 0x50, 0xc9, 0xcb, 0x76, 0xa1, 0xf5, 0xf2, 0xec, 0xd2,
+	0x22, 0xe7, 0x97, 0x16, 0xf9, 0x71, 0x69, 0x91, 0xd3, 0xb1, 0x55, 0x3a, 0x1f, 0x5b, 0xa5, 0x6f,
+	0x63, 0xab, 0xf4, 0x8e, 0xdd, 0x38, 0xe0, 0x71, 0xf1, 0xaf, 0xea, 0x41, 0xfd, 0x15, 0xcd, 0xb8,
+	0xf3, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x38, 0x41, 0xc3, 0x0a, 0x71, 0x05, 0x00, 0x00,
 }
 
 func (m *Swap) Marshal() (dAtA []byte, err error) {
@@ -232,6 +382,42 @@ func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFswap(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AmountCapForFromDenom != nil {
+		{
+			size := m.AmountCapForFromDenom.Size()
+			i -= size
+			if _, err := m.AmountCapForFromDenom.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFswap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RateSchedule) > 0 {
+		for iNdEx := len(m.RateSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.SwapRate.Size()
 		i -= size
@@ -269,6 +455,47 @@ func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapRateStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRateStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRateStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapRate.Size()
+		i -= size
+		if _, err := m.SwapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFswap(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SwapStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -340,6 +567,51 @@ func (m *Swapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClosedSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClosedSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClosedSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFswap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFswap(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFswap(v)
 	base := offset
@@ -369,6 +641,33 @@ func (m *Swap) Size() (n int) {
 	n += 1 + l + sovFswap(uint64(l))
 	l = m.SwapRate.Size()
 	n += 1 + l + sovFswap(uint64(l))
+	if len(m.RateSchedule) > 0 {
+		for _, e := range m.RateSchedule {
+			l = e.Size()
+			n += 1 + l + sovFswap(uint64(l))
+		}
+	}
+	if m.AmountCapForFromDenom != nil {
+		l = m.AmountCapForFromDenom.Size()
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapRateStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.SwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
 	return n
 }
 
@@ -397,6 +696,25 @@ func (m *Swapped) Size() (n int) {
 	return n
 }
 
+func (m *ClosedSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt)
+	n += 1 + l + sovFswap(uint64(l))
+	return n
+}
+
 func sovFswap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -564,61 +882,11 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFswap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFswap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SwapStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFswap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSchedule", wireType)
 			}
-			m.SwapCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFswap
@@ -628,17 +896,290 @@ func (m *SwapStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapCount |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFswap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateSchedule = append(m.RateSchedule, SwapRateStep{})
+			if err := m.RateSchedule[len(m.RateSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountCapForFromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_Finschia_finschia_sdk_types.Int
+			m.AmountCapForFromDenom = &v
+			if err := m.AmountCapForFromDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRateStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRateStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRateStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFswap
 			}
@@ -770,6 +1311,153 @@ func (m *Swapped) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClosedSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClosedSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClosedSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFswap(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,9 +10,11 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Swaps:     []Swap{},
-		SwapStats: SwapStats{},
-		Swappeds:  []Swapped{},
+		Swaps:           []Swap{},
+		SwapStats:       SwapStats{},
+		Swappeds:        []Swapped{},
+		ReverseSwappeds: []Swapped{},
+		ClosedSwaps:     []ClosedSwap{},
 	}
 }
 
@@ -60,5 +62,38 @@ func (gs *GenesisState) Validate() error {
 			return ErrInvalidState.Wrap("AmountCapForToDenom cannot be exceeded")
 		}
 	}
+
+	swaps := make(map[string]Swap, len(gs.GetSwaps()))
+	for _, swap := range gs.GetSwaps() {
+		swaps[swap.FromDenom+"/"+swap.ToDenom] = swap
+	}
+	for _, swapped := range gs.GetReverseSwappeds() {
+		if err := swapped.ValidateBasic(); err != nil {
+			return err
+		}
+		swap, ok := swaps[swapped.ToCoinAmount.Denom+"/"+swapped.FromCoinAmount.Denom]
+		if !ok {
+			return ErrInvalidState.Wrap("reverse swapped of unknown swap")
+		}
+		if !swap.IsReversible() || swap.AmountCapForFromDenom.LT(swapped.ToCoinAmount.Amount) {
+			return ErrInvalidState.Wrap("AmountCapForFromDenom cannot be exceeded")
+		}
+	}
+
+	closed := make(map[string]bool, len(gs.GetClosedSwaps()))
+	for _, closedSwap := range gs.GetClosedSwaps() {
+		key := closedSwap.FromDenom + "/" + closedSwap.ToDenom
+		swap, ok := swaps[key]
+		if !ok {
+			return ErrInvalidState.Wrap("closed swap of unknown swap")
+		}
+		if swap.EndTime == nil || closedSwap.ClosedAt.Before(*swap.EndTime) {
+			return ErrInvalidState.Wrap("swap cannot be closed before its end time")
+		}
+		if closed[key] {
+			return ErrInvalidState.Wrapf("duplicate closed swap %s", key)
+		}
+		closed[key] = true
+	}
 	return nil
 }
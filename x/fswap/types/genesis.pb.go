@@ -28,6 +28,11 @@ type GenesisState struct {
 	Swaps     []Swap    `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps"`
 	SwapStats SwapStats `protobuf:"bytes,2,opt,name=swap_stats,json=swapStats,proto3" json:"swap_stats"`
 	Swappeds  []Swapped `protobuf:"bytes,3,rep,name=swappeds,proto3" json:"swappeds"`
+	// reverse_swappeds defines the amounts swapped back from to-denom to from-denom.
+	// from_coin_amount is of to-denom and to_coin_amount is of from-denom.
+	ReverseSwappeds []Swapped `protobuf:"bytes,4,rep,name=reverse_swappeds,json=reverseSwappeds,proto3" json:"reverse_swappeds"`
+	// closed_swaps defines the swaps which have passed their end time.
+	ClosedSwaps []ClosedSwap `protobuf:"bytes,5,rep,name=closed_swaps,json=closedSwaps,proto3" json:"closed_swaps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +89,20 @@ func (m *GenesisState) GetSwappeds() []Swapped {
 	return nil
 }
 
+func (m *GenesisState) GetReverseSwappeds() []Swapped {
+	if m != nil {
+		return m.ReverseSwappeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClosedSwaps() []ClosedSwap {
+	if m != nil {
+		return m.ClosedSwaps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.fswap.v1.GenesisState")
 }
@@ -91,23 +110,27 @@ func init() {
 func init() { proto.RegisterFile("lbm/fswap/v1/genesis.proto", fileDescriptor_94e309cb1db27661) }
 
 var fileDescriptor_94e309cb1db27661 = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
+	// 308 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x49, 0xca, 0xd5,
 	0x4f, 0x2b, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xc9, 0x49, 0xca, 0xd5, 0x03, 0xcb, 0xe9, 0x95,
 	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x09,
-	0x14, 0xfd, 0x10, 0xc5, 0x60, 0x19, 0xa5, 0xad, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xf3, 0x82, 0x4b,
+	0x14, 0xfd, 0x10, 0xc5, 0x60, 0x19, 0xa5, 0x03, 0x4c, 0x5c, 0x3c, 0xee, 0x10, 0xf3, 0x82, 0x4b,
 	0x12, 0x4b, 0x52, 0x85, 0xf4, 0xb8, 0x58, 0x41, 0xd2, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc,
 	0x46, 0x42, 0x7a, 0xc8, 0xc6, 0xeb, 0x05, 0x97, 0x27, 0x16, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf,
 	0x10, 0x04, 0x51, 0x26, 0x64, 0xc3, 0xc5, 0x05, 0x62, 0xc4, 0x17, 0x97, 0x24, 0x96, 0x14, 0x4b,
 	0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0x63, 0x6a, 0x02, 0x19, 0x5e, 0x0c, 0xd5, 0xc9, 0x59,
 	0x0c, 0x13, 0x10, 0x32, 0xe7, 0xe2, 0x00, 0x71, 0x0a, 0x52, 0x53, 0x8a, 0x25, 0x98, 0xc1, 0x16,
-	0x8a, 0x62, 0xea, 0x2d, 0x48, 0x4d, 0x81, 0xea, 0x84, 0x2b, 0x76, 0xf2, 0x38, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0xb7, 0xcc, 0xbc, 0xe2, 0xe4, 0x8c, 0xcc, 0x44, 0xfd, 0x34, 0x28, 0x43, 0xb7,
-	0x38, 0x25, 0x5b, 0xbf, 0x02, 0x1a, 0x14, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80,
-	0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x1a, 0xa2, 0xb3, 0x64, 0x01, 0x00, 0x00,
+	0x8a, 0x62, 0xea, 0x2d, 0x48, 0x4d, 0x81, 0xea, 0x84, 0x2b, 0x16, 0x72, 0xe3, 0x12, 0x28, 0x4a,
+	0x2d, 0x4b, 0x2d, 0x2a, 0x4e, 0x8d, 0x87, 0x1b, 0xc0, 0x42, 0xd8, 0x00, 0x7e, 0xa8, 0xa6, 0x60,
+	0x98, 0x39, 0x8e, 0x5c, 0x3c, 0xc9, 0x39, 0xf9, 0xc5, 0xa9, 0x29, 0xf1, 0x10, 0x5f, 0xb3, 0x82,
+	0xcd, 0x90, 0x40, 0x35, 0xc3, 0x19, 0xac, 0x02, 0xc9, 0xef, 0xdc, 0xc9, 0x70, 0x91, 0x62, 0x27,
+	0x8f, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
+	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4b, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77, 0xcb, 0xcc, 0x2b, 0x4e, 0xce, 0xc8, 0x4c, 0xd4,
+	0x4f, 0x83, 0x32, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0xa0, 0xb1, 0x52, 0x52, 0x59, 0x90, 0x5a,
+	0x9c, 0xc4, 0x06, 0x8e, 0x13, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27, 0x6a, 0x12, 0x17,
+	0xef, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +153,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClosedSwaps) > 0 {
+		for iNdEx := len(m.ClosedSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClosedSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReverseSwappeds) > 0 {
+		for iNdEx := len(m.ReverseSwappeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReverseSwappeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Swappeds) > 0 {
 		for iNdEx := len(m.Swappeds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -202,6 +253,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ReverseSwappeds) > 0 {
+		for _, e := range m.ReverseSwappeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClosedSwaps) > 0 {
+		for _, e := range m.ClosedSwaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -341,6 +404,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseSwappeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReverseSwappeds = append(m.ReverseSwappeds, Swapped{})
+			if err := m.ReverseSwappeds[len(m.ReverseSwappeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedSwaps = append(m.ClosedSwaps, ClosedSwap{})
+			if err := m.ClosedSwaps[len(m.ClosedSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -163,6 +164,100 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc:     "RateSchedule not sorted by start time is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				gs.Swaps[0].RateSchedule = []types.SwapRateStep{
+					{StartTime: time.Unix(2, 0), SwapRate: sdk.OneDec()},
+					{StartTime: time.Unix(1, 0), SwapRate: sdk.OneDec()},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc:     "SwapRate=0 in RateSchedule is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				gs.Swaps[0].RateSchedule = []types.SwapRateStep{
+					{StartTime: time.Unix(1, 0), SwapRate: sdk.ZeroDec()},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc:     "ReverseSwappeds within AmountCapForFromDenom is valid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				reverseCap := sdk.NewInt(10)
+				gs.Swaps[0].AmountCapForFromDenom = &reverseCap
+				gs.ReverseSwappeds = []types.Swapped{{
+					FromCoinAmount: sdk.NewCoin("bbb", sdk.NewInt(100)),
+					ToCoinAmount:   sdk.NewCoin("aaa", sdk.NewInt(10)),
+				}}
+			},
+			valid: true,
+		},
+		{
+			desc:     "AmountCapForFromDenom has been exceeded is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				reverseCap := sdk.NewInt(10)
+				gs.Swaps[0].AmountCapForFromDenom = &reverseCap
+				gs.ReverseSwappeds = []types.Swapped{{
+					FromCoinAmount: sdk.NewCoin("bbb", sdk.NewInt(100)),
+					ToCoinAmount:   sdk.NewCoin("aaa", sdk.NewInt(11)),
+				}}
+			},
+			valid: false,
+		},
+		{
+			desc:     "ClosedSwaps after EndTime is valid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				endTime := time.Unix(1, 0)
+				gs.Swaps[0].EndTime = &endTime
+				gs.ClosedSwaps = []types.ClosedSwap{{FromDenom: "aaa", ToDenom: "bbb", ClosedAt: endTime}}
+			},
+			valid: true,
+		},
+		{
+			desc:     "ClosedSwaps before EndTime is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				endTime := time.Unix(2, 0)
+				gs.Swaps[0].EndTime = &endTime
+				gs.ClosedSwaps = []types.ClosedSwap{{FromDenom: "aaa", ToDenom: "bbb", ClosedAt: time.Unix(1, 0)}}
+			},
+			valid: false,
+		},
+		{
+			desc:     "ClosedSwaps of swap without EndTime is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				gs.ClosedSwaps = []types.ClosedSwap{{FromDenom: "aaa", ToDenom: "bbb", ClosedAt: time.Unix(1, 0)}}
+			},
+			valid: false,
+		},
+		{
+			desc:     "ClosedSwaps of unknown swap is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				gs.ClosedSwaps = []types.ClosedSwap{{FromDenom: "bbb", ToDenom: "aaa", ClosedAt: time.Unix(1, 0)}}
+			},
+			valid: false,
+		},
+		{
+			desc:     "ReverseSwappeds of unknown swap is invalid",
+			genState: exampleGenesis(),
+			modify: func(gs *types.GenesisState) {
+				gs.ReverseSwappeds = []types.Swapped{{
+					FromCoinAmount: sdk.NewCoin("aaa", sdk.ZeroInt()),
+					ToCoinAmount:   sdk.NewCoin("bbb", sdk.ZeroInt()),
+				}}
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			if tc.modify != nil {
@@ -34,6 +34,41 @@ func (m *MsgSwap) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if err := ValidateSwapRoute(m.FromCoinAmount.Denom, m.HopDenoms, m.ToDenom); err != nil {
+		return err
+	}
+
+	if err := validateMinToCoinAmount(m.MinToCoinAmount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateSwapRoute validates the denoms of the swap route, which must not visit a denom twice.
+func ValidateSwapRoute(fromDenom string, hopDenoms []string, toDenom string) error {
+	denoms := make([]string, 0, len(hopDenoms)+1)
+	denoms = append(denoms, hopDenoms...)
+	denoms = append(denoms, toDenom)
+
+	seen := map[string]bool{fromDenom: true}
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom in the swap route: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateMinToCoinAmount(minToCoinAmount *sdk.Int) error {
+	if minToCoinAmount != nil && minToCoinAmount.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("min to-coin amount cannot be negative: %s", minToCoinAmount)
+	}
 	return nil
 }
 
@@ -80,6 +115,10 @@ func (m *MsgSwapAll) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if err := validateMinToCoinAmount(m.MinToCoinAmount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -92,6 +92,7 @@ func TestMsgSwapValidate(t *testing.T) {
 	if err != nil {
 		return
 	}
+	negative := sdk.NewInt(-1)
 	tests := []struct {
 		name          string
 		msg           *fswaptypes.MsgSwap
@@ -148,6 +149,46 @@ func TestMsgSwapValidate(t *testing.T) {
 			},
 			expectedError: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "valid: HopDenoms",
+			msg: &fswaptypes.MsgSwap{
+				FromAddress:    address,
+				FromCoinAmount: sdk.NewCoin("fromDenom", sdk.OneInt()),
+				ToDenom:        "kei",
+				HopDenoms:      []string{"cony"},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "invalid: HopDenoms",
+			msg: &fswaptypes.MsgSwap{
+				FromAddress:    address,
+				FromCoinAmount: sdk.NewCoin("fromDenom", sdk.OneInt()),
+				ToDenom:        "kei",
+				HopDenoms:      []string{""},
+			},
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid: MinToCoinAmount",
+			msg: &fswaptypes.MsgSwap{
+				FromAddress:     address,
+				FromCoinAmount:  sdk.NewCoin("fromDenom", sdk.OneInt()),
+				ToDenom:         "kei",
+				MinToCoinAmount: &negative,
+			},
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid: duplicate denom in HopDenoms",
+			msg: &fswaptypes.MsgSwap{
+				FromAddress:    address,
+				FromCoinAmount: sdk.NewCoin("fromDenom", sdk.OneInt()),
+				ToDenom:        "kei",
+				HopDenoms:      []string{"cony", "fromDenom"},
+			},
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -163,6 +204,7 @@ func TestMsgSwapAllValidate(t *testing.T) {
 	if err != nil {
 		return
 	}
+	negative := sdk.NewInt(-1)
 	tests := []struct {
 		name          string
 		msg           *fswaptypes.MsgSwapAll
@@ -186,6 +228,16 @@ func TestMsgSwapAllValidate(t *testing.T) {
 			},
 			expectedError: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid: MinToCoinAmount",
+			msg: &fswaptypes.MsgSwapAll{
+				FromAddress:     address,
+				FromDenom:       "cony",
+				ToDenom:         "kei",
+				MinToCoinAmount: &negative,
+			},
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
 		{
 			name: "invalid: FromDenom",
 			msg: &fswaptypes.MsgSwapAll{
@@ -427,6 +427,122 @@ func (m *QuerySwapsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QuerySimulateSwapRequest struct {
+	// from_coin_amount defines the coin to swap, in the form of "1000cony".
+	FromCoinAmount string `protobuf:"bytes,1,opt,name=from_coin_amount,json=fromCoinAmount,proto3" json:"from_coin_amount,omitempty"`
+	ToDenom        string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// intermediate denoms which the swap goes through in order.
+	HopDenoms []string `protobuf:"bytes,3,rep,name=hop_denoms,json=hopDenoms,proto3" json:"hop_denoms,omitempty"`
+}
+
+func (m *QuerySimulateSwapRequest) Reset()         { *m = QuerySimulateSwapRequest{} }
+func (m *QuerySimulateSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapRequest) ProtoMessage()    {}
+func (*QuerySimulateSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01deae9da7816d6a, []int{8}
+}
+func (m *QuerySimulateSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapRequest.Merge(m, src)
+}
+func (m *QuerySimulateSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSwapRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapRequest) GetFromCoinAmount() string {
+	if m != nil {
+		return m.FromCoinAmount
+	}
+	return ""
+}
+
+func (m *QuerySimulateSwapRequest) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
+}
+
+func (m *QuerySimulateSwapRequest) GetHopDenoms() []string {
+	if m != nil {
+		return m.HopDenoms
+	}
+	return nil
+}
+
+type QuerySimulateSwapResponse struct {
+	// to_coin_amount defines the amount of to-coin the swap would output.
+	ToCoinAmount types.Coin `protobuf:"bytes,1,opt,name=to_coin_amount,json=toCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"to_coin_amount"`
+	// swappable_amount defines the swappable amount of to-coin left after the swap, on the last hop.
+	SwappableAmount types.Coin `protobuf:"bytes,2,opt,name=swappable_amount,json=swappableAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"swappable_amount"`
+}
+
+func (m *QuerySimulateSwapResponse) Reset()         { *m = QuerySimulateSwapResponse{} }
+func (m *QuerySimulateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapResponse) ProtoMessage()    {}
+func (*QuerySimulateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01deae9da7816d6a, []int{9}
+}
+func (m *QuerySimulateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapResponse.Merge(m, src)
+}
+func (m *QuerySimulateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSwapResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapResponse) GetToCoinAmount() types.Coin {
+	if m != nil {
+		return m.ToCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateSwapResponse) GetSwappableAmount() types.Coin {
+	if m != nil {
+		return m.SwappableAmount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QuerySwappedRequest)(nil), "lbm.fswap.v1.QuerySwappedRequest")
 	proto.RegisterType((*QuerySwappedResponse)(nil), "lbm.fswap.v1.QuerySwappedResponse")
@@ -436,52 +552,60 @@ func init() {
 	proto.RegisterType((*QuerySwapResponse)(nil), "lbm.fswap.v1.QuerySwapResponse")
 	proto.RegisterType((*QuerySwapsRequest)(nil), "lbm.fswap.v1.QuerySwapsRequest")
 	proto.RegisterType((*QuerySwapsResponse)(nil), "lbm.fswap.v1.QuerySwapsResponse")
+	proto.RegisterType((*QuerySimulateSwapRequest)(nil), "lbm.fswap.v1.QuerySimulateSwapRequest")
+	proto.RegisterType((*QuerySimulateSwapResponse)(nil), "lbm.fswap.v1.QuerySimulateSwapResponse")
 }
 
 func init() { proto.RegisterFile("lbm/fswap/v1/query.proto", fileDescriptor_01deae9da7816d6a) }
 
 var fileDescriptor_01deae9da7816d6a = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0xd6, 0x22, 0x32, 0x12, 0xc4, 0x01, 0x22, 0xac, 0xb2, 0xe0, 0x1e, 0xc0, 0x28, 0xce,
-	0xa4, 0x80, 0x3f, 0x00, 0x34, 0xe8, 0xc1, 0x44, 0xad, 0x9c, 0xf4, 0xd0, 0xcc, 0xb6, 0xd3, 0x65,
-	0x63, 0x77, 0xdf, 0xd2, 0x99, 0x16, 0x7a, 0xf5, 0x60, 0xbc, 0x98, 0x98, 0xf8, 0x17, 0x3c, 0xf9,
-	0x27, 0xbc, 0x72, 0x24, 0xf1, 0xe2, 0x49, 0x4d, 0xeb, 0x0f, 0x31, 0x33, 0x3b, 0x4b, 0x77, 0x03,
-	0xa5, 0x31, 0x84, 0xdb, 0x64, 0xdf, 0xf7, 0xbe, 0xef, 0x9b, 0x2f, 0xef, 0xcd, 0xa2, 0xf9, 0xa6,
-	0x17, 0xd2, 0x86, 0x38, 0x60, 0x31, 0xed, 0x94, 0xe9, 0x7e, 0x9b, 0xb7, 0xba, 0x24, 0x6e, 0x81,
-	0x04, 0x3c, 0xd9, 0xf4, 0x42, 0xa2, 0x2b, 0xa4, 0x53, 0xb6, 0xef, 0xf8, 0x00, 0x7e, 0x93, 0x53,
-	0x16, 0x07, 0x94, 0x45, 0x11, 0x48, 0x26, 0x03, 0x88, 0x44, 0x82, 0xb5, 0x67, 0x7d, 0xf0, 0x41,
-	0x1f, 0xa9, 0x3a, 0x99, 0xaf, 0xf7, 0x6b, 0x20, 0x42, 0x10, 0xd4, 0x63, 0x82, 0x27, 0xd4, 0xb4,
-	0x53, 0xf6, 0xb8, 0x64, 0x65, 0x1a, 0x33, 0x3f, 0x88, 0x34, 0x85, 0xc1, 0x3a, 0x59, 0x6c, 0x8a,
-	0xaa, 0x41, 0x90, 0xd6, 0xf3, 0x3e, 0x13, 0x5b, 0xba, 0xe2, 0xbe, 0x40, 0x33, 0xaf, 0x14, 0xf7,
-	0xeb, 0x03, 0x16, 0xc7, 0xbc, 0x5e, 0xe1, 0xfb, 0x6d, 0x2e, 0x24, 0x5e, 0x44, 0xa8, 0xd1, 0x82,
-	0xb0, 0x5a, 0xe7, 0x11, 0x84, 0xf3, 0xd6, 0xb2, 0x75, 0x6f, 0xa2, 0x32, 0xa1, 0xbe, 0x3c, 0x51,
-	0x1f, 0xf0, 0x02, 0xba, 0x26, 0xc1, 0x14, 0x8b, 0xba, 0x38, 0x2e, 0x41, 0x97, 0xdc, 0x0f, 0x45,
-	0x34, 0x9b, 0x67, 0x14, 0x31, 0x44, 0x82, 0xe3, 0x43, 0x34, 0xad, 0x29, 0x95, 0xad, 0x2a, 0x0b,
-	0xa1, 0x1d, 0x49, 0x4d, 0x7c, 0x7d, 0x7d, 0x81, 0x24, 0xf6, 0x89, 0xb2, 0x4f, 0x8c, 0x7d, 0xf2,
-	0x18, 0x82, 0x68, 0x7b, 0xe3, 0xe8, 0xd7, 0x52, 0xe1, 0xdb, 0xef, 0xa5, 0x07, 0x7e, 0x20, 0xf7,
-	0xda, 0x1e, 0xa9, 0x41, 0x48, 0x77, 0x82, 0x48, 0xd4, 0xf6, 0x02, 0x46, 0x1b, 0xe6, 0xf0, 0x50,
-	0xd4, 0xdf, 0x51, 0xd9, 0x8d, 0xb9, 0xd0, 0x4d, 0x95, 0x29, 0xa5, 0xa3, 0x4e, 0x5b, 0x5a, 0x05,
-	0x4b, 0x34, 0x25, 0x21, 0xa7, 0x5b, 0xbc, 0x14, 0xdd, 0x49, 0x09, 0x03, 0x55, 0xd7, 0x43, 0x2b,
-	0x3a, 0x87, 0x5d, 0x90, 0xac, 0xa9, 0xc3, 0x60, 0x5e, 0x93, 0xef, 0x66, 0x20, 0x17, 0x0f, 0xfb,
-	0xab, 0x85, 0x56, 0x47, 0x8a, 0x98, 0xfc, 0xbb, 0x68, 0x5a, 0xa4, 0x80, 0xcb, 0xcd, 0xff, 0xc6,
-	0x89, 0x8e, 0x89, 0xe2, 0x39, 0x9a, 0x3e, 0x19, 0x89, 0x8b, 0x5f, 0x7a, 0x0b, 0xdd, 0xcc, 0xb0,
-	0x99, 0xdb, 0xad, 0xa1, 0x92, 0x52, 0x35, 0x37, 0xc2, 0x24, 0xbb, 0x7e, 0x44, 0x21, 0xb7, 0x4b,
-	0xea, 0x2a, 0x15, 0x8d, 0x72, 0xdf, 0x66, 0x28, 0x44, 0xea, 0x68, 0x07, 0xa1, 0xc1, 0x62, 0x19,
-	0xa2, 0x95, 0x5c, 0x34, 0xc9, 0x82, 0xa7, 0x01, 0xbd, 0x64, 0x3e, 0x37, 0xbd, 0x95, 0x4c, 0xa7,
-	0xfb, 0xc9, 0x42, 0x38, 0xcb, 0x6e, 0x1c, 0x12, 0x34, 0xa6, 0xb4, 0xc5, 0xbc, 0xb5, 0x7c, 0xe5,
-	0x5c, 0x8b, 0x09, 0x0c, 0x3f, 0xcd, 0xd9, 0x49, 0x26, 0x76, 0x75, 0xa4, 0x9d, 0x44, 0x2c, 0xeb,
-	0x67, 0xfd, 0x63, 0x09, 0x8d, 0x69, 0x3f, 0x18, 0xd0, 0xb8, 0xd9, 0x4a, 0x7c, 0x37, 0x2f, 0x7f,
-	0xc6, 0x1b, 0x60, 0xbb, 0xe7, 0x41, 0x12, 0x1d, 0x77, 0xf1, 0xfd, 0x8f, 0xbf, 0x5f, 0x8a, 0xb7,
-	0xf0, 0x1c, 0xcd, 0xbd, 0x30, 0xc2, 0xa8, 0x7c, 0xb7, 0x90, 0x3d, 0x7c, 0x34, 0xf1, 0xe6, 0x19,
-	0x0a, 0x23, 0xd7, 0xc5, 0x7e, 0xf4, 0x9f, 0x5d, 0xc6, 0xea, 0xa6, 0xb6, 0x4a, 0xf0, 0x5a, 0xde,
-	0xaa, 0x54, 0x9d, 0xd5, 0xc1, 0x66, 0xe4, 0x5f, 0x0a, 0x5c, 0x43, 0x25, 0x45, 0x8b, 0x9d, 0x21,
-	0x61, 0xa4, 0xa6, 0x96, 0x86, 0xd6, 0x8d, 0xbc, 0xad, 0xe5, 0x67, 0x31, 0x3e, 0x9d, 0x14, 0xf6,
-	0xd1, 0x98, 0x9e, 0x15, 0x3c, 0x8c, 0x25, 0x9d, 0x51, 0x7b, 0x79, 0x38, 0xc0, 0xe8, 0xdc, 0xd6,
-	0x3a, 0x73, 0x78, 0xe6, 0xb4, 0x8e, 0xd8, 0x7e, 0x76, 0xd4, 0x73, 0xac, 0xe3, 0x9e, 0x63, 0xfd,
-	0xe9, 0x39, 0xd6, 0xe7, 0xbe, 0x53, 0x38, 0xee, 0x3b, 0x85, 0x9f, 0x7d, 0xa7, 0xf0, 0x86, 0x8c,
-	0x5c, 0xf0, 0x43, 0x43, 0xa6, 0x17, 0xdd, 0xbb, 0xaa, 0x7f, 0x1f, 0x1b, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x24, 0x59, 0x9e, 0xa9, 0x02, 0x07, 0x00, 0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0x2e, 0xec, 0x8f, 0x1f, 0xaf, 0x04, 0x71, 0x80, 0xb8, 0x14, 0x29, 0x6b, 0x4d, 0x80,
+	0x28, 0xce, 0x64, 0x01, 0x3f, 0x00, 0x68, 0xd0, 0x83, 0x89, 0xba, 0x72, 0xd2, 0x03, 0x99, 0x2e,
+	0x43, 0xb7, 0x71, 0xdb, 0x29, 0xcc, 0x2c, 0x7f, 0x2e, 0x1e, 0x8c, 0xf1, 0xa4, 0xd1, 0xc4, 0xaf,
+	0xe0, 0xc9, 0x2f, 0xe1, 0x95, 0x23, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0x0f, 0x62, 0x66, 0x3a, 0x0b,
+	0xad, 0xbb, 0x65, 0x63, 0x08, 0xde, 0x26, 0x7d, 0x9f, 0x79, 0x9e, 0x67, 0x9e, 0x99, 0xf7, 0x4d,
+	0xa1, 0xdc, 0xf4, 0x42, 0xb2, 0x29, 0x76, 0x69, 0x4c, 0x76, 0xaa, 0x64, 0xab, 0xc5, 0xb6, 0xf7,
+	0x71, 0xbc, 0xcd, 0x25, 0x47, 0x43, 0x4d, 0x2f, 0xc4, 0xba, 0x82, 0x77, 0xaa, 0xf6, 0x35, 0x9f,
+	0x73, 0xbf, 0xc9, 0x08, 0x8d, 0x03, 0x42, 0xa3, 0x88, 0x4b, 0x2a, 0x03, 0x1e, 0x89, 0x04, 0x6b,
+	0x8f, 0xf9, 0xdc, 0xe7, 0x7a, 0x49, 0xd4, 0xca, 0x7c, 0xbd, 0x59, 0xe7, 0x22, 0xe4, 0x82, 0x78,
+	0x54, 0xb0, 0x84, 0x9a, 0xec, 0x54, 0x3d, 0x26, 0x69, 0x95, 0xc4, 0xd4, 0x0f, 0x22, 0x4d, 0x61,
+	0xb0, 0x4e, 0x1a, 0xdb, 0x46, 0xd5, 0x79, 0xd0, 0xae, 0x67, 0x7d, 0x26, 0xb6, 0x74, 0xc5, 0x7d,
+	0x04, 0xa3, 0x4f, 0x14, 0xf7, 0xd3, 0x5d, 0x1a, 0xc7, 0x6c, 0xa3, 0xc6, 0xb6, 0x5a, 0x4c, 0x48,
+	0x34, 0x05, 0xb0, 0xb9, 0xcd, 0xc3, 0xf5, 0x0d, 0x16, 0xf1, 0xb0, 0x6c, 0x55, 0xac, 0xb9, 0xc1,
+	0xda, 0xa0, 0xfa, 0x72, 0x4f, 0x7d, 0x40, 0x13, 0xf0, 0xbf, 0xe4, 0xa6, 0x58, 0xd4, 0xc5, 0x01,
+	0xc9, 0x75, 0xc9, 0x7d, 0x53, 0x84, 0xb1, 0x2c, 0xa3, 0x88, 0x79, 0x24, 0x18, 0xda, 0x83, 0x11,
+	0x4d, 0xa9, 0x6c, 0xad, 0xd3, 0x90, 0xb7, 0x22, 0xa9, 0x89, 0x2f, 0x2d, 0x4c, 0xe0, 0xc4, 0x3e,
+	0x56, 0xf6, 0xb1, 0xb1, 0x8f, 0xef, 0xf2, 0x20, 0x5a, 0x59, 0x3c, 0xf8, 0x3e, 0x5d, 0xf8, 0xfc,
+	0x63, 0xfa, 0x96, 0x1f, 0xc8, 0x46, 0xcb, 0xc3, 0x75, 0x1e, 0x92, 0xd5, 0x20, 0x12, 0xf5, 0x46,
+	0x40, 0xc9, 0xa6, 0x59, 0xdc, 0x16, 0x1b, 0x2f, 0x88, 0xdc, 0x8f, 0x99, 0xd0, 0x9b, 0x6a, 0xc3,
+	0x4a, 0x47, 0xad, 0x96, 0xb5, 0x0a, 0x92, 0x30, 0x2c, 0x79, 0x46, 0xb7, 0x78, 0x21, 0xba, 0x43,
+	0x92, 0x9f, 0xaa, 0xba, 0x1e, 0xcc, 0xe8, 0x1c, 0xd6, 0xb8, 0xa4, 0x4d, 0x1d, 0x06, 0xf5, 0x9a,
+	0x6c, 0x2d, 0x05, 0x39, 0x7f, 0xd8, 0x9f, 0x2c, 0x98, 0xed, 0x29, 0x62, 0xf2, 0xdf, 0x87, 0x11,
+	0xd1, 0x06, 0x5c, 0x6c, 0xfe, 0x97, 0x4f, 0x74, 0x4c, 0x14, 0x0f, 0x61, 0xe4, 0xe4, 0x49, 0x9c,
+	0xff, 0xd0, 0xcb, 0x70, 0x25, 0xc5, 0x66, 0x4e, 0x37, 0x0f, 0xfd, 0x4a, 0xd5, 0x9c, 0x08, 0xe1,
+	0x74, 0xfb, 0x61, 0x85, 0x5c, 0xe9, 0x57, 0x47, 0xa9, 0x69, 0x94, 0xfb, 0x3c, 0x45, 0x21, 0xda,
+	0x8e, 0x56, 0x01, 0x4e, 0x1b, 0xcb, 0x10, 0xcd, 0x64, 0xa2, 0x49, 0x1a, 0xbc, 0x1d, 0xd0, 0x63,
+	0xea, 0x33, 0xb3, 0xb7, 0x96, 0xda, 0xe9, 0xbe, 0xb3, 0x00, 0xa5, 0xd9, 0x8d, 0x43, 0x0c, 0x25,
+	0xa5, 0x2d, 0xca, 0x56, 0xa5, 0xef, 0x4c, 0x8b, 0x09, 0x0c, 0xdd, 0xcf, 0xd8, 0x49, 0x5e, 0xec,
+	0x6c, 0x4f, 0x3b, 0x89, 0x58, 0xc6, 0xcf, 0x4b, 0x28, 0x27, 0x76, 0x82, 0xb0, 0xd5, 0xa4, 0x92,
+	0xa5, 0x6f, 0x61, 0x2e, 0xa7, 0x29, 0x07, 0x3b, 0x9a, 0x28, 0xff, 0x42, 0xd4, 0x55, 0x36, 0x78,
+	0x9c, 0xd4, 0x44, 0xb9, 0xaf, 0xd2, 0xa7, 0xae, 0xb2, 0xc1, 0x63, 0x5d, 0x15, 0xee, 0xdb, 0x22,
+	0x4c, 0x74, 0x31, 0x60, 0x62, 0xe9, 0x6c, 0x4e, 0xeb, 0xe2, 0x9b, 0xb3, 0x6b, 0x33, 0x14, 0xff,
+	0x49, 0x33, 0x2c, 0xbc, 0x2f, 0x41, 0x49, 0xc7, 0x81, 0x38, 0x0c, 0x98, 0x21, 0x89, 0xae, 0x67,
+	0x5f, 0x43, 0x97, 0x91, 0x6c, 0xbb, 0x67, 0x41, 0x92, 0x30, 0xdd, 0xa9, 0x57, 0x5f, 0x7f, 0x7d,
+	0x2c, 0x5e, 0x45, 0xe3, 0x24, 0x33, 0xf0, 0x85, 0x51, 0xf9, 0x62, 0x81, 0x9d, 0x3f, 0x29, 0xd0,
+	0x52, 0x17, 0x85, 0x9e, 0xd3, 0xcb, 0xbe, 0xf3, 0x97, 0xbb, 0x8c, 0xd5, 0x25, 0x6d, 0x15, 0xa3,
+	0xf9, 0xac, 0x55, 0xa9, 0x76, 0xae, 0x9f, 0xde, 0x4d, 0xf6, 0x6d, 0xa0, 0x3a, 0xf4, 0x2b, 0x5a,
+	0xe4, 0xe4, 0x84, 0xd1, 0x36, 0x35, 0x9d, 0x5b, 0x37, 0xf2, 0xb6, 0x96, 0x1f, 0x43, 0xa8, 0x33,
+	0x29, 0xe4, 0x43, 0x49, 0xb7, 0x2e, 0xca, 0x63, 0x69, 0x8f, 0x0c, 0xbb, 0x92, 0x0f, 0x30, 0x3a,
+	0x93, 0x5a, 0x67, 0x1c, 0x8d, 0x76, 0xea, 0x08, 0xf4, 0xda, 0x82, 0xa1, 0x74, 0x53, 0xa0, 0x99,
+	0x6e, 0x7c, 0x9d, 0x6d, 0x6b, 0xcf, 0xf6, 0xc4, 0x19, 0xf9, 0x1b, 0x5a, 0x7e, 0x0a, 0x4d, 0xfe,
+	0x21, 0x6f, 0xb0, 0x3a, 0xe8, 0x95, 0x07, 0x07, 0x47, 0x8e, 0x75, 0x78, 0xe4, 0x58, 0x3f, 0x8f,
+	0x1c, 0xeb, 0xc3, 0xb1, 0x53, 0x38, 0x3c, 0x76, 0x0a, 0xdf, 0x8e, 0x9d, 0xc2, 0x33, 0xdc, 0xf3,
+	0xa5, 0xef, 0x19, 0x52, 0xfd, 0xe2, 0xbd, 0xff, 0xf4, 0x4f, 0xc5, 0xe2, 0xef, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x3a, 0x6d, 0x88, 0x11, 0x18, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -505,6 +629,8 @@ type QueryClient interface {
 	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
 	// Swaps queries all the swap that registered
 	Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error)
+	// SimulateSwap queries the output of a swap at the current block without executing it.
+	SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error)
 }
 
 type queryClient struct {
@@ -551,6 +677,15 @@ func (c *queryClient) Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error) {
+	out := new(QuerySimulateSwapResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fswap.v1.Query/SimulateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Swapped queries the current swapped status that includes a burnt amount of from-coin and a minted amount of
@@ -562,6 +697,8 @@ type QueryServer interface {
 	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
 	// Swaps queries all the swap that registered
 	Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error)
+	// SimulateSwap queries the output of a swap at the current block without executing it.
+	SimulateSwap(context.Context, *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -580,6 +717,9 @@ func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest
 func (*UnimplementedQueryServer) Swaps(ctx context.Context, req *QuerySwapsRequest) (*QuerySwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Swaps not implemented")
 }
+func (*UnimplementedQueryServer) SimulateSwap(ctx context.Context, req *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwap not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -657,6 +797,24 @@ func _Query_Swaps_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fswap.v1.Query/SimulateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwap(ctx, req.(*QuerySimulateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.fswap.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -677,6 +835,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Swaps",
 			Handler:    _Query_Swaps_Handler,
 		},
+		{
+			MethodName: "SimulateSwap",
+			Handler:    _Query_SimulateSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/fswap/v1/query.proto",
@@ -986,6 +1148,95 @@ func (m *QuerySwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulateSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HopDenoms) > 0 {
+		for iNdEx := len(m.HopDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HopDenoms[iNdEx])
+			copy(dAtA[i:], m.HopDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.HopDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromCoinAmount) > 0 {
+		i -= len(m.FromCoinAmount)
+		copy(dAtA[i:], m.FromCoinAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromCoinAmount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SwappableAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1115,6 +1366,42 @@ func (m *QuerySwapsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySimulateSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromCoinAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.HopDenoms) > 0 {
+		for _, s := range m.HopDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ToCoinAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwappableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1951,6 +2238,268 @@ func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulateSwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromCoinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopDenoms = append(m.HopDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwappableAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwappableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
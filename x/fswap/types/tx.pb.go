@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	types "github.com/Finschia/finschia-sdk/types"
 	types1 "github.com/Finschia/finschia-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -36,6 +37,12 @@ type MsgSwap struct {
 	// from-coin amount
 	FromCoinAmount types.Coin `protobuf:"bytes,2,opt,name=from_coin_amount,json=fromCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"from_coin_amount"`
 	ToDenom        string     `protobuf:"bytes,3,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// intermediate denoms which the swap goes through in order.
+	// Each hop must be a registered swap or the reverse direction of one.
+	HopDenoms []string `protobuf:"bytes,4,rep,name=hop_denoms,json=hopDenoms,proto3" json:"hop_denoms,omitempty"`
+	// min_to_coin_amount defines the minimum amount of to-coin to receive.
+	// The swap fails if the output is below it.
+	MinToCoinAmount *github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=min_to_coin_amount,json=minToCoinAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"min_to_coin_amount,omitempty"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -92,6 +99,13 @@ func (m *MsgSwap) GetToDenom() string {
 	return ""
 }
 
+func (m *MsgSwap) GetHopDenoms() []string {
+	if m != nil {
+		return m.HopDenoms
+	}
+	return nil
+}
+
 type MsgSwapResponse struct {
 }
 
@@ -133,6 +147,9 @@ type MsgSwapAll struct {
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	FromDenom   string `protobuf:"bytes,2,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
 	ToDenom     string `protobuf:"bytes,3,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// min_to_coin_amount defines the minimum amount of to-coin to receive.
+	// The swap fails if the output is below it.
+	MinToCoinAmount *github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=min_to_coin_amount,json=minToCoinAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"min_to_coin_amount,omitempty"`
 }
 
 func (m *MsgSwapAll) Reset()         { *m = MsgSwapAll{} }
@@ -334,39 +351,44 @@ func init() {
 func init() { proto.RegisterFile("lbm/fswap/v1/tx.proto", fileDescriptor_65c77cf1d9b67323) }
 
 var fileDescriptor_65c77cf1d9b67323 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x8e, 0xda, 0x30,
-	0x14, 0xc5, 0x33, 0xa8, 0x14, 0x33, 0xea, 0x74, 0xac, 0x41, 0x62, 0x50, 0x09, 0x34, 0xab, 0x91,
-	0xda, 0xda, 0x82, 0xd9, 0x55, 0xdd, 0x40, 0x1f, 0xea, 0x86, 0x4d, 0xba, 0xeb, 0x06, 0x39, 0xc4,
-	0x84, 0x88, 0x38, 0x8e, 0xb0, 0x61, 0xe0, 0x2f, 0xfa, 0x1d, 0xfd, 0x8b, 0x76, 0x35, 0xcb, 0xe9,
-	0xae, 0xab, 0x69, 0x05, 0x7f, 0xd0, 0x2f, 0xa8, 0xec, 0x38, 0xd0, 0xa8, 0xf4, 0xb1, 0x73, 0xee,
-	0x39, 0xb9, 0xe7, 0x9c, 0x7b, 0x6d, 0x58, 0x8f, 0x7d, 0x4e, 0x26, 0xf2, 0x9a, 0xa6, 0x64, 0xd9,
-	0x25, 0x6a, 0x85, 0xd3, 0xb9, 0x50, 0x02, 0x9d, 0xc4, 0x3e, 0xc7, 0xa6, 0x8c, 0x97, 0xdd, 0xe6,
-	0x79, 0x28, 0x42, 0x61, 0x00, 0xa2, 0x4f, 0x19, 0xa7, 0xe9, 0x8c, 0x85, 0xe4, 0x42, 0x12, 0x9f,
-	0x4a, 0x46, 0x96, 0x5d, 0x9f, 0x29, 0xda, 0x25, 0x63, 0x11, 0x25, 0xbf, 0xe1, 0xc9, 0x6c, 0x87,
-	0xeb, 0x0f, 0x8b, 0x37, 0x0a, 0xd2, 0x99, 0x98, 0x41, 0xdc, 0x4f, 0x00, 0x56, 0x86, 0x32, 0x7c,
-	0x77, 0x4d, 0x53, 0xf4, 0x18, 0x9e, 0x4c, 0xe6, 0x82, 0x8f, 0x68, 0x10, 0xcc, 0x99, 0x94, 0x0d,
-	0xd0, 0x01, 0x97, 0x55, 0xaf, 0xa6, 0x6b, 0xfd, 0xac, 0x84, 0x56, 0xf0, 0xa1, 0xa1, 0x68, 0xed,
-	0x11, 0xe5, 0x62, 0x91, 0xa8, 0xc6, 0x51, 0x07, 0x5c, 0xd6, 0x7a, 0x17, 0x38, 0xf3, 0x80, 0xb5,
-	0x47, 0x6c, 0x3d, 0xe0, 0x97, 0x22, 0x4a, 0x06, 0x57, 0x37, 0x77, 0xed, 0xd2, 0xc7, 0x6f, 0xed,
-	0x27, 0x61, 0xa4, 0xa6, 0x0b, 0x1f, 0x8f, 0x05, 0x27, 0x6f, 0xa2, 0x44, 0x8e, 0xa7, 0x11, 0x25,
-	0x13, 0x7b, 0x78, 0x26, 0x83, 0x19, 0x51, 0xeb, 0x94, 0x49, 0xf3, 0x93, 0xf7, 0x40, 0xeb, 0xe8,
-	0x53, 0xdf, 0xa8, 0xa0, 0x0b, 0x78, 0x5f, 0x89, 0x51, 0xc0, 0x12, 0xc1, 0x1b, 0xc7, 0xc6, 0x58,
-	0x45, 0x89, 0x57, 0xfa, 0xd3, 0x3d, 0x83, 0xa7, 0x36, 0x82, 0xc7, 0x64, 0x2a, 0x12, 0xc9, 0xdc,
-	0x08, 0x42, 0x5b, 0xea, 0xc7, 0xf1, 0xff, 0x04, 0x6b, 0x41, 0x68, 0x28, 0x99, 0xc0, 0x91, 0x21,
-	0x54, 0x75, 0xc5, 0x48, 0xfc, 0x4d, 0xfd, 0x1c, 0xa2, 0xbd, 0xd4, 0xce, 0xc0, 0x67, 0x90, 0x39,
-	0x60, 0xca, 0x8c, 0xf6, 0x11, 0xac, 0xd2, 0x85, 0x9a, 0x8a, 0x79, 0xa4, 0xd6, 0x56, 0x7e, 0x5f,
-	0x40, 0x4f, 0x61, 0x59, 0xaf, 0xc4, 0x4e, 0x12, 0xe1, 0x5f, 0x6f, 0x04, 0xd6, 0xff, 0x0f, 0xca,
-	0x7a, 0x84, 0x9e, 0x61, 0xa1, 0x29, 0x3c, 0xcb, 0xbd, 0x8c, 0x38, 0x53, 0x34, 0xa0, 0x8a, 0x1a,
-	0x53, 0xb5, 0x5e, 0x6b, 0xbf, 0x84, 0x64, 0xb6, 0x5b, 0xc2, 0xd0, 0x92, 0x06, 0x2d, 0xdd, 0xe5,
-	0xc7, 0x5d, 0xbb, 0xbe, 0xa6, 0x3c, 0x7e, 0xee, 0x16, 0x5b, 0xb8, 0xde, 0xa9, 0x8d, 0x94, 0xf3,
-	0xf3, 0x68, 0x59, 0x86, 0x3c, 0x5a, 0xef, 0x0b, 0x80, 0xc7, 0x43, 0x19, 0xa2, 0x17, 0xb0, 0x6c,
-	0xb2, 0xd5, 0x8b, 0x7e, 0xed, 0x30, 0x9a, 0xad, 0x83, 0xe5, 0xbc, 0x0b, 0x7a, 0x0d, 0x2b, 0xf9,
-	0x7a, 0x1a, 0x07, 0x99, 0xfd, 0x38, 0x6e, 0x76, 0xfe, 0x84, 0x14, 0xda, 0xd8, 0x19, 0x1f, 0x68,
-	0x93, 0x21, 0x87, 0xda, 0x14, 0x33, 0x0d, 0xde, 0xde, 0x6c, 0x1c, 0x70, 0xbb, 0x71, 0xc0, 0xf7,
-	0x8d, 0x03, 0x3e, 0x6c, 0x9d, 0xd2, 0xed, 0xd6, 0x29, 0x7d, 0xdd, 0x3a, 0xa5, 0xf7, 0xf8, 0x9f,
-	0x97, 0x76, 0x65, 0x5f, 0x96, 0xb9, 0xbc, 0xfe, 0x3d, 0xf3, 0xae, 0xae, 0x7e, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xc9, 0xd9, 0x78, 0xc1, 0xee, 0x03, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x8f, 0xd2, 0x40,
+	0x1c, 0xa5, 0xbb, 0x28, 0x32, 0x6c, 0xc4, 0x9d, 0x2c, 0x49, 0x97, 0x2c, 0x05, 0x39, 0x11, 0x5d,
+	0xa7, 0x81, 0xbd, 0x19, 0x2f, 0xe0, 0x9f, 0xe8, 0x01, 0x0f, 0xd5, 0x93, 0x17, 0x32, 0x85, 0x42,
+	0x1b, 0x3a, 0x33, 0x0d, 0x33, 0xb0, 0x10, 0xbf, 0x84, 0x9f, 0xc3, 0x8f, 0xe1, 0x69, 0x8f, 0xeb,
+	0xcd, 0x98, 0x88, 0x06, 0x12, 0x0f, 0x1e, 0xfd, 0x04, 0x66, 0xa6, 0x53, 0xb0, 0x59, 0xd4, 0x3d,
+	0x79, 0x9b, 0xfe, 0xde, 0x6f, 0x7e, 0xbf, 0xf7, 0x5e, 0x5e, 0x07, 0x94, 0x42, 0x97, 0xd8, 0x43,
+	0x7e, 0x8e, 0x23, 0x7b, 0xd6, 0xb4, 0xc5, 0x1c, 0x45, 0x13, 0x26, 0x18, 0x3c, 0x08, 0x5d, 0x82,
+	0x54, 0x19, 0xcd, 0x9a, 0xe5, 0xa3, 0x11, 0x1b, 0x31, 0x05, 0xd8, 0xf2, 0x14, 0xf7, 0x94, 0xad,
+	0x3e, 0xe3, 0x84, 0x71, 0xdb, 0xc5, 0xdc, 0xb3, 0x67, 0x4d, 0xd7, 0x13, 0xb8, 0x69, 0xf7, 0x59,
+	0x40, 0xaf, 0xe0, 0x74, 0xbc, 0xc1, 0xe5, 0x87, 0xc6, 0xcd, 0xd4, 0xea, 0x78, 0x99, 0x42, 0xea,
+	0x5f, 0xf6, 0x40, 0xae, 0xcb, 0x47, 0xaf, 0xce, 0x71, 0x04, 0xef, 0x82, 0x83, 0xe1, 0x84, 0x91,
+	0x1e, 0x1e, 0x0c, 0x26, 0x1e, 0xe7, 0xa6, 0x51, 0x33, 0x1a, 0x79, 0xa7, 0x20, 0x6b, 0xed, 0xb8,
+	0x04, 0xe7, 0xe0, 0x8e, 0x6a, 0x91, 0xbb, 0x7b, 0x98, 0xb0, 0x29, 0x15, 0xe6, 0x5e, 0xcd, 0x68,
+	0x14, 0x5a, 0xc7, 0x28, 0xe6, 0x80, 0x24, 0x47, 0xa4, 0x39, 0xa0, 0xc7, 0x2c, 0xa0, 0x9d, 0xb3,
+	0x8b, 0x65, 0x35, 0xf3, 0xfe, 0x6b, 0xf5, 0xfe, 0x28, 0x10, 0xfe, 0xd4, 0x45, 0x7d, 0x46, 0xec,
+	0x67, 0x01, 0xe5, 0x7d, 0x3f, 0xc0, 0xf6, 0x50, 0x1f, 0x1e, 0xf0, 0xc1, 0xd8, 0x16, 0x8b, 0xc8,
+	0xe3, 0xea, 0x92, 0x73, 0x5b, 0xee, 0x91, 0xa7, 0xb6, 0xda, 0x02, 0x8f, 0xc1, 0x2d, 0xc1, 0x7a,
+	0x03, 0x8f, 0x32, 0x62, 0xee, 0x2b, 0x62, 0x39, 0xc1, 0x9e, 0xc8, 0x4f, 0x58, 0x01, 0xc0, 0x67,
+	0x51, 0x8c, 0x71, 0x33, 0x5b, 0xdb, 0x6f, 0xe4, 0x9d, 0xbc, 0xcf, 0x22, 0x85, 0x72, 0xf8, 0x16,
+	0x40, 0x12, 0xd0, 0x9e, 0x60, 0x29, 0xd6, 0x37, 0xe4, 0x8c, 0xce, 0xcb, 0xcf, 0xcb, 0xea, 0xbd,
+	0x6b, 0xd2, 0x7a, 0x41, 0xc5, 0x8f, 0x65, 0xf5, 0xe4, 0xea, 0xac, 0x53, 0x46, 0x02, 0xe1, 0x91,
+	0x48, 0x2c, 0x9c, 0x22, 0x09, 0xe8, 0x6b, 0xb6, 0xa5, 0x5d, 0x3f, 0x04, 0x45, 0x6d, 0xaf, 0xe3,
+	0xf1, 0x88, 0x51, 0xee, 0xd5, 0xbf, 0x1b, 0x00, 0xe8, 0x5a, 0x3b, 0x0c, 0xaf, 0xe3, 0x7a, 0x05,
+	0x00, 0xd5, 0x12, 0xab, 0xdf, 0x53, 0x0d, 0x79, 0x59, 0x89, 0xf5, 0xff, 0xc5, 0x9a, 0xdd, 0xda,
+	0xb3, 0xff, 0x47, 0xfb, 0x11, 0x80, 0x5b, 0x9d, 0x1b, 0xf9, 0x1f, 0xb4, 0x7c, 0x4f, 0xa8, 0xd0,
+	0x9d, 0x80, 0x3c, 0x9e, 0x0a, 0x9f, 0x4d, 0x02, 0xb1, 0xd0, 0xda, 0xb7, 0x05, 0x78, 0x0a, 0xb2,
+	0x32, 0xac, 0x3a, 0x63, 0x10, 0xfd, 0xfe, 0xaf, 0x20, 0x79, 0xbf, 0x93, 0x95, 0xe1, 0x72, 0x54,
+	0x17, 0xf4, 0xc1, 0x61, 0x62, 0x44, 0x8f, 0x78, 0x02, 0x0f, 0xb0, 0xc0, 0xca, 0x91, 0x42, 0xab,
+	0xb2, 0x8d, 0x27, 0x1d, 0x6f, 0xe2, 0xd9, 0xd5, 0x4d, 0x9d, 0x8a, 0x9c, 0xf2, 0x73, 0x59, 0x2d,
+	0x2d, 0x30, 0x09, 0x1f, 0xd6, 0xd3, 0x23, 0xea, 0x4e, 0x51, 0xfb, 0x99, 0xf4, 0x27, 0xd2, 0x62,
+	0x0d, 0x89, 0xb4, 0xd6, 0x47, 0x03, 0xec, 0x77, 0xf9, 0x08, 0x3e, 0x02, 0x59, 0xa5, 0xad, 0x94,
+	0xe6, 0xab, 0xcd, 0x28, 0x57, 0x76, 0x96, 0x93, 0x29, 0xf0, 0x29, 0xc8, 0x25, 0xd9, 0x30, 0x77,
+	0x76, 0xb6, 0xc3, 0xb0, 0x5c, 0xfb, 0x13, 0x92, 0x1a, 0xa3, 0x3d, 0xde, 0x31, 0x26, 0x46, 0x76,
+	0x8d, 0x49, 0x6b, 0xea, 0x3c, 0xbf, 0x58, 0x59, 0xc6, 0xe5, 0xca, 0x32, 0xbe, 0xad, 0x2c, 0xe3,
+	0xdd, 0xda, 0xca, 0x5c, 0xae, 0xad, 0xcc, 0xa7, 0xb5, 0x95, 0x79, 0x83, 0xfe, 0x99, 0x9d, 0xb9,
+	0x7e, 0x73, 0x54, 0x86, 0xdc, 0x9b, 0xea, 0xc5, 0x39, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x29,
+	0x50, 0xb5, 0xca, 0x08, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -541,6 +563,27 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinToCoinAmount != nil {
+		{
+			size := m.MinToCoinAmount.Size()
+			i -= size
+			if _, err := m.MinToCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HopDenoms) > 0 {
+		for iNdEx := len(m.HopDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HopDenoms[iNdEx])
+			copy(dAtA[i:], m.HopDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.HopDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ToDenom) > 0 {
 		i -= len(m.ToDenom)
 		copy(dAtA[i:], m.ToDenom)
@@ -611,6 +654,18 @@ func (m *MsgSwapAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinToCoinAmount != nil {
+		{
+			size := m.MinToCoinAmount.Size()
+			i -= size
+			if _, err := m.MinToCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ToDenom) > 0 {
 		i -= len(m.ToDenom)
 		copy(dAtA[i:], m.ToDenom)
@@ -758,6 +813,16 @@ func (m *MsgSwap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.HopDenoms) > 0 {
+		for _, s := range m.HopDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MinToCoinAmount != nil {
+		l = m.MinToCoinAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -788,6 +853,10 @@ func (m *MsgSwapAll) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MinToCoinAmount != nil {
+		l = m.MinToCoinAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -958,6 +1027,74 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			}
 			m.ToDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopDenoms = append(m.HopDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinToCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_Finschia_finschia_sdk_types.Int
+			m.MinToCoinAmount = &v
+			if err := m.MinToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1154,6 +1291,42 @@ func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
 			}
 			m.ToDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinToCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_Finschia_finschia_sdk_types.Int
+			m.MinToCoinAmount = &v
+			if err := m.MinToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -23,13 +23,132 @@ func (a CreateValidatorAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foun
 		return foundation.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("validator address differs from the authorization's")
 	}
 
+	// the self-bond is the initial tokens of the validator
+	if err := a.ValidateTokens(mCreate.Value); err != nil {
+		return foundation.AcceptResponse{}, err
+	}
+
 	return foundation.AcceptResponse{Accept: true}, nil
 }
 
+// ValidateTokens checks the total tokens of the validator against the cap.
+func (a CreateValidatorAuthorization) ValidateTokens(tokens sdk.Coin) error {
+	if a.MaxTokens == nil {
+		return nil
+	}
+
+	if tokens.Denom != a.MaxTokens.Denom {
+		return sdkerrors.ErrInvalidRequest.Wrapf("denom %s differs from the cap's %s", tokens.Denom, a.MaxTokens.Denom)
+	}
+
+	if tokens.Amount.GT(a.MaxTokens.Amount) {
+		return sdkerrors.ErrUnauthorized.Wrapf("tokens of validator %s would exceed the cap %s", a.ValidatorAddress, a.MaxTokens)
+	}
+
+	return nil
+}
+
 func (a CreateValidatorAuthorization) ValidateBasic() error {
 	if _, err := sdk.ValAddressFromBech32(a.ValidatorAddress); err != nil {
 		return err
 	}
 
+	if a.MaxTokens != nil && !a.MaxTokens.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(a.MaxTokens.String())
+	}
+
+	return nil
+}
+
+var _ foundation.Authorization = (*EditValidatorAuthorization)(nil)
+
+func (a EditValidatorAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&stakingtypes.MsgEditValidator{})
+}
+
+func (a EditValidatorAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foundation.AcceptResponse, error) {
+	mEdit, ok := msg.(*stakingtypes.MsgEditValidator)
+	if !ok {
+		return foundation.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if mEdit.ValidatorAddress != a.ValidatorAddress {
+		return foundation.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("validator address differs from the authorization's")
+	}
+
+	if rate := mEdit.CommissionRate; rate != nil {
+		if a.MinCommissionRate != nil && rate.LT(*a.MinCommissionRate) {
+			return foundation.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("commission rate %s is below the lower bound %s", rate, a.MinCommissionRate)
+		}
+		if a.MaxCommissionRate != nil && rate.GT(*a.MaxCommissionRate) {
+			return foundation.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("commission rate %s is above the upper bound %s", rate, a.MaxCommissionRate)
+		}
+	}
+
+	return foundation.AcceptResponse{Accept: true}, nil
+}
+
+func (a EditValidatorAuthorization) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(a.ValidatorAddress); err != nil {
+		return err
+	}
+
+	for _, rate := range []*sdk.Dec{a.MinCommissionRate, a.MaxCommissionRate} {
+		if rate == nil {
+			continue
+		}
+		if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("commission rate bound must be between 0 and 1: %s", rate)
+		}
+	}
+
+	if a.MinCommissionRate != nil && a.MaxCommissionRate != nil && a.MinCommissionRate.GT(*a.MaxCommissionRate) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("lower bound %s is greater than upper bound %s", a.MinCommissionRate, a.MaxCommissionRate)
+	}
+
+	return nil
+}
+
+var _ foundation.Authorization = (*DelegateAuthorization)(nil)
+
+func (a DelegateAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+}
+
+// Accept accepts the delegation into the validators of the authorization.
+// The cap on the tokens of the validator is checked by the caller, against
+// the CreateValidatorAuthorization of the validator.
+func (a DelegateAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foundation.AcceptResponse, error) {
+	mDelegate, ok := msg.(*stakingtypes.MsgDelegate)
+	if !ok {
+		return foundation.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	for _, validatorAddress := range a.ValidatorAddresses {
+		if validatorAddress == mDelegate.ValidatorAddress {
+			return foundation.AcceptResponse{Accept: true}, nil
+		}
+	}
+
+	return foundation.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("validator %s is not allowed", mDelegate.ValidatorAddress)
+}
+
+func (a DelegateAuthorization) ValidateBasic() error {
+	if len(a.ValidatorAddresses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty validators")
+	}
+
+	seen := map[string]bool{}
+	for _, validatorAddress := range a.ValidatorAddresses {
+		if _, err := sdk.ValAddressFromBech32(validatorAddress); err != nil {
+			return err
+		}
+
+		if seen[validatorAddress] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate validator %s", validatorAddress)
+		}
+		seen[validatorAddress] = true
+	}
+
 	return nil
 }
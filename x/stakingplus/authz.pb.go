@@ -5,6 +5,8 @@ package stakingplus
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -28,6 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CreateValidatorAuthorization struct {
 	// redundant, but good for the query.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// max_tokens is the cap on the total tokens of the validator, including its self-bond.
+	// It applies to the creation of the validator and to the delegations and redelegations into it.
+	// Empty means no cap.
+	MaxTokens *types.Coin `protobuf:"bytes,2,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
 }
 
 func (m *CreateValidatorAuthorization) Reset()         { *m = CreateValidatorAuthorization{} }
@@ -70,30 +76,149 @@ func (m *CreateValidatorAuthorization) GetValidatorAddress() string {
 	return ""
 }
 
+func (m *CreateValidatorAuthorization) GetMaxTokens() *types.Coin {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return nil
+}
+
+// EditValidatorAuthorization allows the grantee to edit its validator.
+type EditValidatorAuthorization struct {
+	// redundant, but good for the query.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// min_commission_rate is the lower bound of the new commission rate.
+	// Empty means no lower bound.
+	MinCommissionRate *github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"min_commission_rate,omitempty"`
+	// max_commission_rate is the upper bound of the new commission rate.
+	// Empty means no upper bound.
+	MaxCommissionRate *github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"max_commission_rate,omitempty"`
+}
+
+func (m *EditValidatorAuthorization) Reset()         { *m = EditValidatorAuthorization{} }
+func (m *EditValidatorAuthorization) String() string { return proto.CompactTextString(m) }
+func (*EditValidatorAuthorization) ProtoMessage()    {}
+func (*EditValidatorAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85cae299ee13354e, []int{1}
+}
+func (m *EditValidatorAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditValidatorAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditValidatorAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditValidatorAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditValidatorAuthorization.Merge(m, src)
+}
+func (m *EditValidatorAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditValidatorAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditValidatorAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditValidatorAuthorization proto.InternalMessageInfo
+
+func (m *EditValidatorAuthorization) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// DelegateAuthorization allows the grantee to delegate or redelegate to the validators.
+// The cap on the tokens of a validator is set by its CreateValidatorAuthorization.
+type DelegateAuthorization struct {
+	// validator_addresses are the validators which the grantee can delegate to.
+	ValidatorAddresses []string `protobuf:"bytes,1,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+}
+
+func (m *DelegateAuthorization) Reset()         { *m = DelegateAuthorization{} }
+func (m *DelegateAuthorization) String() string { return proto.CompactTextString(m) }
+func (*DelegateAuthorization) ProtoMessage()    {}
+func (*DelegateAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85cae299ee13354e, []int{2}
+}
+func (m *DelegateAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateAuthorization.Merge(m, src)
+}
+func (m *DelegateAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateAuthorization proto.InternalMessageInfo
+
+func (m *DelegateAuthorization) GetValidatorAddresses() []string {
+	if m != nil {
+		return m.ValidatorAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateValidatorAuthorization)(nil), "lbm.stakingplus.v1.CreateValidatorAuthorization")
+	proto.RegisterType((*EditValidatorAuthorization)(nil), "lbm.stakingplus.v1.EditValidatorAuthorization")
+	proto.RegisterType((*DelegateAuthorization)(nil), "lbm.stakingplus.v1.DelegateAuthorization")
 }
 
 func init() { proto.RegisterFile("lbm/stakingplus/v1/authz.proto", fileDescriptor_85cae299ee13354e) }
 
 var fileDescriptor_85cae299ee13354e = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x49, 0xca, 0xd5,
-	0x2f, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0x2f, 0xc8, 0x29, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x4f,
-	0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x49, 0xca, 0xd5,
-	0x43, 0x92, 0xd7, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0xd4, 0xc3, 0xc8, 0x25, 0xe3, 0x5c, 0x94, 0x9a, 0x58, 0x92, 0x1a, 0x96, 0x98, 0x93, 0x99,
-	0x92, 0x58, 0x92, 0x5f, 0xe4, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x95, 0x58, 0x92, 0x99,
-	0x9f, 0x27, 0xa4, 0xcd, 0x25, 0x58, 0x06, 0x93, 0x89, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e,
-	0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x12, 0x80, 0x4b, 0x38, 0x42, 0xc4, 0xad, 0xec, 0x2f,
-	0x6d, 0xd1, 0xb5, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77, 0xcb,
-	0xcc, 0x2b, 0x4e, 0xce, 0xc8, 0x4c, 0xd4, 0x4f, 0x83, 0x32, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b,
-	0xf4, 0xd3, 0xf2, 0x4b, 0xf3, 0x52, 0xc0, 0xe6, 0xeb, 0xa1, 0xd8, 0xe6, 0xe4, 0x71, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x7a, 0x44, 0x18, 0x8b, 0x14, 0x18, 0x49, 0x6c,
-	0x60, 0xff, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x10, 0x9b, 0x46, 0xf1, 0x46, 0x01, 0x00,
-	0x00,
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x13, 0x51,
+	0x10, 0xc7, 0xb3, 0x06, 0x84, 0x3c, 0x2f, 0x76, 0xab, 0xd0, 0x06, 0xdd, 0x96, 0x9e, 0x82, 0xda,
+	0xf7, 0x88, 0x5e, 0x44, 0x0f, 0xd2, 0xa6, 0x8a, 0x37, 0x61, 0x11, 0x0f, 0x5e, 0x96, 0xd9, 0xdd,
+	0xe9, 0xe6, 0x91, 0x7d, 0x6f, 0xc2, 0xbe, 0xd9, 0xb0, 0xed, 0xc5, 0xb3, 0xe0, 0xc1, 0x0f, 0xe3,
+	0x67, 0x10, 0x8f, 0xc5, 0x93, 0x78, 0x28, 0x92, 0xdc, 0xfc, 0x14, 0x92, 0xdd, 0x58, 0x1a, 0x2b,
+	0x2a, 0xa6, 0xb7, 0x37, 0xfb, 0x1b, 0x86, 0xdf, 0xf0, 0xdf, 0x11, 0x41, 0x1e, 0x1b, 0xe5, 0x18,
+	0x46, 0xda, 0x66, 0xe3, 0xbc, 0x74, 0x6a, 0xd2, 0x57, 0x50, 0xf2, 0xf0, 0x58, 0x8e, 0x0b, 0x62,
+	0xf2, 0xfd, 0x3c, 0x36, 0xf2, 0x1c, 0x97, 0x93, 0x7e, 0xf7, 0x46, 0x46, 0x19, 0xd5, 0x58, 0xcd,
+	0x5f, 0x4d, 0x67, 0x77, 0x33, 0x21, 0x67, 0xc8, 0x45, 0x0d, 0x68, 0x8a, 0x05, 0x0a, 0x9a, 0x4a,
+	0xc5, 0xe0, 0x50, 0x4d, 0xfa, 0x31, 0x32, 0xf4, 0x55, 0x42, 0xda, 0x36, 0x7c, 0xe7, 0xa3, 0x27,
+	0x6e, 0x0d, 0x0a, 0x04, 0xc6, 0x57, 0x90, 0xeb, 0x14, 0x98, 0x8a, 0xbd, 0x92, 0x87, 0x54, 0xe8,
+	0x63, 0x60, 0x4d, 0xd6, 0xbf, 0x2b, 0xd6, 0x26, 0x3f, 0x49, 0x04, 0x69, 0x5a, 0xa0, 0x73, 0x1b,
+	0xde, 0xb6, 0xd7, 0xeb, 0x84, 0xd7, 0xcf, 0xc0, 0x5e, 0xf3, 0xdd, 0x7f, 0x28, 0x84, 0x81, 0x2a,
+	0x62, 0x1a, 0xa1, 0x75, 0x1b, 0x57, 0xb6, 0xbd, 0xde, 0xb5, 0xfb, 0x9b, 0x72, 0x21, 0x34, 0x57,
+	0x90, 0x0b, 0x05, 0x39, 0x20, 0x6d, 0xc3, 0x8e, 0x81, 0xea, 0x65, 0xdd, 0xfb, 0xe8, 0xc9, 0xe7,
+	0x0f, 0xbb, 0x8f, 0x33, 0xcd, 0xc3, 0x32, 0x96, 0x09, 0x19, 0xf5, 0x4c, 0x5b, 0x97, 0x0c, 0x35,
+	0xa8, 0xc3, 0xc5, 0x63, 0xd7, 0xa5, 0x23, 0x55, 0xa9, 0x43, 0x2a, 0x6d, 0x5a, 0x9b, 0xc9, 0x25,
+	0xcf, 0x9d, 0x77, 0x6d, 0xd1, 0x7d, 0x9a, 0x6a, 0xbe, 0x8c, 0x35, 0xde, 0x88, 0x75, 0xa3, 0x6d,
+	0x94, 0x90, 0x31, 0xda, 0x39, 0x4d, 0x36, 0x2a, 0x80, 0xb1, 0xde, 0xa7, 0xb3, 0xff, 0xe2, 0xeb,
+	0xe9, 0xd6, 0x9d, 0xbf, 0x9a, 0xf2, 0xd1, 0x18, 0x9d, 0x3c, 0xc0, 0xe4, 0xfb, 0xe9, 0xd6, 0xed,
+	0xdf, 0x0c, 0xbb, 0x47, 0x46, 0x33, 0x9a, 0x31, 0x1f, 0x85, 0x6b, 0x46, 0xdb, 0xc1, 0x19, 0x0d,
+	0x81, 0xb1, 0x16, 0x80, 0xea, 0x82, 0x40, 0xfb, 0xbf, 0x05, 0xa0, 0xfa, 0xa3, 0x00, 0x54, 0xcb,
+	0x02, 0xab, 0xc7, 0xf1, 0xd6, 0x13, 0x37, 0x0f, 0x30, 0xc7, 0x0c, 0x18, 0x97, 0x93, 0x50, 0x62,
+	0xfd, 0x42, 0x12, 0x38, 0xcf, 0xa2, 0xdd, 0xeb, 0x84, 0xfe, 0xaf, 0x59, 0xe0, 0xea, 0xbf, 0xc6,
+	0xfe, 0xf3, 0x4f, 0xd3, 0xc0, 0x3b, 0x99, 0x06, 0xde, 0xb7, 0x69, 0xe0, 0xbd, 0x9f, 0x05, 0xad,
+	0x93, 0x59, 0xd0, 0xfa, 0x32, 0x0b, 0x5a, 0xaf, 0xe5, 0x3f, 0x8c, 0x3d, 0x77, 0x81, 0xf1, 0xd5,
+	0xfa, 0x68, 0x1e, 0xfc, 0x18, 0x00, 0xb5, 0xc8, 0x02, 0x8e, 0xbb, 0x03, 0x00, 0x00,
 }
 
 func (m *CreateValidatorAuthorization) Marshal() (dAtA []byte, err error) {
@@ -116,6 +241,72 @@ func (m *CreateValidatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTokens != nil {
+		{
+			size, err := m.MaxTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditValidatorAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditValidatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditValidatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCommissionRate != nil {
+		{
+			size := m.MaxCommissionRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinCommissionRate != nil {
+		{
+			size := m.MinCommissionRate.Size()
+			i -= size
+			if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -126,6 +317,38 @@ func (m *CreateValidatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegateAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -147,6 +370,46 @@ func (m *CreateValidatorAuthorization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.MaxTokens != nil {
+		l = m.MaxTokens.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *EditValidatorAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MinCommissionRate != nil {
+		l = m.MinCommissionRate.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxCommissionRate != nil {
+		l = m.MaxCommissionRate.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +480,278 @@ func (m *CreateValidatorAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTokens == nil {
+				m.MaxTokens = &types.Coin{}
+			}
+			if err := m.MaxTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditValidatorAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditValidatorAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditValidatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_Finschia_finschia_sdk_types.Dec
+			m.MinCommissionRate = &v
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_Finschia_finschia_sdk_types.Dec
+			m.MaxCommissionRate = &v
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
+	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
 )
 
 func TestAminoJson(t *testing.T) {
@@ -31,3 +32,199 @@ func TestAminoJson(t *testing.T) {
 
 	require.Equal(t, expected, string(grantMsg.GetSignBytes()))
 }
+
+func TestEditValidatorAuthorization(t *testing.T) {
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	minRate := sdk.NewDecWithPrec(1, 2)
+	maxRate := sdk.NewDecWithPrec(1, 1)
+
+	authorization := &EditValidatorAuthorization{
+		ValidatorAddress:  valAddr.String(),
+		MinCommissionRate: &minRate,
+		MaxCommissionRate: &maxRate,
+	}
+	require.NoError(t, authorization.ValidateBasic())
+
+	rate := func(rate sdk.Dec) *sdk.Dec { return &rate }
+	testCases := map[string]struct {
+		valAddr sdk.ValAddress
+		rate    *sdk.Dec
+		accept  bool
+	}{
+		"no commission change": {
+			valAddr: valAddr,
+			accept:  true,
+		},
+		"within the bounds": {
+			valAddr: valAddr,
+			rate:    rate(sdk.NewDecWithPrec(5, 2)),
+			accept:  true,
+		},
+		"below the lower bound": {
+			valAddr: valAddr,
+			rate:    rate(sdk.NewDecWithPrec(1, 3)),
+		},
+		"above the upper bound": {
+			valAddr: valAddr,
+			rate:    rate(sdk.NewDecWithPrec(2, 1)),
+		},
+		"another validator": {
+			valAddr: sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := stakingtypes.NewMsgEditValidator(tc.valAddr, stakingtypes.Description{}, tc.rate, nil)
+
+			resp, err := authorization.Accept(sdk.Context{}, msg)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+		})
+	}
+
+	invalids := map[string]EditValidatorAuthorization{
+		"invalid validator address": {},
+		"negative bound": {
+			ValidatorAddress:  valAddr.String(),
+			MinCommissionRate: rate(sdk.NewDec(-1)),
+		},
+		"bound over one": {
+			ValidatorAddress:  valAddr.String(),
+			MaxCommissionRate: rate(sdk.NewDec(2)),
+		},
+		"lower bound over upper bound": {
+			ValidatorAddress:  valAddr.String(),
+			MinCommissionRate: &maxRate,
+			MaxCommissionRate: &minRate,
+		},
+	}
+	for name, invalid := range invalids {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, invalid.ValidateBasic())
+		})
+	}
+}
+
+func TestCreateValidatorAuthorization(t *testing.T) {
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	maxTokens := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+
+	authorization := &CreateValidatorAuthorization{
+		ValidatorAddress: valAddr.String(),
+		MaxTokens:        &maxTokens,
+	}
+	require.NoError(t, authorization.ValidateBasic())
+
+	testCases := map[string]struct {
+		valAddr  sdk.ValAddress
+		selfBond sdk.Coin
+		accept   bool
+	}{
+		"within the cap": {
+			valAddr:  valAddr,
+			selfBond: maxTokens,
+			accept:   true,
+		},
+		"exceeds the cap": {
+			valAddr:  valAddr,
+			selfBond: maxTokens.AddAmount(sdk.OneInt()),
+		},
+		"denom mismatch": {
+			valAddr:  valAddr,
+			selfBond: sdk.NewInt64Coin("foo", 1),
+		},
+		"validator address mismatch": {
+			valAddr:  sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+			selfBond: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := &stakingtypes.MsgCreateValidator{
+				DelegatorAddress: sdk.AccAddress(tc.valAddr).String(),
+				ValidatorAddress: tc.valAddr.String(),
+				Value:            tc.selfBond,
+			}
+
+			resp, err := authorization.Accept(sdk.Context{}, msg)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+		})
+	}
+
+	invalids := map[string]CreateValidatorAuthorization{
+		"invalid validator address": {},
+		"invalid max tokens": {
+			ValidatorAddress: valAddr.String(),
+			MaxTokens:        &sdk.Coin{},
+		},
+	}
+	for name, invalid := range invalids {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, invalid.ValidateBasic())
+		})
+	}
+}
+
+func TestDelegateAuthorization(t *testing.T) {
+	allowed := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	authorization := &DelegateAuthorization{
+		ValidatorAddresses: []string{allowed.String()},
+	}
+	require.NoError(t, authorization.ValidateBasic())
+
+	testCases := map[string]struct {
+		valAddr sdk.ValAddress
+		accept  bool
+	}{
+		"allowed validator": {
+			valAddr: allowed,
+			accept:  true,
+		},
+		"not allowed validator": {
+			valAddr: sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := stakingtypes.NewMsgDelegate(delegator, tc.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+			resp, err := authorization.Accept(sdk.Context{}, msg)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Nil(t, resp.Updated)
+		})
+	}
+
+	invalids := map[string]DelegateAuthorization{
+		"empty validators": {},
+		"invalid validator address": {
+			ValidatorAddresses: []string{""},
+		},
+		"duplicate validators": {
+			ValidatorAddresses: []string{allowed.String(), allowed.String()},
+		},
+	}
+	for name, invalid := range invalids {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, invalid.ValidateBasic())
+		})
+	}
+}
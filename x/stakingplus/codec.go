@@ -13,12 +13,16 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CreateValidatorAuthorization{}, "lbm-sdk/CreateValidatorAuthorization", nil)
+	cdc.RegisterConcrete(&EditValidatorAuthorization{}, "lbm-sdk/EditValidatorAuthorization", nil)
+	cdc.RegisterConcrete(&DelegateAuthorization{}, "lbm-sdk/DelegateAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*foundation.Authorization)(nil),
 		&CreateValidatorAuthorization{},
+		&EditValidatorAuthorization{},
+		&DelegateAuthorization{},
 	)
 }
 
@@ -2,9 +2,11 @@ package stakingplus
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
 // FoundationKeeper defines the expected foundation keeper
 type FoundationKeeper interface {
 	Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error
+	GetAuthorization(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) (foundation.Authorization, error)
 }
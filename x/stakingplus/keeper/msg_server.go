@@ -13,7 +13,8 @@ import (
 type msgServer struct {
 	stakingtypes.MsgServer
 
-	fk stakingplus.FoundationKeeper
+	keeper stakingkeeper.Keeper
+	fk     stakingplus.FoundationKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the staking MsgServer interface
@@ -21,6 +22,7 @@ type msgServer struct {
 func NewMsgServerImpl(keeper stakingkeeper.Keeper, fk stakingplus.FoundationKeeper) stakingtypes.MsgServer {
 	return &msgServer{
 		MsgServer: stakingkeeper.NewMsgServerImpl(keeper),
+		keeper:    keeper,
 		fk:        fk,
 	}
 }
@@ -41,3 +43,86 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgC
 
 	return k.MsgServer.CreateValidator(goCtx, msg)
 }
+
+func (k msgServer) EditValidator(goCtx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errors.ErrInvalidAddress.Wrapf("invalid validator address: %s", msg.ValidatorAddress)
+	}
+	grantee := sdk.AccAddress(valAddr)
+
+	if err := k.fk.Accept(ctx, grantee, msg); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.EditValidator(goCtx, msg)
+}
+
+func (k msgServer) Delegate(goCtx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", msg.DelegatorAddress)
+	}
+
+	if err := k.acceptDelegation(ctx, grantee, msg.ValidatorAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.Delegate(goCtx, msg)
+}
+
+func (k msgServer) BeginRedelegate(goCtx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", msg.DelegatorAddress)
+	}
+
+	// the redelegation is a delegation into the destination validator
+	if err := k.acceptDelegation(ctx, grantee, msg.ValidatorDstAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.BeginRedelegate(goCtx, msg)
+}
+
+// acceptDelegation checks the delegation of the grantee into the validator
+// against the DelegateAuthorization, and the tokens of the validator against its cap.
+func (k msgServer) acceptDelegation(ctx sdk.Context, grantee sdk.AccAddress, validatorAddress string, amount sdk.Coin) error {
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: grantee.String(),
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}
+	if err := k.fk.Accept(ctx, grantee, msg); err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return errors.ErrInvalidAddress.Wrapf("invalid validator address: %s", validatorAddress)
+	}
+
+	// the cap is set by the CreateValidatorAuthorization of the operator,
+	// and no authorization means the validator has no cap
+	authorization, err := k.fk.GetAuthorization(ctx, sdk.AccAddress(valAddr), sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}))
+	if err != nil {
+		return nil
+	}
+	createAuthorization, ok := authorization.(*stakingplus.CreateValidatorAuthorization)
+	if !ok {
+		return nil
+	}
+
+	validator, found := k.keeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	return createAuthorization.ValidateTokens(sdk.Coin{Denom: amount.Denom, Amount: validator.Tokens.Add(amount.Amount)})
+}
@@ -1,9 +1,23 @@
 package keeper_test
 
 import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/ed25519"
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
+	stakingkeeper "github.com/Finschia/finschia-sdk/x/staking/keeper"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+	"github.com/Finschia/finschia-sdk/x/stakingplus/keeper"
+	"github.com/Finschia/finschia-sdk/x/stakingplus/testutil"
 )
 
 func (s *KeeperTestSuite) TestMsgCreateValidator() {
@@ -46,3 +60,210 @@ func (s *KeeperTestSuite) TestMsgCreateValidator() {
 		})
 	}
 }
+
+func createValidator(t *testing.T, app *simapp.SimApp, ctx sdk.Context) sdk.ValAddress {
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	delegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(delegation)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, operator, sdk.NewCoins(delegation)))
+
+	valAddr := sdk.ValAddress(operator)
+	req, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		ed25519.GenPrivKey().PubKey(),
+		delegation,
+		stakingtypes.Description{},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		delegation.Amount,
+	)
+	require.NoError(t, err)
+
+	// bypass the censorship, which is not the concern of the tests
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+
+	return valAddr
+}
+
+func TestMsgEditValidator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	valAddr := createValidator(t, app, ctx)
+
+	testCases := map[string]struct {
+		accept error
+	}{
+		"valid request": {},
+		"not authorized": {
+			accept: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			req := stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{Moniker: "edited"}, nil, nil)
+
+			// the operator is the grantee
+			foundationKeeper := testutil.NewMockFoundationKeeper(gomock.NewController(t))
+			foundationKeeper.
+				EXPECT().
+				Accept(gomock.Any(), sdk.AccAddress(valAddr), req).
+				Return(tc.accept)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper, foundationKeeper)
+
+			res, err := msgServer.EditValidator(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.accept)
+			if tc.accept != nil {
+				return
+			}
+			require.NotNil(t, res)
+
+			edited, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+			require.True(t, found)
+			require.Equal(t, "edited", edited.Description.Moniker)
+		})
+	}
+}
+
+func TestMsgDelegate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	valAddr := createValidator(t, app, ctx)
+
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegator, amount))
+
+	// the validator has 1 token of its own
+	testCases := map[string]struct {
+		accept    error
+		maxTokens *sdk.Coin
+		err       error
+	}{
+		"valid request": {},
+		"within the cap": {
+			maxTokens: &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(2)},
+		},
+		"exceeds the cap": {
+			maxTokens: &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(1)},
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		"not authorized": {
+			accept: sdkerrors.ErrUnauthorized,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			req := stakingtypes.NewMsgDelegate(delegator, valAddr, amount[0])
+
+			foundationKeeper := testutil.NewMockFoundationKeeper(gomock.NewController(t))
+			foundationKeeper.
+				EXPECT().
+				Accept(gomock.Any(), delegator, req).
+				Return(tc.accept)
+			if tc.accept == nil {
+				expectValidatorCap(foundationKeeper, valAddr, tc.maxTokens)
+			}
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper, foundationKeeper)
+
+			res, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.NotNil(t, res)
+
+			_, found := app.StakingKeeper.GetDelegation(ctx, delegator, valAddr)
+			require.True(t, found)
+		})
+	}
+}
+
+func TestMsgBeginRedelegate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	srcAddr := createValidator(t, app, ctx)
+	dstAddr := createValidator(t, app, ctx)
+
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegator, amount))
+
+	// bypass the censorship, which is not the concern of the tests
+	_, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(delegator, srcAddr, amount[0]))
+	require.NoError(t, err)
+
+	// the destination validator has 1 token of its own
+	testCases := map[string]struct {
+		accept    error
+		maxTokens *sdk.Coin
+		err       error
+	}{
+		"valid request": {},
+		"within the cap": {
+			maxTokens: &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(2)},
+		},
+		"exceeds the cap": {
+			maxTokens: &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(1)},
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		"not authorized": {
+			accept: sdkerrors.ErrUnauthorized,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			req := stakingtypes.NewMsgBeginRedelegate(delegator, srcAddr, dstAddr, amount[0])
+
+			// the redelegation is gated as a delegation into the destination
+			foundationKeeper := testutil.NewMockFoundationKeeper(gomock.NewController(t))
+			foundationKeeper.
+				EXPECT().
+				Accept(gomock.Any(), delegator, stakingtypes.NewMsgDelegate(delegator, dstAddr, amount[0])).
+				Return(tc.accept)
+			if tc.accept == nil {
+				expectValidatorCap(foundationKeeper, dstAddr, tc.maxTokens)
+			}
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper, foundationKeeper)
+
+			res, err := msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.NotNil(t, res)
+
+			_, found := app.StakingKeeper.GetDelegation(ctx, delegator, dstAddr)
+			require.True(t, found)
+		})
+	}
+}
+
+// expectValidatorCap makes the mock return the CreateValidatorAuthorization
+// of the validator with the cap, or no authorization if maxTokens is nil.
+func expectValidatorCap(foundationKeeper *testutil.MockFoundationKeeper, valAddr sdk.ValAddress, maxTokens *sdk.Coin) {
+	call := foundationKeeper.
+		EXPECT().
+		GetAuthorization(gomock.Any(), sdk.AccAddress(valAddr), sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}))
+	if maxTokens == nil {
+		call.Return(nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found"))
+		return
+	}
+
+	call.Return(&stakingplus.CreateValidatorAuthorization{
+		ValidatorAddress: valAddr.String(),
+		MaxTokens:        maxTokens,
+	}, nil)
+}
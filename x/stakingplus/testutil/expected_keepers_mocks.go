@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	types "github.com/Finschia/finschia-sdk/types"
+	foundation "github.com/Finschia/finschia-sdk/x/foundation"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,3 +48,18 @@ func (mr *MockFoundationKeeperMockRecorder) Accept(ctx, grantee, msg interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockFoundationKeeper)(nil).Accept), ctx, grantee, msg)
 }
+
+// GetAuthorization mocks base method.
+func (m *MockFoundationKeeper) GetAuthorization(ctx types.Context, grantee types.AccAddress, msgTypeURL string) (foundation.Authorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorization", ctx, grantee, msgTypeURL)
+	ret0, _ := ret[0].(foundation.Authorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorization indicates an expected call of GetAuthorization.
+func (mr *MockFoundationKeeperMockRecorder) GetAuthorization(ctx, grantee, msgTypeURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorization", reflect.TypeOf((*MockFoundationKeeper)(nil).GetAuthorization), ctx, grantee, msgTypeURL)
+}
@@ -0,0 +1,40 @@
+package token
+
+import (
+	"fmt"
+
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+)
+
+// BankDenom returns the denom of x/bank which the tokens of the contract are converted into.
+func BankDenom(contractID string) string {
+	return fmt.Sprintf("%s/%s", ModuleName, contractID)
+}
+
+// BankMetadata returns the x/bank metadata derived from the contract.
+// The display unit is `{base}/{symbol}`, whose exponent is the decimals of the contract.
+func BankMetadata(class Contract) banktypes.Metadata {
+	base := BankDenom(class.Id)
+	metadata := banktypes.Metadata{
+		Description: class.Meta,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+		},
+		Base:    base,
+		Display: base,
+		Name:    class.Name,
+		Symbol:  class.Symbol,
+		URI:     class.Uri,
+	}
+
+	if class.Decimals > 0 {
+		display := fmt.Sprintf("%s/%s", base, class.Symbol)
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(class.Decimals),
+		})
+		metadata.Display = display
+	}
+
+	return metadata
+}
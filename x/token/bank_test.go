@@ -0,0 +1,46 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func TestBankMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		decimals int32
+		display  string
+	}{
+		"zero decimals": {
+			display: "token/deadbeef",
+		},
+		"non-zero decimals": {
+			decimals: 8,
+			display:  "token/deadbeef/OK",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			class := token.Contract{
+				Id:       "deadbeef",
+				Name:     "Test",
+				Symbol:   "OK",
+				Uri:      "https://example.com",
+				Meta:     "meta",
+				Decimals: tc.decimals,
+			}
+
+			metadata := token.BankMetadata(class)
+			require.NoError(t, metadata.Validate())
+			require.Equal(t, token.BankDenom(class.Id), metadata.Base)
+			require.Equal(t, tc.display, metadata.Display)
+			require.Equal(t, class.Name, metadata.Name)
+			require.Equal(t, class.Symbol, metadata.Symbol)
+			require.Equal(t, class.Uri, metadata.URI)
+			require.Equal(t, class.Meta, metadata.Description)
+		})
+	}
+}
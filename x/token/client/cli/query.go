@@ -31,6 +31,7 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
+		NewQueryCmdAllowance(),
 	)
 
 	return queryCmd
@@ -254,3 +255,31 @@ func NewQueryCmdHoldersByOperator() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "authorizations")
 	return cmd
 }
+
+func NewQueryCmdAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowance [class-id] [holder] [spender]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query the remaining allowance of a spender on the tokens of the holder",
+		Example: fmt.Sprintf(`$ %s query %s allowance <class-id> <holder> <spender>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Allowance(cmd.Context(), &token.QueryAllowanceRequest{
+				ContractId: args[0],
+				Holder:     args[1],
+				Spender:    args[2],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
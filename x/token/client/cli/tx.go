@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,11 +18,12 @@ import (
 )
 
 const (
-	FlagSupply   = "supply"
-	FlagDecimals = "decimals"
-	FlagMintable = "mintable"
-	FlagMeta     = "meta"
-	FlagImageURI = "image-uri"
+	FlagSupply     = "supply"
+	FlagDecimals   = "decimals"
+	FlagMintable   = "mintable"
+	FlagMeta       = "meta"
+	FlagImageURI   = "image-uri"
+	FlagExpiration = "expiration"
 
 	DefaultDecimals = 8
 	DefaultSupply   = "1"
@@ -48,6 +51,9 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdBurn(),
 		NewTxCmdOperatorBurn(),
 		NewTxCmdModify(),
+		NewTxCmdConvertToBank(),
+		NewTxCmdMigrateToBank(),
+		NewTxCmdApprove(),
 	)
 
 	return txCmd
@@ -458,3 +464,140 @@ func NewTxCmdModify() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func validateGenerateOnly(cmd *cobra.Command) error {
+	generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
+	if err != nil {
+		return err
+	}
+	if !generateOnly {
+		return fmt.Errorf("you must use it with the flag --%s", flags.FlagGenerateOnly)
+	}
+	return nil
+}
+
+func NewTxCmdConvertToBank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-to-bank [contract-id] [holder] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "convert tokens into the coins of x/bank",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s convert-to-bank <contract-id> <holder> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			msg := token.MsgConvertToBank{
+				ContractId: args[0],
+				Holder:     args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdMigrateToBank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-to-bank [authority] [contract-id] [limit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "migrate the tokens of at most limit holders of a contract to x/bank",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s migrate-to-bank <authority> <contract-id> <limit> --generate-only`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgMigrateToBank{
+				Authority:  args[0],
+				ContractId: args[1],
+				Limit:      uint32(limit),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [contract-id] [holder] [spender] [spend-limit]",
+		Args:  cobra.ExactArgs(4),
+		Short: "set the allowance of a spender on the tokens of the holder",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve <contract-id> <holder> <spender> <spend-limit> [--%s <expiration>]`, version.AppName, token.ModuleName, FlagExpiration),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limitStr := args[3]
+			limit, ok := sdk.NewIntFromString(limitStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set spend limit: %s", limitStr)
+			}
+
+			msg := token.MsgApprove{
+				ContractId: args[0],
+				Holder:     args[1],
+				Spender:    args[2],
+				SpendLimit: limit,
+			}
+
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != "" {
+				expiration, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				msg.Expiration = &expiration
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the allowance expires")
+	return cmd
+}
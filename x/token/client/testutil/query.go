@@ -8,6 +8,7 @@ import (
 
 	"github.com/Finschia/finschia-sdk/client/flags"
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/client/cli"
@@ -321,3 +322,63 @@ func (s *IntegrationTestSuite) TestNewQueryCmdHoldersByOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryCmdAllowance() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected sdk.Int
+	}{
+		"valid query": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				s.vendor.String(),
+			},
+			true,
+			sdk.ZeroInt(),
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				s.vendor.String(),
+				"extra",
+			},
+			false,
+			sdk.Int{},
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+			},
+			false,
+			sdk.Int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdAllowance()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual token.QueryAllowanceResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.args[1], actual.Allowance.Holder)
+			s.Require().Equal(tc.args[2], actual.Allowance.Spender)
+			s.Require().True(tc.expected.Equal(actual.Allowance.SpendLimit))
+		})
+	}
+}
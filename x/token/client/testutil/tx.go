@@ -6,6 +6,7 @@ import (
 	"github.com/Finschia/finschia-sdk/client/flags"
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	txtypes "github.com/Finschia/finschia-sdk/types/tx"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/client/cli"
 )
@@ -673,3 +674,201 @@ func (s *IntegrationTestSuite) TestNewTxCmdModify() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewTxCmdConvertToBank() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				"1",
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdConvertToBank()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdMigrateToBank() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				token.DefaultAuthority().String(),
+				s.classes[1].Id,
+				"100",
+				fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+			},
+			true,
+		},
+		"without generate-only": {
+			[]string{
+				token.DefaultAuthority().String(),
+				s.classes[1].Id,
+				"100",
+			},
+			false,
+		},
+		"extra args": {
+			[]string{
+				token.DefaultAuthority().String(),
+				s.classes[1].Id,
+				"100",
+				"extra",
+				fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				token.DefaultAuthority().String(),
+				s.classes[1].Id,
+				fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+			},
+			false,
+		},
+		"zero limit": {
+			[]string{
+				token.DefaultAuthority().String(),
+				s.classes[1].Id,
+				"0",
+				fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdMigrateToBank()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdApprove() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"1",
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "2100-01-01T00:00:00Z"),
+			},
+			true,
+		},
+		"invalid expiration": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"1",
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "tomorrow"),
+			},
+			false,
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdApprove()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
@@ -25,6 +25,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "lbm-sdk/MsgBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorBurn{}, "lbm-sdk/MsgOperatorBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgModify{}, "lbm-sdk/token/MsgModify") // Changed msgName due to conflict with `x/collection`
+	legacy.RegisterAminoMsg(cdc, &MsgConvertToBank{}, "lbm-sdk/token/MsgConvertToBank")
+	legacy.RegisterAminoMsg(cdc, &MsgMigrateToBank{}, "lbm-sdk/token/MsgMigrateToBank")
+	legacy.RegisterAminoMsg(cdc, &MsgApprove{}, "lbm-sdk/token/MsgApprove")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -40,6 +43,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorBurn{},
 		&MsgGrantPermission{},
 		&MsgRevokePermission{},
+		&MsgConvertToBank{},
+		&MsgMigrateToBank{},
+		&MsgApprove{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
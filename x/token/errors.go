@@ -30,4 +30,6 @@ var (
 	ErrApproverProxySame        = sdkerrors.Register(tokenCodespace, 22, "approver is same with proxy")
 	ErrTokenNotApproved         = sdkerrors.Register(tokenCodespace, 23, "proxy is not approved on the token")
 	ErrTokenAlreadyApproved     = sdkerrors.Register(tokenCodespace, 24, "proxy is already approved on the token")
+	ErrContractMigrated         = sdkerrors.Register(tokenCodespace, 25, "contract is already migrated to bank")
+	ErrInsufficientAllowance    = sdkerrors.Register(tokenCodespace, 26, "insufficient allowance")
 )
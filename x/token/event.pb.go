@@ -8,15 +8,19 @@ import (
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -741,6 +745,266 @@ func (m *EventModified) GetChanges() []Attribute {
 	return nil
 }
 
+// EventConvertedToBank is emitted when tokens are converted into the coins of x/bank.
+type EventConvertedToBank struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// holder whose tokens were converted.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// denom of the coins in x/bank.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// number of tokens converted.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventConvertedToBank) Reset()         { *m = EventConvertedToBank{} }
+func (m *EventConvertedToBank) String() string { return proto.CompactTextString(m) }
+func (*EventConvertedToBank) ProtoMessage()    {}
+func (*EventConvertedToBank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7505f4c4cdec18e, []int{9}
+}
+func (m *EventConvertedToBank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertedToBank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertedToBank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertedToBank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertedToBank.Merge(m, src)
+}
+func (m *EventConvertedToBank) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertedToBank) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertedToBank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertedToBank proto.InternalMessageInfo
+
+func (m *EventConvertedToBank) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventConvertedToBank) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventConvertedToBank) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventConvertToBankFailed is emitted when the tokens of a holder cannot be converted during the migration to x/bank.
+type EventConvertToBankFailed struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// holder whose tokens were not converted.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// the reason why the conversion failed.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventConvertToBankFailed) Reset()         { *m = EventConvertToBankFailed{} }
+func (m *EventConvertToBankFailed) String() string { return proto.CompactTextString(m) }
+func (*EventConvertToBankFailed) ProtoMessage()    {}
+func (*EventConvertToBankFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7505f4c4cdec18e, []int{10}
+}
+func (m *EventConvertToBankFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertToBankFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertToBankFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertToBankFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertToBankFailed.Merge(m, src)
+}
+func (m *EventConvertToBankFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertToBankFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertToBankFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertToBankFailed proto.InternalMessageInfo
+
+func (m *EventConvertToBankFailed) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventConvertToBankFailed) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventConvertToBankFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventMigratedToBank is emitted when a contract is migrated to x/bank.
+type EventMigratedToBank struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// denom of the coins in x/bank.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventMigratedToBank) Reset()         { *m = EventMigratedToBank{} }
+func (m *EventMigratedToBank) String() string { return proto.CompactTextString(m) }
+func (*EventMigratedToBank) ProtoMessage()    {}
+func (*EventMigratedToBank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7505f4c4cdec18e, []int{11}
+}
+func (m *EventMigratedToBank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMigratedToBank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMigratedToBank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMigratedToBank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMigratedToBank.Merge(m, src)
+}
+func (m *EventMigratedToBank) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMigratedToBank) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMigratedToBank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMigratedToBank proto.InternalMessageInfo
+
+func (m *EventMigratedToBank) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventMigratedToBank) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventApproved is emitted when a holder sets an allowance of a spender.
+type EventApproved struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the token holder which approved the allowance.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// number of tokens the spender can send.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"spend_limit"`
+	// time when the allowance expires.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *EventApproved) Reset()         { *m = EventApproved{} }
+func (m *EventApproved) String() string { return proto.CompactTextString(m) }
+func (*EventApproved) ProtoMessage()    {}
+func (*EventApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7505f4c4cdec18e, []int{12}
+}
+func (m *EventApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApproved.Merge(m, src)
+}
+func (m *EventApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApproved proto.InternalMessageInfo
+
+func (m *EventApproved) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventApproved) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventApproved) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *EventApproved) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("lbm.token.v1.AttributeKey", AttributeKey_name, AttributeKey_value)
 	proto.RegisterType((*EventSent)(nil), "lbm.token.v1.EventSent")
@@ -752,60 +1016,74 @@ func init() {
 	proto.RegisterType((*EventMinted)(nil), "lbm.token.v1.EventMinted")
 	proto.RegisterType((*EventBurned)(nil), "lbm.token.v1.EventBurned")
 	proto.RegisterType((*EventModified)(nil), "lbm.token.v1.EventModified")
+	proto.RegisterType((*EventConvertedToBank)(nil), "lbm.token.v1.EventConvertedToBank")
+	proto.RegisterType((*EventConvertToBankFailed)(nil), "lbm.token.v1.EventConvertToBankFailed")
+	proto.RegisterType((*EventMigratedToBank)(nil), "lbm.token.v1.EventMigratedToBank")
+	proto.RegisterType((*EventApproved)(nil), "lbm.token.v1.EventApproved")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/event.proto", fileDescriptor_d7505f4c4cdec18e) }
 
 var fileDescriptor_d7505f4c4cdec18e = []byte{
-	// 757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8e, 0x7f, 0x9a, 0xa4, 0xd3, 0xde, 0xd4, 0xd7, 0x37, 0x97, 0x0e, 0x46, 0x72, 0xad, 0xac,
-	0xa2, 0x0a, 0x12, 0xb5, 0x5d, 0x80, 0xba, 0x4b, 0x20, 0xad, 0x4c, 0x95, 0x52, 0xb9, 0xc9, 0x02,
-	0x36, 0x91, 0x63, 0x4f, 0x13, 0xab, 0xf1, 0x4c, 0x64, 0x8f, 0x23, 0xca, 0x13, 0xd0, 0xac, 0x78,
-	0x81, 0x2c, 0x10, 0x20, 0x21, 0x16, 0x3c, 0x01, 0x0f, 0xd0, 0x65, 0x57, 0x08, 0xb1, 0xa8, 0x50,
-	0xfb, 0x22, 0xc8, 0xe3, 0x38, 0xc4, 0x6d, 0x45, 0xa9, 0x5a, 0xb1, 0x3b, 0xdf, 0x9c, 0xef, 0xf8,
-	0x7c, 0xdf, 0x1c, 0x1f, 0x1b, 0xc0, 0x5e, 0xdb, 0x2d, 0x53, 0xb2, 0x8f, 0x70, 0x79, 0xb0, 0x52,
-	0x46, 0x03, 0x84, 0x69, 0xa9, 0xef, 0x11, 0x4a, 0xe4, 0xf9, 0x5e, 0xdb, 0x2d, 0xb1, 0x4c, 0x69,
-	0xb0, 0xa2, 0xe4, 0x3b, 0xa4, 0x43, 0x58, 0xa2, 0x1c, 0x46, 0x11, 0x47, 0x49, 0x56, 0x47, 0x64,
-	0x96, 0x29, 0x7c, 0xe1, 0xc0, 0x6c, 0x2d, 0x7c, 0xda, 0x2e, 0xc2, 0x54, 0x5e, 0x02, 0x73, 0x16,
-	0xc1, 0xd4, 0x33, 0x2d, 0xda, 0x72, 0x6c, 0xc8, 0x69, 0x5c, 0x71, 0xd6, 0x00, 0xf1, 0x91, 0x6e,
-	0xcb, 0x0a, 0xc8, 0x92, 0x3e, 0xf2, 0x4c, 0x4a, 0x3c, 0xc8, 0xb3, 0xec, 0x04, 0xcb, 0x32, 0x10,
-	0xf7, 0x3c, 0xe2, 0x42, 0x81, 0x9d, 0xb3, 0x58, 0xce, 0x01, 0x9e, 0x12, 0x28, 0xb2, 0x13, 0x9e,
-	0x12, 0xf9, 0x29, 0x48, 0x9b, 0x2e, 0x09, 0x30, 0x85, 0x33, 0xe1, 0x59, 0x75, 0xf5, 0xe8, 0x64,
-	0x29, 0xf5, 0xfd, 0x64, 0x69, 0xb9, 0xe3, 0xd0, 0x6e, 0xd0, 0x2e, 0x59, 0xc4, 0x2d, 0x6f, 0x38,
-	0xd8, 0xb7, 0xba, 0x8e, 0x59, 0xde, 0x1b, 0x07, 0x0f, 0x7c, 0x7b, 0xbf, 0x4c, 0x0f, 0xfa, 0xc8,
-	0x2f, 0xe9, 0x98, 0x1a, 0xe3, 0x27, 0xac, 0xf3, 0x90, 0x2b, 0x78, 0x60, 0x91, 0xa9, 0xaf, 0x04,
-	0xb4, 0x4b, 0x3c, 0xe7, 0x15, 0xb2, 0x9f, 0xc5, 0x72, 0xae, 0xf4, 0x72, 0x07, 0xa4, 0xbb, 0xa4,
-	0x67, 0xa3, 0xd8, 0xc9, 0x18, 0x25, 0x3c, 0x0a, 0x49, 0x8f, 0xac, 0x27, 0x01, 0x79, 0xd6, 0xd3,
-	0x40, 0x03, 0xb2, 0xff, 0x37, 0x1a, 0x7e, 0xe5, 0xc0, 0x1c, 0xeb, 0xa8, 0xfb, 0x7e, 0x80, 0x6c,
-	0x19, 0x82, 0x8c, 0xe5, 0x21, 0x46, 0x8f, 0x9a, 0xc4, 0xf0, 0xbc, 0x04, 0xfe, 0x82, 0x04, 0x19,
-	0x88, 0xd8, 0x74, 0x51, 0x3c, 0xa3, 0x30, 0x0e, 0x65, 0xf9, 0x07, 0x6e, 0x9b, 0xf4, 0xc6, 0x73,
-	0x1a, 0x23, 0x59, 0x02, 0x42, 0xe0, 0x39, 0xd1, 0xa0, 0x8c, 0x30, 0x0c, 0xab, 0x5d, 0x44, 0x4d,
-	0x98, 0x8e, 0xaa, 0xc3, 0x38, 0x14, 0x6f, 0x23, 0xcb, 0x71, 0xcd, 0x9e, 0x0f, 0x33, 0x1a, 0x57,
-	0x9c, 0x31, 0x26, 0x38, 0xcc, 0xb9, 0x0e, 0xa6, 0x66, 0xbb, 0x87, 0x60, 0x56, 0xe3, 0x8a, 0x59,
-	0x63, 0x82, 0x99, 0xb1, 0xb7, 0x1c, 0x98, 0x67, 0xc6, 0x36, 0x3d, 0x13, 0x53, 0x64, 0x5f, 0x7d,
-	0x85, 0x10, 0x64, 0x3a, 0x8c, 0x1b, 0xdf, 0x61, 0x0c, 0x7f, 0x65, 0x62, 0x73, 0x31, 0x94, 0x1f,
-	0x01, 0xd0, 0x47, 0x9e, 0xeb, 0xf8, 0xbe, 0x43, 0x30, 0xf3, 0x98, 0x5b, 0x85, 0xa5, 0xe9, 0xad,
-	0x29, 0xed, 0x4c, 0xf2, 0xc6, 0x14, 0x97, 0x69, 0x3c, 0xe4, 0x40, 0x6e, 0x3c, 0x6e, 0x4c, 0x02,
-	0x6c, 0x5d, 0x4b, 0x25, 0x4a, 0xaa, 0x3c, 0xaf, 0x45, 0xb8, 0xa6, 0x96, 0x4f, 0xf1, 0x8b, 0x50,
-	0x77, 0xfe, 0xec, 0xba, 0x7e, 0xb7, 0xae, 0xd1, 0x6a, 0x0a, 0x97, 0xac, 0xa6, 0x78, 0x2b, 0xab,
-	0xf9, 0x39, 0x16, 0x5b, 0x0d, 0x3c, 0x7c, 0x53, 0xb1, 0x97, 0x7d, 0x5b, 0x6e, 0x5b, 0xf0, 0x21,
-	0x07, 0xfe, 0x89, 0x6e, 0x97, 0xd8, 0xce, 0x9e, 0x73, 0x53, 0xc9, 0x0f, 0x41, 0xc6, 0xea, 0x9a,
-	0xb8, 0x83, 0x7c, 0x28, 0x68, 0x42, 0x71, 0x6e, 0x75, 0x31, 0x39, 0xe7, 0x0a, 0xa5, 0x9e, 0xd3,
-	0x0e, 0x28, 0xaa, 0x8a, 0xa1, 0x70, 0x23, 0x66, 0x87, 0x5a, 0x96, 0x3f, 0xf0, 0x60, 0x7e, 0x42,
-	0xd8, 0x42, 0x07, 0xf2, 0x3a, 0xb8, 0x5b, 0x69, 0x34, 0x0c, 0xbd, 0xda, 0x6c, 0xd4, 0x5a, 0x5b,
-	0xb5, 0xe7, 0xad, 0xe6, 0xf6, 0xee, 0x4e, 0xed, 0xb1, 0xbe, 0xa1, 0xd7, 0x9e, 0x48, 0x29, 0xe5,
-	0xde, 0x70, 0xa4, 0x2d, 0x4e, 0x17, 0x34, 0xb1, 0xdf, 0x47, 0x56, 0x64, 0xe3, 0x3e, 0x90, 0x93,
-	0xb5, 0xdb, 0x95, 0x7a, 0x4d, 0xe2, 0x94, 0xfc, 0x70, 0xa4, 0x49, 0xd3, 0x45, 0xdb, 0xe1, 0xe7,
-	0xe0, 0x02, 0xbb, 0x5e, 0x6b, 0x54, 0x24, 0xe1, 0x22, 0xbb, 0x1e, 0xae, 0xff, 0x1a, 0xf8, 0x3f,
-	0xc9, 0xd6, 0xeb, 0x9b, 0xad, 0xa6, 0xa1, 0x4b, 0x59, 0x05, 0x0e, 0x47, 0x5a, 0x7e, 0xba, 0x40,
-	0x77, 0xcd, 0x0e, 0x6a, 0x1a, 0xba, 0xbc, 0x0c, 0xfe, 0x3d, 0x67, 0xc6, 0xd0, 0xa5, 0x05, 0xe5,
-	0xbf, 0xe1, 0x48, 0x5b, 0x48, 0x98, 0x30, 0x74, 0x05, 0xbc, 0x7e, 0xa7, 0xa6, 0x3e, 0xbe, 0x57,
-	0x53, 0x90, 0x2b, 0x88, 0x59, 0x5e, 0xe2, 0x0b, 0x62, 0x56, 0x94, 0x32, 0x05, 0x31, 0x3b, 0x2b,
-	0xe5, 0xaa, 0xd5, 0xa3, 0x53, 0x95, 0x3b, 0x3e, 0x55, 0xb9, 0x1f, 0xa7, 0x2a, 0xf7, 0xe6, 0x4c,
-	0x4d, 0x1d, 0x9f, 0xa9, 0xa9, 0x6f, 0x67, 0x6a, 0xea, 0x45, 0xf1, 0xca, 0xb7, 0xe0, 0x65, 0xf4,
-	0x23, 0x6c, 0xa7, 0xd9, 0x9f, 0x70, 0xed, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xb9, 0xa7,
-	0x0f, 0x63, 0x07, 0x00, 0x00,
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
+	0x13, 0x16, 0x29, 0x5a, 0x92, 0x47, 0xfe, 0x1d, 0xfe, 0x8c, 0x1a, 0x6f, 0x55, 0x40, 0x12, 0x74,
+	0x12, 0x8c, 0x96, 0x42, 0x9c, 0x43, 0x8b, 0x9c, 0x2a, 0xa5, 0x72, 0xc0, 0x26, 0x72, 0x03, 0x5a,
+	0x3a, 0xb4, 0x17, 0x81, 0x12, 0xd7, 0xd2, 0x42, 0xe4, 0x2e, 0xb1, 0x5c, 0x0a, 0x71, 0x9f, 0xa0,
+	0xf1, 0x29, 0x2f, 0xe0, 0x43, 0xd1, 0x16, 0x28, 0x7a, 0x28, 0xd0, 0x7b, 0x1f, 0x20, 0xc7, 0x9c,
+	0x8a, 0xa2, 0x87, 0xb4, 0xb0, 0x9f, 0xa2, 0xb7, 0x82, 0x4b, 0x52, 0x95, 0xe2, 0x22, 0xae, 0x63,
+	0xa3, 0xb7, 0xf9, 0x76, 0x66, 0x76, 0xbe, 0x99, 0xe1, 0x0c, 0x17, 0x90, 0x37, 0xf6, 0xdb, 0x82,
+	0xcd, 0x31, 0x6d, 0x2f, 0xee, 0xb6, 0xf1, 0x02, 0x53, 0x61, 0x06, 0x9c, 0x09, 0x66, 0x6c, 0x79,
+	0x63, 0xdf, 0x94, 0x1a, 0x73, 0x71, 0xb7, 0x5a, 0x99, 0xb2, 0x29, 0x93, 0x8a, 0x76, 0x2c, 0x25,
+	0x36, 0xd5, 0xfa, 0x94, 0xb1, 0xa9, 0x87, 0xdb, 0x12, 0x8d, 0xa3, 0xa3, 0xb6, 0x20, 0x3e, 0x0e,
+	0x85, 0xe3, 0x07, 0xa9, 0xc1, 0xfa, 0xf5, 0xc9, 0x6d, 0x52, 0xd3, 0xfc, 0x59, 0x81, 0xcd, 0x5e,
+	0x1c, 0xee, 0x10, 0x53, 0x61, 0xd4, 0xa1, 0x3c, 0x61, 0x54, 0x70, 0x67, 0x22, 0x46, 0xc4, 0x45,
+	0x4a, 0x43, 0x69, 0x6d, 0xda, 0x90, 0x1d, 0x59, 0xae, 0x51, 0x85, 0x12, 0x0b, 0x30, 0x77, 0x04,
+	0xe3, 0x48, 0x95, 0xda, 0x25, 0x36, 0x0c, 0xd0, 0x8e, 0x38, 0xf3, 0x51, 0x5e, 0x9e, 0x4b, 0xd9,
+	0xd8, 0x06, 0x55, 0x30, 0xa4, 0xc9, 0x13, 0x55, 0x30, 0xe3, 0x53, 0x28, 0x38, 0x3e, 0x8b, 0xa8,
+	0x40, 0x1b, 0xf1, 0x59, 0x77, 0xef, 0xc5, 0xab, 0x7a, 0xee, 0xb7, 0x57, 0xf5, 0xdd, 0x29, 0x11,
+	0xb3, 0x68, 0x6c, 0x4e, 0x98, 0xdf, 0xde, 0x27, 0x34, 0x9c, 0xcc, 0x88, 0xd3, 0x3e, 0x4a, 0x85,
+	0x0f, 0x42, 0x77, 0xde, 0x16, 0xc7, 0x01, 0x0e, 0x4d, 0x8b, 0x0a, 0x3b, 0xbd, 0xe1, 0xbe, 0x8a,
+	0x94, 0x26, 0x87, 0x1d, 0xc9, 0xbe, 0x13, 0x89, 0x19, 0xe3, 0xe4, 0x4b, 0xec, 0x7e, 0x96, 0xd1,
+	0xb9, 0x34, 0x97, 0x3b, 0x50, 0x98, 0x31, 0xcf, 0xc5, 0x59, 0x26, 0x29, 0x5a, 0xcb, 0x31, 0xbf,
+	0x9e, 0xa3, 0x8c, 0xc9, 0xa0, 0x22, 0x63, 0xda, 0x78, 0xc1, 0xe6, 0xff, 0x45, 0xc0, 0x5f, 0x14,
+	0x28, 0xcb, 0x88, 0x56, 0x18, 0x46, 0xd8, 0x35, 0x10, 0x14, 0x27, 0x1c, 0x4b, 0xf3, 0x24, 0x48,
+	0x06, 0x5f, 0xa7, 0xa0, 0x5e, 0xa0, 0x60, 0x80, 0x46, 0x1d, 0x1f, 0x67, 0x3d, 0x8a, 0xe5, 0x98,
+	0x56, 0x78, 0xec, 0x8f, 0x99, 0x97, 0xf6, 0x29, 0x45, 0x86, 0x0e, 0xf9, 0x88, 0x93, 0xa4, 0x51,
+	0x76, 0x2c, 0xc6, 0xde, 0x3e, 0x16, 0x0e, 0x2a, 0x24, 0xde, 0xb1, 0x1c, 0x93, 0x77, 0xf1, 0x84,
+	0xf8, 0x8e, 0x17, 0xa2, 0x62, 0x43, 0x69, 0x6d, 0xd8, 0x4b, 0x1c, 0xeb, 0x7c, 0x42, 0x85, 0x33,
+	0xf6, 0x30, 0x2a, 0x35, 0x94, 0x56, 0xc9, 0x5e, 0x62, 0x99, 0xd8, 0xd7, 0x0a, 0x6c, 0xc9, 0xc4,
+	0x1e, 0x72, 0x87, 0x0a, 0xec, 0x5e, 0x5e, 0x42, 0x04, 0xc5, 0xa9, 0xb4, 0xcd, 0x6a, 0x98, 0xc1,
+	0xbf, 0x35, 0x59, 0x72, 0x19, 0x34, 0x3e, 0x02, 0x08, 0x30, 0xf7, 0x49, 0x18, 0x12, 0x46, 0x65,
+	0x8e, 0xdb, 0x7b, 0xc8, 0x5c, 0x1d, 0x2b, 0xf3, 0xc9, 0x52, 0x6f, 0xaf, 0xd8, 0x4a, 0x8e, 0xcf,
+	0x14, 0xd8, 0x4e, 0xdb, 0x4d, 0x59, 0x44, 0x27, 0x57, 0x62, 0x89, 0x91, 0xfa, 0x26, 0x2e, 0xf9,
+	0x2b, 0x72, 0xf9, 0x21, 0xfb, 0x10, 0xfa, 0xe4, 0xdf, 0x95, 0xeb, 0x4d, 0xe3, 0x9a, 0x8c, 0x66,
+	0xfe, 0x1f, 0x46, 0x53, 0xbb, 0x91, 0xd1, 0xfc, 0x31, 0x23, 0xdb, 0x8d, 0x38, 0xc5, 0xee, 0xcd,
+	0xef, 0x96, 0x9b, 0x26, 0xfc, 0x4c, 0x81, 0xff, 0x25, 0xd5, 0x65, 0x2e, 0x39, 0x22, 0xd7, 0xa5,
+	0xfc, 0x21, 0x14, 0x27, 0x33, 0x87, 0x4e, 0x71, 0x88, 0xf2, 0x8d, 0x7c, 0xab, 0xbc, 0xb7, 0xb3,
+	0xde, 0xe7, 0x8e, 0x10, 0x9c, 0x8c, 0x23, 0x81, 0xbb, 0x5a, 0x4c, 0xdc, 0xce, 0xac, 0x25, 0x97,
+	0x9f, 0x94, 0x74, 0xc9, 0x3c, 0x60, 0x74, 0x81, 0xb9, 0xc0, 0xee, 0x80, 0x75, 0x1d, 0x3a, 0x7f,
+	0xfb, 0x25, 0x53, 0x81, 0x0d, 0x17, 0xd3, 0x65, 0x09, 0x13, 0x70, 0x93, 0x35, 0x6c, 0xce, 0x01,
+	0xad, 0x52, 0x4e, 0x08, 0xef, 0x3b, 0xc4, 0xc3, 0xee, 0xdb, 0xd3, 0xbe, 0x03, 0x05, 0x8e, 0x9d,
+	0x30, 0x1d, 0x96, 0x4d, 0x3b, 0x45, 0xcd, 0xc7, 0x70, 0x3b, 0x9d, 0x84, 0x29, 0x77, 0xae, 0x50,
+	0x9e, 0x65, 0x19, 0xd4, 0x95, 0x32, 0x34, 0xff, 0xcc, 0x5a, 0xdf, 0x09, 0x02, 0xce, 0x16, 0xd7,
+	0x21, 0x8c, 0xa0, 0x18, 0x06, 0x98, 0xc6, 0x8a, 0x74, 0x0f, 0xa5, 0xd0, 0x38, 0x84, 0xb2, 0x14,
+	0x47, 0x1e, 0xf1, 0xc9, 0x75, 0x0a, 0x0e, 0xf2, 0x9a, 0xc7, 0xf1, 0x2d, 0xc6, 0xc7, 0x00, 0xf8,
+	0x69, 0x40, 0xb8, 0x23, 0xe2, 0x85, 0x12, 0xef, 0xea, 0xf2, 0x5e, 0xd5, 0x4c, 0xde, 0x03, 0x66,
+	0xf6, 0x1e, 0x30, 0x07, 0xd9, 0x7b, 0xa0, 0xab, 0x3d, 0xff, 0xbd, 0xae, 0xd8, 0x2b, 0x3e, 0xbb,
+	0xdf, 0xa9, 0xb0, 0xb5, 0xfc, 0x16, 0x1f, 0xe1, 0x63, 0xe3, 0x3e, 0xbc, 0xdb, 0x19, 0x0c, 0x6c,
+	0xab, 0x3b, 0x1c, 0xf4, 0x46, 0x8f, 0x7a, 0x9f, 0x8f, 0x86, 0x07, 0x87, 0x4f, 0x7a, 0x0f, 0xac,
+	0x7d, 0xab, 0xf7, 0x89, 0x9e, 0xab, 0xbe, 0x77, 0x72, 0xda, 0xd8, 0x59, 0x75, 0x18, 0xd2, 0x30,
+	0xc0, 0x93, 0x64, 0x62, 0xde, 0x07, 0x63, 0xdd, 0xf7, 0xa0, 0xd3, 0xef, 0xe9, 0x4a, 0xb5, 0x72,
+	0x72, 0xda, 0xd0, 0x57, 0x9d, 0x0e, 0xe2, 0x3f, 0xcf, 0x05, 0xeb, 0x7e, 0x6f, 0xd0, 0xd1, 0xf3,
+	0x17, 0xad, 0xfb, 0xf1, 0x9f, 0xe6, 0x1e, 0xbc, 0xb3, 0x6e, 0x6d, 0xf5, 0x1f, 0x8e, 0x86, 0xb6,
+	0xa5, 0x97, 0xaa, 0xe8, 0xe4, 0xb4, 0x51, 0x59, 0x75, 0xb0, 0x7c, 0x67, 0x8a, 0x87, 0xb6, 0x65,
+	0xec, 0xc2, 0xff, 0x5f, 0x4b, 0xc6, 0xb6, 0xf4, 0x5b, 0xd5, 0xdb, 0x27, 0xa7, 0x8d, 0x5b, 0x6b,
+	0x49, 0xd8, 0x56, 0x15, 0xbe, 0xfa, 0xa6, 0x96, 0xfb, 0xfe, 0xdb, 0x5a, 0x0e, 0x29, 0x4d, 0xad,
+	0xa4, 0xea, 0x6a, 0x53, 0x2b, 0x69, 0x7a, 0xb1, 0xa9, 0x95, 0x36, 0xf5, 0xed, 0x6e, 0xf7, 0xc5,
+	0x59, 0x4d, 0x79, 0x79, 0x56, 0x53, 0xfe, 0x38, 0xab, 0x29, 0xcf, 0xcf, 0x6b, 0xb9, 0x97, 0xe7,
+	0xb5, 0xdc, 0xaf, 0xe7, 0xb5, 0xdc, 0x17, 0xad, 0x4b, 0x7b, 0xf7, 0x34, 0x79, 0x73, 0x8d, 0x0b,
+	0xb2, 0x23, 0xf7, 0xfe, 0x1a, 0x00, 0xe2, 0xa0, 0x67, 0x28, 0xef, 0x09, 0x00, 0x00,
 }
 
 func (m *EventSent) Marshal() (dAtA []byte, err error) {
@@ -1287,60 +1565,259 @@ func (m *EventModified) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventConvertedToBank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventSent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventConvertedToBank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertedToBank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAuthorizedOperator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventConvertToBankFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertToBankFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertToBankFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Operator)
-	if l > 0 {
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMigratedToBank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMigratedToBank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMigratedToBank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventAuthorizedOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
 	return n
@@ -1519,6 +1996,94 @@ func (m *EventModified) Size() (n int) {
 	return n
 }
 
+func (m *EventConvertedToBank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventConvertToBankFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMigratedToBank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1584,11 +2149,579 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuthorizedOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuthorizedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevokedOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevokedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIssued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIssued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIssued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1616,11 +2749,11 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1648,11 +2781,11 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1680,13 +2813,13 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var stringLen uint64
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1696,26 +2829,31 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Decimals |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Mintable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1737,7 +2875,7 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
+func (m *EventGranted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1760,10 +2898,10 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAuthorizedOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGranted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAuthorizedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGranted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1800,7 +2938,7 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1828,11 +2966,11 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1860,8 +2998,27 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1883,7 +3040,7 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
+func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1906,10 +3063,10 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRevokedOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRenounced: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRevokedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRenounced: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1946,7 +3103,7 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1974,13 +3131,13 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var stringLen uint64
+			m.Permission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1990,24 +3147,11 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Permission |= Permission(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2029,7 +3173,7 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventIssued) Unmarshal(dAtA []byte) error {
+func (m *EventMinted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2052,15 +3196,15 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventIssued: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMinted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventIssued: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMinted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2088,11 +3232,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2120,11 +3264,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2152,75 +3296,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2241,54 +3321,17 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Mintable = bool(v != 0)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2310,7 +3353,7 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGranted) Unmarshal(dAtA []byte) error {
+func (m *EventBurned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2333,10 +3376,10 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGranted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBurned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGranted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBurned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2373,7 +3416,7 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2401,11 +3444,11 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2433,13 +3476,13 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Permission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2449,11 +3492,26 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= Permission(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2475,7 +3533,7 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRenounced) Unmarshal(dAtA []byte) error {
+func (m *EventModified) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,10 +3556,10 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRenounced: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventModified: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRenounced: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventModified: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2538,7 +3596,7 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2566,13 +3624,13 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			m.Permission = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2582,11 +3640,26 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= Permission(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, Attribute{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2608,7 +3681,7 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMinted) Unmarshal(dAtA []byte) error {
+func (m *EventConvertedToBank) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2631,10 +3704,10 @@ func (m *EventMinted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMinted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConvertedToBank: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMinted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConvertedToBank: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2671,7 +3744,7 @@ func (m *EventMinted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2699,11 +3772,11 @@ func (m *EventMinted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2731,7 +3804,7 @@ func (m *EventMinted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2788,7 +3861,7 @@ func (m *EventMinted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBurned) Unmarshal(dAtA []byte) error {
+func (m *EventConvertToBankFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2811,10 +3884,10 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBurned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConvertToBankFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBurned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConvertToBankFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2851,7 +3924,7 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2879,11 +3952,11 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2911,11 +3984,61 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMigratedToBank) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMigratedToBank: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMigratedToBank: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2943,9 +4066,39 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2968,7 +4121,7 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventModified) Unmarshal(dAtA []byte) error {
+func (m *EventApproved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2991,10 +4144,10 @@ func (m *EventModified) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventModified: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventApproved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventModified: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventApproved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3031,7 +4184,7 @@ func (m *EventModified) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3059,11 +4212,77 @@ func (m *EventModified) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3090,8 +4309,10 @@ func (m *EventModified) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, Attribute{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package token
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 )
 
 type (
@@ -13,4 +14,16 @@ type (
 		InitGenesis(ctx sdk.Context, data *ClassGenesisState)
 		ExportGenesis(ctx sdk.Context) *ClassGenesisState
 	}
+
+	// BankKeeper defines the contract needed to be fulfilled for banking dependencies.
+	BankKeeper interface {
+		MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+		GetSupply(ctx sdk.Context, denom string) sdk.Coin
+
+		GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+		SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+	}
 )
@@ -90,6 +90,38 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, contractAllowances := range data.Allowances {
+		if err := ValidateContractID(contractAllowances.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractAllowances.Allowances) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowances cannot be empty")
+		}
+		for _, allowance := range contractAllowances.Allowances {
+			if _, err := sdk.AccAddressFromBech32(allowance.Holder); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(allowance.Spender); err != nil {
+				return err
+			}
+			if err := validateAmount(allowance.SpendLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	seenMigrated := map[string]bool{}
+	for _, contractID := range data.MigratedContracts {
+		if err := ValidateContractID(contractID); err != nil {
+			return err
+		}
+		if seenMigrated[contractID] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate migrated contract: %s", contractID)
+		}
+		seenMigrated[contractID] = true
+	}
+
 	return nil
 }
 
@@ -46,6 +46,10 @@ type GenesisState struct {
 	Mints []ContractCoin `protobuf:"bytes,8,rep,name=mints,proto3" json:"mints"`
 	// burns represents the total burns of tokens.
 	Burns []ContractCoin `protobuf:"bytes,9,rep,name=burns,proto3" json:"burns"`
+	// migrated_contracts defines the ids of the contracts migrated to x/bank.
+	MigratedContracts []string `protobuf:"bytes,10,rep,name=migrated_contracts,json=migratedContracts,proto3" json:"migrated_contracts,omitempty"`
+	// allowances defines the allowance information.
+	Allowances []ContractAllowances `protobuf:"bytes,11,rep,name=allowances,proto3" json:"allowances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -144,6 +148,20 @@ func (m *GenesisState) GetBurns() []ContractCoin {
 	return nil
 }
 
+func (m *GenesisState) GetMigratedContracts() []string {
+	if m != nil {
+		return m.MigratedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowances() []ContractAllowances {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // ClassGenesisState defines the classs keeper's genesis state.
 //
 // Deprecated: Do not use.
@@ -359,6 +377,61 @@ func (m *ContractAuthorizations) GetAuthorizations() []Authorization {
 	return nil
 }
 
+// ContractAllowances defines allowances belong to a contract.
+type ContractAllowances struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// allowances of the contract.
+	Allowances []Allowance `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *ContractAllowances) Reset()         { *m = ContractAllowances{} }
+func (m *ContractAllowances) String() string { return proto.CompactTextString(m) }
+func (*ContractAllowances) ProtoMessage()    {}
+func (*ContractAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{5}
+}
+func (m *ContractAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAllowances.Merge(m, src)
+}
+func (m *ContractAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAllowances proto.InternalMessageInfo
+
+func (m *ContractAllowances) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractAllowances) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // ContractGrant defines grants belong to a contract.
 //
 // Deprecated: Do not use.
@@ -373,7 +446,7 @@ func (m *ContractGrants) Reset()         { *m = ContractGrants{} }
 func (m *ContractGrants) String() string { return proto.CompactTextString(m) }
 func (*ContractGrants) ProtoMessage()    {}
 func (*ContractGrants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{5}
+	return fileDescriptor_4528f1ba25ef9938, []int{6}
 }
 func (m *ContractGrants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +501,7 @@ func (m *ContractCoin) Reset()         { *m = ContractCoin{} }
 func (m *ContractCoin) String() string { return proto.CompactTextString(m) }
 func (*ContractCoin) ProtoMessage()    {}
 func (*ContractCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{6}
+	return fileDescriptor_4528f1ba25ef9938, []int{7}
 }
 func (m *ContractCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,6 +543,7 @@ func init() {
 	proto.RegisterType((*ContractBalances)(nil), "lbm.token.v1.ContractBalances")
 	proto.RegisterType((*Balance)(nil), "lbm.token.v1.Balance")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.token.v1.ContractAuthorizations")
+	proto.RegisterType((*ContractAllowances)(nil), "lbm.token.v1.ContractAllowances")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.token.v1.ContractGrants")
 	proto.RegisterType((*ContractCoin)(nil), "lbm.token.v1.ContractCoin")
 }
@@ -477,44 +551,48 @@ func init() {
 func init() { proto.RegisterFile("lbm/token/v1/genesis.proto", fileDescriptor_4528f1ba25ef9938) }
 
 var fileDescriptor_4528f1ba25ef9938 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xc6, 0xe3, 0xa4, 0x75, 0x9a, 0x6f, 0xaa, 0xaa, 0xbf, 0xfb, 0x95, 0xea, 0x14, 0x90, 0x53,
-	0x55, 0x0c, 0x11, 0x08, 0x5b, 0x49, 0xa5, 0x22, 0x45, 0x0c, 0xc5, 0x95, 0xa8, 0xc2, 0x84, 0x8c,
-	0x58, 0x58, 0xaa, 0xf3, 0x1f, 0x92, 0x53, 0xe3, 0x3b, 0xcb, 0x77, 0x29, 0x7f, 0x16, 0x56, 0x46,
-	0x5e, 0x02, 0x2f, 0x85, 0xb1, 0x63, 0x47, 0xc4, 0x50, 0xa1, 0x64, 0xe1, 0x65, 0x20, 0xdf, 0xd9,
-	0x55, 0x9c, 0x06, 0x25, 0x03, 0xdb, 0xc5, 0xf7, 0x3c, 0x9f, 0xc7, 0xcf, 0xe5, 0x7b, 0x86, 0xd6,
-	0xd8, 0x8f, 0x1d, 0xc9, 0x2f, 0x22, 0xe6, 0x5c, 0x76, 0x9d, 0x61, 0xc4, 0x22, 0x41, 0x85, 0x9d,
-	0xa4, 0x5c, 0x72, 0xb4, 0x3d, 0xf6, 0x63, 0x5b, 0xed, 0xd9, 0x97, 0xdd, 0xd6, 0xde, 0x90, 0x0f,
-	0xb9, 0xda, 0x70, 0xb2, 0x95, 0xd6, 0xb4, 0x70, 0xc9, 0xaf, 0xc5, 0x6a, 0xe7, 0xf0, 0xfb, 0x06,
-	0x6c, 0x9f, 0x69, 0xde, 0x6b, 0x49, 0x64, 0x84, 0x7a, 0x60, 0x26, 0x24, 0x25, 0xb1, 0xc0, 0xc6,
-	0x81, 0xd1, 0x69, 0xf6, 0xf6, 0xec, 0x79, 0xbe, 0xfd, 0x4a, 0xed, 0xb9, 0x1b, 0x57, 0x37, 0xed,
-	0x8a, 0x97, 0x2b, 0xd1, 0x09, 0x34, 0x83, 0x31, 0x11, 0xe2, 0x5c, 0x64, 0x08, 0x5c, 0x55, 0xc6,
-	0x76, 0xd9, 0x78, 0x9a, 0x09, 0xe6, 0x93, 0x3c, 0x50, 0x1e, 0x9d, 0x7a, 0x02, 0x5b, 0x3e, 0x19,
-	0x13, 0x16, 0x44, 0x02, 0xd7, 0x0e, 0x6a, 0x9d, 0x66, 0xcf, 0x5a, 0xb0, 0x73, 0x26, 0x53, 0x12,
-	0x48, 0x37, 0x57, 0xe5, 0x6f, 0x70, 0xeb, 0x42, 0xc7, 0x50, 0x57, 0xbc, 0x48, 0xe0, 0x0d, 0x05,
-	0xd8, 0xff, 0x0b, 0x40, 0x1b, 0x0b, 0x31, 0xea, 0x83, 0x39, 0x4c, 0x09, 0x93, 0x02, 0x6f, 0x2a,
-	0xdb, 0x83, 0xe5, 0xb6, 0x33, 0xa5, 0x29, 0x7a, 0x6b, 0x07, 0xf2, 0x60, 0x87, 0x4c, 0xe4, 0x88,
-	0xa7, 0xf4, 0x13, 0x91, 0x94, 0x33, 0x81, 0x4d, 0xc5, 0x78, 0xb8, 0x9c, 0xf1, 0xbc, 0xa4, 0xcd,
-	0x59, 0x0b, 0x04, 0xf4, 0x0c, 0xb6, 0xc4, 0x24, 0x49, 0xc6, 0x34, 0x12, 0xb8, 0xae, 0x68, 0xad,
-	0xe5, 0xb4, 0x53, 0x4e, 0x59, 0x71, 0x0a, 0x85, 0x03, 0x1d, 0xc3, 0x66, 0x4c, 0xb3, 0x32, 0x5b,
-	0x6b, 0x5a, 0xb5, 0x3c, 0xf3, 0xf9, 0x93, 0x94, 0x09, 0xdc, 0x58, 0xd7, 0xa7, 0xe4, 0xfd, 0x2a,
-	0x36, 0x0e, 0x25, 0xfc, 0x77, 0xe7, 0xcf, 0x45, 0x03, 0xd8, 0x64, 0x9c, 0x05, 0x91, 0x9a, 0xa2,
-	0x86, 0x7b, 0x94, 0x99, 0x7e, 0xde, 0xb4, 0x1f, 0x0f, 0xa9, 0x1c, 0x4d, 0x7c, 0x3b, 0xe0, 0xb1,
-	0xf3, 0x82, 0x32, 0x11, 0x8c, 0x28, 0x71, 0xde, 0xe5, 0x8b, 0x27, 0x22, 0xbc, 0x70, 0xe4, 0xc7,
-	0x24, 0x12, 0xf6, 0x1b, 0xca, 0xa4, 0xa7, 0x09, 0x68, 0x17, 0x6a, 0x34, 0x14, 0xb8, 0x7a, 0x50,
-	0xeb, 0x34, 0xbc, 0x6c, 0xa9, 0x52, 0x13, 0xd8, 0x5d, 0x9c, 0x09, 0xd4, 0x86, 0x66, 0x90, 0x3f,
-	0x3b, 0xa7, 0xa1, 0x8e, 0xf6, 0xa0, 0x78, 0x34, 0x08, 0xd1, 0xd3, 0xb9, 0x31, 0xab, 0xaa, 0xa6,
-	0xf7, 0xca, 0x4d, 0x73, 0xd4, 0xe2, 0x74, 0xa9, 0xc4, 0xf7, 0x50, 0xcf, 0xb7, 0x11, 0x86, 0x3a,
-	0x09, 0xc3, 0x34, 0x12, 0x22, 0x0f, 0x29, 0x7e, 0xa2, 0x97, 0x60, 0x92, 0x98, 0x4f, 0x98, 0x54,
-	0xb7, 0xa0, 0xe1, 0xf6, 0xf2, 0xe2, 0x8f, 0xd6, 0x2c, 0x3e, 0x60, 0xd2, 0xcb, 0x09, 0x7d, 0xf3,
-	0xf7, 0xb7, 0xb6, 0x81, 0x8d, 0xc3, 0x2f, 0x06, 0xec, 0x2f, 0x9f, 0xa1, 0xd5, 0x8d, 0x07, 0x77,
-	0x46, 0x54, 0xf7, 0xbe, 0x5f, 0xee, 0x5d, 0xc2, 0x2e, 0x9f, 0x4c, 0x75, 0x06, 0x23, 0xd8, 0x29,
-	0xdf, 0x88, 0xd5, 0x6f, 0xd0, 0xbd, 0xbd, 0x60, 0x3a, 0xf9, 0xff, 0x72, 0xb2, 0xc2, 0x94, 0xef,
-	0x95, 0x4a, 0xfa, 0x0c, 0xdb, 0xf3, 0x63, 0xb7, 0x3a, 0xe7, 0x5f, 0x9e, 0x7c, 0x15, 0x1b, 0xae,
-	0x7b, 0x35, 0xb5, 0x8c, 0xeb, 0xa9, 0x65, 0xfc, 0x9a, 0x5a, 0xc6, 0xd7, 0x99, 0x55, 0xb9, 0x9e,
-	0x59, 0x95, 0x1f, 0x33, 0xab, 0xf2, 0xb6, 0xb3, 0x92, 0xf8, 0x41, 0x7f, 0x63, 0x7d, 0x53, 0x7d,
-	0x64, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xaf, 0x98, 0x1b, 0xc0, 0x05, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6b, 0xdb, 0x3c,
+	0x1c, 0x8e, 0x93, 0x26, 0x69, 0x7e, 0x29, 0xa5, 0xd5, 0xdb, 0xb7, 0xaf, 0xc8, 0x3b, 0x9c, 0x10,
+	0x76, 0x08, 0x1b, 0xb5, 0x69, 0x0a, 0x1d, 0x94, 0x0d, 0xba, 0x14, 0x5a, 0xb2, 0xd3, 0xf0, 0xd8,
+	0x65, 0x97, 0xa2, 0xd8, 0x5e, 0x22, 0x6a, 0x4b, 0xc1, 0x52, 0xda, 0x6d, 0x97, 0x5d, 0x77, 0xdc,
+	0x47, 0xd8, 0xb7, 0xd8, 0x57, 0xe8, 0xb1, 0xc7, 0xb1, 0x43, 0x19, 0xed, 0x65, 0x1f, 0x63, 0x58,
+	0x92, 0x4b, 0x9c, 0xa4, 0xa4, 0x87, 0xdd, 0x94, 0xfc, 0x9e, 0x3f, 0x7a, 0xac, 0x47, 0x36, 0x34,
+	0xa2, 0x41, 0xec, 0x4a, 0x7e, 0x16, 0x32, 0xf7, 0x7c, 0xd7, 0x1d, 0x86, 0x2c, 0x14, 0x54, 0x38,
+	0xe3, 0x84, 0x4b, 0x8e, 0xd6, 0xa2, 0x41, 0xec, 0xa8, 0x99, 0x73, 0xbe, 0xdb, 0xd8, 0x1a, 0xf2,
+	0x21, 0x57, 0x03, 0x37, 0x5d, 0x69, 0x4c, 0x03, 0xe7, 0xf8, 0x1a, 0xac, 0x26, 0xed, 0xef, 0x65,
+	0x58, 0x3b, 0xd1, 0x7a, 0x6f, 0x24, 0x91, 0x21, 0xea, 0x42, 0x65, 0x4c, 0x12, 0x12, 0x0b, 0x6c,
+	0xb5, 0xac, 0x4e, 0xbd, 0xbb, 0xe5, 0x4c, 0xeb, 0x3b, 0xaf, 0xd5, 0xac, 0xb7, 0x72, 0x79, 0xdd,
+	0x2c, 0x78, 0x06, 0x89, 0x0e, 0xa1, 0xee, 0x47, 0x44, 0x88, 0x53, 0x91, 0x4a, 0xe0, 0xa2, 0x22,
+	0x36, 0xf3, 0xc4, 0xa3, 0x14, 0x30, 0xed, 0xe4, 0x81, 0xe2, 0x68, 0xd7, 0x43, 0x58, 0x1d, 0x90,
+	0x88, 0x30, 0x3f, 0x14, 0xb8, 0xd4, 0x2a, 0x75, 0xea, 0x5d, 0x7b, 0x86, 0xce, 0x99, 0x4c, 0x88,
+	0x2f, 0x7b, 0x06, 0x65, 0x76, 0x70, 0xc7, 0x42, 0xfb, 0x50, 0x55, 0x7a, 0xa1, 0xc0, 0x2b, 0x4a,
+	0x60, 0xfb, 0x1e, 0x01, 0x4d, 0xcc, 0xc0, 0xe8, 0x00, 0x2a, 0xc3, 0x84, 0x30, 0x29, 0x70, 0x59,
+	0xd1, 0x1e, 0x2d, 0xa6, 0x9d, 0x28, 0x4c, 0x96, 0x5b, 0x33, 0x90, 0x07, 0xeb, 0x64, 0x22, 0x47,
+	0x3c, 0xa1, 0x9f, 0x88, 0xa4, 0x9c, 0x09, 0x5c, 0x51, 0x1a, 0x8f, 0x17, 0x6b, 0xbc, 0xcc, 0x61,
+	0x8d, 0xd6, 0x8c, 0x02, 0x7a, 0x0e, 0xab, 0x62, 0x32, 0x1e, 0x47, 0x34, 0x14, 0xb8, 0xaa, 0xd4,
+	0x1a, 0x8b, 0xd5, 0x8e, 0x38, 0x65, 0xd9, 0x53, 0xc8, 0x18, 0x68, 0x1f, 0xca, 0x31, 0x4d, 0xc3,
+	0xac, 0x3e, 0x90, 0xaa, 0xe1, 0x29, 0x6f, 0x30, 0x49, 0x98, 0xc0, 0xb5, 0x87, 0xf2, 0x14, 0x1c,
+	0xed, 0x00, 0x8a, 0xe9, 0x30, 0x21, 0x32, 0x0c, 0x4e, 0x7d, 0x83, 0x12, 0x18, 0x5a, 0xa5, 0x4e,
+	0xcd, 0xdb, 0xcc, 0x26, 0x19, 0x5d, 0xa0, 0x63, 0x00, 0x12, 0x45, 0xfc, 0x42, 0x1f, 0x74, 0x5d,
+	0x79, 0xb5, 0xee, 0x79, 0x58, 0x77, 0x38, 0xe3, 0x38, 0xc5, 0x3c, 0x28, 0x62, 0xab, 0x2d, 0x61,
+	0x73, 0xae, 0x53, 0xa8, 0x0f, 0x65, 0xc6, 0x99, 0x1f, 0xaa, 0xf2, 0xd6, 0x7a, 0x7b, 0x29, 0xf3,
+	0xe7, 0x75, 0xf3, 0xe9, 0x90, 0xca, 0xd1, 0x64, 0xe0, 0xf8, 0x3c, 0x76, 0x8f, 0x29, 0x13, 0xfe,
+	0x88, 0x12, 0xf7, 0xbd, 0x59, 0xec, 0x88, 0xe0, 0xcc, 0x95, 0x1f, 0xc7, 0xa1, 0x70, 0xde, 0x52,
+	0x26, 0x3d, 0xad, 0x80, 0x36, 0xa0, 0x44, 0x03, 0x81, 0x8b, 0x2a, 0x4b, 0xba, 0x54, 0xae, 0x63,
+	0xd8, 0x98, 0xad, 0x22, 0x6a, 0x42, 0x3d, 0xcb, 0x7e, 0x4a, 0x03, 0x6d, 0xed, 0x41, 0xf6, 0x57,
+	0x3f, 0x40, 0xcf, 0xa6, 0xda, 0x5d, 0x54, 0xa1, 0xff, 0xcd, 0x87, 0x36, 0x52, 0xb3, 0xa5, 0x56,
+	0x8e, 0x17, 0x50, 0x35, 0x63, 0x84, 0xa1, 0x4a, 0x82, 0x20, 0x09, 0x85, 0x30, 0x26, 0xd9, 0x4f,
+	0xf4, 0x0a, 0x2a, 0x24, 0xe6, 0x13, 0x26, 0xd5, 0xe5, 0xab, 0xf5, 0xba, 0x26, 0xf8, 0x93, 0x07,
+	0x06, 0xef, 0x33, 0xe9, 0x19, 0x85, 0x83, 0xca, 0xef, 0x6f, 0x4d, 0x0b, 0x5b, 0xed, 0x2f, 0x16,
+	0x6c, 0x2f, 0xae, 0xee, 0xf2, 0xc4, 0xfd, 0xb9, 0x9b, 0xa1, 0x73, 0xff, 0x9f, 0xcf, 0x9d, 0x93,
+	0x5d, 0x7c, 0x21, 0xcc, 0x59, 0xa3, 0xf9, 0x5e, 0x2c, 0xdf, 0xc5, 0x8b, 0x5c, 0xdd, 0xf4, 0x0e,
+	0xfe, 0x9b, 0xd9, 0x41, 0x36, 0x9f, 0x6f, 0x59, 0x7b, 0x04, 0xeb, 0xf9, 0xeb, 0xbf, 0xdc, 0x71,
+	0xf7, 0xee, 0x6d, 0xa2, 0xdd, 0xfe, 0xc9, 0xbb, 0x29, 0x99, 0xfc, 0x4b, 0x44, 0xe5, 0xfb, 0x0c,
+	0x6b, 0xd3, 0x77, 0x6c, 0xb9, 0xcf, 0xdf, 0x3c, 0xef, 0x22, 0xb6, 0x7a, 0xbd, 0xcb, 0x1b, 0xdb,
+	0xba, 0xba, 0xb1, 0xad, 0x5f, 0x37, 0xb6, 0xf5, 0xf5, 0xd6, 0x2e, 0x5c, 0xdd, 0xda, 0x85, 0x1f,
+	0xb7, 0x76, 0xe1, 0x5d, 0x67, 0xa9, 0xe2, 0x07, 0xfd, 0x41, 0x19, 0x54, 0xd4, 0x17, 0x65, 0xef,
+	0xcf, 0x00, 0x6c, 0xa6, 0xa7, 0xa0, 0xad, 0x06, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -564,6 +642,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MigratedContracts) > 0 {
+		for iNdEx := len(m.MigratedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MigratedContracts[iNdEx])
+			copy(dAtA[i:], m.MigratedContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MigratedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Burns) > 0 {
 		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -857,6 +958,50 @@ func (m *ContractAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1006,6 +1151,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MigratedContracts) > 0 {
+		for _, s := range m.MigratedContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1079,6 +1236,25 @@ func (m *ContractAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *ContractAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContractGrants) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1455,6 +1631,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedContracts = append(m.MigratedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, ContractAllowances{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1940,6 +2182,122 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -229,6 +229,69 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"allowances of invalid contract id": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					Allowances: []token.Allowance{{
+						Holder:     addr.String(),
+						Spender:    addr.String(),
+						SpendLimit: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty allowances": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid spender of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Holder:     addr.String(),
+						SpendLimit: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid spend limit of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Holder:     addr.String(),
+						Spender:    addr.String(),
+						SpendLimit: sdk.ZeroInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"migrated contracts": {
+			&token.GenesisState{
+				MigratedContracts: []string{"deadbeef", "fee1dead"},
+			},
+			true,
+		},
+		"invalid migrated contract id": {
+			&token.GenesisState{
+				MigratedContracts: []string{""},
+			},
+			false,
+		},
+		"duplicate migrated contracts": {
+			&token.GenesisState{
+				MigratedContracts: []string{"deadbeef", "deadbeef"},
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -107,6 +107,23 @@ func (k Keeper) iterateAuthorizationsImpl(ctx sdk.Context, prefix []byte, fn fun
 	}
 }
 
+func (k Keeper) iterateContractAllowances(ctx sdk.Context, contractID string, fn func(allowance token.Allowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, allowanceKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance token.Allowance
+		k.cdc.MustUnmarshal(iterator.Value(), &allowance)
+
+		stop := fn(allowance)
+		if stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateStatistics(ctx sdk.Context, prefix []byte, fn func(contractID string, amount sdk.Int) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -139,3 +156,19 @@ func (k Keeper) iterateMinteds(ctx sdk.Context, fn func(contractID string, amoun
 func (k Keeper) iterateBurnts(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
 	k.iterateStatistics(ctx, burnKeyPrefix, fn)
 }
+
+func (k Keeper) iterateMigratedContracts(ctx sdk.Context, fn func(contractID string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, migratedContractKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID := splitMigratedContractKey(iterator.Key())
+
+		stop := fn(contractID)
+		if stop {
+			break
+		}
+	}
+}
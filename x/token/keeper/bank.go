@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// ConvertToBank converts the holder's tokens into the coins of x/bank.
+func (k Keeper) ConvertToBank(ctx sdk.Context, contractID string, holder sdk.AccAddress, amount sdk.Int) error {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	return k.convertToBank(ctx, *class, holder, amount)
+}
+
+func (k Keeper) convertToBank(ctx sdk.Context, class token.Contract, holder sdk.AccAddress, amount sdk.Int) error {
+	if err := k.subtractToken(ctx, class.Id, holder, amount); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, class.Id)
+	supply = supply.Sub(amount)
+	k.setSupply(ctx, class.Id, supply)
+
+	k.registerBankDenom(ctx, class)
+
+	denom := token.BankDenom(class.Id)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.MintCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, token.ModuleName, holder, coins); err != nil {
+		return err
+	}
+
+	event := token.EventConvertedToBank{
+		ContractId: class.Id,
+		Holder:     holder.String(),
+		Denom:      denom,
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// MigrateToBank converts the tokens of at most limit holders of the contract
+// into the coins of x/bank, and returns whether the migration is done.
+// The holders whose tokens cannot be converted are skipped, and reported by
+// EventConvertToBankFailed.
+// The migration is done once no holder has the tokens left in x/token.
+// After the migration, mint and burn apply to the coins of x/bank, under the
+// permissions of the contract.
+func (k Keeper) MigrateToBank(ctx sdk.Context, contractID string, limit uint32) (bool, error) {
+	if k.IsMigratedToBank(ctx, contractID) {
+		return false, token.ErrContractMigrated.Wrap(contractID)
+	}
+
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return false, err
+	}
+
+	failed := map[string]bool{}
+	for converted := 0; converted < int(limit); {
+		var balances []token.Balance
+		k.iterateContractBalances(ctx, contractID, func(balance token.Balance) (stop bool) {
+			if failed[balance.Address] {
+				return false
+			}
+			if len(balances) == int(limit)-converted {
+				return true
+			}
+			balances = append(balances, balance)
+			return false
+		})
+		if len(balances) == 0 {
+			break
+		}
+
+		for _, balance := range balances {
+			holder := sdk.MustAccAddressFromBech32(balance.Address)
+
+			// a failing holder must not block the others
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.convertToBank(cacheCtx, *class, holder, balance.Amount); err != nil {
+				failed[balance.Address] = true
+
+				event := token.EventConvertToBankFailed{
+					ContractId: contractID,
+					Holder:     balance.Address,
+					Reason:     err.Error(),
+				}
+				if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+					panic(err)
+				}
+				continue
+			}
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+			converted++
+		}
+	}
+
+	if !k.GetSupply(ctx, contractID).IsZero() {
+		return false, nil
+	}
+
+	// the metadata must exist even if nobody holds the tokens
+	k.registerBankDenom(ctx, *class)
+
+	k.setMigratedToBank(ctx, contractID)
+
+	event := token.EventMigratedToBank{
+		ContractId: contractID,
+		Denom:      token.BankDenom(contractID),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return true, nil
+}
+
+func (k Keeper) registerBankDenom(ctx sdk.Context, class token.Contract) {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, token.BankDenom(class.Id)); found {
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, token.BankMetadata(class))
+}
+
+// GetTotalSupply returns the supply of the contract including the tokens
+// converted into the coins of x/bank.
+func (k Keeper) GetTotalSupply(ctx sdk.Context, contractID string) sdk.Int {
+	supply := k.GetSupply(ctx, contractID)
+	converted := k.bankKeeper.GetSupply(ctx, token.BankDenom(contractID)).Amount
+
+	return supply.Add(converted)
+}
+
+// IsMigratedToBank returns whether the contract has been migrated to x/bank.
+func (k Keeper) IsMigratedToBank(ctx sdk.Context, contractID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(migratedContractKey(contractID))
+}
+
+func (k Keeper) setMigratedToBank(ctx sdk.Context, contractID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(migratedContractKey(contractID), []byte{})
+}
+
+// mintBankCoins mints the coins of x/bank on behalf of the migrated contract.
+func (k Keeper) mintBankCoins(ctx sdk.Context, contractID string, to sdk.AccAddress, amount sdk.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(token.BankDenom(contractID), amount))
+	if err := k.bankKeeper.MintCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, token.ModuleName, to, coins); err != nil {
+		return err
+	}
+
+	minted := k.GetMinted(ctx, contractID)
+	minted = minted.Add(amount)
+	k.setMinted(ctx, contractID, minted)
+
+	return nil
+}
+
+// burnBankCoins burns the coins of x/bank on behalf of the migrated contract.
+func (k Keeper) burnBankCoins(ctx sdk.Context, contractID string, from sdk.AccAddress, amount sdk.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(token.BankDenom(contractID), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+
+	burnt := k.GetBurnt(ctx, contractID)
+	burnt = burnt.Add(amount)
+	k.setBurnt(ctx, contractID, burnt)
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	distrtypes "github.com/Finschia/finschia-sdk/x/distribution/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestConvertToBank() {
+	testCases := map[string]struct {
+		amount sdk.Int
+		err    error
+	}{
+		"valid request": {
+			amount: s.balance,
+		},
+		"insufficient tokens": {
+			amount: s.balance.Add(sdk.OneInt()),
+			err:    token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			supply := s.keeper.GetSupply(ctx, s.contractID)
+
+			err := s.keeper.ConvertToBank(ctx, s.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.keeper.GetBalance(ctx, s.contractID, s.customer).IsZero())
+			s.Require().True(supply.Sub(tc.amount).Equal(s.keeper.GetSupply(ctx, s.contractID)))
+			s.Require().True(supply.Equal(s.keeper.GetTotalSupply(ctx, s.contractID)))
+
+			denom := token.BankDenom(s.contractID)
+			s.Require().Equal(tc.amount, s.bankKeeper.GetBalance(ctx, s.customer, denom).Amount)
+
+			class, err := s.keeper.GetClass(ctx, s.contractID)
+			s.Require().NoError(err)
+			metadata, found := s.bankKeeper.GetDenomMetaData(ctx, denom)
+			s.Require().True(found)
+			s.Require().Equal(token.BankMetadata(*class), metadata)
+
+			// the contract keeps working for the rest of the holders
+			s.Require().False(s.keeper.IsMigratedToBank(ctx, s.contractID))
+			err = s.keeper.Send(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrateToBank() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// converts the holders in batches
+	done, err := s.keeper.MigrateToBank(ctx, s.contractID, 2)
+	s.Require().NoError(err)
+	s.Require().False(done)
+	s.Require().False(s.keeper.IsMigratedToBank(ctx, s.contractID))
+	s.Require().Equal(s.balance, s.keeper.GetSupply(ctx, s.contractID))
+
+	done, err = s.keeper.MigrateToBank(ctx, s.contractID, 2)
+	s.Require().NoError(err)
+	s.Require().True(done)
+	s.Require().True(s.keeper.IsMigratedToBank(ctx, s.contractID))
+
+	denom := token.BankDenom(s.contractID)
+	for _, holder := range []sdk.AccAddress{s.vendor, s.operator, s.customer} {
+		s.Require().True(s.keeper.GetBalance(ctx, s.contractID, holder).IsZero())
+		s.Require().Equal(s.balance, s.bankKeeper.GetBalance(ctx, holder, denom).Amount)
+	}
+	s.Require().True(s.keeper.GetSupply(ctx, s.contractID).IsZero())
+	s.Require().Equal(s.balance.MulRaw(3), s.bankKeeper.GetSupply(ctx, denom).Amount)
+	s.Require().Equal(s.balance.MulRaw(3), s.keeper.GetTotalSupply(ctx, s.contractID))
+
+	// cannot migrate twice
+	_, err = s.keeper.MigrateToBank(ctx, s.contractID, 2)
+	s.Require().ErrorIs(err, token.ErrContractMigrated)
+
+	// mint permission applies to x/bank
+	minted := s.keeper.GetMinted(ctx, s.contractID)
+	err = s.keeper.Mint(ctx, s.contractID, s.operator, s.stranger, s.balance)
+	s.Require().NoError(err)
+	s.Require().True(s.keeper.GetBalance(ctx, s.contractID, s.stranger).IsZero())
+	s.Require().Equal(s.balance, s.bankKeeper.GetBalance(ctx, s.stranger, denom).Amount)
+	s.Require().True(minted.Add(s.balance).Equal(s.keeper.GetMinted(ctx, s.contractID)))
+	s.Require().Equal(s.balance.MulRaw(4), s.keeper.GetTotalSupply(ctx, s.contractID))
+
+	err = s.keeper.Mint(ctx, s.contractID, s.stranger, s.stranger, s.balance)
+	s.Require().ErrorIs(err, token.ErrTokenNoPermission)
+
+	// burn permission applies to x/bank
+	burnt := s.keeper.GetBurnt(ctx, s.contractID)
+	err = s.keeper.Burn(ctx, s.contractID, s.operator, s.balance)
+	s.Require().NoError(err)
+	s.Require().True(s.bankKeeper.GetBalance(ctx, s.operator, denom).IsZero())
+	s.Require().True(burnt.Add(s.balance).Equal(s.keeper.GetBurnt(ctx, s.contractID)))
+	s.Require().Equal(s.balance.MulRaw(3), s.keeper.GetTotalSupply(ctx, s.contractID))
+
+	err = s.keeper.Burn(ctx, s.contractID, s.operator, sdk.OneInt())
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	err = s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.customer, s.balance)
+	s.Require().NoError(err)
+	s.Require().True(s.bankKeeper.GetBalance(ctx, s.customer, denom).IsZero())
+
+	// the metadata follows the contract
+	changes := []token.Attribute{
+		{Key: token.AttributeKeyName.String(), Value: "new name"},
+		{Key: token.AttributeKeyURI.String(), Value: "new uri"},
+	}
+	err = s.keeper.Modify(ctx, s.contractID, s.vendor, changes)
+	s.Require().NoError(err)
+
+	metadata, found := s.bankKeeper.GetDenomMetaData(ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(changes[0].Value, metadata.Name)
+	s.Require().Equal(changes[1].Value, metadata.URI)
+}
+
+func (s *KeeperTestSuite) TestMigrateToBankFailingHolder() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// a blocked address cannot receive the coins of x/bank
+	blocked := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	err := s.keeper.Send(ctx, s.contractID, s.vendor, blocked, sdk.OneInt())
+	s.Require().NoError(err)
+
+	// the failing holder does not take the place of the others
+	done, err := s.keeper.MigrateToBank(ctx, s.contractID, 3)
+	s.Require().NoError(err)
+	s.Require().False(done)
+	s.Require().False(s.keeper.IsMigratedToBank(ctx, s.contractID))
+
+	denom := token.BankDenom(s.contractID)
+	for _, holder := range []sdk.AccAddress{s.vendor, s.operator, s.customer} {
+		s.Require().True(s.keeper.GetBalance(ctx, s.contractID, holder).IsZero())
+	}
+	s.Require().Equal(sdk.OneInt(), s.keeper.GetBalance(ctx, s.contractID, blocked))
+	s.Require().True(s.bankKeeper.GetBalance(ctx, blocked, denom).IsZero())
+	s.Require().Equal(sdk.OneInt(), s.keeper.GetSupply(ctx, s.contractID))
+	s.Require().Equal(s.balance.MulRaw(3), s.keeper.GetTotalSupply(ctx, s.contractID))
+
+	// the failing holder is reported
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	done, err = s.keeper.MigrateToBank(ctx, s.contractID, 1)
+	s.Require().NoError(err)
+	s.Require().False(done)
+
+	var failed []string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&token.EventConvertToBankFailed{}) {
+			continue
+		}
+		event, err := sdk.ParseTypedEvent(abci.Event(e))
+		s.Require().NoError(err)
+		failed = append(failed, event.(*token.EventConvertToBankFailed).Holder)
+	}
+	s.Require().Equal([]string{blocked.String()}, failed)
+}
@@ -62,6 +62,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
 	for _, amount := range data.Burns {
 		k.setBurnt(ctx, amount.ContractId, amount.Amount)
 	}
+
+	for _, contractID := range data.MigratedContracts {
+		k.setMigratedToBank(ctx, contractID)
+	}
+
+	for _, contractAllowances := range data.Allowances {
+		for _, allowance := range contractAllowances.Allowances {
+			k.setAllowance(ctx, contractAllowances.ContractId, allowance)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -150,14 +160,38 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		}
 	}
 
+	var allowances []token.ContractAllowances
+	for _, class := range classes {
+		id := class.Id
+		contractAllowances := token.ContractAllowances{
+			ContractId: id,
+		}
+
+		k.iterateContractAllowances(ctx, id, func(allowance token.Allowance) (stop bool) {
+			contractAllowances.Allowances = append(contractAllowances.Allowances, allowance)
+			return false
+		})
+		if len(contractAllowances.Allowances) != 0 {
+			allowances = append(allowances, contractAllowances)
+		}
+	}
+
+	var migratedContracts []string
+	k.iterateMigratedContracts(ctx, func(contractID string) (stop bool) {
+		migratedContracts = append(migratedContracts, contractID)
+		return false
+	})
+
 	return &token.GenesisState{
-		ClassState:     k.classKeeper.ExportGenesis(ctx),
-		Balances:       balances,
-		Classes:        classes,
-		Grants:         grants,
-		Authorizations: authorizations,
-		Supplies:       supplies,
-		Mints:          mints,
-		Burns:          burns,
+		ClassState:        k.classKeeper.ExportGenesis(ctx),
+		Balances:          balances,
+		Classes:           classes,
+		Grants:            grants,
+		Authorizations:    authorizations,
+		Supplies:          supplies,
+		Mints:             mints,
+		Burns:             burns,
+		MigratedContracts: migratedContracts,
+		Allowances:        allowances,
 	}
 }
@@ -5,11 +5,16 @@ import (
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	done, err := s.keeper.MigrateToBank(s.ctx, s.unmintableContractId, 3)
+	s.Require().NoError(err)
+	s.Require().True(done)
+
 	// export
 	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]string{s.unmintableContractId}, genesis.MigratedContracts)
 
 	// forge
-	err := s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
+	err = s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
 	s.Require().NoError(err)
 	err = s.keeper.Mint(s.ctx, s.contractID, s.vendor, s.customer, s.balance)
 	s.Require().NoError(err)
@@ -67,7 +67,7 @@ func (s queryServer) Supply(c context.Context, req *token.QuerySupplyRequest) (*
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	supply := s.keeper.GetSupply(ctx, req.ContractId)
+	supply := s.keeper.GetTotalSupply(ctx, req.ContractId)
 
 	return &token.QuerySupplyResponse{Amount: supply}, nil
 }
@@ -207,3 +207,26 @@ func (s queryServer) HoldersByOperator(c context.Context, req *token.QueryHolder
 
 	return &token.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes}, nil
 }
+
+func (s queryServer) Allowance(c context.Context, req *token.QueryAllowanceRequest) (*token.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	holder, err := s.addressFromBech32GRPC(req.Holder, "holder")
+	if err != nil {
+		return nil, err
+	}
+	spender, err := s.addressFromBech32GRPC(req.Spender, "spender")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowance := s.keeper.GetAllowance(ctx, req.ContractId, holder, spender)
+
+	return &token.QueryAllowanceResponse{Allowance: allowance}, nil
+}
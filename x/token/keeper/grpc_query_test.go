@@ -407,3 +407,66 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryAllowance() {
+	// empty request
+	_, err := s.queryServer.Allowance(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		spender    sdk.AccAddress
+		valid      bool
+		postTest   func(res *token.QueryAllowanceResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			holder:     s.customer,
+			spender:    s.stranger,
+			valid:      true,
+			postTest: func(res *token.QueryAllowanceResponse) {
+				s.Require().Equal(s.allowance, res.Allowance.SpendLimit)
+			},
+		},
+		"no allowance": {
+			contractID: s.contractID,
+			holder:     s.vendor,
+			spender:    s.stranger,
+			valid:      true,
+			postTest: func(res *token.QueryAllowanceResponse) {
+				s.Require().True(res.Allowance.SpendLimit.IsZero())
+			},
+		},
+		"invalid contract id": {
+			holder:  s.customer,
+			spender: s.stranger,
+		},
+		"invalid holder": {
+			contractID: s.contractID,
+			spender:    s.stranger,
+		},
+		"invalid spender": {
+			contractID: s.contractID,
+			holder:     s.customer,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryAllowanceRequest{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Spender:    tc.spender.String(),
+			}
+			res, err := s.queryServer.Allowance(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
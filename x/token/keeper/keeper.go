@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/Finschia/finschia-sdk/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/class"
 )
@@ -10,12 +11,16 @@ import (
 // Keeper defines the token module Keeper
 type Keeper struct {
 	classKeeper token.ClassKeeper
+	bankKeeper  token.BankKeeper
 
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
 	// The codec for binary encoding/decoding.
 	cdc codec.Codec
+
+	// the address capable of migrating the contracts to x/bank.
+	authority string
 }
 
 // NewKeeper returns a token keeper
@@ -23,12 +28,33 @@ func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
 	ck token.ClassKeeper,
+	bk token.BankKeeper,
+	authority string,
 ) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
 	return Keeper{
 		classKeeper: ck,
+		bankKeeper:  bk,
 		storeKey:    key,
 		cdc:         cdc,
+		authority:   authority,
+	}
+}
+
+// GetAuthority returns the x/token module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) validateAuthority(authority string) error {
+	if authority != k.authority {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.authority, authority)
 	}
+
+	return nil
 }
 
 func ValidateLegacyContract(k Keeper, ctx sdk.Context, contractID string) error {
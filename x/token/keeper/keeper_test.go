@@ -10,6 +10,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/keeper"
 )
@@ -20,6 +21,7 @@ type KeeperTestSuite struct {
 	ctx         sdk.Context
 	goCtx       context.Context
 	keeper      keeper.Keeper
+	bankKeeper  bankkeeper.Keeper
 	queryServer token.QueryServer
 	msgServer   token.MsgServer
 
@@ -31,7 +33,8 @@ type KeeperTestSuite struct {
 	contractID           string
 	unmintableContractId string
 
-	balance sdk.Int
+	balance   sdk.Int
+	allowance sdk.Int
 }
 
 func (s *KeeperTestSuite) createRandomAccounts(accNum int) []sdk.AccAddress {
@@ -58,6 +61,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.TokenKeeper
+	s.bankKeeper = app.BankKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
@@ -73,6 +77,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	s.balance = sdk.NewInt(1000)
+	s.allowance = s.balance.QuoRaw(2)
 
 	// create a mintable class
 	class := token.Contract{
@@ -108,6 +113,10 @@ func (s *KeeperTestSuite) SetupTest() {
 		s.Require().NoError(err)
 	}
 
+	// approve stranger
+	err = s.keeper.Approve(s.ctx, s.contractID, s.customer, s.stranger, s.allowance, nil)
+	s.Require().NoError(err)
+
 	// not token contract
 	notTokenContractID := app.ClassKeeper.NewID(s.ctx)
 	err = keeper.ValidateLegacyContract(s.keeper, s.ctx, notTokenContractID)
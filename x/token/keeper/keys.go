@@ -15,6 +15,11 @@ var (
 	supplyKeyPrefix = []byte{0x04}
 	mintKeyPrefix   = []byte{0x05}
 	burnKeyPrefix   = []byte{0x06}
+
+	// x/bank migration keys
+	migratedContractKeyPrefix = []byte{0x07}
+
+	allowanceKeyPrefix = []byte{0x08}
 )
 
 func classKey(id string) []byte {
@@ -24,6 +29,17 @@ func classKey(id string) []byte {
 	return key
 }
 
+func migratedContractKey(contractID string) []byte {
+	key := make([]byte, len(migratedContractKeyPrefix)+len(contractID))
+	copy(key, migratedContractKeyPrefix)
+	copy(key[len(migratedContractKeyPrefix):], contractID)
+	return key
+}
+
+func splitMigratedContractKey(key []byte) (contractID string) {
+	return string(key[len(migratedContractKeyPrefix):])
+}
+
 func balanceKey(contractID string, address sdk.AccAddress) []byte {
 	prefix := balanceKeyPrefixByContractID(contractID)
 	key := make([]byte, len(prefix)+len(address))
@@ -206,3 +222,44 @@ func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.
 
 	return
 }
+
+func allowanceKey(contractID string, spender, holder sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixBySpender(contractID, spender)
+	key := make([]byte, len(prefix)+len(holder))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], holder)
+
+	return key
+}
+
+func allowanceKeyPrefixBySpender(contractID string, spender sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(spender))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(spender))
+
+	begin++
+	copy(key[begin:], spender)
+
+	return key
+}
+
+func allowanceKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(allowanceKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, allowanceKeyPrefix)
+
+	begin += len(allowanceKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
@@ -63,7 +63,9 @@ func (s msgServer) OperatorSend(c context.Context, req *token.MsgOperatorSend) (
 	to := sdk.MustAccAddressFromBech32(req.To)
 
 	if _, err := s.keeper.GetAuthorization(ctx, req.ContractId, from, operator); err != nil {
-		return nil, token.ErrTokenNotApproved.Wrap(err.Error())
+		if err := s.keeper.spendAllowance(ctx, req.ContractId, from, operator, req.Amount); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := s.keeper.Send(ctx, req.ContractId, from, to, req.Amount); err != nil {
@@ -270,3 +272,69 @@ func (s msgServer) Modify(c context.Context, req *token.MsgModify) (*token.MsgMo
 
 	return &token.MsgModifyResponse{}, nil
 }
+
+// ConvertToBank defines a method to convert the holder's tokens into the coins of x/bank
+func (s msgServer) ConvertToBank(c context.Context, req *token.MsgConvertToBank) (*token.MsgConvertToBankResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.ConvertToBank(ctx, req.ContractId, holder, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgConvertToBankResponse{}, nil
+}
+
+// MigrateToBank defines a method to convert all the tokens of a contract into the coins of x/bank
+func (s msgServer) MigrateToBank(c context.Context, req *token.MsgMigrateToBank) (*token.MsgMigrateToBankResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	done, err := s.keeper.MigrateToBank(ctx, req.ContractId, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.MsgMigrateToBankResponse{Done: done}, nil
+}
+
+// Approve sets the allowance of the spender on the holder's tokens
+func (s msgServer) Approve(c context.Context, req *token.MsgApprove) (*token.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+	spender := sdk.MustAccAddressFromBech32(req.Spender)
+
+	if err := s.keeper.Approve(ctx, req.ContractId, holder, spender, req.SpendLimit, req.Expiration); err != nil {
+		return nil, err
+	}
+
+	event := token.EventApproved{
+		ContractId: req.ContractId,
+		Holder:     req.Holder,
+		Spender:    req.Spender,
+		SpendLimit: req.SpendLimit,
+		Expiration: req.Expiration,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &token.MsgApproveResponse{}, nil
+}
@@ -115,6 +115,31 @@ func (s *KeeperTestSuite) TestMsgOperatorSend() {
 			amount:     s.balance.Add(sdk.OneInt()),
 			err:        token.ErrInsufficientBalance,
 		},
+		"valid request by allowance": {
+			contractID: s.contractID,
+			operator:   s.stranger,
+			from:       s.customer,
+			amount:     s.allowance,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(s.allowance), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.stranger), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"insufficient allowance": {
+			contractID: s.contractID,
+			operator:   s.stranger,
+			from:       s.customer,
+			amount:     s.allowance.Add(sdk.OneInt()),
+			err:        token.ErrInsufficientAllowance,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -136,6 +161,12 @@ func (s *KeeperTestSuite) TestMsgOperatorSend() {
 
 			s.Require().NotNil(res)
 			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			// the allowance is spent only if the operator is not authorized
+			allowance := s.keeper.GetAllowance(ctx, tc.contractID, tc.from, tc.operator)
+			if tc.operator.Equals(s.stranger) {
+				s.Require().True(allowance.SpendLimit.IsZero())
+			}
 		})
 	}
 }
@@ -1071,3 +1102,170 @@ func (s *KeeperTestSuite) TestMsgModify() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgConvertToBank() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance,
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     sdk.OneInt(),
+			err:        class.ErrContractNotExist,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			amount:     s.balance.Add(sdk.OneInt()),
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &token.MsgConvertToBank{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.ConvertToBank(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.amount, s.bankKeeper.GetBalance(ctx, s.customer, token.BankDenom(tc.contractID)).Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgMigrateToBank() {
+	testCases := map[string]struct {
+		authority  sdk.AccAddress
+		contractID string
+		limit      uint32
+		migrated   bool
+		done       bool
+		err        error
+	}{
+		"valid request": {
+			authority:  token.DefaultAuthority(),
+			contractID: s.contractID,
+			limit:      3,
+			done:       true,
+		},
+		"valid request (partial)": {
+			authority:  token.DefaultAuthority(),
+			contractID: s.contractID,
+			limit:      1,
+		},
+		"invalid authority": {
+			authority:  s.vendor,
+			contractID: s.contractID,
+			limit:      3,
+			err:        sdkerrors.ErrUnauthorized,
+		},
+		"contract not found": {
+			authority:  token.DefaultAuthority(),
+			contractID: "fee1dead",
+			limit:      3,
+			err:        class.ErrContractNotExist,
+		},
+		"already migrated": {
+			authority:  token.DefaultAuthority(),
+			contractID: s.contractID,
+			limit:      3,
+			migrated:   true,
+			err:        token.ErrContractMigrated,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.migrated {
+				done, err := s.keeper.MigrateToBank(ctx, tc.contractID, 3)
+				s.Require().NoError(err)
+				s.Require().True(done)
+			}
+
+			req := &token.MsgMigrateToBank{
+				Authority:  tc.authority.String(),
+				ContractId: tc.contractID,
+				Limit:      tc.limit,
+			}
+			res, err := s.msgServer.MigrateToBank(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.done, res.Done)
+			s.Require().Equal(tc.done, s.keeper.IsMigratedToBank(ctx, tc.contractID))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgApprove() {
+	testCases := map[string]struct {
+		contractID string
+		spendLimit sdk.Int
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			spendLimit: s.balance,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventApproved",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("expiration"), Value: []byte("null"), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spend_limit"), Value: testutil.W(s.balance), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			spendLimit: s.balance,
+			err:        class.ErrContractNotExist,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &token.MsgApprove{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Spender:    s.stranger.String(),
+				SpendLimit: tc.spendLimit,
+			}
+			res, err := s.msgServer.Approve(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			allowance := s.keeper.GetAllowance(ctx, tc.contractID, s.customer, s.stranger)
+			s.Require().True(tc.spendLimit.Equal(allowance.SpendLimit))
+		})
+	}
+}
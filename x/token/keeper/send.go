@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/token"
@@ -65,6 +67,94 @@ func (k Keeper) deleteAuthorization(ctx sdk.Context, contractID string, holder,
 	store.Delete(key)
 }
 
+// Approve sets the allowance of the spender on the holder's tokens.
+// A zero spend limit removes the allowance.
+func (k Keeper) Approve(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, spendLimit sdk.Int, expiration *time.Time) error {
+	if _, err := k.GetClass(ctx, contractID); err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("no class for %s", contractID)
+	}
+
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expiration %s must be after the block time", expiration)
+	}
+
+	if spendLimit.IsZero() {
+		k.deleteAllowance(ctx, contractID, holder, spender)
+		return nil
+	}
+
+	k.setAllowance(ctx, contractID, token.Allowance{
+		Holder:     holder.String(),
+		Spender:    spender.String(),
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	})
+
+	return nil
+}
+
+// GetAllowance returns the allowance of the spender on the holder's tokens.
+// The spend limit is zero if there is no allowance or it has expired.
+func (k Keeper) GetAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress) token.Allowance {
+	allowance := token.Allowance{
+		Holder:     holder.String(),
+		Spender:    spender.String(),
+		SpendLimit: sdk.ZeroInt(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(allowanceKey(contractID, spender, holder))
+	if bz == nil {
+		return allowance
+	}
+
+	var stored token.Allowance
+	k.cdc.MustUnmarshal(bz, &stored)
+	if stored.Expiration != nil && !ctx.BlockTime().Before(*stored.Expiration) {
+		return allowance
+	}
+
+	return stored
+}
+
+// spendAllowance decreases the allowance of the spender by the amount.
+func (k Keeper) spendAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) error {
+	allowance := k.GetAllowance(ctx, contractID, holder, spender)
+	if allowance.SpendLimit.IsZero() {
+		return token.ErrTokenNotApproved.Wrapf("no allowance to %s by %s", spender, holder)
+	}
+
+	remaining := allowance.SpendLimit.Sub(amount)
+	if remaining.IsNegative() {
+		return token.ErrInsufficientAllowance.Wrapf("%s is smaller than %s", allowance.SpendLimit, amount)
+	}
+
+	if remaining.IsZero() {
+		k.deleteAllowance(ctx, contractID, holder, spender)
+	} else {
+		allowance.SpendLimit = remaining
+		k.setAllowance(ctx, contractID, allowance)
+	}
+
+	return nil
+}
+
+// setAllowance sets the allowance.
+// The caller must validate `allowance`.
+func (k Keeper) setAllowance(ctx sdk.Context, contractID string, allowance token.Allowance) {
+	store := ctx.KVStore(k.storeKey)
+	holder := sdk.MustAccAddressFromBech32(allowance.Holder)
+	spender := sdk.MustAccAddressFromBech32(allowance.Spender)
+	key := allowanceKey(contractID, spender, holder)
+	store.Set(key, k.cdc.MustMarshal(&allowance))
+}
+
+func (k Keeper) deleteAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := allowanceKey(contractID, spender, holder)
+	store.Delete(key)
+}
+
 func (k Keeper) subtractToken(ctx sdk.Context, contractID string, addr sdk.AccAddress, amount sdk.Int) error {
 	balance := k.GetBalance(ctx, contractID, addr)
 	newBalance := balance.Sub(amount)
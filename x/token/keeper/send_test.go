@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
@@ -102,3 +104,88 @@ func (s *KeeperTestSuite) TestRevokeOperator() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestApprove() {
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	pastExpiration := s.ctx.BlockTime()
+
+	testCases := map[string]struct {
+		contractID string
+		spendLimit sdk.Int
+		expiration *time.Time
+		err        error
+	}{
+		"set allowance": {
+			spendLimit: s.balance,
+		},
+		"set allowance with expiration": {
+			spendLimit: s.balance,
+			expiration: &expiration,
+		},
+		"remove allowance": {
+			spendLimit: sdk.ZeroInt(),
+		},
+		"class not found": {
+			contractID: "fee1dead",
+			spendLimit: s.balance,
+			err:        sdkerrors.ErrNotFound,
+		},
+		"past expiration": {
+			spendLimit: s.balance,
+			expiration: &pastExpiration,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			contractID := s.contractID
+			if tc.contractID != "" {
+				contractID = tc.contractID
+			}
+
+			err := s.keeper.Approve(ctx, contractID, s.customer, s.stranger, tc.spendLimit, tc.expiration)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			allowance := s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger)
+			s.Require().True(tc.spendLimit.Equal(allowance.SpendLimit))
+			s.Require().Equal(tc.expiration, allowance.Expiration)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetAllowance() {
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+
+	testCases := map[string]struct {
+		blockTime  time.Time
+		spendLimit sdk.Int
+	}{
+		"before expiration": {
+			blockTime:  expiration.Add(-time.Second),
+			spendLimit: s.allowance,
+		},
+		"at expiration": {
+			blockTime:  expiration,
+			spendLimit: sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.allowance, &expiration)
+			s.Require().NoError(err)
+
+			ctx = ctx.WithBlockTime(tc.blockTime)
+			allowance := s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger)
+			s.Require().True(tc.spendLimit.Equal(allowance.SpendLimit))
+		})
+	}
+}
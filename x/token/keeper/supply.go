@@ -106,6 +106,10 @@ func (k Keeper) mint(ctx sdk.Context, contractID string, grantee, to sdk.AccAddr
 		return token.ErrTokenNoPermission.Wrap(err.Error())
 	}
 
+	if k.IsMigratedToBank(ctx, contractID) {
+		return k.mintBankCoins(ctx, contractID, to, amount)
+	}
+
 	k.mintToken(ctx, contractID, to, amount)
 
 	return nil
@@ -186,6 +190,10 @@ func (k Keeper) operatorBurn(ctx sdk.Context, contractID string, operator, from
 }
 
 func (k Keeper) burnToken(ctx sdk.Context, contractID string, addr sdk.AccAddress, amount sdk.Int) error {
+	if k.IsMigratedToBank(ctx, contractID) {
+		return k.burnBankCoins(ctx, contractID, addr, amount)
+	}
+
 	if err := k.subtractToken(ctx, contractID, addr, amount); err != nil {
 		return err
 	}
@@ -293,6 +301,11 @@ func (k Keeper) modify(ctx sdk.Context, contractID string, changes []token.Attri
 
 	k.setClass(ctx, *class)
 
+	// keep the metadata of x/bank in sync with the contract
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, token.BankDenom(contractID)); found {
+		k.bankKeeper.SetDenomMetaData(ctx, token.BankMetadata(*class))
+	}
+
 	return nil
 }
 
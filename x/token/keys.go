@@ -1,5 +1,11 @@
 package token
 
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
 const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "token"
@@ -10,3 +16,8 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// DefaultAuthority returns the default authority which migrates the contracts to x/bank.
+func DefaultAuthority() sdk.AccAddress {
+	return authtypes.NewModuleAddress(foundation.ModuleName)
+}
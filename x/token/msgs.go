@@ -517,3 +517,137 @@ func (m MsgModify) Route() string {
 func (m MsgModify) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = (*MsgConvertToBank)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgConvertToBank) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	if err := validateAmount(m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgConvertToBank) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgConvertToBank) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgConvertToBank) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgConvertToBank) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgMigrateToBank)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgMigrateToBank) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if m.Limit == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("limit must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMigrateToBank) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgMigrateToBank) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgMigrateToBank) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgMigrateToBank) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgApprove)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgApprove) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	holderAcc, err := sdk.AccAddressFromBech32(m.Holder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	spenderAcc, err := sdk.AccAddressFromBech32(m.Spender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", m.Spender)
+	}
+
+	if holderAcc.Equals(spenderAcc) {
+		return ErrApproverProxySame
+	}
+
+	if m.SpendLimit.IsNil() || m.SpendLimit.IsNegative() {
+		return ErrInvalidAmount.Wrapf("spend limit must not be negative: %s", m.SpendLimit)
+	}
+
+	if m.Expiration != nil && m.Expiration.Unix() <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid expiration: %s", m.Expiration)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgApprove) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgApprove) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
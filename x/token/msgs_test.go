@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -898,6 +899,211 @@ func TestMsgRevokePermission(t *testing.T) {
 	}
 }
 
+func TestMsgConvertToBank(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder: addrs[0],
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgConvertToBank{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.holder}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgMigrateToBank(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority  sdk.AccAddress
+		contractID string
+		limit      uint32
+		err        error
+	}{
+		"valid msg": {
+			authority:  addrs[0],
+			contractID: "deadbeef",
+			limit:      100,
+		},
+		"invalid authority": {
+			contractID: "deadbeef",
+			limit:      100,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid contract id": {
+			authority: addrs[0],
+			limit:     100,
+			err:       class.ErrInvalidContractID,
+		},
+		"zero limit": {
+			authority:  addrs[0],
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgMigrateToBank{
+				Authority:  tc.authority.String(),
+				ContractId: tc.contractID,
+				Limit:      tc.limit,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgApprove(t *testing.T) {
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	expiration := time.Unix(1, 0).UTC()
+	pastExpiration := time.Time{}
+	testCases := map[string]struct {
+		contractID string
+		holder     string
+		spender    string
+		spendLimit sdk.Int
+		expiration *time.Time
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			spendLimit: sdk.OneInt(),
+		},
+		"valid msg (expiration)": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			spendLimit: sdk.OneInt(),
+			expiration: &expiration,
+		},
+		"valid msg (zero spend limit)": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			spendLimit: sdk.ZeroInt(),
+		},
+		"invalid contract id": {
+			holder:     addrs[0],
+			spender:    addrs[1],
+			spendLimit: sdk.OneInt(),
+			err:        class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			spender:    addrs[1],
+			spendLimit: sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid spender": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spendLimit: sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"holder and spender should be different": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[0],
+			spendLimit: sdk.OneInt(),
+			err:        token.ErrApproverProxySame,
+		},
+		"negative spend limit": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			spendLimit: sdk.NewInt(-1),
+			err:        token.ErrInvalidAmount,
+		},
+		"past expiration": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			spendLimit: sdk.OneInt(),
+			expiration: &pastExpiration,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgApprove{
+				ContractId: tc.contractID,
+				Holder:     tc.holder,
+				Spender:    tc.spender,
+				SpendLimit: tc.spendLimit,
+				Expiration: tc.expiration,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.holder)}, msg.GetSigners())
+		})
+	}
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	contractId := "deadbeef"
@@ -1023,6 +1229,34 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.token.v1.MsgModify",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgModify\",\"value\":{\"changes\":[{\"key\":\"name\",\"value\":\"New test\"}],\"contract_id\":\"deadbeef\",\"owner\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgConvertToBank": {
+			&token.MsgConvertToBank{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Amount:     sdk.NewInt(1000000),
+			},
+			"/lbm.token.v1.MsgConvertToBank",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgConvertToBank\",\"value\":{\"amount\":\"1000000\",\"contract_id\":\"deadbeef\",\"holder\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgMigrateToBank": {
+			&token.MsgMigrateToBank{
+				Authority:  addrs[0].String(),
+				ContractId: contractId,
+				Limit:      100,
+			},
+			"/lbm.token.v1.MsgMigrateToBank",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgMigrateToBank\",\"value\":{\"authority\":\"%s\",\"contract_id\":\"deadbeef\",\"limit\":100}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgApprove": {
+			&token.MsgApprove{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Spender:    addrs[1].String(),
+				SpendLimit: sdk.NewInt(1000000),
+			},
+			"/lbm.token.v1.MsgApprove",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgApprove\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"spend_limit\":\"1000000\",\"spender\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -850,6 +850,116 @@ func (m *QueryHoldersByOperatorResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllowanceRequest is the request type for the Query/Allowance RPC method
+type QueryAllowanceRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the holder of the allowance.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+}
+
+func (m *QueryAllowanceRequest) Reset()         { *m = QueryAllowanceRequest{} }
+func (m *QueryAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceRequest) ProtoMessage()    {}
+func (*QueryAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7759ed3b35cde06a, []int{16}
+}
+func (m *QueryAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceRequest.Merge(m, src)
+}
+func (m *QueryAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryAllowanceRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *QueryAllowanceRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *QueryAllowanceRequest) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+// QueryAllowanceResponse is the response type for the Query/Allowance RPC method
+type QueryAllowanceResponse struct {
+	// allowance of the spender. The spend limit is zero if there is no valid allowance.
+	Allowance Allowance `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance"`
+}
+
+func (m *QueryAllowanceResponse) Reset()         { *m = QueryAllowanceResponse{} }
+func (m *QueryAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceResponse) ProtoMessage()    {}
+func (*QueryAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7759ed3b35cde06a, []int{17}
+}
+func (m *QueryAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceResponse.Merge(m, src)
+}
+func (m *QueryAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceResponse) GetAllowance() Allowance {
+	if m != nil {
+		return m.Allowance
+	}
+	return Allowance{}
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "lbm.token.v1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "lbm.token.v1.QueryBalanceResponse")
@@ -867,67 +977,74 @@ func init() {
 	proto.RegisterType((*QueryIsOperatorForResponse)(nil), "lbm.token.v1.QueryIsOperatorForResponse")
 	proto.RegisterType((*QueryHoldersByOperatorRequest)(nil), "lbm.token.v1.QueryHoldersByOperatorRequest")
 	proto.RegisterType((*QueryHoldersByOperatorResponse)(nil), "lbm.token.v1.QueryHoldersByOperatorResponse")
+	proto.RegisterType((*QueryAllowanceRequest)(nil), "lbm.token.v1.QueryAllowanceRequest")
+	proto.RegisterType((*QueryAllowanceResponse)(nil), "lbm.token.v1.QueryAllowanceResponse")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/query.proto", fileDescriptor_7759ed3b35cde06a) }
 
 var fileDescriptor_7759ed3b35cde06a = []byte{
-	// 865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x41, 0x4f, 0x23, 0x37,
-	0x14, 0xc7, 0xe3, 0x50, 0x42, 0x30, 0xe5, 0x80, 0xa1, 0x68, 0x3a, 0x6a, 0x27, 0x21, 0x95, 0x4a,
-	0x0a, 0xed, 0xb8, 0x49, 0x0f, 0xa5, 0x14, 0x55, 0x55, 0x5a, 0x41, 0xa9, 0x54, 0xb5, 0x9d, 0xb6,
-	0x97, 0x5e, 0x90, 0x27, 0x63, 0x26, 0x23, 0x92, 0xf1, 0x30, 0x76, 0x50, 0x53, 0xc4, 0xa5, 0x95,
-	0x4a, 0x8f, 0x95, 0x2a, 0xed, 0x69, 0x4f, 0x7b, 0x58, 0xed, 0x17, 0x59, 0x89, 0x23, 0xd2, 0x5e,
-	0x56, 0x7b, 0x40, 0x2b, 0xd8, 0x0f, 0xb2, 0x8a, 0xed, 0x61, 0x33, 0x30, 0x40, 0x82, 0xe0, 0x94,
-	0xb1, 0xfd, 0xde, 0xfb, 0xff, 0xfc, 0x6c, 0xbf, 0x17, 0x68, 0xb4, 0xdd, 0x0e, 0x16, 0x6c, 0x87,
-	0x86, 0x78, 0xaf, 0x86, 0x77, 0xbb, 0x34, 0xee, 0xd9, 0x51, 0xcc, 0x04, 0x43, 0x6f, 0xb7, 0xdd,
-	0x8e, 0x2d, 0x57, 0xec, 0xbd, 0x9a, 0xb9, 0xd4, 0x64, 0xbc, 0xc3, 0x38, 0x76, 0x09, 0xa7, 0xca,
-	0x0c, 0xef, 0xd5, 0x5c, 0x2a, 0x48, 0x0d, 0x47, 0xc4, 0x0f, 0x42, 0x22, 0x02, 0x16, 0x2a, 0x4f,
-	0xf3, 0x3d, 0x9f, 0x31, 0xbf, 0x4d, 0x31, 0x89, 0x02, 0x4c, 0xc2, 0x90, 0x09, 0xb9, 0xc8, 0xf5,
-	0x6a, 0x5a, 0x51, 0x09, 0xa8, 0x95, 0x39, 0x9f, 0xf9, 0x4c, 0x7e, 0xe2, 0xfe, 0x97, 0x9a, 0xad,
-	0xfc, 0x0a, 0x67, 0x7f, 0xee, 0xeb, 0x35, 0x48, 0x9b, 0x84, 0x4d, 0xea, 0xd0, 0xdd, 0x2e, 0xe5,
-	0x02, 0x95, 0xe0, 0x54, 0x93, 0x85, 0x22, 0x26, 0x4d, 0xb1, 0x15, 0x78, 0x06, 0x28, 0x83, 0xea,
-	0xa4, 0x03, 0x93, 0xa9, 0x4d, 0x0f, 0x19, 0x70, 0x82, 0x78, 0x5e, 0x4c, 0x39, 0x37, 0xf2, 0x72,
-	0x31, 0x19, 0xae, 0xe6, 0x0d, 0x50, 0xd9, 0x86, 0x73, 0xe9, 0xa8, 0x3c, 0x62, 0x21, 0xa7, 0xe8,
-	0x7b, 0x58, 0x20, 0x1d, 0xd6, 0x0d, 0x85, 0x8a, 0xd8, 0xa8, 0x1f, 0x9d, 0x94, 0x72, 0x2f, 0x4e,
-	0x4a, 0x4b, 0x7e, 0x20, 0x5a, 0x5d, 0xd7, 0x6e, 0xb2, 0x0e, 0x5e, 0x0f, 0x42, 0xde, 0x6c, 0x05,
-	0x04, 0x6f, 0xeb, 0x8f, 0x4f, 0xb8, 0xb7, 0x83, 0x45, 0x2f, 0xa2, 0xdc, 0xde, 0x0c, 0x85, 0xa3,
-	0x23, 0x48, 0x9d, 0x2f, 0x20, 0x92, 0x3a, 0xbf, 0x74, 0xa3, 0xa8, 0xdd, 0x1b, 0x16, 0x5e, 0xba,
-	0x52, 0xbd, 0xf1, 0xc4, 0xf5, 0x9e, 0x09, 0x7f, 0x08, 0x42, 0x41, 0xbd, 0x5b, 0x11, 0x26, 0xae,
-	0xf7, 0x44, 0xb8, 0x02, 0x67, 0xd4, 0x59, 0x75, 0xe3, 0x50, 0x8c, 0x04, 0xe8, 0xe9, 0xbd, 0x69,
-	0xcf, 0x7b, 0xe2, 0xfb, 0x52, 0xdf, 0xa5, 0x6f, 0xb4, 0xf8, 0x48, 0x88, 0xbf, 0xc1, 0x77, 0x2e,
-	0x38, 0x6b, 0xca, 0x15, 0x58, 0x4c, 0x4c, 0xa5, 0xeb, 0x54, 0x7d, 0xde, 0x1e, 0x7c, 0x92, 0x76,
-	0xe2, 0xd1, 0x78, 0xab, 0xcf, 0xef, 0x9c, 0x5b, 0xcb, 0xb0, 0x8f, 0x00, 0x7c, 0x57, 0xc6, 0xdd,
-	0x88, 0x49, 0x28, 0x28, 0x95, 0x3f, 0x7c, 0x94, 0xc7, 0xe3, 0x2b, 0xc7, 0xe4, 0xf1, 0xe8, 0x21,
-	0x5a, 0x87, 0xf0, 0xcd, 0x83, 0x37, 0xc6, 0x24, 0xd8, 0x87, 0xb6, 0xaa, 0x0e, 0x76, 0xbf, 0x3a,
-	0xd8, 0xaa, 0x88, 0xe8, 0xea, 0x60, 0xff, 0x44, 0xfc, 0xe4, 0xcd, 0x3a, 0x03, 0x9e, 0x12, 0xf2,
-	0x21, 0x80, 0x66, 0x16, 0xa4, 0xce, 0x40, 0x0d, 0x16, 0xa4, 0x2a, 0x37, 0x40, 0x79, 0xac, 0x3a,
-	0x55, 0x9f, 0x4d, 0xef, 0x5f, 0x5a, 0xeb, 0xcd, 0x6b, 0x43, 0xb4, 0x91, 0xa2, 0xcb, 0x4b, 0xba,
-	0xc5, 0x1b, 0xe9, 0x94, 0xde, 0x25, 0x3c, 0xa1, 0x53, 0xb8, 0xc9, 0x7f, 0x8c, 0x68, 0x4c, 0x04,
-	0x8b, 0xd7, 0x59, 0x3c, 0x74, 0x0a, 0x4d, 0x58, 0x64, 0xda, 0x4d, 0xe7, 0xf0, 0x7c, 0x8c, 0xe6,
-	0x61, 0xa1, 0xc5, 0xda, 0x1e, 0x8d, 0x65, 0x02, 0x27, 0x1d, 0x3d, 0x92, 0xaa, 0x5f, 0xeb, 0x9c,
-	0x5c, 0x50, 0xd5, 0x39, 0xb1, 0x20, 0x24, 0x5d, 0xd1, 0x62, 0x71, 0xf0, 0x27, 0x55, 0xaa, 0x45,
-	0x67, 0x60, 0x46, 0x46, 0x78, 0x02, 0xe0, 0xfb, 0x32, 0xc4, 0x77, 0x32, 0x2a, 0x6f, 0xf4, 0x92,
-	0x48, 0x77, 0x02, 0x7f, 0x97, 0x37, 0xe0, 0x10, 0x40, 0xeb, 0x2a, 0x54, 0xbd, 0x63, 0x03, 0x4e,
-	0xa8, 0xec, 0xa8, 0x6b, 0x30, 0xe9, 0x24, 0xc3, 0x3b, 0x3d, 0xec, 0xfa, 0xd3, 0x22, 0x1c, 0x97,
-	0x24, 0xe8, 0x01, 0x80, 0x13, 0xba, 0x2d, 0xa0, 0x85, 0xf4, 0x95, 0xcb, 0x68, 0x44, 0x66, 0xe5,
-	0x3a, 0x13, 0x25, 0x56, 0xf9, 0xf6, 0xaf, 0x67, 0xaf, 0xfe, 0xcf, 0x7f, 0x85, 0xd6, 0xf0, 0xe5,
-	0xe6, 0xb7, 0xd5, 0x6c, 0x13, 0xce, 0x29, 0xc7, 0xfb, 0x03, 0x47, 0x72, 0x80, 0x5d, 0x15, 0x82,
-	0xe3, 0x7d, 0xdd, 0xb6, 0x0e, 0xd0, 0x21, 0x80, 0x05, 0xd5, 0x0c, 0x50, 0x39, 0x43, 0x34, 0xd5,
-	0x62, 0xcc, 0x85, 0x6b, 0x2c, 0x34, 0xd5, 0x8a, 0xa4, 0xaa, 0xa3, 0x4f, 0x87, 0xa7, 0xe2, 0x4a,
-	0xbe, 0x4f, 0xa2, 0x8a, 0x7e, 0x26, 0x49, 0xaa, 0x95, 0x64, 0x92, 0xa4, 0x3b, 0xc6, 0x6d, 0x48,
-	0x3a, 0x4a, 0xfe, 0x6f, 0x00, 0xc7, 0x65, 0x75, 0x47, 0xa5, 0xac, 0x73, 0x18, 0xe8, 0x18, 0x66,
-	0xf9, 0x6a, 0x03, 0x8d, 0xf1, 0xb9, 0xc4, 0xa8, 0x21, 0x3c, 0xc2, 0x31, 0x49, 0xed, 0x7f, 0x00,
-	0x2c, 0x26, 0xe5, 0x18, 0x65, 0x5d, 0x88, 0x0b, 0xad, 0xc1, 0xfc, 0xe0, 0x5a, 0x1b, 0x8d, 0x53,
-	0x93, 0x38, 0xcb, 0xe8, 0xa3, 0xa1, 0x71, 0xd0, 0x63, 0x00, 0xa7, 0x53, 0xc5, 0x14, 0x2d, 0x66,
-	0x28, 0x65, 0xf5, 0x04, 0xb3, 0x7a, 0xb3, 0xa1, 0xe6, 0x6a, 0x48, 0xae, 0x35, 0xb4, 0x3a, 0x7c,
-	0x9a, 0x54, 0x79, 0xc6, 0xfb, 0xba, 0x8b, 0x1c, 0x20, 0x17, 0x4e, 0xa7, 0x0a, 0x5c, 0x26, 0x67,
-	0x56, 0xe1, 0xcd, 0xe4, 0xcc, 0xae, 0x95, 0x21, 0x9c, 0xb9, 0x54, 0x56, 0xd0, 0x72, 0x86, 0xfb,
-	0x55, 0x75, 0xd2, 0xfc, 0x78, 0x38, 0x63, 0xa5, 0x67, 0x8e, 0xfd, 0x9b, 0x07, 0x8d, 0xc6, 0xd1,
-	0xa9, 0x05, 0x8e, 0x4f, 0x2d, 0xf0, 0xf2, 0xd4, 0x02, 0xff, 0x9d, 0x59, 0xb9, 0xe3, 0x33, 0x2b,
-	0xf7, 0xfc, 0xcc, 0xca, 0xfd, 0x5e, 0xbd, 0xf1, 0xef, 0xc5, 0x1f, 0x2a, 0x87, 0x6e, 0x41, 0xfe,
-	0xf3, 0xfd, 0xec, 0x75, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x82, 0xc4, 0xe0, 0x9d, 0x0b, 0x00,
-	0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x0e, 0x75, 0xe2, 0x17, 0x7a, 0xe8, 0xa4, 0x04, 0xb3, 0x02, 0x27, 0x31, 0x88,
+	0x9a, 0x16, 0x76, 0xb0, 0x39, 0x10, 0xda, 0x0a, 0x81, 0x41, 0x09, 0x41, 0x42, 0x05, 0x43, 0x2f,
+	0x5c, 0xaa, 0xb1, 0x3d, 0x75, 0x96, 0xae, 0x67, 0xb6, 0x3b, 0xe3, 0x40, 0xb0, 0x7c, 0x01, 0x89,
+	0x72, 0x44, 0x42, 0xe2, 0xc4, 0x89, 0x03, 0xf0, 0x51, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0xa8, 0x50,
+	0xc2, 0x9d, 0xaf, 0x50, 0x79, 0xe6, 0xad, 0xe3, 0x4d, 0x36, 0x8e, 0x5d, 0x25, 0xa7, 0xec, 0xec,
+	0xbc, 0xf7, 0xfe, 0xbf, 0x7d, 0x6f, 0xe6, 0xbd, 0x18, 0x8a, 0x61, 0xb3, 0xcb, 0x8c, 0xba, 0x27,
+	0x24, 0xdb, 0xad, 0xb2, 0xfb, 0x3d, 0x11, 0xef, 0xf9, 0x51, 0xac, 0x8c, 0xa2, 0xcf, 0x86, 0xcd,
+	0xae, 0x6f, 0x77, 0xfc, 0xdd, 0xaa, 0x77, 0xb5, 0xa5, 0x74, 0x57, 0x69, 0xd6, 0xe4, 0x5a, 0x38,
+	0x33, 0xb6, 0x5b, 0x6d, 0x0a, 0xc3, 0xab, 0x2c, 0xe2, 0x9d, 0x40, 0x72, 0x13, 0x28, 0xe9, 0x3c,
+	0xbd, 0x17, 0x3b, 0x4a, 0x75, 0x42, 0xc1, 0x78, 0x14, 0x30, 0x2e, 0xa5, 0x32, 0x76, 0x53, 0xe3,
+	0x6e, 0x5a, 0xd1, 0x09, 0xb8, 0x9d, 0xcb, 0x1d, 0xd5, 0x51, 0xf6, 0x91, 0x0d, 0x9f, 0xdc, 0xdb,
+	0xf2, 0x17, 0xb0, 0xfc, 0xd9, 0x50, 0xaf, 0xce, 0x43, 0x2e, 0x5b, 0xa2, 0x21, 0xee, 0xf7, 0x84,
+	0x36, 0x74, 0x15, 0x96, 0x5a, 0x4a, 0x9a, 0x98, 0xb7, 0xcc, 0x9d, 0xa0, 0x5d, 0x24, 0x6b, 0xa4,
+	0x52, 0x68, 0x40, 0xf2, 0x6a, 0xbb, 0x4d, 0x8b, 0xb0, 0xc0, 0xdb, 0xed, 0x58, 0x68, 0x5d, 0xcc,
+	0xd9, 0xcd, 0x64, 0x79, 0x3d, 0x57, 0x24, 0xe5, 0xbb, 0x70, 0x39, 0x1d, 0x55, 0x47, 0x4a, 0x6a,
+	0x41, 0x3f, 0x86, 0x3c, 0xef, 0xaa, 0x9e, 0x34, 0x2e, 0x62, 0xbd, 0xf6, 0xf0, 0xf1, 0xea, 0xdc,
+	0x3f, 0x8f, 0x57, 0xaf, 0x76, 0x02, 0xb3, 0xd3, 0x6b, 0xfa, 0x2d, 0xd5, 0x65, 0x9b, 0x81, 0xd4,
+	0xad, 0x9d, 0x80, 0xb3, 0xbb, 0xf8, 0xf0, 0x86, 0x6e, 0xdf, 0x63, 0x66, 0x2f, 0x12, 0xda, 0xdf,
+	0x96, 0xa6, 0x81, 0x11, 0xac, 0xce, 0x3b, 0x40, 0xad, 0xce, 0xe7, 0xbd, 0x28, 0x0a, 0xf7, 0xa6,
+	0x85, 0xb7, 0xae, 0x02, 0x96, 0x53, 0xae, 0xe7, 0x4c, 0xf8, 0x49, 0x20, 0x8d, 0x68, 0x3f, 0x15,
+	0x61, 0xe2, 0x7a, 0x4e, 0x84, 0x1b, 0x70, 0xc9, 0xd5, 0xaa, 0x17, 0x4b, 0x33, 0x13, 0x60, 0x1b,
+	0xe8, 0xb8, 0xe7, 0x39, 0xf1, 0xdd, 0xc0, 0xb3, 0xf4, 0x01, 0x8a, 0xcf, 0x84, 0x78, 0x1b, 0x9e,
+	0x3b, 0xe2, 0x8c, 0x94, 0x1b, 0xb0, 0x98, 0x98, 0x5a, 0xd7, 0xa5, 0xda, 0x8a, 0x3f, 0x7e, 0x25,
+	0xfd, 0xc4, 0xa3, 0xfe, 0xcc, 0x90, 0xbf, 0x31, 0xb2, 0xb6, 0x61, 0x7f, 0x23, 0xf0, 0x82, 0x8d,
+	0xbb, 0x15, 0x73, 0x69, 0x84, 0xb0, 0x7f, 0xf4, 0x2c, 0x97, 0xa7, 0xe3, 0x1c, 0x93, 0xcb, 0x83,
+	0x4b, 0xba, 0x09, 0x70, 0x78, 0xe1, 0x8b, 0xf3, 0x16, 0xec, 0x55, 0xdf, 0x75, 0x07, 0x7f, 0xd8,
+	0x1d, 0x7c, 0xd7, 0x44, 0xb0, 0x3b, 0xf8, 0x9f, 0xf2, 0x4e, 0x72, 0x67, 0x1b, 0x63, 0x9e, 0x16,
+	0xf2, 0x57, 0x02, 0x5e, 0x16, 0x24, 0x66, 0xa0, 0x0a, 0x79, 0xab, 0xaa, 0x8b, 0x64, 0x6d, 0xbe,
+	0xb2, 0x54, 0x5b, 0x4e, 0x7f, 0xbf, 0xb5, 0xc6, 0x8f, 0x47, 0x43, 0xba, 0x95, 0xa2, 0xcb, 0x59,
+	0xba, 0x2b, 0xa7, 0xd2, 0x39, 0xbd, 0x63, 0x78, 0x06, 0x53, 0xb8, 0xad, 0x6f, 0x45, 0x22, 0xe6,
+	0x46, 0xc5, 0x9b, 0x2a, 0x9e, 0x3a, 0x85, 0x1e, 0x2c, 0x2a, 0x74, 0xc3, 0x1c, 0x8e, 0xd6, 0x74,
+	0x05, 0xf2, 0x3b, 0x2a, 0x6c, 0x8b, 0xd8, 0x26, 0xb0, 0xd0, 0xc0, 0x95, 0x55, 0x7d, 0x0f, 0xbc,
+	0x2c, 0x55, 0xcc, 0x49, 0x09, 0x80, 0xf7, 0xcc, 0x8e, 0x8a, 0x83, 0x6f, 0x85, 0x53, 0x5d, 0x6c,
+	0x8c, 0xbd, 0xb1, 0x11, 0xfe, 0x24, 0xf0, 0x92, 0x0d, 0xf1, 0x91, 0x8d, 0xaa, 0xeb, 0x7b, 0x49,
+	0xa4, 0x33, 0x81, 0x3f, 0xcb, 0x13, 0xf0, 0x80, 0x40, 0xe9, 0x24, 0x54, 0xfc, 0xe2, 0x22, 0x2c,
+	0xb8, 0xec, 0xb8, 0x63, 0x50, 0x68, 0x24, 0xcb, 0xb3, 0x2d, 0xf6, 0x57, 0x78, 0x0f, 0xdf, 0x0f,
+	0x43, 0xf5, 0xf5, 0x4c, 0x83, 0xe6, 0xb0, 0x98, 0xb9, 0xf1, 0x62, 0x0e, 0xc1, 0x75, 0x24, 0xe4,
+	0x61, 0x95, 0x93, 0x65, 0xf9, 0x36, 0xac, 0x1c, 0xd5, 0xc2, 0x8f, 0xbd, 0x01, 0x05, 0x9e, 0xbc,
+	0xc4, 0x5b, 0xff, 0x7c, 0xfa, 0xd4, 0x8f, 0x7c, 0xf0, 0xe4, 0x1f, 0xda, 0xd7, 0xfe, 0x2f, 0xc0,
+	0x05, 0x1b, 0x97, 0xfe, 0x42, 0x60, 0x01, 0x27, 0x1b, 0x5d, 0x4f, 0xfb, 0x67, 0xcc, 0x52, 0xaf,
+	0x3c, 0xc9, 0xc4, 0x91, 0x95, 0x3f, 0xfc, 0xee, 0xaf, 0xff, 0x7e, 0xce, 0xbd, 0x4b, 0x6f, 0xb2,
+	0xe3, 0xf3, 0xfb, 0x4e, 0x2b, 0xe4, 0x5a, 0x0b, 0xcd, 0xfa, 0x63, 0x99, 0x1a, 0xb0, 0xa6, 0x0b,
+	0xa1, 0x59, 0x1f, 0x27, 0xef, 0x80, 0x3e, 0x20, 0x90, 0x77, 0xf3, 0x8c, 0xae, 0x65, 0x88, 0xa6,
+	0xa6, 0xa4, 0xb7, 0x3e, 0xc1, 0x02, 0xa9, 0x36, 0x2c, 0x55, 0x8d, 0xbe, 0x39, 0x3d, 0x95, 0x76,
+	0xf2, 0x43, 0x12, 0x37, 0xb7, 0x32, 0x49, 0x52, 0xd3, 0xd0, 0x5b, 0x9f, 0x60, 0xf1, 0xf4, 0x24,
+	0x5d, 0x27, 0xff, 0x3d, 0x81, 0x0b, 0x76, 0x40, 0xd1, 0xd5, 0xac, 0x3a, 0x8c, 0x0d, 0x3d, 0x6f,
+	0xed, 0x64, 0x03, 0xc4, 0x78, 0xdb, 0x62, 0x54, 0x29, 0x9b, 0xa1, 0x4c, 0x56, 0xfb, 0x07, 0x02,
+	0x8b, 0xc9, 0x44, 0xa1, 0x59, 0x07, 0xe2, 0xc8, 0x74, 0xf3, 0x5e, 0x9e, 0x68, 0x83, 0x38, 0x55,
+	0x8b, 0x73, 0x8d, 0xbe, 0x36, 0x35, 0x0e, 0xfd, 0x9d, 0xc0, 0xc5, 0xd4, 0x3c, 0xa0, 0x57, 0x32,
+	0x94, 0xb2, 0xc6, 0x9a, 0x57, 0x39, 0xdd, 0x10, 0xb9, 0xea, 0x96, 0xeb, 0x26, 0xbd, 0x3e, 0x7d,
+	0x9a, 0xdc, 0x84, 0x61, 0x7d, 0x1c, 0x84, 0x03, 0xda, 0x84, 0x8b, 0xa9, 0x1e, 0x9d, 0xc9, 0x99,
+	0x35, 0x3b, 0xbc, 0xca, 0xe9, 0x86, 0xd8, 0x0f, 0x24, 0x5c, 0x3a, 0xd6, 0x19, 0xe9, 0xb5, 0x0c,
+	0xf7, 0x93, 0x5a, 0xbd, 0xf7, 0xfa, 0x74, 0xc6, 0xa8, 0xf7, 0x07, 0x81, 0xc2, 0xa8, 0xc3, 0xd0,
+	0xac, 0x12, 0x1f, 0xed, 0x8f, 0xde, 0x2b, 0x93, 0x8d, 0x30, 0xe1, 0xb7, 0x6c, 0xc2, 0xb7, 0xe9,
+	0xd6, 0xf4, 0x09, 0x1f, 0x35, 0x36, 0xcd, 0xfa, 0xae, 0xa5, 0x0e, 0x58, 0x1f, 0x5b, 0xe8, 0xc0,
+	0x9b, 0xff, 0x31, 0x47, 0xea, 0xf5, 0x87, 0xfb, 0x25, 0xf2, 0x68, 0xbf, 0x44, 0xfe, 0xdd, 0x2f,
+	0x91, 0x9f, 0x0e, 0x4a, 0x73, 0x8f, 0x0e, 0x4a, 0x73, 0x7f, 0x1f, 0x94, 0xe6, 0xbe, 0xac, 0x9c,
+	0xfa, 0xbf, 0xdc, 0x37, 0x4e, 0xbc, 0x99, 0xb7, 0x3f, 0x33, 0xde, 0x7a, 0x32, 0x00, 0x67, 0xde,
+	0x5a, 0x90, 0x0a, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -947,6 +1064,7 @@ type QueryClient interface {
 	// Balance queries the number of tokens of a given contract owned by the address.
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Supply queries the number of tokens from the given contract id.
+	// The tokens converted into the coins of x/bank are included.
 	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
 	// Minted queries the number of minted tokens from the given contract id.
 	Minted(ctx context.Context, in *QueryMintedRequest, opts ...grpc.CallOption) (*QueryMintedResponse, error)
@@ -960,6 +1078,8 @@ type QueryClient interface {
 	IsOperatorFor(ctx context.Context, in *QueryIsOperatorForRequest, opts ...grpc.CallOption) (*QueryIsOperatorForResponse, error)
 	// HoldersByOperator queries holders on a given operator.
 	HoldersByOperator(ctx context.Context, in *QueryHoldersByOperatorRequest, opts ...grpc.CallOption) (*QueryHoldersByOperatorResponse, error)
+	// Allowance queries the remaining allowance of a spender on the holder's tokens.
+	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -1043,6 +1163,15 @@ func (c *queryClient) HoldersByOperator(ctx context.Context, in *QueryHoldersByO
 	return out, nil
 }
 
+func (c *queryClient) Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
+	out := new(QueryAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/lbm.token.v1.Query/Allowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 //
 // Deprecated: Do not use.
@@ -1050,6 +1179,7 @@ type QueryServer interface {
 	// Balance queries the number of tokens of a given contract owned by the address.
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Supply queries the number of tokens from the given contract id.
+	// The tokens converted into the coins of x/bank are included.
 	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
 	// Minted queries the number of minted tokens from the given contract id.
 	Minted(context.Context, *QueryMintedRequest) (*QueryMintedResponse, error)
@@ -1063,6 +1193,8 @@ type QueryServer interface {
 	IsOperatorFor(context.Context, *QueryIsOperatorForRequest) (*QueryIsOperatorForResponse, error)
 	// HoldersByOperator queries holders on a given operator.
 	HoldersByOperator(context.Context, *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error)
+	// Allowance queries the remaining allowance of a spender on the holder's tokens.
+	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
 }
 
 // Deprecated: Do not use.
@@ -1094,6 +1226,9 @@ func (*UnimplementedQueryServer) IsOperatorFor(ctx context.Context, req *QueryIs
 func (*UnimplementedQueryServer) HoldersByOperator(ctx context.Context, req *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HoldersByOperator not implemented")
 }
+func (*UnimplementedQueryServer) Allowance(ctx context.Context, req *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowance not implemented")
+}
 
 // Deprecated: Do not use.
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
@@ -1244,6 +1379,24 @@ func _Query_HoldersByOperator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.token.v1.Query/Allowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowance(ctx, req.(*QueryAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.token.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1280,6 +1433,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HoldersByOperator",
 			Handler:    _Query_HoldersByOperator_Handler,
 		},
+		{
+			MethodName: "Allowance",
+			Handler:    _Query_Allowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/token/v1/query.proto",
@@ -1875,6 +2032,83 @@ func (m *QueryHoldersByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2123,6 +2357,38 @@ func (m *QueryHoldersByOperatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3744,6 +4010,235 @@ func (m *QueryHoldersByOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
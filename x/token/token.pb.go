@@ -5,17 +5,22 @@ package token
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -269,6 +274,51 @@ func (m *Authorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Authorization proto.InternalMessageInfo
 
+// Allowance defines an amount of tokens which the spender can send on behalf of the holder.
+type Allowance struct {
+	// address of the token holder which approves the allowance.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// remaining number of tokens the spender can send.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"spend_limit"`
+	// time when the allowance expires. The allowance never expires if not set.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{4}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
 // Grant defines permission given to a grantee.
 //
 // Deprecated: Do not use.
@@ -283,7 +333,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1cc82dfde9e68378, []int{4}
+	return fileDescriptor_1cc82dfde9e68378, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,50 +369,59 @@ func init() {
 	proto.RegisterType((*Contract)(nil), "lbm.token.v1.Contract")
 	proto.RegisterType((*Attribute)(nil), "lbm.token.v1.Attribute")
 	proto.RegisterType((*Authorization)(nil), "lbm.token.v1.Authorization")
+	proto.RegisterType((*Allowance)(nil), "lbm.token.v1.Allowance")
 	proto.RegisterType((*Grant)(nil), "lbm.token.v1.Grant")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/token.proto", fileDescriptor_1cc82dfde9e68378) }
 
 var fileDescriptor_1cc82dfde9e68378 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x4f, 0xdb, 0x40,
-	0x10, 0xb5, 0x03, 0x09, 0x61, 0x44, 0xa9, 0xbb, 0x4a, 0xa3, 0xad, 0xab, 0xba, 0x16, 0x97, 0x46,
-	0x54, 0x4d, 0x44, 0x3f, 0x54, 0xd4, 0x1b, 0x81, 0x80, 0x52, 0x41, 0x88, 0x42, 0x39, 0xd0, 0x0b,
-	0x5a, 0xc7, 0x4b, 0xb2, 0xc2, 0xf6, 0x46, 0xeb, 0x0d, 0x6a, 0xfa, 0x03, 0xaa, 0x2a, 0xa7, 0xfe,
-	0x01, 0x9f, 0xda, 0x03, 0x3f, 0x85, 0x23, 0xc7, 0x1e, 0xdb, 0xf0, 0x47, 0xaa, 0xb5, 0x4d, 0xb0,
-	0x42, 0x7a, 0x7b, 0x6f, 0xe6, 0xbd, 0xd9, 0x79, 0xbb, 0x5a, 0xc0, 0x9e, 0xe3, 0xd7, 0x24, 0x3f,
-	0xa7, 0x41, 0xed, 0x62, 0x23, 0x01, 0xd5, 0x81, 0xe0, 0x92, 0xa3, 0x15, 0xcf, 0xf1, 0xab, 0x49,
-	0xe1, 0x62, 0xc3, 0x2c, 0xf5, 0x78, 0x8f, 0xc7, 0x8d, 0x9a, 0x42, 0x89, 0x66, 0x6d, 0x05, 0x0a,
-	0x6d, 0x22, 0x88, 0x1f, 0x7e, 0xc8, 0x61, 0x7d, 0xed, 0x52, 0x87, 0xe2, 0x36, 0x0f, 0xa4, 0x20,
-	0x5d, 0x89, 0x56, 0x21, 0xc7, 0x5c, 0xac, 0xdb, 0x7a, 0x65, 0xb9, 0x93, 0x63, 0x2e, 0x42, 0xb0,
-	0x18, 0x10, 0x9f, 0xe2, 0x5c, 0x5c, 0x89, 0x31, 0x2a, 0x43, 0x21, 0x1c, 0xf9, 0x0e, 0xf7, 0xf0,
-	0x42, 0x5c, 0x4d, 0x19, 0x32, 0x60, 0x61, 0x28, 0x18, 0x5e, 0x8c, 0x8b, 0x0a, 0x2a, 0xb7, 0x4f,
-	0x25, 0xc1, 0xf9, 0xc4, 0xad, 0x30, 0x32, 0xa1, 0xe8, 0xd2, 0x2e, 0xf3, 0x89, 0x17, 0xe2, 0x82,
-	0xad, 0x57, 0xf2, 0x9d, 0x29, 0x57, 0x3d, 0x9f, 0x05, 0x92, 0x38, 0x1e, 0xc5, 0x4b, 0xb6, 0x5e,
-	0x29, 0x76, 0xa6, 0x3c, 0x5e, 0xf5, 0x3d, 0x2c, 0x6f, 0x49, 0x29, 0x98, 0x33, 0x94, 0x54, 0x1d,
-	0x77, 0x4e, 0x47, 0xe9, 0xae, 0x0a, 0xa2, 0x12, 0xe4, 0x2f, 0x88, 0x37, 0xbc, 0xdd, 0x36, 0x21,
-	0xb1, 0x71, 0x0f, 0x1e, 0x6c, 0x0d, 0x65, 0x9f, 0x0b, 0xf6, 0x95, 0x48, 0xc6, 0x03, 0x95, 0xa1,
-	0xcf, 0x3d, 0x97, 0x8a, 0xd4, 0x9f, 0x32, 0xb5, 0x01, 0x1f, 0x50, 0x41, 0x24, 0x17, 0xe9, 0x94,
-	0x29, 0x8f, 0x07, 0x9d, 0x42, 0x7e, 0x4f, 0x90, 0x40, 0x22, 0x0c, 0x4b, 0x3d, 0x05, 0x28, 0x4d,
-	0x27, 0xdc, 0x52, 0xb4, 0x09, 0x30, 0xa0, 0xc2, 0x67, 0x61, 0xc8, 0x78, 0x10, 0x0f, 0x59, 0x7d,
-	0x8d, 0xab, 0xd9, 0x67, 0xa9, 0xb6, 0xa7, 0xfd, 0x4e, 0x46, 0xab, 0x0e, 0x58, 0xbf, 0xd2, 0x01,
-	0xee, 0xda, 0xe8, 0x1d, 0x94, 0xdb, 0x8d, 0xce, 0x41, 0xf3, 0xe8, 0xa8, 0x79, 0xd8, 0x3a, 0x3d,
-	0x6e, 0x1d, 0xb5, 0x1b, 0xdb, 0xcd, 0xdd, 0x66, 0x63, 0xc7, 0xd0, 0xcc, 0x27, 0xe3, 0xc8, 0x7e,
-	0x7c, 0xa7, 0x3d, 0x0e, 0xc2, 0x01, 0xed, 0xb2, 0x33, 0x46, 0x5d, 0xf4, 0x12, 0x1e, 0x65, 0x6c,
-	0x07, 0x87, 0x3b, 0xcd, 0xdd, 0x13, 0x43, 0x37, 0x4b, 0xe3, 0xc8, 0x36, 0xee, 0x1c, 0x07, 0xdc,
-	0x65, 0x67, 0x23, 0xf4, 0x02, 0x1e, 0x66, 0xc5, 0xcd, 0xd6, 0x27, 0x23, 0x67, 0xa2, 0x71, 0x64,
-	0xaf, 0x66, 0xa4, 0x2c, 0x90, 0x33, 0xc2, 0xfa, 0x71, 0xa7, 0x65, 0x2c, 0xcc, 0x0a, 0xeb, 0x43,
-	0x11, 0x98, 0x8b, 0xdf, 0x7f, 0x5a, 0xda, 0xfa, 0xb7, 0x1c, 0x18, 0xfb, 0xb4, 0x47, 0xba, 0xa3,
-	0x4c, 0xa0, 0x3a, 0x3c, 0xdb, 0x6f, 0xec, 0x6d, 0x6d, 0x9f, 0x9c, 0xfe, 0x37, 0xd7, 0xf3, 0x71,
-	0x64, 0x3f, 0x9d, 0x35, 0x66, 0xd3, 0x6d, 0x02, 0xbe, 0x3f, 0x63, 0x1a, 0xd2, 0x1c, 0x47, 0x76,
-	0x79, 0xd6, 0x9e, 0x46, 0x7d, 0x0b, 0xe5, 0x39, 0xce, 0x24, 0x31, 0x1e, 0x47, 0x76, 0xe9, 0x9e,
-	0x4f, 0xe5, 0x9e, 0xeb, 0x4a, 0xe3, 0xcf, 0x75, 0xc5, 0x97, 0x50, 0x54, 0x97, 0x70, 0xf9, 0xcb,
-	0xd2, 0xea, 0x1f, 0xaf, 0xfe, 0x5a, 0xda, 0xe5, 0xc4, 0xd2, 0xae, 0x26, 0x96, 0x7e, 0x3d, 0xb1,
-	0xf4, 0x3f, 0x13, 0x4b, 0xff, 0x71, 0x63, 0x69, 0xd7, 0x37, 0x96, 0xf6, 0xfb, 0xc6, 0xd2, 0x3e,
-	0x57, 0x7a, 0x4c, 0xf6, 0x87, 0x4e, 0xb5, 0xcb, 0xfd, 0xda, 0x2e, 0x0b, 0xc2, 0x6e, 0x9f, 0x91,
-	0xda, 0x59, 0x0a, 0x5e, 0x85, 0xee, 0x79, 0xed, 0x4b, 0xf2, 0xcb, 0x9d, 0x42, 0xfc, 0x85, 0xdf,
-	0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x39, 0x38, 0xea, 0x02, 0x04, 0x00, 0x00,
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xb6, 0x43, 0x12, 0xc2, 0xc0, 0xb2, 0x59, 0x2b, 0x1b, 0x79, 0xbd, 0x5a, 0xc7, 0xe2, 0xb2,
+	0x11, 0xab, 0xb5, 0x05, 0xbb, 0x55, 0x51, 0x4f, 0x4d, 0x20, 0xa0, 0x54, 0x10, 0x22, 0x07, 0x0e,
+	0xf4, 0x12, 0x8d, 0xe3, 0x49, 0x32, 0xc2, 0xf6, 0x58, 0xf6, 0x84, 0x92, 0xfe, 0x80, 0xaa, 0xca,
+	0x89, 0x3f, 0x90, 0x53, 0x7b, 0xe0, 0xa7, 0xe4, 0xc8, 0xa1, 0x87, 0xaa, 0x07, 0xda, 0x86, 0x3f,
+	0x52, 0xcd, 0xd8, 0x09, 0x56, 0x00, 0xf5, 0xf6, 0x7d, 0xf3, 0xbe, 0xef, 0xf9, 0x7d, 0x4f, 0x4f,
+	0x06, 0xb2, 0x63, 0xb9, 0x06, 0x25, 0xe7, 0xc8, 0x33, 0x2e, 0xb6, 0x22, 0xa0, 0xfb, 0x01, 0xa1,
+	0x44, 0x5a, 0x73, 0x2c, 0x57, 0x8f, 0x1e, 0x2e, 0xb6, 0x94, 0x42, 0x8f, 0xf4, 0x08, 0x2f, 0x18,
+	0x0c, 0x45, 0x1a, 0xa5, 0xd4, 0x23, 0xa4, 0xe7, 0x20, 0x83, 0x33, 0x6b, 0xd0, 0x35, 0x28, 0x76,
+	0x51, 0x48, 0xa1, 0xeb, 0x47, 0x82, 0x8d, 0x35, 0x90, 0x6d, 0xc2, 0x00, 0xba, 0xe1, 0x8b, 0x94,
+	0x2c, 0x6e, 0x5c, 0x8b, 0x20, 0xb7, 0x4b, 0x3c, 0x1a, 0xc0, 0x0e, 0x95, 0xd6, 0x41, 0x0a, 0xdb,
+	0xb2, 0xa8, 0x89, 0xe5, 0x15, 0x33, 0x85, 0x6d, 0x49, 0x02, 0x69, 0x0f, 0xba, 0x48, 0x4e, 0xf1,
+	0x17, 0x8e, 0xa5, 0x22, 0xc8, 0x86, 0x43, 0xd7, 0x22, 0x8e, 0xbc, 0xc4, 0x5f, 0x63, 0x26, 0xe5,
+	0xc1, 0xd2, 0x20, 0xc0, 0x72, 0x9a, 0x3f, 0x32, 0xc8, 0xdc, 0x2e, 0xa2, 0x50, 0xce, 0x44, 0x6e,
+	0x86, 0x25, 0x05, 0xe4, 0x6c, 0xd4, 0xc1, 0x2e, 0x74, 0x42, 0x39, 0xab, 0x89, 0xe5, 0x8c, 0x39,
+	0xe7, 0xac, 0xe6, 0x62, 0x8f, 0x42, 0xcb, 0x41, 0xf2, 0xb2, 0x26, 0x96, 0x73, 0xe6, 0x9c, 0xf3,
+	0x51, 0x9f, 0x83, 0x95, 0x0a, 0xa5, 0x01, 0xb6, 0x06, 0x14, 0xb1, 0xcf, 0x9d, 0xa3, 0x61, 0x3c,
+	0x2b, 0x83, 0x52, 0x01, 0x64, 0x2e, 0xa0, 0x33, 0x98, 0x4d, 0x1b, 0x11, 0x6e, 0x3c, 0x00, 0xbf,
+	0x54, 0x06, 0xb4, 0x4f, 0x02, 0xfc, 0x16, 0x52, 0x4c, 0x3c, 0x96, 0xa1, 0x4f, 0x1c, 0x1b, 0x05,
+	0xb1, 0x3f, 0x66, 0x6c, 0x02, 0xe2, 0xa3, 0x00, 0x52, 0x12, 0xc4, 0x5d, 0xe6, 0x9c, 0x37, 0xfa,
+	0x24, 0x82, 0x95, 0x8a, 0xe3, 0x90, 0x37, 0xd0, 0xeb, 0xa0, 0x27, 0xbb, 0xc8, 0x60, 0x39, 0xf4,
+	0x91, 0x67, 0xa3, 0x59, 0x93, 0x19, 0x95, 0x5a, 0x60, 0x95, 0xc3, 0xb6, 0x83, 0x5d, 0x4c, 0xa3,
+	0x05, 0x56, 0xb7, 0x27, 0xb7, 0x25, 0xe1, 0xcb, 0x6d, 0x69, 0xb3, 0x87, 0x69, 0x7f, 0x60, 0xe9,
+	0x1d, 0xe2, 0x1a, 0xfb, 0xd8, 0x0b, 0x3b, 0x7d, 0x0c, 0x8d, 0x6e, 0x0c, 0xfe, 0x0d, 0xed, 0x73,
+	0x83, 0x0e, 0x7d, 0x14, 0xea, 0x75, 0x8f, 0x9a, 0x80, 0xb7, 0x39, 0x64, 0x5d, 0xa4, 0x97, 0x00,
+	0xa0, 0x4b, 0x1f, 0x07, 0x3c, 0x1a, 0xdf, 0xff, 0xea, 0xb6, 0xa2, 0x47, 0x57, 0xa0, 0xcf, 0xae,
+	0x40, 0x3f, 0x99, 0x5d, 0x41, 0x35, 0x7d, 0xf5, 0xb5, 0x24, 0x9a, 0x09, 0xcf, 0x46, 0x1b, 0x64,
+	0x0e, 0x02, 0xe8, 0x51, 0x36, 0x79, 0x8f, 0x01, 0x84, 0xe2, 0x48, 0x33, 0x2a, 0xed, 0x00, 0xe0,
+	0xa3, 0xc0, 0xc5, 0x61, 0xc8, 0x3e, 0xc2, 0x62, 0xad, 0x6f, 0xcb, 0x7a, 0xf2, 0x1c, 0xf5, 0xe6,
+	0xbc, 0x6e, 0x26, 0xb4, 0x6c, 0x6f, 0x9b, 0x13, 0x11, 0x80, 0xfb, 0xb2, 0xf4, 0x0c, 0x14, 0x9b,
+	0x35, 0xf3, 0xa8, 0xde, 0x6a, 0xd5, 0x8f, 0x1b, 0xed, 0xd3, 0x46, 0xab, 0x59, 0xdb, 0xad, 0xef,
+	0xd7, 0x6b, 0x7b, 0x79, 0x41, 0xf9, 0x63, 0x34, 0xd6, 0x7e, 0xbf, 0xd7, 0x9e, 0x7a, 0xa1, 0x8f,
+	0x3a, 0xb8, 0x8b, 0x91, 0x2d, 0xfd, 0x03, 0x7e, 0x4b, 0xd8, 0x8e, 0x8e, 0xf7, 0xea, 0xfb, 0x67,
+	0x79, 0x51, 0x29, 0x8c, 0xc6, 0x5a, 0xfe, 0xde, 0x71, 0x44, 0x6c, 0xdc, 0x1d, 0x4a, 0x7f, 0x83,
+	0x5f, 0x93, 0xe2, 0x7a, 0xe3, 0x24, 0x9f, 0x52, 0xa4, 0xd1, 0x58, 0x5b, 0x4f, 0x48, 0xb1, 0x47,
+	0x17, 0x84, 0xd5, 0x53, 0xb3, 0x91, 0x5f, 0x5a, 0x14, 0x56, 0x07, 0x81, 0xa7, 0xa4, 0xdf, 0x7f,
+	0x50, 0x85, 0xcd, 0x77, 0x29, 0x90, 0x3f, 0x44, 0x3d, 0xd8, 0x19, 0x26, 0x02, 0x55, 0xc1, 0x5f,
+	0x87, 0xb5, 0x83, 0xca, 0xee, 0x59, 0xfb, 0xc9, 0x5c, 0xa5, 0xd1, 0x58, 0xfb, 0x73, 0xd1, 0x98,
+	0x4c, 0xb7, 0x03, 0xe4, 0x87, 0x3d, 0xe6, 0x21, 0x95, 0xd1, 0x58, 0x2b, 0x2e, 0xda, 0xe3, 0xa8,
+	0xff, 0x83, 0xe2, 0x23, 0xce, 0x28, 0xb1, 0x3c, 0x1a, 0x6b, 0x85, 0x07, 0x3e, 0x96, 0xfb, 0x51,
+	0x57, 0x1c, 0xff, 0x51, 0x17, 0x5f, 0x42, 0x8e, 0x2d, 0xe1, 0xfa, 0xa3, 0x2a, 0x54, 0x5f, 0x4d,
+	0xbe, 0xab, 0xc2, 0xf5, 0x54, 0x15, 0x26, 0x53, 0x55, 0xbc, 0x99, 0xaa, 0xe2, 0xb7, 0xa9, 0x2a,
+	0x5e, 0xdd, 0xa9, 0xc2, 0xcd, 0x9d, 0x2a, 0x7c, 0xbe, 0x53, 0x85, 0xd7, 0xe5, 0x9f, 0x1e, 0xf4,
+	0x65, 0xf4, 0x77, 0xb3, 0xb2, 0xfc, 0x4c, 0xff, 0xfb, 0x31, 0x00, 0xa1, 0x68, 0x85, 0xaf, 0xfa,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -535,6 +594,63 @@ func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintToken(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -659,6 +775,29 @@ func (m *Authorization) Size() (n int) {
 	return n
 }
 
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1208,6 +1347,190 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,18 +10,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1006,6 +1010,258 @@ func (m *MsgModifyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgModifyResponse proto.InternalMessageInfo
 
+// MsgConvertToBank defines the Msg/ConvertToBank request type.
+//
+// Signer: `holder`
+type MsgConvertToBank struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// holder whose tokens are being converted.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// number of tokens to convert.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgConvertToBank) Reset()         { *m = MsgConvertToBank{} }
+func (m *MsgConvertToBank) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertToBank) ProtoMessage()    {}
+func (*MsgConvertToBank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bca67047bb82568, []int{22}
+}
+func (m *MsgConvertToBank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertToBank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertToBank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertToBank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertToBank.Merge(m, src)
+}
+func (m *MsgConvertToBank) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertToBank) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertToBank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertToBank proto.InternalMessageInfo
+
+// MsgConvertToBankResponse defines the Msg/ConvertToBank response type.
+type MsgConvertToBankResponse struct {
+}
+
+func (m *MsgConvertToBankResponse) Reset()         { *m = MsgConvertToBankResponse{} }
+func (m *MsgConvertToBankResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertToBankResponse) ProtoMessage()    {}
+func (*MsgConvertToBankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bca67047bb82568, []int{23}
+}
+func (m *MsgConvertToBankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertToBankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertToBankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertToBankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertToBankResponse.Merge(m, src)
+}
+func (m *MsgConvertToBankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertToBankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertToBankResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertToBankResponse proto.InternalMessageInfo
+
+// MsgMigrateToBank defines the Msg/MigrateToBank request type.
+//
+// Signer: `authority`
+type MsgMigrateToBank struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// maximum number of holders to convert in this message.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgMigrateToBank) Reset()         { *m = MsgMigrateToBank{} }
+func (m *MsgMigrateToBank) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateToBank) ProtoMessage()    {}
+func (*MsgMigrateToBank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bca67047bb82568, []int{24}
+}
+func (m *MsgMigrateToBank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateToBank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateToBank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateToBank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateToBank.Merge(m, src)
+}
+func (m *MsgMigrateToBank) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateToBank) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateToBank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateToBank proto.InternalMessageInfo
+
+// MsgMigrateToBankResponse defines the Msg/MigrateToBank response type.
+type MsgMigrateToBankResponse struct {
+	// whether the migration is done. If not, the remaining holders must be converted by another message.
+	Done bool `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *MsgMigrateToBankResponse) Reset()         { *m = MsgMigrateToBankResponse{} }
+func (m *MsgMigrateToBankResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateToBankResponse) ProtoMessage()    {}
+func (*MsgMigrateToBankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bca67047bb82568, []int{25}
+}
+func (m *MsgMigrateToBankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateToBankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateToBankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateToBankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateToBankResponse.Merge(m, src)
+}
+func (m *MsgMigrateToBankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateToBankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateToBankResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateToBankResponse proto.InternalMessageInfo
+
+// MsgApprove defines the Msg/Approve request type.
+//
+// Signer: `holder`
+type MsgApprove struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the token holder which approves the allowance.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is granted to.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// number of tokens the spender can send.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=spend_limit,json=spendLimit,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"spend_limit"`
+	// time when the allowance expires. The allowance never expires if not set.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *MsgApprove) Reset()         { *m = MsgApprove{} }
+func (m *MsgApprove) String() string { return proto.CompactTextString(m) }
+func (*MsgApprove) ProtoMessage()    {}
+func (*MsgApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bca67047bb82568, []int{26}
+}
+func (m *MsgApprove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprove.Merge(m, src)
+}
+func (m *MsgApprove) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApprove proto.InternalMessageInfo
+
+// MsgApproveResponse defines the Msg/Approve response type.
+type MsgApproveResponse struct {
+}
+
+func (m *MsgApproveResponse) Reset()         { *m = MsgApproveResponse{} }
+func (m *MsgApproveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveResponse) ProtoMessage()    {}
+func (*MsgApproveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bca67047bb82568, []int{27}
+}
+func (m *MsgApproveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveResponse.Merge(m, src)
+}
+func (m *MsgApproveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "lbm.token.v1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "lbm.token.v1.MsgSendResponse")
@@ -1029,66 +1285,86 @@ func init() {
 	proto.RegisterType((*MsgOperatorBurnResponse)(nil), "lbm.token.v1.MsgOperatorBurnResponse")
 	proto.RegisterType((*MsgModify)(nil), "lbm.token.v1.MsgModify")
 	proto.RegisterType((*MsgModifyResponse)(nil), "lbm.token.v1.MsgModifyResponse")
+	proto.RegisterType((*MsgConvertToBank)(nil), "lbm.token.v1.MsgConvertToBank")
+	proto.RegisterType((*MsgConvertToBankResponse)(nil), "lbm.token.v1.MsgConvertToBankResponse")
+	proto.RegisterType((*MsgMigrateToBank)(nil), "lbm.token.v1.MsgMigrateToBank")
+	proto.RegisterType((*MsgMigrateToBankResponse)(nil), "lbm.token.v1.MsgMigrateToBankResponse")
+	proto.RegisterType((*MsgApprove)(nil), "lbm.token.v1.MsgApprove")
+	proto.RegisterType((*MsgApproveResponse)(nil), "lbm.token.v1.MsgApproveResponse")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/tx.proto", fileDescriptor_8bca67047bb82568) }
 
 var fileDescriptor_8bca67047bb82568 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0x8e, 0xe3, 0x24, 0x4d, 0xdf, 0x56, 0xfd, 0xf0, 0xb6, 0x8d, 0xd7, 0xbb, 0x75, 0xd2, 0x48,
-	0xd5, 0x66, 0x2b, 0x6d, 0xa2, 0x76, 0x0f, 0x95, 0x56, 0x95, 0x56, 0xcd, 0x01, 0xd4, 0x4a, 0x11,
-	0x28, 0x70, 0xaa, 0x84, 0xc0, 0x49, 0xa6, 0x8e, 0xd5, 0xd8, 0x13, 0x79, 0x26, 0xa5, 0x45, 0x42,
-	0x5c, 0x11, 0x07, 0xc4, 0x2f, 0xe0, 0xcc, 0x99, 0x9f, 0xc0, 0xa9, 0xc7, 0x1e, 0x11, 0x87, 0x0a,
-	0xd2, 0x1f, 0xc1, 0x15, 0x79, 0x62, 0x1b, 0x4f, 0xc6, 0xad, 0x0b, 0x44, 0x88, 0xdb, 0xcc, 0xfb,
-	0xf9, 0x3c, 0x33, 0xaf, 0x9f, 0x31, 0x2c, 0xf7, 0x5a, 0x76, 0x8d, 0xe2, 0x23, 0xe4, 0xd4, 0x8e,
-	0x37, 0x6b, 0xf4, 0xa4, 0xda, 0x77, 0x31, 0xc5, 0xca, 0x6c, 0xaf, 0x65, 0x57, 0x99, 0xb9, 0x7a,
-	0xbc, 0xa9, 0x2d, 0x99, 0xd8, 0xc4, 0xcc, 0x51, 0xf3, 0x56, 0xa3, 0x18, 0x4d, 0xe5, 0x53, 0x59,
-	0x30, 0xf3, 0x94, 0x5f, 0x4b, 0x30, 0xd5, 0x20, 0xe6, 0x3d, 0xe4, 0x74, 0x94, 0x22, 0xcc, 0xb4,
-	0xb1, 0x43, 0x5d, 0xa3, 0x4d, 0x1f, 0x5a, 0x1d, 0x55, 0x2a, 0x49, 0x95, 0xe9, 0x26, 0x04, 0xa6,
-	0xbd, 0x8e, 0xa2, 0x40, 0xe6, 0xd0, 0xc5, 0xb6, 0x9a, 0x66, 0x1e, 0xb6, 0x56, 0xe6, 0x20, 0x4d,
-	0xb1, 0x2a, 0x33, 0x4b, 0x9a, 0x62, 0x65, 0x1f, 0x72, 0x86, 0x8d, 0x07, 0x0e, 0x55, 0x33, 0x9e,
-	0xad, 0xbe, 0x75, 0x76, 0x51, 0x4c, 0x7d, 0xb8, 0x28, 0x6e, 0x98, 0x16, 0xed, 0x0e, 0x5a, 0xd5,
-	0x36, 0xb6, 0x6b, 0xb7, 0x2c, 0x87, 0xb4, 0xbb, 0x96, 0x51, 0x3b, 0xf4, 0x17, 0xff, 0x90, 0xce,
-	0x51, 0x8d, 0x9e, 0xf6, 0x11, 0xa9, 0xee, 0x39, 0xb4, 0xe9, 0x57, 0xf8, 0x2f, 0xad, 0x4a, 0xe5,
-	0x65, 0x98, 0xf7, 0xf1, 0x35, 0x11, 0xe9, 0x63, 0x87, 0x20, 0x66, 0x7e, 0x27, 0x31, 0xfb, 0x9d,
-	0x3e, 0x72, 0x0d, 0x8a, 0xdd, 0x9b, 0xe1, 0xd7, 0x20, 0x8f, 0xfd, 0x04, 0x9f, 0x43, 0xb8, 0x0f,
-	0xb9, 0xc9, 0x02, 0xb7, 0x4c, 0x0c, 0xb7, 0xec, 0x44, 0xb8, 0xad, 0x42, 0x61, 0x8c, 0x03, 0xc7,
-	0xb1, 0x07, 0x8b, 0x0d, 0x62, 0x36, 0xd1, 0x31, 0x3e, 0x42, 0x41, 0x50, 0x32, 0xc9, 0x15, 0xc8,
-	0x75, 0x71, 0xaf, 0x83, 0x02, 0x8a, 0xfe, 0x8e, 0x23, 0x2f, 0xf3, 0xe4, 0x59, 0xb7, 0x22, 0xfc,
-	0x2e, 0x74, 0xe3, 0xe0, 0x60, 0x58, 0x6a, 0x10, 0x73, 0x77, 0x40, 0xbb, 0xd8, 0xb5, 0x9e, 0xfc,
-	0x04, 0x44, 0x65, 0xf8, 0x33, 0xae, 0x21, 0x07, 0xea, 0x45, 0x1a, 0xf2, 0x0d, 0x62, 0xee, 0x11,
-	0x32, 0x40, 0xde, 0x1d, 0x3a, 0x86, 0x8d, 0x7c, 0x08, 0x6c, 0xed, 0x35, 0x27, 0xa7, 0x76, 0x0b,
-	0xf7, 0x82, 0xe6, 0xa3, 0x9d, 0xb2, 0x00, 0xf2, 0xc0, 0xb5, 0xfc, 0xbe, 0xde, 0xd2, 0xcb, 0xb6,
-	0x11, 0x35, 0xfc, 0xfb, 0x66, 0x6b, 0x0f, 0x62, 0x07, 0xb5, 0x2d, 0xdb, 0xe8, 0x11, 0x76, 0xe7,
-	0xd9, 0x66, 0xb8, 0xf7, 0x7c, 0xb6, 0xe5, 0x50, 0xa3, 0xd5, 0x43, 0x6a, 0xae, 0x24, 0x55, 0xf2,
-	0xcd, 0x70, 0xaf, 0x2c, 0x41, 0x16, 0x3f, 0x76, 0x90, 0xab, 0x4e, 0xb1, 0x62, 0xa3, 0x8d, 0x3f,
-	0x4f, 0xf9, 0x98, 0x79, 0x9a, 0x9e, 0xc8, 0x3c, 0x6d, 0xc3, 0x42, 0x70, 0x16, 0xc1, 0x21, 0x25,
-	0xde, 0x0e, 0x4b, 0x7c, 0x0a, 0x4a, 0x83, 0x98, 0xb7, 0x5d, 0xc3, 0xa1, 0x77, 0x91, 0x6b, 0x5b,
-	0x84, 0x58, 0xd8, 0x99, 0x8c, 0x1e, 0xe8, 0x00, 0xfd, 0xb0, 0xa4, 0x7f, 0xb6, 0x11, 0x0b, 0x6b,
-	0x5f, 0x02, 0x4d, 0x6c, 0xcf, 0x5d, 0xb3, 0x03, 0xbf, 0x85, 0xc3, 0xf9, 0xa3, 0x08, 0x79, 0x44,
-	0x72, 0x2c, 0xa2, 0x35, 0xf8, 0x23, 0xa6, 0x1f, 0x07, 0xc9, 0x57, 0xce, 0x86, 0xe5, 0xd0, 0x5f,
-	0x59, 0x39, 0x3d, 0x7c, 0x1c, 0xee, 0x97, 0x23, 0xdc, 0xf5, 0x81, 0xfb, 0x9d, 0xe7, 0xf7, 0x15,
-	0xa7, 0x3c, 0x41, 0x9c, 0x1e, 0x1e, 0x0e, 0xe7, 0x5b, 0x5e, 0xe1, 0x6f, 0x86, 0xf7, 0x5b, 0x15,
-	0x7e, 0xd2, 0x67, 0xce, 0x2b, 0xba, 0xc0, 0xe9, 0x19, 0x4c, 0x7b, 0x57, 0x82, 0x3b, 0xd6, 0xe1,
-	0x69, 0x32, 0x99, 0x50, 0x44, 0xd2, 0x51, 0x11, 0xd9, 0x86, 0xa9, 0x76, 0xd7, 0x70, 0x4c, 0x44,
-	0x54, 0xb9, 0x24, 0x57, 0x66, 0xb6, 0x0a, 0xd5, 0xe8, 0x1f, 0x40, 0x75, 0x97, 0x52, 0xd7, 0x6a,
-	0x0d, 0x28, 0xaa, 0x67, 0x3c, 0x32, 0xcd, 0x20, 0x9a, 0x01, 0x28, 0xb0, 0x27, 0x65, 0x04, 0x20,
-	0x8a, 0x6c, 0xeb, 0x73, 0x0e, 0xe4, 0x06, 0x31, 0x95, 0x1d, 0xc8, 0xb0, 0xb7, 0x74, 0x99, 0x2f,
-	0xea, 0x3f, 0xc1, 0xda, 0x6a, 0xac, 0x39, 0x14, 0x9b, 0xfb, 0x30, 0xcb, 0xbd, 0xc8, 0x62, 0x78,
-	0xd4, 0xad, 0xad, 0x5f, 0xeb, 0x0e, 0xab, 0x1e, 0xc0, 0xdc, 0xf8, 0x23, 0x28, 0x24, 0xf2, 0x01,
-	0xda, 0x5f, 0x09, 0x01, 0x61, 0xed, 0x36, 0x2c, 0x8a, 0x2f, 0x5a, 0x59, 0xc8, 0x16, 0x62, 0xb4,
-	0x8d, 0xe4, 0x98, 0xb0, 0xc9, 0xff, 0x90, 0x1d, 0x3d, 0x50, 0x2b, 0x42, 0x12, 0xb3, 0x6b, 0x7a,
-	0xbc, 0x3d, 0x2c, 0xf0, 0x00, 0xe6, 0xc7, 0xc5, 0xb9, 0x24, 0xa4, 0x8c, 0x45, 0x68, 0x95, 0xa4,
-	0x88, 0xb0, 0xfc, 0x23, 0x58, 0x10, 0xa4, 0x75, 0xed, 0x8a, 0x13, 0x8c, 0x34, 0xf8, 0x3b, 0x31,
-	0x24, 0xec, 0xb0, 0x03, 0x19, 0x26, 0x94, 0xe2, 0x58, 0x79, 0xe6, 0x98, 0xb1, 0x8a, 0xca, 0x96,
-	0x97, 0xcd, 0x3e, 0x7f, 0x31, 0xdb, 0x33, 0xc7, 0x64, 0x47, 0x3f, 0xbc, 0xe8, 0x50, 0xb2, 0x2a,
-	0x57, 0x0f, 0x25, 0xab, 0xb6, 0x7e, 0xad, 0x3b, 0xac, 0x5a, 0x87, 0x9c, 0xff, 0x1d, 0x17, 0x44,
-	0xf0, 0xcc, 0xa1, 0x15, 0xaf, 0x70, 0x04, 0x35, 0x34, 0xf9, 0x79, 0x5a, 0xaa, 0xef, 0x9f, 0x7d,
-	0xd2, 0x53, 0x6f, 0x86, 0x7a, 0xea, 0x6c, 0xa8, 0x4b, 0xe7, 0x43, 0x5d, 0xfa, 0x38, 0xd4, 0xa5,
-	0x57, 0x97, 0x7a, 0xea, 0xfc, 0x52, 0x4f, 0xbd, 0xbf, 0xd4, 0x53, 0x07, 0x95, 0x44, 0x31, 0x3a,
-	0x19, 0xfd, 0xd3, 0xb7, 0x72, 0xec, 0xa7, 0xfe, 0xdf, 0x2f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x7c, 0x45, 0x23, 0x2b, 0x0c, 0x00, 0x00,
+	// 1083 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xe3, 0xb4, 0x49, 0x5f, 0xf7, 0x4f, 0x6b, 0xda, 0xad, 0x31, 0x5b, 0x27, 0x1b, 0x69,
+	0x97, 0xb0, 0x12, 0x8e, 0xb6, 0x1c, 0x56, 0x42, 0x2b, 0xc1, 0x06, 0x01, 0xea, 0x8a, 0x08, 0xe4,
+	0xad, 0x84, 0xb4, 0x12, 0x5a, 0x9c, 0x64, 0xea, 0x58, 0x8d, 0x67, 0x2c, 0xcf, 0x24, 0xb4, 0x48,
+	0x88, 0x2b, 0xe2, 0x80, 0xf6, 0xc6, 0x8d, 0x33, 0x67, 0x3e, 0x02, 0xa7, 0x1e, 0xf7, 0x88, 0x38,
+	0x2c, 0xd0, 0x7e, 0x01, 0x4e, 0x9c, 0xd1, 0x8c, 0xed, 0x69, 0x26, 0x76, 0x9b, 0xa5, 0x44, 0x88,
+	0xdb, 0xcc, 0xfb, 0xf3, 0x7b, 0xbf, 0xf7, 0xfc, 0xf2, 0xe6, 0x05, 0x36, 0x47, 0xbd, 0xb0, 0xcd,
+	0xc8, 0x01, 0xc2, 0xed, 0xc9, 0xbd, 0x36, 0x3b, 0x74, 0xa2, 0x98, 0x30, 0x62, 0x5c, 0x19, 0xf5,
+	0x42, 0x47, 0x88, 0x9d, 0xc9, 0x3d, 0x6b, 0xc3, 0x27, 0x3e, 0x11, 0x8a, 0x36, 0x3f, 0x25, 0x36,
+	0x56, 0xdd, 0x27, 0xc4, 0x1f, 0xa1, 0xb6, 0xb8, 0xf5, 0xc6, 0xfb, 0x6d, 0x16, 0x84, 0x88, 0x32,
+	0x2f, 0x8c, 0x52, 0x03, 0x53, 0xc5, 0x16, 0x68, 0x42, 0xd3, 0xfc, 0x41, 0x83, 0x6a, 0x97, 0xfa,
+	0x8f, 0x11, 0x1e, 0x18, 0x75, 0x58, 0xed, 0x13, 0xcc, 0x62, 0xaf, 0xcf, 0x9e, 0x06, 0x03, 0x53,
+	0x6b, 0x68, 0xad, 0x15, 0x17, 0x32, 0xd1, 0xee, 0xc0, 0x30, 0xa0, 0xb2, 0x1f, 0x93, 0xd0, 0x2c,
+	0x0b, 0x8d, 0x38, 0x1b, 0xd7, 0xa0, 0xcc, 0x88, 0xa9, 0x0b, 0x49, 0x99, 0x11, 0xe3, 0x11, 0x2c,
+	0x7b, 0x21, 0x19, 0x63, 0x66, 0x56, 0xb8, 0xac, 0xb3, 0x73, 0xfc, 0xa2, 0x5e, 0xfa, 0xf5, 0x45,
+	0xfd, 0xae, 0x1f, 0xb0, 0xe1, 0xb8, 0xe7, 0xf4, 0x49, 0xd8, 0xfe, 0x20, 0xc0, 0xb4, 0x3f, 0x0c,
+	0xbc, 0xf6, 0x7e, 0x7a, 0x78, 0x93, 0x0e, 0x0e, 0xda, 0xec, 0x28, 0x42, 0xd4, 0xd9, 0xc5, 0xcc,
+	0x4d, 0x11, 0xde, 0x2e, 0x9b, 0x5a, 0x73, 0x13, 0xae, 0xa7, 0xfc, 0x5c, 0x44, 0x23, 0x82, 0x29,
+	0x12, 0xe2, 0x9f, 0x35, 0x21, 0xff, 0x38, 0x42, 0xb1, 0xc7, 0x48, 0xfc, 0x72, 0xfc, 0x2d, 0xa8,
+	0x91, 0xd4, 0x21, 0xcd, 0x41, 0xde, 0x65, 0x6e, 0x7a, 0x2e, 0xb7, 0x4a, 0x41, 0x6e, 0x4b, 0x0b,
+	0xc9, 0x6d, 0x1b, 0xb6, 0x66, 0x72, 0x50, 0x72, 0x1c, 0xc1, 0x7a, 0x97, 0xfa, 0x2e, 0x9a, 0x90,
+	0x03, 0x94, 0x19, 0xcd, 0x4f, 0xf2, 0x06, 0x2c, 0x0f, 0xc9, 0x68, 0x80, 0xb2, 0x14, 0xd3, 0x9b,
+	0x92, 0xbc, 0xae, 0x26, 0x2f, 0xa2, 0xd5, 0xe1, 0xd5, 0x5c, 0x34, 0x85, 0x0e, 0x81, 0x8d, 0x2e,
+	0xf5, 0x1f, 0x8e, 0xd9, 0x90, 0xc4, 0xc1, 0x97, 0xff, 0x01, 0xa3, 0x26, 0xdc, 0x2c, 0x0a, 0xa8,
+	0x90, 0xfa, 0xb6, 0x0c, 0xb5, 0x2e, 0xf5, 0x77, 0x29, 0x1d, 0x23, 0xfe, 0x0d, 0xb1, 0x17, 0xa2,
+	0x94, 0x82, 0x38, 0xf3, 0xe0, 0xf4, 0x28, 0xec, 0x91, 0x51, 0x16, 0x3c, 0xb9, 0x19, 0x6b, 0xa0,
+	0x8f, 0xe3, 0x20, 0x8d, 0xcb, 0x8f, 0xdc, 0x3b, 0x44, 0xcc, 0x4b, 0xbf, 0xb7, 0x38, 0x73, 0x8a,
+	0x03, 0xd4, 0x0f, 0x42, 0x6f, 0x44, 0xc5, 0x37, 0x5f, 0x72, 0xe5, 0x9d, 0xeb, 0xc2, 0x00, 0x33,
+	0xaf, 0x37, 0x42, 0xe6, 0x72, 0x43, 0x6b, 0xd5, 0x5c, 0x79, 0x37, 0x36, 0x60, 0x89, 0x7c, 0x81,
+	0x51, 0x6c, 0x56, 0x05, 0x58, 0x72, 0x49, 0xfb, 0xa9, 0x56, 0xd0, 0x4f, 0x2b, 0x0b, 0xe9, 0xa7,
+	0xfb, 0xb0, 0x96, 0xd5, 0x22, 0x2b, 0xd2, 0xdc, 0xaf, 0x23, 0x1c, 0xbf, 0x02, 0xa3, 0x4b, 0xfd,
+	0x0f, 0x63, 0x0f, 0xb3, 0x4f, 0x50, 0x1c, 0x06, 0x94, 0x06, 0x04, 0x2f, 0x66, 0x1e, 0xd8, 0x00,
+	0x91, 0x84, 0x4c, 0x6b, 0x3b, 0x25, 0x11, 0xe1, 0x1b, 0x60, 0xe5, 0xc3, 0x2b, 0x9f, 0x19, 0xc3,
+	0x2b, 0xb2, 0x39, 0xff, 0x2d, 0x43, 0x95, 0x91, 0x5e, 0xc8, 0xe8, 0x16, 0xbc, 0x56, 0x10, 0x4f,
+	0xa1, 0x94, 0x4e, 0xce, 0x6e, 0x80, 0xd9, 0xff, 0x79, 0x72, 0x72, 0x7e, 0x0a, 0xef, 0xef, 0x12,
+	0xde, 0x9d, 0x71, 0x7c, 0xc9, 0xfa, 0x9d, 0xf1, 0xd4, 0x17, 0xc8, 0x93, 0xf3, 0x51, 0x78, 0xfe,
+	0xa4, 0x4e, 0xf8, 0x97, 0xe3, 0xfb, 0x4f, 0x27, 0xfc, 0xa2, 0x6b, 0xae, 0x4e, 0xf4, 0x5c, 0x4e,
+	0x5f, 0xc3, 0x0a, 0xff, 0x24, 0x64, 0x10, 0xec, 0x1f, 0xcd, 0x4f, 0x46, 0x0e, 0x91, 0xf2, 0xf4,
+	0x10, 0xb9, 0x0f, 0xd5, 0xfe, 0xd0, 0xc3, 0x3e, 0xa2, 0xa6, 0xde, 0xd0, 0x5b, 0xab, 0x3b, 0x5b,
+	0xce, 0xf4, 0x8a, 0xe0, 0x3c, 0x64, 0x2c, 0x0e, 0x7a, 0x63, 0x86, 0x3a, 0x15, 0x9e, 0x8c, 0x9b,
+	0x59, 0x0b, 0x02, 0x5b, 0xb0, 0x2e, 0x09, 0x28, 0xcc, 0xbe, 0xd7, 0xc4, 0xec, 0x78, 0x8f, 0xe0,
+	0x09, 0x8a, 0xd9, 0x1e, 0xe9, 0x78, 0xf8, 0xe0, 0xf2, 0x93, 0x7d, 0x81, 0x2d, 0xd2, 0xb4, 0xc0,
+	0x9c, 0x25, 0x96, 0x31, 0x6f, 0xfa, 0x82, 0x74, 0x37, 0xf0, 0x63, 0x8f, 0xa1, 0x94, 0xf4, 0x4d,
+	0x58, 0xf1, 0x92, 0x27, 0x83, 0x1d, 0xa5, 0x94, 0xcf, 0x04, 0xb3, 0x29, 0x95, 0x8b, 0x8a, 0x3e,
+	0x0a, 0xc2, 0x20, 0x61, 0x7e, 0xd5, 0x4d, 0x2e, 0x4d, 0x07, 0xcc, 0xd9, 0x40, 0x72, 0xc2, 0x1a,
+	0x50, 0x19, 0x10, 0x9c, 0xbc, 0x3a, 0x35, 0x57, 0x9c, 0x9b, 0x7f, 0x69, 0x00, 0xfc, 0xed, 0x8a,
+	0xa2, 0x98, 0x4c, 0xd0, 0xe5, 0x0b, 0x69, 0x42, 0x95, 0x46, 0x08, 0x73, 0x45, 0xd2, 0xb6, 0xd9,
+	0xd5, 0x78, 0x0c, 0xab, 0xe2, 0xf8, 0x34, 0x61, 0x7b, 0xf9, 0xf6, 0x05, 0x01, 0xf3, 0x11, 0x47,
+	0x31, 0xde, 0x05, 0x40, 0x87, 0x51, 0x10, 0x7b, 0x8c, 0x8f, 0x46, 0xfe, 0xe0, 0xad, 0xee, 0x58,
+	0x4e, 0xb2, 0x5d, 0x3a, 0xd9, 0x76, 0xe9, 0xec, 0x65, 0xdb, 0x65, 0xa7, 0xf2, 0xec, 0xb7, 0xba,
+	0xe6, 0x4e, 0xf9, 0x34, 0x37, 0xc0, 0x38, 0xcb, 0x3b, 0x2b, 0xd1, 0xce, 0x9f, 0x35, 0xd0, 0xbb,
+	0xd4, 0x37, 0x1e, 0x40, 0x45, 0x6c, 0x6a, 0x9b, 0x6a, 0xcb, 0xa6, 0x0b, 0x9e, 0xb5, 0x5d, 0x28,
+	0x96, 0x85, 0xde, 0x83, 0x2b, 0xca, 0xbe, 0x97, 0x37, 0x9f, 0x56, 0x5b, 0xb7, 0x2f, 0x54, 0x4b,
+	0xd4, 0x27, 0x70, 0x6d, 0x76, 0xc5, 0xca, 0x39, 0xaa, 0x06, 0xd6, 0xeb, 0x73, 0x0c, 0x24, 0x76,
+	0x1f, 0xd6, 0xf3, 0xfb, 0x52, 0x33, 0xe7, 0x9d, 0xb3, 0xb1, 0xee, 0xce, 0xb7, 0x91, 0x41, 0xde,
+	0x81, 0xa5, 0x64, 0xfd, 0xb9, 0x91, 0x73, 0x12, 0x72, 0xcb, 0x2e, 0x96, 0x4b, 0x80, 0xcf, 0xe0,
+	0xfa, 0xec, 0xd3, 0xdf, 0xc8, 0xb9, 0xcc, 0x58, 0x58, 0xad, 0x79, 0x16, 0x12, 0xfe, 0x73, 0x58,
+	0xcb, 0x3d, 0xdc, 0xb7, 0xce, 0xa9, 0xe0, 0x54, 0x80, 0x37, 0xe6, 0x9a, 0xc8, 0x08, 0x0f, 0xa0,
+	0x22, 0x9e, 0xe1, 0x7c, 0x5b, 0x71, 0xb1, 0xb5, 0x5d, 0x28, 0x9e, 0xf6, 0x16, 0x8f, 0x4b, 0xde,
+	0x9b, 0x8b, 0xad, 0xed, 0x42, 0x71, 0x51, 0x53, 0x0a, 0x94, 0xf3, 0x9b, 0x52, 0xa0, 0xdd, 0xbe,
+	0x50, 0x2d, 0x51, 0x3b, 0xb0, 0x9c, 0xbe, 0x12, 0x5b, 0x79, 0xf2, 0x42, 0x61, 0xd5, 0xcf, 0x51,
+	0x48, 0x8c, 0x4f, 0xe1, 0xaa, 0x3a, 0xce, 0xf3, 0x7d, 0xa0, 0xe8, 0xad, 0x3b, 0x17, 0xeb, 0xa7,
+	0x81, 0xd5, 0x91, 0x6b, 0x17, 0x14, 0x78, 0x4a, 0x6f, 0xdd, 0xb9, 0x58, 0x2f, 0x81, 0xdf, 0x87,
+	0x6a, 0x36, 0x31, 0xcd, 0xfc, 0x0f, 0x20, 0xd1, 0x58, 0x8d, 0xf3, 0x34, 0x19, 0x8c, 0xa5, 0x7f,
+	0x53, 0xd6, 0x3a, 0x8f, 0x8e, 0xff, 0xb0, 0x4b, 0x3f, 0x9e, 0xd8, 0xa5, 0xe3, 0x13, 0x5b, 0x7b,
+	0x7e, 0x62, 0x6b, 0xbf, 0x9f, 0xd8, 0xda, 0xb3, 0x53, 0xbb, 0xf4, 0xfc, 0xd4, 0x2e, 0xfd, 0x72,
+	0x6a, 0x97, 0x9e, 0xb4, 0xe6, 0x0e, 0xc9, 0xc3, 0xe4, 0xaf, 0x72, 0x6f, 0x59, 0x8c, 0xbe, 0xb7,
+	0xfe, 0x1e, 0x00, 0x01, 0xff, 0xd0, 0x70, 0xa3, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1111,10 +1387,11 @@ type MsgClient interface {
 	// - transfer (deprecated, not typed)
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
 	// OperatorSend defines a method to send tokens from one account to another account by the operator.
+	// The operator must be authorized by the holder, or have an allowance covering the amount.
+	// In the latter case, the allowance decreases by the amount.
 	// Fires:
 	// - EventSent
 	// - transfer_from (deprecated, not typed)
-	// Note: the approval has no value of limit (not ERC20 compliant).
 	OperatorSend(ctx context.Context, in *MsgOperatorSend, opts ...grpc.CallOption) (*MsgOperatorSendResponse, error)
 	// RevokeOperator revoke the authorization of the operator to send the holder's tokens.
 	// Fires:
@@ -1164,6 +1441,27 @@ type MsgClient interface {
 	// - EventModified
 	// - modify_token (deprecated, not typed)
 	Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error)
+	// ConvertToBank defines a method to convert the holder's tokens into the coins of x/bank.
+	// The denom of the coins is `token/{contract_id}`, whose metadata is derived from the contract.
+	// Fires:
+	// - EventConvertedToBank
+	ConvertToBank(ctx context.Context, in *MsgConvertToBank, opts ...grpc.CallOption) (*MsgConvertToBankResponse, error)
+	// MigrateToBank defines a method to convert all the tokens of a contract into the coins of x/bank.
+	// Each message converts the tokens of at most `limit` holders, so the authority repeats it until the
+	// response reports the migration done.
+	// The holders whose tokens cannot be converted are skipped, and the migration stays undone until they are converted.
+	// After the migration, the mint and burn permissions of the contract apply to the coins of x/bank,
+	// through Msg/Mint and Msg/Burn of this module. x/bank itself has no notion of a denom admin.
+	// Fires:
+	// - EventConvertedToBank (for each holder)
+	// - EventConvertToBankFailed (for each holder skipped)
+	// - EventMigratedToBank (once the migration is done)
+	MigrateToBank(ctx context.Context, in *MsgMigrateToBank, opts ...grpc.CallOption) (*MsgMigrateToBankResponse, error)
+	// Approve sets the allowance of the spender on the holder's tokens, replacing the existing one.
+	// A zero spend limit removes the allowance.
+	// Fires:
+	// - EventApproved
+	Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error)
 }
 
 type msgClient struct {
@@ -1274,6 +1572,33 @@ func (c *msgClient) Modify(ctx context.Context, in *MsgModify, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ConvertToBank(ctx context.Context, in *MsgConvertToBank, opts ...grpc.CallOption) (*MsgConvertToBankResponse, error) {
+	out := new(MsgConvertToBankResponse)
+	err := c.cc.Invoke(ctx, "/lbm.token.v1.Msg/ConvertToBank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateToBank(ctx context.Context, in *MsgMigrateToBank, opts ...grpc.CallOption) (*MsgMigrateToBankResponse, error) {
+	out := new(MsgMigrateToBankResponse)
+	err := c.cc.Invoke(ctx, "/lbm.token.v1.Msg/MigrateToBank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error) {
+	out := new(MsgApproveResponse)
+	err := c.cc.Invoke(ctx, "/lbm.token.v1.Msg/Approve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 //
 // Deprecated: Do not use.
@@ -1284,10 +1609,11 @@ type MsgServer interface {
 	// - transfer (deprecated, not typed)
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
 	// OperatorSend defines a method to send tokens from one account to another account by the operator.
+	// The operator must be authorized by the holder, or have an allowance covering the amount.
+	// In the latter case, the allowance decreases by the amount.
 	// Fires:
 	// - EventSent
 	// - transfer_from (deprecated, not typed)
-	// Note: the approval has no value of limit (not ERC20 compliant).
 	OperatorSend(context.Context, *MsgOperatorSend) (*MsgOperatorSendResponse, error)
 	// RevokeOperator revoke the authorization of the operator to send the holder's tokens.
 	// Fires:
@@ -1337,6 +1663,27 @@ type MsgServer interface {
 	// - EventModified
 	// - modify_token (deprecated, not typed)
 	Modify(context.Context, *MsgModify) (*MsgModifyResponse, error)
+	// ConvertToBank defines a method to convert the holder's tokens into the coins of x/bank.
+	// The denom of the coins is `token/{contract_id}`, whose metadata is derived from the contract.
+	// Fires:
+	// - EventConvertedToBank
+	ConvertToBank(context.Context, *MsgConvertToBank) (*MsgConvertToBankResponse, error)
+	// MigrateToBank defines a method to convert all the tokens of a contract into the coins of x/bank.
+	// Each message converts the tokens of at most `limit` holders, so the authority repeats it until the
+	// response reports the migration done.
+	// The holders whose tokens cannot be converted are skipped, and the migration stays undone until they are converted.
+	// After the migration, the mint and burn permissions of the contract apply to the coins of x/bank,
+	// through Msg/Mint and Msg/Burn of this module. x/bank itself has no notion of a denom admin.
+	// Fires:
+	// - EventConvertedToBank (for each holder)
+	// - EventConvertToBankFailed (for each holder skipped)
+	// - EventMigratedToBank (once the migration is done)
+	MigrateToBank(context.Context, *MsgMigrateToBank) (*MsgMigrateToBankResponse, error)
+	// Approve sets the allowance of the spender on the holder's tokens, replacing the existing one.
+	// A zero spend limit removes the allowance.
+	// Fires:
+	// - EventApproved
+	Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error)
 }
 
 // Deprecated: Do not use.
@@ -1377,6 +1724,15 @@ func (*UnimplementedMsgServer) OperatorBurn(ctx context.Context, req *MsgOperato
 func (*UnimplementedMsgServer) Modify(ctx context.Context, req *MsgModify) (*MsgModifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Modify not implemented")
 }
+func (*UnimplementedMsgServer) ConvertToBank(ctx context.Context, req *MsgConvertToBank) (*MsgConvertToBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertToBank not implemented")
+}
+func (*UnimplementedMsgServer) MigrateToBank(ctx context.Context, req *MsgMigrateToBank) (*MsgMigrateToBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateToBank not implemented")
+}
+func (*UnimplementedMsgServer) Approve(ctx context.Context, req *MsgApprove) (*MsgApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
 
 // Deprecated: Do not use.
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
@@ -1581,6 +1937,60 @@ func _Msg_Modify_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertToBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertToBank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertToBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.token.v1.Msg/ConvertToBank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertToBank(ctx, req.(*MsgConvertToBank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateToBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateToBank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateToBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.token.v1.Msg/MigrateToBank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateToBank(ctx, req.(*MsgMigrateToBank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.token.v1.Msg/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Approve(ctx, req.(*MsgApprove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.token.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1629,6 +2039,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Modify",
 			Handler:    _Msg_Modify_Handler,
 		},
+		{
+			MethodName: "ConvertToBank",
+			Handler:    _Msg_ConvertToBank_Handler,
+		},
+		{
+			MethodName: "MigrateToBank",
+			Handler:    _Msg_MigrateToBank_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _Msg_Approve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/token/v1/tx.proto",
@@ -2488,44 +2910,276 @@ func (m *MsgModifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgConvertToBank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgConvertToBank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConvertToBank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertToBankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertToBankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertToBankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateToBank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateToBank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateToBank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateToBankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateToBankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateToBankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApprove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -2869,27 +3523,123 @@ func (m *MsgModifyResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgConvertToBank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgConvertToBankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func (m *MsgMigrateToBank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgMigrateToBankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Done {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApprove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -3164,13 +3914,782 @@ func (m *MsgOperatorSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3180,27 +4699,15 @@ func (m *MsgOperatorSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Mintable = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3228,9 +4735,9 @@ func (m *MsgOperatorSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -3262,7 +4769,7 @@ func (m *MsgOperatorSend) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -3317,7 +4824,7 @@ func (m *MsgOperatorSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3340,12 +4847,44 @@ func (m *MsgOperatorSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3367,7 +4906,7 @@ func (m *MsgOperatorSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,10 +4929,10 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3430,7 +4969,7 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3458,11 +4997,11 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3490,7 +5029,39 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3513,7 +5084,7 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3536,10 +5107,10 @@ func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3563,7 +5134,7 @@ func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3586,10 +5157,10 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3626,7 +5197,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3654,11 +5225,11 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3686,7 +5257,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3709,57 +5280,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgIssue) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3781,112 +5302,66 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3914,50 +5389,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Mintable = bool(v != 0)
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3985,9 +5421,9 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -4019,7 +5455,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -4074,7 +5510,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4097,44 +5533,12 @@ func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4156,7 +5560,7 @@ func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4179,10 +5583,10 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4251,7 +5655,7 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4279,39 +5683,9 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4334,7 +5708,7 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4357,10 +5731,10 @@ func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4384,7 +5758,7 @@ func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4407,15 +5781,47 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4443,9 +5849,9 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
@@ -4477,9 +5883,9 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4507,7 +5913,9 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permission = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4530,7 +5938,7 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4553,10 +5961,10 @@ func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4580,7 +5988,7 @@ func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgModify) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4603,10 +6011,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModify: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModify: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4643,7 +6051,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4671,45 +6079,13 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4719,23 +6095,23 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, Attribute{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4760,7 +6136,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4783,10 +6159,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4810,7 +6186,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertToBank) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4833,10 +6209,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertToBank: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertToBank: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4873,7 +6249,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4901,7 +6277,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4958,7 +6334,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertToBankResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4981,10 +6357,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertToBankResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertToBankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5008,7 +6384,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateToBank) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5031,15 +6407,15 @@ func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateToBank: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateToBank: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5067,11 +6443,11 @@ func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5099,45 +6475,13 @@ func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5147,26 +6491,11 @@ func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5188,7 +6517,7 @@ func (m *MsgOperatorBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateToBankResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5211,12 +6540,32 @@ func (m *MsgOperatorBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateToBankResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateToBankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5238,7 +6587,7 @@ func (m *MsgOperatorBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModify) Unmarshal(dAtA []byte) error {
+func (m *MsgApprove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5261,10 +6610,10 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModify: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApprove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModify: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApprove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5301,7 +6650,7 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5329,11 +6678,77 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5360,8 +6775,10 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, Attribute{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5386,7 +6803,7 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5409,10 +6826,10 @@ func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: